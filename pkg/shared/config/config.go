@@ -15,6 +15,9 @@ type Config struct {
 	NATS       NATSConfig       `mapstructure:"nats"`
 	Auth       AuthConfig       `mapstructure:"auth"`
 	Hanko      HankoConfig      `mapstructure:"hanko"`
+	WebAuthn   WebAuthnConfig   `mapstructure:"webauthn"`
+	Risk       RiskConfig       `mapstructure:"risk"`
+	Audit      AuditConfig      `mapstructure:"audit"`
 	Monitoring MonitoringConfig `mapstructure:"monitoring"`
 	Logging    LoggingConfig    `mapstructure:"logging"`
 }
@@ -28,6 +31,9 @@ type ServiceConfig struct {
 	Port        int    `mapstructure:"port"`
 	GRPCPort    int    `mapstructure:"grpc_port"`
 	Timeout     int    `mapstructure:"timeout"`
+	// TrustedProxies lists the IP addresses or CIDR ranges whose
+	// X-Forwarded-For header is believed
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 // DatabaseConfig holds database configuration
@@ -65,10 +71,11 @@ type NATSConfig struct {
 
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
-	JWTSecret     string `mapstructure:"jwt_secret"`
-	JWTExpiration int    `mapstructure:"jwt_expiration"`
-	Issuer        string `mapstructure:"issuer"`
-	Audience      string `mapstructure:"audience"`
+	JWTSecret            string `mapstructure:"jwt_secret"`
+	JWTExpiration        int    `mapstructure:"jwt_expiration"`
+	Issuer               string `mapstructure:"issuer"`
+	Audience             string `mapstructure:"audience"`
+	SessionSweepInterval int    `mapstructure:"session_sweep_interval"` // seconds between idle session sweeps
 }
 
 // HankoConfig holds Hanko authentication service configuration
@@ -79,6 +86,30 @@ type HankoConfig struct {
 	RetryCount int    `mapstructure:"retry_count"`
 }
 
+// WebAuthnConfig holds native WebAuthn relying party configuration
+type WebAuthnConfig struct {
+	RPID             string   `mapstructure:"rp_id"`
+	RPName           string   `mapstructure:"rp_name"`
+	Origins          []string `mapstructure:"origins"`
+	Timeout          int      `mapstructure:"timeout"`
+	UserVerification string   `mapstructure:"user_verification"`
+}
+
+// RiskConfig holds risk-based authentication configuration
+type RiskConfig struct {
+	GeoIPDatabase string `mapstructure:"geoip_database"`
+	FailureWindow int    `mapstructure:"failure_window"`
+	HistorySize   int    `mapstructure:"history_size"`
+	ChallengeTTL  int    `mapstructure:"challenge_ttl"`
+}
+
+// AuditConfig holds tamper-evident audit trail configuration
+type AuditConfig struct {
+	SigningKey        string `mapstructure:"signing_key"`        // base64 Ed25519 seed for export manifests
+	AnchorInterval    int    `mapstructure:"anchor_interval"`    // seconds between chain anchors; 0 disables anchoring
+	RetentionInterval int    `mapstructure:"retention_interval"` // seconds between retention sweeps
+}
+
 // MonitoringConfig holds monitoring configuration
 type MonitoringConfig struct {
 	MetricsPath    string `mapstructure:"metrics_path"`
@@ -143,6 +174,7 @@ func setDefaults(serviceName string) {
 	viper.SetDefault("service.port", 8080)
 	viper.SetDefault("service.grpc_port", 9090)
 	viper.SetDefault("service.timeout", 30)
+	viper.SetDefault("service.trusted_proxies", []string{})
 
 	// Database defaults
 	viper.SetDefault("database.host", "localhost")
@@ -175,6 +207,25 @@ func setDefaults(serviceName string) {
 	viper.SetDefault("auth.jwt_expiration", 3600)
 	viper.SetDefault("auth.issuer", "reciprocal-clubs")
 	viper.SetDefault("auth.audience", "reciprocal-clubs")
+	viper.SetDefault("auth.session_sweep_interval", 300)
+
+	// WebAuthn defaults
+	viper.SetDefault("webauthn.rp_id", "localhost")
+	viper.SetDefault("webauthn.rp_name", "Reciprocal Clubs")
+	viper.SetDefault("webauthn.origins", []string{"http://localhost:3000"})
+	viper.SetDefault("webauthn.timeout", 300)
+	viper.SetDefault("webauthn.user_verification", "preferred")
+
+	// Risk engine defaults
+	viper.SetDefault("risk.geoip_database", "")
+	viper.SetDefault("risk.failure_window", 900)
+	viper.SetDefault("risk.history_size", 20)
+	viper.SetDefault("risk.challenge_ttl", 300)
+
+	// Audit defaults
+	viper.SetDefault("audit.signing_key", "")
+	viper.SetDefault("audit.anchor_interval", 0)
+	viper.SetDefault("audit.retention_interval", 86400)
 
 	// Monitoring defaults
 	viper.SetDefault("monitoring.metrics_path", "/metrics")
@@ -2,30 +2,40 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/database"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/messaging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
 
+	"reciprocal-clubs-backend/services/analytics-service/internal/blobstore"
 	grpcHandlers "reciprocal-clubs-backend/services/analytics-service/internal/handlers/grpc"
 	httpHandlers "reciprocal-clubs-backend/services/analytics-service/internal/handlers/http"
 	"reciprocal-clubs-backend/services/analytics-service/internal/integrations"
 	"reciprocal-clubs-backend/services/analytics-service/internal/models"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
 	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
 	"reciprocal-clubs-backend/services/analytics-service/internal/service"
+	"reciprocal-clubs-backend/services/analytics-service/internal/sink"
 )
 
 const serviceName = "analytics-service"
@@ -54,6 +64,23 @@ func main() {
 		&repository.AnalyticsEvent{},
 		&repository.AnalyticsMetric{},
 		&repository.AnalyticsReport{},
+		&repository.ReportSchedule{},
+		&repository.Dashboard{},
+		&repository.AnomalySetting{},
+		&repository.AnomalyEpisode{},
+		&repository.IngestedMessage{},
+		&repository.VisitFact{},
+		&repository.AgreementFact{},
+		&repository.MemberFact{},
+		&repository.MemberStatusChange{},
+		&repository.VoteFact{},
+		&repository.MetricRollup{},
+		&repository.RollupWatermark{},
+		&repository.RetentionPolicy{},
+		&repository.OutboxRecord{},
+		&repository.SinkCheckpoint{},
+		&repository.PrivacySettings{},
+		&repository.ErasureRequest{},
 	); err != nil {
 		logger.Fatal("Failed to migrate database", map[string]interface{}{"error": err.Error()})
 	}
@@ -86,9 +113,10 @@ func main() {
 			Namespace: getEnvOrDefault("DATADOG_NAMESPACE", "analytics"),
 		},
 		Grafana: &integrations.GrafanaConfig{
-			URL:    getEnvOrDefault("GRAFANA_URL", ""),
-			APIKey: getEnvOrDefault("GRAFANA_API_KEY", ""),
-			OrgID:  1,
+			URL:           getEnvOrDefault("GRAFANA_URL", ""),
+			APIKey:        getEnvOrDefault("GRAFANA_API_KEY", ""),
+			OrgID:         1,
+			DataSourceUID: getEnvOrDefault("GRAFANA_DATASOURCE_UID", ""),
 		},
 		BigQuery: &integrations.BigQueryConfig{
 			ProjectID:       getEnvOrDefault("BIGQUERY_PROJECT_ID", ""),
@@ -96,11 +124,17 @@ func main() {
 			CredentialsPath: getEnvOrDefault("BIGQUERY_CREDENTIALS_PATH", ""),
 		},
 		S3: &integrations.S3Config{
-			Region:     getEnvOrDefault("AWS_REGION", "us-east-1"),
-			Bucket:     getEnvOrDefault("S3_BUCKET", ""),
-			AccessKey:  getEnvOrDefault("AWS_ACCESS_KEY_ID", ""),
-			SecretKey:  getEnvOrDefault("AWS_SECRET_ACCESS_KEY", ""),
-			PathPrefix: getEnvOrDefault("S3_PATH_PREFIX", "analytics"),
+			Endpoint:     getEnvOrDefault("S3_ENDPOINT", ""),
+			Region:       getEnvOrDefault("AWS_REGION", "us-east-1"),
+			Bucket:       getEnvOrDefault("S3_BUCKET", ""),
+			AccessKey:    getEnvOrDefault("AWS_ACCESS_KEY_ID", ""),
+			SecretKey:    getEnvOrDefault("AWS_SECRET_ACCESS_KEY", ""),
+			PathPrefix:   getEnvOrDefault("S3_PATH_PREFIX", "analytics"),
+			PathStyle:    getEnvOrDefault("S3_PATH_STYLE", "false") == "true",
+			ExportFormat: getEnvOrDefault("EXPORT_FORMAT", sink.FormatNDJSON),
+		},
+		Notifications: &integrations.NotificationConfig{
+			URL: getEnvOrDefault("NOTIFICATION_SERVICE_URL", ""),
 		},
 	}
 
@@ -125,6 +159,41 @@ func main() {
 	// Initialize service
 	analyticsService := service.NewService(repo, logger, natsClient, monitoringService, analyticsIntegrations)
 
+	// Configure scheduled report storage and delivery (non-fatal)
+	if reportConfig, err := loadReportConfig(cfg.Service.Port, cfg.Service.Environment, logger); err != nil {
+		logger.Warn("Scheduled reports are disabled", map[string]interface{}{"error": err.Error()})
+	} else {
+		analyticsService.ConfigureReports(reportConfig)
+	}
+
+	// Configure dashboard embedding (non-fatal)
+	if dashboardConfig, err := loadDashboardConfig(cfg.Service.Environment, logger); err != nil {
+		logger.Warn("Dashboard embedding is disabled", map[string]interface{}{"error": err.Error()})
+	} else {
+		analyticsService.ConfigureDashboards(dashboardConfig)
+	}
+
+	// Configure export sinks (non-fatal)
+	if sinkConfig, err := loadSinkConfig(analyticsIntegrations); err != nil {
+		logger.Warn("Event and metric export is disabled", map[string]interface{}{"error": err.Error()})
+	} else {
+		analyticsService.ConfigureSinks(sinkConfig)
+	}
+
+	// Configure fact backfill from the source services' databases (non-fatal)
+	if backfillConfig, err := loadBackfillConfig(); err != nil {
+		logger.Warn("Fact backfill is disabled", map[string]interface{}{"error": err.Error()})
+	} else {
+		analyticsService.ConfigureBackfill(backfillConfig)
+	}
+
+	// Configure event data protection (non-fatal)
+	if privacyConfig, err := loadPrivacyConfig(); err != nil {
+		logger.Warn("Invalid privacy configuration; using the defaults", map[string]interface{}{"error": err.Error()})
+	} else if err := analyticsService.ConfigurePrivacy(privacyConfig); err != nil {
+		logger.Warn("Invalid privacy configuration; using the defaults", map[string]interface{}{"error": err.Error()})
+	}
+
 	// Start event processor
 	if err := analyticsService.StartEventProcessor(); err != nil {
 		logger.Error("Failed to start event processor", map[string]interface{}{"error": err.Error()})
@@ -134,6 +203,11 @@ func main() {
 	httpHandler := httpHandlers.NewHTTPHandler(analyticsService, logger, monitoringService)
 	grpcHandler := grpcHandlers.NewGRPCHandler(analyticsService, logger, monitoringService)
 
+	// Scope requests to the caller's club
+	authProvider := auth.NewJWTProvider(&cfg.Auth, logger)
+	httpHandler.SetAuthProvider(authProvider)
+	grpcHandler.SetAuthProvider(authProvider)
+
 	// Start HTTP server
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Service.Port),
@@ -148,7 +222,10 @@ func main() {
 	}()
 
 	// Start gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcHandler.ClubScopeInterceptor),
+		grpc.StreamInterceptor(grpcHandler.ClubScopeStreamInterceptor),
+	)
 	grpcHandler.RegisterServices(grpcServer)
 	reflection.Register(grpcServer)
 
@@ -206,3 +283,143 @@ func getEnvOrDefault(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// loadReportConfig configures where scheduled reports are stored and how their
+// download links are signed
+func loadReportConfig(port int, environment string, logger logging.Logger) (service.ReportConfig, error) {
+	store, err := blobstore.New(blobstore.Config{
+		Driver: getEnvOrDefault("REPORT_STORE", blobstore.DriverFile),
+		Dir:    getEnvOrDefault("REPORT_STORE_DIR", "/var/lib/analytics/reports"),
+		S3: blobstore.S3Config{
+			Endpoint:  getEnvOrDefault("S3_ENDPOINT", ""),
+			Region:    getEnvOrDefault("AWS_REGION", "us-east-1"),
+			Bucket:    getEnvOrDefault("S3_BUCKET", ""),
+			AccessKey: getEnvOrDefault("AWS_ACCESS_KEY_ID", ""),
+			SecretKey: getEnvOrDefault("AWS_SECRET_ACCESS_KEY", ""),
+			Prefix:    getEnvOrDefault("S3_PATH_PREFIX", "analytics"),
+			PathStyle: getEnvOrDefault("S3_PATH_STYLE", "false") == "true",
+		},
+	})
+	if err != nil {
+		return service.ReportConfig{}, err
+	}
+
+	signingKey := []byte(getEnvOrDefault("REPORT_SIGNING_KEY", ""))
+	if len(signingKey) == 0 {
+		// Every replica needs the same key to accept the links the others sign
+		if !strings.EqualFold(environment, "development") {
+			return service.ReportConfig{}, fmt.Errorf("REPORT_SIGNING_KEY is required outside development")
+		}
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			return service.ReportConfig{}, fmt.Errorf("failed to generate report signing key: %w", err)
+		}
+		logger.Warn("REPORT_SIGNING_KEY is not set; report links will expire on restart", map[string]interface{}{})
+	}
+
+	linkTTL, err := time.ParseDuration(getEnvOrDefault("REPORT_LINK_TTL", "168h"))
+	if err != nil {
+		return service.ReportConfig{}, fmt.Errorf("invalid REPORT_LINK_TTL: %w", err)
+	}
+
+	return service.ReportConfig{
+		Store:      store,
+		SigningKey: signingKey,
+		BaseURL:    getEnvOrDefault("REPORT_BASE_URL", fmt.Sprintf("http://localhost:%d/api/v1/analytics/reports", port)),
+		LinkTTL:    linkTTL,
+	}, nil
+}
+
+// loadDashboardConfig configures how dashboard embed tokens are signed
+func loadDashboardConfig(environment string, logger logging.Logger) (service.DashboardConfig, error) {
+	embedKey := []byte(getEnvOrDefault("DASHBOARD_EMBED_KEY", ""))
+	if len(embedKey) == 0 {
+		// Every replica needs the same key to accept the tokens the others sign
+		if !strings.EqualFold(environment, "development") {
+			return service.DashboardConfig{}, fmt.Errorf("DASHBOARD_EMBED_KEY is required outside development")
+		}
+		embedKey = make([]byte, 32)
+		if _, err := rand.Read(embedKey); err != nil {
+			return service.DashboardConfig{}, fmt.Errorf("failed to generate dashboard embed key: %w", err)
+		}
+		logger.Warn("DASHBOARD_EMBED_KEY is not set; embed tokens will expire on restart", map[string]interface{}{})
+	}
+
+	return service.DashboardConfig{EmbedKey: embedKey}, nil
+}
+
+// loadSinkConfig collects the export sinks: every configured integration that
+// stores events or metrics, plus a local file sink when EXPORT_FILE_DIR is set
+func loadSinkConfig(analyticsIntegrations *integrations.AnalyticsIntegrations) (service.SinkConfig, error) {
+	sinks := analyticsIntegrations.Sinks()
+
+	format := getEnvOrDefault("EXPORT_FORMAT", sink.FormatNDJSON)
+	if dir := getEnvOrDefault("EXPORT_FILE_DIR", ""); dir != "" {
+		store, err := blobstore.NewFileStore(dir)
+		if err != nil {
+			return service.SinkConfig{}, err
+		}
+		fileSink, err := sink.NewObjectSink("file", store, "", format)
+		if err != nil {
+			return service.SinkConfig{}, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+
+	batchSize, err := strconv.Atoi(getEnvOrDefault("EXPORT_BATCH_SIZE", "500"))
+	if err != nil || batchSize <= 0 {
+		return service.SinkConfig{}, fmt.Errorf("invalid EXPORT_BATCH_SIZE")
+	}
+
+	retention, err := time.ParseDuration(getEnvOrDefault("EXPORT_OUTBOX_RETENTION", "72h"))
+	if err != nil {
+		return service.SinkConfig{}, fmt.Errorf("invalid EXPORT_OUTBOX_RETENTION: %w", err)
+	}
+
+	return service.SinkConfig{Sinks: sinks, BatchSize: batchSize, Retention: retention}, nil
+}
+
+// loadBackfillConfig opens a connection to the database owning each backfill
+// source, given as BACKFILL_<SOURCE>_DSN (for example BACKFILL_VISITS_DSN).
+// Sources sharing a DSN share the connection; sources without one are skipped.
+func loadBackfillConfig() (service.BackfillConfig, error) {
+	config := service.BackfillConfig{Sources: make(map[string]*gorm.DB)}
+	connections := make(map[string]*gorm.DB)
+	for _, source := range repository.BackfillSources {
+		dsn := getEnvOrDefault("BACKFILL_"+strings.ToUpper(source)+"_DSN", "")
+		if dsn == "" {
+			continue
+		}
+		db, ok := connections[dsn]
+		if !ok {
+			var err error
+			db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+			if err != nil {
+				return service.BackfillConfig{}, fmt.Errorf("failed to connect to the %s backfill database: %w", source, err)
+			}
+			connections[dsn] = db
+		}
+		config.Sources[source] = db
+	}
+	return config, nil
+}
+
+// loadPrivacyConfig reads the k-anonymity threshold of network aggregates and
+// the default privacy policy, given as JSON in PRIVACY_DEFAULT_POLICY
+func loadPrivacyConfig() (service.PrivacyConfig, error) {
+	minGroupSize, err := strconv.Atoi(getEnvOrDefault("PRIVACY_MIN_GROUP_SIZE", "5"))
+	if err != nil || minGroupSize <= 0 {
+		return service.PrivacyConfig{}, fmt.Errorf("invalid PRIVACY_MIN_GROUP_SIZE")
+	}
+	config := service.PrivacyConfig{MinGroupSize: minGroupSize}
+
+	if raw := getEnvOrDefault("PRIVACY_DEFAULT_POLICY", ""); raw != "" {
+		var policy privacy.Policy
+		if err := json.Unmarshal([]byte(raw), &policy); err != nil {
+			return service.PrivacyConfig{}, fmt.Errorf("invalid PRIVACY_DEFAULT_POLICY: %w", err)
+		}
+		config.DefaultPolicy = &policy
+	}
+
+	return config, nil
+}
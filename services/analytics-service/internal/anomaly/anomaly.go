@@ -0,0 +1,199 @@
+package anomaly
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Method names a detector
+type Method string
+
+const (
+	// MethodZScore compares each bucket with the mean and deviation of the trailing window
+	MethodZScore Method = "zscore"
+	// MethodMAD is the robust z-score using the trailing median and median absolute deviation
+	MethodMAD Method = "mad"
+	// MethodSeasonal scores the residual left after removing trend and seasonality
+	MethodSeasonal Method = "seasonal"
+	// MethodChangePoint finds sustained shifts in level between adjacent windows
+	MethodChangePoint Method = "changepoint"
+)
+
+// Methods lists every detector in the order their results take precedence
+var Methods = []Method{MethodSeasonal, MethodMAD, MethodZScore, MethodChangePoint}
+
+const (
+	SeverityHigh   = "high"
+	SeverityMedium = "medium"
+	SeverityLow    = "low"
+
+	DirectionAbove     = "above"
+	DirectionBelow     = "below"
+	DirectionShiftUp   = "shift_up"
+	DirectionShiftDown = "shift_down"
+
+	// Scores are capped so a deviation from a perfectly flat series stays finite
+	maxScore = 100
+	// 1.4826 * MAD estimates the standard deviation of normally distributed data
+	madScale = 1.4826
+)
+
+// Point is a single raw observation of a metric
+type Point struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// Config controls how a series is bucketed and how eager the detectors are
+type Config struct {
+	// Sensitivity runs from 0 (only extreme outliers) to 1 (flag small deviations)
+	Sensitivity float64
+	Methods     []Method
+	// Window is the number of trailing buckets the rolling detectors learn from
+	Window int
+	// Step is the bucket size and Period the seasonal cycle in buckets
+	Step   time.Duration
+	Period int
+}
+
+// Threshold is the score a bucket must reach to be anomalous: 5 at sensitivity
+// 0, 3 at the default 0.5 and 1 at sensitivity 1
+func (c Config) Threshold() float64 {
+	s := math.Min(1, math.Max(0, c.Sensitivity))
+	return 5 - 4*s
+}
+
+// Anomaly is a bucket flagged by one or more detectors
+type Anomaly struct {
+	Timestamp time.Time
+	Value     float64
+	Expected  float64
+	// Score is the largest detector score, in standard deviations
+	Score float64
+	// Probability is the two-sided normal probability of a smaller deviation
+	Probability float64
+	Severity    string
+	Direction   string
+	Methods     []Method
+}
+
+// Result is the outcome of running the detectors over a time range
+type Result struct {
+	Anomalies []Anomaly
+	Threshold float64
+	Buckets   int
+	Config    Config
+}
+
+// CountBySeverity returns the number of anomalies of the given severity
+func (r *Result) CountBySeverity(severity string) int {
+	count := 0
+	for _, a := range r.Anomalies {
+		if a.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// Detect buckets the points over [start, end) by cfg.Step, averaging each
+// bucket, and runs the configured detectors. Buckets without points are
+// skipped rather than interpolated.
+func Detect(points []Point, start, end time.Time, cfg Config) *Result {
+	threshold := cfg.Threshold()
+	result := &Result{Threshold: threshold, Config: cfg}
+	if cfg.Step <= 0 || !end.After(start) {
+		return result
+	}
+
+	start = start.Truncate(cfg.Step)
+	values := bucket(points, start, end, cfg.Step)
+	result.Buckets = len(values)
+
+	found := map[int]*Anomaly{}
+	for _, method := range Methods {
+		if !enabled(cfg.Methods, method) {
+			continue
+		}
+		for _, f := range detect(method, values, cfg) {
+			if f.score < threshold {
+				continue
+			}
+			score := math.Min(f.score, maxScore)
+			// Earlier methods take precedence for the expected value and direction
+			existing, ok := found[f.index]
+			if !ok {
+				found[f.index] = &Anomaly{
+					Timestamp: start.Add(time.Duration(f.index) * cfg.Step),
+					Value:     f.value,
+					Expected:  f.expected,
+					Score:     score,
+					Direction: f.direction,
+					Methods:   []Method{method},
+				}
+				continue
+			}
+			existing.Score = math.Max(existing.Score, score)
+			existing.Methods = append(existing.Methods, method)
+		}
+	}
+
+	for _, a := range found {
+		a.Probability = math.Erf(a.Score / math.Sqrt2)
+		a.Severity = severity(a.Score, threshold)
+		result.Anomalies = append(result.Anomalies, *a)
+	}
+	sort.Slice(result.Anomalies, func(i, j int) bool {
+		return result.Anomalies[i].Timestamp.Before(result.Anomalies[j].Timestamp)
+	})
+	return result
+}
+
+func severity(score, threshold float64) string {
+	switch {
+	case score >= 2*threshold:
+		return SeverityHigh
+	case score >= 1.5*threshold:
+		return SeverityMedium
+	default:
+		return SeverityLow
+	}
+}
+
+func enabled(methods []Method, method Method) bool {
+	if len(methods) == 0 {
+		return true
+	}
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// bucket averages points into fixed buckets; empty buckets are NaN
+func bucket(points []Point, start, end time.Time, step time.Duration) []float64 {
+	n := int((end.Sub(start) + step - 1) / step)
+	sums := make([]float64, n)
+	counts := make([]int, n)
+	for _, p := range points {
+		if p.Timestamp.Before(start) || !p.Timestamp.Before(end) {
+			continue
+		}
+		i := int(p.Timestamp.Sub(start) / step)
+		sums[i] += p.Value
+		counts[i]++
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		if counts[i] == 0 {
+			values[i] = math.NaN()
+			continue
+		}
+		values[i] = sums[i] / float64(counts[i])
+	}
+	return values
+}
@@ -0,0 +1,122 @@
+package anomaly
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hourlyPoints builds an hourly series with a daily cycle and seeded noise
+func hourlyPoints(start time.Time, hours int) []Point {
+	rng := rand.New(rand.NewSource(7))
+	points := make([]Point, hours)
+	for h := range points {
+		v := 100 + 20*math.Sin(2*math.Pi*float64(h%24)/24) + rng.NormFloat64()*2
+		points[h] = Point{Timestamp: start.Add(time.Duration(h) * time.Hour), Value: v}
+	}
+	return points
+}
+
+func hourlyConfig(sensitivity float64, methods ...Method) Config {
+	return Config{Sensitivity: sensitivity, Methods: methods, Window: 24, Step: time.Hour, Period: 24}
+}
+
+func TestConfig_Threshold(t *testing.T) {
+	assert.Equal(t, 5.0, Config{Sensitivity: 0}.Threshold())
+	assert.Equal(t, 3.0, Config{Sensitivity: 0.5}.Threshold())
+	assert.Equal(t, 1.0, Config{Sensitivity: 1}.Threshold())
+	assert.Equal(t, 1.0, Config{Sensitivity: 3}.Threshold())
+}
+
+func TestDetect_FlagsSpikeOnSeasonalSeries(t *testing.T) {
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	points := hourlyPoints(start, 7*24)
+	points[100].Value += 60
+
+	// Noise alone crosses the default threshold about once a week of hours
+	result := Detect(points, start, start.Add(7*24*time.Hour), hourlyConfig(0.25))
+
+	assert.Equal(t, 7*24, result.Buckets)
+	require.Len(t, result.Anomalies, 1)
+	a := result.Anomalies[0]
+	assert.Equal(t, points[100].Timestamp, a.Timestamp)
+	assert.Equal(t, DirectionAbove, a.Direction)
+	assert.Equal(t, SeverityHigh, a.Severity)
+	assert.Equal(t, MethodSeasonal, a.Methods[0])
+	assert.InDelta(t, points[100].Value-60, a.Expected, 6)
+	assert.Greater(t, a.Probability, 0.99)
+}
+
+func TestDetect_SensitivityControlsThreshold(t *testing.T) {
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	points := hourlyPoints(start, 7*24)
+	points[80].Value -= 9
+	end := start.Add(7 * 24 * time.Hour)
+
+	strict := Detect(points, start, end, hourlyConfig(0, MethodSeasonal))
+	assert.Empty(t, strict.Anomalies)
+
+	eager := Detect(points, start, end, hourlyConfig(0.75, MethodSeasonal))
+	var found bool
+	for _, a := range eager.Anomalies {
+		if a.Timestamp.Equal(points[80].Timestamp) {
+			found = true
+			assert.Equal(t, DirectionBelow, a.Direction)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestDetect_ChangePointFindsLevelShift(t *testing.T) {
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	rng := rand.New(rand.NewSource(3))
+	var points []Point
+	for d := 0; d < 60; d++ {
+		v := 50.0
+		if d >= 35 {
+			v = 65
+		}
+		points = append(points, Point{Timestamp: start.AddDate(0, 0, d), Value: v + rng.NormFloat64()*2})
+	}
+
+	cfg := Config{Sensitivity: 0.5, Methods: []Method{MethodChangePoint}, Window: 14, Step: 24 * time.Hour, Period: 7}
+	result := Detect(points, start, start.AddDate(0, 0, 60), cfg)
+
+	require.Len(t, result.Anomalies, 1)
+	a := result.Anomalies[0]
+	assert.Equal(t, start.AddDate(0, 0, 35), a.Timestamp)
+	assert.Equal(t, DirectionShiftUp, a.Direction)
+	assert.InDelta(t, 15, a.Value-a.Expected, 3)
+}
+
+func TestDetect_SkipsEmptyBucketsAndShortSeries(t *testing.T) {
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	points := hourlyPoints(start, 7*24)
+	// Drop a day of data; the gap must not be reported
+	points = append(points[:48], points[72:]...)
+
+	result := Detect(points, start, start.Add(7*24*time.Hour), hourlyConfig(0.25))
+	assert.Empty(t, result.Anomalies)
+
+	short := Detect(points[:2], start, start.Add(2*time.Hour), hourlyConfig(1))
+	assert.Empty(t, short.Anomalies)
+	assert.Equal(t, 2, short.Buckets)
+}
+
+func TestDetect_ZScoreScoresFlatSeries(t *testing.T) {
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	var points []Point
+	for h := 0; h < 30; h++ {
+		points = append(points, Point{Timestamp: start.Add(time.Duration(h) * time.Hour), Value: 10})
+	}
+	points[29].Value = 1000
+
+	result := Detect(points, start, start.Add(30*time.Hour), hourlyConfig(0.5, MethodZScore))
+	require.Len(t, result.Anomalies, 1)
+	assert.Equal(t, float64(maxScore), result.Anomalies[0].Score)
+	assert.Equal(t, 1, result.CountBySeverity(SeverityHigh))
+}
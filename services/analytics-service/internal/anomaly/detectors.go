@@ -0,0 +1,276 @@
+package anomaly
+
+import (
+	"math"
+	"sort"
+)
+
+// finding is a single detector's verdict on one bucket
+type finding struct {
+	index     int
+	value     float64
+	expected  float64
+	score     float64
+	direction string
+}
+
+func detect(method Method, values []float64, cfg Config) []finding {
+	switch method {
+	case MethodZScore:
+		return rolling(values, cfg.Window, false)
+	case MethodMAD:
+		return rolling(values, cfg.Window, true)
+	case MethodSeasonal:
+		return seasonal(values, cfg.Period)
+	case MethodChangePoint:
+		// Each side covers at least one seasonal cycle so the cycle itself is
+		// not mistaken for a shift
+		window := cfg.Window / 2
+		if window < cfg.Period {
+			window = cfg.Period
+		}
+		return changePoints(values, window)
+	}
+	return nil
+}
+
+// rolling scores each bucket against the buckets in the trailing window, using
+// mean and standard deviation or, when robust, median and scaled MAD
+func rolling(values []float64, window int, robust bool) []finding {
+	if window < 3 {
+		return nil
+	}
+
+	var findings []finding
+	for i := window; i < len(values); i++ {
+		x := values[i]
+		if math.IsNaN(x) {
+			continue
+		}
+		// A partial window would not cover the whole cycle of a seasonal metric
+		history := present(values[i-window : i])
+		if 4*len(history) < 3*window {
+			continue
+		}
+
+		center, spread := mean(history), stddev(history)
+		if robust {
+			median, mad := medianAbsoluteDeviation(history)
+			center = median
+			if mad > 0 {
+				spread = madScale * mad
+			}
+		}
+		findings = append(findings, score(i, x, center, spread, DirectionAbove, DirectionBelow))
+	}
+	return findings
+}
+
+// seasonal removes a moving-median trend and the median seasonal profile, then
+// scores each residual against the robust spread of all residuals
+func seasonal(values []float64, period int) []finding {
+	if period < 2 || len(present(values)) < 2*period {
+		return nil
+	}
+
+	residuals, spread := decompose(values, period)
+
+	var findings []finding
+	for i, r := range residuals {
+		if math.IsNaN(r) {
+			continue
+		}
+		findings = append(findings, score(i, values[i], values[i]-r, spread, DirectionAbove, DirectionBelow))
+	}
+	return findings
+}
+
+// decompose returns each bucket's residual after removing trend and season,
+// and the robust spread of those residuals
+func decompose(values []float64, period int) ([]float64, float64) {
+	trend := centredTrend(values, period)
+	detrended := make([]float64, len(values))
+	for i := range values {
+		detrended[i] = values[i] - trend[i]
+	}
+
+	// Each bucket's seasonal component is the median of the same phase in the
+	// other cycles, so an outlier neither sets its own baseline nor pulls the
+	// residuals of its neighbours towards zero
+	residuals := make([]float64, len(values))
+	for i := range values {
+		residuals[i] = math.NaN()
+		if math.IsNaN(detrended[i]) {
+			continue
+		}
+		var phase []float64
+		for j := i % period; j < len(detrended); j += period {
+			if j != i && !math.IsNaN(detrended[j]) {
+				phase = append(phase, detrended[j])
+			}
+		}
+		if len(phase) > 0 {
+			residuals[i] = detrended[i] - median(phase)
+		}
+	}
+
+	remaining := present(residuals)
+	_, mad := medianAbsoluteDeviation(remaining)
+	spread := madScale * mad
+	if spread == 0 {
+		spread = stddev(remaining)
+	}
+	return residuals, spreadFloor(spread, median(present(values)))
+}
+
+// centredTrend is the median of the period of buckets centred on each bucket.
+// A full period holds every phase of the cycle once, so the median does not
+// follow the cycle, and unlike a mean it is not dragged by a spike. Windows
+// with a missing bucket, and buckets within half a period of either end, take
+// the nearest complete value.
+func centredTrend(values []float64, period int) []float64 {
+	half := period / 2
+	trend := make([]float64, len(values))
+	for i := range trend {
+		trend[i] = math.NaN()
+		from := i - half
+		if from < 0 || from+period > len(values) {
+			continue
+		}
+		window := present(values[from : from+period])
+		if len(window) == period {
+			trend[i] = median(window)
+		}
+	}
+
+	// Carry the trend into gaps and out to the edges
+	last := math.NaN()
+	for i := range trend {
+		if math.IsNaN(trend[i]) {
+			trend[i] = last
+		} else {
+			last = trend[i]
+		}
+	}
+	next := math.NaN()
+	for i := len(trend) - 1; i >= 0; i-- {
+		if math.IsNaN(trend[i]) {
+			trend[i] = next
+		} else {
+			next = trend[i]
+		}
+	}
+	return trend
+}
+
+// changePoints compares the mean of the window before each bucket with the
+// window from it onwards, scoring the difference in pooled standard deviations.
+// Only the strongest bucket within a window either side is reported.
+func changePoints(values []float64, window int) []finding {
+	if window < 3 || len(values) < 2*window {
+		return nil
+	}
+
+	candidates := make([]finding, len(values))
+	for i := window; i <= len(values)-window; i++ {
+		before := present(values[i-window : i])
+		after := present(values[i : i+window])
+		// Windows straddling a gap would compare different parts of the cycle
+		if 4*len(before) < 3*window || 4*len(after) < 3*window {
+			continue
+		}
+		pooled := math.Sqrt((variance(before) + variance(after)) / 2)
+		candidates[i] = score(i, mean(after), mean(before), pooled, DirectionShiftUp, DirectionShiftDown)
+	}
+
+	var findings []finding
+	for i := window; i <= len(values)-window; i++ {
+		if candidates[i].score == 0 {
+			continue
+		}
+		strongest := true
+		for j := i - window; j <= i+window && j < len(candidates); j++ {
+			if j != i && (candidates[j].score > candidates[i].score || (j < i && candidates[j].score == candidates[i].score)) {
+				strongest = false
+				break
+			}
+		}
+		if strongest {
+			findings = append(findings, candidates[i])
+		}
+	}
+	return findings
+}
+
+func score(i int, value, expected, spread float64, up, down string) finding {
+	f := finding{
+		index:     i,
+		value:     value,
+		expected:  expected,
+		score:     math.Abs(value-expected) / spreadFloor(spread, expected),
+		direction: up,
+	}
+	if value < expected {
+		f.direction = down
+	}
+	return f
+}
+
+// spreadFloor keeps a flat history from turning any change into an infinite
+// score: the spread is at least 1% of the expected level
+func spreadFloor(spread, level float64) float64 {
+	return math.Max(spread, math.Max(0.01*math.Abs(level), 1e-9))
+}
+
+func present(values []float64) []float64 {
+	out := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func variance(values []float64) float64 {
+	m := mean(values)
+	var sum float64
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return sum / float64(len(values)-1)
+}
+
+func stddev(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	return math.Sqrt(variance(values))
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+func medianAbsoluteDeviation(values []float64) (float64, float64) {
+	m := median(values)
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = math.Abs(v - m)
+	}
+	return m, median(deviations)
+}
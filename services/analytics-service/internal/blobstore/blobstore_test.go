@@ -0,0 +1,102 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := New(Config{Driver: DriverFile, Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "reports/1/report.csv", []byte("a,b\n"), "text/csv"))
+	data, err := store.Get(ctx, "reports/1/report.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "a,b\n", string(data))
+
+	require.NoError(t, store.Put(ctx, "reports/1/report.csv", []byte("c,d\n"), "text/csv"))
+	data, err = store.Get(ctx, "reports/1/report.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "c,d\n", string(data))
+
+	require.NoError(t, store.Delete(ctx, "reports/1/report.csv"))
+	_, err = store.Get(ctx, "reports/1/report.csv")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, "reports/1/report.csv"))
+
+	for _, key := range []string{"", "/etc/passwd", "../x", "a/../../x", "a//b"} {
+		assert.Error(t, store.Put(ctx, key, nil, ""), key)
+	}
+}
+
+func TestSignV4_AWSTestSuite(t *testing.T) {
+	// get-vanilla from the AWS Signature Version 4 test suite
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	require.NoError(t, err)
+	payloadHash := sha256.Sum256(nil)
+
+	signV4(req, payloadHash[:], "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31", req.Header.Get("Authorization"))
+}
+
+func TestS3Store(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		objects = map[string][]byte{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(body)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	store, err := New(Config{Driver: DriverS3, S3: S3Config{Endpoint: server.URL, Bucket: "reports", AccessKey: "key", SecretKey: "secret", Prefix: "analytics/", PathStyle: true}})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "1/report.json", []byte(`{}`), "application/json"))
+	assert.Contains(t, objects, "/reports/analytics/1/report.json")
+
+	data, err := store.Get(ctx, "1/report.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	require.NoError(t, store.Delete(ctx, "1/report.json"))
+	_, err = store.Get(ctx, "1/report.json")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = NewS3Store(S3Config{Bucket: "reports"})
+	assert.Error(t, err)
+}
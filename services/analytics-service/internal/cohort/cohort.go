@@ -0,0 +1,631 @@
+// Package cohort follows club members from the month they joined: how many
+// are still active after each month, how fast each membership type churns,
+// how long memberships last, whether members who use reciprocal visits renew
+// more often, and which members are drifting away.
+package cohort
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// StatusActive is the member status that counts as retained
+const StatusActive = "ACTIVE"
+
+// AllTypes labels the churn row and survival curve of every membership type
+const AllTypes = "ALL"
+
+// Defaults of the report options
+const (
+	DefaultMaxMonths     = 12
+	DefaultRenewalMonths = 12
+	DefaultRiskWindow    = 90 * 24 * time.Hour
+	DefaultRiskLimit     = 50
+)
+
+// Limits of the report options
+const (
+	MaxMonths     = 36
+	MaxRiskWindow = 365 * 24 * time.Hour
+	MaxRiskLimit  = 500
+)
+
+// A member is at risk when their activity in the recent window fell by at
+// least riskDecline from the window before, which had at least
+// riskMinActivity activities
+const (
+	riskDecline     = 0.5
+	riskMinActivity = 2
+)
+
+// usageBands are the bands members are put in by their reciprocal visits
+var usageBands = []struct {
+	label string
+	min   int
+}{
+	{"none", 0},
+	{"1-2", 1},
+	{"3-5", 3},
+	{"6+", 6},
+}
+
+// StatusChange is a status a member was recorded with
+type StatusChange struct {
+	At     time.Time
+	Status string
+}
+
+// Member is a club member and the statuses they were recorded with. A member
+// is taken to be active from joining until their first recorded status, so
+// members whose history starts after they joined are not lost from their
+// cohort.
+type Member struct {
+	MemberID       uint
+	MembershipType string
+	JoinedAt       time.Time
+	RemovedAt      *time.Time
+	// Changes are the member's recorded statuses, oldest first
+	Changes []StatusChange
+}
+
+// Activity is something a member did that shows engagement
+type Activity struct {
+	MemberID uint
+	At       time.Time
+	// Visit is set for attended reciprocal visits, which usage is measured
+	// by; other activities, such as votes, only count towards engagement
+	Visit bool
+}
+
+// Options tune the report; zero values take the defaults
+type Options struct {
+	// MaxMonths is how many months retention and survival are followed for
+	MaxMonths int
+	// RenewalMonths is how long after joining renewal is judged
+	RenewalMonths int
+	// RiskWindow is the length of the recent engagement window and of the
+	// window it is compared with
+	RiskWindow time.Duration
+	// RiskLimit caps the at-risk list
+	RiskLimit int
+}
+
+// Validate checks the options are within their limits
+func (o Options) Validate() error {
+	if o.MaxMonths < 0 || o.MaxMonths > MaxMonths {
+		return fmt.Errorf("max months must be between 1 and %d", MaxMonths)
+	}
+	if o.RenewalMonths < 0 || o.RenewalMonths > MaxMonths {
+		return fmt.Errorf("renewal months must be between 1 and %d", MaxMonths)
+	}
+	if o.RiskWindow < 0 || o.RiskWindow > MaxRiskWindow {
+		return fmt.Errorf("risk window must be at most %d days", int(MaxRiskWindow/(24*time.Hour)))
+	}
+	if o.RiskLimit < 0 || o.RiskLimit > MaxRiskLimit {
+		return fmt.Errorf("risk limit must be between 1 and %d", MaxRiskLimit)
+	}
+	return nil
+}
+
+// WithDefaults fills in the options left unset
+func (o Options) WithDefaults() Options {
+	if o.MaxMonths <= 0 {
+		o.MaxMonths = DefaultMaxMonths
+	}
+	if o.RenewalMonths <= 0 {
+		o.RenewalMonths = DefaultRenewalMonths
+	}
+	if o.RiskWindow <= 0 {
+		o.RiskWindow = DefaultRiskWindow
+	}
+	if o.RiskLimit <= 0 {
+		o.RiskLimit = DefaultRiskLimit
+	}
+	return o
+}
+
+// Report is a club's cohort analysis of members who joined in [Start, End),
+// followed up to End
+type Report struct {
+	ClubID         uint            `json:"club_id"`
+	Start          time.Time       `json:"start"`
+	End            time.Time       `json:"end"`
+	Cohorts        []Cohort        `json:"cohorts"`
+	Churn          []TypeChurn     `json:"churn"`
+	Survival       []SurvivalCurve `json:"survival"`
+	UsageRenewal   UsageRenewal    `json:"usage_renewal"`
+	AtRisk         []AtRiskMember  `json:"at_risk"`
+	RiskWindowDays int             `json:"risk_window_days"`
+}
+
+// Cohort is the members who joined in a calendar month and how many of them
+// were active each month after joining
+type Cohort struct {
+	Month     time.Time       `json:"month"`
+	Members   int             `json:"members"`
+	Retention []RetentionCell `json:"retention"`
+}
+
+// RetentionCell counts the members of a cohort who were active Month months
+// after joining. Only members who had been members that long by the end of
+// the report are eligible, so recent cohorts are not understated.
+type RetentionCell struct {
+	Month    int     `json:"month"`
+	Eligible int     `json:"eligible"`
+	Active   int     `json:"active"`
+	Rate     float64 `json:"rate"`
+}
+
+// TypeChurn is how many members of a membership type stopped being active in
+// the period. Exposed counts the members active at some point in it, Churned
+// those of them who left it, and Active those still active at its end.
+type TypeChurn struct {
+	MembershipType string  `json:"membership_type"`
+	Active         int     `json:"active"`
+	Exposed        int     `json:"exposed"`
+	Churned        int     `json:"churned"`
+	Rate           float64 `json:"rate"`
+}
+
+// SurvivalCurve is the Kaplan-Meier estimate of how long members of a cohort
+// stay active. Members still active at the end of the report are censored.
+type SurvivalCurve struct {
+	MembershipType string `json:"membership_type"`
+	Members        int    `json:"members"`
+	// MedianMonths is the first month by whose end half the members had
+	// churned, unset while more than half are still active
+	MedianMonths *int            `json:"median_months,omitempty"`
+	Points       []SurvivalPoint `json:"points"`
+}
+
+// SurvivalPoint covers a month of membership: AtRisk members were active at
+// its start, Churned of them left in it, and Survival is the estimated share
+// of members still active at its end
+type SurvivalPoint struct {
+	Month    int     `json:"month"`
+	AtRisk   int     `json:"at_risk"`
+	Churned  int     `json:"churned"`
+	Survival float64 `json:"survival"`
+}
+
+// UsageRenewal relates the reciprocal visits members made in their first
+// Months of membership to whether they were still active at its end. Only
+// members who joined at least Months before the end of the report count.
+type UsageRenewal struct {
+	Months  int         `json:"months"`
+	Members int         `json:"members"`
+	Bands   []UsageBand `json:"bands"`
+	// Correlation is the point-biserial correlation between visits and
+	// renewal, unset when either does not vary
+	Correlation *float64 `json:"correlation,omitempty"`
+}
+
+// UsageBand is the renewal of members whose visits fall in a band
+type UsageBand struct {
+	Band        string  `json:"band"`
+	Members     int     `json:"members"`
+	Renewed     int     `json:"renewed"`
+	RenewalRate float64 `json:"renewal_rate"`
+}
+
+// AtRiskMember is an active member whose engagement is declining
+type AtRiskMember struct {
+	MemberID       uint    `json:"member_id"`
+	MembershipType string  `json:"membership_type"`
+	TenureMonths   int     `json:"tenure_months"`
+	Previous       int     `json:"previous"`
+	Recent         int     `json:"recent"`
+	Decline        float64 `json:"decline"`
+	// LastActivityAt is unset for members with no activity in either window
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
+}
+
+// period is a stretch of time a member was active; a zero end is open
+type period struct {
+	start, end time.Time
+}
+
+// timeline is a member with the periods they were active
+type timeline struct {
+	*Member
+	periods []period
+}
+
+func newTimeline(m *Member) timeline {
+	active := true
+	changes := m.Changes
+	for len(changes) > 0 && !changes[0].At.After(m.JoinedAt) {
+		active = isActive(changes[0].Status)
+		changes = changes[1:]
+	}
+
+	var periods []period
+	start := m.JoinedAt
+	for _, change := range changes {
+		now := isActive(change.Status)
+		if now == active {
+			continue
+		}
+		if active {
+			periods = append(periods, period{start: start, end: change.At})
+		} else {
+			start = change.At
+		}
+		active = now
+	}
+	if active {
+		periods = append(periods, period{start: start})
+	}
+
+	if m.RemovedAt != nil {
+		removed := *m.RemovedAt
+		kept := periods[:0]
+		for _, p := range periods {
+			if !p.start.Before(removed) {
+				continue
+			}
+			if p.end.IsZero() || p.end.After(removed) {
+				p.end = removed
+			}
+			kept = append(kept, p)
+		}
+		periods = kept
+	}
+
+	return timeline{Member: m, periods: periods}
+}
+
+func isActive(status string) bool {
+	return strings.EqualFold(status, StatusActive)
+}
+
+// activeAt reports whether the member was active at t
+func (t timeline) activeAt(at time.Time) bool {
+	for _, p := range t.periods {
+		if !at.Before(p.start) && (p.end.IsZero() || at.Before(p.end)) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstExit is when the member first stopped being active, zero if they have
+// not
+func (t timeline) firstExit() time.Time {
+	if len(t.periods) == 0 {
+		return time.Time{}
+	}
+	return t.periods[0].end
+}
+
+// Build computes the cohort report of a club's members. Activities are read
+// from the two risk windows before end and from the renewal months of the
+// members who joined in [start, end).
+func Build(members []Member, activities []Activity, clubID uint, start, end time.Time, options Options) *Report {
+	options = options.WithDefaults()
+	report := &Report{
+		ClubID:         clubID,
+		Start:          start,
+		End:            end,
+		Cohorts:        []Cohort{},
+		Churn:          []TypeChurn{},
+		Survival:       []SurvivalCurve{},
+		AtRisk:         []AtRiskMember{},
+		RiskWindowDays: int(options.RiskWindow / (24 * time.Hour)),
+	}
+
+	timelines := make([]timeline, len(members))
+	var joined []timeline
+	for i := range members {
+		timelines[i] = newTimeline(&members[i])
+		if !members[i].JoinedAt.Before(start) && members[i].JoinedAt.Before(end) {
+			joined = append(joined, timelines[i])
+		}
+	}
+
+	byMember := make(map[uint][]Activity)
+	for _, activity := range activities {
+		byMember[activity.MemberID] = append(byMember[activity.MemberID], activity)
+	}
+
+	report.Cohorts = cohorts(joined, start, end, options.MaxMonths)
+	report.Churn = churn(timelines, start, end)
+	report.Survival = survival(joined, end, options.MaxMonths)
+	report.UsageRenewal = usageRenewal(joined, byMember, end, options.RenewalMonths)
+	report.AtRisk = atRisk(timelines, byMember, end, options.RiskWindow, options.RiskLimit)
+	return report
+}
+
+func cohorts(joined []timeline, start, end time.Time, maxMonths int) []Cohort {
+	cohorts := []Cohort{}
+	index := make(map[time.Time]int)
+	for month := monthStart(start); month.Before(end); month = month.AddDate(0, 1, 0) {
+		index[month] = len(cohorts)
+		cohorts = append(cohorts, Cohort{Month: month, Retention: []RetentionCell{}})
+	}
+
+	for _, member := range joined {
+		cohort := &cohorts[index[monthStart(member.JoinedAt)]]
+		cohort.Members++
+		for n := 0; n <= maxMonths; n++ {
+			at := member.JoinedAt.AddDate(0, n, 0)
+			if at.After(end) {
+				break
+			}
+			if n == len(cohort.Retention) {
+				cohort.Retention = append(cohort.Retention, RetentionCell{Month: n})
+			}
+			cell := &cohort.Retention[n]
+			cell.Eligible++
+			if member.activeAt(at) {
+				cell.Active++
+			}
+		}
+	}
+
+	for i := range cohorts {
+		for j := range cohorts[i].Retention {
+			cell := &cohorts[i].Retention[j]
+			cell.Rate = rate(cell.Active, cell.Eligible)
+		}
+	}
+	return cohorts
+}
+
+func churn(timelines []timeline, start, end time.Time) []TypeChurn {
+	byType := map[string]*TypeChurn{AllTypes: {MembershipType: AllTypes}}
+	for _, member := range timelines {
+		exposed, churned := false, false
+		for _, p := range member.periods {
+			if !p.start.Before(end) || (!p.end.IsZero() && !p.end.After(start)) {
+				continue
+			}
+			exposed = true
+			if !p.end.IsZero() && p.end.Before(end) {
+				churned = true
+			}
+		}
+		if !exposed {
+			continue
+		}
+
+		row, ok := byType[member.MembershipType]
+		if !ok {
+			row = &TypeChurn{MembershipType: member.MembershipType}
+			byType[member.MembershipType] = row
+		}
+		for _, row := range []*TypeChurn{byType[AllTypes], row} {
+			row.Exposed++
+			if churned {
+				row.Churned++
+			}
+			if member.activeAt(end) {
+				row.Active++
+			}
+		}
+	}
+
+	rows := make([]TypeChurn, 0, len(byType))
+	for _, row := range byType {
+		row.Rate = rate(row.Churned, row.Exposed)
+		rows = append(rows, *row)
+	}
+	sortByType(rows, func(i int) string { return rows[i].MembershipType })
+	return rows
+}
+
+// survival estimates the curves of members who were ever active, overall and
+// by membership type
+func survival(joined []timeline, end time.Time, maxMonths int) []SurvivalCurve {
+	type duration struct {
+		months  int
+		churned bool
+	}
+	byType := map[string][]duration{AllTypes: nil}
+	for _, member := range joined {
+		if len(member.periods) == 0 {
+			continue
+		}
+		// A member who churned in their kth month is at risk through it; one
+		// still active is at risk through the months they completed
+		d := duration{months: monthsBetween(member.JoinedAt, end)}
+		if exit := member.firstExit(); !exit.IsZero() && !exit.After(end) {
+			d = duration{months: monthsBetween(member.JoinedAt, exit) + 1, churned: true}
+		}
+		byType[AllTypes] = append(byType[AllTypes], d)
+		byType[member.MembershipType] = append(byType[member.MembershipType], d)
+	}
+
+	curves := make([]SurvivalCurve, 0, len(byType))
+	for membershipType, durations := range byType {
+		curve := SurvivalCurve{MembershipType: membershipType, Members: len(durations), Points: []SurvivalPoint{}}
+		survival := 1.0
+		for month := 1; month <= maxMonths; month++ {
+			point := SurvivalPoint{Month: month}
+			for _, d := range durations {
+				if d.months >= month {
+					point.AtRisk++
+					if d.churned && d.months == month {
+						point.Churned++
+					}
+				}
+			}
+			if point.AtRisk == 0 {
+				break
+			}
+			survival *= 1 - float64(point.Churned)/float64(point.AtRisk)
+			point.Survival = survival
+			curve.Points = append(curve.Points, point)
+			if curve.MedianMonths == nil && survival <= 0.5 {
+				median := month
+				curve.MedianMonths = &median
+			}
+		}
+		curves = append(curves, curve)
+	}
+	sortByType(curves, func(i int) string { return curves[i].MembershipType })
+	return curves
+}
+
+func usageRenewal(joined []timeline, activities map[uint][]Activity, end time.Time, months int) UsageRenewal {
+	usage := UsageRenewal{Months: months, Bands: make([]UsageBand, len(usageBands))}
+	for i, band := range usageBands {
+		usage.Bands[i].Band = band.label
+	}
+
+	var visits, renewed []float64
+	for _, member := range joined {
+		horizon := member.JoinedAt.AddDate(0, months, 0)
+		if horizon.After(end) {
+			continue
+		}
+
+		count := 0
+		for _, activity := range activities[member.MemberID] {
+			if activity.Visit && !activity.At.Before(member.JoinedAt) && activity.At.Before(horizon) {
+				count++
+			}
+		}
+		band := &usage.Bands[0]
+		for i := range usageBands {
+			if count >= usageBands[i].min {
+				band = &usage.Bands[i]
+			}
+		}
+
+		usage.Members++
+		band.Members++
+		outcome := 0.0
+		if member.activeAt(horizon) {
+			band.Renewed++
+			outcome = 1
+		}
+		visits = append(visits, float64(count))
+		renewed = append(renewed, outcome)
+	}
+
+	for i := range usage.Bands {
+		usage.Bands[i].RenewalRate = rate(usage.Bands[i].Renewed, usage.Bands[i].Members)
+	}
+	usage.Correlation = correlation(visits, renewed)
+	return usage
+}
+
+func atRisk(timelines []timeline, activities map[uint][]Activity, end time.Time, window time.Duration, limit int) []AtRiskMember {
+	recentStart := end.Add(-window)
+	previousStart := recentStart.Add(-window)
+
+	members := []AtRiskMember{}
+	for _, member := range timelines {
+		if !member.activeAt(end) {
+			continue
+		}
+
+		candidate := AtRiskMember{
+			MemberID:       member.MemberID,
+			MembershipType: member.MembershipType,
+			TenureMonths:   monthsBetween(member.JoinedAt, end),
+		}
+		for _, activity := range activities[member.MemberID] {
+			switch {
+			case activity.At.Before(previousStart) || !activity.At.Before(end):
+				continue
+			case activity.At.Before(recentStart):
+				candidate.Previous++
+			default:
+				candidate.Recent++
+			}
+			if candidate.LastActivityAt == nil || activity.At.After(*candidate.LastActivityAt) {
+				at := activity.At
+				candidate.LastActivityAt = &at
+			}
+		}
+
+		if candidate.Previous < riskMinActivity {
+			continue
+		}
+		candidate.Decline = float64(candidate.Previous-candidate.Recent) / float64(candidate.Previous)
+		if candidate.Decline >= riskDecline {
+			members = append(members, candidate)
+		}
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+		if a.Decline != b.Decline {
+			return a.Decline > b.Decline
+		}
+		if a.Previous != b.Previous {
+			return a.Previous > b.Previous
+		}
+		return a.MemberID < b.MemberID
+	})
+	if len(members) > limit {
+		members = members[:limit]
+	}
+	return members
+}
+
+// correlation is the Pearson correlation of two series, nil when either has
+// no variance
+func correlation(x, y []float64) *float64 {
+	n := float64(len(x))
+	if len(x) < 2 {
+		return nil
+	}
+
+	var sumX, sumY float64
+	for i := range x {
+		sumX += x[i]
+		sumY += y[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX, varY float64
+	for i := range x {
+		dx, dy := x[i]-meanX, y[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return nil
+	}
+	r := cov / math.Sqrt(varX*varY)
+	return &r
+}
+
+// sortByType puts the row of every type first and the rest by type
+func sortByType[T any](rows []T, membershipType func(i int) string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := membershipType(i), membershipType(j)
+		if a == AllTypes || b == AllTypes {
+			return a == AllTypes && b != AllTypes
+		}
+		return a < b
+	})
+}
+
+func rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
+
+// monthsBetween counts the whole months from a to b
+func monthsBetween(a, b time.Time) int {
+	months := (b.Year()-a.Year())*12 + int(b.Month()) - int(a.Month())
+	if months > 0 && a.AddDate(0, months, 0).After(b) {
+		months--
+	}
+	return max(months, 0)
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,220 @@
+package cohort
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	start = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end   = start.AddDate(1, 0, 0)
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+// sampleMembers covers churn by suspension, by removal and by expiry, a member
+// who was pending before joining properly, and two members still active
+func sampleMembers() []Member {
+	return []Member{
+		{MemberID: 1, MembershipType: "REGULAR", JoinedAt: day(2025, 1, 10), Changes: []StatusChange{
+			{At: day(2025, 1, 10), Status: "ACTIVE"},
+			{At: day(2025, 4, 20), Status: "SUSPENDED"},
+		}},
+		{MemberID: 2, MembershipType: "VIP", JoinedAt: day(2025, 1, 15), Changes: []StatusChange{
+			{At: day(2025, 1, 15), Status: "ACTIVE"},
+		}},
+		{MemberID: 3, MembershipType: "REGULAR", JoinedAt: day(2025, 2, 5), RemovedAt: ptr(day(2025, 8, 1)), Changes: []StatusChange{
+			{At: day(2025, 2, 5), Status: "PENDING"},
+			{At: day(2025, 2, 20), Status: "ACTIVE"},
+		}},
+		// Joined before the period; the history starts with the expiry
+		{MemberID: 4, MembershipType: "REGULAR", JoinedAt: day(2024, 6, 1), Changes: []StatusChange{
+			{At: day(2025, 7, 1), Status: "EXPIRED"},
+		}},
+		{MemberID: 5, MembershipType: "VIP", JoinedAt: day(2025, 12, 10), Changes: []StatusChange{
+			{At: day(2025, 12, 10), Status: "active"},
+		}},
+	}
+}
+
+func TestBuild_RetentionMatrix(t *testing.T) {
+	report := Build(sampleMembers(), nil, 1, start, end, Options{})
+
+	require.Len(t, report.Cohorts, 12)
+
+	january := report.Cohorts[0]
+	assert.Equal(t, start, january.Month)
+	assert.Equal(t, 2, january.Members)
+	require.Len(t, january.Retention, 12)
+	assert.Equal(t, RetentionCell{Month: 0, Eligible: 2, Active: 2, Rate: 1}, january.Retention[0])
+	assert.Equal(t, RetentionCell{Month: 3, Eligible: 2, Active: 2, Rate: 1}, january.Retention[3])
+	assert.Equal(t, RetentionCell{Month: 4, Eligible: 2, Active: 1, Rate: 0.5}, january.Retention[4])
+
+	// Pending at joining, active a month later, gone once removed
+	february := report.Cohorts[1]
+	assert.Equal(t, 1, february.Members)
+	require.Len(t, february.Retention, 11)
+	assert.Equal(t, 0, february.Retention[0].Active)
+	assert.Equal(t, 1, february.Retention[1].Active)
+	assert.Equal(t, 0, february.Retention[6].Active)
+
+	assert.Equal(t, 0, report.Cohorts[5].Members)
+	assert.Empty(t, report.Cohorts[5].Retention)
+
+	// The latest cohort has only been followed for its first month
+	december := report.Cohorts[11]
+	assert.Equal(t, []RetentionCell{{Month: 0, Eligible: 1, Active: 1, Rate: 1}}, december.Retention)
+
+	// MaxMonths caps how far cohorts are followed
+	report = Build(sampleMembers(), nil, 1, start, end, Options{MaxMonths: 3})
+	assert.Len(t, report.Cohorts[0].Retention, 4)
+}
+
+func TestBuild_ChurnByType(t *testing.T) {
+	report := Build(sampleMembers(), nil, 1, start, end, Options{})
+
+	assert.Equal(t, []TypeChurn{
+		{MembershipType: AllTypes, Active: 2, Exposed: 5, Churned: 3, Rate: 0.6},
+		{MembershipType: "REGULAR", Active: 0, Exposed: 3, Churned: 3, Rate: 1},
+		{MembershipType: "VIP", Active: 2, Exposed: 2, Churned: 0, Rate: 0},
+	}, report.Churn)
+
+	// Members who left before the period are not exposed to it
+	report = Build(sampleMembers(), nil, 1, day(2025, 9, 1), end, Options{})
+	assert.Equal(t, TypeChurn{MembershipType: AllTypes, Active: 2, Exposed: 2}, report.Churn[0])
+}
+
+func TestBuild_SurvivalCurves(t *testing.T) {
+	report := Build(sampleMembers(), nil, 1, start, end, Options{})
+
+	require.Len(t, report.Survival, 3)
+	all := report.Survival[0]
+	assert.Equal(t, AllTypes, all.MembershipType)
+	assert.Equal(t, 4, all.Members)
+	require.Len(t, all.Points, 11)
+	assert.Equal(t, SurvivalPoint{Month: 1, AtRisk: 3, Survival: 1}, all.Points[0])
+	assert.Equal(t, 3, all.Points[3].AtRisk)
+	assert.Equal(t, 1, all.Points[3].Churned)
+	assert.InDelta(t, 2.0/3, all.Points[3].Survival, 1e-9)
+	assert.Equal(t, 2, all.Points[5].AtRisk)
+	assert.InDelta(t, 1.0/3, all.Points[5].Survival, 1e-9)
+	require.NotNil(t, all.MedianMonths)
+	assert.Equal(t, 6, *all.MedianMonths)
+
+	regular := report.Survival[1]
+	assert.Equal(t, "REGULAR", regular.MembershipType)
+	require.Len(t, regular.Points, 6)
+	assert.Equal(t, SurvivalPoint{Month: 6, AtRisk: 1, Churned: 1, Survival: 0}, regular.Points[5])
+	require.NotNil(t, regular.MedianMonths)
+	assert.Equal(t, 4, *regular.MedianMonths)
+
+	vip := report.Survival[2]
+	assert.Nil(t, vip.MedianMonths)
+	assert.Equal(t, 1.0, vip.Points[len(vip.Points)-1].Survival)
+}
+
+func TestBuild_UsageRenewal(t *testing.T) {
+	activities := []Activity{
+		{MemberID: 1, At: day(2025, 2, 1), Visit: true},
+		{MemberID: 2, At: day(2025, 2, 1), Visit: true},
+		{MemberID: 2, At: day(2025, 3, 1), Visit: true},
+		{MemberID: 2, At: day(2025, 4, 1), Visit: true},
+		{MemberID: 2, At: day(2025, 6, 1), Visit: true},
+		// Votes and visits after the renewal horizon are not usage
+		{MemberID: 3, At: day(2025, 3, 1)},
+		{MemberID: 3, At: day(2025, 9, 1), Visit: true},
+	}
+	report := Build(sampleMembers(), activities, 1, start, end, Options{RenewalMonths: 6})
+
+	usage := report.UsageRenewal
+	assert.Equal(t, 6, usage.Months)
+	assert.Equal(t, 3, usage.Members)
+	assert.Equal(t, []UsageBand{
+		{Band: "none", Members: 1},
+		{Band: "1-2", Members: 1},
+		{Band: "3-5", Members: 1, Renewed: 1, RenewalRate: 1},
+		{Band: "6+"},
+	}, usage.Bands)
+	require.NotNil(t, usage.Correlation)
+	assert.InDelta(t, 21/math.Sqrt(468), *usage.Correlation, 1e-9)
+
+	// Nobody has been a member for the default twelve months yet
+	report = Build(sampleMembers(), activities, 1, start, end, Options{})
+	assert.Zero(t, report.UsageRenewal.Members)
+	assert.Nil(t, report.UsageRenewal.Correlation)
+}
+
+func TestBuild_AtRisk(t *testing.T) {
+	joined := day(2023, 3, 1)
+	members := []Member{
+		{MemberID: 10, MembershipType: "REGULAR", JoinedAt: joined},
+		{MemberID: 11, MembershipType: "VIP", JoinedAt: joined},
+		{MemberID: 12, MembershipType: "REGULAR", JoinedAt: joined},
+		{MemberID: 13, MembershipType: "REGULAR", JoinedAt: joined},
+		{MemberID: 14, MembershipType: "REGULAR", JoinedAt: joined, Changes: []StatusChange{{At: day(2025, 12, 1), Status: "SUSPENDED"}}},
+	}
+	previous, recent := day(2025, 8, 15), day(2025, 11, 15)
+	var activities []Activity
+	add := func(memberID uint, at time.Time, count int) {
+		for i := 0; i < count; i++ {
+			activities = append(activities, Activity{MemberID: memberID, At: at.AddDate(0, 0, i)})
+		}
+	}
+	// Declined by two thirds and by all, steady, too little to judge, and
+	// no longer active
+	add(10, previous, 3)
+	add(10, recent, 1)
+	add(11, previous, 4)
+	add(12, previous, 2)
+	add(12, recent, 2)
+	add(13, previous, 1)
+	add(14, previous, 5)
+
+	report := Build(members, activities, 1, start, end, Options{})
+
+	assert.Equal(t, 90, report.RiskWindowDays)
+	require.Len(t, report.AtRisk, 2)
+	assert.Equal(t, AtRiskMember{
+		MemberID:       11,
+		MembershipType: "VIP",
+		TenureMonths:   34,
+		Previous:       4,
+		Decline:        1,
+		LastActivityAt: ptr(previous.AddDate(0, 0, 3)),
+	}, report.AtRisk[0])
+	assert.Equal(t, uint(10), report.AtRisk[1].MemberID)
+	assert.InDelta(t, 2.0/3, report.AtRisk[1].Decline, 1e-9)
+	assert.Equal(t, ptr(recent), report.AtRisk[1].LastActivityAt)
+
+	report = Build(members, activities, 1, start, end, Options{RiskLimit: 1})
+	assert.Len(t, report.AtRisk, 1)
+}
+
+func TestMonthsBetween(t *testing.T) {
+	assert.Equal(t, 0, monthsBetween(day(2025, 1, 31), day(2025, 2, 28)))
+	assert.Equal(t, 1, monthsBetween(day(2025, 1, 15), day(2025, 2, 15)))
+	assert.Equal(t, 11, monthsBetween(day(2025, 1, 15), day(2026, 1, 1)))
+	assert.Equal(t, 0, monthsBetween(day(2025, 3, 1), day(2025, 1, 1)))
+}
+
+func TestOptions_Validate(t *testing.T) {
+	assert.NoError(t, Options{}.Validate())
+	assert.NoError(t, Options{MaxMonths: MaxMonths, RiskWindow: MaxRiskWindow, RiskLimit: MaxRiskLimit}.Validate())
+	assert.Error(t, Options{MaxMonths: MaxMonths + 1}.Validate())
+	assert.Error(t, Options{RenewalMonths: -1}.Validate())
+	assert.Error(t, Options{RiskWindow: MaxRiskWindow + time.Hour}.Validate())
+	assert.Error(t, Options{RiskLimit: MaxRiskLimit + 1}.Validate())
+
+	options := Options{MaxMonths: 6}.WithDefaults()
+	assert.Equal(t, Options{MaxMonths: 6, RenewalMonths: DefaultRenewalMonths, RiskWindow: DefaultRiskWindow, RiskLimit: DefaultRiskLimit}, options)
+}
@@ -0,0 +1,564 @@
+// Package dashboard defines the typed schema of analytics dashboards. It
+// validates panel definitions, turns the buckets a panel's query returns into
+// ready-to-render series, signs embed tokens and exports dashboards as Grafana
+// JSON.
+package dashboard
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/rollup"
+)
+
+// Visualization is how a panel is drawn
+type Visualization string
+
+const (
+	VisualizationTimeSeries Visualization = "timeseries"
+	VisualizationBar        Visualization = "bar"
+	VisualizationStat       Visualization = "stat"
+	VisualizationGauge      Visualization = "gauge"
+	VisualizationTable      Visualization = "table"
+	VisualizationPie        Visualization = "pie"
+)
+
+// Visualizations lists the visualizations panels can use
+var Visualizations = []Visualization{
+	VisualizationTimeSeries, VisualizationBar, VisualizationStat,
+	VisualizationGauge, VisualizationTable, VisualizationPie,
+}
+
+// Reduced reports whether the visualization shows one value per series rather
+// than its points over time
+func (v Visualization) Reduced() bool {
+	switch v {
+	case VisualizationStat, VisualizationGauge, VisualizationTable, VisualizationPie:
+		return true
+	}
+	return false
+}
+
+// Query sources: metric values, and events counted by type
+const (
+	SourceMetric = "metric"
+	SourceEvent  = "event"
+)
+
+// Aggregations select the value of each bucket
+const (
+	AggregationCount = "count"
+	AggregationSum   = "sum"
+	AggregationAvg   = "avg"
+	AggregationMin   = "min"
+	AggregationMax   = "max"
+	AggregationP50   = "p50"
+	AggregationP95   = "p95"
+)
+
+var aggregations = []string{AggregationCount, AggregationSum, AggregationAvg, AggregationMin, AggregationMax, AggregationP50, AggregationP95}
+
+// Reducers combine the buckets of a series into one value
+const (
+	ReduceLast  = "last"
+	ReduceFirst = "first"
+	ReduceSum   = "sum"
+	ReduceMean  = "mean"
+	ReduceMin   = "min"
+	ReduceMax   = "max"
+)
+
+var reducers = []string{ReduceLast, ReduceFirst, ReduceSum, ReduceMean, ReduceMin, ReduceMax}
+
+const (
+	// GridColumns is the width of the layout grid
+	GridColumns = 24
+
+	maxPanels     = 50
+	maxGroupBy    = 5
+	maxThresholds = 10
+	// Panels without an interval get one giving at most this many points
+	maxAutoPoints = 300
+)
+
+// autoIntervals are tried in order when a panel has no interval
+var autoIntervals = []string{"1m", "5m", "15m", "30m", "1h", "3h", "6h", "12h", "1d", "1w", "1mo", "3mo", "1y"}
+
+// Query selects what a panel shows from the metric rollups
+type Query struct {
+	// Source is metric (the default) or event
+	Source string `json:"source"`
+	// Name is the metric name or event type
+	Name string `json:"name"`
+	// Aggregation picks each bucket's value. It defaults to count for events
+	// and avg for metrics.
+	Aggregation string `json:"aggregation"`
+	// Interval is the bucket size, such as 5m, 1h or 1d. Without one it is
+	// chosen from the time range.
+	Interval string            `json:"interval,omitempty"`
+	GroupBy  []string          `json:"group_by,omitempty"`
+	Filters  map[string]string `json:"filters,omitempty"`
+	// Reduce combines a series into the value shown by stat, gauge, table and
+	// pie panels. It defaults to sum for counts and sums and last otherwise.
+	Reduce string `json:"reduce,omitempty"`
+}
+
+// Validate checks the query and fills in its defaults
+func (q *Query) Validate() error {
+	if q.Name == "" {
+		return fmt.Errorf("query name is required")
+	}
+	if q.Source == "" {
+		q.Source = SourceMetric
+	}
+	if q.Source != SourceMetric && q.Source != SourceEvent {
+		return fmt.Errorf("query source must be %s or %s", SourceMetric, SourceEvent)
+	}
+
+	if q.Aggregation == "" {
+		q.Aggregation = AggregationAvg
+		if q.Source == SourceEvent {
+			q.Aggregation = AggregationCount
+		}
+	}
+	if !contains(aggregations, q.Aggregation) {
+		return fmt.Errorf("unsupported aggregation: %s", q.Aggregation)
+	}
+
+	if q.Reduce == "" {
+		q.Reduce = ReduceLast
+		if q.Aggregation == AggregationCount || q.Aggregation == AggregationSum {
+			q.Reduce = ReduceSum
+		}
+	}
+	if !contains(reducers, q.Reduce) {
+		return fmt.Errorf("unsupported reducer: %s", q.Reduce)
+	}
+
+	if q.Interval != "" {
+		if _, err := rollup.ParseBucket(q.Interval); err != nil {
+			return err
+		}
+	}
+	if len(q.GroupBy) > maxGroupBy {
+		return fmt.Errorf("queries may group by at most %d tags", maxGroupBy)
+	}
+	for _, tag := range q.GroupBy {
+		if tag == "" {
+			return fmt.Errorf("group_by tags must not be empty")
+		}
+	}
+	for tag := range q.Filters {
+		if tag == "" {
+			return fmt.Errorf("filter tags must not be empty")
+		}
+	}
+	return nil
+}
+
+// TimeRange is a range of absolute (RFC 3339) or relative times. Relative times
+// are "now" or "now-" followed by a number and a unit of m, h, d, w, M (months)
+// or y, as in Grafana.
+type TimeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// DefaultTimeRange covers the last day
+var DefaultTimeRange = TimeRange{From: "now-24h", To: "now"}
+
+// IsZero reports whether neither end of the range is set
+func (r TimeRange) IsZero() bool {
+	return r.From == "" && r.To == ""
+}
+
+// Resolve returns the range's start and end relative to now. A missing end
+// falls back to the default range.
+func (r TimeRange) Resolve(now time.Time) (time.Time, time.Time, error) {
+	from, to := r.From, r.To
+	if from == "" {
+		from = DefaultTimeRange.From
+	}
+	if to == "" {
+		to = DefaultTimeRange.To
+	}
+
+	start, err := parseTime(from, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := parseTime(to, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("time range must end after it starts")
+	}
+	return start, end, nil
+}
+
+func parseTime(text string, now time.Time) (time.Time, error) {
+	text = strings.TrimSpace(text)
+	if text == "now" {
+		return now, nil
+	}
+	if !strings.HasPrefix(text, "now-") {
+		t, err := time.Parse(time.RFC3339, text)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q", text)
+		}
+		return t, nil
+	}
+
+	offset := text[len("now-"):]
+	if len(offset) < 2 {
+		return time.Time{}, fmt.Errorf("invalid time %q", text)
+	}
+	n, err := strconv.Atoi(offset[:len(offset)-1])
+	if err != nil || n <= 0 {
+		return time.Time{}, fmt.Errorf("invalid time %q", text)
+	}
+	switch offset[len(offset)-1] {
+	case 'm':
+		return now.Add(-time.Duration(n) * time.Minute), nil
+	case 'h':
+		return now.Add(-time.Duration(n) * time.Hour), nil
+	case 'd':
+		return now.AddDate(0, 0, -n), nil
+	case 'w':
+		return now.AddDate(0, 0, -7*n), nil
+	case 'M':
+		return now.AddDate(0, -n, 0), nil
+	case 'y':
+		return now.AddDate(-n, 0, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", text)
+}
+
+// Threshold colors values at or above Value
+type Threshold struct {
+	Value float64 `json:"value"`
+	Color string  `json:"color"`
+}
+
+// GridPos places a panel on a grid GridColumns wide
+type GridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// Panel is one visualization of a query on a dashboard
+type Panel struct {
+	ID            string        `json:"id"`
+	Title         string        `json:"title"`
+	Description   string        `json:"description,omitempty"`
+	Visualization Visualization `json:"visualization"`
+	Query         Query         `json:"query"`
+	// TimeRange overrides the dashboard's time range for this panel
+	TimeRange  *TimeRange  `json:"time_range,omitempty"`
+	Thresholds []Threshold `json:"thresholds,omitempty"`
+	Unit       string      `json:"unit,omitempty"`
+	GridPos    GridPos     `json:"grid_pos"`
+}
+
+// Validate checks the panel, fills in its defaults and sorts its thresholds
+func (p *Panel) Validate() error {
+	if p.Title == "" {
+		return fmt.Errorf("panel title is required")
+	}
+	if p.Visualization == "" {
+		p.Visualization = VisualizationTimeSeries
+	}
+	valid := false
+	for _, visualization := range Visualizations {
+		valid = valid || p.Visualization == visualization
+	}
+	if !valid {
+		return fmt.Errorf("unsupported visualization: %s", p.Visualization)
+	}
+	if err := p.Query.Validate(); err != nil {
+		return fmt.Errorf("panel %q: %w", p.Title, err)
+	}
+	if p.TimeRange != nil {
+		if _, _, err := p.TimeRange.Resolve(time.Now()); err != nil {
+			return fmt.Errorf("panel %q: %w", p.Title, err)
+		}
+	}
+
+	if len(p.Thresholds) > maxThresholds {
+		return fmt.Errorf("panels may have at most %d thresholds", maxThresholds)
+	}
+	for _, threshold := range p.Thresholds {
+		if threshold.Color == "" {
+			return fmt.Errorf("threshold colors are required")
+		}
+	}
+	sort.SliceStable(p.Thresholds, func(i, j int) bool { return p.Thresholds[i].Value < p.Thresholds[j].Value })
+
+	grid := &p.GridPos
+	if grid.W == 0 {
+		grid.W = GridColumns / 2
+	}
+	if grid.H == 0 {
+		grid.H = 8
+	}
+	if grid.X < 0 || grid.Y < 0 || grid.W < 0 || grid.H < 0 || grid.X+grid.W > GridColumns {
+		return fmt.Errorf("panel %q does not fit the %d column grid", p.Title, GridColumns)
+	}
+	return nil
+}
+
+// ThresholdColor returns the color of the highest threshold at or below value,
+// or "" when there is none
+func (p *Panel) ThresholdColor(value float64) string {
+	color := ""
+	for _, threshold := range p.Thresholds {
+		if value >= threshold.Value {
+			color = threshold.Color
+		}
+	}
+	return color
+}
+
+// ValidatePanels validates each panel and gives panels without an ID one
+func ValidatePanels(panels []Panel) error {
+	if len(panels) > maxPanels {
+		return fmt.Errorf("dashboards may have at most %d panels", maxPanels)
+	}
+
+	seen := make(map[string]bool, len(panels))
+	for i := range panels {
+		if err := panels[i].Validate(); err != nil {
+			return err
+		}
+		if panels[i].ID == "" {
+			continue
+		}
+		if seen[panels[i].ID] {
+			return fmt.Errorf("duplicate panel id %q", panels[i].ID)
+		}
+		seen[panels[i].ID] = true
+	}
+
+	next := 1
+	for i := range panels {
+		for panels[i].ID == "" {
+			id := fmt.Sprintf("panel-%d", next)
+			next++
+			if !seen[id] {
+				panels[i].ID = id
+				seen[id] = true
+			}
+		}
+	}
+	return nil
+}
+
+// AutoInterval returns the smallest interval that splits start..end into at
+// most maxAutoPoints buckets
+func AutoInterval(start, end time.Time) string {
+	for _, interval := range autoIntervals {
+		bucket, _ := rollup.ParseBucket(interval)
+		if bucket.Count(start, end) <= maxAutoPoints {
+			return interval
+		}
+	}
+	return autoIntervals[len(autoIntervals)-1]
+}
+
+// Bucket is one bucket of a queried series. Empty buckets have no values.
+type Bucket struct {
+	Start time.Time
+	Empty bool
+	Count int64
+	Sum   float64
+	Min   float64
+	Max   float64
+	Avg   float64
+	P50   float64
+	P95   float64
+}
+
+// Group is the buckets of one tag group of a query result
+type Group struct {
+	Tags    map[string]string
+	Buckets []Bucket
+}
+
+// Point is one bucket of a rendered series. Value is null for empty buckets.
+type Point struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     *float64  `json:"value"`
+}
+
+// Series is one tag group of a rendered panel
+type Series struct {
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags,omitempty"`
+	// Points are only returned for time series and bar panels
+	Points []Point `json:"points,omitempty"`
+	// Value is the series reduced by the query's reducer
+	Value *float64 `json:"value"`
+	// Color is the threshold color of Value
+	Color string `json:"color,omitempty"`
+}
+
+// PanelData is a panel with its query evaluated
+type PanelData struct {
+	PanelID       string        `json:"panel_id"`
+	Title         string        `json:"title"`
+	Visualization Visualization `json:"visualization"`
+	Unit          string        `json:"unit,omitempty"`
+	Start         time.Time     `json:"start"`
+	End           time.Time     `json:"end"`
+	Interval      string        `json:"interval"`
+	// CoveredFrom is set when retention has removed the start of the range
+	CoveredFrom *time.Time `json:"covered_from,omitempty"`
+	Series      []Series   `json:"series"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// Rendered is a dashboard with every panel evaluated
+type Rendered struct {
+	DashboardID uint        `json:"dashboard_id"`
+	Name        string      `json:"name"`
+	GeneratedAt time.Time   `json:"generated_at"`
+	Panels      []PanelData `json:"panels"`
+}
+
+// Render builds a panel's data from the groups its query returned
+func (p *Panel) Render(start, end time.Time, interval string, groups []Group) PanelData {
+	data := PanelData{
+		PanelID:       p.ID,
+		Title:         p.Title,
+		Visualization: p.Visualization,
+		Unit:          p.Unit,
+		Start:         start,
+		End:           end,
+		Interval:      interval,
+		Series:        make([]Series, 0, len(groups)),
+	}
+
+	for _, group := range groups {
+		series := Series{Name: p.seriesName(group.Tags), Tags: group.Tags}
+		if !p.Visualization.Reduced() {
+			series.Points = make([]Point, len(group.Buckets))
+			for i, bucket := range group.Buckets {
+				series.Points[i] = Point{Timestamp: bucket.Start, Value: p.Query.value(bucket)}
+			}
+		}
+		series.Value = p.Query.reduce(group.Buckets)
+		if series.Value != nil {
+			series.Color = p.ThresholdColor(*series.Value)
+		}
+		data.Series = append(data.Series, series)
+	}
+	return data
+}
+
+// seriesName labels a group by its grouping tags, or by the query name when
+// the query is not grouped
+func (p *Panel) seriesName(tags map[string]string) string {
+	if len(p.Query.GroupBy) == 0 {
+		return p.Query.Name
+	}
+	parts := make([]string, len(p.Query.GroupBy))
+	for i, tag := range p.Query.GroupBy {
+		parts[i] = tag + "=" + tags[tag]
+	}
+	return strings.Join(parts, ", ")
+}
+
+// value returns a bucket's value for the query's aggregation
+func (q *Query) value(bucket Bucket) *float64 {
+	if bucket.Empty {
+		return nil
+	}
+
+	var value float64
+	switch q.Aggregation {
+	case AggregationCount:
+		value = float64(bucket.Count)
+	case AggregationSum:
+		value = bucket.Sum
+	case AggregationMin:
+		value = bucket.Min
+	case AggregationMax:
+		value = bucket.Max
+	case AggregationP50:
+		value = bucket.P50
+	case AggregationP95:
+		value = bucket.P95
+	default:
+		value = bucket.Avg
+	}
+	return &value
+}
+
+// reduce combines a series into one value, or nil when every bucket is empty.
+// The mean of an average is weighted by each bucket's count.
+func (q *Query) reduce(buckets []Bucket) *float64 {
+	var (
+		values      []float64
+		sum, weight float64
+	)
+	for _, bucket := range buckets {
+		value := q.value(bucket)
+		if value == nil {
+			continue
+		}
+		values = append(values, *value)
+		sum += bucket.Sum
+		weight += float64(bucket.Count)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	var result float64
+	switch q.Reduce {
+	case ReduceFirst:
+		result = values[0]
+	case ReduceSum:
+		for _, value := range values {
+			result += value
+		}
+	case ReduceMean:
+		if q.Aggregation == AggregationAvg && weight > 0 {
+			result = sum / weight
+			break
+		}
+		for _, value := range values {
+			result += value
+		}
+		result /= float64(len(values))
+	case ReduceMin:
+		result = math.Inf(1)
+		for _, value := range values {
+			result = math.Min(result, value)
+		}
+	case ReduceMax:
+		result = math.Inf(-1)
+		for _, value := range values {
+			result = math.Max(result, value)
+		}
+	default:
+		result = values[len(values)-1]
+	}
+	return &result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
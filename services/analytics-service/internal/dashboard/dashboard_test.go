@@ -0,0 +1,205 @@
+package dashboard
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryDefaults(t *testing.T) {
+	metric := Query{Name: "response_time"}
+	require.NoError(t, metric.Validate())
+	assert.Equal(t, SourceMetric, metric.Source)
+	assert.Equal(t, AggregationAvg, metric.Aggregation)
+	assert.Equal(t, ReduceLast, metric.Reduce)
+
+	event := Query{Source: SourceEvent, Name: "visit_completed"}
+	require.NoError(t, event.Validate())
+	assert.Equal(t, AggregationCount, event.Aggregation)
+	assert.Equal(t, ReduceSum, event.Reduce)
+
+	for name, query := range map[string]Query{
+		"name":        {},
+		"source":      {Name: "x", Source: "log"},
+		"aggregation": {Name: "x", Aggregation: "median"},
+		"reduce":      {Name: "x", Reduce: "avg"},
+		"interval":    {Name: "x", Interval: "5s"},
+		"group_by":    {Name: "x", GroupBy: []string{""}},
+	} {
+		assert.Error(t, query.Validate(), name)
+	}
+}
+
+func TestTimeRangeResolve(t *testing.T) {
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	start, end, err := TimeRange{}.Resolve(now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-24*time.Hour), start)
+	assert.Equal(t, now, end)
+
+	start, _, err = TimeRange{From: "now-1M"}.Resolve(now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC), start)
+
+	start, end, err = TimeRange{From: "2025-01-01T00:00:00Z", To: "now-2w"}.Resolve(now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, now.AddDate(0, 0, -14), end)
+
+	for _, bad := range []TimeRange{{From: "yesterday"}, {From: "now-3x"}, {From: "now-0d"}, {From: "now", To: "now-1h"}} {
+		_, _, err := bad.Resolve(now)
+		assert.Error(t, err, bad.From)
+	}
+}
+
+func TestValidatePanels(t *testing.T) {
+	panels := []Panel{
+		{Title: "Visits", Query: Query{Source: SourceEvent, Name: "visit"}},
+		{ID: "panel-1", Title: "Latency", Visualization: VisualizationGauge, Query: Query{Name: "latency"},
+			Thresholds: []Threshold{{Value: 500, Color: "red"}, {Value: 200, Color: "orange"}}},
+	}
+	require.NoError(t, ValidatePanels(panels))
+	assert.Equal(t, "panel-2", panels[0].ID)
+	assert.Equal(t, VisualizationTimeSeries, panels[0].Visualization)
+	assert.Equal(t, GridPos{W: 12, H: 8}, panels[0].GridPos)
+	assert.Equal(t, 200.0, panels[1].Thresholds[0].Value)
+
+	assert.Equal(t, "", panels[1].ThresholdColor(100))
+	assert.Equal(t, "orange", panels[1].ThresholdColor(200))
+	assert.Equal(t, "red", panels[1].ThresholdColor(900))
+
+	duplicate := []Panel{{ID: "a", Title: "A", Query: Query{Name: "x"}}, {ID: "a", Title: "B", Query: Query{Name: "x"}}}
+	assert.Error(t, ValidatePanels(duplicate))
+
+	wide := []Panel{{Title: "A", Query: Query{Name: "x"}, GridPos: GridPos{X: 20, W: 8, H: 4}}}
+	assert.Error(t, ValidatePanels(wide))
+
+	visualization := []Panel{{Title: "A", Visualization: "heatmap", Query: Query{Name: "x"}}}
+	assert.Error(t, ValidatePanels(visualization))
+}
+
+func TestAutoInterval(t *testing.T) {
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, "1m", AutoInterval(now.Add(-time.Hour), now))
+	assert.Equal(t, "5m", AutoInterval(now.Add(-24*time.Hour), now))
+	assert.Equal(t, "1h", AutoInterval(now.AddDate(0, 0, -7), now))
+	assert.Equal(t, "1d", AutoInterval(now.AddDate(-0, -6, 0), now))
+}
+
+func TestRender(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	buckets := []Bucket{
+		{Start: start, Count: 1, Sum: 10, Min: 10, Max: 10, Avg: 10},
+		{Start: start.Add(time.Hour), Empty: true},
+		{Start: start.Add(2 * time.Hour), Count: 3, Sum: 30, Min: 5, Max: 15, Avg: 10, P95: 15},
+		{Start: start.Add(3 * time.Hour), Count: 4, Sum: 80, Min: 10, Max: 30, Avg: 20},
+	}
+	end := start.Add(4 * time.Hour)
+
+	panel := Panel{Title: "Latency", Query: Query{Name: "latency", GroupBy: []string{"endpoint"}, Reduce: ReduceMean},
+		Thresholds: []Threshold{{Value: 15, Color: "orange"}}}
+	require.NoError(t, ValidatePanels([]Panel{panel}))
+	require.NoError(t, panel.Validate())
+	data := panel.Render(start, end, "1h", []Group{{Tags: map[string]string{"endpoint": "/visits"}, Buckets: buckets}})
+
+	require.Len(t, data.Series, 1)
+	series := data.Series[0]
+	assert.Equal(t, "endpoint=/visits", series.Name)
+	require.Len(t, series.Points, 4)
+	assert.Nil(t, series.Points[1].Value)
+	assert.Equal(t, 20.0, *series.Points[3].Value)
+	// The mean of averages is weighted by count: 120 / 8
+	assert.Equal(t, 15.0, *series.Value)
+	assert.Equal(t, "orange", series.Color)
+
+	stat := Panel{Title: "Requests", Visualization: VisualizationStat, Query: Query{Name: "latency", Aggregation: AggregationCount}}
+	require.NoError(t, stat.Validate())
+	data = stat.Render(start, end, "1h", []Group{{Buckets: buckets}})
+	assert.Nil(t, data.Series[0].Points)
+	assert.Equal(t, "latency", data.Series[0].Name)
+	assert.Equal(t, 8.0, *data.Series[0].Value)
+
+	for reduce, want := range map[string]float64{ReduceFirst: 10, ReduceLast: 30, ReduceMin: 10, ReduceMax: 30, ReduceSum: 55} {
+		max := Panel{Title: "Max", Query: Query{Name: "latency", Aggregation: AggregationMax, Reduce: reduce}}
+		require.NoError(t, max.Validate())
+		data = max.Render(start, end, "1h", []Group{{Buckets: buckets}})
+		assert.Equal(t, want, *data.Series[0].Value, reduce)
+	}
+
+	empty := stat.Render(start, end, "1h", []Group{{Buckets: []Bucket{{Start: start, Empty: true}}}})
+	assert.Nil(t, empty.Series[0].Value)
+}
+
+func TestEmbedTokens(t *testing.T) {
+	now := time.Now()
+	signer := NewEmbedSigner([]byte("secret"))
+	token, err := signer.Issue(EmbedClaims{DashboardID: 7, Version: 2, ExpiresAt: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	claims, err := signer.Verify(token, now)
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), claims.DashboardID)
+	assert.Equal(t, 2, claims.Version)
+
+	_, err = signer.Verify(token, now.Add(2*time.Hour))
+	assert.ErrorIs(t, err, ErrEmbedTokenExpired)
+
+	_, err = NewEmbedSigner([]byte("other")).Verify(token, now)
+	assert.ErrorIs(t, err, ErrEmbedToken)
+
+	payload, signature, _ := strings.Cut(token, ".")
+	forged, err := NewEmbedSigner([]byte("other")).Issue(EmbedClaims{DashboardID: 8, ExpiresAt: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+	_, err = signer.Verify(forgedPayload+"."+signature, now)
+	assert.ErrorIs(t, err, ErrEmbedToken)
+
+	for _, bad := range []string{"", payload, payload + ".!!", "." + signature} {
+		_, err = signer.Verify(bad, now)
+		assert.ErrorIs(t, err, ErrEmbedToken, bad)
+	}
+}
+
+func TestGrafanaModel(t *testing.T) {
+	panels := []Panel{
+		{Title: "Visits", Visualization: VisualizationBar, Query: Query{Source: SourceEvent, Name: "visit", Interval: "1d", GroupBy: []string{"facility"}}},
+		{Title: "Latency", Visualization: VisualizationStat, Unit: "ms", Query: Query{Name: "latency", Aggregation: AggregationP95,
+			Filters: map[string]string{"endpoint": "/o'clock"}}, TimeRange: &TimeRange{From: "now-6h"},
+			Thresholds: []Threshold{{Value: 300, Color: "red"}}},
+	}
+	require.NoError(t, ValidatePanels(panels))
+
+	model := GrafanaExport{UID: "analytics-dashboard-1", Title: "Club", ClubID: "42", Panels: panels, RefreshSeconds: 60, DataSourceUID: "pg"}.Model()
+	assert.Equal(t, "analytics-dashboard-1", model["uid"])
+	assert.Equal(t, "60s", model["refresh"])
+	assert.Equal(t, map[string]interface{}{"from": "now-24h", "to": "now"}, model["time"])
+
+	exported := model["panels"].([]map[string]interface{})
+	require.Len(t, exported, 2)
+
+	bars := exported[0]
+	assert.Equal(t, "timeseries", bars["type"])
+	sql := bars["targets"].([]map[string]interface{})[0]["rawSql"].(string)
+	assert.Contains(t, sql, "$__timeGroupAlias(bucket_start, '1d')")
+	assert.Contains(t, sql, "SUM(value_count) AS value")
+	assert.Contains(t, sql, "resolution = 'day'")
+	assert.Contains(t, sql, "AS metric")
+	assert.Contains(t, sql, "GROUP BY 1, 2")
+
+	stat := exported[1]
+	assert.Equal(t, "stat", stat["type"])
+	assert.Equal(t, "6h", stat["timeFrom"])
+	sql = stat["targets"].([]map[string]interface{})[0]["rawSql"].(string)
+	assert.Contains(t, sql, "club_id = '42'")
+	assert.Contains(t, sql, "resolution = 'hour'")
+	assert.Contains(t, sql, "(tags::jsonb ->> 'endpoint') = '/o''clock'")
+	assert.Contains(t, sql, "$__interval")
+	defaults := stat["fieldConfig"].(map[string]interface{})["defaults"].(map[string]interface{})
+	assert.Equal(t, "ms", defaults["unit"])
+	steps := defaults["thresholds"].(map[string]interface{})["steps"].([]map[string]interface{})
+	assert.Len(t, steps, 2)
+}
@@ -0,0 +1,207 @@
+package dashboard
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/rollup"
+)
+
+// grafanaRollupTable is the table of metric rollups that exported panels query
+// through a Grafana PostgreSQL data source
+const grafanaRollupTable = "analytics_metric_rollups"
+
+// GrafanaExport describes a dashboard to export as a Grafana dashboard model
+type GrafanaExport struct {
+	UID            string
+	Title          string
+	Description    string
+	ClubID         string
+	Panels         []Panel
+	TimeRange      TimeRange
+	RefreshSeconds int
+	// DataSourceUID is the Grafana PostgreSQL data source reading the
+	// analytics database
+	DataSourceUID string
+}
+
+var grafanaPanelTypes = map[Visualization]string{
+	VisualizationTimeSeries: "timeseries",
+	VisualizationBar:        "timeseries",
+	VisualizationStat:       "stat",
+	VisualizationGauge:      "gauge",
+	VisualizationTable:      "table",
+	VisualizationPie:        "piechart",
+}
+
+var grafanaReducers = map[string]string{
+	ReduceLast:  "lastNotNull",
+	ReduceFirst: "firstNotNull",
+	ReduceSum:   "sum",
+	ReduceMean:  "mean",
+	ReduceMin:   "min",
+	ReduceMax:   "max",
+}
+
+// Model returns the Grafana dashboard JSON model. Each panel queries the
+// rollups with SQL, so the exported dashboard stays live without the service.
+func (e GrafanaExport) Model() map[string]interface{} {
+	timeRange := e.TimeRange
+	if timeRange.IsZero() {
+		timeRange = DefaultTimeRange
+	}
+
+	panels := make([]map[string]interface{}, len(e.Panels))
+	for i := range e.Panels {
+		panels[i] = e.panel(i+1, &e.Panels[i])
+	}
+
+	model := map[string]interface{}{
+		"uid":           e.UID,
+		"title":         e.Title,
+		"description":   e.Description,
+		"tags":          []string{"analytics", "club-" + e.ClubID},
+		"timezone":      "browser",
+		"schemaVersion": 39,
+		"panels":        panels,
+		"time": map[string]interface{}{
+			"from": timeRange.From,
+			"to":   timeRange.To,
+		},
+	}
+	if e.RefreshSeconds > 0 {
+		model["refresh"] = fmt.Sprintf("%ds", e.RefreshSeconds)
+	}
+	return model
+}
+
+func (e GrafanaExport) panel(id int, p *Panel) map[string]interface{} {
+	steps := []map[string]interface{}{{"color": "green", "value": nil}}
+	for _, threshold := range p.Thresholds {
+		steps = append(steps, map[string]interface{}{"color": threshold.Color, "value": threshold.Value})
+	}
+
+	defaults := map[string]interface{}{
+		"thresholds": map[string]interface{}{"mode": "absolute", "steps": steps},
+	}
+	if p.Unit != "" {
+		defaults["unit"] = p.Unit
+	}
+	if p.Visualization == VisualizationBar {
+		defaults["custom"] = map[string]interface{}{"drawStyle": "bars", "fillOpacity": 80}
+	}
+
+	panel := map[string]interface{}{
+		"id":          id,
+		"title":       p.Title,
+		"description": p.Description,
+		"type":        grafanaPanelTypes[p.Visualization],
+		"datasource":  map[string]interface{}{"type": "grafana-postgresql-datasource", "uid": e.DataSourceUID},
+		"gridPos":     map[string]interface{}{"x": p.GridPos.X, "y": p.GridPos.Y, "w": p.GridPos.W, "h": p.GridPos.H},
+		"fieldConfig": map[string]interface{}{"defaults": defaults, "overrides": []interface{}{}},
+		"targets": []map[string]interface{}{{
+			"refId":      "A",
+			"datasource": map[string]interface{}{"type": "grafana-postgresql-datasource", "uid": e.DataSourceUID},
+			"editorMode": "code",
+			"format":     "time_series",
+			"rawQuery":   true,
+			"rawSql":     e.sql(p),
+		}},
+	}
+	if p.Visualization.Reduced() {
+		panel["options"] = map[string]interface{}{
+			"reduceOptions": map[string]interface{}{"calcs": []string{grafanaReducers[p.Query.Reduce]}, "values": false},
+		}
+	}
+	if p.Visualization == VisualizationTable {
+		panel["transformations"] = []map[string]interface{}{{
+			"id":      "reduce",
+			"options": map[string]interface{}{"reducers": []string{grafanaReducers[p.Query.Reduce]}},
+		}}
+	}
+	if p.TimeRange != nil && strings.HasPrefix(p.TimeRange.From, "now-") && (p.TimeRange.To == "" || p.TimeRange.To == "now") {
+		panel["timeFrom"] = strings.TrimPrefix(p.TimeRange.From, "now-")
+	}
+	return panel
+}
+
+// sql builds a panel's query over the rollups. Rollups of one resolution are
+// grouped into the panel's interval, or Grafana's interval when it has none.
+// Percentiles cannot be merged in SQL, so they are approximated by the
+// count-weighted mean of the stored bucket percentiles.
+func (e GrafanaExport) sql(p *Panel) string {
+	q := p.Query
+	resolution := rollup.Hour
+	timeGroup := "$__timeGroupAlias(bucket_start, $__interval)"
+	if bucket, err := rollup.ParseBucket(q.Interval); err == nil {
+		resolution = bucket.Unit
+		timeGroup = fmt.Sprintf("$__timeGroupAlias(bucket_start, '%s')", bucket)
+		if bucket.Unit == rollup.Month {
+			// Grafana cannot group by months, so monthly rollups are plotted as stored
+			timeGroup = `bucket_start AS "time"`
+		}
+	}
+
+	var value string
+	switch q.Aggregation {
+	case AggregationCount:
+		value = "SUM(value_count)"
+	case AggregationSum:
+		value = "SUM(value_sum)"
+	case AggregationMin:
+		value = "MIN(value_min)"
+	case AggregationMax:
+		value = "MAX(value_max)"
+	case AggregationP50:
+		value = "SUM(value_p50 * value_count) / NULLIF(SUM(value_count), 0)"
+	case AggregationP95:
+		value = "SUM(value_p95 * value_count) / NULLIF(SUM(value_count), 0)"
+	default:
+		value = "SUM(value_sum) / NULLIF(SUM(value_count), 0)"
+	}
+
+	columns := []string{timeGroup}
+	groupBy := "1"
+	if len(q.GroupBy) > 0 {
+		tags := make([]string, len(q.GroupBy))
+		for i, tag := range q.GroupBy {
+			tags[i] = fmt.Sprintf("%s || '=' || coalesce(%s, '')", sqlString(tag), tagColumn(tag))
+		}
+		columns = append(columns, fmt.Sprintf("concat_ws(', ', %s) AS metric", strings.Join(tags, ", ")))
+		groupBy = "1, 2"
+	}
+	columns = append(columns, value+" AS value")
+
+	conditions := []string{
+		"club_id = " + sqlString(e.ClubID),
+		"source = " + sqlString(q.Source),
+		"name = " + sqlString(q.Name),
+		"resolution = " + sqlString(string(resolution)),
+		"$__timeFilter(bucket_start)",
+	}
+	for _, tag := range sortedKeys(q.Filters) {
+		conditions = append(conditions, fmt.Sprintf("%s = %s", tagColumn(tag), sqlString(q.Filters[tag])))
+	}
+
+	return fmt.Sprintf("SELECT %s\nFROM %s\nWHERE %s\nGROUP BY %s\nORDER BY 1",
+		strings.Join(columns, ", "), grafanaRollupTable, strings.Join(conditions, "\n  AND "), groupBy)
+}
+
+func tagColumn(tag string) string {
+	return fmt.Sprintf("(tags::jsonb ->> %s)", sqlString(tag))
+}
+
+// sqlString quotes a PostgreSQL string literal
+func sqlString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
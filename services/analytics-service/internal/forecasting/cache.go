@@ -0,0 +1,55 @@
+package forecasting
+
+import (
+	"sync"
+	"time"
+)
+
+// Cache keeps fitted models per club and metric. An entry is reused until the
+// underlying data changes, which callers express as a fingerprint, or until it
+// is older than the TTL.
+type Cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	fingerprint string
+	fitted      *Fitted
+}
+
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Get returns the cached model when it was fitted on data with the same fingerprint
+func (c *Cache) Get(clubID, metricName, fingerprint string) (*Fitted, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[cacheKey(clubID, metricName)]
+	if !ok || entry.fingerprint != fingerprint || time.Since(entry.fitted.FittedAt) > c.ttl {
+		return nil, false
+	}
+	return entry.fitted, true
+}
+
+func (c *Cache) Put(clubID, metricName, fingerprint string, fitted *Fitted) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if time.Since(entry.fitted.FittedAt) > c.ttl {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[cacheKey(clubID, metricName)] = cacheEntry{fingerprint: fingerprint, fitted: fitted}
+}
+
+func cacheKey(clubID, metricName string) string {
+	return clubID + "\x00" + metricName
+}
@@ -0,0 +1,187 @@
+package forecasting
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrInsufficientData is returned when a series is too short to fit and backtest
+var ErrInsufficientData = errors.New("not enough history to fit a forecast")
+
+const (
+	// MinTrainingDays is the shortest series the forecaster will fit
+	MinTrainingDays = 21
+
+	// z-score of the two-sided 95% prediction interval
+	intervalZ       = 1.959964
+	ConfidenceLevel = "95%"
+
+	maxBacktestDays = 28
+	minBacktestDays = 7
+)
+
+// Prediction is a point forecast with its 95% prediction interval
+type Prediction struct {
+	Timestamp time.Time
+	Value     float64
+	Lower     float64
+	Upper     float64
+}
+
+// Model is a forecasting method that can be fitted on a daily series
+type Model interface {
+	Name() string
+	Fit(series *Series) error
+	// Forecast returns predictions for the horizon days following the fitted series
+	Forecast(horizon int) []Prediction
+	Parameters() map[string]float64
+}
+
+// Backtest holds the out-of-sample accuracy of a model on held-out days
+type Backtest struct {
+	Model string  `json:"model_type"`
+	Days  int     `json:"backtest_days"`
+	MAPE  float64 `json:"mape"`
+	RMSE  float64 `json:"rmse"`
+	// Coverage is the share of held-out values inside the prediction interval
+	Coverage float64 `json:"interval_coverage"`
+}
+
+// Accuracy expresses MAPE as a 0..1 score
+func (b Backtest) Accuracy() float64 {
+	return math.Max(0, 1-b.MAPE)
+}
+
+// Forecaster backtests every candidate model and keeps the most accurate one
+type Forecaster struct {
+	Candidates []func() Model
+}
+
+// NewForecaster returns a forecaster choosing between Holt-Winters and
+// regression with indicators for the given holidays
+func NewForecaster(holidays HolidayCalendar) *Forecaster {
+	return &Forecaster{
+		Candidates: []func() Model{
+			func() Model { return NewHoltWinters() },
+			func() Model { return NewLinearRegression(holidays) },
+		},
+	}
+}
+
+// Fitted is a model selected by backtest and refitted on the full series
+type Fitted struct {
+	Model       Model
+	Series      *Series
+	Backtest    Backtest
+	Candidates  []Backtest
+	FittedAt    time.Time
+	nonNegative bool
+}
+
+// Fit holds back the tail of the series, backtests each candidate on it and
+// refits the lowest-RMSE model on the whole series
+func (f *Forecaster) Fit(series *Series) (*Fitted, error) {
+	if series == nil || series.Len() < MinTrainingDays {
+		return nil, ErrInsufficientData
+	}
+
+	holdout := series.Len() / 5
+	if holdout < minBacktestDays {
+		holdout = minBacktestDays
+	}
+	if holdout > maxBacktestDays {
+		holdout = maxBacktestDays
+	}
+	train := series.Slice(0, series.Len()-holdout)
+	actual := series.Values[series.Len()-holdout:]
+
+	var factories []func() Model
+	var candidates []Backtest
+	for _, candidate := range f.Candidates {
+		model := candidate()
+		if err := model.Fit(train); err != nil {
+			continue
+		}
+		factories = append(factories, candidate)
+		candidates = append(candidates, backtest(model.Name(), model.Forecast(holdout), actual))
+	}
+	if len(candidates) == 0 {
+		return nil, ErrInsufficientData
+	}
+
+	best := 0
+	for i, c := range candidates {
+		if c.RMSE < candidates[best].RMSE {
+			best = i
+		}
+	}
+
+	model := factories[best]()
+	if err := model.Fit(series); err != nil {
+		return nil, fmt.Errorf("failed to refit %s: %w", model.Name(), err)
+	}
+
+	fitted := &Fitted{
+		Model:       model,
+		Series:      series,
+		Backtest:    candidates[best],
+		Candidates:  candidates,
+		FittedAt:    time.Now(),
+		nonNegative: true,
+	}
+	for _, v := range series.Values {
+		if v < 0 {
+			fitted.nonNegative = false
+			break
+		}
+	}
+	return fitted, nil
+}
+
+// Forecast returns one prediction per day for the days after from. Days between
+// the end of the series and from are forecast but not returned.
+func (f *Fitted) Forecast(from time.Time, days int) []Prediction {
+	skip := int(truncateDay(from).Sub(f.Series.End()) / day)
+	if skip < 0 {
+		skip = 0
+	}
+
+	predictions := f.Model.Forecast(skip + days)[skip:]
+	if f.nonNegative {
+		for i := range predictions {
+			predictions[i].Value = math.Max(0, predictions[i].Value)
+			predictions[i].Lower = math.Max(0, predictions[i].Lower)
+			predictions[i].Upper = math.Max(0, predictions[i].Upper)
+		}
+	}
+	return predictions
+}
+
+func backtest(model string, predictions []Prediction, actual []float64) Backtest {
+	var squared, percent float64
+	var percentCount, covered int
+	for i, p := range predictions {
+		e := actual[i] - p.Value
+		squared += e * e
+		if actual[i] != 0 {
+			percent += math.Abs(e / actual[i])
+			percentCount++
+		}
+		if actual[i] >= p.Lower && actual[i] <= p.Upper {
+			covered++
+		}
+	}
+
+	result := Backtest{
+		Model:    model,
+		Days:     len(actual),
+		RMSE:     math.Sqrt(squared / float64(len(actual))),
+		Coverage: float64(covered) / float64(len(actual)),
+	}
+	if percentCount > 0 {
+		result.MAPE = percent / float64(percentCount)
+	}
+	return result
+}
@@ -0,0 +1,177 @@
+package forecasting
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syntheticSeries builds a daily series with a trend, a weekend peak, an
+// optional yearly swing and seeded noise
+func syntheticSeries(start time.Time, days int, yearlyAmplitude float64) *Series {
+	rng := rand.New(rand.NewSource(42))
+	values := make([]float64, days)
+	for t := range values {
+		date := start.AddDate(0, 0, t)
+		v := 100 + 0.05*float64(t)
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			v += 30
+		}
+		v += yearlyAmplitude * math.Sin(2*math.Pi*float64(date.YearDay())/365.25)
+		values[t] = v + rng.NormFloat64()*3
+	}
+	return &Series{Start: start, Values: values}
+}
+
+func TestDailySeries_AveragesDaysAndInterpolatesGaps(t *testing.T) {
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	series := DailySeries([]Point{
+		{Timestamp: start.Add(18 * time.Hour), Value: 12},
+		{Timestamp: start.Add(6 * time.Hour), Value: 8},
+		{Timestamp: start.AddDate(0, 0, 3).Add(9 * time.Hour), Value: 40},
+	})
+
+	require.NotNil(t, series)
+	assert.Equal(t, start, series.Start)
+	assert.Equal(t, []float64{10, 20, 30, 40}, series.Values)
+	assert.Equal(t, start.AddDate(0, 0, 3), series.End())
+	assert.Nil(t, DailySeries(nil))
+}
+
+func TestDefaultHolidays(t *testing.T) {
+	for _, date := range []string{"2025-01-01", "2024-03-29", "2024-04-01", "2025-04-18", "2025-04-21", "2025-12-25", "2025-12-26"} {
+		d, _ := time.Parse("2006-01-02", date)
+		assert.True(t, DefaultHolidays.IsHoliday(d.Add(15*time.Hour)), date)
+	}
+	for _, date := range []string{"2025-04-20", "2025-07-04", "2025-12-24"} {
+		d, _ := time.Parse("2006-01-02", date)
+		assert.False(t, DefaultHolidays.IsHoliday(d), date)
+	}
+}
+
+func TestForecaster_LearnsWeeklySeasonality(t *testing.T) {
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	series := syntheticSeries(start, 120, 0)
+
+	fitted, err := NewForecaster(nil).Fit(series)
+	require.NoError(t, err)
+
+	assert.Equal(t, 24, fitted.Backtest.Days)
+	assert.Len(t, fitted.Candidates, 2)
+	assert.Less(t, fitted.Backtest.MAPE, 0.05)
+	assert.Less(t, fitted.Backtest.RMSE, 6.0)
+	assert.GreaterOrEqual(t, fitted.Backtest.Coverage, 0.75)
+
+	predictions := fitted.Forecast(series.End(), 14)
+	require.Len(t, predictions, 14)
+	assert.Equal(t, series.End().AddDate(0, 0, 1), predictions[0].Timestamp)
+
+	for _, p := range predictions {
+		expected := 100 + 0.05*float64(int(p.Timestamp.Sub(start)/day))
+		if p.Timestamp.Weekday() == time.Saturday || p.Timestamp.Weekday() == time.Sunday {
+			expected += 30
+		}
+		assert.InDelta(t, expected, p.Value, 8, p.Timestamp.String())
+		assert.Less(t, p.Lower, p.Value)
+		assert.Greater(t, p.Upper, p.Value)
+	}
+	assert.Greater(t, predictions[13].Upper-predictions[13].Lower, predictions[0].Upper-predictions[0].Lower)
+}
+
+func TestFitted_ForecastStartsAfterFromDate(t *testing.T) {
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	series := syntheticSeries(start, 60, 0)
+
+	fitted, err := NewForecaster(nil).Fit(series)
+	require.NoError(t, err)
+
+	from := series.End().AddDate(0, 0, 5).Add(13 * time.Hour)
+	predictions := fitted.Forecast(from, 3)
+	require.Len(t, predictions, 3)
+	assert.Equal(t, series.End().AddDate(0, 0, 6), predictions[0].Timestamp)
+}
+
+func TestHoltWinters_FitsYearlySeasonality(t *testing.T) {
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	series := syntheticSeries(start, 2*365+100, 40)
+
+	model := NewHoltWinters()
+	require.NoError(t, model.Fit(series))
+	assert.Contains(t, model.Parameters(), "delta")
+
+	predictions := model.Forecast(365)
+	var summer, winter []float64
+	for _, p := range predictions {
+		switch p.Timestamp.Month() {
+		case time.April:
+			summer = append(summer, p.Value)
+		case time.October:
+			winter = append(winter, p.Value)
+		}
+	}
+	require.NotEmpty(t, summer)
+	require.NotEmpty(t, winter)
+	assert.Greater(t, mean(summer)-mean(winter), 40.0)
+}
+
+func TestLinearRegression_EstimatesHolidayEffect(t *testing.T) {
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	series := syntheticSeries(start, 2*365, 0)
+	for i := range series.Values {
+		if DefaultHolidays.IsHoliday(series.Time(i)) {
+			series.Values[i] -= 60
+		}
+	}
+
+	model := NewLinearRegression(DefaultHolidays)
+	require.NoError(t, model.Fit(series))
+	assert.InDelta(t, -60, model.Parameters()["holiday"], 5)
+	assert.InDelta(t, 0.05, model.Parameters()["trend_per_day"], 0.01)
+
+	// Christmas Day 2025 and the ordinary Wednesday a week earlier
+	predictions := model.Forecast(365)
+	var christmas, ordinary float64
+	for _, p := range predictions {
+		switch p.Timestamp {
+		case time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC):
+			christmas = p.Value
+		case time.Date(2025, 12, 17, 0, 0, 0, 0, time.UTC):
+			ordinary = p.Value
+		}
+	}
+	assert.InDelta(t, -60, christmas-ordinary, 8)
+}
+
+func TestForecaster_RejectsShortSeries(t *testing.T) {
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewForecaster(nil).Fit(syntheticSeries(start, MinTrainingDays-1, 0))
+	assert.ErrorIs(t, err, ErrInsufficientData)
+
+	_, err = NewForecaster(nil).Fit(nil)
+	assert.ErrorIs(t, err, ErrInsufficientData)
+}
+
+func TestCache_InvalidatesOnFingerprintAndTTL(t *testing.T) {
+	cache := NewCache(time.Hour)
+	fitted := &Fitted{FittedAt: time.Now()}
+
+	cache.Put("club-1", "visits", "10:99", fitted)
+
+	got, ok := cache.Get("club-1", "visits", "10:99")
+	assert.True(t, ok)
+	assert.Same(t, fitted, got)
+
+	_, ok = cache.Get("club-1", "visits", "11:100")
+	assert.False(t, ok)
+	_, ok = cache.Get("club-2", "visits", "10:99")
+	assert.False(t, ok)
+
+	cache.Put("club-1", "revenue", "1:1", &Fitted{FittedAt: time.Now().Add(-2 * time.Hour)})
+	_, ok = cache.Get("club-1", "revenue", "1:1")
+	assert.False(t, ok)
+}
@@ -0,0 +1,49 @@
+package forecasting
+
+import "time"
+
+// HolidayCalendar tells the regression model which days are holidays
+type HolidayCalendar interface {
+	IsHoliday(date time.Time) bool
+}
+
+// HolidayCalendarFunc adapts a function to HolidayCalendar
+type HolidayCalendarFunc func(date time.Time) bool
+
+func (f HolidayCalendarFunc) IsHoliday(date time.Time) bool {
+	return f(date)
+}
+
+// DefaultHolidays covers the holidays shared by most member clubs: New Year's
+// Day, Good Friday, Easter Monday, Christmas Day and Boxing Day.
+var DefaultHolidays HolidayCalendar = HolidayCalendarFunc(func(date time.Time) bool {
+	date = truncateDay(date)
+	switch {
+	case date.Month() == time.January && date.Day() == 1:
+		return true
+	case date.Month() == time.December && (date.Day() == 25 || date.Day() == 26):
+		return true
+	}
+
+	easter := easterSunday(date.Year())
+	return date.Equal(easter.AddDate(0, 0, -2)) || date.Equal(easter.AddDate(0, 0, 1))
+})
+
+// easterSunday uses the anonymous Gregorian algorithm
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	dom := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), dom, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,282 @@
+package forecasting
+
+import "math"
+
+const (
+	weekPeriod = 7
+	yearPeriod = 365
+
+	// A year to initialise the yearly component plus eight weeks to smooth it
+	yearlyMinDays  = yearPeriod + 8*weekPeriod
+	weeklyInitDays = 8 * weekPeriod
+)
+
+var (
+	hwAlphas = []float64{0.05, 0.1, 0.2, 0.3, 0.5, 0.7, 0.9}
+	hwBetas  = []float64{0, 0.01, 0.05, 0.1}
+	hwGammas = []float64{0.05, 0.1, 0.2, 0.4}
+	hwDeltas = []float64{0.05, 0.1, 0.2}
+	hwPhis   = []float64{0.9, 0.98, 1}
+)
+
+// HoltWinters is additive double-seasonal exponential smoothing with a damped
+// trend. The weekly component is always fitted; the yearly one only when the
+// series covers more than a year.
+type HoltWinters struct {
+	Alpha, Beta, Gamma, Delta, Phi float64
+
+	state  hwState
+	yearly bool
+	sigma  float64
+	series *Series
+}
+
+type hwState struct {
+	level, trend float64
+	week         []float64
+	year         []float64
+}
+
+func NewHoltWinters() *HoltWinters {
+	return &HoltWinters{}
+}
+
+func (m *HoltWinters) Name() string {
+	return "holt_winters"
+}
+
+// Fit initialises the components from the start of the series and grid-searches
+// the smoothing parameters that minimise the one-step-ahead squared error
+func (m *HoltWinters) Fit(series *Series) error {
+	y := series.Values
+	if len(y) < 2*weekPeriod {
+		return ErrInsufficientData
+	}
+
+	m.series = series
+	m.yearly = len(y) >= yearlyMinDays
+	initial := m.initialState(y)
+
+	deltas := []float64{0}
+	if m.yearly {
+		deltas = hwDeltas
+	}
+
+	bestSSE := math.Inf(1)
+	for _, alpha := range hwAlphas {
+		for _, beta := range hwBetas {
+			for _, gamma := range hwGammas {
+				for _, delta := range deltas {
+					for _, phi := range hwPhis {
+						params := [5]float64{alpha, beta, gamma, delta, phi}
+						sse, state := m.run(params, initial, y)
+						if sse < bestSSE {
+							bestSSE = sse
+							m.Alpha, m.Beta, m.Gamma, m.Delta, m.Phi = alpha, beta, gamma, delta, phi
+							m.state = state
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Level, trend, damping and one smoothing parameter per seasonal component
+	k := 4
+	if m.yearly {
+		k++
+	}
+	m.sigma = math.Sqrt(bestSSE / float64(len(y)-k))
+	return nil
+}
+
+func (m *HoltWinters) initialState(y []float64) hwState {
+	initLen := weeklyInitDays
+	if m.yearly {
+		initLen = yearPeriod
+	}
+	if initLen > len(y) {
+		initLen = len(y)
+	}
+
+	ma := movingAverage(y, weekPeriod)
+	intercept, slope := linearFit(ma[:initLen])
+	if m.yearly {
+		// A line through one year would absorb part of the yearly cycle, so the
+		// trend comes from consecutive yearly means when there are two years
+		first := mean(y[:yearPeriod])
+		slope = 0
+		if len(y) >= 2*yearPeriod {
+			slope = (mean(y[yearPeriod:2*yearPeriod]) - first) / yearPeriod
+		}
+		intercept = first - slope*(yearPeriod-1)/2
+	}
+
+	state := hwState{
+		// The state describes the day before the series starts
+		level: intercept - slope,
+		trend: slope,
+		week:  make([]float64, weekPeriod),
+	}
+
+	counts := make([]int, weekPeriod)
+	for t := 0; t < initLen; t++ {
+		state.week[t%weekPeriod] += y[t] - ma[t]
+		counts[t%weekPeriod]++
+	}
+	for d := range state.week {
+		state.week[d] /= float64(counts[d])
+	}
+	center(state.week)
+
+	if m.yearly {
+		state.year = make([]float64, yearPeriod)
+		for t := 0; t < yearPeriod; t++ {
+			state.year[t] = ma[t] - (intercept + slope*float64(t))
+		}
+		center(state.year)
+	}
+	return state
+}
+
+func (m *HoltWinters) run(params [5]float64, initial hwState, y []float64) (float64, hwState) {
+	alpha, beta, gamma, delta, phi := params[0], params[1], params[2], params[3], params[4]
+
+	state := hwState{
+		level: initial.level,
+		trend: initial.trend,
+		week:  append([]float64(nil), initial.week...),
+	}
+	if initial.year != nil {
+		state.year = append([]float64(nil), initial.year...)
+	}
+
+	var sse float64
+	for t, value := range y {
+		w := t % weekPeriod
+		season := state.week[w]
+		var yearly float64
+		if state.year != nil {
+			yearly = state.year[t%yearPeriod]
+		}
+
+		e := value - (state.level + phi*state.trend + season + yearly)
+		sse += e * e
+
+		level := alpha*(value-season-yearly) + (1-alpha)*(state.level+phi*state.trend)
+		state.trend = beta*(level-state.level) + (1-beta)*phi*state.trend
+		state.level = level
+		state.week[w] = gamma*(value-level-yearly) + (1-gamma)*season
+		if state.year != nil {
+			state.year[t%yearPeriod] = delta*(value-level-season) + (1-delta)*yearly
+		}
+	}
+	return sse, state
+}
+
+// Forecast uses the additive Holt-Winters variance approximation, which widens
+// the interval by the accumulated smoothing weights at each step
+func (m *HoltWinters) Forecast(horizon int) []Prediction {
+	n := m.series.Len()
+	predictions := make([]Prediction, horizon)
+
+	var damped, variance float64
+	for h := 1; h <= horizon; h++ {
+		damped += math.Pow(m.Phi, float64(h))
+		t := n + h - 1
+
+		value := m.state.level + damped*m.state.trend + m.state.week[t%weekPeriod]
+		if m.yearly {
+			value += m.state.year[t%yearPeriod]
+		}
+
+		if h > 1 {
+			c := m.Alpha * (1 + m.Beta*(damped-math.Pow(m.Phi, float64(h))))
+			if (h-1)%weekPeriod == 0 {
+				c += m.Gamma
+			}
+			if m.yearly && (h-1)%yearPeriod == 0 {
+				c += m.Delta
+			}
+			variance += c * c
+		}
+		width := intervalZ * m.sigma * math.Sqrt(1+variance)
+
+		predictions[h-1] = Prediction{
+			Timestamp: m.series.Time(t),
+			Value:     value,
+			Lower:     value - width,
+			Upper:     value + width,
+		}
+	}
+	return predictions
+}
+
+func (m *HoltWinters) Parameters() map[string]float64 {
+	params := map[string]float64{
+		"alpha": m.Alpha,
+		"beta":  m.Beta,
+		"gamma": m.Gamma,
+		"phi":   m.Phi,
+		"sigma": m.sigma,
+	}
+	if m.yearly {
+		params["delta"] = m.Delta
+	}
+	return params
+}
+
+// movingAverage is a centred moving average that shrinks its window at the edges
+func movingAverage(y []float64, window int) []float64 {
+	half := window / 2
+	out := make([]float64, len(y))
+	for t := range y {
+		from, to := t-half, t+half+1
+		if from < 0 {
+			from = 0
+		}
+		if to > len(y) {
+			to = len(y)
+		}
+		var sum float64
+		for _, v := range y[from:to] {
+			sum += v
+		}
+		out[t] = sum / float64(to-from)
+	}
+	return out
+}
+
+// linearFit returns the least-squares intercept and slope of y over 0..n-1
+func linearFit(y []float64) (float64, float64) {
+	n := float64(len(y))
+	var sumX, sumY, sumXY, sumXX float64
+	for i, v := range y {
+		x := float64(i)
+		sumX += x
+		sumY += v
+		sumXY += x * v
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return sumY / n, 0
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	return (sumY - slope*sumX) / n, slope
+}
+
+func center(values []float64) {
+	m := mean(values)
+	for i := range values {
+		values[i] -= m
+	}
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,216 @@
+package forecasting
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	yearlyHarmonics = 2
+	// Small ridge penalty so short or collinear designs stay solvable
+	ridge = 1e-6
+)
+
+var errSingular = errors.New("design matrix is singular")
+
+// LinearRegression models a series as trend + day of week + yearly Fourier
+// terms + a holiday indicator, fitted by least squares
+type LinearRegression struct {
+	Holidays HolidayCalendar
+
+	coefficients []float64
+	// inverse of X'X, used for the prediction interval of new points
+	covariance [][]float64
+	sigma      float64
+	yearly     bool
+	holiday    bool
+	series     *Series
+}
+
+func NewLinearRegression(holidays HolidayCalendar) *LinearRegression {
+	return &LinearRegression{Holidays: holidays}
+}
+
+func (m *LinearRegression) Name() string {
+	return "linear_regression"
+}
+
+func (m *LinearRegression) Fit(series *Series) error {
+	n := series.Len()
+	m.series = series
+	m.yearly = n >= yearPeriod
+	m.holiday = false
+	if m.Holidays != nil {
+		for t := 0; t < n; t++ {
+			if m.Holidays.IsHoliday(series.Time(t)) {
+				m.holiday = true
+				break
+			}
+		}
+	}
+
+	rows := make([][]float64, n)
+	for t := range rows {
+		rows[t] = m.features(t)
+	}
+	p := len(rows[0])
+	if n <= p {
+		return ErrInsufficientData
+	}
+
+	xtx := make([][]float64, p)
+	xty := make([]float64, p)
+	for i := range xtx {
+		xtx[i] = make([]float64, p)
+	}
+	for t, row := range rows {
+		for i := 0; i < p; i++ {
+			xty[i] += row[i] * series.Values[t]
+			for j := 0; j < p; j++ {
+				xtx[i][j] += row[i] * row[j]
+			}
+		}
+	}
+	for i := 1; i < p; i++ {
+		xtx[i][i] += ridge
+	}
+
+	covariance, err := invert(xtx)
+	if err != nil {
+		return err
+	}
+	m.covariance = covariance
+	m.coefficients = multiply(covariance, xty)
+
+	var sse float64
+	for t, row := range rows {
+		e := series.Values[t] - dot(m.coefficients, row)
+		sse += e * e
+	}
+	m.sigma = math.Sqrt(sse / float64(n-p))
+	return nil
+}
+
+func (m *LinearRegression) Forecast(horizon int) []Prediction {
+	n := m.series.Len()
+	predictions := make([]Prediction, horizon)
+	for h := range predictions {
+		t := n + h
+		row := m.features(t)
+		value := dot(m.coefficients, row)
+		leverage := dot(row, multiply(m.covariance, row))
+		width := intervalZ * m.sigma * math.Sqrt(1+leverage)
+
+		predictions[h] = Prediction{
+			Timestamp: m.series.Time(t),
+			Value:     value,
+			Lower:     value - width,
+			Upper:     value + width,
+		}
+	}
+	return predictions
+}
+
+func (m *LinearRegression) Parameters() map[string]float64 {
+	params := map[string]float64{
+		"intercept":     m.coefficients[0],
+		"trend_per_day": m.coefficients[1] / yearPeriod,
+		"sigma":         m.sigma,
+	}
+	for d := 1; d < weekPeriod; d++ {
+		params["weekday_"+time.Weekday(d).String()] = m.coefficients[1+d]
+	}
+	if m.holiday {
+		params["holiday"] = m.coefficients[len(m.coefficients)-1]
+	}
+	return params
+}
+
+// features builds the design row for day t: intercept, trend in years, six
+// weekday dummies with Sunday as the baseline, yearly harmonics and a holiday flag
+func (m *LinearRegression) features(t int) []float64 {
+	date := m.series.Time(t)
+	row := []float64{1, float64(t) / yearPeriod}
+	for d := 1; d < weekPeriod; d++ {
+		if int(date.Weekday()) == d {
+			row = append(row, 1)
+		} else {
+			row = append(row, 0)
+		}
+	}
+	if m.yearly {
+		angle := 2 * math.Pi * float64(date.YearDay()) / 365.25
+		for k := 1; k <= yearlyHarmonics; k++ {
+			row = append(row, math.Sin(float64(k)*angle), math.Cos(float64(k)*angle))
+		}
+	}
+	if m.holiday {
+		if m.Holidays.IsHoliday(date) {
+			row = append(row, 1)
+		} else {
+			row = append(row, 0)
+		}
+	}
+	return row
+}
+
+// invert uses Gauss-Jordan elimination with partial pivoting
+func invert(a [][]float64) ([][]float64, error) {
+	n := len(a)
+	work := make([][]float64, n)
+	for i := range work {
+		work[i] = make([]float64, 2*n)
+		copy(work[i], a[i])
+		work[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(work[row][col]) > math.Abs(work[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(work[pivot][col]) < 1e-12 {
+			return nil, errSingular
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+
+		scale := work[col][col]
+		for j := range work[col] {
+			work[col][j] /= scale
+		}
+		for row := 0; row < n; row++ {
+			if row == col || work[row][col] == 0 {
+				continue
+			}
+			factor := work[row][col]
+			for j := range work[row] {
+				work[row][j] -= factor * work[col][j]
+			}
+		}
+	}
+
+	inverse := make([][]float64, n)
+	for i := range inverse {
+		inverse[i] = work[i][n:]
+	}
+	return inverse, nil
+}
+
+func multiply(a [][]float64, x []float64) []float64 {
+	out := make([]float64, len(a))
+	for i, row := range a {
+		out[i] = dot(row, x)
+	}
+	return out
+}
+
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
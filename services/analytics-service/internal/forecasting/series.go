@@ -0,0 +1,86 @@
+package forecasting
+
+import (
+	"sort"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// Point is a single raw observation of a metric
+type Point struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// Series is a gap-free daily series starting at midnight UTC
+type Series struct {
+	Start  time.Time
+	Values []float64
+}
+
+// DailySeries averages raw points into UTC days and linearly interpolates days
+// without observations. It returns nil when there are no points.
+func DailySeries(points []Point) *Series {
+	if len(points) == 0 {
+		return nil
+	}
+
+	sorted := make([]Point, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Timestamp.Before(sorted[j].Timestamp) })
+
+	start := truncateDay(sorted[0].Timestamp)
+	days := int(truncateDay(sorted[len(sorted)-1].Timestamp).Sub(start)/day) + 1
+
+	sums := make([]float64, days)
+	counts := make([]int, days)
+	for _, p := range sorted {
+		i := int(truncateDay(p.Timestamp).Sub(start) / day)
+		sums[i] += p.Value
+		counts[i]++
+	}
+
+	values := make([]float64, days)
+	last := -1
+	for i := range values {
+		if counts[i] == 0 {
+			continue
+		}
+		values[i] = sums[i] / float64(counts[i])
+		if last >= 0 && i-last > 1 {
+			step := (values[i] - values[last]) / float64(i-last)
+			for j := last + 1; j < i; j++ {
+				values[j] = values[last] + step*float64(j-last)
+			}
+		}
+		last = i
+	}
+
+	return &Series{Start: start, Values: values}
+}
+
+// Len returns the number of days in the series
+func (s *Series) Len() int {
+	return len(s.Values)
+}
+
+// Time returns the day of the i-th value; i may run past the end of the series
+func (s *Series) Time(i int) time.Time {
+	return s.Start.AddDate(0, 0, i)
+}
+
+// End returns the day of the last value
+func (s *Series) End() time.Time {
+	return s.Time(len(s.Values) - 1)
+}
+
+// Slice returns the sub-series [from, to)
+func (s *Series) Slice(from, to int) *Series {
+	return &Series{Start: s.Time(from), Values: s.Values[from:to]}
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -2,15 +2,27 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
 	"reciprocal-clubs-backend/services/analytics-service/internal/service"
 	pb "reciprocal-clubs-backend/services/analytics-service/proto"
 )
@@ -20,6 +32,7 @@ type GRPCHandler struct {
 	service    service.AnalyticsService
 	logger     logging.Logger
 	monitoring *monitoring.Monitor
+	auth       auth.AuthProvider
 }
 
 func NewGRPCHandler(service service.AnalyticsService, logger logging.Logger, monitor *monitoring.Monitor) *GRPCHandler {
@@ -30,6 +43,12 @@ func NewGRPCHandler(service service.AnalyticsService, logger logging.Logger, mon
 	}
 }
 
+// SetAuthProvider requires every call to carry a bearer token and scopes it
+// to the token's club
+func (h *GRPCHandler) SetAuthProvider(provider auth.AuthProvider) {
+	h.auth = provider
+}
+
 func (h *GRPCHandler) RegisterServices(server *grpc.Server) {
 	pb.RegisterAnalyticsServiceServer(server, h)
 	h.logger.Info("gRPC services registered for analytics-service", map[string]interface{}{
@@ -271,18 +290,14 @@ func (h *GRPCHandler) GenerateReport(ctx context.Context, req *pb.GenerateReport
 	}
 
 	// Convert to protobuf format
-	data := make(map[string]string)
-	if reportData, ok := report["data"].(map[string]interface{}); ok {
-		for k, v := range reportData {
-			data[k] = fmt.Sprintf("%v", v)
-		}
-	}
-
+	reportData, _ := report["data"].(map[string]interface{})
+	reportID, _ := report["id"].(uint)
 	protoReport := &pb.AnalyticsReport{
+		Id:          uint32(reportID),
 		ClubId:      req.ClubId,
 		ReportType:  req.ReportType,
 		Title:       report["title"].(string),
-		Data:        data,
+		Data:        convertReportData(reportData),
 		GeneratedAt: timestamppb.New(report["generated_at"].(time.Time)),
 	}
 
@@ -290,10 +305,33 @@ func (h *GRPCHandler) GenerateReport(ctx context.Context, req *pb.GenerateReport
 		Success: true,
 		Message: "Report generated successfully",
 		Report:  protoReport,
-		JobId:   "sync-job", // Would be actual job ID for async
+		// Reports are generated synchronously; the job is the stored report
+		JobId: strconv.FormatUint(uint64(reportID), 10),
 	}, nil
 }
 
+// convertReportData flattens report data into strings, encoding tables and
+// other structured values as JSON
+func convertReportData(reportData map[string]interface{}) map[string]string {
+	data := make(map[string]string, len(reportData))
+	for k, v := range reportData {
+		switch value := v.(type) {
+		case string, bool, int, int64, uint, float64:
+			data[k] = fmt.Sprintf("%v", value)
+		case time.Time:
+			data[k] = value.Format(time.RFC3339)
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				data[k] = fmt.Sprintf("%v", v)
+				continue
+			}
+			data[k] = string(encoded)
+		}
+	}
+	return data
+}
+
 // System operations
 func (h *GRPCHandler) GetSystemHealth(ctx context.Context, req *emptypb.Empty) (*pb.SystemHealthResponse, error) {
 	h.logger.Info("gRPC GetSystemHealth called", nil)
@@ -384,6 +422,23 @@ func (h *GRPCHandler) convertReportTypeToString(reportType pb.ReportType) string
 	}
 }
 
+func (h *GRPCHandler) convertStringToReportType(reportType string) pb.ReportType {
+	switch reportType {
+	case "usage":
+		return pb.ReportType_REPORT_TYPE_USAGE
+	case "engagement":
+		return pb.ReportType_REPORT_TYPE_ENGAGEMENT
+	case "performance":
+		return pb.ReportType_REPORT_TYPE_PERFORMANCE
+	case "financial":
+		return pb.ReportType_REPORT_TYPE_FINANCIAL
+	case "custom":
+		return pb.ReportType_REPORT_TYPE_CUSTOM
+	default:
+		return pb.ReportType_REPORT_TYPE_UNSPECIFIED
+	}
+}
+
 // Real-time analytics methods
 func (h *GRPCHandler) GetRealtimeMetrics(ctx context.Context, req *pb.GetRealtimeMetricsRequest) (*pb.GetRealtimeMetricsResponse, error) {
 	h.logger.Info("gRPC GetRealtimeMetrics called", map[string]interface{}{
@@ -480,19 +535,67 @@ func (h *GRPCHandler) GetLiveStats(ctx context.Context, req *pb.GetLiveStatsRequ
 }
 
 // Report status and scheduling methods
+
+// GetReportStatus looks up a report by the job ID returned when it was
+// generated, which is the report's ID
 func (h *GRPCHandler) GetReportStatus(ctx context.Context, req *pb.GetReportStatusRequest) (*pb.GetReportStatusResponse, error) {
 	h.logger.Info("gRPC GetReportStatus called", map[string]interface{}{
 		"job_id": req.JobId,
 	})
 
-	// Mock implementation - in production would check actual job status
-	return &pb.GetReportStatusResponse{
-		Status:   "completed",
-		Message:  "Report generation completed successfully",
-		Progress: 100,
-	}, nil
+	id, err := strconv.ParseUint(req.JobId, 10, 32)
+	if err != nil || id == 0 {
+		return &pb.GetReportStatusResponse{
+			Status:  "not_found",
+			Message: "Unknown job ID",
+		}, nil
+	}
+
+	report, err := h.service.GetReport(uint(id))
+	if scoped, ok := ctx.Value(scopedClubKey).(string); ok && err == nil && report.ClubID != scoped {
+		err = repository.ErrNotFound
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return &pb.GetReportStatusResponse{
+			Status:  "not_found",
+			Message: "Unknown job ID",
+		}, nil
+	}
+	if err != nil {
+		h.logger.Error("Failed to get report", map[string]interface{}{
+			"error":  err.Error(),
+			"job_id": req.JobId,
+		})
+		return nil, err
+	}
+
+	status := report.Status
+	if status == "" {
+		status = repository.ReportStatusCompleted
+	}
+	response := &pb.GetReportStatusResponse{
+		Status:  status,
+		Message: "Report " + status,
+		Report: &pb.AnalyticsReport{
+			Id:          uint32(report.ID),
+			ClubId:      report.ClubID,
+			ReportType:  h.convertStringToReportType(report.ReportType),
+			Title:       report.Title,
+			Data:        convertReportData(report.Data),
+			GeneratedAt: timestamppb.New(report.GeneratedAt),
+			CreatedAt:   timestamppb.New(report.CreatedAt),
+			Status:      status,
+		},
+	}
+	if status != repository.ReportStatusFailed {
+		response.Progress = 100
+	}
+	return response, nil
 }
 
+// ScheduleReport creates a report schedule. The schedule is a five field cron
+// expression evaluated in the request's time zone (UTC by default), and the
+// report is emailed as a download link to email and the recipients.
 func (h *GRPCHandler) ScheduleReport(ctx context.Context, req *pb.ScheduleReportRequest) (*pb.ScheduleReportResponse, error) {
 	h.logger.Info("gRPC ScheduleReport called", map[string]interface{}{
 		"club_id":     req.ClubId,
@@ -505,14 +608,73 @@ func (h *GRPCHandler) ScheduleReport(ctx context.Context, req *pb.ScheduleReport
 		h.monitoring.RecordGRPCRequest("ScheduleReport", "success", time.Since(start))
 	}()
 
-	// Mock implementation - in production would schedule actual reports
-	scheduleID := fmt.Sprintf("schedule_%d", time.Now().Unix())
+	format, ok := convertExportFormat(req.Format)
+	if !ok {
+		return &pb.ScheduleReportResponse{
+			Success: false,
+			Message: "Unsupported report format: " + req.Format.String(),
+		}, nil
+	}
+
+	recipients := append([]string(nil), req.Recipients...)
+	if req.Email != "" {
+		recipients = append(recipients, req.Email)
+	}
+	reportType := h.convertReportTypeToString(req.ReportType)
+	name := req.Name
+	if name == "" {
+		name = reportType + " report"
+	}
+
+	schedule := &repository.ReportSchedule{
+		ClubID:     req.ClubId,
+		Name:       name,
+		Cron:       req.Schedule,
+		Timezone:   req.Timezone,
+		ReportType: reportType,
+		Parameters: req.Parameters,
+		Format:     format,
+		Recipients: recipients,
+		Enabled:    true,
+	}
+	if err := schedule.Validate(); err != nil {
+		return &pb.ScheduleReportResponse{
+			Success: false,
+			Message: "Invalid schedule: " + err.Error(),
+		}, nil
+	}
 
-	return &pb.ScheduleReportResponse{
+	if err := h.service.CreateReportSchedule(schedule); err != nil {
+		h.logger.Error("Failed to schedule report", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": req.ClubId,
+		})
+		return nil, err
+	}
+
+	response := &pb.ScheduleReportResponse{
 		Success:    true,
 		Message:    "Report scheduled successfully",
-		ScheduleId: scheduleID,
-	}, nil
+		ScheduleId: strconv.FormatUint(uint64(schedule.ID), 10),
+	}
+	if schedule.NextRunAt != nil {
+		response.NextRunAt = timestamppb.New(*schedule.NextRunAt)
+	}
+	return response, nil
+}
+
+// convertExportFormat maps an export format to a report format. PDF reports
+// are not supported.
+func convertExportFormat(format pb.ExportFormat) (string, bool) {
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, pb.ExportFormat_EXPORT_FORMAT_CSV:
+		return "csv", true
+	case pb.ExportFormat_EXPORT_FORMAT_EXCEL:
+		return "xlsx", true
+	case pb.ExportFormat_EXPORT_FORMAT_JSON:
+		return "json", true
+	}
+	return "", false
 }
 
 // Event management methods
@@ -689,3 +851,525 @@ func (h *GRPCHandler) GetServiceMetrics(ctx context.Context, req *emptypb.Empty)
 		Timestamp:  timestamppb.New(time.Now()),
 	}, nil
 }
+
+// Advanced analytics methods
+func (h *GRPCHandler) GetPredictiveAnalytics(ctx context.Context, req *pb.GetPredictiveAnalyticsRequest) (*pb.GetPredictiveAnalyticsResponse, error) {
+	h.logger.Info("gRPC GetPredictiveAnalytics called", map[string]interface{}{
+		"club_id":       req.ClubId,
+		"metric_name":   req.MetricName,
+		"forecast_days": req.ForecastDays,
+	})
+
+	start := time.Now()
+	defer func() {
+		h.monitoring.RecordGRPCRequest("GetPredictiveAnalytics", "success", time.Since(start))
+	}()
+
+	forecastDays := int(req.ForecastDays)
+	if forecastDays == 0 {
+		forecastDays = 30
+	}
+
+	// The model is fitted on the metric's full stored history, so
+	// historical_range is not used to narrow it
+	result, err := h.service.GetPredictiveAnalytics(req.ClubId, req.MetricName, forecastDays)
+	if err != nil {
+		h.logger.Error("Failed to get predictive analytics", map[string]interface{}{
+			"error":       err.Error(),
+			"club_id":     req.ClubId,
+			"metric_name": req.MetricName,
+		})
+		return nil, err
+	}
+
+	predictions := make([]*pb.PredictionDataPoint, 0)
+	if points, ok := result["predictions"].([]map[string]interface{}); ok {
+		for _, point := range points {
+			timestamp, _ := point["timestamp"].(time.Time)
+			value, _ := point["predicted_value"].(float64)
+			upper, _ := point["confidence_upper"].(float64)
+			lower, _ := point["confidence_lower"].(float64)
+			predictions = append(predictions, &pb.PredictionDataPoint{
+				Timestamp:       timestamppb.New(timestamp),
+				PredictedValue:  value,
+				ConfidenceUpper: upper,
+				ConfidenceLower: lower,
+			})
+		}
+	}
+
+	summary := &pb.PredictionSummary{}
+	if data, ok := result["summary"].(map[string]interface{}); ok {
+		summary.ModelType, _ = data["model_type"].(string)
+		summary.Accuracy, _ = data["accuracy"].(float64)
+		summary.ConfidenceLevel, _ = data["confidence_level"].(string)
+	}
+
+	return &pb.GetPredictiveAnalyticsResponse{
+		Predictions: predictions,
+		Summary:     summary,
+	}, nil
+}
+
+func (h *GRPCHandler) GetAnomalyDetection(ctx context.Context, req *pb.GetAnomalyDetectionRequest) (*pb.GetAnomalyDetectionResponse, error) {
+	h.logger.Info("gRPC GetAnomalyDetection called", map[string]interface{}{
+		"club_id":     req.ClubId,
+		"metric_name": req.MetricName,
+		"sensitivity": req.Sensitivity,
+	})
+
+	start := time.Now()
+	defer func() {
+		h.monitoring.RecordGRPCRequest("GetAnomalyDetection", "success", time.Since(start))
+	}()
+
+	// Without a range the last week is scanned
+	timeRange := repository.TimeRange{Start: start.Add(-7 * 24 * time.Hour), End: start}
+	if req.TimeRange != nil {
+		if req.TimeRange.Start != nil {
+			timeRange.Start = req.TimeRange.Start.AsTime()
+		}
+		if req.TimeRange.End != nil {
+			timeRange.End = req.TimeRange.End.AsTime()
+		}
+	}
+
+	result, err := h.service.GetAnomalyDetection(req.ClubId, req.MetricName, timeRange, req.Sensitivity)
+	if err != nil {
+		h.logger.Error("Failed to get anomaly detection", map[string]interface{}{
+			"error":       err.Error(),
+			"club_id":     req.ClubId,
+			"metric_name": req.MetricName,
+		})
+		return nil, err
+	}
+
+	anomalies := make([]*pb.AnomalyDataPoint, 0)
+	if points, ok := result["anomalies"].([]map[string]interface{}); ok {
+		for _, point := range points {
+			timestamp, _ := point["timestamp"].(time.Time)
+			value, _ := point["value"].(float64)
+			expected, _ := point["expected_value"].(float64)
+			score, _ := point["anomaly_score"].(float64)
+			severity, _ := point["severity"].(string)
+			anomalies = append(anomalies, &pb.AnomalyDataPoint{
+				Timestamp:     timestamppb.New(timestamp),
+				Value:         value,
+				ExpectedValue: expected,
+				AnomalyScore:  score,
+				Severity:      severity,
+			})
+		}
+	}
+
+	summary := &pb.AnomalySummary{}
+	if data, ok := result["summary"].(map[string]interface{}); ok {
+		total, _ := data["total_anomalies"].(int)
+		high, _ := data["high_severity"].(int)
+		medium, _ := data["medium_severity"].(int)
+		low, _ := data["low_severity"].(int)
+		summary.TotalAnomalies = int32(total)
+		summary.HighSeverity = int32(high)
+		summary.MediumSeverity = int32(medium)
+		summary.LowSeverity = int32(low)
+	}
+
+	return &pb.GetAnomalyDetectionResponse{
+		Anomalies: anomalies,
+		Summary:   summary,
+	}, nil
+}
+
+func (h *GRPCHandler) GetNetworkAnalytics(ctx context.Context, req *pb.GetNetworkAnalyticsRequest) (*pb.GetNetworkAnalyticsResponse, error) {
+	h.logger.Info("gRPC GetNetworkAnalytics called", map[string]interface{}{
+		"club_id": req.ClubId,
+	})
+
+	start := time.Now()
+	defer func() {
+		h.monitoring.RecordGRPCRequest("GetNetworkAnalytics", "success", time.Since(start))
+	}()
+
+	// Without a range the last 90 days are reported
+	timeRange := repository.TimeRange{Start: start.AddDate(0, 0, -90), End: start}
+	if req.TimeRange != nil {
+		if req.TimeRange.Start != nil {
+			timeRange.Start = req.TimeRange.Start.AsTime()
+		}
+		if req.TimeRange.End != nil {
+			timeRange.End = req.TimeRange.End.AsTime()
+		}
+	}
+
+	report, err := h.service.GetNetworkAnalytics(uint(req.ClubId), timeRange)
+	if err != nil {
+		h.logger.Error("Failed to get network analytics", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": req.ClubId,
+		})
+		return nil, err
+	}
+
+	response := &pb.GetNetworkAnalyticsResponse{
+		Outcomes:   convertVisitOutcomes(report.Outcomes),
+		Flows:      make([]*pb.ClubFlow, len(report.Flows)),
+		Agreements: make([]*pb.AgreementNetworkStats, len(report.Agreements)),
+		Clubs:      make([]*pb.ClubNetworkStats, len(report.Clubs)),
+	}
+	for i, flow := range report.Flows {
+		response.Flows[i] = &pb.ClubFlow{
+			HomeClubId:     uint32(flow.HomeClubID),
+			VisitingClubId: uint32(flow.VisitingClubID),
+			Visits:         int32(flow.Visits),
+			Members:        int32(flow.Members),
+			Requested:      int32(flow.Requested),
+		}
+	}
+	for i, agreement := range report.Agreements {
+		stats := &pb.AgreementNetworkStats{
+			AgreementId:       uint32(agreement.AgreementID),
+			ProposingClubId:   uint32(agreement.ProposingClubID),
+			TargetClubId:      uint32(agreement.TargetClubID),
+			Status:            agreement.Status,
+			Outbound:          int32(agreement.Outbound),
+			Inbound:           int32(agreement.Inbound),
+			Balance:           agreement.Balance,
+			MaxVisitsPerMonth: int32(agreement.MaxVisitsPerMonth),
+			Outcomes:          convertVisitOutcomes(agreement.Outcomes),
+			Trend:             make([]*pb.AgreementTrendPoint, len(agreement.Trend)),
+		}
+		if u := agreement.Utilization; u != nil {
+			stats.Utilization = &pb.AgreementUtilization{
+				MemberMonths:  int32(u.MemberMonths),
+				AverageVisits: u.AverageVisits,
+				Rate:          u.Rate,
+				AtLimit:       int32(u.AtLimit),
+			}
+		}
+		for j, point := range agreement.Trend {
+			stats.Trend[j] = &pb.AgreementTrendPoint{
+				Month:    timestamppb.New(point.Month),
+				Outbound: int32(point.Outbound),
+				Inbound:  int32(point.Inbound),
+			}
+		}
+		response.Agreements[i] = stats
+	}
+	for i, club := range report.Clubs {
+		stats := &pb.ClubNetworkStats{
+			ClubId:      uint32(club.ClubID),
+			Hosted:      convertVisitOutcomes(club.Hosted),
+			VisitsMade:  int32(club.VisitsMade),
+			Partners:    int32(club.Partners),
+			HostRating:  convertVisitRating(club.HostRating),
+			GuestRating: convertVisitRating(club.GuestRating),
+			Facilities:  make([]*pb.FacilityUsage, len(club.Facilities)),
+		}
+		for j, usage := range club.Facilities {
+			stats.Facilities[j] = &pb.FacilityUsage{Facility: usage.Facility, Visits: int32(usage.Visits)}
+		}
+		response.Clubs[i] = stats
+	}
+
+	return response, nil
+}
+
+func (h *GRPCHandler) GetCohortAnalysis(ctx context.Context, req *pb.GetCohortAnalysisRequest) (*pb.GetCohortAnalysisResponse, error) {
+	h.logger.Info("gRPC GetCohortAnalysis called", map[string]interface{}{
+		"club_id": req.ClubId,
+	})
+
+	start := time.Now()
+	defer func() {
+		h.monitoring.RecordGRPCRequest("GetCohortAnalysis", "success", time.Since(start))
+	}()
+
+	// Without a range members who joined in the last 12 months are followed
+	timeRange := repository.TimeRange{Start: start.AddDate(-1, 0, 0), End: start}
+	if req.TimeRange != nil {
+		if req.TimeRange.Start != nil {
+			timeRange.Start = req.TimeRange.Start.AsTime()
+		}
+		if req.TimeRange.End != nil {
+			timeRange.End = req.TimeRange.End.AsTime()
+		}
+	}
+	options := cohort.Options{
+		MaxMonths:     int(req.MaxMonths),
+		RenewalMonths: int(req.RenewalMonths),
+		RiskWindow:    time.Duration(req.RiskWindowDays) * 24 * time.Hour,
+		RiskLimit:     int(req.RiskLimit),
+	}
+
+	// Scoped callers who leave the club out get their own club's analysis
+	clubID := uint(req.ClubId)
+	if scoped, ok := ctx.Value(scopedClubKey).(string); ok && clubID == 0 {
+		if id, err := strconv.ParseUint(scoped, 10, 0); err == nil {
+			clubID = uint(id)
+		}
+	}
+
+	report, err := h.service.GetCohortAnalysis(clubID, timeRange, options)
+	if err != nil {
+		h.logger.Error("Failed to get cohort analysis", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	response := &pb.GetCohortAnalysisResponse{
+		Cohorts:        make([]*pb.MemberCohort, len(report.Cohorts)),
+		Churn:          make([]*pb.MembershipChurn, len(report.Churn)),
+		Survival:       make([]*pb.SurvivalCurve, len(report.Survival)),
+		AtRisk:         make([]*pb.AtRiskMember, len(report.AtRisk)),
+		RiskWindowDays: int32(report.RiskWindowDays),
+	}
+	for i, c := range report.Cohorts {
+		converted := &pb.MemberCohort{
+			Month:     timestamppb.New(c.Month),
+			Members:   int32(c.Members),
+			Retention: make([]*pb.RetentionCell, len(c.Retention)),
+		}
+		for j, cell := range c.Retention {
+			converted.Retention[j] = &pb.RetentionCell{
+				Month:    int32(cell.Month),
+				Eligible: int32(cell.Eligible),
+				Active:   int32(cell.Active),
+				Rate:     cell.Rate,
+			}
+		}
+		response.Cohorts[i] = converted
+	}
+	for i, churn := range report.Churn {
+		response.Churn[i] = &pb.MembershipChurn{
+			MembershipType: churn.MembershipType,
+			Active:         int32(churn.Active),
+			Exposed:        int32(churn.Exposed),
+			Churned:        int32(churn.Churned),
+			Rate:           churn.Rate,
+		}
+	}
+	for i, curve := range report.Survival {
+		converted := &pb.SurvivalCurve{
+			MembershipType: curve.MembershipType,
+			Members:        int32(curve.Members),
+			Points:         make([]*pb.SurvivalPoint, len(curve.Points)),
+		}
+		if curve.MedianMonths != nil {
+			converted.MedianMonths = int32(*curve.MedianMonths)
+		}
+		for j, point := range curve.Points {
+			converted.Points[j] = &pb.SurvivalPoint{
+				Month:    int32(point.Month),
+				AtRisk:   int32(point.AtRisk),
+				Churned:  int32(point.Churned),
+				Survival: point.Survival,
+			}
+		}
+		response.Survival[i] = converted
+	}
+
+	usage := report.UsageRenewal
+	response.UsageRenewal = &pb.UsageRenewal{
+		Months:  int32(usage.Months),
+		Members: int32(usage.Members),
+		Bands:   make([]*pb.UsageBand, len(usage.Bands)),
+	}
+	if usage.Correlation != nil {
+		response.UsageRenewal.Correlation = *usage.Correlation
+		response.UsageRenewal.HasCorrelation = true
+	}
+	for i, band := range usage.Bands {
+		response.UsageRenewal.Bands[i] = &pb.UsageBand{
+			Band:        band.Band,
+			Members:     int32(band.Members),
+			Renewed:     int32(band.Renewed),
+			RenewalRate: band.RenewalRate,
+		}
+	}
+
+	for i, member := range report.AtRisk {
+		converted := &pb.AtRiskMember{
+			MemberId:       uint32(member.MemberID),
+			MembershipType: member.MembershipType,
+			TenureMonths:   int32(member.TenureMonths),
+			Previous:       int32(member.Previous),
+			Recent:         int32(member.Recent),
+			Decline:        member.Decline,
+		}
+		if member.LastActivityAt != nil {
+			converted.LastActivityAt = timestamppb.New(*member.LastActivityAt)
+		}
+		response.AtRisk[i] = converted
+	}
+
+	return response, nil
+}
+
+func convertVisitOutcomes(outcomes network.Outcomes) *pb.VisitOutcomes {
+	return &pb.VisitOutcomes{
+		Visits:           int32(outcomes.Visits),
+		Open:             int32(outcomes.Open),
+		Attended:         int32(outcomes.Attended),
+		Cancelled:        int32(outcomes.Cancelled),
+		NoShows:          int32(outcomes.NoShows),
+		CancellationRate: outcomes.CancellationRate,
+		NoShowRate:       outcomes.NoShowRate,
+	}
+}
+
+func convertVisitRating(rating *network.Rating) *pb.VisitRating {
+	if rating == nil {
+		return nil
+	}
+	return &pb.VisitRating{Average: rating.Average, Count: int32(rating.Count)}
+}
+
+type contextKey string
+
+// scopedClubKey holds the club a scoped call is limited to
+const scopedClubKey contextKey = "scoped_club"
+
+// adminPermission is needed for the system RPCs
+const adminPermission = "system.admin"
+
+// serviceRole marks the tokens other services call with. Their calls are not
+// limited to a club.
+const serviceRole = "service"
+
+var systemMethods = map[string]bool{
+	"CleanupOldData":    true,
+	"GetSystemHealth":   true,
+	"GetServiceMetrics": true,
+}
+
+// ClubScopeInterceptor rejects calls without a valid bearer token and limits
+// the rest to the token's club: every club ID in the request must be that
+// club. Tokens with the service role may name any club. A network analytics
+// request without a club asks for the network-wide report, which is
+// anonymized.
+func (h *GRPCHandler) ClubScopeInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := h.scope(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// ClubScopeStreamInterceptor scopes streaming calls like
+// ClubScopeInterceptor, checking each request the client sends
+func (h *GRPCHandler) ClubScopeStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := h.scope(stream.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &scopedStream{ServerStream: stream, ctx: ctx, handler: h})
+}
+
+// scope authenticates a call and checks the clubs of its request, returning
+// the context the call is handled with
+func (h *GRPCHandler) scope(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	if h.auth == nil {
+		return ctx, nil
+	}
+
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			scheme, value, found := strings.Cut(values[0], " ")
+			if !found || !strings.EqualFold(scheme, "bearer") {
+				return nil, status.Error(codes.Unauthenticated, "invalid authorization metadata")
+			}
+			token = value
+		}
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "authorization required")
+	}
+
+	claims, err := h.auth.ValidateToken(token)
+	if err != nil {
+		h.logger.Warn("Authentication failed", map[string]interface{}{"error": err.Error(), "method": method})
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	if systemMethods[path.Base(method)] && !contains(claims.Permissions, adminPermission) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
+	}
+	if contains(claims.Roles, serviceRole) {
+		return ctx, nil
+	}
+
+	clubID := strconv.FormatUint(uint64(claims.ClubID), 10)
+	if err := h.checkClubs(clubID, method, req); err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, scopedClubKey, clubID), nil
+}
+
+func (h *GRPCHandler) checkClubs(clubID, method string, req interface{}) error {
+	for _, requested := range requestClubs(req) {
+		if requested != clubID {
+			h.logger.Warn("Access denied - wrong club", map[string]interface{}{
+				"user_club":      clubID,
+				"requested_club": requested,
+				"method":         method,
+			})
+			return status.Error(codes.PermissionDenied, "access denied")
+		}
+	}
+	return nil
+}
+
+// requestClubs lists the club IDs a request names
+func requestClubs(req interface{}) []string {
+	var clubs []string
+	switch r := req.(type) {
+	case interface{ GetClubId() string }:
+		if id := r.GetClubId(); id != "" {
+			clubs = append(clubs, id)
+		}
+	case interface{ GetClubId() uint32 }:
+		if id := r.GetClubId(); id != 0 {
+			clubs = append(clubs, strconv.FormatUint(uint64(id), 10))
+		}
+	}
+	if bulk, ok := req.(*pb.BulkRecordEventsRequest); ok {
+		for _, event := range bulk.Events {
+			clubs = append(clubs, event.GetClubId())
+		}
+	}
+	return clubs
+}
+
+// scopedStream checks the clubs of each request received on a scoped stream
+type scopedStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	handler *GRPCHandler
+}
+
+func (s *scopedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *scopedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	clubID, ok := s.ctx.Value(scopedClubKey).(string)
+	if !ok {
+		return nil
+	}
+	method, _ := grpc.Method(s.ctx)
+	return s.handler.checkClubs(clubID, method, m)
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -8,11 +8,26 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
+	analyticsmonitoring "reciprocal-clubs-backend/services/analytics-service/internal/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+	"reciprocal-clubs-backend/services/analytics-service/internal/service"
 	pb "reciprocal-clubs-backend/services/analytics-service/proto"
 )
 
@@ -61,6 +76,109 @@ func (m *MockAnalyticsService) GetEvents(clubID string, timeRange string) ([]map
 	return args.Get(0).([]map[string]interface{}), args.Error(1)
 }
 
+func (m *MockAnalyticsService) GetPredictiveAnalytics(clubID string, metricName string, forecastDays int) (map[string]interface{}, error) {
+	args := m.Called(clubID, metricName, forecastDays)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetAnomalyDetection(clubID string, metricName string, timeRange repository.TimeRange, sensitivity float64) (map[string]interface{}, error) {
+	args := m.Called(clubID, metricName, timeRange, sensitivity)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetAnomalySettings(clubID string, metricName string) (*repository.AnomalySetting, error) {
+	args := m.Called(clubID, metricName)
+	return args.Get(0).(*repository.AnomalySetting), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateAnomalySettings(setting *repository.AnomalySetting) error {
+	args := m.Called(setting)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) ListAnomalyEpisodes(clubID string, metricName string, status string, limit int) ([]*repository.AnomalyEpisode, error) {
+	args := m.Called(clubID, metricName, status, limit)
+	return args.Get(0).([]*repository.AnomalyEpisode), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ConfigureBackfill(config service.BackfillConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) BackfillFacts(sources []string, since time.Time) (map[string]int, error) {
+	args := m.Called(sources, since)
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+func (m *MockAnalyticsService) QueryMetrics(query *repository.RollupQuery) (*repository.RollupResult, error) {
+	args := m.Called(query)
+	return args.Get(0).(*repository.RollupResult), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetRetentionPolicy() (*repository.RetentionPolicy, error) {
+	args := m.Called()
+	return args.Get(0).(*repository.RetentionPolicy), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateRetentionPolicy(policy *repository.RetentionPolicy) error {
+	args := m.Called(policy)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) GetNetworkAnalytics(clubID uint, timeRange repository.TimeRange) (*network.Report, error) {
+	args := m.Called(clubID, timeRange)
+	return args.Get(0).(*network.Report), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetCohortAnalysis(clubID uint, timeRange repository.TimeRange, options cohort.Options) (*cohort.Report, error) {
+	args := m.Called(clubID, timeRange, options)
+	return args.Get(0).(*cohort.Report), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ConfigureReports(config service.ReportConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) CreateReportSchedule(schedule *repository.ReportSchedule) error {
+	args := m.Called(schedule)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) GetReportSchedule(id uint) (*repository.ReportSchedule, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.ReportSchedule), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ListReportSchedules(clubID string) ([]*repository.ReportSchedule, error) {
+	args := m.Called(clubID)
+	return args.Get(0).([]*repository.ReportSchedule), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateReportSchedule(schedule *repository.ReportSchedule) error {
+	args := m.Called(schedule)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) DeleteReportSchedule(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) RunReportSchedule(id uint) (*repository.AnalyticsReport, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.AnalyticsReport), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetReport(id uint) (*repository.AnalyticsReport, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.AnalyticsReport), args.Error(1)
+}
+
+func (m *MockAnalyticsService) DownloadReport(id uint, expires time.Time, signature string) (*repository.AnalyticsReport, []byte, error) {
+	args := m.Called(id, expires, signature)
+	return args.Get(0).(*repository.AnalyticsReport), args.Get(1).([]byte), args.Error(2)
+}
+
 func (m *MockAnalyticsService) CleanupOldData(days int) error {
 	args := m.Called(days)
 	return args.Error(0)
@@ -71,29 +189,114 @@ func (m *MockAnalyticsService) GetSystemHealth() map[string]interface{} {
 	return args.Get(0).(map[string]interface{})
 }
 
-func (m *MockAnalyticsService) ExportData(exportType string, data interface{}) error {
-	args := m.Called(exportType, data)
+func (m *MockAnalyticsService) ConfigureSinks(config service.SinkConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) ConfigureDashboards(config service.DashboardConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) CreateDashboard(d *repository.Dashboard) error {
+	args := m.Called(d)
 	return args.Error(0)
 }
 
-func (m *MockAnalyticsService) CreateDashboard(clubID string) error {
-	args := m.Called(clubID)
+func (m *MockAnalyticsService) GetDashboard(id uint, clubID string) (*repository.Dashboard, error) {
+	args := m.Called(id, clubID)
+	return args.Get(0).(*repository.Dashboard), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ListDashboards(clubID string, limit, offset int) ([]*repository.Dashboard, error) {
+	args := m.Called(clubID, limit, offset)
+	return args.Get(0).([]*repository.Dashboard), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateDashboard(d *repository.Dashboard) error {
+	args := m.Called(d)
 	return args.Error(0)
 }
 
-func (m *MockAnalyticsService) SendMetricsToExternal(metrics map[string]interface{}) error {
-	args := m.Called(metrics)
+func (m *MockAnalyticsService) DeleteDashboard(id uint, clubID string) error {
+	args := m.Called(id, clubID)
 	return args.Error(0)
 }
 
-func (m *MockAnalyticsService) GetHealthChecker() interface{} {
+func (m *MockAnalyticsService) RenderDashboard(id uint, clubID string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error) {
+	args := m.Called(id, clubID, timeRange)
+	return args.Get(0).(*dashboard.Rendered), args.Error(1)
+}
+
+func (m *MockAnalyticsService) IssueEmbedToken(id uint, clubID string, ttl time.Duration) (string, time.Time, error) {
+	args := m.Called(id, clubID, ttl)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *MockAnalyticsService) RevokeEmbedTokens(id uint, clubID string) error {
+	args := m.Called(id, clubID)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) RenderEmbeddedDashboard(token string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error) {
+	args := m.Called(token, timeRange)
+	return args.Get(0).(*dashboard.Rendered), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ExportDashboardToGrafana(id uint, clubID string) (map[string]interface{}, error) {
+	args := m.Called(id, clubID)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (m *MockAnalyticsService) PushDashboardToGrafana(id uint, clubID string) (*repository.Dashboard, error) {
+	args := m.Called(id, clubID)
+	return args.Get(0).(*repository.Dashboard), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetSinkStatus() ([]service.SinkStatus, error) {
+	args := m.Called()
+	return args.Get(0).([]service.SinkStatus), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ConfigurePrivacy(config service.PrivacyConfig) error {
+	args := m.Called(config)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) GetPrivacyPolicy(clubID string) (*service.PrivacyPolicy, error) {
+	args := m.Called(clubID)
+	return args.Get(0).(*service.PrivacyPolicy), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdatePrivacyPolicy(clubID string, policy *privacy.Policy) error {
+	args := m.Called(clubID, policy)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) RequestErasure(clubID, memberID string) (*repository.ErasureRequest, error) {
+	args := m.Called(clubID, memberID)
+	return args.Get(0).(*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetErasureRequest(id uint) (*repository.ErasureRequest, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ListErasureRequests(clubID string, limit int) ([]*repository.ErasureRequest, error) {
+	args := m.Called(clubID, limit)
+	return args.Get(0).([]*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetHealthChecker() *analyticsmonitoring.HealthChecker {
 	args := m.Called()
-	return args.Get(0)
+	checker, _ := args.Get(0).(*analyticsmonitoring.HealthChecker)
+	return checker
 }
 
-func (m *MockAnalyticsService) GetMonitoringMetrics() interface{} {
+func (m *MockAnalyticsService) GetMonitoringMetrics() *analyticsmonitoring.AnalyticsMetrics {
 	args := m.Called()
-	return args.Get(0)
+	metrics, _ := args.Get(0).(*analyticsmonitoring.AnalyticsMetrics)
+	return metrics
 }
 
 func (m *MockAnalyticsService) ProcessAnalyticsEvent(eventType string, data map[string]interface{}) error {
@@ -111,16 +314,6 @@ func (m *MockAnalyticsService) StopEventProcessor() error {
 	return args.Error(0)
 }
 
-// Mock health checker
-type MockHealthChecker struct {
-	mock.Mock
-}
-
-func (m *MockHealthChecker) HealthCheck(ctx context.Context) map[string]interface{} {
-	args := m.Called(ctx)
-	return args.Get(0).(map[string]interface{})
-}
-
 type GRPCHandlerTestSuite struct {
 	suite.Suite
 	mockService *MockAnalyticsService
@@ -132,7 +325,7 @@ func (suite *GRPCHandlerTestSuite) SetupTest() {
 	suite.mockService = new(MockAnalyticsService)
 	loggingConfig := &config.LoggingConfig{Level: "info", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-test")
-	monitor := monitoring.NewMonitor(monitoring.Config{ServiceName: "analytics-service-test"})
+	monitor := monitoring.NewMonitor(&config.MonitoringConfig{}, logger, "analytics-service-test", "test")
 
 	suite.handler = NewGRPCHandler(suite.mockService, logger, monitor)
 	suite.ctx = context.Background()
@@ -143,17 +336,12 @@ func (suite *GRPCHandlerTestSuite) TearDownTest() {
 }
 
 func (suite *GRPCHandlerTestSuite) TestHealth() {
-	mockHealthChecker := new(MockHealthChecker)
-	healthResponse := map[string]interface{}{
-		"status": "healthy",
-		"dependencies": map[string]interface{}{
-			"database": "healthy",
-			"nats":     "healthy",
-		},
-	}
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+	logger := logging.NewLogger(&config.LoggingConfig{Level: "error", Format: "console", Output: "stdout"}, "test")
+	healthChecker := analyticsmonitoring.NewHealthChecker(db, nil, logger)
 
-	suite.mockService.On("GetHealthChecker").Return(mockHealthChecker)
-	mockHealthChecker.On("HealthCheck", suite.ctx).Return(healthResponse)
+	suite.mockService.On("GetHealthChecker").Return(healthChecker)
 
 	req := &emptypb.Empty{}
 	resp, err := suite.handler.Health(suite.ctx, req)
@@ -162,6 +350,7 @@ func (suite *GRPCHandlerTestSuite) TestHealth() {
 	assert.NotNil(suite.T(), resp)
 	assert.Equal(suite.T(), "SERVING", resp.Status)
 	assert.Equal(suite.T(), "analytics-service", resp.Service)
+	assert.Equal(suite.T(), "healthy", resp.Dependencies["database"])
 }
 
 func (suite *GRPCHandlerTestSuite) TestGetMetrics() {
@@ -273,6 +462,7 @@ func (suite *GRPCHandlerTestSuite) TestGenerateReport() {
 	reportType := pb.ReportType_REPORT_TYPE_USAGE
 
 	mockReport := map[string]interface{}{
+		"id":           uint(12),
 		"title":        "Usage Report",
 		"data":         map[string]interface{}{"total_visits": 100},
 		"generated_at": time.Now(),
@@ -293,6 +483,7 @@ func (suite *GRPCHandlerTestSuite) TestGenerateReport() {
 	assert.Equal(suite.T(), "Report generated successfully", resp.Message)
 	assert.NotNil(suite.T(), resp.Report)
 	assert.Equal(suite.T(), clubID, resp.Report.ClubId)
+	assert.Equal(suite.T(), "12", resp.JobId)
 }
 
 func (suite *GRPCHandlerTestSuite) TestGetRealtimeMetrics() {
@@ -441,29 +632,57 @@ func (suite *GRPCHandlerTestSuite) TestCleanupOldData() {
 }
 
 func (suite *GRPCHandlerTestSuite) TestGetReportStatus() {
-	jobID := "job-123"
+	report := &repository.AnalyticsReport{
+		ID:          123,
+		ClubID:      "1",
+		ReportType:  "usage",
+		Title:       "Usage Report",
+		Data:        map[string]interface{}{"visits_made": 3, "tables": []interface{}{}},
+		Status:      repository.ReportStatusDelivered,
+		GeneratedAt: time.Now(),
+	}
+	suite.mockService.On("GetReport", uint(123)).Return(report, nil)
 
 	req := &pb.GetReportStatusRequest{
-		JobId: jobID,
+		JobId: "123",
 	}
 
 	resp, err := suite.handler.GetReportStatus(suite.ctx, req)
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), resp)
-	assert.Equal(suite.T(), "completed", resp.Status)
+	assert.Equal(suite.T(), "delivered", resp.Status)
 	assert.Equal(suite.T(), int32(100), resp.Progress)
+	assert.Equal(suite.T(), pb.ReportType_REPORT_TYPE_USAGE, resp.Report.ReportType)
+	assert.Equal(suite.T(), "3", resp.Report.Data["visits_made"])
+	assert.Equal(suite.T(), "[]", resp.Report.Data["tables"])
+
+	resp, err = suite.handler.GetReportStatus(suite.ctx, &pb.GetReportStatusRequest{JobId: "job-123"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "not_found", resp.Status)
 }
 
 func (suite *GRPCHandlerTestSuite) TestScheduleReport() {
-	clubID := "test-club-1"
+	clubID := "1"
 	reportType := pb.ReportType_REPORT_TYPE_USAGE
 	schedule := "0 9 * * *" // Daily at 9 AM
+	nextRunAt := time.Now().Add(time.Hour)
+
+	suite.mockService.On("CreateReportSchedule", mock.MatchedBy(func(s *repository.ReportSchedule) bool {
+		return s.ClubID == clubID && s.Format == "xlsx" && len(s.Recipients) == 2
+	})).Run(func(args mock.Arguments) {
+		s := args.Get(0).(*repository.ReportSchedule)
+		s.ID = 7
+		s.NextRunAt = &nextRunAt
+	}).Return(nil)
 
 	req := &pb.ScheduleReportRequest{
 		ClubId:     clubID,
 		ReportType: reportType,
 		Schedule:   schedule,
+		Format:     pb.ExportFormat_EXPORT_FORMAT_EXCEL,
+		Recipients: []string{"board@example.com"},
+		Email:      "manager@example.com",
 	}
 
 	resp, err := suite.handler.ScheduleReport(suite.ctx, req)
@@ -472,7 +691,13 @@ func (suite *GRPCHandlerTestSuite) TestScheduleReport() {
 	assert.NotNil(suite.T(), resp)
 	assert.True(suite.T(), resp.Success)
 	assert.Equal(suite.T(), "Report scheduled successfully", resp.Message)
-	assert.NotEmpty(suite.T(), resp.ScheduleId)
+	assert.Equal(suite.T(), "7", resp.ScheduleId)
+	assert.NotNil(suite.T(), resp.NextRunAt)
+
+	req.Format = pb.ExportFormat_EXPORT_FORMAT_PDF
+	resp, err = suite.handler.ScheduleReport(suite.ctx, req)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), resp.Success)
 }
 
 func (suite *GRPCHandlerTestSuite) TestGetServiceMetrics() {
@@ -507,6 +732,108 @@ func (suite *GRPCHandlerTestSuite) TestConvertReportTypeToString() {
 	}
 }
 
+// fakeAuth accepts the tokens it was given claims for
+type fakeAuth struct {
+	claims map[string]*auth.Claims
+}
+
+func (f *fakeAuth) GenerateToken(user *auth.User, expiration time.Duration) (string, error) {
+	return "", nil
+}
+
+func (f *fakeAuth) ValidateToken(token string) (*auth.Claims, error) {
+	if claims, ok := f.claims[token]; ok {
+		return claims, nil
+	}
+	return nil, assert.AnError
+}
+
+func (f *fakeAuth) RefreshToken(token string) (string, error) { return "", nil }
+func (f *fakeAuth) RevokeToken(token string) error            { return nil }
+
+func (suite *GRPCHandlerTestSuite) TestGetCohortAnalysis() {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeRange := repository.TimeRange{Start: start, End: start.AddDate(1, 0, 0)}
+	median, correlation := 6, 0.4
+	lastActivity := start.AddDate(0, 9, 0)
+	report := &cohort.Report{
+		ClubID:         1,
+		Cohorts:        []cohort.Cohort{{Month: start, Members: 2, Retention: []cohort.RetentionCell{{Month: 0, Eligible: 2, Active: 2, Rate: 1}}}},
+		Churn:          []cohort.TypeChurn{{MembershipType: cohort.AllTypes, Exposed: 4, Churned: 1, Rate: 0.25}},
+		Survival:       []cohort.SurvivalCurve{{MembershipType: cohort.AllTypes, Members: 4, MedianMonths: &median, Points: []cohort.SurvivalPoint{{Month: 1, AtRisk: 4, Survival: 1}}}},
+		UsageRenewal:   cohort.UsageRenewal{Months: 12, Members: 3, Bands: []cohort.UsageBand{{Band: "none", Members: 3, Renewed: 1, RenewalRate: 1.0 / 3}}, Correlation: &correlation},
+		AtRisk:         []cohort.AtRiskMember{{MemberID: 42, MembershipType: "VIP", Previous: 4, Decline: 1, LastActivityAt: &lastActivity}},
+		RiskWindowDays: 30,
+	}
+	options := cohort.Options{MaxMonths: 6, RiskWindow: 30 * 24 * time.Hour}
+	suite.mockService.On("GetCohortAnalysis", uint(1), timeRange, options).Return(report, nil).Once()
+
+	// A scoped caller without a club gets their own
+	ctx := context.WithValue(suite.ctx, scopedClubKey, "1")
+	resp, err := suite.handler.GetCohortAnalysis(ctx, &pb.GetCohortAnalysisRequest{
+		TimeRange:      &pb.TimeRange{Start: timestamppb.New(timeRange.Start), End: timestamppb.New(timeRange.End)},
+		MaxMonths:      6,
+		RiskWindowDays: 30,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Cohorts, 1)
+	assert.Equal(suite.T(), int32(2), resp.Cohorts[0].Retention[0].Active)
+	assert.Equal(suite.T(), 0.25, resp.Churn[0].Rate)
+	assert.Equal(suite.T(), int32(6), resp.Survival[0].MedianMonths)
+	assert.True(suite.T(), resp.UsageRenewal.HasCorrelation)
+	assert.Equal(suite.T(), 0.4, resp.UsageRenewal.Correlation)
+	assert.Equal(suite.T(), uint32(42), resp.AtRisk[0].MemberId)
+	assert.Equal(suite.T(), lastActivity, resp.AtRisk[0].LastActivityAt.AsTime())
+	assert.Equal(suite.T(), int32(30), resp.RiskWindowDays)
+}
+
+func (suite *GRPCHandlerTestSuite) TestClubScopeInterceptor() {
+	suite.handler.SetAuthProvider(&fakeAuth{claims: map[string]*auth.Claims{
+		"member":  {ClubID: 1},
+		"service": {Roles: []string{"service"}},
+	}})
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(suite.ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+	intercept := func(ctx context.Context, method string, req interface{}) (context.Context, error) {
+		var scoped context.Context
+		_, err := suite.handler.ClubScopeInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/analytics.AnalyticsService/" + method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				scoped = ctx
+				return nil, nil
+			})
+		return scoped, err
+	}
+
+	_, err := intercept(suite.ctx, "GetEvents", &pb.GetEventsRequest{ClubId: "1"})
+	assert.Equal(suite.T(), codes.Unauthenticated, status.Code(err))
+	_, err = intercept(withToken("forged"), "GetEvents", &pb.GetEventsRequest{ClubId: "1"})
+	assert.Equal(suite.T(), codes.Unauthenticated, status.Code(err))
+	_, err = intercept(withToken("member"), "GetEvents", &pb.GetEventsRequest{ClubId: "2"})
+	assert.Equal(suite.T(), codes.PermissionDenied, status.Code(err))
+	_, err = intercept(withToken("member"), "GetNetworkAnalytics", &pb.GetNetworkAnalyticsRequest{ClubId: 2})
+	assert.Equal(suite.T(), codes.PermissionDenied, status.Code(err))
+	_, err = intercept(withToken("member"), "BulkRecordEvents", &pb.BulkRecordEventsRequest{Events: []*pb.RecordEventRequest{{ClubId: "1"}, {ClubId: "2"}}})
+	assert.Equal(suite.T(), codes.PermissionDenied, status.Code(err))
+	_, err = intercept(withToken("member"), "CleanupOldData", &pb.CleanupOldDataRequest{Days: 30})
+	assert.Equal(suite.T(), codes.PermissionDenied, status.Code(err))
+
+	ctx, err := intercept(withToken("member"), "GetEvents", &pb.GetEventsRequest{ClubId: "1"})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "1", ctx.Value(scopedClubKey))
+
+	// Network-wide reports are open to every club
+	_, err = intercept(withToken("member"), "GetNetworkAnalytics", &pb.GetNetworkAnalyticsRequest{})
+	assert.NoError(suite.T(), err)
+
+	// Other services may name any club, but not call the system RPCs
+	ctx, err = intercept(withToken("service"), "GetEvents", &pb.GetEventsRequest{ClubId: "2"})
+	suite.Require().NoError(err)
+	assert.Nil(suite.T(), ctx.Value(scopedClubKey))
+	_, err = intercept(withToken("service"), "CleanupOldData", &pb.CleanupOldDataRequest{Days: 30})
+	assert.Equal(suite.T(), codes.PermissionDenied, status.Code(err))
+}
+
 func TestGRPCHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(GRPCHandlerTestSuite))
 }
@@ -516,7 +843,7 @@ func TestGRPCHandlerErrorHandling(t *testing.T) {
 	mockService := new(MockAnalyticsService)
 	loggingConfig := &config.LoggingConfig{Level: "info", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-test")
-	monitor := monitoring.NewMonitor(monitoring.Config{ServiceName: "test"})
+	monitor := monitoring.NewMonitor(&config.MonitoringConfig{}, logger, "test", "test")
 	handler := NewGRPCHandler(mockService, logger, monitor)
 	ctx := context.Background()
 
@@ -559,7 +886,7 @@ func BenchmarkGRPCHandler_GetMetrics(b *testing.B) {
 	mockService := new(MockAnalyticsService)
 	loggingConfig := &config.LoggingConfig{Level: "error", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-bench")
-	monitor := monitoring.NewMonitor(monitoring.Config{ServiceName: "test"})
+	monitor := monitoring.NewMonitor(&config.MonitoringConfig{}, logger, "test", "test")
 	handler := NewGRPCHandler(mockService, logger, monitor)
 	ctx := context.Background()
 
@@ -585,7 +912,7 @@ func BenchmarkGRPCHandler_RecordEvent(b *testing.B) {
 	mockService := new(MockAnalyticsService)
 	loggingConfig := &config.LoggingConfig{Level: "error", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-bench")
-	monitor := monitoring.NewMonitor(monitoring.Config{ServiceName: "test"})
+	monitor := monitoring.NewMonitor(&config.MonitoringConfig{}, logger, "test", "test")
 	handler := NewGRPCHandler(mockService, logger, monitor)
 	ctx := context.Background()
 
@@ -1,12 +1,26 @@
 package http
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
+	"reciprocal-clubs-backend/services/analytics-service/internal/forecasting"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
+	"reciprocal-clubs-backend/services/analytics-service/internal/reporting"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
 	"reciprocal-clubs-backend/services/analytics-service/internal/service"
 
 	"github.com/gorilla/mux"
@@ -17,6 +31,7 @@ type HTTPHandler struct {
 	service    service.AnalyticsService
 	logger     logging.Logger
 	monitoring *monitoring.Monitor
+	auth       auth.AuthProvider
 }
 
 func NewHTTPHandler(service service.AnalyticsService, logger logging.Logger, monitor *monitoring.Monitor) *HTTPHandler {
@@ -27,6 +42,11 @@ func NewHTTPHandler(service service.AnalyticsService, logger logging.Logger, mon
 	}
 }
 
+// SetAuthProvider scopes API requests to the club of the caller's token
+func (h *HTTPHandler) SetAuthProvider(provider auth.AuthProvider) {
+	h.auth = provider
+}
+
 func (h *HTTPHandler) SetupRoutes() http.Handler {
 	router := mux.NewRouter()
 
@@ -44,8 +64,17 @@ func (h *HTTPHandler) SetupRoutes() http.Handler {
 	// Core analytics
 	api.HandleFunc("/analytics/metrics", h.GetMetrics).Methods("GET")
 	api.HandleFunc("/analytics/metrics", h.RecordMetric).Methods("POST")
+	api.HandleFunc("/analytics/metrics/query", h.QueryMetrics).Methods("GET")
 	api.HandleFunc("/analytics/reports", h.GetReports).Methods("GET")
 	api.HandleFunc("/analytics/reports/generate", h.GenerateReport).Methods("POST")
+	api.HandleFunc("/analytics/reports/schedules", h.ListReportSchedules).Methods("GET")
+	api.HandleFunc("/analytics/reports/schedules", h.CreateReportSchedule).Methods("POST")
+	api.HandleFunc("/analytics/reports/schedules/{id:[0-9]+}", h.GetReportSchedule).Methods("GET")
+	api.HandleFunc("/analytics/reports/schedules/{id:[0-9]+}", h.UpdateReportSchedule).Methods("PUT")
+	api.HandleFunc("/analytics/reports/schedules/{id:[0-9]+}", h.DeleteReportSchedule).Methods("DELETE")
+	api.HandleFunc("/analytics/reports/schedules/{id:[0-9]+}/run", h.RunReportSchedule).Methods("POST")
+	api.HandleFunc("/analytics/reports/{id:[0-9]+}", h.GetReport).Methods("GET")
+	api.HandleFunc("/analytics/reports/{id:[0-9]+}/download", h.DownloadReport).Methods("GET")
 	api.HandleFunc("/analytics/events", h.GetEvents).Methods("GET")
 	api.HandleFunc("/analytics/events", h.RecordEvent).Methods("POST")
 	api.HandleFunc("/analytics/events/bulk", h.BulkRecordEvents).Methods("POST")
@@ -54,12 +83,31 @@ func (h *HTTPHandler) SetupRoutes() http.Handler {
 	api.HandleFunc("/analytics/realtime/metrics", h.GetRealtimeMetrics).Methods("GET")
 	api.HandleFunc("/analytics/live/stats", h.GetLiveStats).Methods("GET")
 
+	// Advanced analytics
+	api.HandleFunc("/analytics/predictions", h.GetPredictiveAnalytics).Methods("GET")
+	api.HandleFunc("/analytics/anomalies", h.GetAnomalyDetection).Methods("GET")
+	api.HandleFunc("/analytics/anomalies/settings", h.GetAnomalySettings).Methods("GET")
+	api.HandleFunc("/analytics/anomalies/settings", h.UpdateAnomalySettings).Methods("PUT")
+	api.HandleFunc("/analytics/anomalies/episodes", h.ListAnomalyEpisodes).Methods("GET")
+
+	// Reciprocal network analytics
+	api.HandleFunc("/analytics/network", h.GetNetworkAnalytics).Methods("GET")
+
+	// Membership cohorts
+	api.HandleFunc("/analytics/cohorts", h.GetCohortAnalysis).Methods("GET")
+
 	// Dashboard operations
 	api.HandleFunc("/analytics/dashboards", h.ListDashboards).Methods("GET")
 	api.HandleFunc("/analytics/dashboards", h.CreateDashboard).Methods("POST")
-	api.HandleFunc("/analytics/dashboards/{id}", h.GetDashboard).Methods("GET")
-	api.HandleFunc("/analytics/dashboards/{id}", h.UpdateDashboard).Methods("PUT")
-	api.HandleFunc("/analytics/dashboards/{id}", h.DeleteDashboard).Methods("DELETE")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}", h.GetDashboard).Methods("GET")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}", h.UpdateDashboard).Methods("PUT")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}", h.DeleteDashboard).Methods("DELETE")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}/data", h.GetDashboardData).Methods("GET")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}/embed", h.CreateEmbedToken).Methods("POST")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}/embed", h.RevokeEmbedTokens).Methods("DELETE")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}/grafana", h.ExportDashboardToGrafana).Methods("GET")
+	api.HandleFunc("/analytics/dashboards/{id:[0-9]+}/grafana", h.PushDashboardToGrafana).Methods("POST")
+	api.HandleFunc("/analytics/embed/{token}", h.GetEmbeddedDashboard).Methods("GET")
 
 	// Data export
 	api.HandleFunc("/analytics/export/events", h.ExportEvents).Methods("GET")
@@ -69,8 +117,21 @@ func (h *HTTPHandler) SetupRoutes() http.Handler {
 	// System operations
 	api.HandleFunc("/analytics/system/health", h.GetSystemHealth).Methods("GET")
 	api.HandleFunc("/analytics/system/cleanup", h.CleanupOldData).Methods("POST")
+	api.HandleFunc("/analytics/system/backfill", h.BackfillFacts).Methods("POST")
+	api.HandleFunc("/analytics/system/retention", h.GetRetentionPolicy).Methods("GET")
+	api.HandleFunc("/analytics/system/retention", h.UpdateRetentionPolicy).Methods("PUT")
+	api.HandleFunc("/analytics/system/sinks", h.GetSinkStatus).Methods("GET")
+
+	// Privacy
+	api.HandleFunc("/analytics/privacy/policy", h.GetPrivacyPolicy).Methods("GET")
+	api.HandleFunc("/analytics/privacy/policy", h.UpdatePrivacyPolicy).Methods("PUT")
+	api.HandleFunc("/analytics/privacy/policy", h.ResetPrivacyPolicy).Methods("DELETE")
+	api.HandleFunc("/analytics/privacy/erasures", h.ListErasureRequests).Methods("GET")
+	api.HandleFunc("/analytics/privacy/erasures", h.RequestErasure).Methods("POST")
+	api.HandleFunc("/analytics/privacy/erasures/{id:[0-9]+}", h.GetErasureRequest).Methods("GET")
 
 	// Add middleware
+	api.Use(h.ClubScopeMiddleware)
 	router.Use(h.LoggingMiddleware)
 	router.Use(h.MonitoringMiddleware)
 
@@ -177,7 +238,7 @@ func (h *HTTPHandler) RecordEvent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.RecordEvent(event); err != nil {
-		h.logger.Error("Failed to record event", map[string]interface{}{"error": err.Error(), "event": event})
+		h.logger.Error("Failed to record event", map[string]interface{}{"error": err.Error(), "event_type": event["event_type"]})
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
@@ -264,6 +325,11 @@ func (h *HTTPHandler) GenerateReport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if reportRequest.ClubID == "" || !repository.ValidReportType(reportRequest.ReportType) {
+		http.Error(w, "club_id and a supported report_type are required", http.StatusBadRequest)
+		return
+	}
+
 	report, err := h.service.GenerateReport(reportRequest.ClubID, reportRequest.ReportType)
 	if err != nil {
 		h.logger.Error("Failed to generate report", map[string]interface{}{"error": err.Error()})
@@ -352,70 +418,400 @@ func (h *HTTPHandler) GetLiveStats(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(stats)
 }
 
-func (h *HTTPHandler) ListDashboards(w http.ResponseWriter, r *http.Request) {
+func (h *HTTPHandler) GetPredictiveAnalytics(w http.ResponseWriter, r *http.Request) {
+	clubID := r.URL.Query().Get("club_id")
+	metricName := r.URL.Query().Get("metric_name")
+
+	forecastDays := 30
+	if days := r.URL.Query().Get("forecast_days"); days != "" {
+		parsed, err := strconv.Atoi(days)
+		if err != nil {
+			http.Error(w, "Invalid forecast_days", http.StatusBadRequest)
+			return
+		}
+		forecastDays = parsed
+	}
+
+	predictions, err := h.service.GetPredictiveAnalytics(clubID, metricName, forecastDays)
+	if err != nil {
+		h.logger.Error("Failed to get predictive analytics", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		if errors.Is(err, forecasting.ErrInsufficientData) {
+			http.Error(w, "Not enough history to forecast this metric", http.StatusUnprocessableEntity)
+			return
+		}
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(predictions)
+}
+
+// GetAnomalyDetection scans start..end (RFC 3339, defaulting to the last week).
+// A sensitivity between 0 and 1 overrides the metric's stored setting.
+func (h *HTTPHandler) GetAnomalyDetection(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	clubID := query.Get("club_id")
+	metricName := query.Get("metric_name")
+
+	now := time.Now()
+	timeRange := repository.TimeRange{Start: now.Add(-7 * 24 * time.Hour), End: now}
+	for param, target := range map[string]*time.Time{"start": &timeRange.Start, "end": &timeRange.End} {
+		if value := query.Get(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, "Invalid "+param, http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+	}
+
+	var sensitivity float64
+	if value := query.Get("sensitivity"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 || parsed > 1 {
+			http.Error(w, "Invalid sensitivity", http.StatusBadRequest)
+			return
+		}
+		sensitivity = parsed
+	}
+
+	anomalies, err := h.service.GetAnomalyDetection(clubID, metricName, timeRange, sensitivity)
+	if err != nil {
+		h.logger.Error("Failed to get anomaly detection", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(anomalies)
+}
+
+func (h *HTTPHandler) GetAnomalySettings(w http.ResponseWriter, r *http.Request) {
 	clubID := r.URL.Query().Get("club_id")
+	metricName := r.URL.Query().Get("metric_name")
+	if clubID == "" || metricName == "" {
+		http.Error(w, "club_id and metric_name are required", http.StatusBadRequest)
+		return
+	}
+
+	setting, err := h.service.GetAnomalySettings(clubID, metricName)
+	if err != nil {
+		h.logger.Error("Failed to get anomaly settings", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(setting)
+}
+
+func (h *HTTPHandler) UpdateAnomalySettings(w http.ResponseWriter, r *http.Request) {
+	var setting repository.AnomalySetting
+	if err := json.NewDecoder(r.Body).Decode(&setting); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if err := setting.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	// Mock implementation - would integrate with service layer
-	dashboards := []map[string]interface{}{
-		{
-			"id":          1,
-			"name":        "Analytics Dashboard",
-			"description": "Main analytics dashboard",
-			"club_id":     clubID,
-			"is_public":   true,
-		},
+	if err := h.service.UpdateAnomalySettings(&setting); err != nil {
+		h.logger.Error("Failed to update anomaly settings", map[string]interface{}{"error": err.Error(), "club_id": setting.ClubID, "metric_name": setting.MetricName})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"dashboards": dashboards,
-		"total":      len(dashboards),
+		"success": true,
+		"setting": setting,
 	})
 }
 
-func (h *HTTPHandler) CreateDashboard(w http.ResponseWriter, r *http.Request) {
-	var dashboardRequest struct {
-		ClubID      string                 `json:"club_id"`
-		Name        string                 `json:"name"`
-		Description string                 `json:"description"`
-		Panels      map[string]interface{} `json:"panels"`
-		IsPublic    bool                   `json:"is_public"`
+func (h *HTTPHandler) ListAnomalyEpisodes(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	clubID := query.Get("club_id")
+	status := query.Get("status")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+	if status != "" && status != repository.EpisodeOpen && status != repository.EpisodeResolved {
+		http.Error(w, "Invalid status", http.StatusBadRequest)
+		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&dashboardRequest); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+	limit := 50
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	episodes, err := h.service.ListAnomalyEpisodes(clubID, query.Get("metric_name"), status, limit)
+	if err != nil {
+		h.logger.Error("Failed to list anomaly episodes", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"episodes": episodes,
+		"count":    len(episodes),
+	})
+}
+
+// GetNetworkAnalytics reports on reciprocal visits scheduled in start..end
+// (RFC 3339, defaulting to the last 90 days). Without a club_id it covers the
+// whole network.
+func (h *HTTPHandler) GetNetworkAnalytics(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var clubID uint
+	if value := query.Get("club_id"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 0)
+		if err != nil {
+			http.Error(w, "Invalid club_id", http.StatusBadRequest)
+			return
+		}
+		clubID = uint(parsed)
+	}
+
+	now := time.Now()
+	timeRange := repository.TimeRange{Start: now.AddDate(0, 0, -90), End: now}
+	for param, target := range map[string]*time.Time{"start": &timeRange.Start, "end": &timeRange.End} {
+		if value := query.Get(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, "Invalid "+param, http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+	}
+	if !timeRange.End.After(timeRange.Start) {
+		http.Error(w, "end must be after start", http.StatusBadRequest)
 		return
 	}
 
-	err := h.service.CreateDashboard(dashboardRequest.ClubID)
+	report, err := h.service.GetNetworkAnalytics(clubID, timeRange)
 	if err != nil {
-		h.logger.Error("Failed to create dashboard", map[string]interface{}{"error": err.Error()})
+		h.logger.Error("Failed to get network analytics", map[string]interface{}{"error": err.Error(), "club_id": clubID})
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	response := map[string]interface{}{
-		"success":    true,
-		"message":    "Dashboard created successfully",
-		"dashboard": dashboardRequest,
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+// GetCohortAnalysis follows a club's members who joined in start..end
+// (RFC 3339, defaulting to the last 12 months) up to end. max_months,
+// renewal_months, risk_window_days and risk_limit tune the report.
+func (h *HTTPHandler) GetCohortAnalysis(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	clubID, err := strconv.ParseUint(query.Get("club_id"), 10, 0)
+	if err != nil || clubID == 0 {
+		http.Error(w, "Invalid club_id", http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	timeRange := repository.TimeRange{Start: now.AddDate(-1, 0, 0), End: now}
+	for param, target := range map[string]*time.Time{"start": &timeRange.Start, "end": &timeRange.End} {
+		if value := query.Get(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, "Invalid "+param, http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+	}
+	if !timeRange.End.After(timeRange.Start) {
+		http.Error(w, "end must be after start", http.StatusBadRequest)
+		return
+	}
+
+	var options cohort.Options
+	var riskWindowDays int
+	for param, target := range map[string]*int{
+		"max_months":       &options.MaxMonths,
+		"renewal_months":   &options.RenewalMonths,
+		"risk_window_days": &riskWindowDays,
+		"risk_limit":       &options.RiskLimit,
+	} {
+		if value := query.Get(param); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed <= 0 {
+				http.Error(w, "Invalid "+param, http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+	}
+	options.RiskWindow = time.Duration(riskWindowDays) * 24 * time.Hour
+	if err := options.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.service.GetCohortAnalysis(uint(clubID), timeRange, options)
+	if err != nil {
+		h.logger.Error("Failed to get cohort analysis", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+// QueryMetrics buckets a metric, or an event type's count with source=event,
+// over start..end (RFC 3339, defaulting to the last day). group_by takes a
+// comma-separated list of tags and tag.<name>=<value> filters the series.
+func (h *HTTPHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	now := time.Now()
+	query := repository.RollupQuery{
+		ClubID: params.Get("club_id"),
+		Source: params.Get("source"),
+		Name:   params.Get("name"),
+		Start:  now.Add(-24 * time.Hour),
+		End:    now,
+		Bucket: params.Get("bucket"),
+		Fill:   params.Get("fill"),
+		Tags:   map[string]string{},
+	}
+	if query.Bucket == "" {
+		query.Bucket = "1h"
+	}
+	for param, target := range map[string]*time.Time{"start": &query.Start, "end": &query.End} {
+		if value := params.Get(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, "Invalid "+param, http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+	}
+	if value := params.Get("group_by"); value != "" {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				query.GroupBy = append(query.GroupBy, tag)
+			}
+		}
+	}
+	for param, values := range params {
+		if tag := strings.TrimPrefix(param, "tag."); tag != param && tag != "" {
+			query.Tags[tag] = values[0]
+		}
+	}
+
+	if err := query.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.QueryMetrics(&query)
+	if err != nil {
+		h.logger.Error("Failed to query metrics", map[string]interface{}{"error": err.Error(), "club_id": query.ClubID, "name": query.Name})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+func (h *HTTPHandler) ListDashboards(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	clubID := query.Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+
+	limit, offset := 50, 0
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+	if value := query.Get("offset"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			http.Error(w, "Invalid offset", http.StatusBadRequest)
+			return
+		}
+		offset = parsed
+	}
+
+	dashboards, err := h.service.ListDashboards(clubID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to list dashboards", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"dashboards": dashboards,
+		"total":      len(dashboards),
+	})
+}
+
+// CreateDashboard stores a dashboard definition. Panels are validated and
+// given defaults, so the response shows the dashboard as it will render.
+func (h *HTTPHandler) CreateDashboard(w http.ResponseWriter, r *http.Request) {
+	var dashboard repository.Dashboard
+	if err := json.NewDecoder(r.Body).Decode(&dashboard); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if err := dashboard.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.CreateDashboard(&dashboard); err != nil {
+		h.logger.Error("Failed to create dashboard", map[string]interface{}{"error": err.Error(), "club_id": dashboard.ClubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":   true,
+		"message":   "Dashboard created successfully",
+		"dashboard": dashboard,
+	})
 }
 
 func (h *HTTPHandler) GetDashboard(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	dashboardID := vars["id"]
+	id, clubID, ok := dashboardParams(w, r)
+	if !ok {
+		return
+	}
 
-	// Mock implementation
-	dashboard := map[string]interface{}{
-		"id":          dashboardID,
-		"name":        "Analytics Dashboard",
-		"description": "Main analytics dashboard",
-		"panels":      map[string]interface{}{},
-		"is_public":   true,
+	dashboard, err := h.service.GetDashboard(id, clubID)
+	if err != nil {
+		h.dashboardError(w, "Failed to get dashboard", id, err)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -423,33 +819,45 @@ func (h *HTTPHandler) GetDashboard(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *HTTPHandler) UpdateDashboard(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	dashboardID := vars["id"]
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
 
-	var updateRequest map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&updateRequest); err != nil {
+	var dashboard repository.Dashboard
+	if err := json.NewDecoder(r.Body).Decode(&dashboard); err != nil {
 		http.Error(w, "Invalid JSON", http.StatusBadRequest)
 		return
 	}
+	dashboard.ID = id
+	if err := dashboard.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	// Mock implementation
-	updateRequest["id"] = dashboardID
-	updateRequest["updated_at"] = time.Now()
+	if err := h.service.UpdateDashboard(&dashboard); err != nil {
+		h.dashboardError(w, "Failed to update dashboard", id, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success":   true,
 		"message":   "Dashboard updated successfully",
-		"dashboard": updateRequest,
+		"dashboard": dashboard,
 	})
 }
 
 func (h *HTTPHandler) DeleteDashboard(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	dashboardID := vars["id"]
+	id, clubID, ok := dashboardParams(w, r)
+	if !ok {
+		return
+	}
 
-	// Mock implementation
-	h.logger.Info("Dashboard deleted", map[string]interface{}{"dashboard_id": dashboardID})
+	if err := h.service.DeleteDashboard(id, clubID); err != nil {
+		h.dashboardError(w, "Failed to delete dashboard", id, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
@@ -458,6 +866,142 @@ func (h *HTTPHandler) DeleteDashboard(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetDashboardData evaluates every panel of a dashboard. The optional from and
+// to parameters replace the dashboard's time range, for example from=now-7d.
+func (h *HTTPHandler) GetDashboardData(w http.ResponseWriter, r *http.Request) {
+	id, clubID, ok := dashboardParams(w, r)
+	if !ok {
+		return
+	}
+	timeRange, ok := dashboardTimeRange(w, r)
+	if !ok {
+		return
+	}
+
+	rendered, err := h.service.RenderDashboard(id, clubID, timeRange)
+	if err != nil {
+		h.dashboardError(w, "Failed to render dashboard", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rendered)
+}
+
+// CreateEmbedToken issues a signed token that lets anyone holding it view the
+// dashboard's data. The optional ttl is a duration such as 720h.
+func (h *HTTPHandler) CreateEmbedToken(w http.ResponseWriter, r *http.Request) {
+	id, clubID, ok := dashboardParams(w, r)
+	if !ok {
+		return
+	}
+	var ttl time.Duration
+	if value := r.URL.Query().Get("ttl"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "Invalid ttl", http.StatusBadRequest)
+			return
+		}
+		ttl = parsed
+	}
+
+	token, expiresAt, err := h.service.IssueEmbedToken(id, clubID, ttl)
+	if err != nil {
+		h.dashboardError(w, "Failed to issue embed token", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":    true,
+		"token":      token,
+		"expires_at": expiresAt,
+		"url":        "/api/v1/analytics/embed/" + token,
+	})
+}
+
+// RevokeEmbedTokens invalidates every embed token issued for a dashboard
+func (h *HTTPHandler) RevokeEmbedTokens(w http.ResponseWriter, r *http.Request) {
+	id, clubID, ok := dashboardParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RevokeEmbedTokens(id, clubID); err != nil {
+		h.dashboardError(w, "Failed to revoke embed tokens", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+	})
+}
+
+// GetEmbeddedDashboard serves the data of the dashboard an embed token grants
+func (h *HTTPHandler) GetEmbeddedDashboard(w http.ResponseWriter, r *http.Request) {
+	timeRange, ok := dashboardTimeRange(w, r)
+	if !ok {
+		return
+	}
+
+	rendered, err := h.service.RenderEmbeddedDashboard(mux.Vars(r)["token"], timeRange)
+	switch {
+	case errors.Is(err, dashboard.ErrEmbedToken), errors.Is(err, dashboard.ErrEmbedTokenExpired):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case err != nil:
+		h.dashboardError(w, "Failed to render embedded dashboard", 0, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rendered)
+}
+
+// ExportDashboardToGrafana returns the dashboard as a Grafana dashboard model
+// that can be imported into any Grafana with the analytics database as a
+// PostgreSQL data source
+func (h *HTTPHandler) ExportDashboardToGrafana(w http.ResponseWriter, r *http.Request) {
+	id, clubID, ok := dashboardParams(w, r)
+	if !ok {
+		return
+	}
+
+	model, err := h.service.ExportDashboardToGrafana(id, clubID)
+	if err != nil {
+		h.dashboardError(w, "Failed to export dashboard", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"dashboard": model,
+	})
+}
+
+// PushDashboardToGrafana exports the dashboard to the configured Grafana and
+// keeps it updated there
+func (h *HTTPHandler) PushDashboardToGrafana(w http.ResponseWriter, r *http.Request) {
+	id, clubID, ok := dashboardParams(w, r)
+	if !ok {
+		return
+	}
+
+	dashboard, err := h.service.PushDashboardToGrafana(id, clubID)
+	if err != nil {
+		h.dashboardError(w, "Failed to push dashboard to Grafana", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":   true,
+		"dashboard": dashboard,
+	})
+}
+
 func (h *HTTPHandler) ExportEvents(w http.ResponseWriter, r *http.Request) {
 	clubID := r.URL.Query().Get("club_id")
 	format := r.URL.Query().Get("format")
@@ -591,3 +1135,679 @@ func (h *HTTPHandler) CleanupOldData(w http.ResponseWriter, r *http.Request) {
 		"message": "Old data cleanup completed successfully",
 	})
 }
+
+// BackfillFacts rebuilds domain facts from the source services' tables. An
+// empty sources list backfills every source; since limits it to rows updated
+// after that time.
+func (h *HTTPHandler) BackfillFacts(w http.ResponseWriter, r *http.Request) {
+	var backfillRequest struct {
+		Sources []string  `json:"sources"`
+		Since   time.Time `json:"since"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&backfillRequest); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	for _, source := range backfillRequest.Sources {
+		if !knownBackfillSource(source) {
+			http.Error(w, "Unknown source: "+source, http.StatusBadRequest)
+			return
+		}
+	}
+
+	rows, err := h.service.BackfillFacts(backfillRequest.Sources, backfillRequest.Since)
+	if err != nil {
+		h.logger.Error("Failed to backfill facts", map[string]interface{}{"error": err.Error(), "sources": backfillRequest.Sources})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"rows":    rows,
+	})
+}
+
+func knownBackfillSource(source string) bool {
+	for _, known := range repository.BackfillSources {
+		if source == known {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *HTTPHandler) GetRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	policy, err := h.service.GetRetentionPolicy()
+	if err != nil {
+		h.logger.Error("Failed to get retention policy", map[string]interface{}{"error": err.Error()})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(policy)
+}
+
+// GetSinkStatus reports the lag and health of each export sink
+func (h *HTTPHandler) GetSinkStatus(w http.ResponseWriter, r *http.Request) {
+	statuses, err := h.service.GetSinkStatus()
+	if err != nil {
+		h.logger.Error("Failed to get export sink status", map[string]interface{}{"error": err.Error()})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"sinks": statuses})
+}
+
+// UpdateRetentionPolicy replaces the retention policy. Days of zero keep a
+// tier forever.
+func (h *HTTPHandler) UpdateRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	var policy repository.RetentionPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if err := policy.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.UpdateRetentionPolicy(&policy); err != nil {
+		h.logger.Error("Failed to update retention policy", map[string]interface{}{"error": err.Error()})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"policy":  policy,
+	})
+}
+
+func (h *HTTPHandler) ListReportSchedules(w http.ResponseWriter, r *http.Request) {
+	clubID := r.URL.Query().Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+
+	schedules, err := h.service.ListReportSchedules(clubID)
+	if err != nil {
+		h.logger.Error("Failed to list report schedules", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"schedules": schedules,
+		"count":     len(schedules),
+	})
+}
+
+// CreateReportSchedule stores a schedule that generates a report whenever its
+// cron expression fires and emails the recipients a download link
+func (h *HTTPHandler) CreateReportSchedule(w http.ResponseWriter, r *http.Request) {
+	var schedule repository.ReportSchedule
+	if err := json.NewDecoder(r.Body).Decode(&schedule); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if err := schedule.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.CreateReportSchedule(&schedule); err != nil {
+		h.logger.Error("Failed to create report schedule", map[string]interface{}{"error": err.Error(), "club_id": schedule.ClubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":  true,
+		"schedule": schedule,
+	})
+}
+
+func (h *HTTPHandler) GetReportSchedule(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.service.GetReportSchedule(id)
+	if err != nil {
+		h.reportError(w, "Failed to get report schedule", id, err)
+		return
+	}
+	if !inScope(w, r, schedule.ClubID) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(schedule)
+}
+
+// UpdateReportSchedule replaces a schedule's settings and recomputes its next
+// run
+func (h *HTTPHandler) UpdateReportSchedule(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok || !h.scheduleInScope(w, r, id) {
+		return
+	}
+
+	var schedule repository.ReportSchedule
+	if err := json.NewDecoder(r.Body).Decode(&schedule); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	schedule.ID = id
+	if err := schedule.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.UpdateReportSchedule(&schedule); err != nil {
+		h.reportError(w, "Failed to update report schedule", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":  true,
+		"schedule": schedule,
+	})
+}
+
+func (h *HTTPHandler) DeleteReportSchedule(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok || !h.scheduleInScope(w, r, id) {
+		return
+	}
+
+	if err := h.service.DeleteReportSchedule(id); err != nil {
+		h.reportError(w, "Failed to delete report schedule", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+	})
+}
+
+// RunReportSchedule generates and delivers a schedule's report immediately
+func (h *HTTPHandler) RunReportSchedule(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok || !h.scheduleInScope(w, r, id) {
+		return
+	}
+
+	report, err := h.service.RunReportSchedule(id)
+	if err != nil && report == nil {
+		h.reportError(w, "Failed to run report schedule", id, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": err == nil,
+		"report":  report,
+	}
+	if err != nil {
+		// The report was generated but could not be stored or delivered
+		response["error"] = err.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *HTTPHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.service.GetReport(id)
+	if err != nil {
+		h.reportError(w, "Failed to get report", id, err)
+		return
+	}
+	if !inScope(w, r, report.ClubID) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+// DownloadReport serves a report's file to holders of a signed link. The link
+// carries its expiry (unix seconds) and signature as query parameters.
+func (h *HTTPHandler) DownloadReport(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || query.Get("signature") == "" {
+		http.Error(w, "expires and signature are required", http.StatusBadRequest)
+		return
+	}
+
+	report, data, err := h.service.DownloadReport(id, time.Unix(expires, 0), query.Get("signature"))
+	switch {
+	case errors.Is(err, reporting.ErrLinkExpired), errors.Is(err, reporting.ErrLinkSignature):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case err != nil:
+		h.reportError(w, "Failed to download report", id, err)
+		return
+	}
+
+	extension := "bin"
+	if format, err := reporting.ParseFormat(report.Format); err == nil {
+		extension = string(format)
+	}
+	w.Header().Set("Content-Type", report.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-report-%d.%s"`, report.ReportType, report.ID, extension))
+	w.Write(data)
+}
+
+// pathID parses the numeric id route variable
+func pathID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil || id == 0 {
+		http.Error(w, "Invalid id", http.StatusBadRequest)
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// scheduleInScope checks that a report schedule belongs to the caller's club
+// before it is changed or run
+func (h *HTTPHandler) scheduleInScope(w http.ResponseWriter, r *http.Request, id uint) bool {
+	if _, scoped := scopedClub(r); !scoped {
+		return true
+	}
+	schedule, err := h.service.GetReportSchedule(id)
+	if err != nil {
+		h.reportError(w, "Failed to get report schedule", id, err)
+		return false
+	}
+	return inScope(w, r, schedule.ClubID)
+}
+
+// reportError maps errors from the report endpoints to responses
+func (h *HTTPHandler) reportError(w http.ResponseWriter, message string, id uint, err error) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		http.Error(w, "Not found", http.StatusNotFound)
+	case errors.Is(err, service.ErrReportsNotConfigured):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	default:
+		h.logger.Error(message, map[string]interface{}{"error": err.Error(), "id": id})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// dashboardParams parses the dashboard id and the club_id of the caller
+func dashboardParams(w http.ResponseWriter, r *http.Request) (uint, string, bool) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return 0, "", false
+	}
+	clubID := r.URL.Query().Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return 0, "", false
+	}
+	return id, clubID, true
+}
+
+// dashboardTimeRange parses the optional from and to query parameters
+func dashboardTimeRange(w http.ResponseWriter, r *http.Request) (dashboard.TimeRange, bool) {
+	query := r.URL.Query()
+	timeRange := dashboard.TimeRange{From: query.Get("from"), To: query.Get("to")}
+	if _, _, err := timeRange.Resolve(time.Now()); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return dashboard.TimeRange{}, false
+	}
+	return timeRange, true
+}
+
+// dashboardError maps errors from the dashboard endpoints to responses
+func (h *HTTPHandler) dashboardError(w http.ResponseWriter, message string, id uint, err error) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		http.Error(w, "Not found", http.StatusNotFound)
+	case errors.Is(err, service.ErrDashboardNotOwned):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, service.ErrEmbedNotConfigured), errors.Is(err, service.ErrGrafanaNotConfigured):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	default:
+		h.logger.Error(message, map[string]interface{}{"error": err.Error(), "id": id})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// GetPrivacyPolicy returns the policy the club's event data is transformed by
+func (h *HTTPHandler) GetPrivacyPolicy(w http.ResponseWriter, r *http.Request) {
+	clubID := r.URL.Query().Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+
+	policy, err := h.service.GetPrivacyPolicy(clubID)
+	if err != nil {
+		h.logger.Error("Failed to get privacy policy", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(policy)
+}
+
+// UpdatePrivacyPolicy replaces the club's policy for events recorded from now
+// on. Events already stored keep the transformation they were recorded with.
+func (h *HTTPHandler) UpdatePrivacyPolicy(w http.ResponseWriter, r *http.Request) {
+	clubID := r.URL.Query().Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+
+	var policy privacy.Policy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if err := policy.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.UpdatePrivacyPolicy(clubID, &policy); err != nil {
+		h.logger.Error("Failed to update privacy policy", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"policy":  policy,
+	})
+}
+
+// ResetPrivacyPolicy puts the club back on the default policy
+func (h *HTTPHandler) ResetPrivacyPolicy(w http.ResponseWriter, r *http.Request) {
+	clubID := r.URL.Query().Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.UpdatePrivacyPolicy(clubID, nil); err != nil {
+		h.logger.Error("Failed to reset privacy policy", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+	})
+}
+
+// RequestErasure erases a member's analytics data. The database is erased
+// before the response; the request stays pending until the export sinks that
+// support erasure have erased their copies.
+func (h *HTTPHandler) RequestErasure(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		ClubID   string `json:"club_id"`
+		MemberID string `json:"member_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if body.ClubID == "" || body.MemberID == "" {
+		http.Error(w, "club_id and member_id are required", http.StatusBadRequest)
+		return
+	}
+
+	request, err := h.service.RequestErasure(body.ClubID, body.MemberID)
+	if err != nil {
+		h.logger.Error("Failed to erase member data", map[string]interface{}{"error": err.Error(), "club_id": body.ClubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(request)
+}
+
+func (h *HTTPHandler) ListErasureRequests(w http.ResponseWriter, r *http.Request) {
+	clubID := r.URL.Query().Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	requests, err := h.service.ListErasureRequests(clubID, limit)
+	if err != nil {
+		h.logger.Error("Failed to list erasure requests", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"erasures": requests,
+		"count":    len(requests),
+	})
+}
+
+func (h *HTTPHandler) GetErasureRequest(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	clubID := r.URL.Query().Get("club_id")
+	if clubID == "" {
+		http.Error(w, "club_id is required", http.StatusBadRequest)
+		return
+	}
+
+	request, err := h.service.GetErasureRequest(id)
+	if err == nil && request.ClubID != clubID {
+		err = repository.ErrNotFound
+	}
+	if err != nil {
+		h.reportError(w, "Failed to get erasure request", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(request)
+}
+
+type contextKey string
+
+// scopedClubKey holds the club a scoped request is limited to
+const scopedClubKey contextKey = "scoped_club"
+
+const (
+	// adminPermission is needed for the system endpoints once requests are
+	// scoped
+	adminPermission = "system.admin"
+	networkRoute    = "/api/v1/analytics/network"
+	// maxScopedBody bounds the request bodies read to check their club
+	maxScopedBody = 32 << 20
+)
+
+// unscopedRoutes are authorized by their own signed tokens
+var unscopedRoutes = map[string]bool{
+	"/api/v1/analytics/embed/{token}":                true,
+	"/api/v1/analytics/reports/{id:[0-9]+}/download": true,
+}
+
+// ClubScopeMiddleware limits API requests to the club of the caller's bearer
+// token once an auth provider is set. A club_id in the query or a JSON body
+// must be the caller's club, and a missing club_id query parameter defaults
+// to it, except on the network endpoint where leaving it out asks for the
+// network-wide report, which is anonymized. System endpoints also need the
+// system.admin permission.
+func (h *HTTPHandler) ClubScopeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.auth == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		template := ""
+		if route := mux.CurrentRoute(r); route != nil {
+			template, _ = route.GetPathTemplate()
+		}
+		if unscopedRoutes[template] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, ok := h.authenticate(w, r)
+		if !ok {
+			return
+		}
+		if strings.HasPrefix(template, "/api/v1/analytics/system/") && !hasPermission(claims.Permissions, adminPermission) {
+			http.Error(w, "Insufficient permissions", http.StatusForbidden)
+			return
+		}
+
+		clubID := strconv.FormatUint(uint64(claims.ClubID), 10)
+		query := r.URL.Query()
+		requested := query.Get("club_id")
+		if requested != "" && requested != clubID {
+			h.denyClub(w, r, claims, requested)
+			return
+		}
+		if requested == "" && template != networkRoute {
+			query.Set("club_id", clubID)
+			r.URL.RawQuery = query.Encode()
+		}
+
+		if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPut) {
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxScopedBody))
+			if err != nil {
+				http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			if other, ok := bodyClub(body, clubID); !ok {
+				h.denyClub(w, r, claims, other)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), scopedClubKey, clubID)))
+	})
+}
+
+// authenticate validates the request's bearer token, writing the error
+// response when it is missing or invalid
+func (h *HTTPHandler) authenticate(w http.ResponseWriter, r *http.Request) (*auth.Claims, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "bearer") || token == "" {
+		http.Error(w, "Authorization header required", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	claims, err := h.auth.ValidateToken(token)
+	if err != nil {
+		h.logger.Warn("Authentication failed", map[string]interface{}{"error": err.Error(), "path": r.URL.Path})
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return nil, false
+	}
+	return claims, true
+}
+
+func (h *HTTPHandler) denyClub(w http.ResponseWriter, r *http.Request, claims *auth.Claims, requested string) {
+	h.logger.Warn("Access denied - wrong club", map[string]interface{}{
+		"user_id":        claims.UserID,
+		"user_club":      claims.ClubID,
+		"requested_club": requested,
+		"path":           r.URL.Path,
+	})
+	http.Error(w, "Access denied", http.StatusForbidden)
+}
+
+// bodyClub checks the club_id of a JSON body, and of each of its events for
+// bulk requests, against the caller's club. It returns the first other club
+// found. Bodies that are not JSON objects are left to the handler to reject.
+func bodyClub(body []byte, clubID string) (string, bool) {
+	var document map[string]interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return "", true
+	}
+
+	documents := []map[string]interface{}{document}
+	if events, ok := document["events"].([]interface{}); ok {
+		for _, event := range events {
+			if event, ok := event.(map[string]interface{}); ok {
+				documents = append(documents, event)
+			}
+		}
+	}
+	for _, doc := range documents {
+		value, ok := doc["club_id"]
+		if !ok || value == nil {
+			continue
+		}
+		other := fmt.Sprintf("%v", value)
+		if number, ok := value.(float64); ok {
+			other = strconv.FormatFloat(number, 'f', -1, 64)
+		}
+		if other != clubID {
+			return other, false
+		}
+	}
+	return "", true
+}
+
+// scopedClub returns the club a scoped request is limited to
+func scopedClub(r *http.Request) (string, bool) {
+	clubID, ok := r.Context().Value(scopedClubKey).(string)
+	return clubID, ok
+}
+
+// inScope reports whether a club's resource may be served to the caller,
+// answering not found when it may not so other clubs' resources are not
+// revealed to exist
+func inScope(w http.ResponseWriter, r *http.Request, clubID string) bool {
+	if scoped, ok := scopedClub(r); ok && scoped != clubID {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return false
+	}
+	return true
+}
+
+func hasPermission(permissions []string, permission string) bool {
+	for _, candidate := range permissions {
+		if candidate == permission {
+			return true
+		}
+	}
+	return false
+}
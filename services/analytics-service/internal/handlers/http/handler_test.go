@@ -6,14 +6,23 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
+	analyticsmonitoring "reciprocal-clubs-backend/services/analytics-service/internal/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+	"reciprocal-clubs-backend/services/analytics-service/internal/service"
 )
 
 // Mock service for testing
@@ -61,6 +70,109 @@ func (m *MockAnalyticsService) GetEvents(clubID string, timeRange string) ([]map
 	return args.Get(0).([]map[string]interface{}), args.Error(1)
 }
 
+func (m *MockAnalyticsService) GetPredictiveAnalytics(clubID string, metricName string, forecastDays int) (map[string]interface{}, error) {
+	args := m.Called(clubID, metricName, forecastDays)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetAnomalyDetection(clubID string, metricName string, timeRange repository.TimeRange, sensitivity float64) (map[string]interface{}, error) {
+	args := m.Called(clubID, metricName, timeRange, sensitivity)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetAnomalySettings(clubID string, metricName string) (*repository.AnomalySetting, error) {
+	args := m.Called(clubID, metricName)
+	return args.Get(0).(*repository.AnomalySetting), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateAnomalySettings(setting *repository.AnomalySetting) error {
+	args := m.Called(setting)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) ListAnomalyEpisodes(clubID string, metricName string, status string, limit int) ([]*repository.AnomalyEpisode, error) {
+	args := m.Called(clubID, metricName, status, limit)
+	return args.Get(0).([]*repository.AnomalyEpisode), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ConfigureBackfill(config service.BackfillConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) BackfillFacts(sources []string, since time.Time) (map[string]int, error) {
+	args := m.Called(sources, since)
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+func (m *MockAnalyticsService) QueryMetrics(query *repository.RollupQuery) (*repository.RollupResult, error) {
+	args := m.Called(query)
+	return args.Get(0).(*repository.RollupResult), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetRetentionPolicy() (*repository.RetentionPolicy, error) {
+	args := m.Called()
+	return args.Get(0).(*repository.RetentionPolicy), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateRetentionPolicy(policy *repository.RetentionPolicy) error {
+	args := m.Called(policy)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) GetNetworkAnalytics(clubID uint, timeRange repository.TimeRange) (*network.Report, error) {
+	args := m.Called(clubID, timeRange)
+	return args.Get(0).(*network.Report), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetCohortAnalysis(clubID uint, timeRange repository.TimeRange, options cohort.Options) (*cohort.Report, error) {
+	args := m.Called(clubID, timeRange, options)
+	return args.Get(0).(*cohort.Report), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ConfigureReports(config service.ReportConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) CreateReportSchedule(schedule *repository.ReportSchedule) error {
+	args := m.Called(schedule)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) GetReportSchedule(id uint) (*repository.ReportSchedule, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.ReportSchedule), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ListReportSchedules(clubID string) ([]*repository.ReportSchedule, error) {
+	args := m.Called(clubID)
+	return args.Get(0).([]*repository.ReportSchedule), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateReportSchedule(schedule *repository.ReportSchedule) error {
+	args := m.Called(schedule)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) DeleteReportSchedule(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) RunReportSchedule(id uint) (*repository.AnalyticsReport, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.AnalyticsReport), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetReport(id uint) (*repository.AnalyticsReport, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.AnalyticsReport), args.Error(1)
+}
+
+func (m *MockAnalyticsService) DownloadReport(id uint, expires time.Time, signature string) (*repository.AnalyticsReport, []byte, error) {
+	args := m.Called(id, expires, signature)
+	return args.Get(0).(*repository.AnalyticsReport), args.Get(1).([]byte), args.Error(2)
+}
+
 func (m *MockAnalyticsService) CleanupOldData(days int) error {
 	args := m.Called(days)
 	return args.Error(0)
@@ -71,29 +183,114 @@ func (m *MockAnalyticsService) GetSystemHealth() map[string]interface{} {
 	return args.Get(0).(map[string]interface{})
 }
 
-func (m *MockAnalyticsService) ExportData(exportType string, data interface{}) error {
-	args := m.Called(exportType, data)
+func (m *MockAnalyticsService) ConfigureSinks(config service.SinkConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) ConfigureDashboards(config service.DashboardConfig) {
+	m.Called(config)
+}
+
+func (m *MockAnalyticsService) CreateDashboard(d *repository.Dashboard) error {
+	args := m.Called(d)
 	return args.Error(0)
 }
 
-func (m *MockAnalyticsService) CreateDashboard(clubID string) error {
-	args := m.Called(clubID)
+func (m *MockAnalyticsService) GetDashboard(id uint, clubID string) (*repository.Dashboard, error) {
+	args := m.Called(id, clubID)
+	return args.Get(0).(*repository.Dashboard), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ListDashboards(clubID string, limit, offset int) ([]*repository.Dashboard, error) {
+	args := m.Called(clubID, limit, offset)
+	return args.Get(0).([]*repository.Dashboard), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdateDashboard(d *repository.Dashboard) error {
+	args := m.Called(d)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) DeleteDashboard(id uint, clubID string) error {
+	args := m.Called(id, clubID)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) RenderDashboard(id uint, clubID string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error) {
+	args := m.Called(id, clubID, timeRange)
+	return args.Get(0).(*dashboard.Rendered), args.Error(1)
+}
+
+func (m *MockAnalyticsService) IssueEmbedToken(id uint, clubID string, ttl time.Duration) (string, time.Time, error) {
+	args := m.Called(id, clubID, ttl)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *MockAnalyticsService) RevokeEmbedTokens(id uint, clubID string) error {
+	args := m.Called(id, clubID)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) RenderEmbeddedDashboard(token string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error) {
+	args := m.Called(token, timeRange)
+	return args.Get(0).(*dashboard.Rendered), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ExportDashboardToGrafana(id uint, clubID string) (map[string]interface{}, error) {
+	args := m.Called(id, clubID)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (m *MockAnalyticsService) PushDashboardToGrafana(id uint, clubID string) (*repository.Dashboard, error) {
+	args := m.Called(id, clubID)
+	return args.Get(0).(*repository.Dashboard), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetSinkStatus() ([]service.SinkStatus, error) {
+	args := m.Called()
+	return args.Get(0).([]service.SinkStatus), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ConfigurePrivacy(config service.PrivacyConfig) error {
+	args := m.Called(config)
 	return args.Error(0)
 }
 
-func (m *MockAnalyticsService) SendMetricsToExternal(metrics map[string]interface{}) error {
-	args := m.Called(metrics)
+func (m *MockAnalyticsService) GetPrivacyPolicy(clubID string) (*service.PrivacyPolicy, error) {
+	args := m.Called(clubID)
+	return args.Get(0).(*service.PrivacyPolicy), args.Error(1)
+}
+
+func (m *MockAnalyticsService) UpdatePrivacyPolicy(clubID string, policy *privacy.Policy) error {
+	args := m.Called(clubID, policy)
 	return args.Error(0)
 }
 
-func (m *MockAnalyticsService) GetHealthChecker() interface{} {
+func (m *MockAnalyticsService) RequestErasure(clubID, memberID string) (*repository.ErasureRequest, error) {
+	args := m.Called(clubID, memberID)
+	return args.Get(0).(*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetErasureRequest(id uint) (*repository.ErasureRequest, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockAnalyticsService) ListErasureRequests(clubID string, limit int) ([]*repository.ErasureRequest, error) {
+	args := m.Called(clubID, limit)
+	return args.Get(0).([]*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetHealthChecker() *analyticsmonitoring.HealthChecker {
 	args := m.Called()
-	return args.Get(0)
+	checker, _ := args.Get(0).(*analyticsmonitoring.HealthChecker)
+	return checker
 }
 
-func (m *MockAnalyticsService) GetMonitoringMetrics() interface{} {
+func (m *MockAnalyticsService) GetMonitoringMetrics() *analyticsmonitoring.AnalyticsMetrics {
 	args := m.Called()
-	return args.Get(0)
+	metrics, _ := args.Get(0).(*analyticsmonitoring.AnalyticsMetrics)
+	return metrics
 }
 
 func (m *MockAnalyticsService) ProcessAnalyticsEvent(eventType string, data map[string]interface{}) error {
@@ -134,6 +331,9 @@ func (suite *HTTPHandlerTestSuite) SetupTest() {
 
 	suite.handler = NewHTTPHandler(suite.mockService, logger, monitor)
 	suite.router = suite.handler.SetupRoutes()
+
+	// The metrics middleware looks the collectors up on every request
+	suite.mockService.On("GetMonitoringMetrics").Return(nil).Maybe()
 }
 
 func (suite *HTTPHandlerTestSuite) TearDownTest() {
@@ -379,6 +579,104 @@ func (suite *HTTPHandlerTestSuite) TestMiddleware() {
 	// If we got a response, middleware was applied
 }
 
+// fakeAuth accepts the tokens it was given claims for
+type fakeAuth struct {
+	claims map[string]*auth.Claims
+}
+
+func (f *fakeAuth) GenerateToken(user *auth.User, expiration time.Duration) (string, error) {
+	return "", nil
+}
+
+func (f *fakeAuth) ValidateToken(token string) (*auth.Claims, error) {
+	if claims, ok := f.claims[token]; ok {
+		return claims, nil
+	}
+	return nil, assert.AnError
+}
+
+func (f *fakeAuth) RefreshToken(token string) (string, error) { return "", nil }
+func (f *fakeAuth) RevokeToken(token string) error            { return nil }
+
+func (suite *HTTPHandlerTestSuite) TestClubScope() {
+	suite.handler.SetAuthProvider(&fakeAuth{claims: map[string]*auth.Claims{
+		"member": {ClubID: 1},
+		"admin":  {ClubID: 1, Permissions: []string{"system.admin"}},
+	}})
+	router := suite.handler.SetupRoutes()
+	serve := func(method, target, token string, body interface{}) int {
+		reader := bytes.NewBuffer(nil)
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, target, reader)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	assert.Equal(suite.T(), http.StatusUnauthorized, serve("GET", "/api/v1/analytics/privacy/policy?club_id=1", "", nil))
+	assert.Equal(suite.T(), http.StatusUnauthorized, serve("GET", "/api/v1/analytics/privacy/policy?club_id=1", "forged", nil))
+	assert.Equal(suite.T(), http.StatusForbidden, serve("GET", "/api/v1/analytics/privacy/policy?club_id=2", "member", nil))
+	assert.Equal(suite.T(), http.StatusForbidden, serve("POST", "/api/v1/analytics/privacy/erasures", "member", map[string]string{"club_id": "2", "member_id": "42"}))
+	assert.Equal(suite.T(), http.StatusForbidden, serve("POST", "/api/v1/analytics/system/cleanup", "member", nil))
+
+	// A missing club defaults to the caller's
+	suite.mockService.On("GetPrivacyPolicy", "1").Return(&service.PrivacyPolicy{ClubID: "1", Default: true}, nil).Once()
+	assert.Equal(suite.T(), http.StatusOK, serve("GET", "/api/v1/analytics/privacy/policy", "member", nil))
+
+	suite.mockService.On("RequestErasure", "1", "42").Return(&repository.ErasureRequest{ID: 3, ClubID: "1", Status: repository.ErasureStatusCompleted}, nil).Once()
+	assert.Equal(suite.T(), http.StatusCreated, serve("POST", "/api/v1/analytics/privacy/erasures", "member", map[string]string{"club_id": "1", "member_id": "42"}))
+
+	// Another club's erasure request is not found
+	suite.mockService.On("GetErasureRequest", uint(4)).Return(&repository.ErasureRequest{ID: 4, ClubID: "2"}, nil).Once()
+	assert.Equal(suite.T(), http.StatusNotFound, serve("GET", "/api/v1/analytics/privacy/erasures/4", "member", nil))
+}
+
+func (suite *HTTPHandlerTestSuite) TestUpdatePrivacyPolicyValidation() {
+	body := bytes.NewBufferString(`{"rules":[{"field":"club_id","action":"drop"}]}`)
+	req, _ := http.NewRequest("PUT", "/api/v1/analytics/privacy/policy?club_id=1", body)
+	rr := httptest.NewRecorder()
+	suite.router.ServeHTTP(rr, req)
+	assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+
+	policy := privacy.Policy{Rules: []privacy.Rule{{Field: "notes", Action: privacy.ActionKeep}}}
+	suite.mockService.On("UpdatePrivacyPolicy", "1", &policy).Return(nil).Once()
+	data, _ := json.Marshal(policy)
+	req, _ = http.NewRequest("PUT", "/api/v1/analytics/privacy/policy?club_id=1", bytes.NewBuffer(data))
+	rr = httptest.NewRecorder()
+	suite.router.ServeHTTP(rr, req)
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+}
+
+func (suite *HTTPHandlerTestSuite) TestGetCohortAnalysis() {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeRange := repository.TimeRange{Start: start, End: start.AddDate(1, 0, 0)}
+	options := cohort.Options{MaxMonths: 6, RiskWindow: 30 * 24 * time.Hour}
+	report := &cohort.Report{ClubID: 1, Churn: []cohort.TypeChurn{{MembershipType: cohort.AllTypes, Exposed: 4, Churned: 1, Rate: 0.25}}}
+	suite.mockService.On("GetCohortAnalysis", uint(1), timeRange, options).Return(report, nil).Once()
+
+	req, _ := http.NewRequest("GET", "/api/v1/analytics/cohorts?club_id=1&start=2025-01-01T00:00:00Z&end=2026-01-01T00:00:00Z&max_months=6&risk_window_days=30", nil)
+	rr := httptest.NewRecorder()
+	suite.router.ServeHTTP(rr, req)
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+
+	var response cohort.Report
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(suite.T(), report.Churn, response.Churn)
+
+	for _, query := range []string{"", "?club_id=abc", "?club_id=1&max_months=0", "?club_id=1&max_months=37", "?club_id=1&risk_window_days=400", "?club_id=1&end=2020-01-01T00:00:00Z"} {
+		req, _ := http.NewRequest("GET", "/api/v1/analytics/cohorts"+query, nil)
+		rr := httptest.NewRecorder()
+		suite.router.ServeHTTP(rr, req)
+		assert.Equal(suite.T(), http.StatusBadRequest, rr.Code, query)
+	}
+}
+
 func TestHTTPHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HTTPHandlerTestSuite))
 }
@@ -411,6 +709,7 @@ func TestHTTPHandler_EmptyQueryParams(t *testing.T) {
 	}
 	monitor := monitoring.NewMonitor(monitoringConfig, logger, "test", "test")
 	handler := NewHTTPHandler(mockService, logger, monitor)
+	mockService.On("GetMonitoringMetrics").Return(nil).Maybe()
 	router := handler.SetupRoutes()
 
 	// Test with empty query parameters
@@ -440,6 +739,7 @@ func TestHTTPHandler_CORSHeaders(t *testing.T) {
 	}
 	monitor := monitoring.NewMonitor(monitoringConfig, logger, "test", "test")
 	handler := NewHTTPHandler(mockService, logger, monitor)
+	mockService.On("GetMonitoringMetrics").Return(nil).Maybe()
 	router := handler.SetupRoutes()
 
 	mockService.On("GetHealthChecker").Return(nil)
@@ -469,6 +769,7 @@ func BenchmarkHTTPHandler_GetMetrics(b *testing.B) {
 	}
 	monitor := monitoring.NewMonitor(monitoringConfig, logger, "test", "test")
 	handler := NewHTTPHandler(mockService, logger, monitor)
+	mockService.On("GetMonitoringMetrics").Return(nil).Maybe()
 	router := handler.SetupRoutes()
 
 	mockMetrics := map[string]interface{}{"total": 100}
@@ -498,6 +799,7 @@ func BenchmarkHTTPHandler_RecordEvent(b *testing.B) {
 	}
 	monitor := monitoring.NewMonitor(monitoringConfig, logger, "test", "test")
 	handler := NewHTTPHandler(mockService, logger, monitor)
+	mockService.On("GetMonitoringMetrics").Return(nil).Maybe()
 	router := handler.SetupRoutes()
 
 	eventData := map[string]interface{}{
@@ -0,0 +1,383 @@
+// Package ingestion validates domain events published by the other services
+// and normalizes them into typed analytics facts.
+package ingestion
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+// Queue is the queue group analytics replicas share, so each event is
+// ingested by a single replica
+const Queue = "analytics-service"
+
+// Subjects are the domain event subjects facts are built from
+var Subjects = []string{"visit.>", "agreement.>", "member.>", "governance.>"}
+
+// Kind names the fact table an event feeds
+type Kind string
+
+const (
+	KindVisit     Kind = "visit"
+	KindAgreement Kind = "agreement"
+	KindMember    Kind = "member"
+	KindVote      Kind = "vote"
+)
+
+var (
+	// ErrUnsupported marks events on a subscribed subject that carry no facts
+	ErrUnsupported = errors.New("event type is not ingested")
+
+	// ErrInvalid marks events whose payload cannot be turned into a fact;
+	// redelivering them will not help
+	ErrInvalid = errors.New("invalid event")
+)
+
+// Event is a validated domain event and the fact it produces
+type Event struct {
+	MessageID  string
+	Subject    string
+	Type       string
+	Kind       Kind
+	OccurredAt time.Time
+	Fact       repository.Fact
+}
+
+// Decode validates a message and normalizes its payload into a fact
+func Decode(msg *messaging.Message) (*Event, error) {
+	if msg.ID == "" {
+		return nil, fmt.Errorf("%w: message on %s has no id", ErrInvalid, msg.Subject)
+	}
+
+	p, err := decodePayload(msg.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	// Services publishing several event types on one subject name the type in the payload
+	eventType := msg.Subject
+	if named, ok := p["event_type"].(string); ok && named != "" {
+		eventType = named
+	}
+
+	occurredAt, err := p.time("timestamp")
+	if err != nil || occurredAt.IsZero() {
+		occurredAt = msg.Timestamp
+	}
+	if occurredAt.IsZero() {
+		return nil, fmt.Errorf("%w: %s has no timestamp", ErrInvalid, eventType)
+	}
+	occurredAt = occurredAt.UTC()
+
+	event := &Event{MessageID: msg.ID, Subject: msg.Subject, Type: eventType, OccurredAt: occurredAt}
+	switch {
+	case strings.HasPrefix(eventType, "visit."):
+		event.Kind = KindVisit
+		event.Fact, err = visitFact(p, occurredAt)
+	case strings.HasPrefix(eventType, "agreement."):
+		event.Kind = KindAgreement
+		event.Fact, err = agreementFact(p, eventType, occurredAt)
+	case strings.HasPrefix(eventType, "member."):
+		event.Kind = KindMember
+		event.Fact, err = memberFact(p, eventType, occurredAt)
+	case eventType == "governance.vote.cast":
+		event.Kind = KindVote
+		event.Fact, err = voteFact(p, occurredAt)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, eventType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalid, eventType, err)
+	}
+
+	return event, nil
+}
+
+// visitStatuses maps each visit status to the lifecycle timestamp it sets
+var visitStatuses = map[string]func(*repository.VisitFact, *time.Time){
+	"pending":    func(f *repository.VisitFact, at *time.Time) { f.RequestedAt = at },
+	"confirmed":  func(f *repository.VisitFact, at *time.Time) { f.ConfirmedAt = at },
+	"checked_in": func(f *repository.VisitFact, at *time.Time) { f.CheckedInAt = at },
+	"completed":  func(f *repository.VisitFact, at *time.Time) { f.CompletedAt = at },
+	"cancelled":  nil,
+	"no_show":    nil,
+}
+
+func visitFact(p payload, occurredAt time.Time) (repository.Fact, error) {
+	fact := &repository.VisitFact{LastEventAt: occurredAt}
+
+	var err error
+	if fact.VisitID, err = p.id("visit_id"); err != nil {
+		return nil, err
+	}
+	if fact.MemberID, err = p.id("member_id"); err != nil {
+		return nil, err
+	}
+	if fact.VisitingClubID, err = p.id("visiting_club_id"); err != nil {
+		return nil, err
+	}
+	if fact.HomeClubID, err = p.id("home_club_id"); err != nil {
+		return nil, err
+	}
+	if fact.AgreementID, err = p.optionalID("agreement_id"); err != nil {
+		return nil, err
+	}
+	if fact.VisitDate, err = p.time("visit_date"); err != nil {
+		return nil, err
+	}
+	if fact.VisitDate.IsZero() {
+		return nil, fmt.Errorf("visit_date is required")
+	}
+	fact.VisitDate = fact.VisitDate.UTC()
+	if fact.FacilitiesUsed, err = p.strings("facilities_used"); err != nil {
+		return nil, err
+	}
+	if fact.GuestCount, err = p.count("guest_count"); err != nil {
+		return nil, err
+	}
+	if fact.MemberRating, err = p.rating("member_rating"); err != nil {
+		return nil, err
+	}
+	if fact.ClubRating, err = p.rating("club_rating"); err != nil {
+		return nil, err
+	}
+
+	fact.Status = strings.ToLower(p.string("status"))
+	setLifecycle, ok := visitStatuses[fact.Status]
+	if !ok {
+		return nil, fmt.Errorf("unknown visit status %q", fact.Status)
+	}
+	if setLifecycle != nil {
+		setLifecycle(fact, &occurredAt)
+	}
+
+	return fact, nil
+}
+
+func agreementFact(p payload, eventType string, occurredAt time.Time) (repository.Fact, error) {
+	fact := &repository.AgreementFact{LastEventAt: occurredAt}
+
+	var err error
+	if fact.AgreementID, err = p.id("agreement_id"); err != nil {
+		return nil, err
+	}
+	if fact.ProposingClubID, err = p.id("proposing_club_id"); err != nil {
+		return nil, err
+	}
+	if fact.TargetClubID, err = p.id("target_club_id"); err != nil {
+		return nil, err
+	}
+	if fact.Status = strings.ToLower(p.string("status")); fact.Status == "" {
+		return nil, fmt.Errorf("status is required")
+	}
+	if fact.MaxVisitsPerMonth, err = p.count("max_visits_per_month"); err != nil {
+		return nil, err
+	}
+	if eventType == "agreement.created" {
+		fact.ProposedAt = &occurredAt
+	}
+
+	return fact, nil
+}
+
+func memberFact(p payload, eventType string, occurredAt time.Time) (repository.Fact, error) {
+	fact := &repository.MemberFact{
+		MemberNumber:   p.string("member_number"),
+		MembershipType: strings.ToUpper(p.string("membership_type")),
+		Status:         strings.ToUpper(p.string("status")),
+		LastEventAt:    occurredAt,
+	}
+
+	var err error
+	if fact.MemberID, err = p.id("member_id"); err != nil {
+		return nil, err
+	}
+	if fact.ClubID, err = p.id("club_id"); err != nil {
+		return nil, err
+	}
+	if fact.UserID, err = p.optionalID("user_id"); err != nil {
+		return nil, err
+	}
+	if fact.Status == "" {
+		return nil, fmt.Errorf("status is required")
+	}
+
+	switch eventType {
+	case "member.created":
+		fact.JoinedAt = &occurredAt
+	case "member.deleted":
+		fact.RemovedAt = &occurredAt
+	}
+
+	return fact, nil
+}
+
+// voteChoices are the choices the governance service accepts
+var voteChoices = map[string]bool{"yes": true, "no": true, "abstain": true}
+
+func voteFact(p payload, occurredAt time.Time) (repository.Fact, error) {
+	fact := &repository.VoteFact{
+		Choice: strings.ToLower(p.string("choice")),
+		CastAt: occurredAt,
+	}
+
+	var err error
+	if fact.VoteID, err = p.id("vote_id"); err != nil {
+		return nil, err
+	}
+	if fact.ProposalID, err = p.id("proposal_id"); err != nil {
+		return nil, err
+	}
+	if fact.ClubID, err = p.id("club_id"); err != nil {
+		return nil, err
+	}
+	if fact.MemberID, err = p.optionalID("member_id"); err != nil {
+		return nil, err
+	}
+	if !voteChoices[fact.Choice] {
+		return nil, fmt.Errorf("unknown vote choice %q", fact.Choice)
+	}
+
+	return fact, nil
+}
+
+// payload is a decoded event body
+type payload map[string]interface{}
+
+// decodePayload accepts a JSON object, or a JSON string holding one; services
+// that marshal their payload before publishing send it as a base64 string
+func decodePayload(data json.RawMessage) (payload, error) {
+	p := payload{}
+	if len(data) == 0 {
+		return p, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&p); err == nil {
+		return p, nil
+	}
+
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("payload is not a JSON object")
+	}
+	raw := []byte(encoded)
+	if decoded, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+		raw = decoded
+	}
+
+	p = payload{}
+	decoder = json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("payload is not a JSON object")
+	}
+	return p, nil
+}
+
+func (p payload) string(key string) string {
+	switch value := p[key].(type) {
+	case string:
+		return strings.TrimSpace(value)
+	case json.Number:
+		return value.String()
+	default:
+		return ""
+	}
+}
+
+// id reads a required positive integer ID, written as a number or a string
+func (p payload) id(key string) (uint, error) {
+	id, err := p.optionalID(key)
+	if err == nil && id == 0 {
+		err = fmt.Errorf("%s is required", key)
+	}
+	return id, err
+}
+
+func (p payload) optionalID(key string) (uint, error) {
+	var text string
+	switch value := p[key].(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		text = value.String()
+	case string:
+		text = strings.TrimSpace(value)
+		if text == "" {
+			return 0, nil
+		}
+	default:
+		return 0, fmt.Errorf("%s must be a number", key)
+	}
+
+	id, err := strconv.ParseUint(text, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a positive integer", key)
+	}
+	return uint(id), nil
+}
+
+// count reads an optional non-negative integer
+func (p payload) count(key string) (int, error) {
+	count, err := p.optionalID(key)
+	return int(count), err
+}
+
+// rating reads an optional rating from 1 to 5
+func (p payload) rating(key string) (*int, error) {
+	rating, err := p.count(key)
+	if err != nil || p[key] == nil {
+		return nil, err
+	}
+	if rating < 1 || rating > 5 {
+		return nil, fmt.Errorf("%s must be between 1 and 5", key)
+	}
+	return &rating, nil
+}
+
+// strings reads an optional list of strings, dropping blank entries
+func (p payload) strings(key string) ([]string, error) {
+	if p[key] == nil {
+		return nil, nil
+	}
+	items, ok := p[key].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", key)
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		value, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a list of strings", key)
+		}
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+// time reads an optional RFC 3339 timestamp
+func (p payload) time(key string) (time.Time, error) {
+	text := p.string(key)
+	if text == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp", key)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,139 @@
+package ingestion
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+var published = time.Date(2025, 6, 2, 9, 30, 0, 0, time.UTC)
+
+// message wraps data the way the message bus does, marshalling it once
+func message(t *testing.T, subject string, data interface{}) *messaging.Message {
+	t.Helper()
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+	return &messaging.Message{ID: "msg_1", Subject: subject, Data: raw, Timestamp: published}
+}
+
+func TestDecode_VisitPublishedAsBytes(t *testing.T) {
+	// The reciprocal service marshals its payload before publishing, so the
+	// bus carries it as a base64 string
+	payload, err := json.Marshal(map[string]interface{}{
+		"visit_id":         41,
+		"agreement_id":     7,
+		"member_id":        1200,
+		"visiting_club_id": 3,
+		"home_club_id":     5,
+		"status":           "checked_in",
+		"visit_date":       "2025-06-02T00:00:00Z",
+		"facilities_used":  []string{"pool", " ", "gym"},
+		"guest_count":      2,
+		"member_rating":    nil,
+		"timestamp":        "2025-06-02T10:15:00+01:00",
+	})
+	require.NoError(t, err)
+
+	event, err := Decode(message(t, "visit.checked_in", payload))
+	require.NoError(t, err)
+
+	assert.Equal(t, KindVisit, event.Kind)
+	assert.Equal(t, "visit.checked_in", event.Type)
+	assert.Equal(t, time.Date(2025, 6, 2, 9, 15, 0, 0, time.UTC), event.OccurredAt)
+
+	fact, ok := event.Fact.(*repository.VisitFact)
+	require.True(t, ok)
+	assert.Equal(t, uint(41), fact.VisitID)
+	assert.Equal(t, uint(7), fact.AgreementID)
+	assert.Equal(t, uint(3), fact.VisitingClubID)
+	assert.Equal(t, uint(5), fact.HomeClubID)
+	assert.Equal(t, "checked_in", fact.Status)
+	assert.Equal(t, []string{"pool", "gym"}, fact.FacilitiesUsed)
+	assert.Equal(t, 2, fact.GuestCount)
+	assert.Nil(t, fact.MemberRating)
+	require.NotNil(t, fact.CheckedInAt)
+	assert.Equal(t, event.OccurredAt, *fact.CheckedInAt)
+	assert.Nil(t, fact.RequestedAt)
+	assert.Nil(t, fact.CompletedAt)
+}
+
+func TestDecode_MemberEventTypeFromPayload(t *testing.T) {
+	event, err := Decode(message(t, "member.events", map[string]interface{}{
+		"event_type":      "member.created",
+		"member_id":       "88",
+		"club_id":         3,
+		"user_id":         501,
+		"member_number":   "M-0088",
+		"membership_type": "vip",
+		"status":          "active",
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, KindMember, event.Kind)
+	assert.Equal(t, "member.created", event.Type)
+	// Without a timestamp in the payload the bus timestamp is used
+	assert.Equal(t, published, event.OccurredAt)
+
+	fact := event.Fact.(*repository.MemberFact)
+	assert.Equal(t, uint(88), fact.MemberID)
+	assert.Equal(t, uint(3), fact.ClubID)
+	assert.Equal(t, "VIP", fact.MembershipType)
+	assert.Equal(t, "ACTIVE", fact.Status)
+	require.NotNil(t, fact.JoinedAt)
+	assert.Equal(t, published, *fact.JoinedAt)
+	assert.Nil(t, fact.RemovedAt)
+}
+
+func TestDecode_VoteAndUnsupportedGovernanceEvents(t *testing.T) {
+	event, err := Decode(message(t, "governance.vote.cast", map[string]interface{}{
+		"vote_id":     9,
+		"proposal_id": 4,
+		"member_id":   88,
+		"choice":      "Abstain",
+		"club_id":     3,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, KindVote, event.Kind)
+	fact := event.Fact.(*repository.VoteFact)
+	assert.Equal(t, "abstain", fact.Choice)
+	assert.Equal(t, published, fact.CastAt)
+
+	_, err = Decode(message(t, "governance.proposal.created", map[string]interface{}{"proposal_id": 4, "club_id": 3}))
+	assert.True(t, errors.Is(err, ErrUnsupported))
+}
+
+func TestDecode_RejectsInvalidPayloads(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		data    interface{}
+	}{
+		{"missing club", "governance.vote.cast", map[string]interface{}{"vote_id": 9, "proposal_id": 4, "choice": "yes"}},
+		{"fractional id", "agreement.created", map[string]interface{}{"agreement_id": 1.5, "proposing_club_id": 3, "target_club_id": 5, "status": "pending"}},
+		{"negative id", "member.events", map[string]interface{}{"event_type": "member.updated", "member_id": -1, "club_id": 3, "status": "ACTIVE"}},
+		{"unknown visit status", "visit.requested", map[string]interface{}{"visit_id": 1, "member_id": 2, "visiting_club_id": 3, "home_club_id": 5, "status": "lost", "visit_date": "2025-06-02T00:00:00Z"}},
+		{"bad visit date", "visit.requested", map[string]interface{}{"visit_id": 1, "member_id": 2, "visiting_club_id": 3, "home_club_id": 5, "status": "pending", "visit_date": "tomorrow"}},
+		{"rating out of range", "visit.completed", map[string]interface{}{"visit_id": 1, "member_id": 2, "visiting_club_id": 3, "home_club_id": 5, "status": "completed", "visit_date": "2025-06-02T00:00:00Z", "member_rating": 6}},
+		{"facilities not a list", "visit.completed", map[string]interface{}{"visit_id": 1, "member_id": 2, "visiting_club_id": 3, "home_club_id": 5, "status": "completed", "visit_date": "2025-06-02T00:00:00Z", "facilities_used": "gym"}},
+		{"not an object", "visit.requested", []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(message(t, tt.subject, tt.data))
+			assert.True(t, errors.Is(err, ErrInvalid), "got %v", err)
+		})
+	}
+
+	msg := message(t, "visit.requested", map[string]interface{}{})
+	msg.ID = ""
+	_, err := Decode(msg)
+	assert.True(t, errors.Is(err, ErrInvalid))
+}
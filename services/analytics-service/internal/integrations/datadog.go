@@ -107,6 +107,11 @@ func (dd *DataDogClient) SendMetrics(ctx context.Context, metrics map[string]int
 		}
 	}
 
+	return dd.SendSeries(ctx, series)
+}
+
+// SendSeries submits metric series to DataDog
+func (dd *DataDogClient) SendSeries(ctx context.Context, series []DataDogMetric) error {
 	payload := DataDogMetricsPayload{Series: series}
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
@@ -295,4 +300,44 @@ func (dd *DataDogClient) CreateAlert(ctx context.Context, alertConfig map[string
 	})
 
 	return nil
-}
\ No newline at end of file
+}
+
+// AnomalyAlert describes an anomaly episode to raise in a monitoring system
+type AnomalyAlert struct {
+	ClubID     string
+	MetricName string
+	Severity   string
+	Direction  string
+	Message    string
+}
+
+// AnomalyMonitor builds a monitor that watches the metric with DataDog's own
+// anomaly detection, so the alert keeps tracking the incident after it is raised
+func (dd *DataDogClient) AnomalyMonitor(alert *AnomalyAlert) map[string]interface{} {
+	priority := 3
+	switch alert.Severity {
+	case "high":
+		priority = 1
+	case "medium":
+		priority = 2
+	}
+
+	query := fmt.Sprintf("avg(last_4h):anomalies(avg:%s{club_id:%s}, 'agile', 2) >= 1", dd.addNamespace(alert.MetricName), alert.ClubID)
+	return map[string]interface{}{
+		"name":     fmt.Sprintf("Anomaly in %s for club %s", alert.MetricName, alert.ClubID),
+		"type":     "query alert",
+		"query":    query,
+		"message":  alert.Message,
+		"priority": priority,
+		"tags": []string{
+			"source:analytics-service",
+			"club_id:" + alert.ClubID,
+			"metric_name:" + alert.MetricName,
+			"severity:" + alert.Severity,
+			"direction:" + alert.Direction,
+		},
+		"options": map[string]interface{}{
+			"thresholds": map[string]interface{}{"critical": 1},
+		},
+	}
+}
@@ -211,18 +211,31 @@ func (es *ElasticSearchClient) GetHealth(ctx context.Context) map[string]interfa
 
 // BulkIndex performs bulk indexing of multiple documents
 func (es *ElasticSearchClient) BulkIndex(ctx context.Context, documents []interface{}) error {
+	return es.BulkIndexWithIDs(ctx, nil, documents)
+}
+
+// BulkIndexWithIDs bulk indexes documents under the given IDs, so indexing a
+// document again replaces it. It fails if any document is rejected.
+func (es *ElasticSearchClient) BulkIndexWithIDs(ctx context.Context, ids []string, documents []interface{}) error {
 	if len(documents) == 0 {
 		return nil
 	}
+	if ids != nil && len(ids) != len(documents) {
+		return fmt.Errorf("bulk index needs one ID per document")
+	}
 
 	var bulkBody bytes.Buffer
 
-	for _, doc := range documents {
+	for i, doc := range documents {
 		// Add index action
+		target := map[string]interface{}{
+			"_index": es.config.Index,
+		}
+		if ids != nil {
+			target["_id"] = ids[i]
+		}
 		action := map[string]interface{}{
-			"index": map[string]interface{}{
-				"_index": es.config.Index,
-			},
+			"index": target,
 		}
 		actionJSON, _ := json.Marshal(action)
 		bulkBody.Write(actionJSON)
@@ -259,6 +272,17 @@ func (es *ElasticSearchClient) BulkIndex(ctx context.Context, documents []interf
 		return fmt.Errorf("ElasticSearch bulk indexing failed: status %d", resp.StatusCode)
 	}
 
+	// Rejected documents are reported in the body of a successful response
+	var result struct {
+		Errors bool `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+	if result.Errors {
+		return fmt.Errorf("ElasticSearch rejected documents in bulk request")
+	}
+
 	es.logger.Info("Bulk indexing completed", map[string]interface{}{
 		"index": es.config.Index,
 		"document_count": len(documents),
@@ -266,4 +290,59 @@ func (es *ElasticSearchClient) BulkIndex(ctx context.Context, documents []interf
 	})
 
 	return nil
-}
\ No newline at end of file
+}
+
+// BulkDelete deletes documents by ID. Documents that do not exist are skipped.
+func (es *ElasticSearchClient) BulkDelete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var bulkBody bytes.Buffer
+	for _, id := range ids {
+		action := map[string]interface{}{
+			"delete": map[string]interface{}{"_index": es.config.Index, "_id": id},
+		}
+		actionJSON, _ := json.Marshal(action)
+		bulkBody.Write(actionJSON)
+		bulkBody.WriteByte('\n')
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/_bulk", es.config.URL), &bulkBody)
+	if err != nil {
+		return fmt.Errorf("failed to create bulk request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if es.config.Username != "" && es.config.Password != "" {
+		req.SetBasicAuth(es.config.Username, es.config.Password)
+	}
+
+	resp, err := es.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute bulk request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("ElasticSearch bulk delete failed: status %d", resp.StatusCode)
+	}
+
+	// Missing documents come back as not_found without setting errors
+	var result struct {
+		Errors bool `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+	if result.Errors {
+		return fmt.Errorf("ElasticSearch failed to delete documents in bulk request")
+	}
+
+	es.logger.Info("Bulk delete completed", map[string]interface{}{
+		"index":          es.config.Index,
+		"document_count": len(ids),
+	})
+
+	return nil
+}
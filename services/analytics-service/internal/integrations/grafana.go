@@ -214,58 +214,10 @@ func (g *GrafanaClient) DeleteDashboard(ctx context.Context, uid string) error {
 	return nil
 }
 
-// CreateAnalyticsDashboard creates a standard analytics dashboard
-func (g *GrafanaClient) CreateAnalyticsDashboard(ctx context.Context, clubID string) error {
-	dashboard := map[string]interface{}{
-		"id":    nil,
-		"uid":   fmt.Sprintf("analytics-club-%s", clubID),
-		"title": fmt.Sprintf("Analytics Dashboard - Club %s", clubID),
-		"tags":  []string{"analytics", "club", clubID},
-		"timezone": "browser",
-		"panels": []map[string]interface{}{
-			{
-				"id":    1,
-				"title": "Event Count",
-				"type":  "stat",
-				"targets": []map[string]interface{}{
-					{
-						"expr":   fmt.Sprintf(`analytics_events_total{club_id="%s"}`, clubID),
-						"legendFormat": "Events",
-					},
-				},
-				"gridPos": map[string]interface{}{
-					"h": 8,
-					"w": 12,
-					"x": 0,
-					"y": 0,
-				},
-			},
-			{
-				"id":    2,
-				"title": "Metrics Over Time",
-				"type":  "graph",
-				"targets": []map[string]interface{}{
-					{
-						"expr":   fmt.Sprintf(`rate(analytics_events_total{club_id="%s"}[5m])`, clubID),
-						"legendFormat": "Event Rate",
-					},
-				},
-				"gridPos": map[string]interface{}{
-					"h": 8,
-					"w": 12,
-					"x": 12,
-					"y": 0,
-				},
-			},
-		},
-		"time": map[string]interface{}{
-			"from": "now-6h",
-			"to":   "now",
-		},
-		"refresh": "30s",
-	}
-
-	return g.CreateDashboard(ctx, dashboard)
+// DataSourceUID returns the UID of the PostgreSQL data source that exported
+// dashboards query
+func (g *GrafanaClient) DataSourceUID() string {
+	return g.config.DataSourceUID
 }
 
 // GetHealth returns Grafana integration health status
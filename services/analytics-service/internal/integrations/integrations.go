@@ -2,6 +2,7 @@ package integrations
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/logging"
@@ -14,6 +15,7 @@ type IntegrationsConfig struct {
 	Grafana       *GrafanaConfig       `json:"grafana"`
 	BigQuery      *BigQueryConfig      `json:"bigquery"`
 	S3            *S3Config            `json:"s3"`
+	Notifications *NotificationConfig  `json:"notifications"`
 }
 
 // ElasticSearchConfig holds Elasticsearch configuration
@@ -34,9 +36,11 @@ type DataDogConfig struct {
 
 // GrafanaConfig holds Grafana configuration
 type GrafanaConfig struct {
-	URL      string `json:"url"`
-	APIKey   string `json:"api_key"`
-	OrgID    int    `json:"org_id"`
+	URL    string `json:"url"`
+	APIKey string `json:"api_key"`
+	OrgID  int    `json:"org_id"`
+	// DataSourceUID is the Grafana PostgreSQL data source reading the analytics database
+	DataSourceUID string `json:"datasource_uid"`
 }
 
 // BigQueryConfig holds Google BigQuery configuration
@@ -48,11 +52,21 @@ type BigQueryConfig struct {
 
 // S3Config holds AWS S3 configuration for data export
 type S3Config struct {
+	// Endpoint overrides the AWS endpoint, e.g. http://minio:9000
+	Endpoint   string `json:"endpoint"`
 	Region     string `json:"region"`
 	Bucket     string `json:"bucket"`
 	AccessKey  string `json:"access_key"`
 	SecretKey  string `json:"secret_key"`
 	PathPrefix string `json:"path_prefix"`
+	PathStyle  bool   `json:"path_style"`
+	// ExportFormat is the encoding of exported batches, ndjson or csv
+	ExportFormat string `json:"export_format"`
+}
+
+// NotificationConfig holds notification service configuration for report delivery
+type NotificationConfig struct {
+	URL string `json:"url"`
 }
 
 // AnalyticsIntegrations manages all external analytics integrations
@@ -62,6 +76,7 @@ type AnalyticsIntegrations struct {
 	Grafana       *GrafanaClient
 	BigQuery      *BigQueryClient
 	S3            *S3Client
+	Notifications *NotificationClient
 	logger        logging.Logger
 }
 
@@ -96,6 +111,11 @@ func NewAnalyticsIntegrations(config *IntegrationsConfig, logger logging.Logger)
 		integrations.S3 = NewS3Client(config.S3, logger)
 	}
 
+	// Initialize notification service client
+	if config.Notifications != nil {
+		integrations.Notifications = NewNotificationClient(config.Notifications, logger)
+	}
+
 	return integrations
 }
 
@@ -131,6 +151,12 @@ func (ai *AnalyticsIntegrations) ValidateConfig() error {
 		}
 	}
 
+	if ai.Notifications != nil {
+		if err := ai.Notifications.ValidateConfig(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -166,46 +192,37 @@ func (ai *AnalyticsIntegrations) TestConnections(ctx context.Context) error {
 		}
 	}
 
-	return nil
-}
-
-// ExportData exports analytics data to configured external systems
-func (ai *AnalyticsIntegrations) ExportData(ctx context.Context, data interface{}, exportType string) error {
-	switch exportType {
-	case "elasticsearch":
-		if ai.ElasticSearch != nil {
-			return ai.ElasticSearch.IndexData(ctx, data)
-		}
-	case "bigquery":
-		if ai.BigQuery != nil {
-			return ai.BigQuery.InsertData(ctx, data)
-		}
-	case "s3":
-		if ai.S3 != nil {
-			return ai.S3.UploadData(ctx, data)
+	if ai.Notifications != nil {
+		if err := ai.Notifications.TestConnection(ctx); err != nil {
+			ai.logger.Warn("Notification service connection failed", map[string]interface{}{"error": err.Error()})
 		}
 	}
 
-	ai.logger.Warn("Export type not configured or unsupported", map[string]interface{}{"export_type": exportType})
 	return nil
 }
 
-// SendMetrics sends metrics to configured monitoring systems
-func (ai *AnalyticsIntegrations) SendMetrics(ctx context.Context, metrics map[string]interface{}) error {
-	if ai.DataDog != nil {
-		if err := ai.DataDog.SendMetrics(ctx, metrics); err != nil {
-			ai.logger.Error("Failed to send metrics to DataDog", map[string]interface{}{"error": err.Error()})
+// CreateDashboard creates or updates dashboards in configured systems
+func (ai *AnalyticsIntegrations) CreateDashboard(ctx context.Context, dashboardConfig map[string]interface{}) error {
+	if ai.Grafana != nil {
+		if err := ai.Grafana.CreateDashboard(ctx, dashboardConfig); err != nil {
+			ai.logger.Error("Failed to create Grafana dashboard", map[string]interface{}{"error": err.Error()})
+			return err
 		}
 	}
 
 	return nil
 }
 
-// CreateDashboard creates or updates dashboards in configured systems
-func (ai *AnalyticsIntegrations) CreateDashboard(ctx context.Context, dashboardConfig map[string]interface{}) error {
+// GrafanaConfigured reports whether dashboards can be pushed to Grafana
+func (ai *AnalyticsIntegrations) GrafanaConfigured() bool {
+	return ai.Grafana != nil && ai.Grafana.ValidateConfig() == nil
+}
+
+// DeleteDashboard removes a dashboard from configured systems
+func (ai *AnalyticsIntegrations) DeleteDashboard(ctx context.Context, uid string) error {
 	if ai.Grafana != nil {
-		if err := ai.Grafana.CreateDashboard(ctx, dashboardConfig); err != nil {
-			ai.logger.Error("Failed to create Grafana dashboard", map[string]interface{}{"error": err.Error()})
+		if err := ai.Grafana.DeleteDashboard(ctx, uid); err != nil {
+			ai.logger.Error("Failed to delete Grafana dashboard", map[string]interface{}{"error": err.Error(), "uid": uid})
 			return err
 		}
 	}
@@ -213,6 +230,32 @@ func (ai *AnalyticsIntegrations) CreateDashboard(ctx context.Context, dashboardC
 	return nil
 }
 
+// CreateAnomalyAlert raises an alert for an anomaly episode in configured
+// monitoring systems. It is a no-op when DataDog is absent or missing its keys.
+func (ai *AnalyticsIntegrations) CreateAnomalyAlert(ctx context.Context, alert *AnomalyAlert) error {
+	if ai.DataDog == nil || ai.DataDog.ValidateConfig() != nil {
+		return nil
+	}
+
+	if err := ai.DataDog.CreateAlert(ctx, ai.DataDog.AnomalyMonitor(alert)); err != nil {
+		ai.logger.Error("Failed to create DataDog anomaly alert", map[string]interface{}{"error": err.Error(), "club_id": alert.ClubID, "metric_name": alert.MetricName})
+		return err
+	}
+
+	return nil
+}
+
+// SendReportEmail emails a recipient through the notification service. It
+// fails when the notification service is not configured, so callers can record
+// the delivery as failed.
+func (ai *AnalyticsIntegrations) SendReportEmail(ctx context.Context, clubID uint, recipient, subject, message string, metadata map[string]interface{}) error {
+	if ai.Notifications == nil || ai.Notifications.ValidateConfig() != nil {
+		return fmt.Errorf("notification service is not configured")
+	}
+
+	return ai.Notifications.SendEmail(ctx, clubID, recipient, subject, message, "analytics_report", metadata)
+}
+
 // GetHealth returns health status of all integrations
 func (ai *AnalyticsIntegrations) GetHealth(ctx context.Context) map[string]interface{} {
 	health := map[string]interface{}{
@@ -242,5 +285,9 @@ func (ai *AnalyticsIntegrations) GetHealth(ctx context.Context) map[string]inter
 		integrations["s3"] = ai.S3.GetHealth(ctx)
 	}
 
+	if ai.Notifications != nil {
+		integrations["notifications"] = ai.Notifications.GetHealth(ctx)
+	}
+
 	return health
 }
\ No newline at end of file
@@ -0,0 +1,139 @@
+package integrations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/logging"
+)
+
+// NotificationClient sends messages through the notification service
+type NotificationClient struct {
+	config     *NotificationConfig
+	httpClient *http.Client
+	logger     logging.Logger
+}
+
+// NewNotificationClient creates a new notification service client
+func NewNotificationClient(config *NotificationConfig, logger logging.Logger) *NotificationClient {
+	return &NotificationClient{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// ValidateConfig validates notification service configuration
+func (n *NotificationClient) ValidateConfig() error {
+	if n.config.URL == "" {
+		return fmt.Errorf("notification service URL is required")
+	}
+	return nil
+}
+
+// TestConnection tests connectivity to the notification service
+func (n *NotificationClient) TestConnection(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", n.baseURL()+"/health", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create health request: %w", err)
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to notification service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("notification service health check failed: status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// emailNotification is the notification service's create request
+type emailNotification struct {
+	ClubID    uint   `json:"club_id"`
+	Type      string `json:"type"`
+	Subject   string `json:"subject"`
+	Message   string `json:"message"`
+	Recipient string `json:"recipient"`
+	Category  string `json:"category,omitempty"`
+	Metadata  string `json:"metadata,omitempty"`
+}
+
+// SendEmail asks the notification service to email a recipient. Metadata is
+// stored with the notification for tracing it back to its source.
+func (n *NotificationClient) SendEmail(ctx context.Context, clubID uint, recipient, subject, message, category string, metadata map[string]interface{}) error {
+	notification := emailNotification{
+		ClubID:    clubID,
+		Type:      "email",
+		Subject:   subject,
+		Message:   message,
+		Recipient: recipient,
+		Category:  category,
+	}
+	if len(metadata) > 0 {
+		encoded, err := json.Marshal(metadata)
+		if err != nil {
+			return fmt.Errorf("failed to marshal notification metadata: %w", err)
+		}
+		notification.Metadata = string(encoded)
+	}
+
+	jsonData, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", n.baseURL()+"/api/v1/notifications", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("notification request failed: status %d", resp.StatusCode)
+	}
+
+	n.logger.Info("Email notification sent", map[string]interface{}{
+		"club_id":  clubID,
+		"category": category,
+	})
+
+	return nil
+}
+
+// GetHealth returns notification service integration health status
+func (n *NotificationClient) GetHealth(ctx context.Context) map[string]interface{} {
+	health := map[string]interface{}{
+		"service": "notifications",
+		"url":     n.config.URL,
+	}
+
+	if err := n.TestConnection(ctx); err != nil {
+		health["status"] = "unhealthy"
+		health["error"] = err.Error()
+	} else {
+		health["status"] = "healthy"
+	}
+
+	return health
+}
+
+func (n *NotificationClient) baseURL() string {
+	return strings.TrimSuffix(n.config.URL, "/")
+}
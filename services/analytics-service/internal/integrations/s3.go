@@ -53,34 +53,6 @@ func (s *S3Client) TestConnection(ctx context.Context) error {
 	return nil
 }
 
-// UploadData uploads analytics data to S3
-func (s *S3Client) UploadData(ctx context.Context, data interface{}) error {
-	// Convert data to JSON
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return fmt.Errorf("failed to marshal data: %w", err)
-	}
-
-	// Generate object key with timestamp and prefix
-	timestamp := time.Now().Format("2006/01/02/15")
-	objectKey := fmt.Sprintf("%s/analytics-data/%s/data.json",
-		strings.TrimPrefix(s.config.PathPrefix, "/"), timestamp)
-
-	// Note: In a real implementation, you would:
-	// 1. Use AWS SDK v2 for Go
-	// 2. Create an S3 service client
-	// 3. Use PutObject operation with proper authentication
-	// 4. Handle AWS-specific errors and retry logic
-
-	s.logger.Info("Data uploaded to S3 - simulated", map[string]interface{}{
-		"bucket":     s.config.Bucket,
-		"object_key": objectKey,
-		"data_size":  len(jsonData),
-	})
-
-	return nil
-}
-
 // UploadFile uploads a file to S3
 func (s *S3Client) UploadFile(ctx context.Context, key string, data []byte, contentType string) error {
 	// Note: In a real implementation, you would:
@@ -161,48 +133,6 @@ func (s *S3Client) DeleteObject(ctx context.Context, key string) error {
 	return nil
 }
 
-// ExportAnalyticsData exports analytics data in various formats
-func (s *S3Client) ExportAnalyticsData(ctx context.Context, data interface{}, format string) error {
-	timestamp := time.Now().Format("2006-01-02-15-04-05")
-
-	var exportData []byte
-	var contentType string
-	var fileExtension string
-
-	switch format {
-	case "json":
-		jsonData, err := json.MarshalIndent(data, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to marshal JSON: %w", err)
-		}
-		exportData = jsonData
-		contentType = "application/json"
-		fileExtension = "json"
-
-	case "csv":
-		// Note: In a real implementation, you would convert data to CSV format
-		csvData := s.convertToCSV(data)
-		exportData = []byte(csvData)
-		contentType = "text/csv"
-		fileExtension = "csv"
-
-	default:
-		return fmt.Errorf("unsupported export format: %s", format)
-	}
-
-	key := fmt.Sprintf("exports/analytics-export-%s.%s", timestamp, fileExtension)
-	return s.UploadFile(ctx, key, exportData, contentType)
-}
-
-// convertToCSV converts data to CSV format (simplified implementation)
-func (s *S3Client) convertToCSV(data interface{}) string {
-	// This is a simplified CSV conversion
-	// In a real implementation, you would use a proper CSV library
-	return "timestamp,event_type,club_id,count\n" +
-		   "2024-01-01T00:00:00Z,member_visit,club_123,50\n" +
-		   "2024-01-01T01:00:00Z,reciprocal_usage,club_123,25\n"
-}
-
 // CreateBackup creates a backup of analytics data
 func (s *S3Client) CreateBackup(ctx context.Context, data interface{}) error {
 	timestamp := time.Now().Format("2006-01-02")
@@ -267,28 +197,6 @@ func (s *S3Client) getFullKey(key string) string {
 	return strings.TrimSuffix(s.config.PathPrefix, "/") + "/" + strings.TrimPrefix(key, "/")
 }
 
-// ScheduleDataExport schedules regular data exports to S3
-func (s *S3Client) ScheduleDataExport(ctx context.Context, interval time.Duration, data interface{}) {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			s.logger.Info("Data export scheduler stopped", map[string]interface{}{})
-			return
-		case <-ticker.C:
-			if err := s.ExportAnalyticsData(ctx, data, "json"); err != nil {
-				s.logger.Error("Scheduled data export failed", map[string]interface{}{
-					"error": err.Error(),
-				})
-			} else {
-				s.logger.Info("Scheduled data export completed", map[string]interface{}{})
-			}
-		}
-	}
-}
-
 // CleanupOldBackups removes old backup files to manage storage costs
 func (s *S3Client) CleanupOldBackups(ctx context.Context, retentionDays int) error {
 	// Note: In a real implementation, you would:
@@ -0,0 +1,188 @@
+package integrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/blobstore"
+	"reciprocal-clubs-backend/services/analytics-service/internal/sink"
+)
+
+// Sinks returns an export sink for each configured integration that stores
+// events or metrics. Grafana and the notification service are not sinks.
+func (ai *AnalyticsIntegrations) Sinks() []sink.Sink {
+	var sinks []sink.Sink
+
+	if ai.ElasticSearch != nil && ai.ElasticSearch.ValidateConfig() == nil {
+		sinks = append(sinks, &elasticSearchSink{client: ai.ElasticSearch})
+	}
+
+	if ai.DataDog != nil && ai.DataDog.ValidateConfig() == nil {
+		sinks = append(sinks, &dataDogSink{client: ai.DataDog})
+	}
+
+	if ai.BigQuery != nil && ai.BigQuery.ValidateConfig() == nil {
+		sinks = append(sinks, &bigQuerySink{client: ai.BigQuery})
+	}
+
+	if ai.S3 != nil && ai.S3.ValidateConfig() == nil {
+		s3Sink, err := ai.S3.Sink()
+		if err != nil {
+			ai.logger.Warn("S3 export sink is disabled", map[string]interface{}{"error": err.Error()})
+		} else {
+			sinks = append(sinks, s3Sink)
+		}
+	}
+
+	return sinks
+}
+
+// elasticSearchSink indexes events and metrics under their record key, so a
+// redelivered record replaces its earlier copy
+type elasticSearchSink struct {
+	client *ElasticSearchClient
+}
+
+func (s *elasticSearchSink) Name() string { return "elasticsearch" }
+
+func (s *elasticSearchSink) Kinds() []string { return sink.Kinds }
+
+func (s *elasticSearchSink) Write(ctx context.Context, records []sink.Record) error {
+	ids := make([]string, len(records))
+	documents := make([]interface{}, len(records))
+	for i, record := range records {
+		ids[i] = record.Key()
+		documents[i] = record
+	}
+	return s.client.BulkIndexWithIDs(ctx, ids, documents)
+}
+
+func (s *elasticSearchSink) Check(ctx context.Context) error {
+	return s.client.TestConnection(ctx)
+}
+
+func (s *elasticSearchSink) Erase(ctx context.Context, keys []string) error {
+	return s.client.BulkDelete(ctx, keys)
+}
+
+// dataDogSink submits metrics as gauge points at their recorded time, tagged
+// with the club and the metric's tags
+type dataDogSink struct {
+	client *DataDogClient
+}
+
+func (s *dataDogSink) Name() string { return "datadog" }
+
+func (s *dataDogSink) Kinds() []string { return []string{sink.KindMetric} }
+
+func (s *dataDogSink) Write(ctx context.Context, records []sink.Record) error {
+	series := make([]DataDogMetric, 0, len(records))
+	for _, record := range records {
+		tags := []string{"source:analytics-service", "club_id:" + record.ClubID}
+		keys := make([]string, 0, len(record.Data))
+		for key := range record.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			tags = append(tags, fmt.Sprintf("%s:%v", key, record.Data[key]))
+		}
+
+		series = append(series, DataDogMetric{
+			Metric: s.client.addNamespace(record.Name),
+			Points: [][]float64{{float64(record.Timestamp.Unix()), record.Value}},
+			Type:   "gauge",
+			Tags:   tags,
+		})
+	}
+	return s.client.SendSeries(ctx, series)
+}
+
+func (s *dataDogSink) Check(ctx context.Context) error {
+	return s.client.TestConnection(ctx)
+}
+
+// bigQuerySink streams events and metrics into the events and metrics tables
+type bigQuerySink struct {
+	client *BigQueryClient
+}
+
+func (s *bigQuerySink) Name() string { return "bigquery" }
+
+func (s *bigQuerySink) Kinds() []string { return sink.Kinds }
+
+func (s *bigQuerySink) Write(ctx context.Context, records []sink.Record) error {
+	tables := map[string][]map[string]interface{}{}
+	for _, record := range records {
+		row := map[string]interface{}{
+			"insert_id": record.Key(),
+			"id":        record.ID,
+			"club_id":   record.ClubID,
+			"name":      record.Name,
+			"data":      record.Data,
+			"timestamp": record.Timestamp,
+		}
+		if record.Kind == sink.KindMetric {
+			row["value"] = record.Value
+		}
+		tables[record.Kind+"s"] = append(tables[record.Kind+"s"], row)
+	}
+
+	for _, table := range []string{"events", "metrics"} {
+		if rows := tables[table]; len(rows) > 0 {
+			if err := s.client.StreamInsert(ctx, table, rows); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *bigQuerySink) Check(ctx context.Context) error {
+	return s.client.TestConnection(ctx)
+}
+
+// Erase deletes the rows written under the keys' insert IDs. Keys only hold
+// a kind and a number, so they are safe to quote into the statement.
+func (s *bigQuerySink) Erase(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = "'" + key + "'"
+	}
+	for _, table := range []string{"events", "metrics"} {
+		statement := fmt.Sprintf("DELETE FROM `%s.%s.%s` WHERE insert_id IN (%s)",
+			s.client.config.ProjectID, s.client.config.DatasetID, table, strings.Join(quoted, ", "))
+		if _, err := s.client.RunQuery(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sink creates the export sink writing batches under the exports prefix of
+// the bucket
+func (s *S3Client) Sink() (sink.Sink, error) {
+	store, err := blobstore.NewS3Store(blobstore.S3Config{
+		Endpoint:  s.config.Endpoint,
+		Region:    s.config.Region,
+		Bucket:    s.config.Bucket,
+		AccessKey: s.config.AccessKey,
+		SecretKey: s.config.SecretKey,
+		Prefix:    s.config.PathPrefix,
+		PathStyle: s.config.PathStyle,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	format := s.config.ExportFormat
+	if format == "" {
+		format = sink.FormatNDJSON
+	}
+	return sink.NewObjectSink("s3", store, "exports", format)
+}
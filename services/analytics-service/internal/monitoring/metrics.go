@@ -18,6 +18,26 @@ type AnalyticsMetrics struct {
 	ReportsGenerated    *prometheus.CounterVec
 	DashboardsCreated   *prometheus.CounterVec
 
+	// Anomaly detection metrics
+	AnomaliesDetected *prometheus.CounterVec
+	AnomalyEpisodes   *prometheus.CounterVec
+	AnomalyChecks     *prometheus.CounterVec
+
+	// Domain event ingestion metrics
+	EventsIngested  *prometheus.CounterVec
+	FactsBackfilled *prometheus.CounterVec
+	RowsRolledUp    prometheus.Counter
+	RowsRetired     *prometheus.CounterVec
+
+	// Report scheduling metrics
+	ScheduledReportRuns *prometheus.CounterVec
+	ReportDeliveries    *prometheus.CounterVec
+
+	// Export sink metrics
+	SinkRecords *prometheus.CounterVec
+	SinkPending *prometheus.GaugeVec
+	SinkLag     *prometheus.GaugeVec
+
 	// Data processing metrics
 	ProcessingDuration  *prometheus.HistogramVec
 	QueueSize          prometheus.Gauge
@@ -99,6 +119,97 @@ func NewAnalyticsMetrics(logger logging.Logger) *AnalyticsMetrics {
 			[]string{"club_id", "dashboard_type"},
 		),
 
+		// Anomaly detection metrics
+		AnomaliesDetected: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_anomalies_detected_total",
+				Help: "Total number of anomalous buckets found by continuous monitoring",
+			},
+			[]string{"metric_name", "severity"},
+		),
+
+		AnomalyEpisodes: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_anomaly_episodes_total",
+				Help: "Total number of anomaly episode transitions",
+			},
+			[]string{"metric_name", "transition"},
+		),
+
+		AnomalyChecks: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_anomaly_checks_total",
+				Help: "Total number of continuous anomaly checks",
+			},
+			[]string{"status"},
+		),
+
+		// Domain event ingestion metrics
+		EventsIngested: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_events_ingested_total",
+				Help: "Total number of domain events handled by the ingestion pipeline",
+			},
+			[]string{"kind", "outcome"},
+		),
+		FactsBackfilled: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_facts_backfilled_total",
+				Help: "Total number of source rows read by fact backfills",
+			},
+			[]string{"source"},
+		),
+		RowsRolledUp: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Name: "analytics_rows_rolled_up_total",
+				Help: "Total number of raw metric and event rows folded into rollups",
+			},
+		),
+		RowsRetired: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_rows_retired_total",
+				Help: "Total number of rows deleted by the retention policy",
+			},
+			[]string{"tier"},
+		),
+		ScheduledReportRuns: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_scheduled_report_runs_total",
+				Help: "Total number of report schedule runs by outcome",
+			},
+			[]string{"report_type", "status"},
+		),
+		ReportDeliveries: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_report_deliveries_total",
+				Help: "Total number of report emails sent to recipients",
+			},
+			[]string{"status"},
+		),
+
+		// Export sink metrics
+		SinkRecords: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analytics_sink_records_total",
+				Help: "Total number of outbox records written to export sinks by outcome",
+			},
+			[]string{"sink", "status"},
+		),
+		SinkPending: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "analytics_sink_pending_records",
+				Help: "Outbox records an export sink has not shipped yet",
+			},
+			[]string{"sink"},
+		),
+		SinkLag: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "analytics_sink_lag_seconds",
+				Help: "Age of the oldest outbox record an export sink has not shipped yet",
+			},
+			[]string{"sink"},
+		),
+
 		// Data processing metrics
 		ProcessingDuration: promauto.NewHistogramVec(
 			prometheus.HistogramOpts{
@@ -298,6 +409,62 @@ func (m *AnalyticsMetrics) RecordDashboardCreated(clubID, dashboardType string)
 	m.DashboardsCreated.WithLabelValues(clubID, dashboardType).Inc()
 }
 
+// RecordAnomalyDetected records an anomalous bucket found by continuous monitoring
+func (m *AnalyticsMetrics) RecordAnomalyDetected(metricName, severity string) {
+	m.AnomaliesDetected.WithLabelValues(metricName, severity).Inc()
+}
+
+// RecordAnomalyEpisode records an episode being opened, escalated or resolved
+func (m *AnalyticsMetrics) RecordAnomalyEpisode(metricName, transition string) {
+	m.AnomalyEpisodes.WithLabelValues(metricName, transition).Inc()
+}
+
+// RecordAnomalyCheck records the outcome of a continuous anomaly check
+func (m *AnalyticsMetrics) RecordAnomalyCheck(status string) {
+	m.AnomalyChecks.WithLabelValues(status).Inc()
+}
+
+// RecordEventIngested records how the ingestion pipeline handled a domain event
+func (m *AnalyticsMetrics) RecordEventIngested(kind, outcome string) {
+	m.EventsIngested.WithLabelValues(kind, outcome).Inc()
+}
+
+// RecordFactsBackfilled records source rows read by a backfill
+func (m *AnalyticsMetrics) RecordFactsBackfilled(source string, rows int) {
+	m.FactsBackfilled.WithLabelValues(source).Add(float64(rows))
+}
+
+// RecordRowsRolledUp records raw rows folded into rollups
+func (m *AnalyticsMetrics) RecordRowsRolledUp(rows int) {
+	m.RowsRolledUp.Add(float64(rows))
+}
+
+// RecordRowsRetired records rows deleted from a retention tier
+func (m *AnalyticsMetrics) RecordRowsRetired(tier string, rows int64) {
+	m.RowsRetired.WithLabelValues(tier).Add(float64(rows))
+}
+
+// RecordScheduledReportRun records the outcome of a report schedule run
+func (m *AnalyticsMetrics) RecordScheduledReportRun(reportType, status string) {
+	m.ScheduledReportRuns.WithLabelValues(reportType, status).Inc()
+}
+
+// RecordReportDelivery records a report email to one recipient
+func (m *AnalyticsMetrics) RecordReportDelivery(status string) {
+	m.ReportDeliveries.WithLabelValues(status).Inc()
+}
+
+// RecordSinkBatch records a batch of records written to an export sink
+func (m *AnalyticsMetrics) RecordSinkBatch(sink, status string, records int) {
+	m.SinkRecords.WithLabelValues(sink, status).Add(float64(records))
+}
+
+// UpdateSinkLag updates how far an export sink is behind the outbox
+func (m *AnalyticsMetrics) UpdateSinkLag(sink string, pending int64, lag time.Duration) {
+	m.SinkPending.WithLabelValues(sink).Set(float64(pending))
+	m.SinkLag.WithLabelValues(sink).Set(lag.Seconds())
+}
+
 // RecordProcessingDuration records the duration of a processing operation
 func (m *AnalyticsMetrics) RecordProcessingDuration(operation, status string, duration time.Duration) {
 	m.ProcessingDuration.WithLabelValues(operation, status).Observe(duration.Seconds())
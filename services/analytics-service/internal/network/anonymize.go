@@ -0,0 +1,70 @@
+package network
+
+// Anonymize applies a k-anonymity threshold to a report seen by a club. Rows
+// about other clubs that fewer than minGroupSize members are behind are left
+// out, as are the parts of a club's row and the ratings that fewer members
+// are behind, so no figure about another club's members can be traced to a
+// handful of them. Rows involving the viewing club are kept whole; a viewer of
+// zero sees no row whole.
+func Anonymize(report *Report, viewer uint, minGroupSize int) {
+	if minGroupSize <= 1 {
+		return
+	}
+	report.MinGroupSize = minGroupSize
+
+	// A club's report only counts visits the club was party to
+	if viewer == 0 && report.Members < minGroupSize {
+		report.Outcomes = Outcomes{}
+	}
+
+	flows := report.Flows[:0]
+	for _, flow := range report.Flows {
+		if flow.HomeClubID == viewer || flow.VisitingClubID == viewer || flow.Members >= minGroupSize {
+			flows = append(flows, flow)
+		} else {
+			report.Suppressed++
+		}
+	}
+	report.Flows = flows
+
+	agreements := report.Agreements[:0]
+	for _, agreement := range report.Agreements {
+		if agreement.ProposingClubID == viewer || agreement.TargetClubID == viewer || agreement.Members >= minGroupSize {
+			agreements = append(agreements, agreement)
+		} else {
+			report.Suppressed++
+		}
+	}
+	report.Agreements = agreements
+
+	clubs := report.Clubs[:0]
+	for _, club := range report.Clubs {
+		if club.ClubID == viewer {
+			clubs = append(clubs, club)
+			continue
+		}
+		if club.Guests < minGroupSize && club.Travellers < minGroupSize {
+			report.Suppressed++
+			continue
+		}
+
+		if club.Guests < minGroupSize {
+			club.Hosted = Outcomes{}
+			club.HostRating = nil
+			club.Facilities = []FacilityUsage{}
+		}
+		if club.Travellers < minGroupSize {
+			club.VisitsMade = 0
+			club.Partners = 0
+			club.GuestRating = nil
+		}
+		if club.HostRating != nil && club.HostRating.Count < minGroupSize {
+			club.HostRating = nil
+		}
+		if club.GuestRating != nil && club.GuestRating.Count < minGroupSize {
+			club.GuestRating = nil
+		}
+		clubs = append(clubs, club)
+	}
+	report.Clubs = clubs
+}
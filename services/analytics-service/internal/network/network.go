@@ -0,0 +1,407 @@
+// Package network reports on the reciprocal network from visit and agreement
+// facts: where members travel, how balanced and well used each agreement is,
+// and how visits turn out.
+package network
+
+import (
+	"sort"
+	"time"
+)
+
+// Visit statuses, as published by the reciprocal service
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusCheckedIn = "checked_in"
+	StatusCompleted = "completed"
+	StatusCancelled = "cancelled"
+	StatusNoShow    = "no_show"
+)
+
+// Visit is a member's visit from their home club to a partner club
+type Visit struct {
+	VisitID        uint
+	AgreementID    uint
+	MemberID       uint
+	HomeClubID     uint
+	VisitingClubID uint
+	Status         string
+	VisitDate      time.Time
+	Facilities     []string
+	// MemberRating is the member's rating of the club they visited
+	MemberRating *int
+	// ClubRating is the visited club's rating of the member
+	ClubRating *int
+}
+
+// attended reports whether the member turned up
+func (v *Visit) attended() bool {
+	return v.Status == StatusCheckedIn || v.Status == StatusCompleted
+}
+
+// Agreement is a reciprocal agreement between two clubs
+type Agreement struct {
+	AgreementID       uint
+	ProposingClubID   uint
+	TargetClubID      uint
+	Status            string
+	MaxVisitsPerMonth int
+}
+
+// Report is the network report for a period, optionally seen from one club
+type Report struct {
+	ClubID     uint              `json:"club_id,omitempty"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Outcomes   Outcomes          `json:"outcomes"`
+	Flows      []Flow            `json:"flows"`
+	Agreements []AgreementReport `json:"agreements"`
+	Clubs      []ClubReport      `json:"clubs"`
+	// Members counts the members who scheduled the visits
+	Members int `json:"members"`
+	// Suppressed counts the flows, agreements and clubs left out because
+	// fewer than MinGroupSize members were behind them
+	Suppressed   int `json:"suppressed,omitempty"`
+	MinGroupSize int `json:"min_group_size,omitempty"`
+}
+
+// Outcomes counts how visits scheduled in the period turned out. The rates
+// are shares of the visits that have reached an outcome, so visits still
+// pending or confirmed do not lower them.
+type Outcomes struct {
+	Visits           int     `json:"visits"`
+	Open             int     `json:"open"`
+	Attended         int     `json:"attended"`
+	Cancelled        int     `json:"cancelled"`
+	NoShows          int     `json:"no_shows"`
+	CancellationRate float64 `json:"cancellation_rate"`
+	NoShowRate       float64 `json:"no_show_rate"`
+}
+
+func (o *Outcomes) add(v *Visit) {
+	o.Visits++
+	switch {
+	case v.attended():
+		o.Attended++
+	case v.Status == StatusCancelled:
+		o.Cancelled++
+	case v.Status == StatusNoShow:
+		o.NoShows++
+	default:
+		o.Open++
+	}
+}
+
+func (o *Outcomes) finish() {
+	if closed := o.Visits - o.Open; closed > 0 {
+		o.CancellationRate = float64(o.Cancelled) / float64(closed)
+		o.NoShowRate = float64(o.NoShows) / float64(closed)
+	}
+}
+
+// Flow is one cell of the origin-destination matrix: visits by members of the
+// home club to the visiting club
+type Flow struct {
+	HomeClubID     uint `json:"home_club_id"`
+	VisitingClubID uint `json:"visiting_club_id"`
+	// Visits counts attended visits and Members the members who made them
+	Visits    int `json:"visits"`
+	Members   int `json:"members"`
+	Requested int `json:"requested"`
+}
+
+// AgreementReport shows how an agreement is used in each direction. Outbound
+// visits are by members of the proposing club to the target club, inbound
+// the reverse.
+type AgreementReport struct {
+	AgreementID     uint   `json:"agreement_id"`
+	ProposingClubID uint   `json:"proposing_club_id"`
+	TargetClubID    uint   `json:"target_club_id"`
+	Status          string `json:"status"`
+	Outbound        int    `json:"outbound"`
+	Inbound         int    `json:"inbound"`
+	// Balance runs from -1 (only inbound) to 1 (only outbound)
+	Balance           float64      `json:"balance"`
+	MaxVisitsPerMonth int          `json:"max_visits_per_month"`
+	Utilization       *Utilization `json:"utilization,omitempty"`
+	Outcomes          Outcomes     `json:"outcomes"`
+	Trend             []TrendPoint `json:"trend"`
+	// Members counts the members who scheduled visits under the agreement
+	Members int `json:"members"`
+}
+
+// Utilization compares members' monthly visits under an agreement with its
+// MaxVisitsPerMonth. Only months in which a member visited are counted.
+type Utilization struct {
+	MemberMonths  int     `json:"member_months"`
+	AverageVisits float64 `json:"average_visits"`
+	Rate          float64 `json:"rate"`
+	AtLimit       int     `json:"at_limit"`
+}
+
+// TrendPoint counts an agreement's attended visits in a calendar month
+type TrendPoint struct {
+	Month    time.Time `json:"month"`
+	Outbound int       `json:"outbound"`
+	Inbound  int       `json:"inbound"`
+}
+
+// ClubReport shows a club as host and as home of visiting members
+type ClubReport struct {
+	ClubID uint `json:"club_id"`
+	// Hosted counts visits to the club by members of partner clubs
+	Hosted     Outcomes `json:"hosted"`
+	VisitsMade int      `json:"visits_made"`
+	Partners   int      `json:"partners"`
+	// HostRating averages the ratings visiting members gave the club, and
+	// GuestRating the ratings partner clubs gave the club's members
+	HostRating  *Rating         `json:"host_rating,omitempty"`
+	GuestRating *Rating         `json:"guest_rating,omitempty"`
+	Facilities  []FacilityUsage `json:"facilities"`
+	// Guests counts the partner members behind the hosted visits, and
+	// Travellers the club's members behind the visits made
+	Guests     int `json:"guests"`
+	Travellers int `json:"travellers"`
+}
+
+// Rating is an average of ratings from 1 to 5
+type Rating struct {
+	Average float64 `json:"average"`
+	Count   int     `json:"count"`
+}
+
+func (r *Rating) add(rating *int) *Rating {
+	if rating == nil {
+		return r
+	}
+	if r == nil {
+		r = &Rating{}
+	}
+	r.Average += (float64(*rating) - r.Average) / float64(r.Count+1)
+	r.Count++
+	return r
+}
+
+// FacilityUsage counts attended visits that used a facility
+type FacilityUsage struct {
+	Facility string `json:"facility"`
+	Visits   int    `json:"visits"`
+}
+
+type memberMonth struct {
+	memberID uint
+	month    time.Time
+}
+
+// Build computes the report for visits scheduled in [start, end). With a club
+// ID the club reports are limited to that club.
+func Build(visits []Visit, agreements []Agreement, clubID uint, start, end time.Time) *Report {
+	report := &Report{
+		ClubID:     clubID,
+		Start:      start,
+		End:        end,
+		Flows:      []Flow{},
+		Agreements: []AgreementReport{},
+		Clubs:      []ClubReport{},
+	}
+
+	type flowKey struct{ home, visiting uint }
+	flows := make(map[flowKey]*Flow)
+	flowMembers := make(map[flowKey]map[uint]bool)
+
+	type clubState struct {
+		report     *ClubReport
+		partners   map[uint]bool
+		facilities map[string]int
+		guests     map[uint]bool
+		travellers map[uint]bool
+	}
+	clubs := make(map[uint]*clubState)
+	club := func(id uint) *clubState {
+		state, ok := clubs[id]
+		if !ok {
+			state = &clubState{
+				report:     &ClubReport{ClubID: id},
+				partners:   make(map[uint]bool),
+				facilities: make(map[string]int),
+				guests:     make(map[uint]bool),
+				travellers: make(map[uint]bool),
+			}
+			clubs[id] = state
+		}
+		return state
+	}
+
+	members := make(map[uint]bool)
+	byAgreement := make(map[uint][]*Visit)
+	for i := range visits {
+		v := &visits[i]
+		report.Outcomes.add(v)
+		members[v.MemberID] = true
+		if v.AgreementID != 0 {
+			byAgreement[v.AgreementID] = append(byAgreement[v.AgreementID], v)
+		}
+
+		key := flowKey{v.HomeClubID, v.VisitingClubID}
+		flow, ok := flows[key]
+		if !ok {
+			flow = &Flow{HomeClubID: v.HomeClubID, VisitingClubID: v.VisitingClubID}
+			flows[key] = flow
+			flowMembers[key] = make(map[uint]bool)
+		}
+		flow.Requested++
+
+		host, home := club(v.VisitingClubID), club(v.HomeClubID)
+		host.report.Hosted.add(v)
+		host.guests[v.MemberID] = true
+		if !v.attended() {
+			continue
+		}
+
+		flow.Visits++
+		flowMembers[key][v.MemberID] = true
+		home.report.VisitsMade++
+		home.partners[v.VisitingClubID] = true
+		home.travellers[v.MemberID] = true
+		host.report.HostRating = host.report.HostRating.add(v.MemberRating)
+		home.report.GuestRating = home.report.GuestRating.add(v.ClubRating)
+		for _, facility := range v.Facilities {
+			host.facilities[facility]++
+		}
+	}
+	report.Outcomes.finish()
+	report.Members = len(members)
+
+	for key, flow := range flows {
+		flow.Members = len(flowMembers[key])
+		report.Flows = append(report.Flows, *flow)
+	}
+	sort.Slice(report.Flows, func(i, j int) bool {
+		a, b := report.Flows[i], report.Flows[j]
+		if a.Visits != b.Visits {
+			return a.Visits > b.Visits
+		}
+		if a.Requested != b.Requested {
+			return a.Requested > b.Requested
+		}
+		if a.HomeClubID != b.HomeClubID {
+			return a.HomeClubID < b.HomeClubID
+		}
+		return a.VisitingClubID < b.VisitingClubID
+	})
+
+	for _, agreement := range agreements {
+		report.Agreements = append(report.Agreements, agreementReport(agreement, byAgreement[agreement.AgreementID], start, end))
+	}
+	sort.Slice(report.Agreements, func(i, j int) bool {
+		a, b := report.Agreements[i], report.Agreements[j]
+		if a.Outcomes.Visits != b.Outcomes.Visits {
+			return a.Outcomes.Visits > b.Outcomes.Visits
+		}
+		return a.AgreementID < b.AgreementID
+	})
+
+	for id, state := range clubs {
+		if clubID != 0 && id != clubID {
+			continue
+		}
+		state.report.Hosted.finish()
+		state.report.Partners = len(state.partners)
+		state.report.Guests = len(state.guests)
+		state.report.Travellers = len(state.travellers)
+		state.report.Facilities = []FacilityUsage{}
+		for facility, count := range state.facilities {
+			state.report.Facilities = append(state.report.Facilities, FacilityUsage{Facility: facility, Visits: count})
+		}
+		sort.Slice(state.report.Facilities, func(i, j int) bool {
+			a, b := state.report.Facilities[i], state.report.Facilities[j]
+			if a.Visits != b.Visits {
+				return a.Visits > b.Visits
+			}
+			return a.Facility < b.Facility
+		})
+		report.Clubs = append(report.Clubs, *state.report)
+	}
+	sort.Slice(report.Clubs, func(i, j int) bool {
+		a, b := report.Clubs[i], report.Clubs[j]
+		if a.Hosted.Visits != b.Hosted.Visits {
+			return a.Hosted.Visits > b.Hosted.Visits
+		}
+		return a.ClubID < b.ClubID
+	})
+
+	return report
+}
+
+func agreementReport(agreement Agreement, visits []*Visit, start, end time.Time) AgreementReport {
+	report := AgreementReport{
+		AgreementID:       agreement.AgreementID,
+		ProposingClubID:   agreement.ProposingClubID,
+		TargetClubID:      agreement.TargetClubID,
+		Status:            agreement.Status,
+		MaxVisitsPerMonth: agreement.MaxVisitsPerMonth,
+		Trend:             []TrendPoint{},
+	}
+
+	trend := make(map[time.Time]*TrendPoint)
+	for month := monthStart(start); month.Before(end); month = month.AddDate(0, 1, 0) {
+		report.Trend = append(report.Trend, TrendPoint{Month: month})
+	}
+	for i := range report.Trend {
+		trend[report.Trend[i].Month] = &report.Trend[i]
+	}
+
+	members := make(map[uint]bool)
+	monthly := make(map[memberMonth]int)
+	for _, v := range visits {
+		report.Outcomes.add(v)
+		members[v.MemberID] = true
+		if !v.attended() {
+			continue
+		}
+
+		outbound := v.HomeClubID == agreement.ProposingClubID
+		if outbound {
+			report.Outbound++
+		} else {
+			report.Inbound++
+		}
+		if point, ok := trend[monthStart(v.VisitDate)]; ok {
+			if outbound {
+				point.Outbound++
+			} else {
+				point.Inbound++
+			}
+		}
+		monthly[memberMonth{v.MemberID, monthStart(v.VisitDate)}]++
+	}
+	report.Outcomes.finish()
+	report.Members = len(members)
+
+	if total := report.Outbound + report.Inbound; total > 0 {
+		report.Balance = float64(report.Outbound-report.Inbound) / float64(total)
+	}
+
+	if agreement.MaxVisitsPerMonth > 0 {
+		utilization := &Utilization{MemberMonths: len(monthly)}
+		attended := 0
+		for _, count := range monthly {
+			attended += count
+			if count >= agreement.MaxVisitsPerMonth {
+				utilization.AtLimit++
+			}
+		}
+		if utilization.MemberMonths > 0 {
+			utilization.AverageVisits = float64(attended) / float64(utilization.MemberMonths)
+			utilization.Rate = utilization.AverageVisits / float64(agreement.MaxVisitsPerMonth)
+		}
+		report.Utilization = utilization
+	}
+
+	return report
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,125 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	june = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	july = june.AddDate(0, 1, 0)
+)
+
+func rating(value int) *int {
+	return &value
+}
+
+// visits between club 1 and club 2 under agreement 7, plus one to club 3
+func sampleVisits() []Visit {
+	return []Visit{
+		{VisitID: 1, AgreementID: 7, MemberID: 100, HomeClubID: 1, VisitingClubID: 2, Status: StatusCompleted, VisitDate: june.AddDate(0, 0, 2), Facilities: []string{"pool", "gym"}, MemberRating: rating(5), ClubRating: rating(4)},
+		{VisitID: 2, AgreementID: 7, MemberID: 100, HomeClubID: 1, VisitingClubID: 2, Status: StatusCheckedIn, VisitDate: june.AddDate(0, 0, 9), Facilities: []string{"pool"}, MemberRating: rating(3)},
+		{VisitID: 3, AgreementID: 7, MemberID: 101, HomeClubID: 1, VisitingClubID: 2, Status: StatusNoShow, VisitDate: june.AddDate(0, 0, 10)},
+		{VisitID: 4, AgreementID: 7, MemberID: 200, HomeClubID: 2, VisitingClubID: 1, Status: StatusCompleted, VisitDate: july.AddDate(0, 0, 3), Facilities: []string{"restaurant"}},
+		{VisitID: 5, AgreementID: 7, MemberID: 101, HomeClubID: 1, VisitingClubID: 2, Status: StatusCancelled, VisitDate: july.AddDate(0, 0, 4)},
+		{VisitID: 6, AgreementID: 7, MemberID: 100, HomeClubID: 1, VisitingClubID: 2, Status: StatusPending, VisitDate: july.AddDate(0, 0, 20)},
+		{VisitID: 7, AgreementID: 8, MemberID: 100, HomeClubID: 1, VisitingClubID: 3, Status: StatusCompleted, VisitDate: july.AddDate(0, 0, 5)},
+	}
+}
+
+func TestBuild_FlowsAndOutcomes(t *testing.T) {
+	report := Build(sampleVisits(), nil, 0, june, july.AddDate(0, 1, 0))
+
+	assert.Equal(t, Outcomes{Visits: 7, Open: 1, Attended: 4, Cancelled: 1, NoShows: 1, CancellationRate: 1.0 / 6, NoShowRate: 1.0 / 6}, report.Outcomes)
+
+	require.Len(t, report.Flows, 3)
+	assert.Equal(t, Flow{HomeClubID: 1, VisitingClubID: 2, Visits: 2, Members: 1, Requested: 5}, report.Flows[0])
+	assert.Equal(t, Flow{HomeClubID: 1, VisitingClubID: 3, Visits: 1, Members: 1, Requested: 1}, report.Flows[1])
+
+	require.Len(t, report.Clubs, 3)
+	host := report.Clubs[0]
+	assert.Equal(t, uint(2), host.ClubID)
+	assert.Equal(t, 5, host.Hosted.Visits)
+	assert.InDelta(t, 0.25, host.Hosted.NoShowRate, 1e-9)
+	require.NotNil(t, host.HostRating)
+	assert.Equal(t, Rating{Average: 4, Count: 2}, *host.HostRating)
+	assert.Equal(t, []FacilityUsage{{"pool", 2}, {"gym", 1}}, host.Facilities)
+
+	home := report.Clubs[1]
+	assert.Equal(t, uint(1), home.ClubID)
+	assert.Equal(t, 3, home.VisitsMade)
+	assert.Equal(t, 2, home.Partners)
+	require.NotNil(t, home.GuestRating)
+	assert.Equal(t, 4.0, home.GuestRating.Average)
+	assert.Nil(t, home.HostRating)
+
+	// Seen from one club, only that club is reported
+	report = Build(sampleVisits(), nil, 2, june, july.AddDate(0, 1, 0))
+	require.Len(t, report.Clubs, 1)
+	assert.Equal(t, uint(2), report.Clubs[0].ClubID)
+}
+
+func TestBuild_AgreementBalanceUtilizationAndTrend(t *testing.T) {
+	agreements := []Agreement{
+		{AgreementID: 7, ProposingClubID: 1, TargetClubID: 2, Status: "active", MaxVisitsPerMonth: 2},
+		{AgreementID: 9, ProposingClubID: 4, TargetClubID: 1, Status: "pending"},
+	}
+	report := Build(sampleVisits(), agreements, 0, june, july.AddDate(0, 1, 0))
+
+	require.Len(t, report.Agreements, 2)
+	agreement := report.Agreements[0]
+	assert.Equal(t, uint(7), agreement.AgreementID)
+	assert.Equal(t, 2, agreement.Outbound)
+	assert.Equal(t, 1, agreement.Inbound)
+	assert.InDelta(t, 1.0/3, agreement.Balance, 1e-9)
+	assert.Equal(t, 6, agreement.Outcomes.Visits)
+
+	// Member 100 used both June visits; member 200 one of two in July
+	require.NotNil(t, agreement.Utilization)
+	assert.Equal(t, Utilization{MemberMonths: 2, AverageVisits: 1.5, Rate: 0.75, AtLimit: 1}, *agreement.Utilization)
+
+	assert.Equal(t, []TrendPoint{{Month: june, Outbound: 2}, {Month: july, Inbound: 1}}, agreement.Trend)
+
+	unused := report.Agreements[1]
+	assert.Equal(t, uint(9), unused.AgreementID)
+	assert.Zero(t, unused.Balance)
+	assert.Nil(t, unused.Utilization)
+	assert.Len(t, unused.Trend, 2)
+}
+
+func TestAnonymize_SuppressesSmallGroups(t *testing.T) {
+	report := Build(sampleVisits(), nil, 0, june, july.AddDate(0, 1, 0))
+	Anonymize(report, 0, 2)
+
+	assert.Equal(t, 2, report.MinGroupSize)
+	assert.Equal(t, 7, report.Outcomes.Visits)
+	// Every flow was attended by a single member
+	assert.Empty(t, report.Flows)
+
+	// Two members visited club 2, but only one of its own travelled
+	require.Len(t, report.Clubs, 1)
+	host := report.Clubs[0]
+	assert.Equal(t, uint(2), host.ClubID)
+	assert.Equal(t, 5, host.Hosted.Visits)
+	assert.NotNil(t, host.HostRating)
+	assert.Zero(t, host.VisitsMade)
+	assert.Nil(t, host.GuestRating)
+	assert.Equal(t, 5, report.Suppressed)
+
+	// The viewer's own rows are kept whole
+	report = Build(sampleVisits(), nil, 0, june, july.AddDate(0, 1, 0))
+	Anonymize(report, 1, 2)
+	require.Len(t, report.Flows, 3)
+	require.Len(t, report.Clubs, 2)
+	assert.Equal(t, uint(1), report.Clubs[1].ClubID)
+	assert.Equal(t, 3, report.Clubs[1].VisitsMade)
+
+	// A threshold of one leaves the report alone
+	report = Build(sampleVisits(), nil, 0, june, july.AddDate(0, 1, 0))
+	Anonymize(report, 0, 1)
+	assert.Len(t, report.Clubs, 3)
+	assert.Zero(t, report.MinGroupSize)
+}
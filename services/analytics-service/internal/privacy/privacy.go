@@ -0,0 +1,357 @@
+// Package privacy classifies the fields of recorded event data and transforms
+// personal data before it is stored or exported. Each club has a policy of
+// field rules and a secret salt, so pseudonyms are stable within a club but
+// cannot be matched across clubs or reversed without the salt.
+package privacy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field classes
+const (
+	// ClassIdentifier fields identify a member, such as member and user IDs
+	ClassIdentifier = "identifier"
+	// ClassContact fields hold contact details, such as emails and phone numbers
+	ClassContact = "contact"
+	// ClassFreeText fields hold text written by members, such as feedback
+	ClassFreeText = "free_text"
+	// ClassQuasiIdentifier fields identify a member in combination, such as
+	// ages and postal codes
+	ClassQuasiIdentifier = "quasi_identifier"
+)
+
+// Transformations
+const (
+	ActionKeep       = "keep"
+	ActionDrop       = "drop"
+	ActionHash       = "hash"
+	ActionTruncate   = "truncate"
+	ActionGeneralize = "generalize"
+)
+
+// Date granularities a date can be generalized to
+const (
+	GranularityYear  = "year"
+	GranularityMonth = "month"
+)
+
+// classActions is the transformation applied to a class unless a rule sets one
+var classActions = map[string]string{
+	ClassIdentifier:      ActionHash,
+	ClassContact:         ActionDrop,
+	ClassFreeText:        ActionDrop,
+	ClassQuasiIdentifier: ActionGeneralize,
+}
+
+// reservedFields route events and cannot be transformed
+var reservedFields = map[string]bool{"club_id": true, "event_type": true}
+
+// DefaultSubjectField is the field naming the member an event is about
+const DefaultSubjectField = "member_id"
+
+// Rule classifies a field and sets how it is transformed. A field without a
+// dot matches the key at any depth; a dotted path such as guest.email matches
+// only that path. Keys match case-insensitively.
+type Rule struct {
+	Field string `json:"field"`
+	Class string `json:"class,omitempty"`
+	// Action overrides the class's transformation
+	Action string `json:"action,omitempty"`
+	// Length is how many characters truncate keeps
+	Length int `json:"length,omitempty"`
+	// Bands are ascending bounds numbers are generalized to, so bands of
+	// 18, 25 and 35 give <18, 18-25, 25-35 and 35+, each band including its
+	// lower bound
+	Bands []float64 `json:"bands,omitempty"`
+	// Granularity is what dates are generalized to, year or month
+	Granularity string `json:"granularity,omitempty"`
+}
+
+// action is the transformation the rule applies
+func (r Rule) action() string {
+	if r.Action != "" {
+		return r.Action
+	}
+	return classActions[r.Class]
+}
+
+// Validate checks the rule
+func (r Rule) Validate() error {
+	if r.Field == "" {
+		return fmt.Errorf("rule field is required")
+	}
+	if reservedFields[strings.ToLower(r.Field)] {
+		return fmt.Errorf("field %s cannot be transformed", r.Field)
+	}
+	if r.Class != "" {
+		if _, ok := classActions[r.Class]; !ok {
+			return fmt.Errorf("field %s has unknown class %q", r.Field, r.Class)
+		}
+	}
+
+	switch r.action() {
+	case ActionKeep, ActionDrop, ActionHash:
+	case ActionTruncate:
+		if r.Length <= 0 {
+			return fmt.Errorf("field %s is truncated but has no length", r.Field)
+		}
+	case ActionGeneralize:
+		if len(r.Bands) == 0 && r.Granularity == "" {
+			return fmt.Errorf("field %s is generalized but has no bands or granularity", r.Field)
+		}
+		for i := 1; i < len(r.Bands); i++ {
+			if r.Bands[i] <= r.Bands[i-1] {
+				return fmt.Errorf("field %s bands must be ascending", r.Field)
+			}
+		}
+		if r.Granularity != "" && r.Granularity != GranularityYear && r.Granularity != GranularityMonth {
+			return fmt.Errorf("field %s has unknown granularity %q", r.Field, r.Granularity)
+		}
+	case "":
+		return fmt.Errorf("field %s needs a class or an action", r.Field)
+	default:
+		return fmt.Errorf("field %s has unknown action %q", r.Field, r.Action)
+	}
+	return nil
+}
+
+// Policy is how a club's event data is transformed at ingestion
+type Policy struct {
+	Rules []Rule `json:"rules"`
+	// SubjectField names the member an event is about. Its value is
+	// pseudonymized into the event's subject, which erasure requests match.
+	SubjectField string `json:"subject_field,omitempty"`
+}
+
+// DefaultPolicy hashes member identifiers, drops contact details and free
+// text, and generalizes ages, birth dates and postal codes
+func DefaultPolicy() Policy {
+	policy := Policy{SubjectField: DefaultSubjectField}
+	for _, field := range []string{"member_id", "user_id", "member_number"} {
+		policy.Rules = append(policy.Rules, Rule{Field: field, Class: ClassIdentifier})
+	}
+	for _, field := range []string{"email", "phone", "phone_number", "first_name", "last_name", "full_name", "address"} {
+		policy.Rules = append(policy.Rules, Rule{Field: field, Class: ClassContact})
+	}
+	for _, field := range []string{"feedback", "comment", "comments", "notes", "message"} {
+		policy.Rules = append(policy.Rules, Rule{Field: field, Class: ClassFreeText})
+	}
+	policy.Rules = append(policy.Rules,
+		Rule{Field: "age", Class: ClassQuasiIdentifier, Bands: []float64{18, 25, 35, 45, 55, 65}},
+		Rule{Field: "date_of_birth", Class: ClassQuasiIdentifier, Granularity: GranularityYear},
+		Rule{Field: "birth_date", Class: ClassQuasiIdentifier, Granularity: GranularityYear},
+		Rule{Field: "postal_code", Class: ClassQuasiIdentifier, Action: ActionTruncate, Length: 3},
+	)
+	return policy
+}
+
+// Validate checks the policy's rules
+func (p *Policy) Validate() error {
+	fields := make(map[string]bool, len(p.Rules))
+	for _, rule := range p.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		field := strings.ToLower(rule.Field)
+		if fields[field] {
+			return fmt.Errorf("field %s has more than one rule", rule.Field)
+		}
+		fields[field] = true
+	}
+	if reservedFields[strings.ToLower(p.SubjectField)] {
+		return fmt.Errorf("subject field cannot be %s", p.SubjectField)
+	}
+	return nil
+}
+
+// Apply returns a transformed copy of the data, leaving the data itself
+// unchanged, and the pseudonymized subject, empty when the data has no
+// subject field. Values a rule cannot transform are dropped.
+func (p *Policy) Apply(salt []byte, data map[string]interface{}) (map[string]interface{}, string) {
+	rules := make(map[string]Rule, len(p.Rules))
+	for _, rule := range p.Rules {
+		rules[strings.ToLower(rule.Field)] = rule
+	}
+
+	subject := ""
+	if p.SubjectField != "" {
+		if value, ok := lookup(data, strings.Split(strings.ToLower(p.SubjectField), ".")); ok && value != nil {
+			subject = Pseudonym(salt, stringValue(value))
+		}
+	}
+
+	return transformMap(rules, salt, data, ""), subject
+}
+
+// Pseudonym is the salted hash a value is replaced with
+func Pseudonym(salt []byte, value string) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))[:32]
+}
+
+func transformMap(rules map[string]Rule, salt []byte, data map[string]interface{}, path string) map[string]interface{} {
+	if data == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		lower := strings.ToLower(key)
+		fieldPath := lower
+		if path != "" {
+			fieldPath = path + "." + lower
+		}
+
+		rule, ok := rules[fieldPath]
+		if !ok && path != "" {
+			rule, ok = rules[lower]
+		}
+		if !ok || (path == "" && reservedFields[lower]) {
+			out[key] = transformValue(rules, salt, value, fieldPath)
+			continue
+		}
+		if transformed, keep := apply(rule, salt, value); keep {
+			out[key] = transformed
+		}
+	}
+	return out
+}
+
+func transformValue(rules map[string]Rule, salt []byte, value interface{}, path string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return transformMap(rules, salt, v, path)
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = transformValue(rules, salt, item, path)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// apply transforms a value by a rule and reports whether anything is kept
+func apply(rule Rule, salt []byte, value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, rule.action() != ActionDrop
+	}
+
+	switch rule.action() {
+	case ActionKeep:
+		return value, true
+	case ActionHash:
+		return Pseudonym(salt, stringValue(value)), true
+	case ActionTruncate:
+		runes := []rune(stringValue(value))
+		if len(runes) > rule.Length {
+			runes = runes[:rule.Length]
+		}
+		return string(runes), true
+	case ActionGeneralize:
+		return generalize(rule, value)
+	default:
+		return nil, false
+	}
+}
+
+// generalize puts numbers in the rule's bands and cuts dates to its
+// granularity
+func generalize(rule Rule, value interface{}) (interface{}, bool) {
+	if len(rule.Bands) > 0 {
+		if number, ok := toNumber(value); ok {
+			return band(rule.Bands, number), true
+		}
+	}
+
+	if rule.Granularity != "" {
+		if text, ok := value.(string); ok {
+			for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+				if t, err := time.Parse(layout, text); err == nil {
+					if rule.Granularity == GranularityMonth {
+						return t.Format("2006-01"), true
+					}
+					return t.Format("2006"), true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func band(bounds []float64, number float64) string {
+	format := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+	if number < bounds[0] {
+		return "<" + format(bounds[0])
+	}
+	for i := 1; i < len(bounds); i++ {
+		if number < bounds[i] {
+			return format(bounds[i-1]) + "-" + format(bounds[i])
+		}
+	}
+	return format(bounds[len(bounds)-1]) + "+"
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// stringValue formats a value for hashing, writing whole numbers decoded from
+// JSON as integers so 1000000 hashes like "1000000" rather than "1e+06"
+func stringValue(value interface{}) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// lookup finds a value by a lower-case path, matching keys case-insensitively
+func lookup(data map[string]interface{}, path []string) (interface{}, bool) {
+	for key, value := range data {
+		if strings.ToLower(key) != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return value, true
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			return lookup(nested, path[1:])
+		}
+	}
+	return nil, false
+}
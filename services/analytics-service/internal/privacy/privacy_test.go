@@ -0,0 +1,120 @@
+package privacy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var salt = []byte("club-salt")
+
+func TestDefaultPolicy_Validates(t *testing.T) {
+	policy := DefaultPolicy()
+	require.NoError(t, policy.Validate())
+	assert.Equal(t, DefaultSubjectField, policy.SubjectField)
+}
+
+func TestApply_TransformsByClass(t *testing.T) {
+	policy := DefaultPolicy()
+	data := map[string]interface{}{
+		"club_id":       "1",
+		"event_type":    "member_visit",
+		"member_id":     float64(1000000),
+		"Email":         "member@example.com",
+		"feedback":      "Lovely pool",
+		"age":           float64(34),
+		"date_of_birth": "1991-04-12",
+		"postal_code":   "SW1A 1AA",
+		"facility":      "pool",
+		"guest": map[string]interface{}{
+			"email":     "guest@example.com",
+			"member_id": "55",
+		},
+		"party": []interface{}{
+			map[string]interface{}{"phone": "0123", "age": float64(70)},
+		},
+	}
+
+	out, subject := policy.Apply(salt, data)
+
+	assert.Equal(t, Pseudonym(salt, "1000000"), subject)
+	assert.Equal(t, subject, out["member_id"])
+	assert.Equal(t, "1", out["club_id"])
+	assert.Equal(t, "member_visit", out["event_type"])
+	assert.NotContains(t, out, "Email")
+	assert.NotContains(t, out, "feedback")
+	assert.Equal(t, "25-35", out["age"])
+	assert.Equal(t, "1991", out["date_of_birth"])
+	assert.Equal(t, "SW1", out["postal_code"])
+	assert.Equal(t, "pool", out["facility"])
+
+	// Rules without a dot apply at any depth
+	assert.Equal(t, map[string]interface{}{"member_id": Pseudonym(salt, "55")}, out["guest"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"age": "65+"}}, out["party"])
+
+	// The data itself is left alone
+	assert.Equal(t, "member@example.com", data["Email"])
+	assert.Equal(t, "guest@example.com", data["guest"].(map[string]interface{})["email"])
+}
+
+func TestApply_DottedRulesAndOverrides(t *testing.T) {
+	policy := Policy{
+		SubjectField: "guest.id",
+		Rules: []Rule{
+			{Field: "guest.id", Class: ClassIdentifier},
+			{Field: "notes", Class: ClassFreeText, Action: ActionKeep},
+			{Field: "visited_at", Class: ClassQuasiIdentifier, Granularity: GranularityMonth},
+			{Field: "age", Class: ClassQuasiIdentifier, Bands: []float64{18}},
+		},
+	}
+	require.NoError(t, policy.Validate())
+
+	out, subject := policy.Apply(salt, map[string]interface{}{
+		"id":         "7",
+		"guest":      map[string]interface{}{"id": "9"},
+		"notes":      "kept",
+		"visited_at": "2025-06-14T10:00:00Z",
+		"age":        "unknown",
+	})
+
+	assert.Equal(t, Pseudonym(salt, "9"), subject)
+	assert.Equal(t, "7", out["id"])
+	assert.Equal(t, map[string]interface{}{"id": subject}, out["guest"])
+	assert.Equal(t, "kept", out["notes"])
+	assert.Equal(t, "2025-06", out["visited_at"])
+	// Values a rule cannot generalize are dropped
+	assert.NotContains(t, out, "age")
+
+	_, subject = policy.Apply(salt, map[string]interface{}{"id": "7"})
+	assert.Empty(t, subject)
+}
+
+func TestPseudonym_DependsOnSalt(t *testing.T) {
+	assert.Equal(t, Pseudonym(salt, "42"), Pseudonym(salt, "42"))
+	assert.NotEqual(t, Pseudonym(salt, "42"), Pseudonym([]byte("other-club"), "42"))
+	assert.Len(t, Pseudonym(salt, "42"), 32)
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+	}{
+		{"reserved field", Policy{Rules: []Rule{{Field: "club_id", Action: ActionDrop}}}},
+		{"reserved subject", Policy{SubjectField: "event_type"}},
+		{"duplicate field", Policy{Rules: []Rule{{Field: "email", Class: ClassContact}, {Field: "EMAIL", Class: ClassContact}}}},
+		{"unknown class", Policy{Rules: []Rule{{Field: "email", Class: "secret"}}}},
+		{"unknown action", Policy{Rules: []Rule{{Field: "email", Action: "encrypt"}}}},
+		{"no action", Policy{Rules: []Rule{{Field: "email"}}}},
+		{"truncate without length", Policy{Rules: []Rule{{Field: "postal_code", Action: ActionTruncate}}}},
+		{"generalize without bands", Policy{Rules: []Rule{{Field: "age", Class: ClassQuasiIdentifier}}}},
+		{"descending bands", Policy{Rules: []Rule{{Field: "age", Class: ClassQuasiIdentifier, Bands: []float64{30, 20}}}}},
+		{"unknown granularity", Policy{Rules: []Rule{{Field: "dob", Class: ClassQuasiIdentifier, Granularity: "week"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.policy.Validate())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package reporting
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Link verification errors
+var (
+	ErrLinkExpired   = errors.New("download link has expired")
+	ErrLinkSignature = errors.New("download link signature is invalid")
+)
+
+// LinkSigner signs report download links so recipients can fetch a report
+// without credentials until the link expires
+type LinkSigner struct {
+	key []byte
+}
+
+// NewLinkSigner creates a signer with an HMAC key
+func NewLinkSigner(key []byte) *LinkSigner {
+	return &LinkSigner{key: key}
+}
+
+// Sign returns the signature for a report that expires at the given time
+func (s *LinkSigner) Sign(reportID uint, expires time.Time) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%d:%d", reportID, expires.Unix())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature and that the link has not expired
+func (s *LinkSigner) Verify(reportID uint, expires time.Time, signature string, now time.Time) error {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrLinkSignature
+	}
+	actual, _ := hex.DecodeString(s.Sign(reportID, expires))
+	if !hmac.Equal(expected, actual) {
+		return ErrLinkSignature
+	}
+	if !now.Before(expires) {
+		return ErrLinkExpired
+	}
+	return nil
+}
+
+// URL returns a signed download link under a base URL such as
+// https://analytics.example.com/analytics/reports
+func (s *LinkSigner) URL(baseURL string, reportID uint, expires time.Time) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", s.Sign(reportID, expires))
+	return fmt.Sprintf("%s/%d/download?%s", baseURL, reportID, query.Encode())
+}
@@ -0,0 +1,200 @@
+// Package reporting renders analytics reports as CSV, XLSX or JSON documents,
+// works out when scheduled reports are due from cron expressions, and signs the
+// download links sent to recipients.
+package reporting
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is a rendered report's file format
+type Format string
+
+// Supported report formats
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	FormatJSON Format = "json"
+)
+
+// ParseFormat parses a format name. An empty name is CSV.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(name))) {
+	case "", FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX, "excel":
+		return FormatXLSX, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	}
+	return "", fmt.Errorf("unsupported report format %q", name)
+}
+
+// ContentType returns the MIME type of files in the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatJSON:
+		return "application/json"
+	}
+	return "text/csv"
+}
+
+// Field is a named figure in a report's summary
+type Field struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
+// Table is a section of a report laid out in rows
+type Table struct {
+	Name    string          `json:"name"`
+	Columns []string        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+}
+
+// Document is a report's content, independent of the format it is rendered in
+type Document struct {
+	Title       string    `json:"title"`
+	ReportType  string    `json:"report_type"`
+	ClubID      string    `json:"club_id"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Summary     []Field   `json:"summary"`
+	Tables      []Table   `json:"tables"`
+}
+
+// AddField appends a figure to the summary
+func (d *Document) AddField(name string, value interface{}) {
+	d.Summary = append(d.Summary, Field{Name: name, Value: value})
+}
+
+// Data flattens the document into the map stored on report records: the
+// summary figures keyed by name, plus the tables.
+func (d *Document) Data() map[string]interface{} {
+	data := make(map[string]interface{}, len(d.Summary)+3)
+	for _, field := range d.Summary {
+		data[field.Name] = field.Value
+	}
+	data["period_start"] = d.Start
+	data["period_end"] = d.End
+	if len(d.Tables) > 0 {
+		data["tables"] = d.Tables
+	}
+	return data
+}
+
+// Artifact is a rendered report
+type Artifact struct {
+	Data        []byte
+	ContentType string
+	Extension   string
+}
+
+// Render renders a document in a format
+func Render(doc *Document, format Format) (*Artifact, error) {
+	var (
+		data []byte
+		err  error
+	)
+	switch format {
+	case FormatCSV:
+		data, err = renderCSV(doc)
+	case FormatXLSX:
+		data, err = renderXLSX(doc)
+	case FormatJSON:
+		data, err = json.MarshalIndent(doc, "", "  ")
+	default:
+		return nil, fmt.Errorf("unsupported report format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s report: %w", format, err)
+	}
+	return &Artifact{Data: data, ContentType: format.ContentType(), Extension: string(format)}, nil
+}
+
+// renderCSV writes the summary and then each table, separated by blank rows
+func renderCSV(doc *Document) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{
+		{doc.Title},
+		{"Period", formatCell(doc.Start), formatCell(doc.End)},
+		{"Generated", formatCell(doc.GeneratedAt)},
+	}
+	for _, field := range doc.Summary {
+		rows = append(rows, []string{field.Name, formatCell(field.Value)})
+	}
+	for _, table := range doc.Tables {
+		rows = append(rows, nil, []string{table.Name}, table.Columns)
+		for _, row := range table.Rows {
+			cells := make([]string, len(row))
+			for i, value := range row {
+				cells[i] = formatCell(value)
+			}
+			rows = append(rows, cells)
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = escapeFormula(cell)
+		}
+		if err := w.Write(cells); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// formatCell renders a value as cell text
+func formatCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	case float64:
+		return formatFloat(v)
+	case float32:
+		return formatFloat(float64(v))
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// formatFloat rounds to two decimal places without trailing zeros
+func formatFloat(v float64) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return ""
+	}
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// escapeFormula stops spreadsheet applications evaluating text that looks like
+// a formula, since club and member names end up in report cells
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
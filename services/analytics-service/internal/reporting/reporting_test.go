@@ -0,0 +1,204 @@
+package reporting
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var june = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func sampleDocument() *Document {
+	doc := &Document{
+		Title:       "Engagement report",
+		ReportType:  "engagement",
+		ClubID:      "1",
+		Start:       june,
+		End:         june.AddDate(0, 0, 7),
+		GeneratedAt: june.AddDate(0, 0, 7),
+	}
+	doc.AddField("total_members", int64(120))
+	doc.AddField("vote_rate", 0.4567)
+	doc.Tables = []Table{
+		{Name: "Members: by type", Columns: []string{"membership_type", "members"}, Rows: [][]interface{}{
+			{"full", int64(100)},
+			{"=HYPERLINK(\"x\")", int64(20)},
+		}},
+		{Name: "Members: by type", Columns: []string{"day", "joins"}, Rows: [][]interface{}{
+			{june, -3},
+		}},
+	}
+	return doc
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]Format{"": FormatCSV, "CSV": FormatCSV, "xlsx": FormatXLSX, "excel": FormatXLSX, "json": FormatJSON} {
+		format, err := ParseFormat(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, format, name)
+	}
+	_, err := ParseFormat("pdf")
+	assert.Error(t, err)
+}
+
+func TestRender_CSV(t *testing.T) {
+	artifact, err := Render(sampleDocument(), FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", artifact.ContentType)
+
+	r := csv.NewReader(bytes.NewReader(artifact.Data))
+	r.FieldsPerRecord = -1
+	rows, err := r.ReadAll()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Engagement report"}, rows[0])
+	assert.Equal(t, []string{"Period", "2025-06-01T00:00:00Z", "2025-06-08T00:00:00Z"}, rows[1])
+	assert.Equal(t, []string{"total_members", "120"}, rows[3])
+	assert.Equal(t, []string{"vote_rate", "0.46"}, rows[4])
+	assert.Contains(t, rows, []string{"'=HYPERLINK(\"x\")", "20"}, "formulas are escaped")
+	assert.Contains(t, rows, []string{"2025-06-01T00:00:00Z", "-3"}, "negative numbers are left alone")
+}
+
+func TestRender_XLSX(t *testing.T) {
+	artifact, err := Render(sampleDocument(), FormatXLSX)
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(artifact.Data), int64(len(artifact.Data)))
+	require.NoError(t, err)
+	parts := map[string]string{}
+	for _, file := range zr.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		parts[file.Name] = string(body)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml", "xl/worksheets/sheet3.xml"} {
+		assert.Contains(t, parts, name)
+	}
+	assert.Contains(t, parts["xl/workbook.xml"], `<sheet name="Summary" sheetId="1" r:id="rId1"/>`)
+	assert.Contains(t, parts["xl/workbook.xml"], `<sheet name="Members_ by type" sheetId="2" r:id="rId2"/>`)
+	assert.Contains(t, parts["xl/workbook.xml"], `<sheet name="Members_ by type (2)" sheetId="3" r:id="rId3"/>`)
+	assert.Contains(t, parts["xl/worksheets/sheet1.xml"], `<c r="B4"><v>120</v></c>`)
+	assert.Contains(t, parts["xl/worksheets/sheet2.xml"], `<t xml:space="preserve">=HYPERLINK(&#34;x&#34;)</t>`)
+}
+
+func TestRender_JSON(t *testing.T) {
+	artifact, err := Render(sampleDocument(), FormatJSON)
+	require.NoError(t, err)
+
+	var doc Document
+	require.NoError(t, json.Unmarshal(artifact.Data, &doc))
+	assert.Equal(t, "engagement", doc.ReportType)
+	assert.Len(t, doc.Tables, 2)
+}
+
+func TestSheetName(t *testing.T) {
+	used := map[string]bool{"summary": true}
+	assert.Equal(t, "summary (2)", sheetName("summary", used))
+	assert.Equal(t, "Sheet", sheetName("  ", used))
+	long := sheetName(strings.Repeat("x", 40), used)
+	assert.Len(t, long, maxSheetName)
+	assert.Len(t, sheetName(strings.Repeat("x", 40), used), maxSheetName)
+	assert.Equal(t, "AA", columnName(26))
+}
+
+func TestCron_Next(t *testing.T) {
+	from := time.Date(2025, 6, 4, 10, 30, 0, 0, time.UTC) // a Wednesday
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2025, 6, 4, 10, 45, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2025, 6, 5, 10, 30, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2025, 6, 5, 9, 0, 0, 0, time.UTC)},
+		{"0 8 * * 7", time.Date(2025, 6, 8, 8, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan,jul *", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+		// both day fields restricted: the 15th or any Friday
+		{"0 6 15 * fri", time.Date(2025, 6, 6, 6, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		schedule, err := ParseCron(tt.expr, nil)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, schedule.Next(from), tt.expr)
+	}
+
+	never, err := ParseCron("0 0 30 2 *", nil)
+	require.NoError(t, err)
+	assert.True(t, never.Next(from).IsZero())
+}
+
+func TestCron_Previous(t *testing.T) {
+	monday := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+
+	weekdays, err := ParseCron("0 8 * * mon-fri", nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 5, 30, 8, 0, 0, 0, time.UTC), weekdays.Previous(monday), "Monday's run follows Friday's")
+
+	monthly, err := ParseCron("@monthly", nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), monthly.Previous(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)))
+
+	minutely, err := ParseCron("* * * * *", nil)
+	require.NoError(t, err)
+	assert.Equal(t, monday.Add(-time.Minute), minutely.Previous(monday))
+
+	never, err := ParseCron("0 0 30 2 *", nil)
+	require.NoError(t, err)
+	assert.True(t, never.Previous(monday).IsZero())
+}
+
+func TestCron_Location(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("time zone data unavailable")
+	}
+	schedule, err := ParseCron("0 7 * * *", london)
+	require.NoError(t, err)
+
+	// British Summer Time starts on 30 March 2025
+	next := schedule.Next(time.Date(2025, 3, 29, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 3, 30, 6, 0, 0, 0, time.UTC), next.UTC())
+
+	skipped, err := ParseCron("30 1 * * *", london)
+	require.NoError(t, err)
+	next = skipped.Next(time.Date(2025, 3, 29, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 30, 0, 0, time.UTC), next.UTC(), "01:30 does not exist on the 30th")
+}
+
+func TestParseCron_Errors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+		_, err := ParseCron(expr, nil)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestLinkSigner(t *testing.T) {
+	signer := NewLinkSigner([]byte("secret"))
+	expires := june.Add(time.Hour)
+	signature := signer.Sign(42, expires)
+
+	assert.NoError(t, signer.Verify(42, expires, signature, june))
+	assert.ErrorIs(t, signer.Verify(43, expires, signature, june), ErrLinkSignature)
+	assert.ErrorIs(t, signer.Verify(42, expires.Add(time.Hour), signature, june), ErrLinkSignature)
+	assert.ErrorIs(t, signer.Verify(42, expires, "zz", june), ErrLinkSignature)
+	assert.ErrorIs(t, signer.Verify(42, expires, signature, expires), ErrLinkExpired)
+	assert.ErrorIs(t, NewLinkSigner([]byte("other")).Verify(42, expires, signature, june), ErrLinkSignature)
+
+	link := signer.URL("https://analytics.example.com/analytics/reports", 42, expires)
+	assert.True(t, strings.HasPrefix(link, "https://analytics.example.com/analytics/reports/42/download?expires="))
+	assert.Contains(t, link, "signature="+signature)
+}
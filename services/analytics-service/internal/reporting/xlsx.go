@@ -0,0 +1,205 @@
+package reporting
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxSheetName is the longest worksheet name spreadsheet applications accept
+const maxSheetName = 31
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`%s</Types>`
+	xlsxSheetType = `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`
+
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets>%s</sheets></workbook>`
+	xlsxSheetEntry = `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`
+
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">%s</Relationships>`
+	xlsxSheetRel = `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`
+
+	xlsxWorksheet = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>%s</sheetData></worksheet>`
+)
+
+// sheet is a worksheet's name and rows
+type sheet struct {
+	name string
+	rows [][]interface{}
+}
+
+// renderXLSX writes a workbook with the summary on its first sheet and a sheet
+// per table. Cells hold inline strings, so no shared string table is needed.
+func renderXLSX(doc *Document) ([]byte, error) {
+	summary := sheet{name: "Summary", rows: [][]interface{}{
+		{doc.Title},
+		{"Period", doc.Start, doc.End},
+		{"Generated", doc.GeneratedAt},
+	}}
+	for _, field := range doc.Summary {
+		summary.rows = append(summary.rows, []interface{}{field.Name, field.Value})
+	}
+
+	sheets := []sheet{summary}
+	used := map[string]bool{strings.ToLower(summary.name): true}
+	for _, table := range doc.Tables {
+		rows := make([][]interface{}, 0, len(table.Rows)+1)
+		header := make([]interface{}, len(table.Columns))
+		for i, column := range table.Columns {
+			header[i] = column
+		}
+		rows = append(rows, header)
+		rows = append(rows, table.Rows...)
+		sheets = append(sheets, sheet{name: sheetName(table.Name, used), rows: rows})
+	}
+
+	var types, entries, rels strings.Builder
+	for i, s := range sheets {
+		fmt.Fprintf(&types, xlsxSheetType, i+1)
+		fmt.Fprintf(&entries, xlsxSheetEntry, escapeXML(s.name), i+1, i+1)
+		fmt.Fprintf(&rels, xlsxSheetRel, i+1, i+1)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", fmt.Sprintf(xlsxContentTypes, types.String())},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, entries.String())},
+		{"xl/_rels/workbook.xml.rels", fmt.Sprintf(xlsxWorkbookRels, rels.String())},
+	}
+	for i, s := range sheets {
+		parts = append(parts, struct{ name, body string }{
+			fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1),
+			fmt.Sprintf(xlsxWorksheet, sheetData(s.rows)),
+		})
+	}
+	for _, part := range parts {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sheetName makes a table name a valid worksheet name that no earlier sheet uses
+func sheetName(name string, used map[string]bool) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	name = strings.Trim(name, "'")
+	if name == "" {
+		name = "Sheet"
+	}
+	name = truncateRunes(name, maxSheetName)
+
+	candidate := name
+	for n := 2; used[strings.ToLower(candidate)]; n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		candidate = truncateRunes(name, maxSheetName-len(suffix)) + suffix
+	}
+	used[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
+
+// sheetData renders rows as worksheet XML
+func sheetData(rows [][]interface{}) string {
+	var b strings.Builder
+	for r, row := range rows {
+		fmt.Fprintf(&b, `<row r="%d">`, r+1)
+		for c, value := range row {
+			ref := columnName(c) + strconv.Itoa(r+1)
+			if number, ok := numericCell(value); ok {
+				fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, number)
+				continue
+			}
+			if text := formatCell(value); text != "" {
+				fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escapeXML(text))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	return b.String()
+}
+
+// numericCell returns a value's text when it should be stored as a number
+func numericCell(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case int:
+		return strconv.FormatInt(int64(v), 10), true
+	case int32:
+		return strconv.FormatInt(int64(v), 10), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint64:
+		return strconv.FormatUint(v, 10), true
+	case float32:
+		return floatCell(float64(v))
+	case float64:
+		return floatCell(v)
+	}
+	return "", false
+}
+
+func floatCell(v float64) (string, bool) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "", false
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64), true
+}
+
+// columnName converts a zero-based column index to its letters: A, B, ... AA
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
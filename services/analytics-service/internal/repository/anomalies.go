@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/anomaly"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	ResolutionHour = "hour"
+	ResolutionDay  = "day"
+
+	EpisodeOpen     = "open"
+	EpisodeResolved = "resolved"
+
+	maxAnomalyWindow = 24 * 14
+)
+
+// AnomalySetting tunes detection for one metric of one club. Metrics without a
+// stored setting use DefaultAnomalySetting.
+type AnomalySetting struct {
+	ID          uint     `json:"id" gorm:"primaryKey"`
+	ClubID      string   `json:"club_id" gorm:"uniqueIndex:idx_anomaly_setting_metric;size:255"`
+	MetricName  string   `json:"metric_name" gorm:"uniqueIndex:idx_anomaly_setting_metric;size:100"`
+	Sensitivity float64  `json:"sensitivity"`
+	Methods     []string `json:"methods" gorm:"serializer:json"`
+	// Window is the number of trailing buckets the rolling detectors learn from
+	Window     int    `json:"window" gorm:"column:window_size"`
+	Resolution string `json:"resolution" gorm:"size:10"`
+	// CooldownMinutes is how long an episode stays open after its last anomaly
+	CooldownMinutes int       `json:"cooldown_minutes"`
+	Enabled         bool      `json:"enabled"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (AnomalySetting) TableName() string {
+	return "analytics_anomaly_settings"
+}
+
+// DefaultAnomalySetting runs every detector over hourly buckets with a day of
+// history and a three hour cooldown
+func DefaultAnomalySetting(clubID, metricName string) *AnomalySetting {
+	return &AnomalySetting{
+		ClubID:          clubID,
+		MetricName:      metricName,
+		Sensitivity:     0.5,
+		Window:          24,
+		Resolution:      ResolutionHour,
+		CooldownMinutes: 180,
+		Enabled:         true,
+	}
+}
+
+func (s *AnomalySetting) Validate() error {
+	if s.ClubID == "" || s.MetricName == "" {
+		return fmt.Errorf("club_id and metric_name are required")
+	}
+	if s.Sensitivity < 0 || s.Sensitivity > 1 {
+		return fmt.Errorf("sensitivity must be between 0 and 1")
+	}
+	if s.Window < 3 || s.Window > maxAnomalyWindow {
+		return fmt.Errorf("window must be between 3 and %d buckets", maxAnomalyWindow)
+	}
+	if s.Resolution != ResolutionHour && s.Resolution != ResolutionDay {
+		return fmt.Errorf("resolution must be %q or %q", ResolutionHour, ResolutionDay)
+	}
+	if s.CooldownMinutes < 0 {
+		return fmt.Errorf("cooldown_minutes must not be negative")
+	}
+	for _, method := range s.Methods {
+		if !knownMethod(anomaly.Method(method)) {
+			return fmt.Errorf("unknown detection method: %s", method)
+		}
+	}
+	return nil
+}
+
+// Config converts the setting into detector configuration. Hourly metrics have
+// a daily cycle and daily metrics a weekly one.
+func (s *AnomalySetting) Config() anomaly.Config {
+	cfg := anomaly.Config{
+		Sensitivity: s.Sensitivity,
+		Window:      s.Window,
+		Step:        time.Hour,
+		Period:      24,
+	}
+	if s.Resolution == ResolutionDay {
+		cfg.Step = 24 * time.Hour
+		cfg.Period = 7
+	}
+	for _, method := range s.Methods {
+		cfg.Methods = append(cfg.Methods, anomaly.Method(method))
+	}
+	return cfg
+}
+
+// Lookback is how much history continuous monitoring scores the latest bucket
+// against: a week of hours or thirteen weeks of days
+func (s *AnomalySetting) Lookback() time.Duration {
+	if s.Resolution == ResolutionDay {
+		return 91 * 24 * time.Hour
+	}
+	return 7 * 24 * time.Hour
+}
+
+func (s *AnomalySetting) Cooldown() time.Duration {
+	return time.Duration(s.CooldownMinutes) * time.Minute
+}
+
+// AnomalyEpisode groups consecutive anomalies of a metric so a sustained
+// incident raises one alert. It stays open until no anomaly has been seen for
+// the setting's cooldown.
+type AnomalyEpisode struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	ClubID        string     `json:"club_id" gorm:"index:idx_anomaly_episode_metric;size:255"`
+	MetricName    string     `json:"metric_name" gorm:"index:idx_anomaly_episode_metric;size:100"`
+	Status        string     `json:"status" gorm:"index;size:20"`
+	Severity      string     `json:"severity" gorm:"size:20"`
+	Direction     string     `json:"direction" gorm:"size:20"`
+	Methods       []string   `json:"methods" gorm:"serializer:json"`
+	PeakScore     float64    `json:"peak_score"`
+	PeakValue     float64    `json:"peak_value"`
+	ExpectedValue float64    `json:"expected_value"`
+	Occurrences   int        `json:"occurrences"`
+	StartedAt     time.Time  `json:"started_at"`
+	LastSeenAt    time.Time  `json:"last_seen_at"`
+	ExpiresAt     time.Time  `json:"expires_at" gorm:"index"`
+	ResolvedAt    *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func (AnomalyEpisode) TableName() string {
+	return "analytics_anomaly_episodes"
+}
+
+func (r *repository) GetAnomalySetting(clubID string, metricName string) (*AnomalySetting, error) {
+	var setting AnomalySetting
+	err := r.db.Where("club_id = ? AND metric_name = ?", clubID, metricName).First(&setting).Error
+	if err == gorm.ErrRecordNotFound {
+		return DefaultAnomalySetting(clubID, metricName), nil
+	}
+	if err != nil {
+		r.logger.Error("Failed to get anomaly setting", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		return nil, fmt.Errorf("failed to get anomaly setting: %w", err)
+	}
+	return &setting, nil
+}
+
+func (r *repository) SaveAnomalySetting(setting *AnomalySetting) error {
+	if err := setting.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "club_id"}, {Name: "metric_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sensitivity", "methods", "window_size", "resolution", "cooldown_minutes", "enabled", "updated_at"}),
+	}).Create(setting).Error; err != nil {
+		r.logger.Error("Failed to save anomaly setting", map[string]interface{}{"error": err.Error(), "club_id": setting.ClubID, "metric_name": setting.MetricName})
+		return fmt.Errorf("failed to save anomaly setting: %w", err)
+	}
+
+	r.logger.Info("Saved anomaly setting", map[string]interface{}{"club_id": setting.ClubID, "metric_name": setting.MetricName, "sensitivity": setting.Sensitivity})
+	return nil
+}
+
+// DetectAnomalies runs the detectors configured by the setting over the metric's
+// raw values in the time range
+func (r *repository) DetectAnomalies(clubID string, metricName string, timeRange TimeRange, setting *AnomalySetting) (*anomaly.Result, error) {
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("time range end must be after start")
+	}
+
+	var metrics []*AnalyticsMetric
+	if err := r.db.Select("timestamp", "metric_value").
+		Where("club_id = ? AND metric_name = ? AND timestamp >= ? AND timestamp < ?", clubID, metricName, timeRange.Start, timeRange.End).
+		Order("timestamp ASC").
+		Find(&metrics).Error; err != nil {
+		r.logger.Error("Failed to get anomaly history", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		return nil, fmt.Errorf("failed to get anomaly history: %w", err)
+	}
+
+	points := make([]anomaly.Point, len(metrics))
+	for i, metric := range metrics {
+		points[i] = anomaly.Point{Timestamp: metric.Timestamp, Value: metric.MetricValue}
+	}
+	return anomaly.Detect(points, timeRange.Start, timeRange.End, setting.Config()), nil
+}
+
+func (r *repository) GetAnomalyDetection(clubID string, metricName string, timeRange TimeRange) (map[string]interface{}, error) {
+	setting, err := r.GetAnomalySetting(clubID, metricName)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.DetectAnomalies(clubID, metricName, timeRange, setting)
+	if err != nil {
+		return nil, err
+	}
+	return AnomalyReport(result, setting), nil
+}
+
+// AnomalyReport renders a detection result in the map shape the handlers return
+func AnomalyReport(result *anomaly.Result, setting *AnomalySetting) map[string]interface{} {
+	anomalies := []map[string]interface{}{}
+	for _, a := range result.Anomalies {
+		anomalies = append(anomalies, map[string]interface{}{
+			"timestamp":      a.Timestamp,
+			"value":          a.Value,
+			"expected_value": a.Expected,
+			"anomaly_score":  a.Score,
+			"probability":    a.Probability,
+			"severity":       a.Severity,
+			"direction":      a.Direction,
+			"methods":        a.Methods,
+		})
+	}
+
+	methods := result.Config.Methods
+	if len(methods) == 0 {
+		methods = anomaly.Methods
+	}
+
+	return map[string]interface{}{
+		"anomalies": anomalies,
+		"summary": map[string]interface{}{
+			"total_anomalies": len(anomalies),
+			"high_severity":   result.CountBySeverity(anomaly.SeverityHigh),
+			"medium_severity": result.CountBySeverity(anomaly.SeverityMedium),
+			"low_severity":    result.CountBySeverity(anomaly.SeverityLow),
+			"threshold":       result.Threshold,
+			"sensitivity":     result.Config.Sensitivity,
+			"methods":         methods,
+			"resolution":      setting.Resolution,
+			"buckets":         result.Buckets,
+		},
+	}
+}
+
+// GetOpenAnomalyEpisode returns the metric's open episode, or nil when there is none
+func (r *repository) GetOpenAnomalyEpisode(clubID string, metricName string) (*AnomalyEpisode, error) {
+	var episode AnomalyEpisode
+	err := r.db.Where("club_id = ? AND metric_name = ? AND status = ?", clubID, metricName, EpisodeOpen).
+		Order("started_at DESC").
+		First(&episode).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		r.logger.Error("Failed to get open anomaly episode", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		return nil, fmt.Errorf("failed to get open anomaly episode: %w", err)
+	}
+	return &episode, nil
+}
+
+func (r *repository) SaveAnomalyEpisode(episode *AnomalyEpisode) error {
+	if err := r.db.Save(episode).Error; err != nil {
+		r.logger.Error("Failed to save anomaly episode", map[string]interface{}{"error": err.Error(), "club_id": episode.ClubID, "metric_name": episode.MetricName})
+		return fmt.Errorf("failed to save anomaly episode: %w", err)
+	}
+	return nil
+}
+
+// GetExpiredAnomalyEpisodes returns open episodes whose cooldown ended before the given time
+func (r *repository) GetExpiredAnomalyEpisodes(before time.Time) ([]*AnomalyEpisode, error) {
+	var episodes []*AnomalyEpisode
+	if err := r.db.Where("status = ? AND expires_at < ?", EpisodeOpen, before).Find(&episodes).Error; err != nil {
+		r.logger.Error("Failed to get expired anomaly episodes", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to get expired anomaly episodes: %w", err)
+	}
+	return episodes, nil
+}
+
+// ListAnomalyEpisodes returns a club's episodes, newest first, optionally
+// narrowed to one metric and status
+func (r *repository) ListAnomalyEpisodes(clubID string, metricName string, status string, limit int) ([]*AnomalyEpisode, error) {
+	query := r.db.Where("club_id = ?", clubID)
+	if metricName != "" {
+		query = query.Where("metric_name = ?", metricName)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var episodes []*AnomalyEpisode
+	if err := query.Order("started_at DESC").Limit(limit).Find(&episodes).Error; err != nil {
+		r.logger.Error("Failed to list anomaly episodes", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to list anomaly episodes: %w", err)
+	}
+	return episodes, nil
+}
+
+func knownMethod(method anomaly.Method) bool {
+	for _, m := range anomaly.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
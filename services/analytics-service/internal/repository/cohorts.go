@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"fmt"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+)
+
+// CohortAnalysis follows the club's members who joined in the time range up
+// to its end. Every member who joined before the end is read for churn and
+// engagement; member status history, attended reciprocal visits and votes
+// feed the analysis.
+func (r *repository) CohortAnalysis(clubID uint, timeRange TimeRange, options cohort.Options) (*cohort.Report, error) {
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+	options = options.WithDefaults()
+
+	var memberFacts []*MemberFact
+	if err := r.db.Where("club_id = ?", clubID).Order("member_id").Find(&memberFacts).Error; err != nil {
+		r.logger.Error("Failed to get member facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get member facts: %w", err)
+	}
+
+	var changes []*MemberStatusChange
+	if err := r.db.Where("club_id = ?", clubID).Order("member_id, changed_at").Find(&changes).Error; err != nil {
+		r.logger.Error("Failed to get member status changes", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get member status changes: %w", err)
+	}
+	history := make(map[uint][]cohort.StatusChange)
+	for _, change := range changes {
+		history[change.MemberID] = append(history[change.MemberID], cohort.StatusChange{At: change.ChangedAt, Status: change.Status})
+	}
+
+	members := make([]cohort.Member, 0, len(memberFacts))
+	for _, fact := range memberFacts {
+		member := cohort.Member{
+			MemberID:       fact.MemberID,
+			MembershipType: fact.MembershipType,
+			RemovedAt:      fact.RemovedAt,
+			Changes:        history[fact.MemberID],
+		}
+		// Members whose creation was never seen joined by their first event
+		switch {
+		case fact.JoinedAt != nil:
+			member.JoinedAt = *fact.JoinedAt
+		case len(member.Changes) > 0:
+			member.JoinedAt = member.Changes[0].At
+		default:
+			continue
+		}
+		if member.JoinedAt.Before(timeRange.End) {
+			members = append(members, member)
+		}
+	}
+
+	// Activity is read from the earlier of the start of the range, which
+	// renewal usage is counted from, and the start of the risk windows
+	from := timeRange.End.Add(-2 * options.RiskWindow)
+	if timeRange.Start.Before(from) {
+		from = timeRange.Start
+	}
+
+	var visits []*VisitFact
+	err := r.db.Where("home_club_id = ? AND status IN ? AND visit_date >= ? AND visit_date < ?",
+		clubID, []string{network.StatusCheckedIn, network.StatusCompleted}, from, timeRange.End).Find(&visits).Error
+	if err != nil {
+		r.logger.Error("Failed to get visit facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get visit facts: %w", err)
+	}
+
+	var votes []*VoteFact
+	err = r.db.Where("club_id = ? AND cast_at >= ? AND cast_at < ?", clubID, from, timeRange.End).Find(&votes).Error
+	if err != nil {
+		r.logger.Error("Failed to get vote facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get vote facts: %w", err)
+	}
+
+	activities := make([]cohort.Activity, 0, len(visits)+len(votes))
+	for _, visit := range visits {
+		activities = append(activities, cohort.Activity{MemberID: visit.MemberID, At: visit.VisitDate, Visit: true})
+	}
+	for _, vote := range votes {
+		activities = append(activities, cohort.Activity{MemberID: vote.MemberID, At: vote.CastAt})
+	}
+
+	return cohort.Build(members, activities, clubID, timeRange.Start, timeRange.End, options), nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
+)
+
+const (
+	minDashboardRefresh = 5
+	maxDashboardRefresh = 24 * 60 * 60
+)
+
+// Dashboard is a declarative dashboard whose panels are evaluated by the
+// service against the metric rollups
+type Dashboard struct {
+	ID          uint                `json:"id" gorm:"primaryKey"`
+	ClubID      string              `json:"club_id" gorm:"index;size:255"`
+	Name        string              `json:"name" gorm:"size:255"`
+	Description string              `json:"description" gorm:"type:text"`
+	Panels      []dashboard.Panel   `json:"panels" gorm:"serializer:json"`
+	TimeRange   dashboard.TimeRange `json:"time_range" gorm:"serializer:json"`
+	// RefreshSeconds is how often viewers should reload the dashboard, 0 for never
+	RefreshSeconds int  `json:"refresh_seconds"`
+	IsPublic       bool `json:"is_public" gorm:"default:false"`
+	// EmbedVersion is signed into embed tokens; bumping it revokes them
+	EmbedVersion    int        `json:"-" gorm:"default:0"`
+	GrafanaSyncedAt *time.Time `json:"grafana_synced_at,omitempty"`
+	CreatedBy       string     `json:"created_by" gorm:"size:255"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func (Dashboard) TableName() string {
+	return "analytics_dashboards"
+}
+
+// Validate checks the dashboard and fills in panel defaults
+func (d *Dashboard) Validate() error {
+	if d.ClubID == "" || d.Name == "" {
+		return fmt.Errorf("club_id and name are required")
+	}
+	if d.RefreshSeconds != 0 && (d.RefreshSeconds < minDashboardRefresh || d.RefreshSeconds > maxDashboardRefresh) {
+		return fmt.Errorf("refresh_seconds must be 0 or between %d and %d", minDashboardRefresh, maxDashboardRefresh)
+	}
+	if d.TimeRange.IsZero() {
+		d.TimeRange = dashboard.DefaultTimeRange
+	}
+	if _, _, err := d.TimeRange.Resolve(time.Now()); err != nil {
+		return err
+	}
+	return dashboard.ValidatePanels(d.Panels)
+}
+
+// VisibleTo reports whether a club may view the dashboard
+func (d *Dashboard) VisibleTo(clubID string) bool {
+	return d.IsPublic || d.ClubID == clubID
+}
+
+// GrafanaUID is the UID the dashboard is exported to Grafana under
+func (d *Dashboard) GrafanaUID() string {
+	return fmt.Sprintf("analytics-dashboard-%d", d.ID)
+}
+
+// Dashboard operations
+func (r *repository) CreateDashboard(dashboard *Dashboard) error {
+	if err := r.db.Create(dashboard).Error; err != nil {
+		r.logger.Error("Failed to create dashboard", map[string]interface{}{"error": err.Error()})
+		return fmt.Errorf("failed to create dashboard: %w", err)
+	}
+
+	r.logger.Info("Created dashboard", map[string]interface{}{"dashboard_id": dashboard.ID, "club_id": dashboard.ClubID})
+	return nil
+}
+
+func (r *repository) GetDashboard(dashboardID uint) (*Dashboard, error) {
+	var dashboard Dashboard
+	if err := r.db.First(&dashboard, dashboardID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("Failed to get dashboard", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to get dashboard: %w", err)
+	}
+	return &dashboard, nil
+}
+
+func (r *repository) UpdateDashboard(dashboard *Dashboard) error {
+	if err := r.db.Save(dashboard).Error; err != nil {
+		r.logger.Error("Failed to update dashboard", map[string]interface{}{"error": err.Error()})
+		return fmt.Errorf("failed to update dashboard: %w", err)
+	}
+
+	r.logger.Info("Updated dashboard", map[string]interface{}{"dashboard_id": dashboard.ID})
+	return nil
+}
+
+func (r *repository) DeleteDashboard(dashboardID uint) error {
+	result := r.db.Delete(&Dashboard{}, dashboardID)
+	if result.Error != nil {
+		r.logger.Error("Failed to delete dashboard", map[string]interface{}{"error": result.Error.Error()})
+		return fmt.Errorf("failed to delete dashboard: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	r.logger.Info("Deleted dashboard", map[string]interface{}{"dashboard_id": dashboardID})
+	return nil
+}
+
+func (r *repository) ListDashboards(clubID string, limit, offset int) ([]*Dashboard, error) {
+	var dashboards []*Dashboard
+	query := r.db.Where("club_id = ? OR is_public = ?", clubID, true)
+
+	if err := query.Limit(limit).Offset(offset).Order("created_at DESC").Find(&dashboards).Error; err != nil {
+		r.logger.Error("Failed to list dashboards", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to list dashboards: %w", err)
+	}
+
+	return dashboards, nil
+}
@@ -0,0 +1,517 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Backfill sources, one per domain table facts can be rebuilt from
+const (
+	BackfillVisits     = "visits"
+	BackfillAgreements = "agreements"
+	BackfillMembers    = "members"
+	BackfillVotes      = "votes"
+
+	backfillBatchSize = 500
+)
+
+// BackfillSources lists every source in the order a full backfill reads them
+var BackfillSources = []string{BackfillAgreements, BackfillMembers, BackfillVisits, BackfillVotes}
+
+// Fact is a typed row derived from a domain event or a backfilled record
+type Fact interface {
+	upsert(tx *gorm.DB) error
+}
+
+// IngestedMessage records a message ID whose event has been stored, so
+// redelivered messages are not applied twice
+type IngestedMessage struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	MessageID  string    `json:"message_id" gorm:"uniqueIndex;size:100;not null"`
+	Subject    string    `json:"subject" gorm:"size:100"`
+	EventType  string    `json:"event_type" gorm:"size:100"`
+	IngestedAt time.Time `json:"ingested_at" gorm:"index"`
+}
+
+func (IngestedMessage) TableName() string {
+	return "analytics_ingested_messages"
+}
+
+// VisitFact is the latest known state of a reciprocal visit
+type VisitFact struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	VisitID        uint       `json:"visit_id" gorm:"uniqueIndex;not null"`
+	AgreementID    uint       `json:"agreement_id" gorm:"index"`
+	MemberID       uint       `json:"member_id" gorm:"index"`
+	VisitingClubID uint       `json:"visiting_club_id" gorm:"index;not null"`
+	HomeClubID     uint       `json:"home_club_id" gorm:"index;not null"`
+	Status         string     `json:"status" gorm:"size:20"`
+	VisitDate      time.Time  `json:"visit_date" gorm:"index"`
+	FacilitiesUsed []string   `json:"facilities_used,omitempty" gorm:"serializer:json"`
+	GuestCount     int        `json:"guest_count"`
+	MemberRating   *int       `json:"member_rating,omitempty"`
+	ClubRating     *int       `json:"club_rating,omitempty"`
+	RequestedAt    *time.Time `json:"requested_at,omitempty"`
+	ConfirmedAt    *time.Time `json:"confirmed_at,omitempty"`
+	CheckedInAt    *time.Time `json:"checked_in_at,omitempty"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+	LastEventAt    time.Time  `json:"last_event_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func (VisitFact) TableName() string {
+	return "analytics_visit_facts"
+}
+
+// AgreementFact is the latest known state of a reciprocal agreement
+type AgreementFact struct {
+	ID                uint       `json:"id" gorm:"primaryKey"`
+	AgreementID       uint       `json:"agreement_id" gorm:"uniqueIndex;not null"`
+	ProposingClubID   uint       `json:"proposing_club_id" gorm:"index;not null"`
+	TargetClubID      uint       `json:"target_club_id" gorm:"index;not null"`
+	Status            string     `json:"status" gorm:"size:20"`
+	MaxVisitsPerMonth int        `json:"max_visits_per_month"`
+	ProposedAt        *time.Time `json:"proposed_at,omitempty"`
+	LastEventAt       time.Time  `json:"last_event_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+func (AgreementFact) TableName() string {
+	return "analytics_agreement_facts"
+}
+
+// MemberFact is the latest known state of a club member
+type MemberFact struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	MemberID       uint       `json:"member_id" gorm:"uniqueIndex;not null"`
+	ClubID         uint       `json:"club_id" gorm:"index;not null"`
+	UserID         uint       `json:"user_id" gorm:"index"`
+	MemberNumber   string     `json:"member_number" gorm:"size:50"`
+	MembershipType string     `json:"membership_type" gorm:"size:20"`
+	Status         string     `json:"status" gorm:"size:20"`
+	JoinedAt       *time.Time `json:"joined_at,omitempty"`
+	RemovedAt      *time.Time `json:"removed_at,omitempty"`
+	LastEventAt    time.Time  `json:"last_event_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func (MemberFact) TableName() string {
+	return "analytics_member_facts"
+}
+
+// MemberStatusChange is a member's status and membership type as of one
+// member event, so cohort analysis can tell whether a member was active at
+// any point in the past rather than only now
+type MemberStatusChange struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	MemberID       uint      `json:"member_id" gorm:"uniqueIndex:idx_member_status_change;not null"`
+	ClubID         uint      `json:"club_id" gorm:"index;not null"`
+	MembershipType string    `json:"membership_type" gorm:"size:20"`
+	Status         string    `json:"status" gorm:"size:20"`
+	ChangedAt      time.Time `json:"changed_at" gorm:"uniqueIndex:idx_member_status_change"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (MemberStatusChange) TableName() string {
+	return "analytics_member_status_changes"
+}
+
+// VoteFact is a governance vote; votes are never changed once cast
+type VoteFact struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	VoteID     uint      `json:"vote_id" gorm:"uniqueIndex;not null"`
+	ProposalID uint      `json:"proposal_id" gorm:"index;not null"`
+	MemberID   uint      `json:"member_id" gorm:"index"`
+	ClubID     uint      `json:"club_id" gorm:"index;not null"`
+	Choice     string    `json:"choice" gorm:"size:10"`
+	CastAt     time.Time `json:"cast_at" gorm:"index"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (VoteFact) TableName() string {
+	return "analytics_vote_facts"
+}
+
+// Events can arrive out of order, so each upsert keeps the earliest lifecycle
+// timestamps it has seen and only takes the state of an event at least as new
+// as the one already stored.
+
+func (f *VisitFact) upsert(tx *gorm.DB) error {
+	var existing VisitFact
+	err := tx.Where("visit_id = ?", f.VisitID).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Create(f).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	existing.RequestedAt = earliest(existing.RequestedAt, f.RequestedAt)
+	existing.ConfirmedAt = earliest(existing.ConfirmedAt, f.ConfirmedAt)
+	existing.CheckedInAt = earliest(existing.CheckedInAt, f.CheckedInAt)
+	existing.CompletedAt = earliest(existing.CompletedAt, f.CompletedAt)
+
+	// Visit details and feedback are not on every event, so an event without
+	// them keeps the stored values
+	newer := !f.LastEventAt.Before(existing.LastEventAt)
+	if f.FacilitiesUsed != nil && (newer || existing.FacilitiesUsed == nil) {
+		existing.FacilitiesUsed = f.FacilitiesUsed
+	}
+	if f.GuestCount > 0 && (newer || existing.GuestCount == 0) {
+		existing.GuestCount = f.GuestCount
+	}
+	if f.MemberRating != nil && (newer || existing.MemberRating == nil) {
+		existing.MemberRating = f.MemberRating
+	}
+	if f.ClubRating != nil && (newer || existing.ClubRating == nil) {
+		existing.ClubRating = f.ClubRating
+	}
+
+	if newer {
+		existing.AgreementID = f.AgreementID
+		existing.MemberID = f.MemberID
+		existing.VisitingClubID = f.VisitingClubID
+		existing.HomeClubID = f.HomeClubID
+		existing.Status = f.Status
+		existing.VisitDate = f.VisitDate
+		existing.LastEventAt = f.LastEventAt
+	}
+	return tx.Save(&existing).Error
+}
+
+func (f *AgreementFact) upsert(tx *gorm.DB) error {
+	var existing AgreementFact
+	err := tx.Where("agreement_id = ?", f.AgreementID).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Create(f).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	existing.ProposedAt = earliest(existing.ProposedAt, f.ProposedAt)
+	newer := !f.LastEventAt.Before(existing.LastEventAt)
+	if f.MaxVisitsPerMonth > 0 && (newer || existing.MaxVisitsPerMonth == 0) {
+		existing.MaxVisitsPerMonth = f.MaxVisitsPerMonth
+	}
+	if newer {
+		existing.ProposingClubID = f.ProposingClubID
+		existing.TargetClubID = f.TargetClubID
+		existing.Status = f.Status
+		existing.LastEventAt = f.LastEventAt
+	}
+	return tx.Save(&existing).Error
+}
+
+func (f *MemberFact) upsert(tx *gorm.DB) error {
+	// Every event adds to the member's history, whatever order it arrives in
+	change := &MemberStatusChange{
+		MemberID:       f.MemberID,
+		ClubID:         f.ClubID,
+		MembershipType: f.MembershipType,
+		Status:         f.Status,
+		ChangedAt:      f.LastEventAt,
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(change).Error; err != nil {
+		return err
+	}
+
+	var existing MemberFact
+	err := tx.Where("member_id = ?", f.MemberID).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Create(f).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	existing.JoinedAt = earliest(existing.JoinedAt, f.JoinedAt)
+	existing.RemovedAt = earliest(existing.RemovedAt, f.RemovedAt)
+	if !f.LastEventAt.Before(existing.LastEventAt) {
+		existing.ClubID = f.ClubID
+		existing.UserID = f.UserID
+		existing.MemberNumber = f.MemberNumber
+		existing.MembershipType = f.MembershipType
+		existing.Status = f.Status
+		existing.LastEventAt = f.LastEventAt
+	}
+	return tx.Save(&existing).Error
+}
+
+func (f *VoteFact) upsert(tx *gorm.DB) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "vote_id"}},
+		DoNothing: true,
+	}).Create(f).Error
+}
+
+// IngestEvent stores the fact of a domain event unless its message has been
+// ingested before. It reports whether the fact was stored.
+func (r *repository) IngestEvent(message *IngestedMessage, fact Fact) (bool, error) {
+	if message.MessageID == "" {
+		return false, fmt.Errorf("message id is required")
+	}
+	if message.IngestedAt.IsZero() {
+		message.IngestedAt = time.Now()
+	}
+
+	ingested := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "message_id"}},
+			DoNothing: true,
+		}).Create(message)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		ingested = true
+		return fact.upsert(tx)
+	})
+	if err != nil {
+		r.logger.Error("Failed to ingest event", map[string]interface{}{"error": err.Error(), "message_id": message.MessageID, "event_type": message.EventType})
+		return false, fmt.Errorf("failed to ingest event: %w", err)
+	}
+
+	return ingested, nil
+}
+
+// BackfillFacts rebuilds facts from a source service's table, reading the rows
+// updated since the given time from that service's own database. It returns
+// the number of rows read.
+func (r *repository) BackfillFacts(source string, sourceDB *gorm.DB, since time.Time) (int, error) {
+	if sourceDB == nil {
+		return 0, fmt.Errorf("no database configured for backfill source: %s", source)
+	}
+
+	var (
+		count int
+		err   error
+	)
+
+	switch source {
+	case BackfillVisits:
+		var rows []sourceVisit
+		count, err = r.backfill(sourceDB.Where("deleted_at IS NULL"), &rows, since, func() []Fact {
+			facts := make([]Fact, len(rows))
+			for i := range rows {
+				facts[i] = rows[i].fact()
+			}
+			return facts
+		})
+	case BackfillAgreements:
+		var rows []sourceAgreement
+		count, err = r.backfill(sourceDB.Where("deleted_at IS NULL"), &rows, since, func() []Fact {
+			facts := make([]Fact, len(rows))
+			for i := range rows {
+				facts[i] = rows[i].fact()
+			}
+			return facts
+		})
+	case BackfillMembers:
+		// Deleted members are kept so their removal is recorded
+		var rows []sourceMember
+		count, err = r.backfill(sourceDB, &rows, since, func() []Fact {
+			facts := make([]Fact, len(rows))
+			for i := range rows {
+				facts[i] = rows[i].fact()
+			}
+			return facts
+		})
+	case BackfillVotes:
+		var rows []sourceVote
+		count, err = r.backfill(sourceDB, &rows, since, func() []Fact {
+			facts := make([]Fact, len(rows))
+			for i := range rows {
+				facts[i] = rows[i].fact()
+			}
+			return facts
+		})
+	default:
+		return 0, fmt.Errorf("unknown backfill source: %s", source)
+	}
+
+	if err != nil {
+		r.logger.Error("Failed to backfill facts", map[string]interface{}{"error": err.Error(), "source": source})
+		return count, fmt.Errorf("failed to backfill %s: %w", source, err)
+	}
+
+	r.logger.Info("Backfilled facts", map[string]interface{}{"source": source, "rows": count, "since": since})
+	return count, nil
+}
+
+// backfill reads rows from the source query in batches into dest and upserts
+// the facts built from each batch into the analytics database in its own
+// transaction
+func (r *repository) backfill(query *gorm.DB, dest interface{}, since time.Time, facts func() []Fact) (int, error) {
+	if !since.IsZero() {
+		query = query.Where("updated_at >= ?", since)
+	}
+
+	count := 0
+	result := query.Order("id").FindInBatches(dest, backfillBatchSize, func(batch *gorm.DB, _ int) error {
+		count += int(batch.RowsAffected)
+		return r.db.Transaction(func(tx *gorm.DB) error {
+			for _, fact := range facts() {
+				if err := fact.upsert(tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	return count, result.Error
+}
+
+// Source rows hold only the columns facts are built from
+
+type sourceVisit struct {
+	ID             uint
+	AgreementID    uint
+	MemberID       uint
+	VisitingClubID uint
+	HomeClubID     uint
+	Status         string
+	VisitDate      time.Time
+	FacilitiesUsed []string `gorm:"serializer:json"`
+	GuestCount     int
+	MemberRating   *int
+	ClubRating     *int
+	CheckInTime    *time.Time
+	CheckOutTime   *time.Time
+	VerifiedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (sourceVisit) TableName() string {
+	return "reciprocal_visits"
+}
+
+func (v *sourceVisit) fact() Fact {
+	requestedAt := v.CreatedAt
+	return &VisitFact{
+		VisitID:        v.ID,
+		AgreementID:    v.AgreementID,
+		MemberID:       v.MemberID,
+		VisitingClubID: v.VisitingClubID,
+		HomeClubID:     v.HomeClubID,
+		Status:         v.Status,
+		VisitDate:      v.VisitDate,
+		FacilitiesUsed: v.FacilitiesUsed,
+		GuestCount:     v.GuestCount,
+		MemberRating:   v.MemberRating,
+		ClubRating:     v.ClubRating,
+		RequestedAt:    &requestedAt,
+		ConfirmedAt:    v.VerifiedAt,
+		CheckedInAt:    v.CheckInTime,
+		CompletedAt:    v.CheckOutTime,
+		LastEventAt:    v.UpdatedAt,
+	}
+}
+
+type sourceAgreement struct {
+	ID              uint
+	ProposingClubID uint
+	TargetClubID    uint
+	Status          string
+	Terms           sourceTerms `gorm:"serializer:json"`
+	ProposedAt      time.Time
+	UpdatedAt       time.Time
+}
+
+// sourceTerms holds the agreement terms analytics reports on
+type sourceTerms struct {
+	MaxVisitsPerMonth int `json:"max_visits_per_month"`
+}
+
+func (sourceAgreement) TableName() string {
+	return "reciprocal_agreements"
+}
+
+func (a *sourceAgreement) fact() Fact {
+	proposedAt := a.ProposedAt
+	return &AgreementFact{
+		AgreementID:       a.ID,
+		ProposingClubID:   a.ProposingClubID,
+		TargetClubID:      a.TargetClubID,
+		Status:            a.Status,
+		MaxVisitsPerMonth: a.Terms.MaxVisitsPerMonth,
+		ProposedAt:        &proposedAt,
+		LastEventAt:       a.UpdatedAt,
+	}
+}
+
+type sourceMember struct {
+	ID             uint
+	ClubID         uint
+	UserID         uint
+	MemberNumber   string
+	MembershipType string
+	Status         string
+	JoinedAt       time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
+
+func (sourceMember) TableName() string {
+	return "members"
+}
+
+func (m *sourceMember) fact() Fact {
+	joinedAt := m.JoinedAt
+	return &MemberFact{
+		MemberID:       m.ID,
+		ClubID:         m.ClubID,
+		UserID:         m.UserID,
+		MemberNumber:   m.MemberNumber,
+		MembershipType: m.MembershipType,
+		Status:         m.Status,
+		JoinedAt:       &joinedAt,
+		RemovedAt:      m.DeletedAt,
+		LastEventAt:    m.UpdatedAt,
+	}
+}
+
+type sourceVote struct {
+	ID         uint
+	ProposalID uint
+	MemberID   uint
+	ClubID     uint
+	Choice     string
+	VotedAt    time.Time
+	UpdatedAt  time.Time
+}
+
+func (sourceVote) TableName() string {
+	return "governance_votes"
+}
+
+func (v *sourceVote) fact() Fact {
+	return &VoteFact{
+		VoteID:     v.ID,
+		ProposalID: v.ProposalID,
+		MemberID:   v.MemberID,
+		ClubID:     v.ClubID,
+		Choice:     v.Choice,
+		CastAt:     v.VotedAt,
+	}
+}
+
+// earliest returns the earlier of two optional timestamps
+func earliest(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.Before(*a)) {
+		return b
+	}
+	return a
+}
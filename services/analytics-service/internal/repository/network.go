@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"fmt"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+)
+
+// NetworkAnalytics reports on visits scheduled in the time range, and on the
+// agreements that are in force or were used in it. A club ID of zero reports
+// on the whole network; otherwise only visits and agreements involving the
+// club are read.
+func (r *repository) NetworkAnalytics(clubID uint, timeRange TimeRange) (*network.Report, error) {
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	visitQuery := r.db.Where("visit_date >= ? AND visit_date < ?", timeRange.Start, timeRange.End)
+	agreementQuery := r.db.Where("status IN ?", []string{"active", "suspended"})
+	if clubID != 0 {
+		visitQuery = visitQuery.Where("home_club_id = ? OR visiting_club_id = ?", clubID, clubID)
+		agreementQuery = agreementQuery.Where("proposing_club_id = ? OR target_club_id = ?", clubID, clubID)
+	}
+
+	var visitFacts []*VisitFact
+	if err := visitQuery.Order("visit_date").Find(&visitFacts).Error; err != nil {
+		r.logger.Error("Failed to get visit facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get visit facts: %w", err)
+	}
+
+	used := make(map[uint]bool)
+	visits := make([]network.Visit, len(visitFacts))
+	for i, fact := range visitFacts {
+		visits[i] = network.Visit{
+			VisitID:        fact.VisitID,
+			AgreementID:    fact.AgreementID,
+			MemberID:       fact.MemberID,
+			HomeClubID:     fact.HomeClubID,
+			VisitingClubID: fact.VisitingClubID,
+			Status:         fact.Status,
+			VisitDate:      fact.VisitDate,
+			Facilities:     fact.FacilitiesUsed,
+			MemberRating:   fact.MemberRating,
+			ClubRating:     fact.ClubRating,
+		}
+		if fact.AgreementID != 0 {
+			used[fact.AgreementID] = true
+		}
+	}
+
+	ids := make([]uint, 0, len(used))
+	for id := range used {
+		ids = append(ids, id)
+	}
+	if len(ids) > 0 {
+		agreementQuery = r.db.Where(agreementQuery).Or("agreement_id IN ?", ids)
+	}
+
+	var agreementFacts []*AgreementFact
+	if err := agreementQuery.Order("agreement_id").Find(&agreementFacts).Error; err != nil {
+		r.logger.Error("Failed to get agreement facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get agreement facts: %w", err)
+	}
+
+	agreements := make([]network.Agreement, len(agreementFacts))
+	for i, fact := range agreementFacts {
+		agreements[i] = network.Agreement{
+			AgreementID:       fact.AgreementID,
+			ProposingClubID:   fact.ProposingClubID,
+			TargetClubID:      fact.TargetClubID,
+			Status:            fact.Status,
+			MaxVisitsPerMonth: fact.MaxVisitsPerMonth,
+		}
+	}
+
+	return network.Build(visits, agreements, clubID, timeRange.Start, timeRange.End), nil
+}
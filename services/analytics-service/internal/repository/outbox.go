@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/sink"
+)
+
+// OutboxRecord is a copy of a recorded event or metric waiting to be shipped
+// to the export sinks. It is written in the same transaction as the row it
+// copies, so every stored row is exported and nothing else is.
+type OutboxRecord struct {
+	ID        uint                   `json:"id" gorm:"primaryKey"`
+	Kind      string                 `json:"kind" gorm:"size:10"`
+	SourceID  uint                   `json:"source_id"`
+	ClubID    string                 `json:"club_id" gorm:"size:255"`
+	Name      string                 `json:"name" gorm:"size:100"`
+	Value     float64                `json:"value"`
+	Data      map[string]interface{} `json:"data" gorm:"serializer:json"`
+	Timestamp time.Time              `json:"timestamp"`
+	CreatedAt time.Time              `json:"created_at" gorm:"index"`
+}
+
+func (OutboxRecord) TableName() string {
+	return "analytics_outbox"
+}
+
+// Record converts the outbox row to the record sinks receive
+func (o *OutboxRecord) Record() sink.Record {
+	return sink.Record{
+		ID:        o.SourceID,
+		Kind:      o.Kind,
+		ClubID:    o.ClubID,
+		Name:      o.Name,
+		Value:     o.Value,
+		Data:      o.Data,
+		Timestamp: o.Timestamp,
+	}
+}
+
+// SinkCheckpoint is how far a sink has shipped the outbox. Position only moves
+// past a batch once the sink has accepted it, and the replica holding the
+// lease is the only one shipping to the sink.
+type SinkCheckpoint struct {
+	Sink string `json:"sink" gorm:"primaryKey;size:100"`
+	// Position is the ID of the last outbox record shipped
+	Position uint  `json:"position"`
+	Shipped  int64 `json:"shipped"`
+	// Dropped counts records pruned from the outbox before the sink shipped them
+	Dropped             int64      `json:"dropped"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	LastError           string     `json:"last_error,omitempty" gorm:"type:text"`
+	LastSuccessAt       *time.Time `json:"last_success_at,omitempty"`
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"`
+	LeaseOwner          string     `json:"-" gorm:"size:255"`
+	LeaseUntil          *time.Time `json:"-"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+func (SinkCheckpoint) TableName() string {
+	return "analytics_sink_checkpoints"
+}
+
+func eventOutboxRecord(event *AnalyticsEvent) *OutboxRecord {
+	return &OutboxRecord{
+		Kind:      sink.KindEvent,
+		SourceID:  event.ID,
+		ClubID:    event.ClubID,
+		Name:      event.EventType,
+		Data:      event.Data,
+		Timestamp: event.Timestamp,
+	}
+}
+
+func metricOutboxRecord(metric *AnalyticsMetric) *OutboxRecord {
+	return &OutboxRecord{
+		Kind:      sink.KindMetric,
+		SourceID:  metric.ID,
+		ClubID:    metric.ClubID,
+		Name:      metric.MetricName,
+		Value:     metric.MetricValue,
+		Data:      metric.Tags,
+		Timestamp: metric.Timestamp,
+	}
+}
+
+// OutboxBatch returns up to limit outbox records of the given kinds after a
+// position, oldest first. Only records created by settled are returned, so
+// records whose insert is still committing when the batch is read are not
+// skipped.
+func (r *repository) OutboxBatch(afterID uint, kinds []string, settled time.Time, limit int) ([]*OutboxRecord, error) {
+	var records []*OutboxRecord
+	err := r.db.Where("id > ? AND kind IN ? AND created_at <= ?", afterID, kinds, settled).
+		Order("id").Limit(limit).Find(&records).Error
+	if err != nil {
+		r.logger.Error("Failed to read outbox", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	return records, nil
+}
+
+// OutboxLag counts the outbox records of the given kinds after a position and
+// returns when the oldest of them was recorded, nil when there are none
+func (r *repository) OutboxLag(afterID uint, kinds []string) (int64, *time.Time, error) {
+	query := r.db.Model(&OutboxRecord{}).Where("id > ? AND kind IN ?", afterID, kinds)
+
+	var pending int64
+	if err := query.Count(&pending).Error; err != nil {
+		return 0, nil, fmt.Errorf("failed to count outbox records: %w", err)
+	}
+	if pending == 0 {
+		return 0, nil, nil
+	}
+
+	var oldest OutboxRecord
+	if err := r.db.Where("id > ? AND kind IN ?", afterID, kinds).Order("id").Limit(1).Find(&oldest).Error; err != nil {
+		return 0, nil, fmt.Errorf("failed to read oldest outbox record: %w", err)
+	}
+	return pending, &oldest.CreatedAt, nil
+}
+
+// AcquireSinkLease takes or renews the lease to ship to a sink until the given
+// time and returns the sink's checkpoint. It returns nil when another replica
+// holds an unexpired lease.
+func (r *repository) AcquireSinkLease(sinkName, owner string, now, until time.Time) (*SinkCheckpoint, error) {
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&SinkCheckpoint{Sink: sinkName}).Error; err != nil {
+		return nil, fmt.Errorf("failed to create sink checkpoint: %w", err)
+	}
+
+	result := r.db.Model(&SinkCheckpoint{}).
+		Where("sink = ? AND (lease_owner = ? OR lease_until IS NULL OR lease_until < ?)", sinkName, owner, now).
+		Updates(map[string]interface{}{"lease_owner": owner, "lease_until": until})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to acquire sink lease: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	var checkpoint SinkCheckpoint
+	if err := r.db.First(&checkpoint, "sink = ?", sinkName).Error; err != nil {
+		return nil, fmt.Errorf("failed to get sink checkpoint: %w", err)
+	}
+	return &checkpoint, nil
+}
+
+// AdvanceSinkCheckpoint moves a sink past a shipped batch and clears its
+// failures. It returns false when the owner has lost the lease meanwhile.
+func (r *repository) AdvanceSinkCheckpoint(sinkName, owner string, position uint, shipped int, now time.Time) (bool, error) {
+	result := r.db.Model(&SinkCheckpoint{}).Where("sink = ? AND lease_owner = ?", sinkName, owner).Updates(map[string]interface{}{
+		"position":             position,
+		"shipped":              gorm.Expr("shipped + ?", shipped),
+		"consecutive_failures": 0,
+		"last_error":           "",
+		"last_success_at":      now,
+	})
+	if result.Error != nil {
+		r.logger.Error("Failed to advance sink checkpoint", map[string]interface{}{"error": result.Error.Error(), "sink": sinkName})
+		return false, fmt.Errorf("failed to advance sink checkpoint: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RecordSinkFailure records a failed batch against a sink's checkpoint
+func (r *repository) RecordSinkFailure(sinkName string, failure error, now time.Time) error {
+	err := r.db.Model(&SinkCheckpoint{}).Where("sink = ?", sinkName).Updates(map[string]interface{}{
+		"consecutive_failures": gorm.Expr("consecutive_failures + 1"),
+		"last_error":           failure.Error(),
+		"last_failure_at":      now,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record sink failure: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) ListSinkCheckpoints() ([]*SinkCheckpoint, error) {
+	var checkpoints []*SinkCheckpoint
+	if err := r.db.Order("sink").Find(&checkpoints).Error; err != nil {
+		r.logger.Error("Failed to list sink checkpoints", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to list sink checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+// PruneOutbox deletes the outbox records every sink accepting their kind has
+// shipped, given each sink's accepted kinds, along with records created before
+// the horizon whether shipped or not. Records pruned before a sink shipped them
+// are counted as dropped on its checkpoint. Records of a kind no sink accepts
+// are deleted straight away.
+func (r *repository) PruneOutbox(sinks map[string][]string, horizon time.Time) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var checkpoints []*SinkCheckpoint
+		if err := tx.Find(&checkpoints).Error; err != nil {
+			return err
+		}
+		positions := make(map[string]uint, len(checkpoints))
+		for _, checkpoint := range checkpoints {
+			positions[checkpoint.Sink] = checkpoint.Position
+		}
+
+		for name, kinds := range sinks {
+			var dropped int64
+			err := tx.Model(&OutboxRecord{}).Where("id > ? AND kind IN ? AND created_at < ?", positions[name], kinds, horizon).Count(&dropped).Error
+			if err != nil {
+				return err
+			}
+			if dropped == 0 {
+				continue
+			}
+			err = tx.Model(&SinkCheckpoint{}).Where("sink = ?", name).Update("dropped", gorm.Expr("dropped + ?", dropped)).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, kind := range sink.Kinds {
+			query := tx.Where("kind = ?", kind)
+			accepted := false
+			var shipped uint
+			for name, kinds := range sinks {
+				if !containsString(kinds, kind) {
+					continue
+				}
+				if position := positions[name]; !accepted || position < shipped {
+					shipped = position
+				}
+				accepted = true
+			}
+			if accepted {
+				query = query.Where("(id <= ? OR created_at < ?)", shipped, horizon)
+			}
+
+			result := query.Delete(&OutboxRecord{})
+			if result.Error != nil {
+				return result.Error
+			}
+			deleted += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to prune outbox", map[string]interface{}{"error": err.Error()})
+		return deleted, fmt.Errorf("failed to prune outbox: %w", err)
+	}
+	return deleted, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
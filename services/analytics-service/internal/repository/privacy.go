@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
+	"reciprocal-clubs-backend/services/analytics-service/internal/sink"
+)
+
+// Erasure request statuses. A request is pending while export sinks still
+// have to erase the member's events; the database rows go when it is made.
+const (
+	ErasureStatusPending   = "pending"
+	ErasureStatusCompleted = "completed"
+)
+
+// PrivacySettings is how a club's event data is transformed at ingestion and
+// the salt its pseudonyms are hashed with. The salt is created with the row
+// and never changes, so pseudonyms stay stable and erasure can find them.
+type PrivacySettings struct {
+	ClubID string `json:"club_id" gorm:"primaryKey;size:255"`
+	// Policy is nil for clubs following the default policy
+	Policy    *privacy.Policy `json:"policy" gorm:"serializer:json"`
+	Salt      string          `json:"-" gorm:"size:64"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+func (PrivacySettings) TableName() string {
+	return "analytics_privacy_settings"
+}
+
+// ErasureRequest records the erasure of a member's analytics data. Only the
+// member's pseudonym is kept, so the log of erasures holds no member IDs.
+type ErasureRequest struct {
+	ID            uint   `json:"id" gorm:"primaryKey"`
+	ClubID        string `json:"club_id" gorm:"index;size:255"`
+	SubjectID     string `json:"subject_id" gorm:"size:64"`
+	Status        string `json:"status" gorm:"index;size:20"`
+	EventsDeleted int64  `json:"events_deleted"`
+	OutboxDeleted int64  `json:"outbox_deleted"`
+	FactsDeleted  int64  `json:"facts_deleted"`
+	// SinkKeys are the export record keys of the erased events
+	SinkKeys []string `json:"-" gorm:"serializer:json"`
+	// SinkResults maps each export sink to erased, unsupported or the error
+	// of its last attempt
+	SinkResults map[string]string `json:"sink_results" gorm:"serializer:json"`
+	Attempts    int               `json:"attempts"`
+	CreatedAt   time.Time         `json:"created_at" gorm:"index"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+func (ErasureRequest) TableName() string {
+	return "analytics_erasure_requests"
+}
+
+func (r *repository) GetPrivacySettings(clubID string) (*PrivacySettings, error) {
+	var settings PrivacySettings
+	if err := r.db.First(&settings, "club_id = ?", clubID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("Failed to get privacy settings", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get privacy settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// EnsurePrivacySettings stores the settings unless the club already has some
+// and returns the club's stored settings, so replicas racing to create a
+// club's salt all end up with the same one
+func (r *repository) EnsurePrivacySettings(settings *PrivacySettings) (*PrivacySettings, error) {
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(settings).Error; err != nil {
+		r.logger.Error("Failed to create privacy settings", map[string]interface{}{"error": err.Error(), "club_id": settings.ClubID})
+		return nil, fmt.Errorf("failed to create privacy settings: %w", err)
+	}
+	return r.GetPrivacySettings(settings.ClubID)
+}
+
+// SavePrivacyPolicy sets a club's policy, leaving its salt alone
+func (r *repository) SavePrivacyPolicy(clubID string, policy *privacy.Policy) error {
+	result := r.db.Model(&PrivacySettings{ClubID: clubID}).Select("policy", "updated_at").Updates(&PrivacySettings{Policy: policy, UpdatedAt: time.Now()})
+	if result.Error != nil {
+		r.logger.Error("Failed to save privacy policy", map[string]interface{}{"error": result.Error.Error(), "club_id": clubID})
+		return fmt.Errorf("failed to save privacy policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// EraseSubject deletes a member's events and the outbox copies not yet
+// shipped, along with the member's facts when the member ID is given, and
+// stores the request with what was deleted and the record keys of the events
+// for export sinks to erase. Facts are keyed by the member's home club, so
+// visits the member made to other clubs go too.
+func (r *repository) EraseSubject(request *ErasureRequest, memberID uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var eventIDs []uint
+		if err := tx.Model(&AnalyticsEvent{}).Where("club_id = ? AND subject_id = ?", request.ClubID, request.SubjectID).Pluck("id", &eventIDs).Error; err != nil {
+			return err
+		}
+
+		request.SinkKeys = make([]string, len(eventIDs))
+		for i, id := range eventIDs {
+			request.SinkKeys[i] = sink.Record{ID: id, Kind: sink.KindEvent}.Key()
+		}
+
+		if len(eventIDs) > 0 {
+			result := tx.Where("id IN ?", eventIDs).Delete(&AnalyticsEvent{})
+			if result.Error != nil {
+				return result.Error
+			}
+			request.EventsDeleted = result.RowsAffected
+
+			result = tx.Where("kind = ? AND source_id IN ?", sink.KindEvent, eventIDs).Delete(&OutboxRecord{})
+			if result.Error != nil {
+				return result.Error
+			}
+			request.OutboxDeleted = result.RowsAffected
+		}
+
+		if clubID, err := strconv.ParseUint(request.ClubID, 10, 0); err == nil && memberID != 0 {
+			facts := []struct {
+				model interface{}
+				where string
+			}{
+				{&VisitFact{}, "member_id = ? AND home_club_id = ?"},
+				{&MemberFact{}, "member_id = ? AND club_id = ?"},
+				{&MemberStatusChange{}, "member_id = ? AND club_id = ?"},
+				{&VoteFact{}, "member_id = ? AND club_id = ?"},
+			}
+			for _, fact := range facts {
+				result := tx.Where(fact.where, memberID, clubID).Delete(fact.model)
+				if result.Error != nil {
+					return result.Error
+				}
+				request.FactsDeleted += result.RowsAffected
+			}
+		}
+
+		return tx.Create(request).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to erase subject", map[string]interface{}{"error": err.Error(), "club_id": request.ClubID})
+		return fmt.Errorf("failed to erase subject: %w", err)
+	}
+
+	r.logger.Info("Erased subject", map[string]interface{}{
+		"club_id":        request.ClubID,
+		"erasure_id":     request.ID,
+		"events_deleted": request.EventsDeleted,
+		"facts_deleted":  request.FactsDeleted,
+	})
+	return nil
+}
+
+func (r *repository) GetErasureRequest(id uint) (*ErasureRequest, error) {
+	var request ErasureRequest
+	if err := r.db.First(&request, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("Failed to get erasure request", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to get erasure request: %w", err)
+	}
+	return &request, nil
+}
+
+func (r *repository) ListErasureRequests(clubID string, limit int) ([]*ErasureRequest, error) {
+	var requests []*ErasureRequest
+	if err := r.db.Where("club_id = ?", clubID).Order("id DESC").Limit(limit).Find(&requests).Error; err != nil {
+		r.logger.Error("Failed to list erasure requests", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to list erasure requests: %w", err)
+	}
+	return requests, nil
+}
+
+// PendingErasureRequests returns pending requests made by the given time,
+// oldest first
+func (r *repository) PendingErasureRequests(before time.Time, limit int) ([]*ErasureRequest, error) {
+	var requests []*ErasureRequest
+	err := r.db.Where("status = ? AND created_at <= ?", ErasureStatusPending, before).Order("id").Limit(limit).Find(&requests).Error
+	if err != nil {
+		r.logger.Error("Failed to get pending erasure requests", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to get pending erasure requests: %w", err)
+	}
+	return requests, nil
+}
+
+func (r *repository) UpdateErasureRequest(request *ErasureRequest) error {
+	if err := r.db.Save(request).Error; err != nil {
+		r.logger.Error("Failed to update erasure request", map[string]interface{}{"error": err.Error(), "erasure_id": request.ID})
+		return fmt.Errorf("failed to update erasure request: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/reporting"
+	"reciprocal-clubs-backend/services/analytics-service/internal/rollup"
+)
+
+// ErrNotFound is returned when a looked up record does not exist
+var ErrNotFound = errors.New("record not found")
+
+// Report types
+const (
+	ReportTypeUsage       = "usage"
+	ReportTypeEngagement  = "engagement"
+	ReportTypePerformance = "performance"
+)
+
+// ReportTypes lists the report types that can be generated
+var ReportTypes = []string{ReportTypeUsage, ReportTypeEngagement, ReportTypePerformance}
+
+// Report statuses
+const (
+	ReportStatusCompleted = "completed"
+	ReportStatusDelivered = "delivered"
+	ReportStatusFailed    = "failed"
+)
+
+const (
+	maxReportRecipients = 20
+	// maxReportDays bounds the period a report can cover
+	maxReportDays = 366
+)
+
+// ReportSchedule generates a report on a cron schedule and emails it to a
+// list of recipients
+type ReportSchedule struct {
+	ID         uint              `json:"id" gorm:"primaryKey"`
+	ClubID     string            `json:"club_id" gorm:"index;size:255"`
+	Name       string            `json:"name" gorm:"size:255"`
+	Cron       string            `json:"cron" gorm:"size:100"`
+	Timezone   string            `json:"timezone" gorm:"size:64"`
+	ReportType string            `json:"report_type" gorm:"size:100"`
+	Parameters map[string]string `json:"parameters" gorm:"serializer:json"`
+	Format     string            `json:"format" gorm:"size:10"`
+	Recipients []string          `json:"recipients" gorm:"serializer:json"`
+	Enabled    bool              `json:"enabled"`
+	// NextRunAt is when the schedule next fires; it is null while disabled
+	NextRunAt    *time.Time `json:"next_run_at,omitempty" gorm:"index"`
+	LastRunAt    *time.Time `json:"last_run_at,omitempty"`
+	LastReportID *uint      `json:"last_report_id,omitempty"`
+	LastStatus   string     `json:"last_status,omitempty" gorm:"size:20"`
+	LastError    string     `json:"last_error,omitempty" gorm:"type:text"`
+	CreatedBy    string     `json:"created_by" gorm:"size:255"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func (ReportSchedule) TableName() string {
+	return "analytics_report_schedules"
+}
+
+// CronSchedule parses the schedule's cron expression in its time zone
+func (s *ReportSchedule) CronSchedule() (*reporting.Schedule, error) {
+	location := time.UTC
+	if s.Timezone != "" {
+		loaded, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown timezone: %s", s.Timezone)
+		}
+		location = loaded
+	}
+	return reporting.ParseCron(s.Cron, location)
+}
+
+// Days returns the period the "days" parameter asks reports to cover, or zero
+// when the period follows the schedule
+func (s *ReportSchedule) Days() int {
+	days, _ := strconv.Atoi(s.Parameters["days"])
+	return days
+}
+
+// Validate checks the schedule and normalises its format and recipients
+func (s *ReportSchedule) Validate() error {
+	if s.ClubID == "" || s.Name == "" {
+		return fmt.Errorf("club_id and name are required")
+	}
+	if id, err := strconv.ParseUint(s.ClubID, 10, 32); err != nil || id == 0 {
+		return fmt.Errorf("club_id must be a positive number")
+	}
+	cron, err := s.CronSchedule()
+	if err != nil {
+		return err
+	}
+	if cron.Next(time.Now()).IsZero() {
+		return fmt.Errorf("cron expression %q never fires", s.Cron)
+	}
+	if !ValidReportType(s.ReportType) {
+		return fmt.Errorf("unsupported report type: %s", s.ReportType)
+	}
+	format, err := reporting.ParseFormat(s.Format)
+	if err != nil {
+		return err
+	}
+	s.Format = string(format)
+
+	if value, ok := s.Parameters["days"]; ok {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 1 || days > maxReportDays {
+			return fmt.Errorf("days parameter must be between 1 and %d", maxReportDays)
+		}
+	}
+
+	if len(s.Recipients) == 0 || len(s.Recipients) > maxReportRecipients {
+		return fmt.Errorf("between 1 and %d recipients are required", maxReportRecipients)
+	}
+	for i, recipient := range s.Recipients {
+		address, err := mail.ParseAddress(strings.TrimSpace(recipient))
+		if err != nil {
+			return fmt.Errorf("invalid recipient: %s", recipient)
+		}
+		s.Recipients[i] = address.Address
+	}
+	return nil
+}
+
+// ValidReportType reports whether reports of a type can be generated
+func ValidReportType(reportType string) bool {
+	for _, known := range ReportTypes {
+		if reportType == known {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateReportSchedule stores a new schedule
+func (r *repository) CreateReportSchedule(schedule *ReportSchedule) error {
+	if err := r.db.Create(schedule).Error; err != nil {
+		r.logger.Error("Failed to create report schedule", map[string]interface{}{"error": err.Error(), "club_id": schedule.ClubID})
+		return fmt.Errorf("failed to create report schedule: %w", err)
+	}
+
+	r.logger.Info("Created report schedule", map[string]interface{}{"schedule_id": schedule.ID, "club_id": schedule.ClubID})
+	return nil
+}
+
+func (r *repository) GetReportSchedule(id uint) (*ReportSchedule, error) {
+	var schedule ReportSchedule
+	if err := r.db.First(&schedule, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("Failed to get report schedule", map[string]interface{}{"error": err.Error(), "schedule_id": id})
+		return nil, fmt.Errorf("failed to get report schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+func (r *repository) ListReportSchedules(clubID string) ([]*ReportSchedule, error) {
+	var schedules []*ReportSchedule
+	if err := r.db.Where("club_id = ?", clubID).Order("id").Find(&schedules).Error; err != nil {
+		r.logger.Error("Failed to list report schedules", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to list report schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+func (r *repository) UpdateReportSchedule(schedule *ReportSchedule) error {
+	if err := r.db.Save(schedule).Error; err != nil {
+		r.logger.Error("Failed to update report schedule", map[string]interface{}{"error": err.Error(), "schedule_id": schedule.ID})
+		return fmt.Errorf("failed to update report schedule: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) DeleteReportSchedule(id uint) error {
+	result := r.db.Delete(&ReportSchedule{}, id)
+	if result.Error != nil {
+		r.logger.Error("Failed to delete report schedule", map[string]interface{}{"error": result.Error.Error(), "schedule_id": id})
+		return fmt.Errorf("failed to delete report schedule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	r.logger.Info("Deleted report schedule", map[string]interface{}{"schedule_id": id})
+	return nil
+}
+
+// DueReportSchedules returns enabled schedules whose next run is not after now,
+// earliest first
+func (r *repository) DueReportSchedules(now time.Time, limit int) ([]*ReportSchedule, error) {
+	var schedules []*ReportSchedule
+	err := r.db.Where("enabled = ? AND next_run_at IS NOT NULL AND next_run_at <= ?", true, now).
+		Order("next_run_at").Limit(limit).Find(&schedules).Error
+	if err != nil {
+		r.logger.Error("Failed to get due report schedules", map[string]interface{}{"error": err.Error()})
+		return nil, fmt.Errorf("failed to get due report schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// ClaimReportSchedule moves a due schedule's next run from the time it was
+// read with to the following one. It returns false when another replica has
+// already claimed the run, so each run is generated once.
+func (r *repository) ClaimReportSchedule(id uint, dueAt time.Time, nextRunAt *time.Time) (bool, error) {
+	result := r.db.Model(&ReportSchedule{}).
+		Where("id = ? AND enabled = ? AND next_run_at = ?", id, true, dueAt).
+		Updates(map[string]interface{}{"next_run_at": nextRunAt, "last_run_at": dueAt})
+	if result.Error != nil {
+		r.logger.Error("Failed to claim report schedule", map[string]interface{}{"error": result.Error.Error(), "schedule_id": id})
+		return false, fmt.Errorf("failed to claim report schedule: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RecordReportScheduleRun stores the outcome of a schedule's latest run
+func (r *repository) RecordReportScheduleRun(id uint, reportID *uint, status string, runErr error) error {
+	message := ""
+	if runErr != nil {
+		message = runErr.Error()
+	}
+	err := r.db.Model(&ReportSchedule{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"last_report_id": reportID,
+		"last_status":    status,
+		"last_error":     message,
+	}).Error
+	if err != nil {
+		r.logger.Error("Failed to record report schedule run", map[string]interface{}{"error": err.Error(), "schedule_id": id})
+		return fmt.Errorf("failed to record report schedule run: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetReport(id uint) (*AnalyticsReport, error) {
+	var report AnalyticsReport
+	if err := r.db.First(&report, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("Failed to get report", map[string]interface{}{"error": err.Error(), "report_id": id})
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+	return &report, nil
+}
+
+func (r *repository) UpdateReport(report *AnalyticsReport) error {
+	if err := r.db.Save(report).Error; err != nil {
+		r.logger.Error("Failed to update report", map[string]interface{}{"error": err.Error(), "report_id": report.ID})
+		return fmt.Errorf("failed to update report: %w", err)
+	}
+	return nil
+}
+
+// EngagementStats summarises a club's members, votes and visits over a period
+type EngagementStats struct {
+	Members        int64            `json:"members"`
+	ActiveMembers  int64            `json:"active_members"`
+	Joined         int64            `json:"joined"`
+	Removed        int64            `json:"removed"`
+	Votes          int64            `json:"votes"`
+	Voters         int64            `json:"voters"`
+	VisitsMade     int64            `json:"visits_made"`
+	VisitsHosted   int64            `json:"visits_hosted"`
+	ByType         map[string]int64 `json:"by_type"`
+	Activity       []ActivityPeriod `json:"activity"`
+	ActivityPeriod string           `json:"activity_period"`
+}
+
+// ActivityPeriod counts engagement in one day or month of a report's period
+type ActivityPeriod struct {
+	Start  time.Time `json:"start"`
+	Joined int64     `json:"joined"`
+	Votes  int64     `json:"votes"`
+	Visits int64     `json:"visits"`
+}
+
+// EngagementStats reads a club's member, vote and visit facts for a period.
+// Activity is broken down by day, or by month for periods over 90 days.
+func (r *repository) EngagementStats(clubID uint, timeRange TimeRange) (*EngagementStats, error) {
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	var members []*MemberFact
+	if err := r.db.Where("club_id = ?", clubID).Find(&members).Error; err != nil {
+		r.logger.Error("Failed to get member facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get member facts: %w", err)
+	}
+	var votes []*VoteFact
+	if err := r.db.Where("club_id = ? AND cast_at >= ? AND cast_at < ?", clubID, timeRange.Start, timeRange.End).Find(&votes).Error; err != nil {
+		r.logger.Error("Failed to get vote facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get vote facts: %w", err)
+	}
+	var visits []*VisitFact
+	err := r.db.Where("(home_club_id = ? OR visiting_club_id = ?) AND visit_date >= ? AND visit_date < ?", clubID, clubID, timeRange.Start, timeRange.End).
+		Find(&visits).Error
+	if err != nil {
+		r.logger.Error("Failed to get visit facts", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get visit facts: %w", err)
+	}
+
+	resolution := rollup.Day
+	if timeRange.End.Sub(timeRange.Start) > 90*24*time.Hour {
+		resolution = rollup.Month
+	}
+	stats := &EngagementStats{ByType: make(map[string]int64), ActivityPeriod: string(resolution)}
+	activity := make(map[time.Time]*ActivityPeriod)
+	period := func(t time.Time) *ActivityPeriod {
+		start := resolution.Truncate(t)
+		p, ok := activity[start]
+		if !ok {
+			p = &ActivityPeriod{Start: start}
+			activity[start] = p
+		}
+		return p
+	}
+	for start := resolution.Truncate(timeRange.Start); start.Before(timeRange.End); start = resolution.Add(start, 1) {
+		period(start)
+	}
+	inRange := func(t *time.Time) bool {
+		return t != nil && !t.Before(timeRange.Start) && t.Before(timeRange.End)
+	}
+
+	for _, member := range members {
+		// Members are counted as they stood at the end of the period
+		if member.JoinedAt != nil && !member.JoinedAt.Before(timeRange.End) {
+			continue
+		}
+		if inRange(member.JoinedAt) {
+			stats.Joined++
+			period(*member.JoinedAt).Joined++
+		}
+		if inRange(member.RemovedAt) {
+			stats.Removed++
+		}
+		if member.RemovedAt != nil && member.RemovedAt.Before(timeRange.End) {
+			continue
+		}
+		stats.Members++
+		if member.Status == "active" {
+			stats.ActiveMembers++
+		}
+		stats.ByType[member.MembershipType]++
+	}
+
+	voters := make(map[uint]bool)
+	for _, vote := range votes {
+		stats.Votes++
+		voters[vote.MemberID] = true
+		period(vote.CastAt).Votes++
+	}
+	stats.Voters = int64(len(voters))
+
+	for _, visit := range visits {
+		if visit.HomeClubID == clubID {
+			stats.VisitsMade++
+			period(visit.VisitDate).Visits++
+		}
+		if visit.VisitingClubID == clubID {
+			stats.VisitsHosted++
+		}
+	}
+
+	for _, p := range activity {
+		stats.Activity = append(stats.Activity, *p)
+	}
+	sort.Slice(stats.Activity, func(i, j int) bool { return stats.Activity[i].Start.Before(stats.Activity[j].Start) })
+	return stats, nil
+}
+
+// MetricSummary summarises every value of a metric, or every occurrence of an
+// event type, over a period
+type MetricSummary struct {
+	Source string  `json:"source"`
+	Name   string  `json:"name"`
+	Count  int64   `json:"count"`
+	Sum    float64 `json:"sum"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Avg    float64 `json:"avg"`
+	P50    float64 `json:"p50"`
+	P95    float64 `json:"p95"`
+}
+
+// MetricSummaries merges a club's hourly rollups over a period into one
+// summary per metric and event type, ordered by source and name
+func (r *repository) MetricSummaries(clubID string, timeRange TimeRange) ([]MetricSummary, error) {
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	var rows []*MetricRollup
+	err := r.db.Where("club_id = ? AND resolution = ? AND bucket_start >= ? AND bucket_start < ?",
+		clubID, rollup.Hour, rollup.Hour.Truncate(timeRange.Start), timeRange.End).Find(&rows).Error
+	if err != nil {
+		r.logger.Error("Failed to get metric rollups", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get metric rollups: %w", err)
+	}
+
+	type metricKey struct{ source, name string }
+	aggregates := make(map[metricKey]*rollup.Aggregate)
+	for _, row := range rows {
+		key := metricKey{row.Source, row.Name}
+		if aggregates[key] == nil {
+			aggregates[key] = rollup.NewAggregate()
+		}
+		aggregates[key].Merge(row.aggregate())
+	}
+
+	summaries := make([]MetricSummary, 0, len(aggregates))
+	for key, aggregate := range aggregates {
+		summaries = append(summaries, MetricSummary{
+			Source: key.source,
+			Name:   key.name,
+			Count:  aggregate.Count,
+			Sum:    aggregate.Sum,
+			Min:    aggregate.Min,
+			Max:    aggregate.Max,
+			Avg:    aggregate.Mean(),
+			P50:    aggregate.Quantile(0.5),
+			P95:    aggregate.Quantile(0.95),
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Source != summaries[j].Source {
+			return summaries[i].Source < summaries[j].Source
+		}
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries, nil
+}
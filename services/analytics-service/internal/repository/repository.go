@@ -6,7 +6,12 @@ import (
 	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/logging"
+	"reciprocal-clubs-backend/services/analytics-service/internal/anomaly"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/forecasting"
 	"reciprocal-clubs-backend/services/analytics-service/internal/models"
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
 
 	"gorm.io/gorm"
 )
@@ -32,6 +37,46 @@ type Repository interface {
 	GetPredictiveAnalytics(clubID string, metricName string, forecastDays int) (map[string]interface{}, error)
 	GetAnomalyDetection(clubID string, metricName string, timeRange TimeRange) (map[string]interface{}, error)
 
+	// Anomaly detection
+	GetAnomalySetting(clubID string, metricName string) (*AnomalySetting, error)
+	SaveAnomalySetting(setting *AnomalySetting) error
+	DetectAnomalies(clubID string, metricName string, timeRange TimeRange, setting *AnomalySetting) (*anomaly.Result, error)
+	GetOpenAnomalyEpisode(clubID string, metricName string) (*AnomalyEpisode, error)
+	SaveAnomalyEpisode(episode *AnomalyEpisode) error
+	GetExpiredAnomalyEpisodes(before time.Time) ([]*AnomalyEpisode, error)
+	ListAnomalyEpisodes(clubID string, metricName string, status string, limit int) ([]*AnomalyEpisode, error)
+
+	// Domain event ingestion
+	IngestEvent(message *IngestedMessage, fact Fact) (bool, error)
+	BackfillFacts(source string, sourceDB *gorm.DB, since time.Time) (int, error)
+
+	// Rollups and retention
+	RollUp(now time.Time) (int, error)
+	QueryRollups(query *RollupQuery) (*RollupResult, error)
+	GetRetentionPolicy() (*RetentionPolicy, error)
+	SaveRetentionPolicy(policy *RetentionPolicy) error
+	ApplyRetention(policy *RetentionPolicy, now time.Time) (map[string]int64, error)
+
+	// Reciprocal network analytics
+	NetworkAnalytics(clubID uint, timeRange TimeRange) (*network.Report, error)
+
+	// Membership cohorts
+	CohortAnalysis(clubID uint, timeRange TimeRange, options cohort.Options) (*cohort.Report, error)
+
+	// Scheduled reports
+	CreateReportSchedule(schedule *ReportSchedule) error
+	GetReportSchedule(id uint) (*ReportSchedule, error)
+	ListReportSchedules(clubID string) ([]*ReportSchedule, error)
+	UpdateReportSchedule(schedule *ReportSchedule) error
+	DeleteReportSchedule(id uint) error
+	DueReportSchedules(now time.Time, limit int) ([]*ReportSchedule, error)
+	ClaimReportSchedule(id uint, dueAt time.Time, nextRunAt *time.Time) (bool, error)
+	RecordReportScheduleRun(id uint, reportID *uint, status string, runErr error) error
+	GetReport(id uint) (*AnalyticsReport, error)
+	UpdateReport(report *AnalyticsReport) error
+	EngagementStats(clubID uint, timeRange TimeRange) (*EngagementStats, error)
+	MetricSummaries(clubID string, timeRange TimeRange) ([]MetricSummary, error)
+
 	// Dashboard operations
 	CreateDashboard(dashboard *Dashboard) error
 	GetDashboard(dashboardID uint) (*Dashboard, error)
@@ -39,6 +84,25 @@ type Repository interface {
 	DeleteDashboard(dashboardID uint) error
 	ListDashboards(clubID string, limit, offset int) ([]*Dashboard, error)
 
+	// Export outbox
+	OutboxBatch(afterID uint, kinds []string, settled time.Time, limit int) ([]*OutboxRecord, error)
+	OutboxLag(afterID uint, kinds []string) (int64, *time.Time, error)
+	AcquireSinkLease(sinkName, owner string, now, until time.Time) (*SinkCheckpoint, error)
+	AdvanceSinkCheckpoint(sinkName, owner string, position uint, shipped int, now time.Time) (bool, error)
+	RecordSinkFailure(sinkName string, failure error, now time.Time) error
+	ListSinkCheckpoints() ([]*SinkCheckpoint, error)
+	PruneOutbox(sinks map[string][]string, horizon time.Time) (int64, error)
+
+	// Privacy
+	GetPrivacySettings(clubID string) (*PrivacySettings, error)
+	EnsurePrivacySettings(settings *PrivacySettings) (*PrivacySettings, error)
+	SavePrivacyPolicy(clubID string, policy *privacy.Policy) error
+	EraseSubject(request *ErasureRequest, memberID uint) error
+	GetErasureRequest(id uint) (*ErasureRequest, error)
+	ListErasureRequests(clubID string, limit int) ([]*ErasureRequest, error)
+	PendingErasureRequests(before time.Time, limit int) ([]*ErasureRequest, error)
+	UpdateErasureRequest(request *ErasureRequest) error
+
 	// Export operations
 	ExportEvents(clubID string, timeRange TimeRange, format string) ([]byte, error)
 	ExportMetrics(clubID string, timeRange TimeRange, format string) ([]byte, error)
@@ -62,8 +126,11 @@ type AnalyticsEvent struct {
 	ClubID    string                 `json:"club_id" gorm:"index;size:255"`
 	EventType string                 `json:"event_type" gorm:"size:100"`
 	Data      map[string]interface{} `json:"data" gorm:"serializer:json"`
-	Timestamp time.Time              `json:"timestamp" gorm:"index"`
-	CreatedAt time.Time              `json:"created_at"`
+	// SubjectID is the pseudonym of the member the event is about, which
+	// erasure requests match
+	SubjectID string    `json:"subject_id,omitempty" gorm:"index;size:64"`
+	Timestamp time.Time `json:"timestamp" gorm:"index"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type AnalyticsMetric struct {
@@ -82,8 +149,16 @@ type AnalyticsReport struct {
 	ReportType string                 `json:"report_type" gorm:"size:100"`
 	Title      string                 `json:"title" gorm:"size:255"`
 	Data       map[string]interface{} `json:"data" gorm:"serializer:json"`
-	GeneratedAt time.Time             `json:"generated_at"`
-	CreatedAt   time.Time             `json:"created_at"`
+	// ScheduleID is set on reports generated by a report schedule
+	ScheduleID   *uint      `json:"schedule_id,omitempty" gorm:"index"`
+	Status       string     `json:"status,omitempty" gorm:"size:20"`
+	Format       string     `json:"format,omitempty" gorm:"size:10"`
+	ArtifactKey  string     `json:"-" gorm:"size:512"`
+	ContentType  string     `json:"content_type,omitempty" gorm:"size:100"`
+	ArtifactSize int64      `json:"artifact_size,omitempty"`
+	DeliveredAt  *time.Time `json:"delivered_at,omitempty"`
+	GeneratedAt  time.Time  `json:"generated_at"`
+	CreatedAt    time.Time  `json:"created_at"`
 }
 
 func (AnalyticsEvent) TableName() string {
@@ -98,31 +173,26 @@ func (AnalyticsReport) TableName() string {
 	return "analytics_reports"
 }
 
-type Dashboard struct {
-	ID          uint                   `json:"id" gorm:"primaryKey"`
-	ClubID      string                 `json:"club_id" gorm:"index;size:255"`
-	Name        string                 `json:"name" gorm:"size:255"`
-	Description string                 `json:"description" gorm:"type:text"`
-	Panels      map[string]interface{} `json:"panels" gorm:"serializer:json"`
-	IsPublic    bool                   `json:"is_public" gorm:"default:false"`
-	CreatedBy   string                 `json:"created_by" gorm:"size:255"`
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
-}
-
-func (Dashboard) TableName() string {
-	return "analytics_dashboards"
-}
+const (
+	// Three years of history lets the forecaster learn yearly seasonality
+	forecastHistoryDays = 3 * 365
+	maxForecastDays     = 365
+	forecastCacheTTL    = 24 * time.Hour
+)
 
 type repository struct {
-	db     *gorm.DB
-	logger logging.Logger
+	db         *gorm.DB
+	logger     logging.Logger
+	forecaster *forecasting.Forecaster
+	forecasts  *forecasting.Cache
 }
 
 func NewRepository(db *gorm.DB, logger logging.Logger) Repository {
 	return &repository{
-		db:     db,
-		logger: logger,
+		db:         db,
+		logger:     logger,
+		forecaster: forecasting.NewForecaster(forecasting.DefaultHolidays),
+		forecasts:  forecasting.NewCache(forecastCacheTTL),
 	}
 }
 
@@ -146,7 +216,15 @@ func (r *repository) RecordEvent(event *AnalyticsEvent) error {
 		event.Timestamp = time.Now()
 	}
 
-	if err := r.db.Create(event).Error; err != nil {
+	// The outbox copy is written in the same transaction, so the event is
+	// exported if and only if it is stored
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		return tx.Create(eventOutboxRecord(event)).Error
+	})
+	if err != nil {
 		r.logger.Error("Failed to record analytics event", map[string]interface{}{"error": err.Error()})
 		return fmt.Errorf("failed to record event: %w", err)
 	}
@@ -238,7 +316,14 @@ func (r *repository) RecordMetric(metric *AnalyticsMetric) error {
 		metric.Timestamp = time.Now()
 	}
 
-	if err := r.db.Create(metric).Error; err != nil {
+	// Written together with its outbox copy, as events are
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(metric).Error; err != nil {
+			return err
+		}
+		return tx.Create(metricOutboxRecord(metric)).Error
+	})
+	if err != nil {
 		r.logger.Error("Failed to record analytics metric", map[string]interface{}{"error": err.Error()})
 		return fmt.Errorf("failed to record metric: %w", err)
 	}
@@ -335,6 +420,13 @@ func (r *repository) CleanupOldEvents(olderThan time.Time) error {
 		r.logger.Info("Cleaned up old metrics", map[string]interface{}{"rows_deleted": result.RowsAffected, "older_than": olderThan})
 	}
 
+	// Message IDs only guard against redelivery, which happens within minutes
+	result = r.db.Where("ingested_at < ?", olderThan).Delete(&IngestedMessage{})
+	if result.Error != nil {
+		r.logger.Error("Failed to cleanup ingested messages", map[string]interface{}{"error": result.Error.Error()})
+		return fmt.Errorf("failed to cleanup ingested messages: %w", result.Error)
+	}
+
 	return nil
 }
 
@@ -413,119 +505,97 @@ func (r *repository) GetCorrelationAnalysis(clubID string, metricNames []string,
 }
 
 func (r *repository) GetPredictiveAnalytics(clubID string, metricName string, forecastDays int) (map[string]interface{}, error) {
-	// Mock implementation - in production would use ML models
-	predictions := []map[string]interface{}{}
-	baseValue := 100.0
+	if forecastDays <= 0 || forecastDays > maxForecastDays {
+		return nil, fmt.Errorf("forecast days must be between 1 and %d", maxForecastDays)
+	}
 
-	for i := 0; i < forecastDays; i++ {
-		timestamp := time.Now().AddDate(0, 0, i+1)
-		predictedValue := baseValue + float64(i)*2.5
-		upperBound := predictedValue * 1.2
-		lowerBound := predictedValue * 0.8
+	fitted, err := r.fitForecast(clubID, metricName)
+	if err != nil {
+		return nil, err
+	}
 
+	predictions := []map[string]interface{}{}
+	for _, p := range fitted.Forecast(time.Now(), forecastDays) {
 		predictions = append(predictions, map[string]interface{}{
-			"timestamp":        timestamp,
-			"predicted_value":  predictedValue,
-			"confidence_upper": upperBound,
-			"confidence_lower": lowerBound,
+			"timestamp":        p.Timestamp,
+			"predicted_value":  p.Value,
+			"confidence_upper": p.Upper,
+			"confidence_lower": p.Lower,
 		})
 	}
 
 	return map[string]interface{}{
 		"predictions": predictions,
 		"summary": map[string]interface{}{
-			"model_type":       "linear_trend",
-			"accuracy":         0.82,
-			"confidence_level": "95%",
+			"model_type":        fitted.Backtest.Model,
+			"accuracy":          fitted.Backtest.Accuracy(),
+			"confidence_level":  forecasting.ConfidenceLevel,
+			"mape":              fitted.Backtest.MAPE,
+			"rmse":              fitted.Backtest.RMSE,
+			"interval_coverage": fitted.Backtest.Coverage,
+			"backtest_days":     fitted.Backtest.Days,
+			"training_days":     fitted.Series.Len(),
+			"history_start":     fitted.Series.Start,
+			"history_end":       fitted.Series.End(),
+			"fitted_at":         fitted.FittedAt,
+			"parameters":        fitted.Model.Parameters(),
+			"candidates":        fitted.Candidates,
 		},
 	}, nil
 }
 
-func (r *repository) GetAnomalyDetection(clubID string, metricName string, timeRange TimeRange) (map[string]interface{}, error) {
-	// Mock implementation - in production would use statistical anomaly detection
-	anomalies := []map[string]interface{}{
-		{
-			"timestamp":      time.Now().Add(-2 * time.Hour),
-			"value":          150.0,
-			"expected_value": 100.0,
-			"anomaly_score":  0.85,
-			"severity":       "medium",
-		},
-		{
-			"timestamp":      time.Now().Add(-6 * time.Hour),
-			"value":          200.0,
-			"expected_value": 105.0,
-			"anomaly_score":  0.95,
-			"severity":       "high",
-		},
-	}
+// fitForecast returns the cached model for the metric while its history is
+// unchanged, and refits it otherwise
+func (r *repository) fitForecast(clubID string, metricName string) (*forecasting.Fitted, error) {
+	since := time.Now().AddDate(0, 0, -forecastHistoryDays)
 
-	summary := map[string]interface{}{
-		"total_anomalies":  len(anomalies),
-		"high_severity":    1,
-		"medium_severity":  1,
-		"low_severity":     0,
+	var state struct {
+		Count  int64
+		LastID *uint
 	}
-
-	return map[string]interface{}{
-		"anomalies": anomalies,
-		"summary":   summary,
-	}, nil
-}
-
-// Dashboard operations
-func (r *repository) CreateDashboard(dashboard *Dashboard) error {
-	if err := r.db.Create(dashboard).Error; err != nil {
-		r.logger.Error("Failed to create dashboard", map[string]interface{}{"error": err.Error()})
-		return fmt.Errorf("failed to create dashboard: %w", err)
+	if err := r.db.Model(&AnalyticsMetric{}).
+		Select("COUNT(*) AS count, MAX(id) AS last_id").
+		Where("club_id = ? AND metric_name = ? AND timestamp >= ?", clubID, metricName, since).
+		Scan(&state).Error; err != nil {
+		return nil, fmt.Errorf("failed to check forecast history: %w", err)
 	}
-
-	r.logger.Info("Created dashboard", map[string]interface{}{"dashboard_id": dashboard.ID, "club_id": dashboard.ClubID})
-	return nil
-}
-
-func (r *repository) GetDashboard(dashboardID uint) (*Dashboard, error) {
-	var dashboard Dashboard
-	if err := r.db.First(&dashboard, dashboardID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("dashboard not found")
-		}
-		r.logger.Error("Failed to get dashboard", map[string]interface{}{"error": err.Error()})
-		return nil, fmt.Errorf("failed to get dashboard: %w", err)
+	if state.Count == 0 {
+		return nil, forecasting.ErrInsufficientData
 	}
-	return &dashboard, nil
-}
 
-func (r *repository) UpdateDashboard(dashboard *Dashboard) error {
-	if err := r.db.Save(dashboard).Error; err != nil {
-		r.logger.Error("Failed to update dashboard", map[string]interface{}{"error": err.Error()})
-		return fmt.Errorf("failed to update dashboard: %w", err)
+	fingerprint := fmt.Sprintf("%d:%d", state.Count, *state.LastID)
+	if fitted, ok := r.forecasts.Get(clubID, metricName, fingerprint); ok {
+		return fitted, nil
 	}
 
-	r.logger.Info("Updated dashboard", map[string]interface{}{"dashboard_id": dashboard.ID})
-	return nil
-}
-
-func (r *repository) DeleteDashboard(dashboardID uint) error {
-	if err := r.db.Delete(&Dashboard{}, dashboardID).Error; err != nil {
-		r.logger.Error("Failed to delete dashboard", map[string]interface{}{"error": err.Error()})
-		return fmt.Errorf("failed to delete dashboard: %w", err)
+	var metrics []*AnalyticsMetric
+	if err := r.db.Select("timestamp", "metric_value").
+		Where("club_id = ? AND metric_name = ? AND timestamp >= ?", clubID, metricName, since).
+		Order("timestamp ASC").
+		Find(&metrics).Error; err != nil {
+		r.logger.Error("Failed to get forecast history", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		return nil, fmt.Errorf("failed to get forecast history: %w", err)
 	}
 
-	r.logger.Info("Deleted dashboard", map[string]interface{}{"dashboard_id": dashboardID})
-	return nil
-}
-
-func (r *repository) ListDashboards(clubID string, limit, offset int) ([]*Dashboard, error) {
-	var dashboards []*Dashboard
-	query := r.db.Where("club_id = ? OR is_public = ?", clubID, true)
-
-	if err := query.Limit(limit).Offset(offset).Order("created_at DESC").Find(&dashboards).Error; err != nil {
-		r.logger.Error("Failed to list dashboards", map[string]interface{}{"error": err.Error()})
-		return nil, fmt.Errorf("failed to list dashboards: %w", err)
+	points := make([]forecasting.Point, len(metrics))
+	for i, metric := range metrics {
+		points[i] = forecasting.Point{Timestamp: metric.Timestamp, Value: metric.MetricValue}
 	}
 
-	return dashboards, nil
+	fitted, err := r.forecaster.Fit(forecasting.DailySeries(points))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fit forecast: %w", err)
+	}
+	r.forecasts.Put(clubID, metricName, fingerprint, fitted)
+
+	r.logger.Info("Fitted forecast model", map[string]interface{}{
+		"club_id":     clubID,
+		"metric_name": metricName,
+		"model_type":  fitted.Backtest.Model,
+		"mape":        fitted.Backtest.MAPE,
+		"rmse":        fitted.Backtest.RMSE,
+	})
+	return fitted, nil
 }
 
 // Export operations
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"fmt"
+	"math"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -11,6 +14,11 @@ import (
 
 	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/logging"
+	"reciprocal-clubs-backend/services/analytics-service/internal/anomaly"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
+	"reciprocal-clubs-backend/services/analytics-service/internal/forecasting"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
 )
 
 type RepositoryTestSuite struct {
@@ -30,6 +38,22 @@ func (suite *RepositoryTestSuite) SetupSuite() {
 		&AnalyticsMetric{},
 		&AnalyticsReport{},
 		&Dashboard{},
+		&AnomalySetting{},
+		&AnomalyEpisode{},
+		&IngestedMessage{},
+		&VisitFact{},
+		&AgreementFact{},
+		&MemberFact{},
+		&MemberStatusChange{},
+		&VoteFact{},
+		&MetricRollup{},
+		&RollupWatermark{},
+		&RetentionPolicy{},
+		&ReportSchedule{},
+		&OutboxRecord{},
+		&SinkCheckpoint{},
+		&PrivacySettings{},
+		&ErasureRequest{},
 	)
 	suite.Require().NoError(err)
 
@@ -45,6 +69,22 @@ func (suite *RepositoryTestSuite) TearDownTest() {
 	suite.db.Exec("DELETE FROM analytics_metrics")
 	suite.db.Exec("DELETE FROM analytics_reports")
 	suite.db.Exec("DELETE FROM analytics_dashboards")
+	suite.db.Exec("DELETE FROM analytics_anomaly_settings")
+	suite.db.Exec("DELETE FROM analytics_anomaly_episodes")
+	suite.db.Exec("DELETE FROM analytics_ingested_messages")
+	suite.db.Exec("DELETE FROM analytics_visit_facts")
+	suite.db.Exec("DELETE FROM analytics_agreement_facts")
+	suite.db.Exec("DELETE FROM analytics_member_facts")
+	suite.db.Exec("DELETE FROM analytics_member_status_changes")
+	suite.db.Exec("DELETE FROM analytics_vote_facts")
+	suite.db.Exec("DELETE FROM analytics_metric_rollups")
+	suite.db.Exec("DELETE FROM analytics_rollup_watermarks")
+	suite.db.Exec("DELETE FROM analytics_retention_policies")
+	suite.db.Exec("DELETE FROM analytics_report_schedules")
+	suite.db.Exec("DELETE FROM analytics_outbox")
+	suite.db.Exec("DELETE FROM analytics_sink_checkpoints")
+	suite.db.Exec("DELETE FROM analytics_privacy_settings")
+	suite.db.Exec("DELETE FROM analytics_erasure_requests")
 }
 
 func (suite *RepositoryTestSuite) TestIsHealthy() {
@@ -255,15 +295,15 @@ func (suite *RepositoryTestSuite) TestDashboardOperations() {
 		ClubID:      "test-club-1",
 		Name:        "Test Dashboard",
 		Description: "A test dashboard",
-		Panels: map[string]interface{}{
-			"panel1": map[string]interface{}{
-				"title": "Visitor Count",
-				"type":  "graph",
-			},
-		},
+		Panels: []dashboard.Panel{{
+			Title:      "Visitor Count",
+			Query:      dashboard.Query{Source: dashboard.SourceEvent, Name: "visit", GroupBy: []string{"facility"}},
+			Thresholds: []dashboard.Threshold{{Value: 100, Color: "red"}},
+		}},
 		IsPublic:  true,
 		CreatedBy: "admin@test.com",
 	}
+	suite.Require().NoError(dashboard.Validate())
 
 	// Test Create
 	err := suite.repo.CreateDashboard(dashboard)
@@ -275,6 +315,8 @@ func (suite *RepositoryTestSuite) TestDashboardOperations() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), dashboard.Name, retrieved.Name)
 	assert.Equal(suite.T(), dashboard.ClubID, retrieved.ClubID)
+	assert.Equal(suite.T(), dashboard.Panels, retrieved.Panels)
+	assert.Equal(suite.T(), dashboard.TimeRange, retrieved.TimeRange)
 
 	// Test Update
 	dashboard.Name = "Updated Dashboard"
@@ -295,7 +337,31 @@ func (suite *RepositoryTestSuite) TestDashboardOperations() {
 	assert.NoError(suite.T(), err)
 
 	_, err = suite.repo.GetDashboard(dashboard.ID)
-	assert.Error(suite.T(), err)
+	assert.ErrorIs(suite.T(), err, ErrNotFound)
+	assert.ErrorIs(suite.T(), suite.repo.DeleteDashboard(dashboard.ID), ErrNotFound)
+}
+
+func (suite *RepositoryTestSuite) TestDashboardValidate() {
+	valid := func() *Dashboard {
+		return &Dashboard{ClubID: "1", Name: "Club", Panels: []dashboard.Panel{{Title: "Visits", Query: dashboard.Query{Name: "visits"}}}}
+	}
+	d := valid()
+	suite.Require().NoError(d.Validate())
+	assert.Equal(suite.T(), dashboard.DefaultTimeRange, d.TimeRange)
+	assert.Equal(suite.T(), "panel-1", d.Panels[0].ID)
+	assert.True(suite.T(), d.VisibleTo("1"))
+	assert.False(suite.T(), d.VisibleTo("2"))
+
+	for name, mutate := range map[string]func(*Dashboard){
+		"name":       func(d *Dashboard) { d.Name = "" },
+		"refresh":    func(d *Dashboard) { d.RefreshSeconds = 1 },
+		"time_range": func(d *Dashboard) { d.TimeRange = dashboard.TimeRange{From: "last week"} },
+		"panel":      func(d *Dashboard) { d.Panels[0].Query.Name = "" },
+	} {
+		d := valid()
+		mutate(d)
+		assert.Error(suite.T(), d.Validate(), name)
+	}
 }
 
 func (suite *RepositoryTestSuite) TestAggregateMetrics() {
@@ -416,6 +482,831 @@ func (suite *RepositoryTestSuite) TestCleanupOldEvents() {
 	assert.Equal(suite.T(), newEvent.ID, remainingEvent.ID)
 }
 
+func (suite *RepositoryTestSuite) TestGetPredictiveAnalytics() {
+	clubID := "test-club-1"
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	// Ten weeks of daily visits, busier at weekends, with a reading every morning and evening
+	var metrics []*AnalyticsMetric
+	for d := 70; d >= 1; d-- {
+		day := today.AddDate(0, 0, -d)
+		visits := 40.0 + float64(d%3)
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			visits += 25
+		}
+		for _, hour := range []int{9, 18} {
+			metrics = append(metrics, &AnalyticsMetric{
+				ClubID:      clubID,
+				MetricName:  "daily_visits",
+				MetricValue: visits,
+				Timestamp:   day.Add(time.Duration(hour) * time.Hour),
+			})
+		}
+	}
+	suite.Require().NoError(suite.db.Create(&metrics).Error)
+
+	result, err := suite.repo.GetPredictiveAnalytics(clubID, "daily_visits", 14)
+	suite.Require().NoError(err)
+
+	predictions := result["predictions"].([]map[string]interface{})
+	suite.Require().Len(predictions, 14)
+	for _, p := range predictions {
+		timestamp := p["timestamp"].(time.Time)
+		value := p["predicted_value"].(float64)
+		assert.True(suite.T(), timestamp.After(today.Add(-time.Second)))
+		assert.LessOrEqual(suite.T(), p["confidence_lower"].(float64), value)
+		assert.GreaterOrEqual(suite.T(), p["confidence_upper"].(float64), value)
+
+		if timestamp.Weekday() == time.Saturday || timestamp.Weekday() == time.Sunday {
+			assert.InDelta(suite.T(), 66, value, 6)
+		} else {
+			assert.InDelta(suite.T(), 41, value, 6)
+		}
+	}
+
+	summary := result["summary"].(map[string]interface{})
+	assert.Contains(suite.T(), []string{"holt_winters", "linear_regression"}, summary["model_type"])
+	assert.Equal(suite.T(), "95%", summary["confidence_level"])
+	assert.Greater(suite.T(), summary["accuracy"].(float64), 0.9)
+	assert.Contains(suite.T(), summary, "mape")
+	assert.Contains(suite.T(), summary, "rmse")
+	assert.Equal(suite.T(), 70, summary["training_days"])
+
+	// Unchanged history reuses the cached model
+	again, err := suite.repo.GetPredictiveAnalytics(clubID, "daily_visits", 7)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), summary["fitted_at"], again["summary"].(map[string]interface{})["fitted_at"])
+	assert.Len(suite.T(), again["predictions"], 7)
+
+	// New data invalidates it
+	suite.Require().NoError(suite.repo.RecordMetric(&AnalyticsMetric{ClubID: clubID, MetricName: "daily_visits", MetricValue: 42}))
+	refitted, err := suite.repo.GetPredictiveAnalytics(clubID, "daily_visits", 7)
+	suite.Require().NoError(err)
+	assert.NotEqual(suite.T(), summary["fitted_at"], refitted["summary"].(map[string]interface{})["fitted_at"])
+
+	// Too little history and invalid horizons are rejected
+	_, err = suite.repo.GetPredictiveAnalytics("other-club", "daily_visits", 7)
+	assert.ErrorIs(suite.T(), err, forecasting.ErrInsufficientData)
+	_, err = suite.repo.GetPredictiveAnalytics(clubID, "daily_visits", 0)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *RepositoryTestSuite) TestGetAnomalyDetection() {
+	clubID := "test-club-1"
+	end := time.Now().UTC().Truncate(time.Hour)
+	start := end.Add(-7 * 24 * time.Hour)
+
+	// A week of hourly check-ins following the daily cycle, with one surge
+	rng := rand.New(rand.NewSource(11))
+	var metrics []*AnalyticsMetric
+	surge := start.Add(100 * time.Hour)
+	for ts := start; ts.Before(end); ts = ts.Add(time.Hour) {
+		value := 20 + 10*math.Sin(2*math.Pi*float64(ts.Hour())/24) + rng.NormFloat64()
+		if ts.Equal(surge) {
+			value += 60
+		}
+		metrics = append(metrics, &AnalyticsMetric{ClubID: clubID, MetricName: "check_ins", MetricValue: value, Timestamp: ts.Add(15 * time.Minute)})
+	}
+	suite.Require().NoError(suite.db.Create(&metrics).Error)
+
+	// Noise alone crosses the default threshold of 3 about once a week of hours
+	setting := DefaultAnomalySetting(clubID, "check_ins")
+	setting.Sensitivity = 0.25
+	suite.Require().NoError(suite.repo.SaveAnomalySetting(setting))
+
+	result, err := suite.repo.GetAnomalyDetection(clubID, "check_ins", TimeRange{Start: start, End: end})
+	suite.Require().NoError(err)
+
+	anomalies := result["anomalies"].([]map[string]interface{})
+	suite.Require().Len(anomalies, 1)
+	assert.True(suite.T(), anomalies[0]["timestamp"].(time.Time).Equal(surge))
+	assert.Equal(suite.T(), anomaly.SeverityHigh, anomalies[0]["severity"])
+	assert.Equal(suite.T(), anomaly.DirectionAbove, anomalies[0]["direction"])
+	assert.Greater(suite.T(), anomalies[0]["value"].(float64), anomalies[0]["expected_value"].(float64)+50)
+
+	summary := result["summary"].(map[string]interface{})
+	assert.Equal(suite.T(), 1, summary["total_anomalies"])
+	assert.Equal(suite.T(), 1, summary["high_severity"])
+	assert.Equal(suite.T(), 4.0, summary["threshold"])
+	assert.Equal(suite.T(), 7*24, summary["buckets"])
+
+	// A stored setting restricted to the change-point detector ignores the surge
+	suite.Require().NoError(suite.repo.SaveAnomalySetting(&AnomalySetting{
+		ClubID: clubID, MetricName: "check_ins", Sensitivity: 0.25, Methods: []string{"changepoint"},
+		Window: 24, Resolution: ResolutionHour, CooldownMinutes: 60, Enabled: true,
+	}))
+	result, err = suite.repo.GetAnomalyDetection(clubID, "check_ins", TimeRange{Start: start, End: end})
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), result["anomalies"])
+}
+
+func (suite *RepositoryTestSuite) TestAnomalySettings() {
+	setting, err := suite.repo.GetAnomalySetting("test-club-1", "revenue")
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), DefaultAnomalySetting("test-club-1", "revenue"), setting)
+
+	setting.Sensitivity = 0.8
+	setting.Resolution = ResolutionDay
+	setting.Methods = []string{"mad", "seasonal"}
+	suite.Require().NoError(suite.repo.SaveAnomalySetting(setting))
+
+	// Saving again updates the existing row
+	update := DefaultAnomalySetting("test-club-1", "revenue")
+	update.Enabled = false
+	update.Sensitivity = 0.2
+	suite.Require().NoError(suite.repo.SaveAnomalySetting(update))
+
+	stored, err := suite.repo.GetAnomalySetting("test-club-1", "revenue")
+	suite.Require().NoError(err)
+	assert.False(suite.T(), stored.Enabled)
+	assert.Equal(suite.T(), 0.2, stored.Sensitivity)
+	assert.Equal(suite.T(), ResolutionHour, stored.Resolution)
+	var count int64
+	suite.db.Model(&AnomalySetting{}).Count(&count)
+	assert.Equal(suite.T(), int64(1), count)
+
+	for _, invalid := range []func(s *AnomalySetting){
+		func(s *AnomalySetting) { s.Sensitivity = 1.5 },
+		func(s *AnomalySetting) { s.Window = 1 },
+		func(s *AnomalySetting) { s.Resolution = "week" },
+		func(s *AnomalySetting) { s.Methods = []string{"prophet"} },
+	} {
+		s := DefaultAnomalySetting("test-club-1", "revenue")
+		invalid(s)
+		assert.Error(suite.T(), suite.repo.SaveAnomalySetting(s))
+	}
+}
+
+func (suite *RepositoryTestSuite) TestAnomalyEpisodes() {
+	now := time.Now()
+
+	open, err := suite.repo.GetOpenAnomalyEpisode("test-club-1", "check_ins")
+	suite.Require().NoError(err)
+	assert.Nil(suite.T(), open)
+
+	episode := &AnomalyEpisode{
+		ClubID: "test-club-1", MetricName: "check_ins", Status: EpisodeOpen, Severity: anomaly.SeverityHigh,
+		Methods: []string{"mad"}, Occurrences: 1, StartedAt: now.Add(-3 * time.Hour), LastSeenAt: now.Add(-3 * time.Hour),
+		ExpiresAt: now.Add(-time.Hour),
+	}
+	suite.Require().NoError(suite.repo.SaveAnomalyEpisode(episode))
+	suite.Require().NoError(suite.repo.SaveAnomalyEpisode(&AnomalyEpisode{
+		ClubID: "test-club-1", MetricName: "revenue", Status: EpisodeOpen, StartedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour),
+	}))
+
+	open, err = suite.repo.GetOpenAnomalyEpisode("test-club-1", "check_ins")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(open)
+	assert.Equal(suite.T(), episode.ID, open.ID)
+	assert.Equal(suite.T(), []string{"mad"}, open.Methods)
+
+	expired, err := suite.repo.GetExpiredAnomalyEpisodes(now)
+	suite.Require().NoError(err)
+	suite.Require().Len(expired, 1)
+	assert.Equal(suite.T(), episode.ID, expired[0].ID)
+
+	expired[0].Status = EpisodeResolved
+	expired[0].ResolvedAt = &now
+	suite.Require().NoError(suite.repo.SaveAnomalyEpisode(expired[0]))
+
+	all, err := suite.repo.ListAnomalyEpisodes("test-club-1", "", "", 10)
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), all, 2)
+	resolved, err := suite.repo.ListAnomalyEpisodes("test-club-1", "check_ins", EpisodeResolved, 10)
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), resolved, 1)
+	open, err = suite.repo.GetOpenAnomalyEpisode("test-club-1", "check_ins")
+	suite.Require().NoError(err)
+	assert.Nil(suite.T(), open)
+}
+
+func (suite *RepositoryTestSuite) TestIngestEvent() {
+	requested := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	checkedIn := requested.Add(26 * time.Hour)
+	visitDate := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	visit := func(status string, at time.Time) *VisitFact {
+		return &VisitFact{VisitID: 41, MemberID: 1200, VisitingClubID: 3, HomeClubID: 5, Status: status, VisitDate: visitDate, LastEventAt: at}
+	}
+
+	checkedInFact := visit("checked_in", checkedIn)
+	checkedInFact.CheckedInAt = &checkedIn
+	ingested, err := suite.repo.IngestEvent(&IngestedMessage{MessageID: "msg_2", EventType: "visit.checked_in"}, checkedInFact)
+	suite.Require().NoError(err)
+	assert.True(suite.T(), ingested)
+
+	// The earlier request arrives late; it fills in its timestamp but leaves the status
+	requestedFact := visit("pending", requested)
+	requestedFact.RequestedAt = &requested
+	ingested, err = suite.repo.IngestEvent(&IngestedMessage{MessageID: "msg_1", EventType: "visit.requested"}, requestedFact)
+	suite.Require().NoError(err)
+	assert.True(suite.T(), ingested)
+
+	// Redelivery of an ingested message is skipped
+	ingested, err = suite.repo.IngestEvent(&IngestedMessage{MessageID: "msg_1", EventType: "visit.requested"}, visit("cancelled", checkedIn.Add(time.Hour)))
+	suite.Require().NoError(err)
+	assert.False(suite.T(), ingested)
+
+	var facts []VisitFact
+	suite.Require().NoError(suite.db.Find(&facts).Error)
+	suite.Require().Len(facts, 1)
+	assert.Equal(suite.T(), "checked_in", facts[0].Status)
+	suite.Require().NotNil(facts[0].RequestedAt)
+	assert.True(suite.T(), requested.Equal(*facts[0].RequestedAt))
+	suite.Require().NotNil(facts[0].CheckedInAt)
+	assert.True(suite.T(), checkedIn.Equal(*facts[0].CheckedInAt))
+
+	var messages int64
+	suite.db.Model(&IngestedMessage{}).Count(&messages)
+	assert.Equal(suite.T(), int64(2), messages)
+
+	// Votes are immutable, so a second message for the same vote changes nothing
+	for i, choice := range []string{"yes", "no"} {
+		_, err := suite.repo.IngestEvent(&IngestedMessage{MessageID: fmt.Sprintf("vote_%d", i)}, &VoteFact{VoteID: 9, ProposalID: 4, ClubID: 3, Choice: choice, CastAt: requested})
+		suite.Require().NoError(err)
+	}
+	var votes []VoteFact
+	suite.Require().NoError(suite.db.Find(&votes).Error)
+	suite.Require().Len(votes, 1)
+	assert.Equal(suite.T(), "yes", votes[0].Choice)
+
+	_, err = suite.repo.IngestEvent(&IngestedMessage{}, visit("pending", requested))
+	assert.Error(suite.T(), err)
+}
+
+func (suite *RepositoryTestSuite) TestBackfillFacts() {
+	// The source tables live in the member and reciprocal services' own database
+	source, err := gorm.Open(sqlite.Open("file:backfill_source?mode=memory&cache=shared"), &gorm.Config{})
+	suite.Require().NoError(err)
+	sourceSQL, err := source.DB()
+	suite.Require().NoError(err)
+	defer sourceSQL.Close()
+
+	suite.Require().NoError(source.Exec(`CREATE TABLE members (
+		id integer PRIMARY KEY, club_id integer, user_id integer, member_number text, membership_type text,
+		status text, joined_at datetime, created_at datetime, updated_at datetime, deleted_at datetime)`).Error)
+	suite.Require().NoError(source.Exec(`CREATE TABLE reciprocal_visits (
+		id integer PRIMARY KEY, agreement_id integer, member_id integer, visiting_club_id integer, home_club_id integer,
+		status text, visit_date datetime, check_in_time datetime, check_out_time datetime, verified_at datetime,
+		created_at datetime, updated_at datetime, deleted_at datetime)`).Error)
+
+	joined := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	updated := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	suite.Require().NoError(source.Exec(`INSERT INTO members VALUES
+		(1, 3, 501, 'M-1', 'REGULAR', 'ACTIVE', ?, ?, ?, NULL),
+		(2, 3, 502, 'M-2', 'VIP', 'SUSPENDED', ?, ?, ?, ?),
+		(3, 5, 503, 'M-3', 'REGULAR', 'ACTIVE', ?, ?, ?, NULL)`,
+		joined, joined, updated, joined, joined, updated, updated, joined, joined, joined).Error)
+	suite.Require().NoError(source.Exec(`INSERT INTO reciprocal_visits VALUES
+		(41, 7, 1, 5, 3, 'completed', ?, ?, ?, ?, ?, ?, NULL),
+		(42, 7, 1, 5, 3, 'pending', ?, NULL, NULL, NULL, ?, ?, ?)`,
+		updated, updated.Add(10*time.Hour), updated.Add(12*time.Hour), joined, joined, updated,
+		updated, joined, updated, updated).Error)
+
+	count, err := suite.repo.BackfillFacts(BackfillMembers, source, joined.Add(time.Hour))
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 2, count)
+
+	var members []MemberFact
+	suite.Require().NoError(suite.db.Order("member_id").Find(&members).Error)
+	suite.Require().Len(members, 2)
+	assert.Equal(suite.T(), uint(3), members[0].ClubID)
+	suite.Require().NotNil(members[0].JoinedAt)
+	assert.True(suite.T(), joined.Equal(*members[0].JoinedAt))
+	assert.Nil(suite.T(), members[0].RemovedAt)
+	assert.NotNil(suite.T(), members[1].RemovedAt)
+
+	// Soft-deleted visits are skipped
+	count, err = suite.repo.BackfillFacts(BackfillVisits, source, time.Time{})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 1, count)
+
+	var visits []VisitFact
+	suite.Require().NoError(suite.db.Find(&visits).Error)
+	suite.Require().Len(visits, 1)
+	assert.Equal(suite.T(), "completed", visits[0].Status)
+	assert.Equal(suite.T(), uint(5), visits[0].VisitingClubID)
+	assert.NotNil(suite.T(), visits[0].ConfirmedAt)
+	assert.NotNil(suite.T(), visits[0].CompletedAt)
+
+	// Running the backfill again leaves a single fact per record
+	_, err = suite.repo.BackfillFacts(BackfillVisits, source, time.Time{})
+	suite.Require().NoError(err)
+	var total int64
+	suite.db.Model(&VisitFact{}).Count(&total)
+	assert.Equal(suite.T(), int64(1), total)
+
+	_, err = suite.repo.BackfillFacts("payments", source, time.Time{})
+	assert.Error(suite.T(), err)
+
+	// Nothing is read from the analytics database itself
+	_, err = suite.repo.BackfillFacts(BackfillVisits, nil, time.Time{})
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), suite.db.Migrator().HasTable("reciprocal_visits"))
+}
+
+func (suite *RepositoryTestSuite) TestRollUpAndQueryRollups() {
+	clubID := "test-club-1"
+	base := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	for i, value := range []float64{10, 20, 30, 40} {
+		area := "gym"
+		if i%2 == 1 {
+			area = "pool"
+		}
+		suite.Require().NoError(suite.repo.RecordMetric(&AnalyticsMetric{
+			ClubID:      clubID,
+			MetricName:  "occupancy",
+			MetricValue: value,
+			Tags:        map[string]interface{}{"area": area},
+			Timestamp:   base.Add(time.Duration(i) * 40 * time.Minute),
+		}))
+	}
+	for i := 0; i < 3; i++ {
+		suite.Require().NoError(suite.repo.RecordEvent(&AnalyticsEvent{ClubID: clubID, EventType: "member_visit", Timestamp: base.Add(time.Duration(i) * time.Minute)}))
+	}
+
+	later := time.Now().Add(time.Minute)
+	count, err := suite.repo.RollUp(later)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 7, count)
+
+	// Rows already rolled up are not counted again
+	count, err = suite.repo.RollUp(later)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 0, count)
+
+	result, err := suite.repo.QueryRollups(&RollupQuery{
+		ClubID:  clubID,
+		Name:    "occupancy",
+		Start:   base,
+		End:     base.Add(3 * time.Hour),
+		Bucket:  "1h",
+		GroupBy: []string{"area"},
+		Fill:    "zero",
+	})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "hour", string(result.Resolution))
+	suite.Require().Len(result.Series, 2)
+
+	gym := result.Series[0]
+	assert.Equal(suite.T(), map[string]string{"area": "gym"}, gym.Tags)
+	suite.Require().Len(gym.Points, 3)
+	assert.Equal(suite.T(), int64(1), *gym.Points[0].Count)
+	assert.Equal(suite.T(), 10.0, *gym.Points[0].Sum)
+	assert.Equal(suite.T(), int64(1), *gym.Points[1].Count)
+	assert.Equal(suite.T(), 30.0, *gym.Points[1].Max)
+	assert.Equal(suite.T(), int64(0), *gym.Points[2].Count)
+
+	// Minute rollups combine into any minute bucket, and tags can be filtered
+	result, err = suite.repo.QueryRollups(&RollupQuery{
+		ClubID: clubID,
+		Name:   "occupancy",
+		Start:  base,
+		End:    base.Add(time.Hour),
+		Bucket: "30m",
+		Tags:   map[string]string{"area": "pool"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Series, 1)
+	points := result.Series[0].Points
+	suite.Require().Len(points, 1)
+	assert.Equal(suite.T(), base.Add(30*time.Minute), points[0].Timestamp)
+	assert.Equal(suite.T(), 20.0, *points[0].Avg)
+	assert.Equal(suite.T(), 20.0, *points[0].P95)
+
+	// Events are counted by type
+	result, err = suite.repo.QueryRollups(&RollupQuery{
+		ClubID: clubID,
+		Source: RollupSourceEvent,
+		Name:   "member_visit",
+		Start:  base,
+		End:    base.AddDate(0, 0, 1),
+		Bucket: "1d",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Series, 1)
+	suite.Require().Len(result.Series[0].Points, 1)
+	assert.Equal(suite.T(), int64(3), *result.Series[0].Points[0].Count)
+
+	_, err = suite.repo.QueryRollups(&RollupQuery{ClubID: clubID, Name: "occupancy", Start: base, End: base.AddDate(1, 0, 0), Bucket: "1m"})
+	assert.Error(suite.T(), err)
+	_, err = suite.repo.QueryRollups(&RollupQuery{ClubID: clubID, Name: "occupancy", Start: base, End: base.Add(time.Hour), Bucket: "1h", Fill: "linear"})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *RepositoryTestSuite) TestApplyRetention() {
+	clubID := "test-club-1"
+	now := time.Now()
+	old := now.AddDate(0, 0, -40)
+	for _, at := range []time.Time{old, now.Add(-time.Hour)} {
+		suite.Require().NoError(suite.repo.RecordMetric(&AnalyticsMetric{ClubID: clubID, MetricName: "occupancy", MetricValue: 1, Timestamp: at}))
+	}
+
+	policy, err := suite.repo.GetRetentionPolicy()
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), DefaultRetentionPolicy().HourDays, policy.HourDays)
+
+	// Raw rows that have not been rolled up yet are kept
+	deleted, err := suite.repo.ApplyRetention(policy, now)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(0), deleted["raw"])
+
+	_, err = suite.repo.RollUp(now.Add(time.Minute))
+	suite.Require().NoError(err)
+
+	deleted, err = suite.repo.ApplyRetention(policy, now)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(1), deleted["raw"])
+	assert.Equal(suite.T(), int64(1), deleted["minute"])
+	assert.Equal(suite.T(), int64(0), deleted["hour"])
+
+	var metrics int64
+	suite.db.Model(&AnalyticsMetric{}).Count(&metrics)
+	assert.Equal(suite.T(), int64(1), metrics)
+
+	// The old hour survives the raw rows it was built from
+	result, err := suite.repo.QueryRollups(&RollupQuery{ClubID: clubID, Name: "occupancy", Start: old.Add(-time.Hour), End: now, Bucket: "1d"})
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Series, 1)
+	assert.Len(suite.T(), result.Series[0].Points, 2)
+	assert.Nil(suite.T(), result.CoveredFrom)
+
+	policy.HourDays = -1
+	assert.Error(suite.T(), suite.repo.SaveRetentionPolicy(policy))
+	policy.HourDays = 365
+	suite.Require().NoError(suite.repo.SaveRetentionPolicy(policy))
+	policy.RawDays = 7
+	suite.Require().NoError(suite.repo.SaveRetentionPolicy(policy))
+	stored, err := suite.repo.GetRetentionPolicy()
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 7, stored.RawDays)
+	var policies int64
+	suite.db.Model(&RetentionPolicy{}).Count(&policies)
+	assert.Equal(suite.T(), int64(1), policies)
+}
+
+func (suite *RepositoryTestSuite) TestNetworkAnalytics() {
+	june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	ingest := func(id string, fact Fact) {
+		_, err := suite.repo.IngestEvent(&IngestedMessage{MessageID: id}, fact)
+		suite.Require().NoError(err)
+	}
+	rating := 5
+
+	ingest("a7", &AgreementFact{AgreementID: 7, ProposingClubID: 1, TargetClubID: 2, Status: "active", MaxVisitsPerMonth: 4, LastEventAt: june})
+	ingest("a8", &AgreementFact{AgreementID: 8, ProposingClubID: 1, TargetClubID: 3, Status: "rejected", LastEventAt: june})
+	ingest("a9", &AgreementFact{AgreementID: 9, ProposingClubID: 2, TargetClubID: 3, Status: "expired", LastEventAt: june})
+
+	// Feedback arrives on a later event without the visit's facilities
+	ingest("v1", &VisitFact{VisitID: 1, AgreementID: 7, MemberID: 100, HomeClubID: 1, VisitingClubID: 2, Status: "completed", VisitDate: june.AddDate(0, 0, 3), FacilitiesUsed: []string{"pool"}, LastEventAt: june.AddDate(0, 0, 3)})
+	ingest("v1-rated", &VisitFact{VisitID: 1, AgreementID: 7, MemberID: 100, HomeClubID: 1, VisitingClubID: 2, Status: "completed", VisitDate: june.AddDate(0, 0, 3), MemberRating: &rating, LastEventAt: june.AddDate(0, 0, 4)})
+	ingest("v2", &VisitFact{VisitID: 2, AgreementID: 9, MemberID: 300, HomeClubID: 3, VisitingClubID: 2, Status: "no_show", VisitDate: june.AddDate(0, 0, 5), LastEventAt: june.AddDate(0, 0, 5)})
+	ingest("v3", &VisitFact{VisitID: 3, AgreementID: 7, MemberID: 100, HomeClubID: 1, VisitingClubID: 2, Status: "completed", VisitDate: june.AddDate(0, 2, 0), LastEventAt: june.AddDate(0, 2, 0)})
+
+	timeRange := TimeRange{Start: june, End: june.AddDate(0, 1, 0)}
+	report, err := suite.repo.NetworkAnalytics(1, timeRange)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 1, report.Outcomes.Visits)
+	suite.Require().Len(report.Agreements, 1)
+	assert.Equal(suite.T(), uint(7), report.Agreements[0].AgreementID)
+	assert.Equal(suite.T(), 1, report.Agreements[0].Outbound)
+	suite.Require().NotNil(report.Agreements[0].Utilization)
+	assert.Equal(suite.T(), 0.25, report.Agreements[0].Utilization.Rate)
+	suite.Require().Len(report.Clubs, 1)
+	assert.Equal(suite.T(), uint(1), report.Clubs[0].ClubID)
+
+	// Across the network, agreements no longer in force still report the
+	// visits made under them
+	report, err = suite.repo.NetworkAnalytics(0, timeRange)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 2, report.Outcomes.Visits)
+	suite.Require().Len(report.Agreements, 2)
+	assert.Equal(suite.T(), uint(9), report.Agreements[1].AgreementID)
+
+	host := report.Clubs[0]
+	assert.Equal(suite.T(), uint(2), host.ClubID)
+	assert.Equal(suite.T(), 0.5, host.Hosted.NoShowRate)
+	suite.Require().NotNil(host.HostRating)
+	assert.Equal(suite.T(), 5.0, host.HostRating.Average)
+	assert.Equal(suite.T(), "pool", host.Facilities[0].Facility)
+
+	_, err = suite.repo.NetworkAnalytics(0, TimeRange{Start: june, End: june})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *RepositoryTestSuite) TestCohortAnalysis() {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ingest := func(id string, fact Fact) {
+		_, err := suite.repo.IngestEvent(&IngestedMessage{MessageID: id}, fact)
+		suite.Require().NoError(err)
+	}
+	at := func(month time.Month, day int) *time.Time {
+		t := time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	// The suspension arrives before the creation, and both go in the history
+	ingest("m1-suspended", &MemberFact{MemberID: 1, ClubID: 1, MembershipType: "REGULAR", Status: "SUSPENDED", LastEventAt: *at(4, 20)})
+	ingest("m1-created", &MemberFact{MemberID: 1, ClubID: 1, MembershipType: "REGULAR", Status: "ACTIVE", JoinedAt: at(1, 10), LastEventAt: *at(1, 10)})
+	ingest("m2-created", &MemberFact{MemberID: 2, ClubID: 1, MembershipType: "VIP", Status: "ACTIVE", JoinedAt: at(1, 15), LastEventAt: *at(1, 15)})
+	ingest("m3-created", &MemberFact{MemberID: 3, ClubID: 2, MembershipType: "VIP", Status: "ACTIVE", JoinedAt: at(1, 15), LastEventAt: *at(1, 15)})
+	ingest("visit1", &VisitFact{VisitID: 1, MemberID: 2, HomeClubID: 1, VisitingClubID: 2, Status: "completed", VisitDate: *at(3, 1), LastEventAt: *at(3, 1)})
+	ingest("visit2", &VisitFact{VisitID: 2, MemberID: 1, HomeClubID: 1, VisitingClubID: 2, Status: "cancelled", VisitDate: *at(3, 1), LastEventAt: *at(3, 1)})
+	ingest("vote1", &VoteFact{VoteID: 1, ProposalID: 1, MemberID: 2, ClubID: 1, Choice: "yes", CastAt: *at(10, 1)})
+
+	var fact MemberFact
+	suite.Require().NoError(suite.db.First(&fact, "member_id = ?", 1).Error)
+	assert.Equal(suite.T(), "SUSPENDED", fact.Status)
+	var changes int64
+	suite.Require().NoError(suite.db.Model(&MemberStatusChange{}).Where("member_id = ?", 1).Count(&changes).Error)
+	assert.Equal(suite.T(), int64(2), changes)
+
+	report, err := suite.repo.CohortAnalysis(1, TimeRange{Start: start, End: start.AddDate(1, 0, 0)}, cohort.Options{RenewalMonths: 6})
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Cohorts, 12)
+	assert.Equal(suite.T(), 2, report.Cohorts[0].Members)
+	assert.Equal(suite.T(), 2, report.Cohorts[0].Retention[3].Active)
+	assert.Equal(suite.T(), 1, report.Cohorts[0].Retention[4].Active)
+	assert.Equal(suite.T(), cohort.TypeChurn{MembershipType: cohort.AllTypes, Active: 1, Exposed: 2, Churned: 1, Rate: 0.5}, report.Churn[0])
+
+	// Only attended visits count as usage
+	assert.Equal(suite.T(), 2, report.UsageRenewal.Members)
+	assert.Equal(suite.T(), cohort.UsageBand{Band: "none", Members: 1}, report.UsageRenewal.Bands[0])
+	assert.Equal(suite.T(), cohort.UsageBand{Band: "1-2", Members: 1, Renewed: 1, RenewalRate: 1}, report.UsageRenewal.Bands[1])
+
+	_, err = suite.repo.CohortAnalysis(1, TimeRange{Start: start, End: start}, cohort.Options{})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *RepositoryTestSuite) TestReportSchedules() {
+	due := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	schedule := &ReportSchedule{
+		ClubID:     "1",
+		Name:       "Weekly engagement",
+		Cron:       "0 8 * * mon",
+		ReportType: ReportTypeEngagement,
+		Format:     "XLSX",
+		Recipients: []string{"Board <board@example.com>"},
+		Enabled:    true,
+		NextRunAt:  &due,
+	}
+	suite.Require().NoError(schedule.Validate())
+	assert.Equal(suite.T(), "xlsx", schedule.Format)
+	assert.Equal(suite.T(), []string{"board@example.com"}, schedule.Recipients)
+	suite.Require().NoError(suite.repo.CreateReportSchedule(schedule))
+
+	disabled := &ReportSchedule{ClubID: "1", Name: "Paused", Cron: "@daily", ReportType: ReportTypeUsage, Recipients: []string{"a@example.com"}, NextRunAt: &due}
+	suite.Require().NoError(suite.repo.CreateReportSchedule(disabled))
+
+	schedules, err := suite.repo.DueReportSchedules(due.Add(time.Minute), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(schedules, 1)
+	assert.Equal(suite.T(), schedule.ID, schedules[0].ID)
+
+	none, err := suite.repo.DueReportSchedules(due.Add(-time.Minute), 10)
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), none)
+
+	// Only the first replica to claim a run gets it
+	next := due.AddDate(0, 0, 7)
+	claimed, err := suite.repo.ClaimReportSchedule(schedule.ID, *schedules[0].NextRunAt, &next)
+	suite.Require().NoError(err)
+	assert.True(suite.T(), claimed)
+	claimed, err = suite.repo.ClaimReportSchedule(schedule.ID, *schedules[0].NextRunAt, &next)
+	suite.Require().NoError(err)
+	assert.False(suite.T(), claimed)
+
+	reportID := uint(42)
+	suite.Require().NoError(suite.repo.RecordReportScheduleRun(schedule.ID, &reportID, ReportStatusDelivered, nil))
+	stored, err := suite.repo.GetReportSchedule(schedule.ID)
+	suite.Require().NoError(err)
+	assert.True(suite.T(), next.Equal(*stored.NextRunAt))
+	assert.True(suite.T(), due.Equal(*stored.LastRunAt))
+	assert.Equal(suite.T(), ReportStatusDelivered, stored.LastStatus)
+	assert.Equal(suite.T(), reportID, *stored.LastReportID)
+
+	listed, err := suite.repo.ListReportSchedules("1")
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), listed, 2)
+
+	suite.Require().NoError(suite.repo.DeleteReportSchedule(schedule.ID))
+	_, err = suite.repo.GetReportSchedule(schedule.ID)
+	assert.ErrorIs(suite.T(), err, ErrNotFound)
+	assert.ErrorIs(suite.T(), suite.repo.DeleteReportSchedule(schedule.ID), ErrNotFound)
+}
+
+func (suite *RepositoryTestSuite) TestReportScheduleValidate() {
+	valid := func() *ReportSchedule {
+		return &ReportSchedule{ClubID: "1", Name: "Report", Cron: "@weekly", ReportType: ReportTypeUsage, Recipients: []string{"a@example.com"}}
+	}
+	suite.Require().NoError(valid().Validate())
+
+	for name, mutate := range map[string]func(*ReportSchedule){
+		"club":       func(s *ReportSchedule) { s.ClubID = "club1" },
+		"cron":       func(s *ReportSchedule) { s.Cron = "every day" },
+		"never":      func(s *ReportSchedule) { s.Cron = "0 0 30 2 *" },
+		"timezone":   func(s *ReportSchedule) { s.Timezone = "Mars/Olympus" },
+		"type":       func(s *ReportSchedule) { s.ReportType = "financial" },
+		"format":     func(s *ReportSchedule) { s.Format = "pdf" },
+		"recipient":  func(s *ReportSchedule) { s.Recipients = []string{"not an address"} },
+		"recipients": func(s *ReportSchedule) { s.Recipients = nil },
+		"days":       func(s *ReportSchedule) { s.Parameters = map[string]string{"days": "0"} },
+	} {
+		schedule := valid()
+		mutate(schedule)
+		assert.Error(suite.T(), schedule.Validate(), name)
+	}
+}
+
+func (suite *RepositoryTestSuite) TestEngagementStats() {
+	june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	ingest := func(id string, fact Fact) {
+		_, err := suite.repo.IngestEvent(&IngestedMessage{MessageID: id}, fact)
+		suite.Require().NoError(err)
+	}
+	at := func(days int) *time.Time {
+		t := june.AddDate(0, 0, days)
+		return &t
+	}
+
+	ingest("m1", &MemberFact{MemberID: 1, ClubID: 1, MembershipType: "full", Status: "active", JoinedAt: at(-30), LastEventAt: june})
+	ingest("m2", &MemberFact{MemberID: 2, ClubID: 1, MembershipType: "social", Status: "active", JoinedAt: at(2), LastEventAt: *at(2)})
+	ingest("m3", &MemberFact{MemberID: 3, ClubID: 1, MembershipType: "full", Status: "removed", JoinedAt: at(-60), RemovedAt: at(3), LastEventAt: *at(3)})
+	ingest("m4", &MemberFact{MemberID: 4, ClubID: 1, MembershipType: "full", Status: "active", JoinedAt: at(10), LastEventAt: *at(10)})
+	ingest("m5", &MemberFact{MemberID: 5, ClubID: 2, MembershipType: "full", Status: "active", JoinedAt: at(1), LastEventAt: *at(1)})
+	ingest("vote1", &VoteFact{VoteID: 1, ProposalID: 1, MemberID: 1, ClubID: 1, Choice: "yes", CastAt: *at(1)})
+	ingest("vote2", &VoteFact{VoteID: 2, ProposalID: 2, MemberID: 1, ClubID: 1, Choice: "no", CastAt: *at(4)})
+	ingest("visit1", &VisitFact{VisitID: 1, MemberID: 1, HomeClubID: 1, VisitingClubID: 2, Status: "completed", VisitDate: *at(2), LastEventAt: *at(2)})
+	ingest("visit2", &VisitFact{VisitID: 2, MemberID: 5, HomeClubID: 2, VisitingClubID: 1, Status: "completed", VisitDate: *at(5), LastEventAt: *at(5)})
+
+	stats, err := suite.repo.EngagementStats(1, TimeRange{Start: june, End: june.AddDate(0, 0, 7)})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(2), stats.Members)
+	assert.Equal(suite.T(), int64(1), stats.Joined)
+	assert.Equal(suite.T(), int64(1), stats.Removed)
+	assert.Equal(suite.T(), int64(2), stats.Votes)
+	assert.Equal(suite.T(), int64(1), stats.Voters)
+	assert.Equal(suite.T(), int64(1), stats.VisitsMade)
+	assert.Equal(suite.T(), int64(1), stats.VisitsHosted)
+	assert.Equal(suite.T(), map[string]int64{"full": 1, "social": 1}, stats.ByType)
+	suite.Require().Len(stats.Activity, 7)
+	assert.Equal(suite.T(), ActivityPeriod{Start: june.AddDate(0, 0, 2), Joined: 1, Visits: 1}, stats.Activity[2])
+}
+
+func (suite *RepositoryTestSuite) TestOutbox() {
+	now := time.Now()
+	event := &AnalyticsEvent{ClubID: "1", EventType: "visit", Data: map[string]interface{}{"member_id": "7"}, Timestamp: now}
+	suite.Require().NoError(suite.repo.RecordEvent(event))
+	metric := &AnalyticsMetric{ClubID: "1", MetricName: "visitors", MetricValue: 3, Tags: map[string]interface{}{"region": "eu"}, Timestamp: now}
+	suite.Require().NoError(suite.repo.RecordMetric(metric))
+
+	all := []string{"event", "metric"}
+	records, err := suite.repo.OutboxBatch(0, all, now.Add(time.Minute), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+	assert.Equal(suite.T(), "event-"+fmt.Sprint(event.ID), records[0].Record().Key())
+	assert.Equal(suite.T(), "7", records[0].Record().Data["member_id"])
+	assert.Equal(suite.T(), 3.0, records[1].Record().Value)
+
+	// Unsettled records are left for the next batch
+	unsettled, err := suite.repo.OutboxBatch(0, all, now.Add(-time.Minute), 10)
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), unsettled)
+
+	metrics, err := suite.repo.OutboxBatch(0, []string{"metric"}, now.Add(time.Minute), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(metrics, 1)
+	assert.Equal(suite.T(), metric.ID, metrics[0].SourceID)
+
+	// Only one replica holds a sink's lease until it expires
+	checkpoint, err := suite.repo.AcquireSinkLease("file", "a", now, now.Add(time.Minute))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(checkpoint)
+	assert.Zero(suite.T(), checkpoint.Position)
+	held, err := suite.repo.AcquireSinkLease("file", "b", now, now.Add(time.Minute))
+	suite.Require().NoError(err)
+	assert.Nil(suite.T(), held)
+
+	suite.Require().NoError(suite.repo.RecordSinkFailure("file", fmt.Errorf("unreachable"), now))
+	advanced, err := suite.repo.AdvanceSinkCheckpoint("file", "b", records[0].ID, 1, now)
+	suite.Require().NoError(err)
+	assert.False(suite.T(), advanced)
+	advanced, err = suite.repo.AdvanceSinkCheckpoint("file", "a", records[0].ID, 1, now)
+	suite.Require().NoError(err)
+	assert.True(suite.T(), advanced)
+
+	pending, oldest, err := suite.repo.OutboxLag(records[0].ID, all)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(1), pending)
+	suite.Require().NotNil(oldest)
+
+	taken, err := suite.repo.AcquireSinkLease("file", "b", now.Add(2*time.Minute), now.Add(3*time.Minute))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(taken)
+	assert.Equal(suite.T(), records[0].ID, taken.Position)
+	assert.Equal(suite.T(), int64(1), taken.Shipped)
+	assert.Zero(suite.T(), taken.ConsecutiveFailures)
+
+	// Records the file sink shipped go, the rest wait for it
+	deleted, err := suite.repo.PruneOutbox(map[string][]string{"file": all}, now.Add(-time.Hour))
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(1), deleted)
+
+	// Past the horizon records go whether shipped or not
+	deleted, err = suite.repo.PruneOutbox(map[string][]string{"file": all}, now.Add(time.Hour))
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(1), deleted)
+
+	checkpoints, err := suite.repo.ListSinkCheckpoints()
+	suite.Require().NoError(err)
+	suite.Require().Len(checkpoints, 1)
+	assert.Equal(suite.T(), int64(1), checkpoints[0].Dropped)
+
+	// Nothing is kept for kinds no sink accepts
+	suite.Require().NoError(suite.repo.RecordMetric(&AnalyticsMetric{ClubID: "1", MetricName: "visitors", MetricValue: 1}))
+	deleted, err = suite.repo.PruneOutbox(map[string][]string{"file": {"event"}}, now.Add(-time.Hour))
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(1), deleted)
+}
+
+func (suite *RepositoryTestSuite) TestPrivacySettings() {
+	_, err := suite.repo.GetPrivacySettings("1")
+	assert.ErrorIs(suite.T(), err, ErrNotFound)
+	assert.ErrorIs(suite.T(), suite.repo.SavePrivacyPolicy("1", nil), ErrNotFound)
+
+	settings, err := suite.repo.EnsurePrivacySettings(&PrivacySettings{ClubID: "1", Salt: "first"})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "first", settings.Salt)
+	assert.Nil(suite.T(), settings.Policy)
+
+	// A club's salt never changes once created
+	settings, err = suite.repo.EnsurePrivacySettings(&PrivacySettings{ClubID: "1", Salt: "second"})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "first", settings.Salt)
+
+	policy := &privacy.Policy{Rules: []privacy.Rule{{Field: "notes", Action: privacy.ActionKeep}}}
+	suite.Require().NoError(suite.repo.SavePrivacyPolicy("1", policy))
+	settings, err = suite.repo.GetPrivacySettings("1")
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), policy, settings.Policy)
+	assert.Equal(suite.T(), "first", settings.Salt)
+
+	suite.Require().NoError(suite.repo.SavePrivacyPolicy("1", nil))
+	settings, err = suite.repo.GetPrivacySettings("1")
+	suite.Require().NoError(err)
+	assert.Nil(suite.T(), settings.Policy)
+}
+
+func (suite *RepositoryTestSuite) TestEraseSubject() {
+	now := time.Now()
+	erased := &AnalyticsEvent{ClubID: "1", EventType: "visit", SubjectID: "abc", Timestamp: now}
+	kept := &AnalyticsEvent{ClubID: "1", EventType: "visit", SubjectID: "def", Timestamp: now}
+	otherClub := &AnalyticsEvent{ClubID: "2", EventType: "visit", SubjectID: "abc", Timestamp: now}
+	for _, event := range []*AnalyticsEvent{erased, kept, otherClub} {
+		suite.Require().NoError(suite.repo.RecordEvent(event))
+	}
+	suite.Require().NoError(suite.db.Create(&VisitFact{VisitID: 1, MemberID: 42, HomeClubID: 1, VisitingClubID: 2}).Error)
+	suite.Require().NoError(suite.db.Create(&VisitFact{VisitID: 2, MemberID: 43, HomeClubID: 1, VisitingClubID: 2}).Error)
+	suite.Require().NoError(suite.db.Create(&MemberFact{MemberID: 42, ClubID: 1}).Error)
+	suite.Require().NoError(suite.db.Create(&MemberStatusChange{MemberID: 42, ClubID: 1, Status: "ACTIVE", ChangedAt: now}).Error)
+	suite.Require().NoError(suite.db.Create(&VoteFact{VoteID: 1, ProposalID: 1, MemberID: 42, ClubID: 1}).Error)
+
+	request := &ErasureRequest{ClubID: "1", SubjectID: "abc", Status: ErasureStatusPending}
+	suite.Require().NoError(suite.repo.EraseSubject(request, 42))
+	assert.NotZero(suite.T(), request.ID)
+	assert.Equal(suite.T(), int64(1), request.EventsDeleted)
+	assert.Equal(suite.T(), int64(1), request.OutboxDeleted)
+	assert.Equal(suite.T(), int64(4), request.FactsDeleted)
+	assert.Equal(suite.T(), []string{"event-" + fmt.Sprint(erased.ID)}, request.SinkKeys)
+
+	var events []uint
+	suite.Require().NoError(suite.db.Model(&AnalyticsEvent{}).Order("id").Pluck("id", &events).Error)
+	assert.Equal(suite.T(), []uint{kept.ID, otherClub.ID}, events)
+	var visits int64
+	suite.Require().NoError(suite.db.Model(&VisitFact{}).Count(&visits).Error)
+	assert.Equal(suite.T(), int64(1), visits)
+
+	pending, err := suite.repo.PendingErasureRequests(now.Add(time.Minute), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(pending, 1)
+	assert.Equal(suite.T(), request.SinkKeys, pending[0].SinkKeys)
+
+	completed := now
+	request.Status = ErasureStatusCompleted
+	request.CompletedAt = &completed
+	suite.Require().NoError(suite.repo.UpdateErasureRequest(request))
+	pending, err = suite.repo.PendingErasureRequests(now.Add(time.Minute), 10)
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), pending)
+
+	requests, err := suite.repo.ListErasureRequests("1", 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(requests, 1)
+	assert.Equal(suite.T(), ErasureStatusCompleted, requests[0].Status)
+
+	_, err = suite.repo.GetErasureRequest(request.ID + 1)
+	assert.ErrorIs(suite.T(), err, ErrNotFound)
+}
+
 func (suite *RepositoryTestSuite) TestExportOperations() {
 	clubID := "test-club-1"
 	now := time.Now()
@@ -494,7 +1385,7 @@ func TestRepositoryTestSuite(t *testing.T) {
 // Benchmark tests
 func BenchmarkRecordEvent(b *testing.B) {
 	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
-	db.AutoMigrate(&AnalyticsEvent{})
+	db.AutoMigrate(&AnalyticsEvent{}, &OutboxRecord{})
 
 	loggingConfig := &config.LoggingConfig{Level: "error", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-bench")
@@ -516,7 +1407,7 @@ func BenchmarkRecordEvent(b *testing.B) {
 
 func BenchmarkRecordMetric(b *testing.B) {
 	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
-	db.AutoMigrate(&AnalyticsMetric{})
+	db.AutoMigrate(&AnalyticsMetric{}, &OutboxRecord{})
 
 	loggingConfig := &config.LoggingConfig{Level: "error", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-bench")
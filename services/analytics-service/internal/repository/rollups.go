@@ -0,0 +1,584 @@
+package repository
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/rollup"
+)
+
+// Rollup sources: metric values, and events counted by type
+const (
+	RollupSourceMetric = "metric"
+	RollupSourceEvent  = "event"
+
+	rollupBatchSize = 5000
+
+	// Raw rows are rolled up once they are this old, so rows whose insert is
+	// still committing when the roller reads are not skipped
+	rollupSettleDelay = 5 * time.Second
+
+	maxRollupBuckets = 10000
+)
+
+// errRollupRace means another replica advanced the watermark first
+var errRollupRace = errors.New("rollup watermark moved")
+
+// MetricRollup aggregates the values of one metric and tag set, or the
+// occurrences of one event type, in a bucket of a stored resolution
+type MetricRollup struct {
+	ID          uint              `json:"id" gorm:"primaryKey"`
+	ClubID      string            `json:"club_id" gorm:"uniqueIndex:idx_metric_rollup_bucket;size:255"`
+	Source      string            `json:"source" gorm:"uniqueIndex:idx_metric_rollup_bucket;size:10"`
+	Name        string            `json:"name" gorm:"uniqueIndex:idx_metric_rollup_bucket;size:100"`
+	Resolution  rollup.Resolution `json:"resolution" gorm:"uniqueIndex:idx_metric_rollup_bucket;index:idx_metric_rollup_retention;size:10"`
+	BucketStart time.Time         `json:"bucket_start" gorm:"uniqueIndex:idx_metric_rollup_bucket;index:idx_metric_rollup_retention"`
+	TagKey      string            `json:"-" gorm:"uniqueIndex:idx_metric_rollup_bucket;size:40"`
+	Tags        map[string]string `json:"tags" gorm:"serializer:json"`
+	Count       int64             `json:"count" gorm:"column:value_count"`
+	Sum         float64           `json:"sum" gorm:"column:value_sum"`
+	Min         float64           `json:"min" gorm:"column:value_min"`
+	Max         float64           `json:"max" gorm:"column:value_max"`
+	P50         float64           `json:"p50" gorm:"column:value_p50"`
+	P95         float64           `json:"p95" gorm:"column:value_p95"`
+	Sketch      *rollup.Sketch    `json:"-" gorm:"serializer:json"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+func (MetricRollup) TableName() string {
+	return "analytics_metric_rollups"
+}
+
+func (m *MetricRollup) aggregate() *rollup.Aggregate {
+	return &rollup.Aggregate{Count: m.Count, Sum: m.Sum, Min: m.Min, Max: m.Max, Sketch: m.Sketch}
+}
+
+func (m *MetricRollup) setAggregate(aggregate *rollup.Aggregate) {
+	m.Count = aggregate.Count
+	m.Sum = aggregate.Sum
+	m.Min = aggregate.Min
+	m.Max = aggregate.Max
+	m.P50 = aggregate.Quantile(0.5)
+	m.P95 = aggregate.Quantile(0.95)
+	m.Sketch = aggregate.Sketch
+}
+
+// RollupWatermark is the ID of the last raw row of a source that has been
+// rolled up
+type RollupWatermark struct {
+	Source    string    `json:"source" gorm:"primaryKey;size:10"`
+	LastID    uint      `json:"last_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (RollupWatermark) TableName() string {
+	return "analytics_rollup_watermarks"
+}
+
+// RetentionPolicy sets how many days raw rows and each rollup resolution are
+// kept. Zero keeps data forever.
+type RetentionPolicy struct {
+	ID         uint      `json:"-" gorm:"primaryKey"`
+	RawDays    int       `json:"raw_days"`
+	MinuteDays int       `json:"minute_days"`
+	HourDays   int       `json:"hour_days"`
+	DayDays    int       `json:"day_days"`
+	MonthDays  int       `json:"month_days"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (RetentionPolicy) TableName() string {
+	return "analytics_retention_policies"
+}
+
+// DefaultRetentionPolicy keeps raw rows for 30 days, minutes for a week, hours
+// for two years, days for five years and months forever
+func DefaultRetentionPolicy() *RetentionPolicy {
+	return &RetentionPolicy{RawDays: 30, MinuteDays: 7, HourDays: 730, DayDays: 1825}
+}
+
+// Validate checks every horizon is zero or positive
+func (p *RetentionPolicy) Validate() error {
+	for _, days := range []int{p.RawDays, p.MinuteDays, p.HourDays, p.DayDays, p.MonthDays} {
+		if days < 0 {
+			return fmt.Errorf("retention days must not be negative")
+		}
+	}
+	return nil
+}
+
+// Days returns the horizon of a rollup resolution
+func (p *RetentionPolicy) Days(resolution rollup.Resolution) int {
+	switch resolution {
+	case rollup.Minute:
+		return p.MinuteDays
+	case rollup.Hour:
+		return p.HourDays
+	case rollup.Day:
+		return p.DayDays
+	default:
+		return p.MonthDays
+	}
+}
+
+// RollupQuery asks for a metric, or an event type's count, in buckets of any
+// whole number of minutes, hours, days or months
+type RollupQuery struct {
+	ClubID  string            `json:"club_id"`
+	Source  string            `json:"source"`
+	Name    string            `json:"name"`
+	Start   time.Time         `json:"start"`
+	End     time.Time         `json:"end"`
+	Bucket  string            `json:"bucket"`
+	GroupBy []string          `json:"group_by"`
+	Tags    map[string]string `json:"tags"`
+	Fill    string            `json:"fill"`
+}
+
+// Validate checks the query and fills in the default source and fill
+func (q *RollupQuery) Validate() error {
+	if q.ClubID == "" || q.Name == "" {
+		return fmt.Errorf("club_id and name are required")
+	}
+	if q.Source == "" {
+		q.Source = RollupSourceMetric
+	}
+	if q.Source != RollupSourceMetric && q.Source != RollupSourceEvent {
+		return fmt.Errorf("source must be %s or %s", RollupSourceMetric, RollupSourceEvent)
+	}
+	if !q.End.After(q.Start) {
+		return fmt.Errorf("end must be after start")
+	}
+
+	bucket, err := rollup.ParseBucket(q.Bucket)
+	if err != nil {
+		return err
+	}
+	if count := bucket.Count(q.Start, q.End); count > maxRollupBuckets {
+		return fmt.Errorf("query spans %d buckets, more than the limit of %d", count, maxRollupBuckets)
+	}
+
+	fill, err := rollup.ParseFill(q.Fill)
+	if err != nil {
+		return err
+	}
+	q.Fill = string(fill)
+	return nil
+}
+
+// RollupResult is a query answered from rollups, one series per group
+type RollupResult struct {
+	Bucket     string            `json:"bucket"`
+	Resolution rollup.Resolution `json:"resolution"`
+	Fill       string            `json:"fill"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	// CoveredFrom is set when retention has removed the start of the range
+	CoveredFrom *time.Time     `json:"covered_from,omitempty"`
+	Series      []RollupSeries `json:"series"`
+}
+
+// RollupSeries is one group of a query result
+type RollupSeries struct {
+	Tags   map[string]string `json:"tags"`
+	Points []RollupPoint     `json:"points"`
+}
+
+// RollupPoint is one bucket of a series. Values are null for buckets returned
+// empty by the null fill.
+type RollupPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Count     *int64    `json:"count"`
+	Sum       *float64  `json:"sum"`
+	Min       *float64  `json:"min"`
+	Max       *float64  `json:"max"`
+	Avg       *float64  `json:"avg"`
+	P50       *float64  `json:"p50"`
+	P95       *float64  `json:"p95"`
+}
+
+type rollupKey struct {
+	clubID     string
+	name       string
+	resolution rollup.Resolution
+	start      time.Time
+	tagKey     string
+}
+
+type rollupPartial struct {
+	tags      map[string]string
+	aggregate *rollup.Aggregate
+}
+
+// rawValue is a raw row reduced to what rollups need
+type rawValue struct {
+	id        uint
+	clubID    string
+	name      string
+	value     float64
+	tags      map[string]interface{}
+	timestamp time.Time
+}
+
+// RollUp folds raw rows written since the last run into every rollup
+// resolution and returns how many rows it read. Each batch advances the
+// source's watermark in the same transaction, so replicas rolling up at the
+// same time never count a row twice.
+func (r *repository) RollUp(now time.Time) (int, error) {
+	settled := now.Add(-rollupSettleDelay)
+
+	total := 0
+	for _, source := range []string{RollupSourceMetric, RollupSourceEvent} {
+		for {
+			count, err := r.rollUpBatch(source, settled)
+			total += count
+			if err != nil {
+				r.logger.Error("Failed to roll up raw rows", map[string]interface{}{"error": err.Error(), "source": source})
+				return total, fmt.Errorf("failed to roll up %s rows: %w", source, err)
+			}
+			if count < rollupBatchSize {
+				break
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func (r *repository) rollUpBatch(source string, settled time.Time) (int, error) {
+	var mark RollupWatermark
+	found := r.db.Where("source = ?", source).Limit(1).Find(&mark)
+	if found.Error != nil {
+		return 0, found.Error
+	}
+
+	rows, err := r.rawValues(source, mark.LastID, settled)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+
+	partials := make(map[rollupKey]*rollupPartial)
+	for _, row := range rows {
+		tags, tagKey := rollupTags(row.tags)
+		for _, resolution := range rollup.Resolutions {
+			key := rollupKey{clubID: row.clubID, name: row.name, resolution: resolution, start: resolution.Truncate(row.timestamp), tagKey: tagKey}
+			partial, ok := partials[key]
+			if !ok {
+				partial = &rollupPartial{tags: tags, aggregate: rollup.NewAggregate()}
+				partials[key] = partial
+			}
+			partial.aggregate.Add(row.value)
+		}
+	}
+
+	lastID := rows[len(rows)-1].id
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if found.RowsAffected == 0 {
+			created := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&RollupWatermark{Source: source, LastID: lastID})
+			if created.Error != nil {
+				return created.Error
+			}
+			if created.RowsAffected == 0 {
+				return errRollupRace
+			}
+		} else {
+			moved := tx.Model(&RollupWatermark{}).Where("source = ? AND last_id = ?", source, mark.LastID).Update("last_id", lastID)
+			if moved.Error != nil {
+				return moved.Error
+			}
+			if moved.RowsAffected == 0 {
+				return errRollupRace
+			}
+		}
+
+		for key, partial := range partials {
+			if err := mergeRollup(tx, source, key, partial); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errRollupRace) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return len(rows), nil
+}
+
+// rawValues reads the next batch of settled raw rows of a source. Events count
+// as a value of one under their event type.
+func (r *repository) rawValues(source string, afterID uint, settled time.Time) ([]rawValue, error) {
+	query := r.db.Where("id > ? AND created_at <= ?", afterID, settled).Order("id").Limit(rollupBatchSize)
+
+	if source == RollupSourceEvent {
+		var events []*AnalyticsEvent
+		if err := query.Select("id", "club_id", "event_type", "timestamp").Find(&events).Error; err != nil {
+			return nil, err
+		}
+		rows := make([]rawValue, len(events))
+		for i, event := range events {
+			rows[i] = rawValue{id: event.ID, clubID: event.ClubID, name: event.EventType, value: 1, timestamp: event.Timestamp}
+		}
+		return rows, nil
+	}
+
+	var metrics []*AnalyticsMetric
+	if err := query.Select("id", "club_id", "metric_name", "metric_value", "tags", "timestamp").Find(&metrics).Error; err != nil {
+		return nil, err
+	}
+	rows := make([]rawValue, len(metrics))
+	for i, metric := range metrics {
+		rows[i] = rawValue{id: metric.ID, clubID: metric.ClubID, name: metric.MetricName, value: metric.MetricValue, tags: metric.Tags, timestamp: metric.Timestamp}
+	}
+	return rows, nil
+}
+
+func mergeRollup(tx *gorm.DB, source string, key rollupKey, partial *rollupPartial) error {
+	var row MetricRollup
+	err := tx.Where("club_id = ? AND source = ? AND name = ? AND resolution = ? AND bucket_start = ? AND tag_key = ?",
+		key.clubID, source, key.name, key.resolution, key.start, key.tagKey).Limit(1).Find(&row).Error
+	if err != nil {
+		return err
+	}
+	if row.ID == 0 {
+		row = MetricRollup{
+			ClubID:      key.clubID,
+			Source:      source,
+			Name:        key.name,
+			Resolution:  key.resolution,
+			BucketStart: key.start,
+			TagKey:      key.tagKey,
+			Tags:        partial.tags,
+		}
+	}
+
+	aggregate := row.aggregate()
+	aggregate.Merge(partial.aggregate)
+	row.setAggregate(aggregate)
+	return tx.Save(&row).Error
+}
+
+// rollupTags stringifies a tag set and derives the key identifying it
+func rollupTags(tags map[string]interface{}) (map[string]string, string) {
+	if len(tags) == 0 {
+		return nil, ""
+	}
+
+	values := make(map[string]string, len(tags))
+	pairs := make([]string, 0, len(tags))
+	for name, value := range tags {
+		values[name] = fmt.Sprint(value)
+		pairs = append(pairs, name+"="+values[name])
+	}
+	sort.Strings(pairs)
+
+	sum := sha1.Sum([]byte(strings.Join(pairs, "\n")))
+	return values, hex.EncodeToString(sum[:])
+}
+
+// QueryRollups answers a validated query from the coarsest stored resolution
+// that divides its bucket
+func (r *repository) QueryRollups(query *RollupQuery) (*RollupResult, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	bucket, _ := rollup.ParseBucket(query.Bucket)
+	fill, _ := rollup.ParseFill(query.Fill)
+
+	start := bucket.Align(query.Start)
+	result := &RollupResult{
+		Bucket:     bucket.String(),
+		Resolution: bucket.Unit,
+		Fill:       query.Fill,
+		Start:      start,
+		End:        query.End,
+		Series:     []RollupSeries{},
+	}
+
+	var rows []*MetricRollup
+	err := r.db.Where("club_id = ? AND source = ? AND name = ? AND resolution = ? AND bucket_start >= ? AND bucket_start < ?",
+		query.ClubID, query.Source, query.Name, bucket.Unit, start, query.End).Find(&rows).Error
+	if err != nil {
+		r.logger.Error("Failed to query rollups", map[string]interface{}{"error": err.Error(), "club_id": query.ClubID, "name": query.Name})
+		return nil, fmt.Errorf("failed to query rollups: %w", err)
+	}
+
+	type group struct {
+		tags    map[string]string
+		buckets map[time.Time]*rollup.Aggregate
+	}
+	groups := make(map[string]*group)
+	var order []string
+	for _, row := range rows {
+		if !tagsMatch(row.Tags, query.Tags) {
+			continue
+		}
+
+		tags := make(map[string]string, len(query.GroupBy))
+		parts := make([]string, len(query.GroupBy))
+		for i, name := range query.GroupBy {
+			tags[name] = row.Tags[name]
+			parts[i] = row.Tags[name]
+		}
+		groupKey := strings.Join(parts, "\n")
+
+		g, ok := groups[groupKey]
+		if !ok {
+			g = &group{tags: tags, buckets: make(map[time.Time]*rollup.Aggregate)}
+			groups[groupKey] = g
+			order = append(order, groupKey)
+		}
+
+		at := bucket.Align(row.BucketStart)
+		aggregate, ok := g.buckets[at]
+		if !ok {
+			aggregate = rollup.NewAggregate()
+			g.buckets[at] = aggregate
+		}
+		aggregate.Merge(row.aggregate())
+	}
+
+	sort.Strings(order)
+	for _, groupKey := range order {
+		g := groups[groupKey]
+		series := RollupSeries{Tags: g.tags, Points: []RollupPoint{}}
+		for _, point := range rollup.FillSeries(g.buckets, bucket, start, query.End, fill) {
+			series.Points = append(series.Points, rollupPoint(point))
+		}
+		result.Series = append(result.Series, series)
+	}
+
+	policy, err := r.GetRetentionPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if days := policy.Days(bucket.Unit); days > 0 {
+		horizon := bucket.Align(time.Now().AddDate(0, 0, -days))
+		if horizon.After(start) {
+			result.CoveredFrom = &horizon
+		}
+	}
+
+	return result, nil
+}
+
+func tagsMatch(tags map[string]string, filter map[string]string) bool {
+	for name, value := range filter {
+		if tags[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func rollupPoint(point rollup.Point) RollupPoint {
+	p := RollupPoint{Timestamp: point.Start}
+	if point.Aggregate == nil {
+		return p
+	}
+
+	a := point.Aggregate
+	values := []float64{a.Sum, a.Min, a.Max, a.Mean(), a.Quantile(0.5), a.Quantile(0.95)}
+	for i := range values {
+		// Buckets filled with zero have no values to summarise
+		if math.IsNaN(values[i]) {
+			values[i] = 0
+		}
+	}
+
+	count := a.Count
+	p.Count = &count
+	p.Sum, p.Min, p.Max, p.Avg, p.P50, p.P95 = &values[0], &values[1], &values[2], &values[3], &values[4], &values[5]
+	return p
+}
+
+// GetRetentionPolicy returns the stored policy, or the default when none has
+// been saved
+func (r *repository) GetRetentionPolicy() (*RetentionPolicy, error) {
+	var policy RetentionPolicy
+	result := r.db.Limit(1).Find(&policy)
+	if result.Error != nil {
+		r.logger.Error("Failed to get retention policy", map[string]interface{}{"error": result.Error.Error()})
+		return nil, fmt.Errorf("failed to get retention policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return DefaultRetentionPolicy(), nil
+	}
+	return &policy, nil
+}
+
+// SaveRetentionPolicy validates and stores the policy; there is only ever one
+func (r *repository) SaveRetentionPolicy(policy *RetentionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	policy.ID = 1
+	if err := r.db.Save(policy).Error; err != nil {
+		r.logger.Error("Failed to save retention policy", map[string]interface{}{"error": err.Error()})
+		return fmt.Errorf("failed to save retention policy: %w", err)
+	}
+	return nil
+}
+
+// ApplyRetention deletes raw rows and rollups past their horizon and returns
+// how many rows it deleted from each tier. Raw rows are only deleted once they
+// have been rolled up.
+func (r *repository) ApplyRetention(policy *RetentionPolicy, now time.Time) (map[string]int64, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	deleted := make(map[string]int64)
+	if policy.RawDays > 0 {
+		cutoff := now.AddDate(0, 0, -policy.RawDays)
+
+		for source, model := range map[string]interface{}{RollupSourceMetric: &AnalyticsMetric{}, RollupSourceEvent: &AnalyticsEvent{}} {
+			var mark RollupWatermark
+			if err := r.db.Where("source = ?", source).Limit(1).Find(&mark).Error; err != nil {
+				return deleted, fmt.Errorf("failed to apply retention: %w", err)
+			}
+
+			result := r.db.Where("timestamp < ? AND id <= ?", cutoff, mark.LastID).Delete(model)
+			if result.Error != nil {
+				r.logger.Error("Failed to delete raw rows", map[string]interface{}{"error": result.Error.Error(), "source": source})
+				return deleted, fmt.Errorf("failed to apply retention: %w", result.Error)
+			}
+			deleted["raw"] += result.RowsAffected
+		}
+
+		result := r.db.Where("ingested_at < ?", cutoff).Delete(&IngestedMessage{})
+		if result.Error != nil {
+			return deleted, fmt.Errorf("failed to apply retention: %w", result.Error)
+		}
+	}
+
+	for _, resolution := range rollup.Resolutions {
+		days := policy.Days(resolution)
+		if days == 0 {
+			continue
+		}
+
+		result := r.db.Where("resolution = ? AND bucket_start < ?", resolution, resolution.Truncate(now.AddDate(0, 0, -days))).Delete(&MetricRollup{})
+		if result.Error != nil {
+			r.logger.Error("Failed to delete rollups", map[string]interface{}{"error": result.Error.Error(), "resolution": resolution})
+			return deleted, fmt.Errorf("failed to apply retention: %w", result.Error)
+		}
+		deleted[string(resolution)] = result.RowsAffected
+	}
+
+	r.logger.Info("Applied retention policy", map[string]interface{}{"deleted": deleted})
+	return deleted, nil
+}
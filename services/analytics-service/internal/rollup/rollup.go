@@ -0,0 +1,308 @@
+// Package rollup pre-aggregates metric values into minute, hour, day and month
+// buckets and answers time-bucketed queries from them.
+package rollup
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Aggregate summarises the values in a bucket. Aggregates merge exactly, so a
+// bucket can be built from the finer buckets it covers.
+type Aggregate struct {
+	Count  int64   `json:"count"`
+	Sum    float64 `json:"sum"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Sketch *Sketch `json:"sketch"`
+}
+
+// NewAggregate returns an empty aggregate
+func NewAggregate() *Aggregate {
+	return &Aggregate{Sketch: NewSketch()}
+}
+
+// Add counts a value. NaN and infinite values are ignored.
+func (a *Aggregate) Add(value float64) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	if a.Count == 0 || value < a.Min {
+		a.Min = value
+	}
+	if a.Count == 0 || value > a.Max {
+		a.Max = value
+	}
+	a.Count++
+	a.Sum += value
+	a.sketch().Add(value)
+}
+
+// Merge adds every value counted by another aggregate
+func (a *Aggregate) Merge(other *Aggregate) {
+	if other == nil || other.Count == 0 {
+		return
+	}
+	if a.Count == 0 || other.Min < a.Min {
+		a.Min = other.Min
+	}
+	if a.Count == 0 || other.Max > a.Max {
+		a.Max = other.Max
+	}
+	a.Count += other.Count
+	a.Sum += other.Sum
+	a.sketch().Merge(other.Sketch)
+}
+
+// Mean returns the average value, or NaN when the aggregate is empty
+func (a *Aggregate) Mean() float64 {
+	if a.Count == 0 {
+		return math.NaN()
+	}
+	return a.Sum / float64(a.Count)
+}
+
+// Quantile estimates the q-quantile, kept within the observed range
+func (a *Aggregate) Quantile(q float64) float64 {
+	if a.Count == 0 {
+		return math.NaN()
+	}
+	return math.Max(a.Min, math.Min(a.Max, a.sketch().Quantile(q)))
+}
+
+func (a *Aggregate) sketch() *Sketch {
+	if a.Sketch == nil {
+		a.Sketch = NewSketch()
+	}
+	return a.Sketch
+}
+
+// Resolution is the width of a stored rollup bucket
+type Resolution string
+
+const (
+	Minute Resolution = "minute"
+	Hour   Resolution = "hour"
+	Day    Resolution = "day"
+	Month  Resolution = "month"
+)
+
+// Resolutions lists every stored resolution from finest to coarsest
+var Resolutions = []Resolution{Minute, Hour, Day, Month}
+
+// Truncate returns the start of the UTC bucket holding t
+func (r Resolution) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch r {
+	case Minute:
+		return t.Truncate(time.Minute)
+	case Hour:
+		return t.Truncate(time.Hour)
+	case Day:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Add moves t forward by n buckets
+func (r Resolution) Add(t time.Time, n int) time.Time {
+	switch r {
+	case Minute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case Hour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case Day:
+		return t.AddDate(0, 0, n)
+	default:
+		return t.AddDate(0, n, 0)
+	}
+}
+
+// Valid reports whether r is a stored resolution
+func (r Resolution) Valid() bool {
+	for _, resolution := range Resolutions {
+		if r == resolution {
+			return true
+		}
+	}
+	return false
+}
+
+// Buckets align to multiples of their width counted from this Monday, so
+// weekly buckets start on Mondays
+var bucketEpoch = time.Date(1970, 1, 5, 0, 0, 0, 0, time.UTC)
+
+// Bucket is the width of a query bucket: a whole number of a stored resolution
+type Bucket struct {
+	Size int
+	Unit Resolution
+}
+
+// bucketUnits maps the suffixes ParseBucket accepts to a resolution and a
+// multiplier
+var bucketUnits = map[string]struct {
+	unit   Resolution
+	factor int
+}{
+	"m":  {Minute, 1},
+	"h":  {Hour, 1},
+	"d":  {Day, 1},
+	"w":  {Day, 7},
+	"mo": {Month, 1},
+	"y":  {Month, 12},
+}
+
+// ParseBucket reads a bucket size such as 5m, 1h, 1d, 1w, 1mo or 1y. Sizes are
+// expressed in the coarsest unit that divides them, so 120m is read as 2h and
+// served from hourly rollups.
+func ParseBucket(text string) (Bucket, error) {
+	text = strings.TrimSpace(strings.ToLower(text))
+	split := strings.IndexFunc(text, func(r rune) bool { return r < '0' || r > '9' })
+	if split <= 0 {
+		return Bucket{}, fmt.Errorf("invalid bucket %q", text)
+	}
+
+	size, err := strconv.Atoi(text[:split])
+	unit, ok := bucketUnits[text[split:]]
+	if err != nil || !ok || size <= 0 {
+		return Bucket{}, fmt.Errorf("invalid bucket %q", text)
+	}
+
+	bucket := Bucket{Size: size * unit.factor, Unit: unit.unit}
+	if bucket.Unit == Minute && bucket.Size%60 == 0 {
+		bucket = Bucket{Size: bucket.Size / 60, Unit: Hour}
+	}
+	if bucket.Unit == Hour && bucket.Size%24 == 0 {
+		bucket = Bucket{Size: bucket.Size / 24, Unit: Day}
+	}
+	return bucket, nil
+}
+
+func (b Bucket) String() string {
+	switch b.Unit {
+	case Minute:
+		return fmt.Sprintf("%dm", b.Size)
+	case Hour:
+		return fmt.Sprintf("%dh", b.Size)
+	case Day:
+		return fmt.Sprintf("%dd", b.Size)
+	default:
+		return fmt.Sprintf("%dmo", b.Size)
+	}
+}
+
+// Align returns the start of the bucket holding t
+func (b Bucket) Align(t time.Time) time.Time {
+	t = b.Unit.Truncate(t)
+
+	var elapsed int
+	switch b.Unit {
+	case Minute:
+		elapsed = int(t.Sub(bucketEpoch) / time.Minute)
+	case Hour:
+		elapsed = int(t.Sub(bucketEpoch) / time.Hour)
+	case Day:
+		elapsed = int(t.Sub(bucketEpoch) / (24 * time.Hour))
+	default:
+		elapsed = (t.Year()-1970)*12 + int(t.Month()) - 1
+	}
+
+	offset := elapsed % b.Size
+	if offset < 0 {
+		offset += b.Size
+	}
+	return b.Unit.Add(t, -offset)
+}
+
+// Next returns the start of the bucket after the one starting at t
+func (b Bucket) Next(t time.Time) time.Time {
+	return b.Unit.Add(t, b.Size)
+}
+
+// Count returns the number of buckets overlapping [start, end)
+func (b Bucket) Count(start, end time.Time) int {
+	first := b.Align(start)
+	if !first.Before(end) {
+		return 0
+	}
+
+	var span int
+	switch b.Unit {
+	case Minute:
+		span = int((end.Sub(first) + time.Minute - 1) / time.Minute)
+	case Hour:
+		span = int((end.Sub(first) + time.Hour - 1) / time.Hour)
+	case Day:
+		span = int((end.Sub(first) + 24*time.Hour - 1) / (24 * time.Hour))
+	default:
+		end = end.UTC()
+		span = (end.Year()-first.Year())*12 + int(end.Month()) - int(first.Month())
+		if Month.Truncate(end).Before(end) {
+			span++
+		}
+	}
+	return (span + b.Size - 1) / b.Size
+}
+
+// Fill decides what a query returns for buckets without values
+type Fill string
+
+const (
+	// FillNone leaves empty buckets out
+	FillNone Fill = "none"
+	// FillNull returns empty buckets without values
+	FillNull Fill = "null"
+	// FillZero returns empty buckets with every value at zero
+	FillZero Fill = "zero"
+	// FillPrevious repeats the last bucket with values
+	FillPrevious Fill = "previous"
+)
+
+// ParseFill reads a fill policy, defaulting to FillNone
+func ParseFill(text string) (Fill, error) {
+	switch fill := Fill(strings.ToLower(strings.TrimSpace(text))); fill {
+	case "":
+		return FillNone, nil
+	case FillNone, FillNull, FillZero, FillPrevious:
+		return fill, nil
+	default:
+		return "", fmt.Errorf("invalid fill %q", text)
+	}
+}
+
+// Point is one bucket of a filled series. Aggregate is nil for empty buckets
+// returned by FillNull, and for FillPrevious buckets before the first value.
+type Point struct {
+	Start     time.Time
+	Aggregate *Aggregate
+}
+
+// FillSeries orders the buckets of [start, end) and applies the fill policy to
+// the ones missing from aggregates, which is keyed by aligned bucket start
+func FillSeries(aggregates map[time.Time]*Aggregate, bucket Bucket, start, end time.Time, fill Fill) []Point {
+	var (
+		points   []Point
+		previous *Aggregate
+	)
+	for t := bucket.Align(start); t.Before(end); t = bucket.Next(t) {
+		if aggregate, ok := aggregates[t]; ok {
+			points = append(points, Point{Start: t, Aggregate: aggregate})
+			previous = aggregate
+			continue
+		}
+
+		switch fill {
+		case FillNull:
+			points = append(points, Point{Start: t})
+		case FillZero:
+			points = append(points, Point{Start: t, Aggregate: &Aggregate{}})
+		case FillPrevious:
+			points = append(points, Point{Start: t, Aggregate: previous})
+		}
+	}
+	return points
+}
@@ -0,0 +1,129 @@
+package rollup
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketch_QuantilesWithinAccuracy(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	values := make([]float64, 5000)
+	sketch := NewSketch()
+	for i := range values {
+		values[i] = math.Exp(rng.NormFloat64()*2) - 0.5
+		sketch.Add(values[i])
+	}
+	sort.Float64s(values)
+
+	for _, q := range []float64{0, 0.5, 0.95, 1} {
+		exact := values[int(q*float64(len(values)-1))]
+		assert.InDelta(t, exact, sketch.Quantile(q), math.Abs(exact)*sketchAccuracy+1e-9, "q=%v", q)
+	}
+	assert.Equal(t, uint64(len(values)), sketch.Count())
+}
+
+func TestAggregate_MergeMatchesSinglePass(t *testing.T) {
+	whole, left, right := NewAggregate(), NewAggregate(), NewAggregate()
+	for i := 1; i <= 200; i++ {
+		value := float64(i % 37)
+		whole.Add(value)
+		if i%2 == 0 {
+			left.Add(value)
+		} else {
+			right.Add(value)
+		}
+	}
+
+	// Merging must survive a round trip through storage
+	encoded, err := json.Marshal(right)
+	require.NoError(t, err)
+	var decoded Aggregate
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	left.Merge(&decoded)
+
+	assert.Equal(t, whole.Count, left.Count)
+	assert.Equal(t, whole.Sum, left.Sum)
+	assert.Equal(t, whole.Min, left.Min)
+	assert.Equal(t, whole.Max, left.Max)
+	assert.Equal(t, whole.Quantile(0.5), left.Quantile(0.5))
+	assert.Equal(t, whole.Quantile(0.95), left.Quantile(0.95))
+	assert.True(t, math.IsNaN(NewAggregate().Quantile(0.5)))
+}
+
+func TestParseBucket(t *testing.T) {
+	tests := []struct {
+		text string
+		want Bucket
+	}{
+		{"5m", Bucket{5, Minute}},
+		{"120m", Bucket{2, Hour}},
+		{"48h", Bucket{2, Day}},
+		{"1w", Bucket{7, Day}},
+		{"3mo", Bucket{3, Month}},
+		{"1Y", Bucket{12, Month}},
+	}
+	for _, tt := range tests {
+		got, err := ParseBucket(tt.text)
+		require.NoError(t, err, tt.text)
+		assert.Equal(t, tt.want, got, tt.text)
+	}
+
+	for _, text := range []string{"", "m", "0h", "-1d", "1s", "1.5h"} {
+		_, err := ParseBucket(text)
+		assert.Error(t, err, text)
+	}
+}
+
+func TestBucket_AlignAndCount(t *testing.T) {
+	at := time.Date(2025, 6, 4, 13, 47, 12, 0, time.UTC) // a Wednesday
+
+	assert.Equal(t, time.Date(2025, 6, 4, 13, 45, 0, 0, time.UTC), Bucket{15, Minute}.Align(at))
+	assert.Equal(t, time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC), Bucket{6, Hour}.Align(at))
+	assert.Equal(t, time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), Bucket{7, Day}.Align(at))
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), Bucket{3, Month}.Align(at))
+
+	start := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	for _, bucket := range []Bucket{{15, Minute}, {6, Hour}, {7, Day}, {1, Month}, {3, Month}} {
+		end := bucket.Unit.Add(start, 1000)
+		count := 0
+		for t := bucket.Align(start); t.Before(end); t = bucket.Next(t) {
+			count++
+		}
+		assert.Equal(t, count, bucket.Count(start, end), bucket.String())
+	}
+	assert.Equal(t, 0, Bucket{1, Hour}.Count(start, start))
+}
+
+func TestFillSeries(t *testing.T) {
+	bucket := Bucket{1, Hour}
+	start := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+	filled := &Aggregate{Count: 2, Sum: 10, Min: 4, Max: 6}
+	aggregates := map[time.Time]*Aggregate{start.Add(time.Hour): filled}
+	end := start.Add(3 * time.Hour)
+
+	assert.Len(t, FillSeries(aggregates, bucket, start, end, FillNone), 1)
+
+	nulls := FillSeries(aggregates, bucket, start, end, FillNull)
+	require.Len(t, nulls, 3)
+	assert.Nil(t, nulls[0].Aggregate)
+	assert.Equal(t, start.Add(2*time.Hour), nulls[2].Start)
+
+	zeros := FillSeries(aggregates, bucket, start, end, FillZero)
+	require.Len(t, zeros, 3)
+	assert.Equal(t, int64(0), zeros[0].Aggregate.Count)
+
+	previous := FillSeries(aggregates, bucket, start, end, FillPrevious)
+	require.Len(t, previous, 3)
+	assert.Nil(t, previous[0].Aggregate)
+	assert.Same(t, filled, previous[2].Aggregate)
+
+	_, err := ParseFill("linear")
+	assert.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package rollup
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// sketchAccuracy is the relative error of quantile estimates
+	sketchAccuracy = 0.01
+
+	// Values closer to zero than this are counted as zero
+	minSketchValue = 1e-9
+)
+
+var (
+	sketchGamma    = (1 + sketchAccuracy) / (1 - sketchAccuracy)
+	sketchLogGamma = math.Log(sketchGamma)
+)
+
+// Sketch estimates quantiles by counting values in bins whose width grows
+// with their distance from zero, so every estimate is within sketchAccuracy of
+// a value that was added. Sketches merge exactly, which lets minute rollups be
+// combined into hours, days and months without keeping the raw values.
+type Sketch struct {
+	Positive map[int]uint64 `json:"positive,omitempty"`
+	Negative map[int]uint64 `json:"negative,omitempty"`
+	Zero     uint64         `json:"zero,omitempty"`
+}
+
+// NewSketch returns an empty sketch
+func NewSketch() *Sketch {
+	return &Sketch{Positive: map[int]uint64{}, Negative: map[int]uint64{}}
+}
+
+// Add counts a value. NaN and infinite values are ignored.
+func (s *Sketch) Add(value float64) {
+	switch {
+	case math.IsNaN(value) || math.IsInf(value, 0):
+	case math.Abs(value) < minSketchValue:
+		s.Zero++
+	case value > 0:
+		s.bins(&s.Positive)[sketchIndex(value)]++
+	default:
+		s.bins(&s.Negative)[sketchIndex(-value)]++
+	}
+}
+
+// Merge adds every value counted by another sketch
+func (s *Sketch) Merge(other *Sketch) {
+	if other == nil {
+		return
+	}
+	for index, count := range other.Positive {
+		s.bins(&s.Positive)[index] += count
+	}
+	for index, count := range other.Negative {
+		s.bins(&s.Negative)[index] += count
+	}
+	s.Zero += other.Zero
+}
+
+// Count returns the number of values counted
+func (s *Sketch) Count() uint64 {
+	count := s.Zero
+	for _, c := range s.Positive {
+		count += c
+	}
+	for _, c := range s.Negative {
+		count += c
+	}
+	return count
+}
+
+// Quantile estimates the q-quantile (0 <= q <= 1). It returns NaN for an
+// empty sketch.
+func (s *Sketch) Quantile(q float64) float64 {
+	count := s.Count()
+	if count == 0 {
+		return math.NaN()
+	}
+	q = math.Max(0, math.Min(1, q))
+	rank := uint64(q * float64(count-1))
+
+	// Walk the bins from the most negative value to the most positive
+	var seen uint64
+	negative := sortedIndexes(s.Negative)
+	for i := len(negative) - 1; i >= 0; i-- {
+		seen += s.Negative[negative[i]]
+		if seen > rank {
+			return -sketchValue(negative[i])
+		}
+	}
+	seen += s.Zero
+	if seen > rank {
+		return 0
+	}
+	for _, index := range sortedIndexes(s.Positive) {
+		seen += s.Positive[index]
+		if seen > rank {
+			return sketchValue(index)
+		}
+	}
+	return math.NaN()
+}
+
+// bins returns the map, creating it for sketches decoded without one
+func (s *Sketch) bins(m *map[int]uint64) map[int]uint64 {
+	if *m == nil {
+		*m = map[int]uint64{}
+	}
+	return *m
+}
+
+// sketchIndex is the bin of a positive value: bin i holds (gamma^(i-1), gamma^i]
+func sketchIndex(value float64) int {
+	return int(math.Ceil(math.Log(value) / sketchLogGamma))
+}
+
+// sketchValue is the point of bin i within sketchAccuracy of all its values
+func sketchValue(index int) float64 {
+	return 2 * math.Pow(sketchGamma, float64(index)) / (sketchGamma + 1)
+}
+
+func sortedIndexes(bins map[int]uint64) []int {
+	indexes := make([]int, 0, len(bins))
+	for index := range bins {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/anomaly"
+	"reciprocal-clubs-backend/services/analytics-service/internal/integrations"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+const (
+	SubjectAnomalyDetected  = "analytics.anomaly.detected"
+	SubjectAnomalyEscalated = "analytics.anomaly.escalated"
+	SubjectAnomalyResolved  = "analytics.anomaly.resolved"
+
+	anomalyQueueSize     = 1024
+	anomalySweepInterval = time.Minute
+	// An anomaly belongs to the current episode only if it is in the latest
+	// bucket or the one before, which may have just closed
+	anomalyRecentBuckets = 2
+	maxAnomalyEpisodes   = 100
+)
+
+type anomalyKey struct {
+	clubID     string
+	metricName string
+}
+
+// anomalyMonitor queues metrics for detection as they are recorded. A metric
+// already waiting in the queue is not queued again, so a burst of writes costs
+// one check.
+type anomalyMonitor struct {
+	queue    chan anomalyKey
+	mu       sync.Mutex
+	pending  map[anomalyKey]bool
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newAnomalyMonitor() *anomalyMonitor {
+	return &anomalyMonitor{
+		queue:   make(chan anomalyKey, anomalyQueueSize),
+		pending: make(map[anomalyKey]bool),
+		stop:    make(chan struct{}),
+	}
+}
+
+// anomalyEvent is published on the message bus for every episode transition
+type anomalyEvent struct {
+	Transition string                     `json:"transition"`
+	Episode    *repository.AnomalyEpisode `json:"episode"`
+	OccurredAt time.Time                  `json:"occurred_at"`
+}
+
+func (s *service) GetAnomalyDetection(clubID string, metricName string, timeRange repository.TimeRange, sensitivity float64) (map[string]interface{}, error) {
+	start := time.Now()
+	s.monitoring.RecordBusinessEvent("analytics_anomaly_requests", clubID)
+
+	if clubID == "" || metricName == "" {
+		return nil, fmt.Errorf("club_id and metric_name are required")
+	}
+	if sensitivity < 0 || sensitivity > 1 {
+		return nil, fmt.Errorf("sensitivity must be between 0 and 1")
+	}
+
+	setting, err := s.repo.GetAnomalySetting(clubID, metricName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomaly setting: %w", err)
+	}
+	// A sensitivity on the request overrides the stored one for this call only
+	if sensitivity > 0 {
+		setting.Sensitivity = sensitivity
+	}
+
+	result, err := s.repo.DetectAnomalies(clubID, metricName, timeRange, setting)
+	if err != nil {
+		s.metrics.RecordProcessingError("anomaly_detection", "detection_error")
+		s.logger.Error("Failed to detect anomalies", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		return nil, fmt.Errorf("failed to detect anomalies: %w", err)
+	}
+
+	s.metrics.RecordProcessingDuration("anomaly_detection", "success", time.Since(start))
+	s.logger.Info("Detected anomalies for club", map[string]interface{}{"club_id": clubID, "metric_name": metricName, "count": len(result.Anomalies)})
+	return repository.AnomalyReport(result, setting), nil
+}
+
+func (s *service) GetAnomalySettings(clubID string, metricName string) (*repository.AnomalySetting, error) {
+	if clubID == "" || metricName == "" {
+		return nil, fmt.Errorf("club_id and metric_name are required")
+	}
+	return s.repo.GetAnomalySetting(clubID, metricName)
+}
+
+func (s *service) UpdateAnomalySettings(setting *repository.AnomalySetting) error {
+	if err := setting.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.repo.SaveAnomalySetting(setting); err != nil {
+		s.logger.Error("Failed to save anomaly settings", map[string]interface{}{"error": err.Error(), "club_id": setting.ClubID, "metric_name": setting.MetricName})
+		return fmt.Errorf("failed to save anomaly settings: %w", err)
+	}
+
+	s.logger.Info("Updated anomaly settings", map[string]interface{}{"club_id": setting.ClubID, "metric_name": setting.MetricName})
+	return nil
+}
+
+func (s *service) ListAnomalyEpisodes(clubID string, metricName string, status string, limit int) ([]*repository.AnomalyEpisode, error) {
+	if clubID == "" {
+		return nil, fmt.Errorf("club_id is required")
+	}
+	if status != "" && status != repository.EpisodeOpen && status != repository.EpisodeResolved {
+		return nil, fmt.Errorf("unsupported episode status: %s", status)
+	}
+	if limit <= 0 || limit > maxAnomalyEpisodes {
+		limit = maxAnomalyEpisodes
+	}
+	return s.repo.ListAnomalyEpisodes(clubID, metricName, status, limit)
+}
+
+// scheduleAnomalyCheck queues the metric for continuous detection without
+// blocking the caller. When the queue is full the check is dropped; the next
+// write to the metric queues it again.
+func (s *service) scheduleAnomalyCheck(clubID, metricName string) {
+	key := anomalyKey{clubID: clubID, metricName: metricName}
+
+	s.anomalies.mu.Lock()
+	defer s.anomalies.mu.Unlock()
+	if s.anomalies.pending[key] {
+		return
+	}
+
+	select {
+	case s.anomalies.queue <- key:
+		s.anomalies.pending[key] = true
+	default:
+		s.metrics.RecordAnomalyCheck("dropped")
+	}
+}
+
+// runAnomalyMonitor checks queued metrics one at a time and periodically
+// resolves episodes whose cooldown has passed
+func (s *service) runAnomalyMonitor() {
+	ticker := time.NewTicker(anomalySweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.anomalies.stop:
+			return
+		case key := <-s.anomalies.queue:
+			// Clear the flag first so writes made during the check queue another
+			s.anomalies.mu.Lock()
+			delete(s.anomalies.pending, key)
+			s.anomalies.mu.Unlock()
+
+			if err := s.checkAnomalies(key.clubID, key.metricName, time.Now()); err != nil {
+				s.metrics.RecordAnomalyCheck("error")
+				s.logger.Error("Failed to check metric for anomalies", map[string]interface{}{"error": err.Error(), "club_id": key.clubID, "metric_name": key.metricName})
+				continue
+			}
+			s.metrics.RecordAnomalyCheck("success")
+		case <-ticker.C:
+			if err := s.resolveAnomalyEpisodes(time.Now()); err != nil {
+				s.logger.Error("Failed to resolve anomaly episodes", map[string]interface{}{"error": err.Error()})
+			}
+		}
+	}
+}
+
+// checkAnomalies scores the metric's latest buckets against its recent history
+// and folds the strongest anomaly into the metric's open episode, opening one
+// if there is none
+func (s *service) checkAnomalies(clubID, metricName string, now time.Time) error {
+	setting, err := s.repo.GetAnomalySetting(clubID, metricName)
+	if err != nil {
+		return err
+	}
+	if !setting.Enabled {
+		return nil
+	}
+
+	timeRange := repository.TimeRange{Start: now.Add(-setting.Lookback()), End: now}
+	result, err := s.repo.DetectAnomalies(clubID, metricName, timeRange, setting)
+	if err != nil {
+		return err
+	}
+
+	step := result.Config.Step
+	since := now.Truncate(step).Add(-(anomalyRecentBuckets - 1) * step)
+	var latest *anomaly.Anomaly
+	for i := range result.Anomalies {
+		a := &result.Anomalies[i]
+		if !a.Timestamp.Before(since) && (latest == nil || a.Score > latest.Score) {
+			latest = a
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	s.metrics.RecordAnomalyDetected(metricName, latest.Severity)
+
+	episode, err := s.repo.GetOpenAnomalyEpisode(clubID, metricName)
+	if err != nil {
+		return err
+	}
+
+	if episode == nil {
+		episode = &repository.AnomalyEpisode{
+			ClubID:        clubID,
+			MetricName:    metricName,
+			Status:        repository.EpisodeOpen,
+			Severity:      latest.Severity,
+			Direction:     latest.Direction,
+			Methods:       methodNames(latest.Methods),
+			PeakScore:     latest.Score,
+			PeakValue:     latest.Value,
+			ExpectedValue: latest.Expected,
+			Occurrences:   1,
+			StartedAt:     latest.Timestamp,
+			LastSeenAt:    latest.Timestamp,
+			ExpiresAt:     now.Add(setting.Cooldown()),
+		}
+		if err := s.repo.SaveAnomalyEpisode(episode); err != nil {
+			return err
+		}
+		s.announceAnomalyEpisode(SubjectAnomalyDetected, "detected", episode, now)
+		return nil
+	}
+
+	// Repeated checks of the same bucket extend the episode without counting again
+	if latest.Timestamp.After(episode.LastSeenAt) {
+		episode.Occurrences++
+		episode.LastSeenAt = latest.Timestamp
+	}
+	episode.ExpiresAt = now.Add(setting.Cooldown())
+	episode.Methods = mergeMethods(episode.Methods, latest.Methods)
+	if latest.Score > episode.PeakScore {
+		episode.PeakScore = latest.Score
+		episode.PeakValue = latest.Value
+		episode.ExpectedValue = latest.Expected
+		episode.Direction = latest.Direction
+	}
+
+	escalated := severityRank(latest.Severity) > severityRank(episode.Severity)
+	if escalated {
+		episode.Severity = latest.Severity
+	}
+
+	if err := s.repo.SaveAnomalyEpisode(episode); err != nil {
+		return err
+	}
+	if escalated {
+		s.announceAnomalyEpisode(SubjectAnomalyEscalated, "escalated", episode, now)
+	}
+	return nil
+}
+
+// resolveAnomalyEpisodes closes episodes that have seen no anomaly for their cooldown
+func (s *service) resolveAnomalyEpisodes(now time.Time) error {
+	episodes, err := s.repo.GetExpiredAnomalyEpisodes(now)
+	if err != nil {
+		return err
+	}
+
+	for _, episode := range episodes {
+		resolvedAt := now
+		episode.Status = repository.EpisodeResolved
+		episode.ResolvedAt = &resolvedAt
+		if err := s.repo.SaveAnomalyEpisode(episode); err != nil {
+			s.logger.Error("Failed to resolve anomaly episode", map[string]interface{}{"error": err.Error(), "episode_id": episode.ID})
+			continue
+		}
+		s.announceAnomalyEpisode(SubjectAnomalyResolved, "resolved", episode, now)
+	}
+	return nil
+}
+
+// announceAnomalyEpisode publishes the transition on the message bus and, for
+// new and escalated episodes, raises an external alert. Failures are logged
+// rather than returned so the episode state stays authoritative.
+func (s *service) announceAnomalyEpisode(subject, transition string, episode *repository.AnomalyEpisode, now time.Time) {
+	s.metrics.RecordAnomalyEpisode(episode.MetricName, transition)
+	fields := map[string]interface{}{
+		"episode_id":  episode.ID,
+		"club_id":     episode.ClubID,
+		"metric_name": episode.MetricName,
+		"severity":    episode.Severity,
+		"transition":  transition,
+	}
+
+	data, err := json.Marshal(anomalyEvent{Transition: transition, Episode: episode, OccurredAt: now})
+	if err == nil {
+		err = s.natsClient.Publish(context.Background(), subject, data)
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		s.logger.Error("Failed to publish anomaly event", fields)
+	} else {
+		s.logger.Info("Published anomaly event", fields)
+	}
+
+	if s.integrations == nil || transition == "resolved" {
+		return
+	}
+	alert := &integrations.AnomalyAlert{
+		ClubID:     episode.ClubID,
+		MetricName: episode.MetricName,
+		Severity:   episode.Severity,
+		Direction:  episode.Direction,
+		Message: fmt.Sprintf("%s for club %s reached %.2f against an expected %.2f (%s, score %.1f)",
+			episode.MetricName, episode.ClubID, episode.PeakValue, episode.ExpectedValue, episode.Direction, episode.PeakScore),
+	}
+	if err := s.integrations.CreateAnomalyAlert(context.Background(), alert); err != nil {
+		s.metrics.RecordIntegrationError("datadog", "create_alert", "request_error")
+	}
+}
+
+func severityRank(severity string) int {
+	switch severity {
+	case anomaly.SeverityHigh:
+		return 3
+	case anomaly.SeverityMedium:
+		return 2
+	case anomaly.SeverityLow:
+		return 1
+	}
+	return 0
+}
+
+func methodNames(methods []anomaly.Method) []string {
+	names := make([]string, len(methods))
+	for i, m := range methods {
+		names[i] = string(m)
+	}
+	return names
+}
+
+func mergeMethods(existing []string, methods []anomaly.Method) []string {
+	merged := append([]string(nil), existing...)
+	for _, name := range methodNames(methods) {
+		found := false
+		for _, m := range merged {
+			if m == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, name)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+// GetCohortAnalysis follows a club's members who joined in the time range:
+// retention by join month, churn by membership type, survival curves,
+// reciprocal usage against renewal and members whose engagement is falling.
+// The analysis is as of the end of the range, which is capped at now.
+func (s *service) GetCohortAnalysis(clubID uint, timeRange repository.TimeRange, options cohort.Options) (*cohort.Report, error) {
+	start := time.Now()
+	s.monitoring.RecordBusinessEvent("analytics_cohort_requests", strconv.FormatUint(uint64(clubID), 10))
+
+	if clubID == 0 {
+		return nil, fmt.Errorf("club_id is required")
+	}
+	if timeRange.End.After(start) {
+		timeRange.End = start
+	}
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	report, err := s.repo.CohortAnalysis(clubID, timeRange, options)
+	if err != nil {
+		s.metrics.RecordProcessingError("cohort_analysis", "query_error")
+		s.logger.Error("Failed to get cohort analysis", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get cohort analysis: %w", err)
+	}
+
+	s.metrics.RecordProcessingDuration("cohort_analysis", "success", time.Since(start))
+	return report, nil
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+	"reciprocal-clubs-backend/services/analytics-service/internal/rollup"
+)
+
+const (
+	defaultEmbedTTL = 30 * 24 * time.Hour
+	maxEmbedTTL     = 365 * 24 * time.Hour
+	grafanaTimeout  = 30 * time.Second
+)
+
+var (
+	// ErrEmbedNotConfigured is returned when no embed signing key has been set
+	ErrEmbedNotConfigured = errors.New("dashboard embedding is not configured")
+	// ErrGrafanaNotConfigured is returned when dashboards cannot be pushed to Grafana
+	ErrGrafanaNotConfigured = errors.New("grafana is not configured")
+	// ErrDashboardNotOwned is returned when a club changes a public dashboard
+	// that belongs to another club
+	ErrDashboardNotOwned = errors.New("dashboard belongs to another club")
+)
+
+// DashboardConfig sets how dashboard embed tokens are signed
+type DashboardConfig struct {
+	// EmbedKey signs embed tokens; tokens stop working when it changes
+	EmbedKey []byte
+}
+
+// ConfigureDashboards sets up dashboard embedding
+func (s *service) ConfigureDashboards(config DashboardConfig) {
+	if len(config.EmbedKey) > 0 {
+		s.embeds = dashboard.NewEmbedSigner(config.EmbedKey)
+	}
+}
+
+func (s *service) CreateDashboard(d *repository.Dashboard) error {
+	s.monitoring.RecordBusinessEvent("analytics_dashboard_creations", d.ClubID)
+
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	d.ID = 0
+	d.EmbedVersion = 0
+	d.GrafanaSyncedAt = nil
+
+	if err := s.repo.CreateDashboard(d); err != nil {
+		return err
+	}
+
+	s.metrics.RecordDashboardCreated(d.ClubID, "declarative")
+	return nil
+}
+
+// GetDashboard returns a dashboard the club owns or that is public
+func (s *service) GetDashboard(id uint, clubID string) (*repository.Dashboard, error) {
+	d, err := s.repo.GetDashboard(id)
+	if err != nil {
+		return nil, err
+	}
+	if !d.VisibleTo(clubID) {
+		return nil, repository.ErrNotFound
+	}
+	return d, nil
+}
+
+// ListDashboards returns the club's dashboards and every public dashboard
+func (s *service) ListDashboards(clubID string, limit, offset int) ([]*repository.Dashboard, error) {
+	return s.repo.ListDashboards(clubID, limit, offset)
+}
+
+// UpdateDashboard replaces a dashboard's definition. Dashboards exported to
+// Grafana are exported again so the copy there stays current.
+func (s *service) UpdateDashboard(d *repository.Dashboard) error {
+	existing, err := s.ownedDashboard(d.ID, d.ClubID)
+	if err != nil {
+		return err
+	}
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	existing.Name = d.Name
+	existing.Description = d.Description
+	existing.Panels = d.Panels
+	existing.TimeRange = d.TimeRange
+	existing.RefreshSeconds = d.RefreshSeconds
+	existing.IsPublic = d.IsPublic
+	if err := s.repo.UpdateDashboard(existing); err != nil {
+		return err
+	}
+	*d = *existing
+
+	if existing.GrafanaSyncedAt != nil {
+		if err := s.pushToGrafana(existing); err != nil {
+			s.logger.Warn("Failed to update dashboard in Grafana", map[string]interface{}{"error": err.Error(), "dashboard_id": d.ID})
+		}
+	}
+	return nil
+}
+
+// DeleteDashboard deletes a dashboard and its Grafana copy, if any
+func (s *service) DeleteDashboard(id uint, clubID string) error {
+	d, err := s.ownedDashboard(id, clubID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteDashboard(id); err != nil {
+		return err
+	}
+
+	if d.GrafanaSyncedAt != nil && s.integrations != nil && s.integrations.GrafanaConfigured() {
+		ctx, cancel := context.WithTimeout(context.Background(), grafanaTimeout)
+		defer cancel()
+		if err := s.integrations.DeleteDashboard(ctx, d.GrafanaUID()); err != nil {
+			s.logger.Warn("Failed to delete dashboard from Grafana", map[string]interface{}{"error": err.Error(), "dashboard_id": id})
+		}
+	}
+	return nil
+}
+
+// RenderDashboard evaluates every panel of a dashboard the club can view.
+// A non-zero time range replaces the dashboard's own, but not the ranges set
+// on individual panels.
+func (s *service) RenderDashboard(id uint, clubID string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error) {
+	d, err := s.GetDashboard(id, clubID)
+	if err != nil {
+		return nil, err
+	}
+	return s.renderDashboard(d, timeRange)
+}
+
+// IssueEmbedToken signs a token granting read access to a dashboard without
+// a club. The TTL defaults to 30 days and is capped at a year.
+func (s *service) IssueEmbedToken(id uint, clubID string, ttl time.Duration) (string, time.Time, error) {
+	if s.embeds == nil {
+		return "", time.Time{}, ErrEmbedNotConfigured
+	}
+	if ttl <= 0 {
+		ttl = defaultEmbedTTL
+	}
+	if ttl > maxEmbedTTL {
+		return "", time.Time{}, fmt.Errorf("embed token TTL must be at most %s", maxEmbedTTL)
+	}
+
+	d, err := s.ownedDashboard(id, clubID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+	token, err := s.embeds.Issue(dashboard.EmbedClaims{DashboardID: d.ID, Version: d.EmbedVersion, ExpiresAt: expiresAt.Unix()})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to issue embed token: %w", err)
+	}
+
+	s.logger.Info("Issued dashboard embed token", map[string]interface{}{"dashboard_id": id, "expires_at": expiresAt})
+	return token, expiresAt, nil
+}
+
+// RevokeEmbedTokens invalidates every embed token issued for a dashboard
+func (s *service) RevokeEmbedTokens(id uint, clubID string) error {
+	d, err := s.ownedDashboard(id, clubID)
+	if err != nil {
+		return err
+	}
+
+	d.EmbedVersion++
+	if err := s.repo.UpdateDashboard(d); err != nil {
+		return err
+	}
+
+	s.logger.Info("Revoked dashboard embed tokens", map[string]interface{}{"dashboard_id": id})
+	return nil
+}
+
+// RenderEmbeddedDashboard evaluates the dashboard an embed token grants
+func (s *service) RenderEmbeddedDashboard(token string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error) {
+	if s.embeds == nil {
+		return nil, ErrEmbedNotConfigured
+	}
+	claims, err := s.embeds.Verify(token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.repo.GetDashboard(claims.DashboardID)
+	if errors.Is(err, repository.ErrNotFound) || (err == nil && d.EmbedVersion != claims.Version) {
+		return nil, dashboard.ErrEmbedToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.renderDashboard(d, timeRange)
+}
+
+// ExportDashboardToGrafana returns a dashboard as a Grafana dashboard model
+// whose panels query the rollups through a PostgreSQL data source
+func (s *service) ExportDashboardToGrafana(id uint, clubID string) (map[string]interface{}, error) {
+	d, err := s.GetDashboard(id, clubID)
+	if err != nil {
+		return nil, err
+	}
+	return s.grafanaModel(d), nil
+}
+
+// PushDashboardToGrafana exports a dashboard to the configured Grafana. Once
+// pushed, updates and deletes are mirrored there.
+func (s *service) PushDashboardToGrafana(id uint, clubID string) (*repository.Dashboard, error) {
+	d, err := s.ownedDashboard(id, clubID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pushToGrafana(d); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	d.GrafanaSyncedAt = &now
+	if err := s.repo.UpdateDashboard(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ownedDashboard returns a dashboard the club may change
+func (s *service) ownedDashboard(id uint, clubID string) (*repository.Dashboard, error) {
+	d, err := s.GetDashboard(id, clubID)
+	if err != nil {
+		return nil, err
+	}
+	if d.ClubID != clubID {
+		return nil, ErrDashboardNotOwned
+	}
+	return d, nil
+}
+
+func (s *service) pushToGrafana(d *repository.Dashboard) error {
+	if s.integrations == nil || !s.integrations.GrafanaConfigured() {
+		return ErrGrafanaNotConfigured
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), grafanaTimeout)
+	defer cancel()
+	if err := s.integrations.CreateDashboard(ctx, s.grafanaModel(d)); err != nil {
+		return fmt.Errorf("failed to push dashboard to Grafana: %w", err)
+	}
+
+	s.logger.Info("Pushed dashboard to Grafana", map[string]interface{}{"dashboard_id": d.ID, "uid": d.GrafanaUID()})
+	return nil
+}
+
+func (s *service) grafanaModel(d *repository.Dashboard) map[string]interface{} {
+	export := dashboard.GrafanaExport{
+		UID:            d.GrafanaUID(),
+		Title:          d.Name,
+		Description:    d.Description,
+		ClubID:         d.ClubID,
+		Panels:         d.Panels,
+		TimeRange:      d.TimeRange,
+		RefreshSeconds: d.RefreshSeconds,
+	}
+	if s.integrations != nil && s.integrations.Grafana != nil {
+		export.DataSourceUID = s.integrations.Grafana.DataSourceUID()
+	}
+	return export.Model()
+}
+
+// renderDashboard runs every panel's query against the rollups. A panel whose
+// query fails carries the error instead of failing the whole dashboard.
+func (s *service) renderDashboard(d *repository.Dashboard, timeRange dashboard.TimeRange) (*dashboard.Rendered, error) {
+	start := time.Now()
+	if timeRange.IsZero() {
+		timeRange = d.TimeRange
+	}
+	if _, _, err := timeRange.Resolve(start); err != nil {
+		return nil, err
+	}
+
+	rendered := &dashboard.Rendered{
+		DashboardID: d.ID,
+		Name:        d.Name,
+		GeneratedAt: start,
+		Panels:      make([]dashboard.PanelData, len(d.Panels)),
+	}
+	for i := range d.Panels {
+		rendered.Panels[i] = s.renderPanel(d.ClubID, &d.Panels[i], timeRange, start)
+	}
+
+	s.metrics.RecordProcessingDuration("dashboard_render", "success", time.Since(start))
+	return rendered, nil
+}
+
+func (s *service) renderPanel(clubID string, panel *dashboard.Panel, timeRange dashboard.TimeRange, now time.Time) dashboard.PanelData {
+	if panel.TimeRange != nil {
+		timeRange = *panel.TimeRange
+	}
+	start, end, err := timeRange.Resolve(now)
+	if err != nil {
+		data := panel.Render(now, now, panel.Query.Interval, nil)
+		data.Error = err.Error()
+		return data
+	}
+
+	interval := panel.Query.Interval
+	if interval == "" {
+		interval = dashboard.AutoInterval(start, end)
+	}
+
+	query := &repository.RollupQuery{
+		ClubID:  clubID,
+		Source:  panel.Query.Source,
+		Name:    panel.Query.Name,
+		Start:   start,
+		End:     end,
+		Bucket:  interval,
+		GroupBy: panel.Query.GroupBy,
+		Tags:    panel.Query.Filters,
+		Fill:    string(rollup.FillNull),
+	}
+	err = query.Validate()
+	var result *repository.RollupResult
+	if err == nil {
+		result, err = s.repo.QueryRollups(query)
+	}
+	if err != nil {
+		s.logger.Warn("Failed to query dashboard panel", map[string]interface{}{"error": err.Error(), "panel_id": panel.ID, "club_id": clubID})
+		data := panel.Render(start, end, interval, nil)
+		data.Error = err.Error()
+		return data
+	}
+
+	groups := make([]dashboard.Group, len(result.Series))
+	for i, series := range result.Series {
+		groups[i] = dashboard.Group{Tags: series.Tags, Buckets: make([]dashboard.Bucket, len(series.Points))}
+		for j, point := range series.Points {
+			groups[i].Buckets[j] = rollupBucket(point)
+		}
+	}
+
+	data := panel.Render(result.Start, result.End, interval, groups)
+	data.CoveredFrom = result.CoveredFrom
+	return data
+}
+
+func rollupBucket(point repository.RollupPoint) dashboard.Bucket {
+	if point.Count == nil {
+		return dashboard.Bucket{Start: point.Timestamp, Empty: true}
+	}
+	value := func(v *float64) float64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	return dashboard.Bucket{
+		Start: point.Timestamp,
+		Count: *point.Count,
+		Sum:   value(point.Sum),
+		Min:   value(point.Min),
+		Max:   value(point.Max),
+		Avg:   value(point.Avg),
+		P50:   value(point.P50),
+		P95:   value(point.P95),
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/analytics-service/internal/ingestion"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+// startIngestion subscribes the ingestion pipeline to domain events
+func (s *service) startIngestion() error {
+	for _, subject := range ingestion.Subjects {
+		if err := s.natsClient.SubscribeQueue(subject, ingestion.Queue, s.handleDomainEvent); err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+		}
+	}
+
+	s.logger.Info("Domain event ingestion started", map[string]interface{}{
+		"subjects": ingestion.Subjects,
+		"queue":    ingestion.Queue,
+	})
+	return nil
+}
+
+// handleDomainEvent stores the fact of a domain event. Only storage failures
+// are returned, so the message bus retries them; events that cannot be
+// ingested are dropped.
+func (s *service) handleDomainEvent(ctx context.Context, msg *messaging.Message) error {
+	event, err := ingestion.Decode(msg)
+	if errors.Is(err, ingestion.ErrUnsupported) {
+		s.metrics.RecordEventIngested("none", "ignored")
+		return nil
+	}
+	if err != nil {
+		s.metrics.RecordEventIngested("none", "invalid")
+		s.logger.Warn("Dropping invalid domain event", map[string]interface{}{
+			"error":      err.Error(),
+			"subject":    msg.Subject,
+			"message_id": msg.ID,
+		})
+		return nil
+	}
+
+	ingested, err := s.repo.IngestEvent(&repository.IngestedMessage{
+		MessageID: event.MessageID,
+		Subject:   event.Subject,
+		EventType: event.Type,
+	}, event.Fact)
+	if err != nil {
+		s.metrics.RecordEventIngested(string(event.Kind), "failed")
+		return err
+	}
+
+	if !ingested {
+		s.metrics.RecordEventIngested(string(event.Kind), "duplicate")
+		s.logger.Debug("Skipping already ingested event", map[string]interface{}{
+			"message_id": event.MessageID,
+			"event_type": event.Type,
+		})
+		return nil
+	}
+
+	s.metrics.RecordEventIngested(string(event.Kind), "ingested")
+	return nil
+}
+
+// BackfillConfig holds a read connection to the database of the service that
+// owns each backfill source. Sources without one cannot be backfilled.
+type BackfillConfig struct {
+	Sources map[string]*gorm.DB
+}
+
+// ConfigureBackfill sets the databases facts are backfilled from
+func (s *service) ConfigureBackfill(config BackfillConfig) {
+	s.backfill = config.Sources
+}
+
+// BackfillFacts rebuilds facts from the given sources, or from every
+// configured source when none are given, and returns the rows read from each
+func (s *service) BackfillFacts(sources []string, since time.Time) (map[string]int, error) {
+	if len(sources) == 0 {
+		for _, source := range repository.BackfillSources {
+			if s.backfill[source] != nil {
+				sources = append(sources, source)
+			}
+		}
+		if len(sources) == 0 {
+			return nil, fmt.Errorf("no backfill sources are configured")
+		}
+	}
+	for _, source := range sources {
+		if !knownBackfillSource(source) {
+			return nil, fmt.Errorf("unknown backfill source: %s", source)
+		}
+		if s.backfill[source] == nil {
+			return nil, fmt.Errorf("no database configured for backfill source: %s", source)
+		}
+	}
+
+	counts := make(map[string]int, len(sources))
+	for _, source := range sources {
+		count, err := s.repo.BackfillFacts(source, s.backfill[source], since)
+		counts[source] = count
+		s.metrics.RecordFactsBackfilled(source, count)
+		if err != nil {
+			return counts, err
+		}
+	}
+
+	s.logger.Info("Fact backfill completed", map[string]interface{}{"rows": counts, "since": since})
+	return counts, nil
+}
+
+func knownBackfillSource(source string) bool {
+	for _, known := range repository.BackfillSources {
+		if source == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+// GetNetworkAnalytics reports on reciprocal visits between clubs. A club ID of
+// zero covers the whole network. Rows about clubs other than the one asking
+// are held to the k-anonymity threshold, as is everything in a network-wide
+// report.
+func (s *service) GetNetworkAnalytics(clubID uint, timeRange repository.TimeRange) (*network.Report, error) {
+	start := time.Now()
+	s.monitoring.RecordBusinessEvent("analytics_network_requests", strconv.FormatUint(uint64(clubID), 10))
+
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	report, err := s.repo.NetworkAnalytics(clubID, timeRange)
+	if err != nil {
+		s.metrics.RecordProcessingError("network_analytics", "query_error")
+		s.logger.Error("Failed to get network analytics", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return nil, fmt.Errorf("failed to get network analytics: %w", err)
+	}
+
+	network.Anonymize(report, clubID, s.privacy.minGroupSize)
+
+	s.metrics.RecordProcessingDuration("network_analytics", "success", time.Since(start))
+	return report, nil
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+	"reciprocal-clubs-backend/services/analytics-service/internal/sink"
+)
+
+const (
+	// Privacy settings are cached this long, so a policy saved on another
+	// replica takes effect here within it
+	privacyCacheTTL      = time.Minute
+	defaultMinGroupSize  = 5
+	erasureInterval      = time.Minute
+	erasureBatchSize     = 50
+	erasureSinkBatchSize = 500
+
+	// Sinks are asked to erase a request's events only once any batch that was
+	// being shipped when the events were deleted has landed
+	erasureSinkDelay = outboxSettleDelay + sinkWriteTimeout
+)
+
+// Export sink results of an erasure request
+const (
+	ErasureSinkErased      = "erased"
+	ErasureSinkUnsupported = "unsupported"
+)
+
+// PrivacyConfig sets how event data is protected
+type PrivacyConfig struct {
+	// DefaultPolicy applies to clubs that have not set their own
+	DefaultPolicy *privacy.Policy
+	// MinGroupSize is the k-anonymity threshold of network aggregates: rows
+	// about other clubs need at least this many members behind them
+	MinGroupSize int
+}
+
+// PrivacyPolicy is the policy a club's event data is transformed by
+type PrivacyPolicy struct {
+	ClubID string         `json:"club_id"`
+	Policy privacy.Policy `json:"policy"`
+	// Default is set while the club follows the default policy
+	Default      bool `json:"default"`
+	MinGroupSize int  `json:"min_group_size"`
+}
+
+type cachedPrivacySettings struct {
+	settings *repository.PrivacySettings
+	loadedAt time.Time
+}
+
+// privacyGuard transforms event data by its club's policy and works through
+// the export sink side of erasure requests
+type privacyGuard struct {
+	stop         chan struct{}
+	stopOnce     sync.Once
+	defaults     privacy.Policy
+	minGroupSize int
+
+	mu    sync.Mutex
+	cache map[string]cachedPrivacySettings
+}
+
+func newPrivacyGuard() *privacyGuard {
+	return &privacyGuard{
+		stop:         make(chan struct{}),
+		defaults:     privacy.DefaultPolicy(),
+		minGroupSize: defaultMinGroupSize,
+		cache:        make(map[string]cachedPrivacySettings),
+	}
+}
+
+// ConfigurePrivacy sets the default policy and the k-anonymity threshold
+func (s *service) ConfigurePrivacy(config PrivacyConfig) error {
+	if config.DefaultPolicy != nil {
+		if err := config.DefaultPolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid default privacy policy: %w", err)
+		}
+		s.privacy.defaults = *config.DefaultPolicy
+	}
+	if config.MinGroupSize > 0 {
+		s.privacy.minGroupSize = config.MinGroupSize
+	}
+	return nil
+}
+
+// privacySettings returns a club's settings, creating them with a new salt
+// the first time the club is seen
+func (s *service) privacySettings(clubID string) (*repository.PrivacySettings, error) {
+	s.privacy.mu.Lock()
+	cached, ok := s.privacy.cache[clubID]
+	s.privacy.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < privacyCacheTTL {
+		return cached.settings, nil
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate privacy salt: %w", err)
+	}
+	settings, err := s.repo.EnsurePrivacySettings(&repository.PrivacySettings{ClubID: clubID, Salt: hex.EncodeToString(salt)})
+	if err != nil {
+		return nil, err
+	}
+
+	s.privacy.mu.Lock()
+	s.privacy.cache[clubID] = cachedPrivacySettings{settings: settings, loadedAt: time.Now()}
+	s.privacy.mu.Unlock()
+	return settings, nil
+}
+
+// effectivePolicy is the club's own policy, or the default one
+func (s *service) effectivePolicy(settings *repository.PrivacySettings) privacy.Policy {
+	if settings.Policy != nil {
+		return *settings.Policy
+	}
+	return s.privacy.defaults
+}
+
+// protect transforms event data or metric tags by the club's policy and
+// returns them with the pseudonym of the member they are about
+func (s *service) protect(clubID string, data map[string]interface{}) (map[string]interface{}, string, error) {
+	if data == nil {
+		return nil, "", nil
+	}
+	settings, err := s.privacySettings(clubID)
+	if err != nil {
+		return nil, "", err
+	}
+	policy := s.effectivePolicy(settings)
+	protected, subject := policy.Apply([]byte(settings.Salt), data)
+	return protected, subject, nil
+}
+
+// GetPrivacyPolicy returns the policy a club's event data is transformed by
+func (s *service) GetPrivacyPolicy(clubID string) (*PrivacyPolicy, error) {
+	if clubID == "" {
+		return nil, fmt.Errorf("club_id is required")
+	}
+	settings, err := s.privacySettings(clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get privacy policy: %w", err)
+	}
+	return &PrivacyPolicy{
+		ClubID:       clubID,
+		Policy:       s.effectivePolicy(settings),
+		Default:      settings.Policy == nil,
+		MinGroupSize: s.privacy.minGroupSize,
+	}, nil
+}
+
+// UpdatePrivacyPolicy sets a club's policy for events recorded from now on.
+// A nil policy puts the club back on the default policy.
+func (s *service) UpdatePrivacyPolicy(clubID string, policy *privacy.Policy) error {
+	if clubID == "" {
+		return fmt.Errorf("club_id is required")
+	}
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.privacySettings(clubID); err != nil {
+		return fmt.Errorf("failed to update privacy policy: %w", err)
+	}
+	if err := s.repo.SavePrivacyPolicy(clubID, policy); err != nil {
+		return fmt.Errorf("failed to update privacy policy: %w", err)
+	}
+
+	s.privacy.mu.Lock()
+	delete(s.privacy.cache, clubID)
+	s.privacy.mu.Unlock()
+
+	s.logger.Info("Updated privacy policy", map[string]interface{}{"club_id": clubID, "default": policy == nil})
+	return nil
+}
+
+// RequestErasure erases a member's analytics data: their events and facts
+// are deleted straight away, along with outbox copies not yet exported, and
+// export sinks that support it are asked to erase the copies they were sent.
+// Sinks that cannot erase records are listed as unsupported on the request.
+func (s *service) RequestErasure(clubID, memberID string) (*repository.ErasureRequest, error) {
+	if clubID == "" || memberID == "" {
+		return nil, fmt.Errorf("club_id and member_id are required")
+	}
+
+	settings, err := s.privacySettings(clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to erase member data: %w", err)
+	}
+
+	request := &repository.ErasureRequest{
+		ClubID:      clubID,
+		SubjectID:   privacy.Pseudonym([]byte(settings.Salt), memberID),
+		Status:      repository.ErasureStatusPending,
+		SinkResults: map[string]string{},
+	}
+	for _, target := range s.erasureSinks() {
+		if _, ok := target.(sink.Eraser); !ok {
+			request.SinkResults[target.Name()] = ErasureSinkUnsupported
+		}
+	}
+
+	// Facts key members by number; other member IDs only match events
+	var factMemberID uint
+	if id, err := strconv.ParseUint(memberID, 10, 0); err == nil {
+		factMemberID = uint(id)
+	}
+	if err := s.repo.EraseSubject(request, factMemberID); err != nil {
+		s.metrics.RecordProcessingError("erasure", "database_error")
+		return nil, fmt.Errorf("failed to erase member data: %w", err)
+	}
+
+	if len(request.SinkKeys) == 0 || !s.erasurePending(request) {
+		s.completeErasure(request)
+		if err := s.repo.UpdateErasureRequest(request); err != nil {
+			return nil, fmt.Errorf("failed to erase member data: %w", err)
+		}
+	}
+
+	s.logger.Info("Erased member data", map[string]interface{}{"club_id": clubID, "erasure_id": request.ID, "status": request.Status})
+	return request, nil
+}
+
+func (s *service) GetErasureRequest(id uint) (*repository.ErasureRequest, error) {
+	return s.repo.GetErasureRequest(id)
+}
+
+func (s *service) ListErasureRequests(clubID string, limit int) ([]*repository.ErasureRequest, error) {
+	if clubID == "" {
+		return nil, fmt.Errorf("club_id is required")
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	requests, err := s.repo.ListErasureRequests(clubID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list erasure requests: %w", err)
+	}
+	return requests, nil
+}
+
+// erasureSinks are the export sinks events are shipped to
+func (s *service) erasureSinks() []sink.Sink {
+	var sinks []sink.Sink
+	for _, target := range s.sinks.sinks {
+		if sink.Accepts(target, sink.KindEvent) {
+			sinks = append(sinks, target)
+		}
+	}
+	return sinks
+}
+
+// erasurePending reports whether a sink that can erase has yet to
+func (s *service) erasurePending(request *repository.ErasureRequest) bool {
+	for _, target := range s.erasureSinks() {
+		if _, ok := target.(sink.Eraser); ok && request.SinkResults[target.Name()] != ErasureSinkErased {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *service) completeErasure(request *repository.ErasureRequest) {
+	now := time.Now()
+	request.Status = repository.ErasureStatusCompleted
+	request.CompletedAt = &now
+	request.SinkKeys = nil
+}
+
+func (s *service) runErasures() {
+	ticker := time.NewTicker(erasureInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.privacy.stop:
+			return
+		case <-ticker.C:
+			s.processErasures()
+		}
+	}
+}
+
+// processErasures asks export sinks to erase the events of pending requests.
+// A sink that fails is retried on the next pass, and a request completes once
+// every sink that can erase has done so.
+func (s *service) processErasures() {
+	requests, err := s.repo.PendingErasureRequests(time.Now().Add(-erasureSinkDelay), erasureBatchSize)
+	if err != nil {
+		s.logger.Error("Failed to get pending erasure requests", map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	for _, request := range requests {
+		request.Attempts++
+		if request.SinkResults == nil {
+			request.SinkResults = map[string]string{}
+		}
+
+		for _, target := range s.erasureSinks() {
+			eraser, ok := target.(sink.Eraser)
+			if !ok || request.SinkResults[target.Name()] == ErasureSinkErased {
+				continue
+			}
+			if err := s.eraseFromSink(eraser, request.SinkKeys); err != nil {
+				s.logger.Warn("Failed to erase events from export sink", map[string]interface{}{"error": err.Error(), "sink": target.Name(), "erasure_id": request.ID})
+				request.SinkResults[target.Name()] = err.Error()
+				continue
+			}
+			request.SinkResults[target.Name()] = ErasureSinkErased
+		}
+
+		if !s.erasurePending(request) {
+			s.completeErasure(request)
+		}
+		if err := s.repo.UpdateErasureRequest(request); err != nil {
+			s.logger.Error("Failed to update erasure request", map[string]interface{}{"error": err.Error(), "erasure_id": request.ID})
+		}
+	}
+}
+
+func (s *service) eraseFromSink(eraser sink.Eraser, keys []string) error {
+	for start := 0; start < len(keys); start += erasureSinkBatchSize {
+		batch := keys[start:min(start+erasureSinkBatchSize, len(keys))]
+		ctx, cancel := context.WithTimeout(context.Background(), sinkWriteTimeout)
+		err := eraser.Erase(ctx, batch)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
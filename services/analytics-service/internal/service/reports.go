@@ -0,0 +1,186 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/reporting"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+// defaultReportDays is the period covered by reports generated on demand
+const defaultReportDays = 7
+
+var reportTitles = map[string]string{
+	repository.ReportTypeUsage:       "Usage Report",
+	repository.ReportTypeEngagement:  "Engagement Report",
+	repository.ReportTypePerformance: "Performance Report",
+}
+
+// buildReport gathers the content of a report on a club over a period. Usage
+// reports come from reciprocal visits, engagement reports from member, vote
+// and visit facts, and performance reports from metric rollups.
+func (s *service) buildReport(clubID, reportType string, timeRange repository.TimeRange) (*reporting.Document, error) {
+	title, ok := reportTitles[reportType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported report type: %s", reportType)
+	}
+
+	doc := &reporting.Document{
+		Title:       title,
+		ReportType:  reportType,
+		ClubID:      clubID,
+		Start:       timeRange.Start,
+		End:         timeRange.End,
+		GeneratedAt: time.Now(),
+	}
+
+	var err error
+	switch reportType {
+	case repository.ReportTypeUsage:
+		err = s.buildUsageReport(doc, clubID, timeRange)
+	case repository.ReportTypeEngagement:
+		err = s.buildEngagementReport(doc, clubID, timeRange)
+	case repository.ReportTypePerformance:
+		err = s.buildPerformanceReport(doc, clubID, timeRange)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// factClubID parses a club ID for the fact tables, which key clubs by number
+func factClubID(clubID string) (uint, error) {
+	id, err := strconv.ParseUint(clubID, 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("club_id must be a positive number for this report type")
+	}
+	return uint(id), nil
+}
+
+func (s *service) buildUsageReport(doc *reporting.Document, clubID string, timeRange repository.TimeRange) error {
+	id, err := factClubID(clubID)
+	if err != nil {
+		return err
+	}
+	report, err := s.repo.NetworkAnalytics(id, timeRange)
+	if err != nil {
+		return fmt.Errorf("failed to build usage report: %w", err)
+	}
+
+	for _, club := range report.Clubs {
+		if club.ClubID != id {
+			continue
+		}
+		doc.AddField("visits_made", club.VisitsMade)
+		doc.AddField("visits_hosted", club.Hosted.Visits)
+		doc.AddField("hosted_attended", club.Hosted.Attended)
+		doc.AddField("hosted_cancellation_rate", club.Hosted.CancellationRate)
+		doc.AddField("hosted_no_show_rate", club.Hosted.NoShowRate)
+		doc.AddField("partners", club.Partners)
+		if club.HostRating != nil {
+			doc.AddField("host_rating", club.HostRating.Average)
+		}
+
+		facilities := reporting.Table{Name: "Facilities", Columns: []string{"facility", "visits"}}
+		for _, usage := range club.Facilities {
+			facilities.Rows = append(facilities.Rows, []interface{}{usage.Facility, usage.Visits})
+		}
+		doc.Tables = append(doc.Tables, facilities)
+	}
+
+	destinations := reporting.Table{Name: "Destinations", Columns: []string{"club_id", "visits", "members", "requested"}}
+	for _, flow := range report.Flows {
+		if flow.HomeClubID == id {
+			destinations.Rows = append(destinations.Rows, []interface{}{flow.VisitingClubID, flow.Visits, flow.Members, flow.Requested})
+		}
+	}
+
+	agreements := reporting.Table{Name: "Agreements", Columns: []string{"agreement_id", "partner_club_id", "status", "visits_made", "visits_hosted", "utilization_rate"}}
+	for _, agreement := range report.Agreements {
+		partner, made, hosted := agreement.TargetClubID, agreement.Outbound, agreement.Inbound
+		if agreement.TargetClubID == id {
+			partner, made, hosted = agreement.ProposingClubID, agreement.Inbound, agreement.Outbound
+		}
+		var rate interface{}
+		if agreement.Utilization != nil {
+			rate = agreement.Utilization.Rate
+		}
+		agreements.Rows = append(agreements.Rows, []interface{}{agreement.AgreementID, partner, agreement.Status, made, hosted, rate})
+	}
+
+	doc.Tables = append(doc.Tables, destinations, agreements)
+	return nil
+}
+
+func (s *service) buildEngagementReport(doc *reporting.Document, clubID string, timeRange repository.TimeRange) error {
+	id, err := factClubID(clubID)
+	if err != nil {
+		return err
+	}
+	stats, err := s.repo.EngagementStats(id, timeRange)
+	if err != nil {
+		return fmt.Errorf("failed to build engagement report: %w", err)
+	}
+
+	doc.AddField("members", stats.Members)
+	doc.AddField("active_members", stats.ActiveMembers)
+	doc.AddField("members_joined", stats.Joined)
+	doc.AddField("members_removed", stats.Removed)
+	doc.AddField("votes", stats.Votes)
+	doc.AddField("voters", stats.Voters)
+	if stats.Members > 0 {
+		doc.AddField("voter_turnout", float64(stats.Voters)/float64(stats.Members))
+	}
+	doc.AddField("visits_made", stats.VisitsMade)
+	doc.AddField("visits_hosted", stats.VisitsHosted)
+
+	byType := reporting.Table{Name: "Membership types", Columns: []string{"membership_type", "members"}}
+	for _, membershipType := range sortedKeys(stats.ByType) {
+		byType.Rows = append(byType.Rows, []interface{}{membershipType, stats.ByType[membershipType]})
+	}
+
+	activity := reporting.Table{Name: "Activity", Columns: []string{stats.ActivityPeriod, "members_joined", "votes", "visits_made"}}
+	for _, period := range stats.Activity {
+		activity.Rows = append(activity.Rows, []interface{}{period.Start, period.Joined, period.Votes, period.Visits})
+	}
+
+	doc.Tables = append(doc.Tables, byType, activity)
+	return nil
+}
+
+func (s *service) buildPerformanceReport(doc *reporting.Document, clubID string, timeRange repository.TimeRange) error {
+	summaries, err := s.repo.MetricSummaries(clubID, timeRange)
+	if err != nil {
+		return fmt.Errorf("failed to build performance report: %w", err)
+	}
+
+	metrics := reporting.Table{Name: "Metrics", Columns: []string{"metric", "count", "avg", "min", "max", "p50", "p95"}}
+	events := reporting.Table{Name: "Events", Columns: []string{"event_type", "count"}}
+	var totalEvents int64
+	for _, summary := range summaries {
+		if summary.Source == repository.RollupSourceEvent {
+			totalEvents += summary.Count
+			events.Rows = append(events.Rows, []interface{}{summary.Name, summary.Count})
+			continue
+		}
+		metrics.Rows = append(metrics.Rows, []interface{}{summary.Name, summary.Count, summary.Avg, summary.Min, summary.Max, summary.P50, summary.P95})
+	}
+
+	doc.AddField("metrics_tracked", len(metrics.Rows))
+	doc.AddField("events", totalEvents)
+	doc.Tables = append(doc.Tables, metrics, events)
+	return nil
+}
+
+func sortedKeys(counts map[string]int64) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+const (
+	rollupInterval    = 30 * time.Second
+	retentionInterval = time.Hour
+)
+
+// rollupWorker folds new raw rows into rollups and applies the retention
+// policy in the background
+type rollupWorker struct {
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newRollupWorker() *rollupWorker {
+	return &rollupWorker{stop: make(chan struct{})}
+}
+
+func (s *service) runRollups() {
+	rollups := time.NewTicker(rollupInterval)
+	defer rollups.Stop()
+	retention := time.NewTicker(retentionInterval)
+	defer retention.Stop()
+
+	for {
+		select {
+		case <-s.rollups.stop:
+			return
+		case <-rollups.C:
+			count, err := s.repo.RollUp(time.Now())
+			s.metrics.RecordRowsRolledUp(count)
+			if err != nil {
+				s.metrics.RecordProcessingError("rollup", "rollup_error")
+				s.logger.Error("Failed to roll up raw rows", map[string]interface{}{"error": err.Error()})
+			}
+		case <-retention.C:
+			policy, err := s.repo.GetRetentionPolicy()
+			if err == nil {
+				err = s.applyRetention(policy)
+			}
+			if err != nil {
+				s.logger.Error("Failed to apply retention policy", map[string]interface{}{"error": err.Error()})
+			}
+		}
+	}
+}
+
+func (s *service) applyRetention(policy *repository.RetentionPolicy) error {
+	deleted, err := s.repo.ApplyRetention(policy, time.Now())
+	for tier, count := range deleted {
+		s.metrics.RecordRowsRetired(tier, count)
+	}
+	return err
+}
+
+// QueryMetrics answers a bucketed query from the stored rollups. Rollups trail
+// raw writes by up to a rollup interval.
+func (s *service) QueryMetrics(query *repository.RollupQuery) (*repository.RollupResult, error) {
+	start := time.Now()
+	s.monitoring.RecordBusinessEvent("analytics_metric_queries", query.ClubID)
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.QueryRollups(query)
+	if err != nil {
+		s.metrics.RecordProcessingError("metric_query", "query_error")
+		return nil, fmt.Errorf("failed to query metrics: %w", err)
+	}
+
+	s.metrics.RecordProcessingDuration("metric_query", "success", time.Since(start))
+	return result, nil
+}
+
+func (s *service) GetRetentionPolicy() (*repository.RetentionPolicy, error) {
+	return s.repo.GetRetentionPolicy()
+}
+
+func (s *service) UpdateRetentionPolicy(policy *repository.RetentionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.repo.SaveRetentionPolicy(policy); err != nil {
+		s.logger.Error("Failed to save retention policy", map[string]interface{}{"error": err.Error()})
+		return fmt.Errorf("failed to save retention policy: %w", err)
+	}
+
+	s.logger.Info("Updated retention policy", map[string]interface{}{"policy": policy})
+	return nil
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/blobstore"
+	"reciprocal-clubs-backend/services/analytics-service/internal/reporting"
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+)
+
+const (
+	reportScheduleInterval = time.Minute
+	reportScheduleBatch    = 20
+	reportRunTimeout       = 5 * time.Minute
+	defaultReportLinkTTL   = 7 * 24 * time.Hour
+)
+
+// ErrReportsNotConfigured is returned when report storage has not been set up
+var ErrReportsNotConfigured = errors.New("report storage is not configured")
+
+// ReportConfig sets where rendered reports are stored and how the download
+// links emailed to recipients are built
+type ReportConfig struct {
+	Store blobstore.Store
+	// SigningKey signs download links; links stop working when it changes
+	SigningKey []byte
+	// BaseURL is the public address of the report routes, for example
+	// https://analytics.example.com/api/v1/analytics/reports
+	BaseURL string
+	LinkTTL time.Duration
+}
+
+// reportScheduler runs due report schedules in the background
+type reportScheduler struct {
+	stop     chan struct{}
+	stopOnce sync.Once
+	store    blobstore.Store
+	signer   *reporting.LinkSigner
+	baseURL  string
+	linkTTL  time.Duration
+}
+
+func newReportScheduler() *reportScheduler {
+	return &reportScheduler{stop: make(chan struct{}), linkTTL: defaultReportLinkTTL}
+}
+
+// ConfigureReports sets up report storage and delivery. It must be called
+// before the event processor starts.
+func (s *service) ConfigureReports(config ReportConfig) {
+	s.reports.store = config.Store
+	s.reports.signer = reporting.NewLinkSigner(config.SigningKey)
+	s.reports.baseURL = strings.TrimSuffix(config.BaseURL, "/")
+	if config.LinkTTL > 0 {
+		s.reports.linkTTL = config.LinkTTL
+	}
+}
+
+func (s *service) runReportSchedules() {
+	ticker := time.NewTicker(reportScheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.reports.stop:
+			return
+		case <-ticker.C:
+			if s.reports.store == nil {
+				continue
+			}
+			if err := s.runDueReports(time.Now()); err != nil {
+				s.logger.Error("Failed to run due report schedules", map[string]interface{}{"error": err.Error()})
+			}
+		}
+	}
+}
+
+// runDueReports generates every due schedule's report. A schedule that missed
+// several runs, for example while the service was down, runs once and then
+// waits for its next time after now.
+func (s *service) runDueReports(now time.Time) error {
+	schedules, err := s.repo.DueReportSchedules(now, reportScheduleBatch)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range schedules {
+		firedAt := *schedule.NextRunAt
+		var nextRunAt *time.Time
+		cron, err := schedule.CronSchedule()
+		if err == nil {
+			if next := cron.Next(now); !next.IsZero() {
+				nextRunAt = &next
+			}
+		}
+
+		claimed, claimErr := s.repo.ClaimReportSchedule(schedule.ID, firedAt, nextRunAt)
+		if claimErr != nil {
+			return claimErr
+		}
+		if !claimed {
+			// Another replica is running it
+			continue
+		}
+		if err != nil {
+			s.recordScheduleRun(schedule, nil, err)
+			continue
+		}
+
+		s.runReportSchedule(schedule, cron, firedAt)
+	}
+	return nil
+}
+
+// runReportSchedule generates, stores and delivers one run of a schedule. The
+// report covers the time since the schedule last fired, or the number of days
+// in its "days" parameter.
+func (s *service) runReportSchedule(schedule *repository.ReportSchedule, cron *reporting.Schedule, firedAt time.Time) (*repository.AnalyticsReport, error) {
+	start := firedAt.AddDate(0, 0, -defaultReportDays)
+	if days := schedule.Days(); days > 0 {
+		start = firedAt.AddDate(0, 0, -days)
+	} else if previous := cron.Previous(firedAt); !previous.IsZero() {
+		start = previous
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), reportRunTimeout)
+	defer cancel()
+
+	timeRange := repository.TimeRange{Start: start, End: firedAt}
+	report, err := s.generateScheduledReport(ctx, schedule, timeRange)
+	if err == nil {
+		err = s.deliverReport(ctx, schedule, report, timeRange)
+	}
+	s.recordScheduleRun(schedule, report, err)
+	return report, err
+}
+
+func (s *service) recordScheduleRun(schedule *repository.ReportSchedule, report *repository.AnalyticsReport, runErr error) {
+	status := repository.ReportStatusDelivered
+	if runErr != nil {
+		status = repository.ReportStatusFailed
+		s.logger.Error("Scheduled report failed", map[string]interface{}{"error": runErr.Error(), "schedule_id": schedule.ID, "club_id": schedule.ClubID})
+	}
+	s.metrics.RecordScheduledReportRun(schedule.ReportType, status)
+
+	var reportID *uint
+	if report != nil {
+		reportID = &report.ID
+	}
+	if err := s.repo.RecordReportScheduleRun(schedule.ID, reportID, status, runErr); err != nil {
+		s.logger.Error("Failed to record report schedule run", map[string]interface{}{"error": err.Error(), "schedule_id": schedule.ID})
+	}
+}
+
+// generateScheduledReport renders a schedule's report, stores the file and
+// records the report
+func (s *service) generateScheduledReport(ctx context.Context, schedule *repository.ReportSchedule, timeRange repository.TimeRange) (*repository.AnalyticsReport, error) {
+	if s.reports.store == nil {
+		return nil, ErrReportsNotConfigured
+	}
+
+	doc, err := s.buildReport(schedule.ClubID, schedule.ReportType, timeRange)
+	if err != nil {
+		return nil, err
+	}
+	format, err := reporting.ParseFormat(schedule.Format)
+	if err != nil {
+		return nil, err
+	}
+	artifact, err := reporting.Render(doc, format)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleID := schedule.ID
+	report := &repository.AnalyticsReport{
+		ClubID:       schedule.ClubID,
+		ReportType:   schedule.ReportType,
+		Title:        doc.Title,
+		Data:         doc.Data(),
+		ScheduleID:   &scheduleID,
+		Status:       repository.ReportStatusCompleted,
+		Format:       string(format),
+		ContentType:  artifact.ContentType,
+		ArtifactSize: int64(len(artifact.Data)),
+		GeneratedAt:  doc.GeneratedAt,
+	}
+	if err := s.repo.CreateReport(report); err != nil {
+		return nil, err
+	}
+
+	report.ArtifactKey = fmt.Sprintf("reports/%s/%d.%s", schedule.ClubID, report.ID, artifact.Extension)
+	if err := s.reports.store.Put(ctx, report.ArtifactKey, artifact.Data, artifact.ContentType); err != nil {
+		report.ArtifactKey = ""
+		report.Status = repository.ReportStatusFailed
+		if updateErr := s.repo.UpdateReport(report); updateErr != nil {
+			s.logger.Error("Failed to mark report failed", map[string]interface{}{"error": updateErr.Error(), "report_id": report.ID})
+		}
+		return report, fmt.Errorf("failed to store report: %w", err)
+	}
+	if err := s.repo.UpdateReport(report); err != nil {
+		return report, err
+	}
+
+	s.metrics.RecordReportGenerated(schedule.ClubID, schedule.ReportType, len(artifact.Data))
+	return report, nil
+}
+
+// deliverReport emails each recipient a signed link to download the report
+func (s *service) deliverReport(ctx context.Context, schedule *repository.ReportSchedule, report *repository.AnalyticsReport, timeRange repository.TimeRange) error {
+	if s.integrations == nil {
+		return fmt.Errorf("notification service is not configured")
+	}
+	clubID, err := factClubID(schedule.ClubID)
+	if err != nil {
+		return err
+	}
+
+	expires := time.Now().Add(s.reports.linkTTL)
+	link := s.reports.signer.URL(s.reports.baseURL, report.ID, expires)
+	subject := fmt.Sprintf("%s: %s", schedule.Name, report.Title)
+	location := time.UTC
+	if cron, err := schedule.CronSchedule(); err == nil {
+		location = cron.Location()
+	}
+	message := fmt.Sprintf("Your %s for %s to %s is ready.\n\nDownload it (%s) before %s:\n%s",
+		strings.ToLower(report.Title),
+		timeRange.Start.In(location).Format("2 Jan 2006 15:04"),
+		timeRange.End.In(location).Format("2 Jan 2006 15:04 MST"),
+		strings.ToUpper(report.Format),
+		expires.In(location).Format("2 Jan 2006 15:04 MST"),
+		link)
+	metadata := map[string]interface{}{"report_id": report.ID, "schedule_id": schedule.ID}
+
+	var failed []string
+	var firstErr error
+	for _, recipient := range schedule.Recipients {
+		if err := s.integrations.SendReportEmail(ctx, clubID, recipient, subject, message, metadata); err != nil {
+			s.metrics.RecordReportDelivery("failed")
+			failed = append(failed, recipient)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		s.metrics.RecordReportDelivery("sent")
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to deliver report to %s: %w", strings.Join(failed, ", "), firstErr)
+	}
+
+	deliveredAt := time.Now()
+	report.Status = repository.ReportStatusDelivered
+	report.DeliveredAt = &deliveredAt
+	return s.repo.UpdateReport(report)
+}
+
+// scheduleNextRun validates a schedule and sets when it next fires
+func (s *service) scheduleNextRun(schedule *repository.ReportSchedule, now time.Time) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	schedule.NextRunAt = nil
+	if !schedule.Enabled {
+		return nil
+	}
+	cron, _ := schedule.CronSchedule()
+	next := cron.Next(now)
+	if next.IsZero() {
+		return fmt.Errorf("cron expression %q never fires", schedule.Cron)
+	}
+	schedule.NextRunAt = &next
+	return nil
+}
+
+func (s *service) CreateReportSchedule(schedule *repository.ReportSchedule) error {
+	if err := s.scheduleNextRun(schedule, time.Now()); err != nil {
+		return err
+	}
+
+	if err := s.repo.CreateReportSchedule(schedule); err != nil {
+		return fmt.Errorf("failed to create report schedule: %w", err)
+	}
+	return nil
+}
+
+func (s *service) GetReportSchedule(id uint) (*repository.ReportSchedule, error) {
+	return s.repo.GetReportSchedule(id)
+}
+
+func (s *service) ListReportSchedules(clubID string) ([]*repository.ReportSchedule, error) {
+	return s.repo.ListReportSchedules(clubID)
+}
+
+// UpdateReportSchedule replaces a schedule's settings, keeping its run history
+func (s *service) UpdateReportSchedule(schedule *repository.ReportSchedule) error {
+	existing, err := s.repo.GetReportSchedule(schedule.ID)
+	if err != nil {
+		return err
+	}
+
+	existing.Name = schedule.Name
+	existing.Cron = schedule.Cron
+	existing.Timezone = schedule.Timezone
+	existing.ReportType = schedule.ReportType
+	existing.Parameters = schedule.Parameters
+	existing.Format = schedule.Format
+	existing.Recipients = schedule.Recipients
+	existing.Enabled = schedule.Enabled
+	if err := s.scheduleNextRun(existing, time.Now()); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateReportSchedule(existing); err != nil {
+		return fmt.Errorf("failed to update report schedule: %w", err)
+	}
+	*schedule = *existing
+	return nil
+}
+
+func (s *service) DeleteReportSchedule(id uint) error {
+	return s.repo.DeleteReportSchedule(id)
+}
+
+// RunReportSchedule generates and delivers a schedule's report now, covering
+// the time since the schedule last fired. The schedule's next run is unchanged.
+func (s *service) RunReportSchedule(id uint) (*repository.AnalyticsReport, error) {
+	schedule, err := s.repo.GetReportSchedule(id)
+	if err != nil {
+		return nil, err
+	}
+	cron, err := schedule.CronSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.runReportSchedule(schedule, cron, time.Now())
+}
+
+func (s *service) GetReport(id uint) (*repository.AnalyticsReport, error) {
+	return s.repo.GetReport(id)
+}
+
+// DownloadReport checks a signed download link and returns the report and its
+// file
+func (s *service) DownloadReport(id uint, expires time.Time, signature string) (*repository.AnalyticsReport, []byte, error) {
+	if s.reports.store == nil || s.reports.signer == nil {
+		return nil, nil, ErrReportsNotConfigured
+	}
+	if err := s.reports.signer.Verify(id, expires, signature, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	report, err := s.repo.GetReport(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if report.ArtifactKey == "" {
+		return nil, nil, repository.ErrNotFound
+	}
+
+	data, err := s.reports.store.Get(context.Background(), report.ArtifactKey)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return nil, nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read report file: %w", err)
+	}
+	return report, data, nil
+}
@@ -11,9 +11,14 @@ import (
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/messaging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
 	"reciprocal-clubs-backend/services/analytics-service/internal/integrations"
 	analyticsmonitoring "reciprocal-clubs-backend/services/analytics-service/internal/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
 	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+	"reciprocal-clubs-backend/services/analytics-service/internal/rollup"
 )
 
 type AnalyticsService interface {
@@ -29,14 +34,70 @@ type AnalyticsService interface {
 	RecordMetric(clubID string, metricName string, value float64, tags map[string]interface{}) error
 	GetEvents(clubID string, timeRange string) ([]map[string]interface{}, error)
 
+	// Advanced analytics
+	GetPredictiveAnalytics(clubID string, metricName string, forecastDays int) (map[string]interface{}, error)
+	GetAnomalyDetection(clubID string, metricName string, timeRange repository.TimeRange, sensitivity float64) (map[string]interface{}, error)
+
+	// Anomaly alerting
+	GetAnomalySettings(clubID string, metricName string) (*repository.AnomalySetting, error)
+	UpdateAnomalySettings(setting *repository.AnomalySetting) error
+	ListAnomalyEpisodes(clubID string, metricName string, status string, limit int) ([]*repository.AnomalyEpisode, error)
+
+	// Domain event ingestion
+	ConfigureBackfill(config BackfillConfig)
+	BackfillFacts(sources []string, since time.Time) (map[string]int, error)
+
+	// Rollups and retention
+	QueryMetrics(query *repository.RollupQuery) (*repository.RollupResult, error)
+	GetRetentionPolicy() (*repository.RetentionPolicy, error)
+	UpdateRetentionPolicy(policy *repository.RetentionPolicy) error
+
+	// Reciprocal network analytics
+	GetNetworkAnalytics(clubID uint, timeRange repository.TimeRange) (*network.Report, error)
+
+	// Membership cohorts
+	GetCohortAnalysis(clubID uint, timeRange repository.TimeRange, options cohort.Options) (*cohort.Report, error)
+
+	// Scheduled reports
+	ConfigureReports(config ReportConfig)
+	CreateReportSchedule(schedule *repository.ReportSchedule) error
+	GetReportSchedule(id uint) (*repository.ReportSchedule, error)
+	ListReportSchedules(clubID string) ([]*repository.ReportSchedule, error)
+	UpdateReportSchedule(schedule *repository.ReportSchedule) error
+	DeleteReportSchedule(id uint) error
+	RunReportSchedule(id uint) (*repository.AnalyticsReport, error)
+	GetReport(id uint) (*repository.AnalyticsReport, error)
+	DownloadReport(id uint, expires time.Time, signature string) (*repository.AnalyticsReport, []byte, error)
+
+	// Dashboards
+	ConfigureDashboards(config DashboardConfig)
+	CreateDashboard(dashboard *repository.Dashboard) error
+	GetDashboard(id uint, clubID string) (*repository.Dashboard, error)
+	ListDashboards(clubID string, limit, offset int) ([]*repository.Dashboard, error)
+	UpdateDashboard(dashboard *repository.Dashboard) error
+	DeleteDashboard(id uint, clubID string) error
+	RenderDashboard(id uint, clubID string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error)
+	IssueEmbedToken(id uint, clubID string, ttl time.Duration) (string, time.Time, error)
+	RevokeEmbedTokens(id uint, clubID string) error
+	RenderEmbeddedDashboard(token string, timeRange dashboard.TimeRange) (*dashboard.Rendered, error)
+	ExportDashboardToGrafana(id uint, clubID string) (map[string]interface{}, error)
+	PushDashboardToGrafana(id uint, clubID string) (*repository.Dashboard, error)
+
 	// Maintenance operations
 	CleanupOldData(days int) error
 	GetSystemHealth() map[string]interface{}
 
-	// External integrations
-	ExportData(exportType string, data interface{}) error
-	CreateDashboard(clubID string) error
-	SendMetricsToExternal(metrics map[string]interface{}) error
+	// Export sinks
+	ConfigureSinks(config SinkConfig)
+	GetSinkStatus() ([]SinkStatus, error)
+
+	// Privacy
+	ConfigurePrivacy(config PrivacyConfig) error
+	GetPrivacyPolicy(clubID string) (*PrivacyPolicy, error)
+	UpdatePrivacyPolicy(clubID string, policy *privacy.Policy) error
+	RequestErasure(clubID, memberID string) (*repository.ErasureRequest, error)
+	GetErasureRequest(id uint) (*repository.ErasureRequest, error)
+	ListErasureRequests(clubID string, limit int) ([]*repository.ErasureRequest, error)
 
 	// Monitoring access
 	GetHealthChecker() *analyticsmonitoring.HealthChecker
@@ -57,6 +118,13 @@ type service struct {
 	metrics      *analyticsmonitoring.AnalyticsMetrics
 	health       *analyticsmonitoring.HealthChecker
 	stopChannel  chan bool
+	anomalies    *anomalyMonitor
+	rollups      *rollupWorker
+	reports      *reportScheduler
+	embeds       *dashboard.EmbedSigner
+	sinks        *sinkShipper
+	privacy      *privacyGuard
+	backfill     map[string]*gorm.DB
 }
 
 func NewService(repo repository.Repository, logger logging.Logger, natsClient messaging.MessageBus, monitor *monitoring.Monitor, integrations *integrations.AnalyticsIntegrations) AnalyticsService {
@@ -81,6 +149,11 @@ func NewService(repo repository.Repository, logger logging.Logger, natsClient me
 		metrics:      metrics,
 		health:       health,
 		stopChannel:  make(chan bool, 1),
+		anomalies:    newAnomalyMonitor(),
+		rollups:      newRollupWorker(),
+		reports:      newReportScheduler(),
+		sinks:        newSinkShipper(),
+		privacy:      newPrivacyGuard(),
 	}
 }
 
@@ -184,11 +257,22 @@ func (s *service) RecordEvent(eventData map[string]interface{}) error {
 		return fmt.Errorf("club_id and event_type are required")
 	}
 
+	// Personal data is transformed before the event is stored, exported or
+	// published, and the event is not recorded if that fails
+	clubID := fmt.Sprintf("%v", eventData["club_id"])
+	data, subject, err := s.protect(clubID, eventData)
+	if err != nil {
+		s.metrics.RecordProcessingError("record_event", "privacy_error")
+		s.logger.Error("Failed to apply privacy policy", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return fmt.Errorf("failed to record event: %w", err)
+	}
+
 	// Create analytics event
 	event := &repository.AnalyticsEvent{
-		ClubID:    fmt.Sprintf("%v", eventData["club_id"]),
+		ClubID:    clubID,
 		EventType: eventType,
-		Data:      eventData,
+		Data:      data,
+		SubjectID: subject,
 		Timestamp: time.Now(),
 	}
 
@@ -213,34 +297,26 @@ func (s *service) RecordEvent(eventData map[string]interface{}) error {
 	return nil
 }
 
+// GenerateReport builds a report on the club's last week and stores it
 func (s *service) GenerateReport(clubID string, reportType string) (map[string]interface{}, error) {
 	s.monitoring.RecordBusinessEvent("analytics_reports_generated", clubID)
 
-	// Generate report based on type
-	var reportData map[string]interface{}
-	var title string
-
-	switch reportType {
-	case "usage":
-		reportData = s.generateUsageReport(clubID)
-		title = "Usage Report"
-	case "engagement":
-		reportData = s.generateEngagementReport(clubID)
-		title = "Engagement Report"
-	case "performance":
-		reportData = s.generatePerformanceReport(clubID)
-		title = "Performance Report"
-	default:
-		return nil, fmt.Errorf("unsupported report type: %s", reportType)
+	now := time.Now()
+	doc, err := s.buildReport(clubID, reportType, repository.TimeRange{Start: now.AddDate(0, 0, -defaultReportDays), End: now})
+	if err != nil {
+		s.logger.Error("Failed to generate report", map[string]interface{}{"error": err.Error(), "club_id": clubID, "report_type": reportType})
+		return nil, err
 	}
+	reportData := doc.Data()
 
 	// Store report in database
 	report := &repository.AnalyticsReport{
 		ClubID:      clubID,
 		ReportType:  reportType,
-		Title:       title,
+		Title:       doc.Title,
 		Data:        reportData,
-		GeneratedAt: time.Now(),
+		Status:      repository.ReportStatusCompleted,
+		GeneratedAt: doc.GeneratedAt,
 	}
 
 	if err := s.repo.CreateReport(report); err != nil {
@@ -249,11 +325,12 @@ func (s *service) GenerateReport(clubID string, reportType string) (map[string]i
 	}
 
 	result := map[string]interface{}{
+		"id":           report.ID,
 		"club_id":      clubID,
 		"report_type":  reportType,
-		"title":        title,
+		"title":        doc.Title,
 		"data":         reportData,
-		"generated_at": time.Now(),
+		"generated_at": doc.GeneratedAt,
 	}
 
 	s.logger.Info("Generated report for club", map[string]interface{}{"report_type": reportType, "club_id": clubID})
@@ -262,11 +339,8 @@ func (s *service) GenerateReport(clubID string, reportType string) (map[string]i
 
 func (s *service) ProcessAnalyticsEvent(eventType string, data map[string]interface{}) error {
 	// Process different types of analytics events
+	// Visits and other domain facts arrive through the ingestion pipeline
 	switch eventType {
-	case "member_visit":
-		return s.processMemberVisitEvent(data)
-	case "reciprocal_usage":
-		return s.processReciprocalUsageEvent(data)
 	case "system_metric":
 		return s.processSystemMetricEvent(data)
 	default:
@@ -292,6 +366,16 @@ func (s *service) StartEventProcessor() error {
 		return fmt.Errorf("failed to start event processor: %w", err)
 	}
 
+	if err := s.startIngestion(); err != nil {
+		return fmt.Errorf("failed to start event processor: %w", err)
+	}
+
+	go s.runAnomalyMonitor()
+	go s.runRollups()
+	go s.runReportSchedules()
+	go s.runSinks()
+	go s.runErasures()
+
 	go func() {
 		<-s.stopChannel
 		// Note: NATS subscriptions are managed by the connection lifecycle
@@ -308,6 +392,11 @@ func (s *service) StopEventProcessor() error {
 	default:
 		// Channel already has a value or is closed
 	}
+	s.anomalies.stopOnce.Do(func() { close(s.anomalies.stop) })
+	s.rollups.stopOnce.Do(func() { close(s.rollups.stop) })
+	s.reports.stopOnce.Do(func() { close(s.reports.stop) })
+	s.sinks.stopOnce.Do(func() { close(s.sinks.stop) })
+	s.privacy.stopOnce.Do(func() { close(s.privacy.stop) })
 	s.logger.Info("Analytics event processor stopped", map[string]interface{}{})
 	return nil
 }
@@ -333,6 +422,12 @@ func (s *service) RecordMetric(clubID string, metricName string, value float64,
 		return fmt.Errorf("club_id and metric_name are required")
 	}
 
+	tags, _, err := s.protect(clubID, tags)
+	if err != nil {
+		s.logger.Error("Failed to apply privacy policy", map[string]interface{}{"error": err.Error(), "club_id": clubID})
+		return fmt.Errorf("failed to record metric: %w", err)
+	}
+
 	metric := &repository.AnalyticsMetric{
 		ClubID:      clubID,
 		MetricName:  metricName,
@@ -345,6 +440,7 @@ func (s *service) RecordMetric(clubID string, metricName string, value float64,
 		s.logger.Error("Failed to record metric", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
 		return fmt.Errorf("failed to record metric: %w", err)
 	}
+	s.scheduleAnomalyCheck(clubID, metricName)
 
 	s.logger.Info("Recorded metric for club", map[string]interface{}{"club_id": clubID, "metric_name": metricName, "value": value})
 	return nil
@@ -384,6 +480,26 @@ func (s *service) GetEvents(clubID string, timeRange string) ([]map[string]inter
 	return result, nil
 }
 
+func (s *service) GetPredictiveAnalytics(clubID string, metricName string, forecastDays int) (map[string]interface{}, error) {
+	start := time.Now()
+	s.monitoring.RecordBusinessEvent("analytics_predictions_requests", clubID)
+
+	if clubID == "" || metricName == "" {
+		return nil, fmt.Errorf("club_id and metric_name are required")
+	}
+
+	predictions, err := s.repo.GetPredictiveAnalytics(clubID, metricName, forecastDays)
+	if err != nil {
+		s.metrics.RecordProcessingError("predictive_analytics", "forecast_error")
+		s.logger.Error("Failed to get predictive analytics", map[string]interface{}{"error": err.Error(), "club_id": clubID, "metric_name": metricName})
+		return nil, fmt.Errorf("failed to get predictive analytics: %w", err)
+	}
+
+	s.metrics.RecordProcessingDuration("predictive_analytics", "success", time.Since(start))
+	s.logger.Info("Forecast metric for club", map[string]interface{}{"club_id": clubID, "metric_name": metricName, "forecast_days": forecastDays})
+	return predictions, nil
+}
+
 func (s *service) CleanupOldData(days int) error {
 	s.monitoring.RecordBusinessEvent("analytics_cleanup_operations", "system")
 
@@ -391,15 +507,19 @@ func (s *service) CleanupOldData(days int) error {
 		return fmt.Errorf("days must be greater than 0")
 	}
 
-	// Calculate cutoff time
-	cutoffTime := time.Now().AddDate(0, 0, -days)
+	// Raw rows use the given horizon; rollups keep the stored policy's tiers
+	policy, err := s.repo.GetRetentionPolicy()
+	if err != nil {
+		return fmt.Errorf("failed to cleanup old data: %w", err)
+	}
+	policy.RawDays = days
 
-	if err := s.repo.CleanupOldEvents(cutoffTime); err != nil {
+	if err := s.applyRetention(policy); err != nil {
 		s.logger.Error("Failed to cleanup old data", map[string]interface{}{"error": err.Error(), "days": days})
 		return fmt.Errorf("failed to cleanup old data: %w", err)
 	}
 
-	s.logger.Info("Cleaned up old data", map[string]interface{}{"days": days, "cutoff_time": cutoffTime})
+	s.logger.Info("Cleaned up old data", map[string]interface{}{"days": days})
 	return nil
 }
 
@@ -411,6 +531,7 @@ func (s *service) GetSystemHealth() map[string]interface{} {
 			"database":    s.repo.IsHealthy(),
 			"nats":        s.natsClient.HealthCheck(context.Background()) == nil,
 			"event_processor": len(s.stopChannel) == 0, // Running if stop channel is empty
+			"export_sinks":    s.sinksHealthy(),
 		},
 	}
 
@@ -433,72 +554,6 @@ func (s *service) GetSystemHealth() map[string]interface{} {
 	return health
 }
 
-func (s *service) ExportData(exportType string, data interface{}) error {
-	s.monitoring.RecordBusinessEvent("analytics_data_exports", "system")
-
-	if s.integrations == nil {
-		return fmt.Errorf("integrations not configured")
-	}
-
-	if err := s.integrations.ExportData(context.Background(), data, exportType); err != nil {
-		s.logger.Error("Failed to export data", map[string]interface{}{"error": err.Error(), "export_type": exportType})
-		return fmt.Errorf("failed to export data: %w", err)
-	}
-
-	s.logger.Info("Data exported successfully", map[string]interface{}{"export_type": exportType})
-	return nil
-}
-
-func (s *service) CreateDashboard(clubID string) error {
-	s.monitoring.RecordBusinessEvent("analytics_dashboard_creations", clubID)
-
-	if s.integrations == nil {
-		return fmt.Errorf("integrations not configured")
-	}
-
-	// Create dashboard configuration
-	dashboardConfig := map[string]interface{}{
-		"title":   fmt.Sprintf("Analytics Dashboard - Club %s", clubID),
-		"club_id": clubID,
-		"panels": []map[string]interface{}{
-			{
-				"title": "Event Count",
-				"type":  "stat",
-				"query": fmt.Sprintf("analytics_events_total{club_id=\"%s\"}", clubID),
-			},
-			{
-				"title": "Event Rate",
-				"type":  "graph",
-				"query": fmt.Sprintf("rate(analytics_events_total{club_id=\"%s\"}[5m])", clubID),
-			},
-		},
-	}
-
-	if err := s.integrations.CreateDashboard(context.Background(), dashboardConfig); err != nil {
-		s.logger.Error("Failed to create dashboard", map[string]interface{}{"error": err.Error(), "club_id": clubID})
-		return fmt.Errorf("failed to create dashboard: %w", err)
-	}
-
-	s.logger.Info("Dashboard created successfully", map[string]interface{}{"club_id": clubID})
-	return nil
-}
-
-func (s *service) SendMetricsToExternal(metrics map[string]interface{}) error {
-	s.monitoring.RecordBusinessEvent("analytics_external_metrics", "system")
-
-	if s.integrations == nil {
-		return fmt.Errorf("integrations not configured")
-	}
-
-	if err := s.integrations.SendMetrics(context.Background(), metrics); err != nil {
-		s.logger.Error("Failed to send metrics to external systems", map[string]interface{}{"error": err.Error()})
-		return fmt.Errorf("failed to send metrics: %w", err)
-	}
-
-	s.logger.Info("Metrics sent to external systems", map[string]interface{}{"metric_count": len(metrics)})
-	return nil
-}
-
 func (s *service) GetHealthChecker() *analyticsmonitoring.HealthChecker {
 	return s.health
 }
@@ -510,27 +565,14 @@ func (s *service) GetMonitoringMetrics() *analyticsmonitoring.AnalyticsMetrics {
 // Private helper methods
 
 func (s *service) parseTimeRange(timeRange string) (*repository.TimeRange, error) {
-	now := time.Now()
-	var start, end time.Time
-
-	switch timeRange {
-	case "1h":
-		start = now.Add(-time.Hour)
-		end = now
-	case "24h":
-		start = now.Add(-24 * time.Hour)
-		end = now
-	case "7d":
-		start = now.Add(-7 * 24 * time.Hour)
-		end = now
-	case "30d":
-		start = now.Add(-30 * 24 * time.Hour)
-		end = now
-	default:
+	// Any span a query bucket can have, such as 90m, 24h, 2w, 3mo or 1y
+	span, err := rollup.ParseBucket(timeRange)
+	if err != nil {
 		return nil, fmt.Errorf("unsupported time range: %s", timeRange)
 	}
 
-	return &repository.TimeRange{Start: start, End: end}, nil
+	now := time.Now()
+	return &repository.TimeRange{Start: span.Unit.Add(now, -span.Size), End: now}, nil
 }
 
 func (s *service) publishEvent(event *repository.AnalyticsEvent) error {
@@ -543,50 +585,17 @@ func (s *service) publishEvent(event *repository.AnalyticsEvent) error {
 	return s.natsClient.Publish(context.Background(), subject, data)
 }
 
-func (s *service) generateUsageReport(clubID string) map[string]interface{} {
-	// Mock usage report data
-	return map[string]interface{}{
-		"total_visits":    150,
-		"unique_visitors": 85,
-		"peak_hours":      []string{"12:00-13:00", "18:00-19:00"},
-		"popular_areas":   []string{"gym", "restaurant", "pool"},
-	}
-}
-
-func (s *service) generateEngagementReport(clubID string) map[string]interface{} {
-	// Mock engagement report data
-	return map[string]interface{}{
-		"average_session_duration": "45 minutes",
-		"return_visit_rate":        0.65,
-		"popular_activities":       []string{"fitness", "dining", "events"},
-		"member_satisfaction":      4.2,
-	}
-}
-
-func (s *service) generatePerformanceReport(clubID string) map[string]interface{} {
-	// Mock performance report data
-	return map[string]interface{}{
-		"response_time_avg": "150ms",
-		"uptime":            0.995,
-		"error_rate":        0.002,
-		"throughput":        "1200 req/min",
-	}
-}
-
-func (s *service) processMemberVisitEvent(data map[string]interface{}) error {
-	// Process member visit event
-	s.logger.Info("Processing member visit event", map[string]interface{}{"data": data})
-	return nil
-}
-
-func (s *service) processReciprocalUsageEvent(data map[string]interface{}) error {
-	// Process reciprocal usage event
-	s.logger.Info("Processing reciprocal usage event", map[string]interface{}{"data": data})
-	return nil
-}
-
 func (s *service) processSystemMetricEvent(data map[string]interface{}) error {
-	// Process system metric event
+	// Metrics arriving on the bus are stored like API writes, which also queues
+	// them for anomaly detection
 	s.logger.Info("Processing system metric event", map[string]interface{}{"data": data})
-	return nil
+
+	clubID, _ := data["club_id"].(string)
+	metricName, _ := data["metric_name"].(string)
+	value, ok := data["value"].(float64)
+	if !ok {
+		return fmt.Errorf("system metric event requires a numeric value")
+	}
+	tags, _ := data["tags"].(map[string]interface{})
+	return s.RecordMetric(clubID, metricName, value, tags)
 }
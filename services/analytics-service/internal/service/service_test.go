@@ -5,16 +5,25 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 
 	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/messaging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	"reciprocal-clubs-backend/services/analytics-service/internal/anomaly"
+	"reciprocal-clubs-backend/services/analytics-service/internal/cohort"
+	"reciprocal-clubs-backend/services/analytics-service/internal/dashboard"
 	"reciprocal-clubs-backend/services/analytics-service/internal/integrations"
+	"reciprocal-clubs-backend/services/analytics-service/internal/models"
+	"reciprocal-clubs-backend/services/analytics-service/internal/network"
+	"reciprocal-clubs-backend/services/analytics-service/internal/privacy"
 	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+	"reciprocal-clubs-backend/services/analytics-service/internal/sink"
 )
 
 // Mock implementations
@@ -93,6 +102,146 @@ func (m *MockRepository) GetAnomalyDetection(clubID string, metricName string, t
 	return args.Get(0).(map[string]interface{}), args.Error(1)
 }
 
+func (m *MockRepository) GetAnomalySetting(clubID string, metricName string) (*repository.AnomalySetting, error) {
+	args := m.Called(clubID, metricName)
+	return args.Get(0).(*repository.AnomalySetting), args.Error(1)
+}
+
+func (m *MockRepository) SaveAnomalySetting(setting *repository.AnomalySetting) error {
+	args := m.Called(setting)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DetectAnomalies(clubID string, metricName string, timeRange repository.TimeRange, setting *repository.AnomalySetting) (*anomaly.Result, error) {
+	args := m.Called(clubID, metricName, timeRange, setting)
+	return args.Get(0).(*anomaly.Result), args.Error(1)
+}
+
+func (m *MockRepository) GetOpenAnomalyEpisode(clubID string, metricName string) (*repository.AnomalyEpisode, error) {
+	args := m.Called(clubID, metricName)
+	return args.Get(0).(*repository.AnomalyEpisode), args.Error(1)
+}
+
+func (m *MockRepository) SaveAnomalyEpisode(episode *repository.AnomalyEpisode) error {
+	args := m.Called(episode)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetExpiredAnomalyEpisodes(before time.Time) ([]*repository.AnomalyEpisode, error) {
+	args := m.Called(before)
+	return args.Get(0).([]*repository.AnomalyEpisode), args.Error(1)
+}
+
+func (m *MockRepository) ListAnomalyEpisodes(clubID string, metricName string, status string, limit int) ([]*repository.AnomalyEpisode, error) {
+	args := m.Called(clubID, metricName, status, limit)
+	return args.Get(0).([]*repository.AnomalyEpisode), args.Error(1)
+}
+
+func (m *MockRepository) IngestEvent(message *repository.IngestedMessage, fact repository.Fact) (bool, error) {
+	args := m.Called(message, fact)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) BackfillFacts(source string, sourceDB *gorm.DB, since time.Time) (int, error) {
+	args := m.Called(source, sourceDB, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) RollUp(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) QueryRollups(query *repository.RollupQuery) (*repository.RollupResult, error) {
+	args := m.Called(query)
+	return args.Get(0).(*repository.RollupResult), args.Error(1)
+}
+
+func (m *MockRepository) GetRetentionPolicy() (*repository.RetentionPolicy, error) {
+	args := m.Called()
+	return args.Get(0).(*repository.RetentionPolicy), args.Error(1)
+}
+
+func (m *MockRepository) SaveRetentionPolicy(policy *repository.RetentionPolicy) error {
+	args := m.Called(policy)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ApplyRetention(policy *repository.RetentionPolicy, now time.Time) (map[string]int64, error) {
+	args := m.Called(policy, now)
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+func (m *MockRepository) NetworkAnalytics(clubID uint, timeRange repository.TimeRange) (*network.Report, error) {
+	args := m.Called(clubID, timeRange)
+	return args.Get(0).(*network.Report), args.Error(1)
+}
+
+func (m *MockRepository) CohortAnalysis(clubID uint, timeRange repository.TimeRange, options cohort.Options) (*cohort.Report, error) {
+	args := m.Called(clubID, timeRange, options)
+	return args.Get(0).(*cohort.Report), args.Error(1)
+}
+
+func (m *MockRepository) CreateReportSchedule(schedule *repository.ReportSchedule) error {
+	args := m.Called(schedule)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetReportSchedule(id uint) (*repository.ReportSchedule, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.ReportSchedule), args.Error(1)
+}
+
+func (m *MockRepository) ListReportSchedules(clubID string) ([]*repository.ReportSchedule, error) {
+	args := m.Called(clubID)
+	return args.Get(0).([]*repository.ReportSchedule), args.Error(1)
+}
+
+func (m *MockRepository) UpdateReportSchedule(schedule *repository.ReportSchedule) error {
+	args := m.Called(schedule)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteReportSchedule(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DueReportSchedules(now time.Time, limit int) ([]*repository.ReportSchedule, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]*repository.ReportSchedule), args.Error(1)
+}
+
+func (m *MockRepository) ClaimReportSchedule(id uint, dueAt time.Time, nextRunAt *time.Time) (bool, error) {
+	args := m.Called(id, dueAt, nextRunAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) RecordReportScheduleRun(id uint, reportID *uint, status string, runErr error) error {
+	args := m.Called(id, reportID, status, runErr)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetReport(id uint) (*repository.AnalyticsReport, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.AnalyticsReport), args.Error(1)
+}
+
+func (m *MockRepository) UpdateReport(report *repository.AnalyticsReport) error {
+	args := m.Called(report)
+	return args.Error(0)
+}
+
+func (m *MockRepository) EngagementStats(clubID uint, timeRange repository.TimeRange) (*repository.EngagementStats, error) {
+	args := m.Called(clubID, timeRange)
+	return args.Get(0).(*repository.EngagementStats), args.Error(1)
+}
+
+func (m *MockRepository) MetricSummaries(clubID string, timeRange repository.TimeRange) ([]repository.MetricSummary, error) {
+	args := m.Called(clubID, timeRange)
+	return args.Get(0).([]repository.MetricSummary), args.Error(1)
+}
+
 func (m *MockRepository) CreateDashboard(dashboard *repository.Dashboard) error {
 	args := m.Called(dashboard)
 	return args.Error(0)
@@ -118,6 +267,81 @@ func (m *MockRepository) ListDashboards(clubID string, limit, offset int) ([]*re
 	return args.Get(0).([]*repository.Dashboard), args.Error(1)
 }
 
+func (m *MockRepository) OutboxBatch(afterID uint, kinds []string, settled time.Time, limit int) ([]*repository.OutboxRecord, error) {
+	args := m.Called(afterID, kinds, settled, limit)
+	return args.Get(0).([]*repository.OutboxRecord), args.Error(1)
+}
+
+func (m *MockRepository) OutboxLag(afterID uint, kinds []string) (int64, *time.Time, error) {
+	args := m.Called(afterID, kinds)
+	return args.Get(0).(int64), args.Get(1).(*time.Time), args.Error(2)
+}
+
+func (m *MockRepository) AcquireSinkLease(sinkName, owner string, now, until time.Time) (*repository.SinkCheckpoint, error) {
+	args := m.Called(sinkName, owner, now, until)
+	return args.Get(0).(*repository.SinkCheckpoint), args.Error(1)
+}
+
+func (m *MockRepository) AdvanceSinkCheckpoint(sinkName, owner string, position uint, shipped int, now time.Time) (bool, error) {
+	args := m.Called(sinkName, owner, position, shipped, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) RecordSinkFailure(sinkName string, failure error, now time.Time) error {
+	args := m.Called(sinkName, failure, now)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListSinkCheckpoints() ([]*repository.SinkCheckpoint, error) {
+	args := m.Called()
+	return args.Get(0).([]*repository.SinkCheckpoint), args.Error(1)
+}
+
+func (m *MockRepository) PruneOutbox(sinks map[string][]string, horizon time.Time) (int64, error) {
+	args := m.Called(sinks, horizon)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) GetPrivacySettings(clubID string) (*repository.PrivacySettings, error) {
+	args := m.Called(clubID)
+	return args.Get(0).(*repository.PrivacySettings), args.Error(1)
+}
+
+func (m *MockRepository) EnsurePrivacySettings(settings *repository.PrivacySettings) (*repository.PrivacySettings, error) {
+	args := m.Called(settings)
+	return args.Get(0).(*repository.PrivacySettings), args.Error(1)
+}
+
+func (m *MockRepository) SavePrivacyPolicy(clubID string, policy *privacy.Policy) error {
+	args := m.Called(clubID, policy)
+	return args.Error(0)
+}
+
+func (m *MockRepository) EraseSubject(request *repository.ErasureRequest, memberID uint) error {
+	args := m.Called(request, memberID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetErasureRequest(id uint) (*repository.ErasureRequest, error) {
+	args := m.Called(id)
+	return args.Get(0).(*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockRepository) ListErasureRequests(clubID string, limit int) ([]*repository.ErasureRequest, error) {
+	args := m.Called(clubID, limit)
+	return args.Get(0).([]*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockRepository) PendingErasureRequests(before time.Time, limit int) ([]*repository.ErasureRequest, error) {
+	args := m.Called(before, limit)
+	return args.Get(0).([]*repository.ErasureRequest), args.Error(1)
+}
+
+func (m *MockRepository) UpdateErasureRequest(request *repository.ErasureRequest) error {
+	args := m.Called(request)
+	return args.Error(0)
+}
+
 func (m *MockRepository) ExportEvents(clubID string, timeRange repository.TimeRange, format string) ([]byte, error) {
 	args := m.Called(clubID, timeRange, format)
 	return args.Get(0).([]byte), args.Error(1)
@@ -134,17 +358,18 @@ func (m *MockRepository) ExportReports(clubID string, format string) ([]byte, er
 }
 
 // Mock example methods (can be removed in production)
-func (m *MockRepository) CreateExample(example interface{}) error {
+func (m *MockRepository) CreateExample(example *models.Example) error {
 	args := m.Called(example)
 	return args.Error(0)
 }
 
-func (m *MockRepository) GetExampleByID(id uint) (interface{}, error) {
+func (m *MockRepository) GetExampleByID(id uint) (*models.Example, error) {
 	args := m.Called(id)
-	return args.Get(0), args.Error(1)
+	example, _ := args.Get(0).(*models.Example)
+	return example, args.Error(1)
 }
 
-func (m *MockRepository) UpdateExample(example interface{}) error {
+func (m *MockRepository) UpdateExample(example *models.Example) error {
 	args := m.Called(example)
 	return args.Error(0)
 }
@@ -154,9 +379,9 @@ func (m *MockRepository) DeleteExample(id uint) error {
 	return args.Error(0)
 }
 
-func (m *MockRepository) ListExamples(limit, offset int) ([]interface{}, error) {
+func (m *MockRepository) ListExamples(limit, offset int) ([]*models.Example, error) {
 	args := m.Called(limit, offset)
-	return args.Get(0).([]interface{}), args.Error(1)
+	return args.Get(0).([]*models.Example), args.Error(1)
 }
 
 type MockMessageBus struct {
@@ -168,7 +393,12 @@ func (m *MockMessageBus) HealthCheck(ctx context.Context) error {
 	return args.Error(0)
 }
 
-func (m *MockMessageBus) Publish(ctx context.Context, subject string, data []byte) error {
+func (m *MockMessageBus) Publish(ctx context.Context, subject string, data interface{}) error {
+	args := m.Called(ctx, subject, data)
+	return args.Error(0)
+}
+
+func (m *MockMessageBus) PublishSync(ctx context.Context, subject string, data interface{}) error {
 	args := m.Called(ctx, subject, data)
 	return args.Error(0)
 }
@@ -178,6 +408,16 @@ func (m *MockMessageBus) Subscribe(subject string, handler messaging.MessageHand
 	return args.Error(0)
 }
 
+func (m *MockMessageBus) SubscribeQueue(subject, queue string, handler messaging.MessageHandler) error {
+	args := m.Called(subject, queue, handler)
+	return args.Error(0)
+}
+
+func (m *MockMessageBus) Request(ctx context.Context, subject string, data interface{}, response interface{}) error {
+	args := m.Called(ctx, subject, data, response)
+	return args.Error(0)
+}
+
 func (m *MockMessageBus) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -198,9 +438,12 @@ func (suite *ServiceTestSuite) SetupTest() {
 	suite.mockNATS = new(MockMessageBus)
 	loggingConfig := &config.LoggingConfig{Level: "info", Format: "console", Output: "stdout"}
 	suite.logger = logging.NewLogger(loggingConfig, "analytics-service-test")
-	suite.monitor = monitoring.NewMonitor(monitoring.Config{ServiceName: "analytics-service-test"})
-	suite.integrations = integrations.NewAnalyticsIntegrations(integrations.Config{}, suite.logger)
+	suite.monitor = monitoring.NewMonitor(&config.MonitoringConfig{}, suite.logger, "analytics-service-test", "test")
+	suite.integrations = integrations.NewAnalyticsIntegrations(&integrations.IntegrationsConfig{}, suite.logger)
 
+	// NewService registers its metrics with the default registry, so each
+	// test needs a fresh one
+	prometheus.DefaultRegisterer = prometheus.NewRegistry()
 	suite.service = NewService(
 		suite.mockRepo,
 		suite.logger,
@@ -217,8 +460,8 @@ func (suite *ServiceTestSuite) TearDownTest() {
 
 func (suite *ServiceTestSuite) TestIsReady() {
 	// Test case: all dependencies healthy
-	suite.mockRepo.On("IsHealthy").Return(true)
-	suite.mockNATS.On("HealthCheck", mock.AnythingOfType("*context.emptyCtx")).Return(nil)
+	suite.mockRepo.On("IsHealthy").Return(true).Once()
+	suite.mockNATS.On("HealthCheck", mock.Anything).Return(nil)
 
 	ready := suite.service.IsReady()
 	assert.True(suite.T(), ready)
@@ -235,11 +478,17 @@ func (suite *ServiceTestSuite) TestRecordEvent() {
 		"club_id":    "test-club-1",
 		"event_type": "member_visit",
 		"user_id":    "user-123",
+		"email":      "member@example.com",
 	}
+	settings := &repository.PrivacySettings{ClubID: "test-club-1", Salt: "salt"}
 
 	// Setup expectations
-	suite.mockRepo.On("RecordEvent", mock.AnythingOfType("*repository.AnalyticsEvent")).Return(nil)
-	suite.mockNATS.On("Publish", mock.AnythingOfType("*context.emptyCtx"), "analytics.events.member_visit", mock.AnythingOfType("[]uint8")).Return(nil)
+	suite.mockRepo.On("EnsurePrivacySettings", mock.AnythingOfType("*repository.PrivacySettings")).Return(settings, nil).Once()
+	suite.mockRepo.On("RecordEvent", mock.MatchedBy(func(event *repository.AnalyticsEvent) bool {
+		_, hasEmail := event.Data["email"]
+		return !hasEmail && event.Data["user_id"] == privacy.Pseudonym([]byte("salt"), "user-123")
+	})).Return(nil)
+	suite.mockNATS.On("Publish", mock.Anything, "analytics.events.member_visit", mock.AnythingOfType("[]uint8")).Return(nil)
 
 	err := suite.service.RecordEvent(eventData)
 	assert.NoError(suite.T(), err)
@@ -319,10 +568,14 @@ func (suite *ServiceTestSuite) TestGetReports() {
 }
 
 func (suite *ServiceTestSuite) TestGenerateReport() {
-	clubID := "test-club-1"
+	clubID := "1"
 	reportType := "usage"
 
 	// Setup expectations
+	suite.mockRepo.On("NetworkAnalytics", uint(1), mock.AnythingOfType("repository.TimeRange")).Return(&network.Report{
+		Clubs: []network.ClubReport{{ClubID: 1, VisitsMade: 3}},
+		Flows: []network.Flow{{HomeClubID: 1, VisitingClubID: 2, Visits: 3, Members: 2, Requested: 4}},
+	}, nil)
 	suite.mockRepo.On("CreateReport", mock.AnythingOfType("*repository.AnalyticsReport")).Return(nil)
 
 	result, err := suite.service.GenerateReport(clubID, reportType)
@@ -331,6 +584,12 @@ func (suite *ServiceTestSuite) TestGenerateReport() {
 	assert.Equal(suite.T(), reportType, result["report_type"])
 	assert.Contains(suite.T(), result, "data")
 	assert.Contains(suite.T(), result, "generated_at")
+	assert.Equal(suite.T(), 3, result["data"].(map[string]interface{})["visits_made"])
+}
+
+func (suite *ServiceTestSuite) TestGenerateReportNonNumericClub() {
+	_, err := suite.service.GenerateReport("test-club-1", "engagement")
+	assert.Error(suite.T(), err)
 }
 
 func (suite *ServiceTestSuite) TestGenerateReportUnsupportedType() {
@@ -342,6 +601,219 @@ func (suite *ServiceTestSuite) TestGenerateReportUnsupportedType() {
 	assert.Contains(suite.T(), err.Error(), "unsupported report type")
 }
 
+func (suite *ServiceTestSuite) TestRenderDashboard() {
+	count, sum := int64(4), 80.0
+	d := &repository.Dashboard{ID: 3, ClubID: "1", Name: "Club", Panels: []dashboard.Panel{
+		{ID: "visits", Title: "Visits", Visualization: dashboard.VisualizationStat, Query: dashboard.Query{Source: dashboard.SourceEvent, Name: "visit"}},
+		{ID: "latency", Title: "Latency", Query: dashboard.Query{Name: "latency"}},
+	}}
+	suite.Require().NoError(d.Validate())
+
+	suite.mockRepo.On("GetDashboard", uint(3)).Return(d, nil)
+	suite.mockRepo.On("QueryRollups", mock.MatchedBy(func(q *repository.RollupQuery) bool { return q.Name == "visit" })).Return(&repository.RollupResult{
+		Series: []repository.RollupSeries{{Points: []repository.RollupPoint{{Count: &count, Sum: &sum}, {}}}},
+	}, nil)
+	suite.mockRepo.On("QueryRollups", mock.MatchedBy(func(q *repository.RollupQuery) bool { return q.Name == "latency" })).Return((*repository.RollupResult)(nil), assert.AnError)
+
+	rendered, err := suite.service.RenderDashboard(3, "1", dashboard.TimeRange{From: "now-7d"})
+	suite.Require().NoError(err)
+	suite.Require().Len(rendered.Panels, 2)
+	assert.Equal(suite.T(), 4.0, *rendered.Panels[0].Series[0].Value)
+	assert.Equal(suite.T(), "1h", rendered.Panels[0].Interval)
+	assert.NotEmpty(suite.T(), rendered.Panels[1].Error)
+
+	_, err = suite.service.RenderDashboard(3, "2", dashboard.TimeRange{})
+	assert.ErrorIs(suite.T(), err, repository.ErrNotFound)
+}
+
+func (suite *ServiceTestSuite) TestDashboardEmbedTokens() {
+	d := &repository.Dashboard{ID: 3, ClubID: "1", Name: "Club", IsPublic: true}
+	suite.mockRepo.On("GetDashboard", uint(3)).Return(d, nil)
+
+	_, _, err := suite.service.IssueEmbedToken(3, "1", 0)
+	assert.ErrorIs(suite.T(), err, ErrEmbedNotConfigured)
+
+	suite.service.ConfigureDashboards(DashboardConfig{EmbedKey: []byte("secret")})
+	_, _, err = suite.service.IssueEmbedToken(3, "2", 0)
+	assert.ErrorIs(suite.T(), err, ErrDashboardNotOwned)
+
+	token, expiresAt, err := suite.service.IssueEmbedToken(3, "1", time.Hour)
+	suite.Require().NoError(err)
+	assert.WithinDuration(suite.T(), time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	rendered, err := suite.service.RenderEmbeddedDashboard(token, dashboard.TimeRange{})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), uint(3), rendered.DashboardID)
+
+	suite.mockRepo.On("UpdateDashboard", d).Return(nil)
+	suite.Require().NoError(suite.service.RevokeEmbedTokens(3, "1"))
+	_, err = suite.service.RenderEmbeddedDashboard(token, dashboard.TimeRange{})
+	assert.ErrorIs(suite.T(), err, dashboard.ErrEmbedToken)
+}
+
+// fakeSink records the batches written to it and fails while err is set
+type fakeSink struct {
+	name    string
+	kinds   []string
+	err     error
+	batches [][]sink.Record
+}
+
+func (f *fakeSink) Name() string    { return f.name }
+func (f *fakeSink) Kinds() []string { return f.kinds }
+func (f *fakeSink) Check(ctx context.Context) error {
+	return f.err
+}
+func (f *fakeSink) Write(ctx context.Context, records []sink.Record) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.batches = append(f.batches, records)
+	return nil
+}
+
+type fakeEraser struct {
+	fakeSink
+	erased []string
+}
+
+func (f *fakeEraser) Erase(ctx context.Context, keys []string) error {
+	f.erased = append(f.erased, keys...)
+	return nil
+}
+
+func (suite *ServiceTestSuite) TestShipBatch() {
+	target := &fakeSink{name: "file", kinds: sink.Kinds}
+	svc := suite.service.(*service)
+	owner := svc.sinks.owner
+
+	suite.mockRepo.On("AcquireSinkLease", "file", owner, mock.Anything, mock.Anything).Return(&repository.SinkCheckpoint{Sink: "file", Position: 4}, nil)
+	suite.mockRepo.On("OutboxBatch", uint(4), sink.Kinds, mock.Anything, 2).Return([]*repository.OutboxRecord{
+		{ID: 5, Kind: sink.KindEvent, SourceID: 10, ClubID: "1", Name: "visit"},
+		{ID: 6, Kind: sink.KindMetric, SourceID: 3, ClubID: "1", Name: "visitors", Value: 2},
+	}, nil)
+
+	// A failed batch leaves the checkpoint where it was
+	target.err = assert.AnError
+	suite.mockRepo.On("RecordSinkFailure", "file", assert.AnError, mock.Anything).Return(nil).Once()
+	shipped, err := svc.shipBatch(target, 2)
+	assert.ErrorIs(suite.T(), err, assert.AnError)
+	assert.Zero(suite.T(), shipped)
+
+	target.err = nil
+	suite.mockRepo.On("AdvanceSinkCheckpoint", "file", owner, uint(6), 2, mock.Anything).Return(true, nil).Once()
+	shipped, err = svc.shipBatch(target, 2)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 2, shipped)
+	suite.Require().Len(target.batches, 1)
+	assert.Equal(suite.T(), "event-10", target.batches[0][0].Key())
+	assert.Equal(suite.T(), "metric-3", target.batches[0][1].Key())
+}
+
+func (suite *ServiceTestSuite) TestGetSinkStatus() {
+	statuses, err := suite.service.GetSinkStatus()
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), statuses)
+
+	datadog := &fakeSink{name: "datadog", kinds: []string{sink.KindMetric}}
+	file := &fakeSink{name: "file", kinds: sink.Kinds}
+	suite.service.ConfigureSinks(SinkConfig{Sinks: []sink.Sink{datadog, file, &fakeSink{name: "file"}}})
+
+	oldest := time.Now().Add(-10 * time.Minute)
+	suite.mockRepo.On("ListSinkCheckpoints").Return([]*repository.SinkCheckpoint{{Sink: "datadog", Position: 9, ConsecutiveFailures: 3}}, nil)
+	suite.mockRepo.On("OutboxLag", uint(9), []string{sink.KindMetric}).Return(int64(5), &oldest, nil)
+	suite.mockRepo.On("OutboxLag", uint(0), sink.Kinds).Return(int64(0), (*time.Time)(nil), nil)
+
+	statuses, err = suite.service.GetSinkStatus()
+	suite.Require().NoError(err)
+	suite.Require().Len(statuses, 2)
+	assert.Equal(suite.T(), SinkStatusFailing, statuses[0].Status)
+	assert.Equal(suite.T(), int64(5), statuses[0].Pending)
+	assert.InDelta(suite.T(), 600, statuses[0].LagSeconds, 5)
+	assert.Equal(suite.T(), SinkStatusHealthy, statuses[1].Status)
+
+	file.err = assert.AnError
+	statuses, err = suite.service.GetSinkStatus()
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), SinkStatusFailing, statuses[1].Status)
+	assert.NotEmpty(suite.T(), statuses[1].CheckError)
+}
+
+func (suite *ServiceTestSuite) TestPrivacyPolicy() {
+	settings := &repository.PrivacySettings{ClubID: "1", Salt: "salt"}
+	suite.mockRepo.On("EnsurePrivacySettings", mock.AnythingOfType("*repository.PrivacySettings")).Return(settings, nil).Once()
+
+	policy, err := suite.service.GetPrivacyPolicy("1")
+	suite.Require().NoError(err)
+	assert.True(suite.T(), policy.Default)
+	assert.Equal(suite.T(), defaultMinGroupSize, policy.MinGroupSize)
+
+	// Invalid policies are rejected before anything is saved
+	err = suite.service.UpdatePrivacyPolicy("1", &privacy.Policy{Rules: []privacy.Rule{{Field: "club_id", Action: privacy.ActionDrop}}})
+	assert.Error(suite.T(), err)
+
+	custom := &privacy.Policy{Rules: []privacy.Rule{{Field: "notes", Action: privacy.ActionKeep}}}
+	suite.mockRepo.On("SavePrivacyPolicy", "1", custom).Return(nil).Once()
+	suite.Require().NoError(suite.service.UpdatePrivacyPolicy("1", custom))
+
+	// Saving drops the cached settings so the new policy applies straight away
+	suite.mockRepo.On("EnsurePrivacySettings", mock.AnythingOfType("*repository.PrivacySettings")).Return(&repository.PrivacySettings{ClubID: "1", Salt: "salt", Policy: custom}, nil).Once()
+	policy, err = suite.service.GetPrivacyPolicy("1")
+	suite.Require().NoError(err)
+	assert.False(suite.T(), policy.Default)
+	assert.Equal(suite.T(), *custom, policy.Policy)
+}
+
+func (suite *ServiceTestSuite) TestRequestErasure() {
+	svc := suite.service.(*service)
+	eraser := &fakeEraser{fakeSink: fakeSink{name: "elasticsearch", kinds: sink.Kinds}}
+	svc.ConfigureSinks(SinkConfig{Sinks: []sink.Sink{eraser, &fakeSink{name: "file", kinds: sink.Kinds}}})
+
+	suite.mockRepo.On("EnsurePrivacySettings", mock.AnythingOfType("*repository.PrivacySettings")).Return(&repository.PrivacySettings{ClubID: "1", Salt: "salt"}, nil).Once()
+	suite.mockRepo.On("EraseSubject", mock.MatchedBy(func(request *repository.ErasureRequest) bool {
+		return request.SubjectID == privacy.Pseudonym([]byte("salt"), "42")
+	}), uint(42)).Run(func(args mock.Arguments) {
+		request := args.Get(0).(*repository.ErasureRequest)
+		request.ID = 7
+		request.SinkKeys = []string{"event-1", "event-2"}
+	}).Return(nil).Once()
+
+	request, err := suite.service.RequestErasure("1", "42")
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), repository.ErasureStatusPending, request.Status)
+	assert.Equal(suite.T(), map[string]string{"file": ErasureSinkUnsupported}, request.SinkResults)
+
+	// The worker erases the events from the sinks that can and completes it
+	suite.mockRepo.On("PendingErasureRequests", mock.Anything, erasureBatchSize).Return([]*repository.ErasureRequest{request}, nil).Once()
+	suite.mockRepo.On("UpdateErasureRequest", request).Return(nil).Once()
+	svc.processErasures()
+
+	assert.Equal(suite.T(), []string{"event-1", "event-2"}, eraser.erased)
+	assert.Equal(suite.T(), repository.ErasureStatusCompleted, request.Status)
+	assert.Equal(suite.T(), ErasureSinkErased, request.SinkResults["elasticsearch"])
+	assert.Empty(suite.T(), request.SinkKeys)
+}
+
+func (suite *ServiceTestSuite) TestGetCohortAnalysis() {
+	start := time.Now().AddDate(-1, 0, 0)
+	report := &cohort.Report{ClubID: 1}
+
+	// The analysis cannot run past now
+	suite.mockRepo.On("CohortAnalysis", uint(1), mock.MatchedBy(func(timeRange repository.TimeRange) bool {
+		return timeRange.Start.Equal(start) && !timeRange.End.After(time.Now())
+	}), cohort.Options{}).Return(report, nil).Once()
+	result, err := suite.service.GetCohortAnalysis(1, repository.TimeRange{Start: start, End: start.AddDate(2, 0, 0)}, cohort.Options{})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), report, result)
+
+	_, err = suite.service.GetCohortAnalysis(0, repository.TimeRange{Start: start, End: time.Now()}, cohort.Options{})
+	assert.Error(suite.T(), err)
+	_, err = suite.service.GetCohortAnalysis(1, repository.TimeRange{Start: time.Now().Add(time.Hour), End: time.Now().Add(2 * time.Hour)}, cohort.Options{})
+	assert.Error(suite.T(), err)
+	_, err = suite.service.GetCohortAnalysis(1, repository.TimeRange{Start: start, End: time.Now()}, cohort.Options{MaxMonths: cohort.MaxMonths + 1})
+	assert.Error(suite.T(), err)
+}
+
 func (suite *ServiceTestSuite) TestRecordMetric() {
 	clubID := "test-club-1"
 	metricName := "visitor_count"
@@ -349,6 +821,7 @@ func (suite *ServiceTestSuite) TestRecordMetric() {
 	tags := map[string]interface{}{"location": "entrance"}
 
 	// Setup expectations
+	suite.mockRepo.On("EnsurePrivacySettings", mock.AnythingOfType("*repository.PrivacySettings")).Return(&repository.PrivacySettings{ClubID: clubID, Salt: "salt"}, nil).Once()
 	suite.mockRepo.On("RecordMetric", mock.AnythingOfType("*repository.AnalyticsMetric")).Return(nil)
 
 	err := suite.service.RecordMetric(clubID, metricName, value, tags)
@@ -407,7 +880,10 @@ func (suite *ServiceTestSuite) TestCleanupOldData() {
 	days := 30
 
 	// Setup expectations
-	suite.mockRepo.On("CleanupOldEvents", mock.AnythingOfType("time.Time")).Return(nil)
+	suite.mockRepo.On("GetRetentionPolicy").Return(repository.DefaultRetentionPolicy(), nil)
+	suite.mockRepo.On("ApplyRetention", mock.MatchedBy(func(policy *repository.RetentionPolicy) bool {
+		return policy.RawDays == days && policy.HourDays == repository.DefaultRetentionPolicy().HourDays
+	}), mock.AnythingOfType("time.Time")).Return(map[string]int64{"raw": 12}, nil)
 
 	err := suite.service.CleanupOldData(days)
 	assert.NoError(suite.T(), err)
@@ -423,8 +899,8 @@ func (suite *ServiceTestSuite) TestCleanupOldDataInvalidDays() {
 
 func (suite *ServiceTestSuite) TestGetSystemHealth() {
 	// Setup expectations
-	suite.mockRepo.On("IsHealthy").Return(true)
-	suite.mockNATS.On("HealthCheck", mock.AnythingOfType("*context.emptyCtx")).Return(nil)
+	suite.mockRepo.On("IsHealthy").Return(true).Once()
+	suite.mockNATS.On("HealthCheck", mock.Anything).Return(nil)
 
 	result := suite.service.GetSystemHealth()
 	assert.Contains(suite.T(), result, "status")
@@ -466,7 +942,11 @@ func (suite *ServiceTestSuite) TestParseTimeRange() {
 		{"24h", true},
 		{"7d", true},
 		{"30d", true},
+		{"90m", true},
+		{"2w", true},
+		{"3mo", true},
 		{"invalid", false},
+		{"0d", false},
 	}
 
 	for _, tc := range testCases {
@@ -509,13 +989,15 @@ func BenchmarkRecordEvent(b *testing.B) {
 	mockNATS := new(MockMessageBus)
 	loggingConfig := &config.LoggingConfig{Level: "error", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-bench")
-	monitor := monitoring.NewMonitor(monitoring.Config{ServiceName: "test"})
-	integrations := integrations.NewAnalyticsIntegrations(integrations.Config{}, logger)
+	monitor := monitoring.NewMonitor(&config.MonitoringConfig{}, logger, "test", "test")
+	integrations := integrations.NewAnalyticsIntegrations(&integrations.IntegrationsConfig{}, logger)
 
+	prometheus.DefaultRegisterer = prometheus.NewRegistry()
 	service := NewService(mockRepo, logger, mockNATS, monitor, integrations)
 
+	mockRepo.On("EnsurePrivacySettings", mock.AnythingOfType("*repository.PrivacySettings")).Return(&repository.PrivacySettings{ClubID: "test-club-1", Salt: "salt"}, nil)
 	mockRepo.On("RecordEvent", mock.AnythingOfType("*repository.AnalyticsEvent")).Return(nil)
-	mockNATS.On("Publish", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Return(nil)
+	mockNATS.On("Publish", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Return(nil)
 
 	eventData := map[string]interface{}{
 		"club_id":    "test-club-1",
@@ -534,9 +1016,10 @@ func BenchmarkGetMetrics(b *testing.B) {
 	mockNATS := new(MockMessageBus)
 	loggingConfig := &config.LoggingConfig{Level: "error", Format: "console", Output: "stdout"}
 	logger := logging.NewLogger(loggingConfig, "analytics-service-bench")
-	monitor := monitoring.NewMonitor(monitoring.Config{ServiceName: "test"})
-	integrations := integrations.NewAnalyticsIntegrations(integrations.Config{}, logger)
+	monitor := monitoring.NewMonitor(&config.MonitoringConfig{}, logger, "test", "test")
+	integrations := integrations.NewAnalyticsIntegrations(&integrations.IntegrationsConfig{}, logger)
 
+	prometheus.DefaultRegisterer = prometheus.NewRegistry()
 	service := NewService(mockRepo, logger, mockNATS, monitor, integrations)
 
 	mockAggregation := map[string]interface{}{"total": 100}
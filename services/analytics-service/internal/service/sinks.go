@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/repository"
+	"reciprocal-clubs-backend/services/analytics-service/internal/sink"
+)
+
+const (
+	sinkPollInterval        = 5 * time.Second
+	sinkLeaseTTL            = 2 * time.Minute
+	sinkWriteTimeout        = 30 * time.Second
+	sinkCheckTimeout        = 5 * time.Second
+	sinkMinBackoff          = time.Second
+	sinkMaxBackoff          = 5 * time.Minute
+	sinkMaintenanceInterval = time.Minute
+	outboxPruneInterval     = 10 * time.Minute
+	defaultSinkBatchSize    = 500
+	defaultOutboxRetention  = 72 * time.Hour
+
+	// Outbox records are shipped once they are this old, so records whose
+	// insert is still committing when a batch is read are not skipped
+	outboxSettleDelay = 5 * time.Second
+
+	// A sink is failing after this many failed batches in a row, and lagging
+	// once its oldest unshipped record is older than sinkLagThreshold
+	sinkFailingAfter = 3
+	sinkLagThreshold = 5 * time.Minute
+)
+
+// Export sink statuses
+const (
+	SinkStatusHealthy = "healthy"
+	SinkStatusLagging = "lagging"
+	SinkStatusFailing = "failing"
+)
+
+// SinkConfig sets the sinks recorded events and metrics are exported to
+type SinkConfig struct {
+	Sinks []sink.Sink
+	// BatchSize is the most records written to a sink at once
+	BatchSize int
+	// Retention is how long records wait in the outbox for a sink that is not
+	// taking them before they are dropped
+	Retention time.Duration
+}
+
+// SinkStatus reports how far behind an export sink is and whether it is
+// reachable
+type SinkStatus struct {
+	Name            string                    `json:"name"`
+	Kinds           []string                  `json:"kinds"`
+	Status          string                    `json:"status"`
+	Pending         int64                     `json:"pending"`
+	OldestPendingAt *time.Time                `json:"oldest_pending_at,omitempty"`
+	LagSeconds      float64                   `json:"lag_seconds"`
+	CheckError      string                    `json:"check_error,omitempty"`
+	Checkpoint      repository.SinkCheckpoint `json:"checkpoint"`
+}
+
+// sinkShipper ships the outbox to each sink from its own goroutine. Workers
+// pull batches at the pace their sink accepts them, so a slow or failing sink
+// never holds up writes or the other sinks; the outbox absorbs the difference
+// up to the retention period.
+type sinkShipper struct {
+	stop      chan struct{}
+	stopOnce  sync.Once
+	sinks     []sink.Sink
+	batchSize int
+	retention time.Duration
+	// owner identifies this replica in sink leases
+	owner string
+}
+
+func newSinkShipper() *sinkShipper {
+	return &sinkShipper{
+		stop:      make(chan struct{}),
+		batchSize: defaultSinkBatchSize,
+		retention: defaultOutboxRetention,
+		owner:     replicaID(),
+	}
+}
+
+// replicaID names this process uniquely among the replicas sharing the database
+func replicaID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "analytics"
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	return host + "-" + hex.EncodeToString(suffix)
+}
+
+// ConfigureSinks sets the export sinks. It must be called before the event
+// processor starts. Sinks whose name is already taken are ignored, since the
+// name keys the sink's checkpoint.
+func (s *service) ConfigureSinks(config SinkConfig) {
+	names := map[string]bool{}
+	s.sinks.sinks = nil
+	for _, target := range config.Sinks {
+		if names[target.Name()] {
+			s.logger.Warn("Ignoring export sink with a duplicate name", map[string]interface{}{"sink": target.Name()})
+			continue
+		}
+		names[target.Name()] = true
+		s.sinks.sinks = append(s.sinks.sinks, target)
+	}
+	if config.BatchSize > 0 {
+		s.sinks.batchSize = config.BatchSize
+	}
+	if config.Retention > 0 {
+		s.sinks.retention = config.Retention
+	}
+}
+
+func (s *service) runSinks() {
+	for _, target := range s.sinks.sinks {
+		go s.runSink(target)
+	}
+
+	maintenance := time.NewTicker(sinkMaintenanceInterval)
+	defer maintenance.Stop()
+	prune := time.NewTicker(outboxPruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-s.sinks.stop:
+			return
+		case <-maintenance.C:
+			if _, err := s.sinkStatuses(false); err != nil {
+				s.logger.Error("Failed to update export sink lag", map[string]interface{}{"error": err.Error()})
+			}
+		case <-prune.C:
+			s.pruneOutbox()
+		}
+	}
+}
+
+// runSink ships batches to one sink until the processor stops. A failed batch
+// is retried with exponential backoff and half as many records, in case the
+// batch itself is what the sink rejects; the batch size grows back as batches
+// succeed.
+func (s *service) runSink(target sink.Sink) {
+	limit := s.sinks.batchSize
+	var backoff time.Duration
+
+	for {
+		wait := sinkPollInterval
+		shipped, err := s.shipBatch(target, limit)
+		if err != nil {
+			s.logger.Warn("Failed to ship batch to export sink", map[string]interface{}{"error": err.Error(), "sink": target.Name(), "batch_size": limit})
+			backoff = min(max(backoff*2, sinkMinBackoff), sinkMaxBackoff)
+			wait = backoff
+			limit = max(limit/2, 1)
+		} else {
+			backoff = 0
+			if shipped == limit {
+				// More records are waiting
+				wait = 0
+			}
+			limit = min(limit*2, s.sinks.batchSize)
+		}
+
+		select {
+		case <-s.sinks.stop:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// shipBatch writes the next batch of the outbox to a sink and moves its
+// checkpoint past it. It returns how many records were shipped, which is zero
+// when another replica holds the sink's lease.
+func (s *service) shipBatch(target sink.Sink, limit int) (int, error) {
+	name := target.Name()
+	now := time.Now()
+
+	checkpoint, err := s.repo.AcquireSinkLease(name, s.sinks.owner, now, now.Add(sinkLeaseTTL))
+	if err != nil || checkpoint == nil {
+		return 0, err
+	}
+
+	rows, err := s.repo.OutboxBatch(checkpoint.Position, target.Kinds(), now.Add(-outboxSettleDelay), limit)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+
+	records := make([]sink.Record, len(rows))
+	for i, row := range rows {
+		records[i] = row.Record()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sinkWriteTimeout)
+	err = target.Write(ctx, records)
+	cancel()
+	if err != nil {
+		s.metrics.RecordSinkBatch(name, "failed", len(records))
+		if recordErr := s.repo.RecordSinkFailure(name, err, time.Now()); recordErr != nil {
+			s.logger.Error("Failed to record export sink failure", map[string]interface{}{"error": recordErr.Error(), "sink": name})
+		}
+		return 0, err
+	}
+	s.metrics.RecordSinkBatch(name, "shipped", len(records))
+
+	advanced, err := s.repo.AdvanceSinkCheckpoint(name, s.sinks.owner, rows[len(rows)-1].ID, len(rows), time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if !advanced {
+		// The lease expired while the batch was being written; the replica
+		// now holding it ships the batch again
+		s.logger.Warn("Lost export sink lease while shipping", map[string]interface{}{"sink": name})
+		return 0, nil
+	}
+	return len(rows), nil
+}
+
+// pruneOutbox deletes the records every sink has shipped and drops those older
+// than the retention period
+func (s *service) pruneOutbox() {
+	kinds := make(map[string][]string, len(s.sinks.sinks))
+	for _, target := range s.sinks.sinks {
+		kinds[target.Name()] = target.Kinds()
+	}
+
+	deleted, err := s.repo.PruneOutbox(kinds, time.Now().Add(-s.sinks.retention))
+	if err != nil {
+		s.logger.Error("Failed to prune outbox", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("Pruned outbox", map[string]interface{}{"deleted": deleted})
+	}
+}
+
+// GetSinkStatus reports the lag and health of every export sink, checking
+// that each is reachable
+func (s *service) GetSinkStatus() ([]SinkStatus, error) {
+	return s.sinkStatuses(true)
+}
+
+// sinkStatuses works out each sink's status from its checkpoint and the
+// outbox, updating the lag gauges on the way, and checks each sink when asked
+func (s *service) sinkStatuses(check bool) ([]SinkStatus, error) {
+	if len(s.sinks.sinks) == 0 {
+		return []SinkStatus{}, nil
+	}
+
+	checkpoints, err := s.repo.ListSinkCheckpoints()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sink status: %w", err)
+	}
+	byName := make(map[string]*repository.SinkCheckpoint, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		byName[checkpoint.Sink] = checkpoint
+	}
+
+	now := time.Now()
+	statuses := make([]SinkStatus, 0, len(s.sinks.sinks))
+	for _, target := range s.sinks.sinks {
+		status := SinkStatus{
+			Name:       target.Name(),
+			Kinds:      target.Kinds(),
+			Status:     SinkStatusHealthy,
+			Checkpoint: repository.SinkCheckpoint{Sink: target.Name()},
+		}
+		if checkpoint, ok := byName[target.Name()]; ok {
+			status.Checkpoint = *checkpoint
+		}
+
+		pending, oldest, err := s.repo.OutboxLag(status.Checkpoint.Position, target.Kinds())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sink status: %w", err)
+		}
+		var lag time.Duration
+		if oldest != nil {
+			lag = now.Sub(*oldest)
+		}
+		status.Pending, status.OldestPendingAt, status.LagSeconds = pending, oldest, lag.Seconds()
+		s.metrics.UpdateSinkLag(target.Name(), pending, lag)
+
+		switch {
+		case status.Checkpoint.ConsecutiveFailures >= sinkFailingAfter:
+			status.Status = SinkStatusFailing
+		case lag > sinkLagThreshold:
+			status.Status = SinkStatusLagging
+		}
+
+		if check {
+			ctx, cancel := context.WithTimeout(context.Background(), sinkCheckTimeout)
+			if err := target.Check(ctx); err != nil {
+				status.CheckError = err.Error()
+				status.Status = SinkStatusFailing
+			}
+			cancel()
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// sinksHealthy reports whether no export sink is failing
+func (s *service) sinksHealthy() bool {
+	statuses, err := s.sinkStatuses(false)
+	if err != nil {
+		return false
+	}
+	for _, status := range statuses {
+		if status.Status == SinkStatusFailing {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/blobstore"
+)
+
+// ObjectSink writes each batch as NDJSON or CSV objects in a blob store, one
+// object per record kind, which makes it both the local file sink and the S3
+// export sink. Objects are keyed by the records they hold, so a batch
+// redelivered after a lost acknowledgement replaces its earlier copy.
+type ObjectSink struct {
+	name   string
+	store  blobstore.Store
+	prefix string
+	format string
+	kinds  []string
+}
+
+// NewObjectSink creates a sink writing objects under a key prefix. It accepts
+// every record kind unless kinds are given.
+func NewObjectSink(name string, store blobstore.Store, prefix, format string, kinds ...string) (*ObjectSink, error) {
+	if name == "" || store == nil {
+		return nil, fmt.Errorf("object sink requires a name and a store")
+	}
+	if !ValidFormat(format) {
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+	if len(kinds) == 0 {
+		kinds = Kinds
+	}
+	return &ObjectSink{name: name, store: store, prefix: prefix, format: format, kinds: kinds}, nil
+}
+
+// Name identifies the sink
+func (s *ObjectSink) Name() string {
+	return s.name
+}
+
+// Kinds lists the record kinds the sink accepts
+func (s *ObjectSink) Kinds() []string {
+	return s.kinds
+}
+
+// Write stores the batch, one object per record kind
+func (s *ObjectSink) Write(ctx context.Context, records []Record) error {
+	var order []string
+	byKind := map[string][]Record{}
+	for _, record := range records {
+		if _, ok := byKind[record.Kind]; !ok {
+			order = append(order, record.Kind)
+		}
+		byKind[record.Kind] = append(byKind[record.Kind], record)
+	}
+
+	for _, kind := range order {
+		batch := byKind[kind]
+		data, contentType, err := Encode(s.format, batch)
+		if err != nil {
+			return err
+		}
+		if err := s.store.Put(ctx, s.objectKey(batch), data, contentType); err != nil {
+			return fmt.Errorf("failed to write %s batch: %w", kind, err)
+		}
+	}
+	return nil
+}
+
+// objectKey names a batch of one kind by its first and last record, under the
+// date of its first record, for example exports/events/2025/03/01/120-350.ndjson
+func (s *ObjectSink) objectKey(batch []Record) string {
+	first, last := batch[0], batch[len(batch)-1]
+	name := fmt.Sprintf("%d-%d.%s", first.ID, last.ID, s.format)
+	return path.Join(s.prefix, first.Kind+"s", first.Timestamp.UTC().Format("2006/01/02"), name)
+}
+
+// Check writes and removes a probe object
+func (s *ObjectSink) Check(ctx context.Context) error {
+	key := path.Join(s.prefix, ".check")
+	if err := s.store.Put(ctx, key, []byte("ok"), "text/plain"); err != nil {
+		return err
+	}
+	return s.store.Delete(ctx, key)
+}
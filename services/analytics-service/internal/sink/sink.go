@@ -0,0 +1,139 @@
+// Package sink defines where recorded events and metrics are exported to.
+// Records are written once to the outbox and shipped to every sink by its own
+// worker, so a sink only has to know how to write a batch and report whether
+// it is reachable.
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Record kinds
+const (
+	KindEvent  = "event"
+	KindMetric = "metric"
+)
+
+// Kinds lists every record kind
+var Kinds = []string{KindEvent, KindMetric}
+
+// Record is an event or metric to export. ID is the ID of the event or metric
+// row, so together with Kind it identifies the record across redeliveries.
+type Record struct {
+	ID     uint   `json:"id"`
+	Kind   string `json:"kind"`
+	ClubID string `json:"club_id"`
+	// Name is the event type or the metric name
+	Name string `json:"name"`
+	// Value is the metric value; events carry none
+	Value float64 `json:"value,omitempty"`
+	// Data is the event data or the metric tags
+	Data      map[string]interface{} `json:"data,omitempty"`
+	Timestamp time.Time              `json:"timestamp"`
+}
+
+// Key identifies the record across redeliveries
+func (r Record) Key() string {
+	return r.Kind + "-" + strconv.FormatUint(uint64(r.ID), 10)
+}
+
+// Sink ships records to an external system. Delivery is at least once: when
+// Write fails the whole batch is retried, possibly with fewer records, so
+// sinks should write idempotently on Record.Key where the target allows it.
+type Sink interface {
+	// Name identifies the sink's checkpoint and must not change between
+	// releases
+	Name() string
+	// Kinds lists the record kinds the sink accepts
+	Kinds() []string
+	// Write ships a batch of records, oldest first
+	Write(ctx context.Context, records []Record) error
+	// Check reports whether the sink is reachable
+	Check(ctx context.Context) error
+}
+
+// Eraser is a sink that can delete records it has been sent, by their keys.
+// Erasing a key the sink does not hold is not an error.
+type Eraser interface {
+	Erase(ctx context.Context, keys []string) error
+}
+
+// Encodings a batch can be written in
+const (
+	FormatNDJSON = "ndjson"
+	FormatCSV    = "csv"
+)
+
+// csvColumns are the columns of CSV encoded batches. Data is a JSON object.
+var csvColumns = []string{"id", "kind", "club_id", "name", "value", "timestamp", "data"}
+
+// Encode encodes a batch and returns it with its content type
+func Encode(format string, records []Record) ([]byte, string, error) {
+	var buf bytes.Buffer
+	switch format {
+	case FormatNDJSON:
+		encoder := json.NewEncoder(&buf)
+		for _, record := range records {
+			if err := encoder.Encode(record); err != nil {
+				return nil, "", fmt.Errorf("failed to encode record %s: %w", record.Key(), err)
+			}
+		}
+		return buf.Bytes(), "application/x-ndjson", nil
+
+	case FormatCSV:
+		writer := csv.NewWriter(&buf)
+		if err := writer.Write(csvColumns); err != nil {
+			return nil, "", err
+		}
+		for _, record := range records {
+			data := ""
+			if len(record.Data) > 0 {
+				encoded, err := json.Marshal(record.Data)
+				if err != nil {
+					return nil, "", fmt.Errorf("failed to encode record %s: %w", record.Key(), err)
+				}
+				data = string(encoded)
+			}
+			row := []string{
+				strconv.FormatUint(uint64(record.ID), 10),
+				record.Kind,
+				record.ClubID,
+				record.Name,
+				strconv.FormatFloat(record.Value, 'f', -1, 64),
+				record.Timestamp.UTC().Format(time.RFC3339Nano),
+				data,
+			}
+			if err := writer.Write(row); err != nil {
+				return nil, "", err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "text/csv", nil
+	}
+
+	return nil, "", fmt.Errorf("unsupported export format %q", format)
+}
+
+// ValidFormat reports whether batches can be encoded in a format
+func ValidFormat(format string) bool {
+	return format == FormatNDJSON || format == FormatCSV
+}
+
+// Accepts reports whether a sink accepts a record kind
+func Accepts(s Sink, kind string) bool {
+	for _, accepted := range s.Kinds() {
+		if accepted == kind {
+			return true
+		}
+	}
+	return false
+}
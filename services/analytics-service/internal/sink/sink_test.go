@@ -0,0 +1,99 @@
+package sink
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"reciprocal-clubs-backend/services/analytics-service/internal/blobstore"
+)
+
+var testTime = time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+
+func testRecords() []Record {
+	return []Record{
+		{ID: 7, Kind: KindEvent, ClubID: "1", Name: "visit", Data: map[string]interface{}{"note": "a, \"quoted\"\nline"}, Timestamp: testTime},
+		{ID: 3, Kind: KindMetric, ClubID: "1", Name: "visitors", Value: 12.5, Data: map[string]interface{}{"region": "eu"}, Timestamp: testTime},
+		{ID: 8, Kind: KindEvent, ClubID: "2", Name: "checkin", Timestamp: testTime.Add(time.Minute)},
+	}
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	data, contentType, err := Encode(FormatNDJSON, testRecords())
+	require.NoError(t, err)
+	assert.Equal(t, "application/x-ndjson", contentType)
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+	var decoded Record
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &decoded))
+	assert.Equal(t, "metric-3", decoded.Key())
+	assert.Equal(t, 12.5, decoded.Value)
+	assert.Equal(t, "eu", decoded.Data["region"])
+	assert.True(t, testTime.Equal(decoded.Timestamp))
+}
+
+func TestEncodeCSV(t *testing.T) {
+	data, contentType, err := Encode(FormatCSV, testRecords())
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", contentType)
+
+	rows, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, csvColumns, rows[0])
+	assert.Equal(t, []string{"7", "event", "1", "visit", "0", "2025-03-01T12:30:00Z", `{"note":"a, \"quoted\"\nline"}`}, rows[1])
+	assert.Equal(t, []string{"3", "metric", "1", "visitors", "12.5", "2025-03-01T12:30:00Z", `{"region":"eu"}`}, rows[2])
+	assert.Equal(t, "", rows[3][6])
+
+	_, _, err = Encode("parquet", testRecords())
+	assert.Error(t, err)
+}
+
+func TestObjectSink(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := blobstore.NewFileStore(dir)
+	require.NoError(t, err)
+
+	_, err = NewObjectSink("file", store, "exports", "xml")
+	assert.Error(t, err)
+
+	s, err := NewObjectSink("file", store, "exports", FormatNDJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Kinds, s.Kinds())
+	require.NoError(t, s.Check(ctx))
+
+	require.NoError(t, s.Write(ctx, testRecords()))
+	// Redelivering the batch replaces the objects rather than adding more
+	require.NoError(t, s.Write(ctx, testRecords()))
+
+	events, err := os.ReadFile(filepath.Join(dir, "exports", "events", "2025", "03", "01", "7-8.ndjson"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(events), "\n"))
+	metrics, err := os.ReadFile(filepath.Join(dir, "exports", "metrics", "2025", "03", "01", "3-3.ndjson"))
+	require.NoError(t, err)
+	assert.Contains(t, string(metrics), `"name":"visitors"`)
+
+	var files int
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			files++
+		}
+		return err
+	}))
+	assert.Equal(t, 2, files)
+
+	metricsOnly, err := NewObjectSink("metrics", store, "", FormatCSV, KindMetric)
+	require.NoError(t, err)
+	assert.True(t, Accepts(metricsOnly, KindMetric))
+	assert.False(t, Accepts(metricsOnly, KindEvent))
+}
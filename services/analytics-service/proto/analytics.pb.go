@@ -2105,6 +2105,10 @@ type ScheduleReportRequest struct {
 	Schedule      string                 `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Parameters    map[string]string      `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,6,opt,name=format,proto3,enum=analytics.ExportFormat" json:"format,omitempty"`
+	Recipients    []string               `protobuf:"bytes,7,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Name          string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Timezone      string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2174,11 +2178,40 @@ func (x *ScheduleReportRequest) GetEmail() string {
 	return ""
 }
 
+func (x *ScheduleReportRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ScheduleReportRequest) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *ScheduleReportRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleReportRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type ScheduleReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	ScheduleId    string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2234,6 +2267,13 @@ func (x *ScheduleReportResponse) GetScheduleId() string {
 	return ""
 }
 
+func (x *ScheduleReportResponse) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
 // Event management
 type GetEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -4751,236 +4791,1725 @@ func (x *AnomalySummary) GetLowSeverity() int32 {
 	return 0
 }
 
-var File_proto_analytics_proto protoreflect.FileDescriptor
+// Reciprocal network analytics
+type GetNetworkAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	TimeRange     *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_analytics_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/analytics.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf9\x03\n" +
-	"\x0eAnalyticsEvent\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
-	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x12\x1d\n" +
-	"\n" +
-	"event_type\x18\x03 \x01(\tR\teventType\x127\n" +
-	"\x04data\x18\x04 \x03(\v2#.analytics.AnalyticsEvent.DataEntryR\x04data\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x17\n" +
-	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\a \x01(\tR\tsessionId\x12C\n" +
-	"\bmetadata\x18\b \x03(\v2'.analytics.AnalyticsEvent.MetadataEntryR\bmetadata\x129\n" +
-	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a7\n" +
-	"\tDataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9e\x03\n" +
-	"\x0fAnalyticsMetric\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
-	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x12\x1f\n" +
-	"\vmetric_name\x18\x03 \x01(\tR\n" +
-	"metricName\x12!\n" +
-	"\fmetric_value\x18\x04 \x01(\x01R\vmetricValue\x126\n" +
-	"\vmetric_type\x18\x05 \x01(\x0e2\x15.analytics.MetricTypeR\n" +
-	"metricType\x128\n" +
-	"\x04tags\x18\x06 \x03(\v2$.analytics.AnalyticsMetric.TagsEntryR\x04tags\x128\n" +
-	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a7\n" +
-	"\tTagsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\x03\n" +
-	"\x0fAnalyticsReport\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
-	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x126\n" +
-	"\vreport_type\x18\x03 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
-	"reportType\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\x128\n" +
-	"\x04data\x18\x05 \x03(\v2$.analytics.AnalyticsReport.DataEntryR\x04data\x12=\n" +
-	"\fgenerated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\t \x01(\tR\tcreatedBy\x1a7\n" +
-	"\tDataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcf\x02\n" +
-	"\tDashboard\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
-	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
-	"\x06panels\x18\x05 \x03(\v2\x19.analytics.DashboardPanelR\x06panels\x12\x1b\n" +
-	"\tis_public\x18\x06 \x01(\bR\bisPublic\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa8\x02\n" +
-	"\x0eDashboardPanel\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
-	"\x05query\x18\x04 \x01(\tR\x05query\x12@\n" +
-	"\aoptions\x18\x05 \x03(\v2&.analytics.DashboardPanel.OptionsEntryR\aoptions\x12\f\n" +
-	"\x01x\x18\x06 \x01(\x05R\x01x\x12\f\n" +
-	"\x01y\x18\a \x01(\x05R\x01y\x12\x14\n" +
-	"\x05width\x18\b \x01(\x05R\x05width\x12\x16\n" +
-	"\x06height\x18\t \x01(\x05R\x06height\x1a:\n" +
-	"\fOptionsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"k\n" +
-	"\tTimeRange\x120\n" +
-	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
-	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"U\n" +
-	"\vQueryFilter\x12\x14\n" +
-	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
-	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
-	"\x05value\x18\x03 \x01(\tR\x05value\"\xd4\x01\n" +
-	"\x0eHealthResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\aservice\x18\x02 \x01(\tR\aservice\x12O\n" +
-	"\fdependencies\x18\x03 \x03(\v2+.analytics.HealthResponse.DependenciesEntryR\fdependencies\x1a?\n" +
-	"\x11DependenciesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xde\x01\n" +
-	"\x11GetMetricsRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1d\n" +
-	"\n" +
-	"time_range\x18\x02 \x01(\tR\ttimeRange\x12!\n" +
-	"\fmetric_names\x18\x03 \x03(\tR\vmetricNames\x12<\n" +
-	"\vgranularity\x18\x04 \x01(\x0e2\x1a.analytics.TimeGranularityR\vgranularity\x120\n" +
-	"\afilters\x18\x05 \x03(\v2\x16.analytics.QueryFilterR\afilters\"\xc3\x02\n" +
-	"\x12GetMetricsResponse\x12D\n" +
-	"\asummary\x18\x01 \x03(\v2*.analytics.GetMetricsResponse.SummaryEntryR\asummary\x124\n" +
-	"\adetails\x18\x02 \x03(\v2\x1a.analytics.AnalyticsMetricR\adetails\x12\x17\n" +
-	"\aclub_id\x18\x03 \x01(\tR\x06clubId\x12\x1d\n" +
-	"\n" +
-	"time_range\x18\x04 \x01(\tR\ttimeRange\x12=\n" +
-	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x1a:\n" +
-	"\fSummaryEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x01\n" +
-	"\x11GetReportsRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x126\n" +
-	"\vreport_type\x18\x02 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
-	"reportType\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\rR\x06offset\"`\n" +
-	"\x12GetReportsResponse\x124\n" +
-	"\areports\x18\x01 \x03(\v2\x1a.analytics.AnalyticsReportR\areports\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xba\x03\n" +
-	"\x12RecordEventRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1d\n" +
-	"\n" +
-	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
-	"\x04data\x18\x03 \x03(\v2'.analytics.RecordEventRequest.DataEntryR\x04data\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x05 \x01(\tR\tsessionId\x12G\n" +
-	"\bmetadata\x18\x06 \x03(\v2+.analytics.RecordEventRequest.MetadataEntryR\bmetadata\x128\n" +
-	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a7\n" +
-	"\tDataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
-	"\x13RecordEventResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
-	"\bevent_id\x18\x03 \x01(\rR\aeventId\"\xdb\x02\n" +
-	"\x13RecordMetricRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1f\n" +
-	"\vmetric_name\x18\x02 \x01(\tR\n" +
-	"metricName\x12!\n" +
-	"\fmetric_value\x18\x03 \x01(\x01R\vmetricValue\x126\n" +
-	"\vmetric_type\x18\x04 \x01(\x0e2\x15.analytics.MetricTypeR\n" +
-	"metricType\x12<\n" +
-	"\x04tags\x18\x05 \x03(\v2(.analytics.RecordMetricRequest.TagsEntryR\x04tags\x128\n" +
-	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a7\n" +
-	"\tTagsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
-	"\x14RecordMetricResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
-	"\tmetric_id\x18\x03 \x01(\rR\bmetricId\"W\n" +
-	"\x19GetRealtimeMetricsRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12!\n" +
-	"\fmetric_names\x18\x02 \x03(\tR\vmetricNames\"\xe0\x01\n" +
-	"\x1aGetRealtimeMetricsResponse\x12L\n" +
-	"\ametrics\x18\x01 \x03(\v22.analytics.GetRealtimeMetricsResponse.MetricsEntryR\ametrics\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a:\n" +
-	"\fMetricsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x81\x01\n" +
-	"\x13StreamEventsRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1f\n" +
-	"\vevent_types\x18\x02 \x03(\tR\n" +
-	"eventTypes\x120\n" +
-	"\afilters\x18\x03 \x03(\v2\x16.analytics.QueryFilterR\afilters\"\x83\x01\n" +
-	"\x13EventStreamResponse\x12/\n" +
-	"\x05event\x18\x01 \x01(\v2\x19.analytics.AnalyticsEventR\x05event\x12;\n" +
-	"\vreceived_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"receivedAt\".\n" +
-	"\x13GetLiveStatsRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\"\xcc\x01\n" +
-	"\x14GetLiveStatsResponse\x12@\n" +
-	"\x05stats\x18\x01 \x03(\v2*.analytics.GetLiveStatsResponse.StatsEntryR\x05stats\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a8\n" +
-	"\n" +
-	"StatsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xc4\x02\n" +
-	"\x15GenerateReportRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x126\n" +
-	"\vreport_type\x18\x02 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
-	"reportType\x123\n" +
-	"\n" +
-	"time_range\x18\x03 \x01(\v2\x14.analytics.TimeRangeR\ttimeRange\x12P\n" +
-	"\n" +
-	"parameters\x18\x04 \x03(\v20.analytics.GenerateReportRequest.ParametersEntryR\n" +
-	"parameters\x12\x14\n" +
-	"\x05async\x18\x05 \x01(\bR\x05async\x1a=\n" +
-	"\x0fParametersEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x97\x01\n" +
-	"\x16GenerateReportResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
-	"\x06report\x18\x03 \x01(\v2\x1a.analytics.AnalyticsReportR\x06report\x12\x15\n" +
-	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"/\n" +
-	"\x16GetReportStatusRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x9b\x01\n" +
-	"\x17GetReportStatusResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
-	"\bprogress\x18\x03 \x01(\x05R\bprogress\x122\n" +
-	"\x06report\x18\x04 \x01(\v2\x1a.analytics.AnalyticsReportR\x06report\"\xab\x02\n" +
-	"\x15ScheduleReportRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x126\n" +
-	"\vreport_type\x18\x02 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
-	"reportType\x12\x1a\n" +
-	"\bschedule\x18\x03 \x01(\tR\bschedule\x12P\n" +
-	"\n" +
-	"parameters\x18\x04 \x03(\v20.analytics.ScheduleReportRequest.ParametersEntryR\n" +
-	"parameters\x12\x14\n" +
-	"\x05email\x18\x05 \x01(\tR\x05email\x1a=\n" +
-	"\x0fParametersEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
-	"\x16ScheduleReportResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
-	"\vschedule_id\x18\x03 \x01(\tR\n" +
-	"scheduleId\"\x99\x01\n" +
-	"\x10GetEventsRequest\x12\x17\n" +
-	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1d\n" +
-	"\n" +
-	"time_range\x18\x02 \x01(\tR\ttimeRange\x12\x1f\n" +
+func (x *GetNetworkAnalyticsRequest) Reset() {
+	*x = GetNetworkAnalyticsRequest{}
+	mi := &file_proto_analytics_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetNetworkAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworkAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetNetworkAnalyticsRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *GetNetworkAnalyticsRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+type GetNetworkAnalyticsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Outcomes      *VisitOutcomes           `protobuf:"bytes,1,opt,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Flows         []*ClubFlow              `protobuf:"bytes,2,rep,name=flows,proto3" json:"flows,omitempty"`
+	Agreements    []*AgreementNetworkStats `protobuf:"bytes,3,rep,name=agreements,proto3" json:"agreements,omitempty"`
+	Clubs         []*ClubNetworkStats      `protobuf:"bytes,4,rep,name=clubs,proto3" json:"clubs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkAnalyticsResponse) Reset() {
+	*x = GetNetworkAnalyticsResponse{}
+	mi := &file_proto_analytics_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetNetworkAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetNetworkAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetNetworkAnalyticsResponse) GetOutcomes() *VisitOutcomes {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *GetNetworkAnalyticsResponse) GetFlows() []*ClubFlow {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
+}
+
+func (x *GetNetworkAnalyticsResponse) GetAgreements() []*AgreementNetworkStats {
+	if x != nil {
+		return x.Agreements
+	}
+	return nil
+}
+
+func (x *GetNetworkAnalyticsResponse) GetClubs() []*ClubNetworkStats {
+	if x != nil {
+		return x.Clubs
+	}
+	return nil
+}
+
+type VisitOutcomes struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Visits           int32                  `protobuf:"varint,1,opt,name=visits,proto3" json:"visits,omitempty"`
+	Open             int32                  `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	Attended         int32                  `protobuf:"varint,3,opt,name=attended,proto3" json:"attended,omitempty"`
+	Cancelled        int32                  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	NoShows          int32                  `protobuf:"varint,5,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`
+	CancellationRate float64                `protobuf:"fixed64,6,opt,name=cancellation_rate,json=cancellationRate,proto3" json:"cancellation_rate,omitempty"`
+	NoShowRate       float64                `protobuf:"fixed64,7,opt,name=no_show_rate,json=noShowRate,proto3" json:"no_show_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VisitOutcomes) Reset() {
+	*x = VisitOutcomes{}
+	mi := &file_proto_analytics_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitOutcomes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitOutcomes) ProtoMessage() {}
+
+func (x *VisitOutcomes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitOutcomes.ProtoReflect.Descriptor instead.
+func (*VisitOutcomes) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *VisitOutcomes) GetVisits() int32 {
+	if x != nil {
+		return x.Visits
+	}
+	return 0
+}
+
+func (x *VisitOutcomes) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *VisitOutcomes) GetAttended() int32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *VisitOutcomes) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *VisitOutcomes) GetNoShows() int32 {
+	if x != nil {
+		return x.NoShows
+	}
+	return 0
+}
+
+func (x *VisitOutcomes) GetCancellationRate() float64 {
+	if x != nil {
+		return x.CancellationRate
+	}
+	return 0
+}
+
+func (x *VisitOutcomes) GetNoShowRate() float64 {
+	if x != nil {
+		return x.NoShowRate
+	}
+	return 0
+}
+
+type ClubFlow struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HomeClubId     uint32                 `protobuf:"varint,1,opt,name=home_club_id,json=homeClubId,proto3" json:"home_club_id,omitempty"`
+	VisitingClubId uint32                 `protobuf:"varint,2,opt,name=visiting_club_id,json=visitingClubId,proto3" json:"visiting_club_id,omitempty"`
+	Visits         int32                  `protobuf:"varint,3,opt,name=visits,proto3" json:"visits,omitempty"`
+	Members        int32                  `protobuf:"varint,4,opt,name=members,proto3" json:"members,omitempty"`
+	Requested      int32                  `protobuf:"varint,5,opt,name=requested,proto3" json:"requested,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClubFlow) Reset() {
+	*x = ClubFlow{}
+	mi := &file_proto_analytics_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClubFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubFlow) ProtoMessage() {}
+
+func (x *ClubFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubFlow.ProtoReflect.Descriptor instead.
+func (*ClubFlow) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ClubFlow) GetHomeClubId() uint32 {
+	if x != nil {
+		return x.HomeClubId
+	}
+	return 0
+}
+
+func (x *ClubFlow) GetVisitingClubId() uint32 {
+	if x != nil {
+		return x.VisitingClubId
+	}
+	return 0
+}
+
+func (x *ClubFlow) GetVisits() int32 {
+	if x != nil {
+		return x.Visits
+	}
+	return 0
+}
+
+func (x *ClubFlow) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *ClubFlow) GetRequested() int32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+type AgreementNetworkStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AgreementId       uint32                 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty"`
+	ProposingClubId   uint32                 `protobuf:"varint,2,opt,name=proposing_club_id,json=proposingClubId,proto3" json:"proposing_club_id,omitempty"`
+	TargetClubId      uint32                 `protobuf:"varint,3,opt,name=target_club_id,json=targetClubId,proto3" json:"target_club_id,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Outbound          int32                  `protobuf:"varint,5,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound           int32                  `protobuf:"varint,6,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	Balance           float64                `protobuf:"fixed64,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	MaxVisitsPerMonth int32                  `protobuf:"varint,8,opt,name=max_visits_per_month,json=maxVisitsPerMonth,proto3" json:"max_visits_per_month,omitempty"`
+	Utilization       *AgreementUtilization  `protobuf:"bytes,9,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Outcomes          *VisitOutcomes         `protobuf:"bytes,10,opt,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Trend             []*AgreementTrendPoint `protobuf:"bytes,11,rep,name=trend,proto3" json:"trend,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgreementNetworkStats) Reset() {
+	*x = AgreementNetworkStats{}
+	mi := &file_proto_analytics_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgreementNetworkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgreementNetworkStats) ProtoMessage() {}
+
+func (x *AgreementNetworkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgreementNetworkStats.ProtoReflect.Descriptor instead.
+func (*AgreementNetworkStats) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AgreementNetworkStats) GetAgreementId() uint32 {
+	if x != nil {
+		return x.AgreementId
+	}
+	return 0
+}
+
+func (x *AgreementNetworkStats) GetProposingClubId() uint32 {
+	if x != nil {
+		return x.ProposingClubId
+	}
+	return 0
+}
+
+func (x *AgreementNetworkStats) GetTargetClubId() uint32 {
+	if x != nil {
+		return x.TargetClubId
+	}
+	return 0
+}
+
+func (x *AgreementNetworkStats) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AgreementNetworkStats) GetOutbound() int32 {
+	if x != nil {
+		return x.Outbound
+	}
+	return 0
+}
+
+func (x *AgreementNetworkStats) GetInbound() int32 {
+	if x != nil {
+		return x.Inbound
+	}
+	return 0
+}
+
+func (x *AgreementNetworkStats) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AgreementNetworkStats) GetMaxVisitsPerMonth() int32 {
+	if x != nil {
+		return x.MaxVisitsPerMonth
+	}
+	return 0
+}
+
+func (x *AgreementNetworkStats) GetUtilization() *AgreementUtilization {
+	if x != nil {
+		return x.Utilization
+	}
+	return nil
+}
+
+func (x *AgreementNetworkStats) GetOutcomes() *VisitOutcomes {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *AgreementNetworkStats) GetTrend() []*AgreementTrendPoint {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+type AgreementUtilization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberMonths  int32                  `protobuf:"varint,1,opt,name=member_months,json=memberMonths,proto3" json:"member_months,omitempty"`
+	AverageVisits float64                `protobuf:"fixed64,2,opt,name=average_visits,json=averageVisits,proto3" json:"average_visits,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	AtLimit       int32                  `protobuf:"varint,4,opt,name=at_limit,json=atLimit,proto3" json:"at_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgreementUtilization) Reset() {
+	*x = AgreementUtilization{}
+	mi := &file_proto_analytics_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgreementUtilization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgreementUtilization) ProtoMessage() {}
+
+func (x *AgreementUtilization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgreementUtilization.ProtoReflect.Descriptor instead.
+func (*AgreementUtilization) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AgreementUtilization) GetMemberMonths() int32 {
+	if x != nil {
+		return x.MemberMonths
+	}
+	return 0
+}
+
+func (x *AgreementUtilization) GetAverageVisits() float64 {
+	if x != nil {
+		return x.AverageVisits
+	}
+	return 0
+}
+
+func (x *AgreementUtilization) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *AgreementUtilization) GetAtLimit() int32 {
+	if x != nil {
+		return x.AtLimit
+	}
+	return 0
+}
+
+type AgreementTrendPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Outbound      int32                  `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound       int32                  `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgreementTrendPoint) Reset() {
+	*x = AgreementTrendPoint{}
+	mi := &file_proto_analytics_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgreementTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgreementTrendPoint) ProtoMessage() {}
+
+func (x *AgreementTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgreementTrendPoint.ProtoReflect.Descriptor instead.
+func (*AgreementTrendPoint) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AgreementTrendPoint) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+func (x *AgreementTrendPoint) GetOutbound() int32 {
+	if x != nil {
+		return x.Outbound
+	}
+	return 0
+}
+
+func (x *AgreementTrendPoint) GetInbound() int32 {
+	if x != nil {
+		return x.Inbound
+	}
+	return 0
+}
+
+type ClubNetworkStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	Hosted        *VisitOutcomes         `protobuf:"bytes,2,opt,name=hosted,proto3" json:"hosted,omitempty"`
+	VisitsMade    int32                  `protobuf:"varint,3,opt,name=visits_made,json=visitsMade,proto3" json:"visits_made,omitempty"`
+	Partners      int32                  `protobuf:"varint,4,opt,name=partners,proto3" json:"partners,omitempty"`
+	HostRating    *VisitRating           `protobuf:"bytes,5,opt,name=host_rating,json=hostRating,proto3" json:"host_rating,omitempty"`
+	GuestRating   *VisitRating           `protobuf:"bytes,6,opt,name=guest_rating,json=guestRating,proto3" json:"guest_rating,omitempty"`
+	Facilities    []*FacilityUsage       `protobuf:"bytes,7,rep,name=facilities,proto3" json:"facilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClubNetworkStats) Reset() {
+	*x = ClubNetworkStats{}
+	mi := &file_proto_analytics_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClubNetworkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubNetworkStats) ProtoMessage() {}
+
+func (x *ClubNetworkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubNetworkStats.ProtoReflect.Descriptor instead.
+func (*ClubNetworkStats) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ClubNetworkStats) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *ClubNetworkStats) GetHosted() *VisitOutcomes {
+	if x != nil {
+		return x.Hosted
+	}
+	return nil
+}
+
+func (x *ClubNetworkStats) GetVisitsMade() int32 {
+	if x != nil {
+		return x.VisitsMade
+	}
+	return 0
+}
+
+func (x *ClubNetworkStats) GetPartners() int32 {
+	if x != nil {
+		return x.Partners
+	}
+	return 0
+}
+
+func (x *ClubNetworkStats) GetHostRating() *VisitRating {
+	if x != nil {
+		return x.HostRating
+	}
+	return nil
+}
+
+func (x *ClubNetworkStats) GetGuestRating() *VisitRating {
+	if x != nil {
+		return x.GuestRating
+	}
+	return nil
+}
+
+func (x *ClubNetworkStats) GetFacilities() []*FacilityUsage {
+	if x != nil {
+		return x.Facilities
+	}
+	return nil
+}
+
+type VisitRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Average       float64                `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisitRating) Reset() {
+	*x = VisitRating{}
+	mi := &file_proto_analytics_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitRating) ProtoMessage() {}
+
+func (x *VisitRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitRating.ProtoReflect.Descriptor instead.
+func (*VisitRating) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *VisitRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *VisitRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FacilityUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Facility      string                 `protobuf:"bytes,1,opt,name=facility,proto3" json:"facility,omitempty"`
+	Visits        int32                  `protobuf:"varint,2,opt,name=visits,proto3" json:"visits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacilityUsage) Reset() {
+	*x = FacilityUsage{}
+	mi := &file_proto_analytics_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacilityUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacilityUsage) ProtoMessage() {}
+
+func (x *FacilityUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacilityUsage.ProtoReflect.Descriptor instead.
+func (*FacilityUsage) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *FacilityUsage) GetFacility() string {
+	if x != nil {
+		return x.Facility
+	}
+	return ""
+}
+
+func (x *FacilityUsage) GetVisits() int32 {
+	if x != nil {
+		return x.Visits
+	}
+	return 0
+}
+
+// Membership cohorts
+type GetCohortAnalysisRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClubId         uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	TimeRange      *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	MaxMonths      int32                  `protobuf:"varint,3,opt,name=max_months,json=maxMonths,proto3" json:"max_months,omitempty"`
+	RenewalMonths  int32                  `protobuf:"varint,4,opt,name=renewal_months,json=renewalMonths,proto3" json:"renewal_months,omitempty"`
+	RiskWindowDays int32                  `protobuf:"varint,5,opt,name=risk_window_days,json=riskWindowDays,proto3" json:"risk_window_days,omitempty"`
+	RiskLimit      int32                  `protobuf:"varint,6,opt,name=risk_limit,json=riskLimit,proto3" json:"risk_limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCohortAnalysisRequest) Reset() {
+	*x = GetCohortAnalysisRequest{}
+	mi := &file_proto_analytics_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCohortAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCohortAnalysisRequest) ProtoMessage() {}
+
+func (x *GetCohortAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCohortAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetCohortAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCohortAnalysisRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *GetCohortAnalysisRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetCohortAnalysisRequest) GetMaxMonths() int32 {
+	if x != nil {
+		return x.MaxMonths
+	}
+	return 0
+}
+
+func (x *GetCohortAnalysisRequest) GetRenewalMonths() int32 {
+	if x != nil {
+		return x.RenewalMonths
+	}
+	return 0
+}
+
+func (x *GetCohortAnalysisRequest) GetRiskWindowDays() int32 {
+	if x != nil {
+		return x.RiskWindowDays
+	}
+	return 0
+}
+
+func (x *GetCohortAnalysisRequest) GetRiskLimit() int32 {
+	if x != nil {
+		return x.RiskLimit
+	}
+	return 0
+}
+
+type GetCohortAnalysisResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Cohorts        []*MemberCohort        `protobuf:"bytes,1,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	Churn          []*MembershipChurn     `protobuf:"bytes,2,rep,name=churn,proto3" json:"churn,omitempty"`
+	Survival       []*SurvivalCurve       `protobuf:"bytes,3,rep,name=survival,proto3" json:"survival,omitempty"`
+	UsageRenewal   *UsageRenewal          `protobuf:"bytes,4,opt,name=usage_renewal,json=usageRenewal,proto3" json:"usage_renewal,omitempty"`
+	AtRisk         []*AtRiskMember        `protobuf:"bytes,5,rep,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	RiskWindowDays int32                  `protobuf:"varint,6,opt,name=risk_window_days,json=riskWindowDays,proto3" json:"risk_window_days,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCohortAnalysisResponse) Reset() {
+	*x = GetCohortAnalysisResponse{}
+	mi := &file_proto_analytics_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCohortAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCohortAnalysisResponse) ProtoMessage() {}
+
+func (x *GetCohortAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCohortAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetCohortAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetCohortAnalysisResponse) GetCohorts() []*MemberCohort {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+func (x *GetCohortAnalysisResponse) GetChurn() []*MembershipChurn {
+	if x != nil {
+		return x.Churn
+	}
+	return nil
+}
+
+func (x *GetCohortAnalysisResponse) GetSurvival() []*SurvivalCurve {
+	if x != nil {
+		return x.Survival
+	}
+	return nil
+}
+
+func (x *GetCohortAnalysisResponse) GetUsageRenewal() *UsageRenewal {
+	if x != nil {
+		return x.UsageRenewal
+	}
+	return nil
+}
+
+func (x *GetCohortAnalysisResponse) GetAtRisk() []*AtRiskMember {
+	if x != nil {
+		return x.AtRisk
+	}
+	return nil
+}
+
+func (x *GetCohortAnalysisResponse) GetRiskWindowDays() int32 {
+	if x != nil {
+		return x.RiskWindowDays
+	}
+	return 0
+}
+
+type MemberCohort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Members       int32                  `protobuf:"varint,2,opt,name=members,proto3" json:"members,omitempty"`
+	Retention     []*RetentionCell       `protobuf:"bytes,3,rep,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberCohort) Reset() {
+	*x = MemberCohort{}
+	mi := &file_proto_analytics_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberCohort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberCohort) ProtoMessage() {}
+
+func (x *MemberCohort) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberCohort.ProtoReflect.Descriptor instead.
+func (*MemberCohort) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MemberCohort) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+func (x *MemberCohort) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *MemberCohort) GetRetention() []*RetentionCell {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+type RetentionCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         int32                  `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	Eligible      int32                  `protobuf:"varint,2,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Active        int32                  `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionCell) Reset() {
+	*x = RetentionCell{}
+	mi := &file_proto_analytics_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionCell) ProtoMessage() {}
+
+func (x *RetentionCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionCell.ProtoReflect.Descriptor instead.
+func (*RetentionCell) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RetentionCell) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *RetentionCell) GetEligible() int32 {
+	if x != nil {
+		return x.Eligible
+	}
+	return 0
+}
+
+func (x *RetentionCell) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *RetentionCell) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type MembershipChurn struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MembershipType string                 `protobuf:"bytes,1,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty"`
+	Active         int32                  `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Exposed        int32                  `protobuf:"varint,3,opt,name=exposed,proto3" json:"exposed,omitempty"`
+	Churned        int32                  `protobuf:"varint,4,opt,name=churned,proto3" json:"churned,omitempty"`
+	Rate           float64                `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MembershipChurn) Reset() {
+	*x = MembershipChurn{}
+	mi := &file_proto_analytics_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembershipChurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipChurn) ProtoMessage() {}
+
+func (x *MembershipChurn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipChurn.ProtoReflect.Descriptor instead.
+func (*MembershipChurn) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *MembershipChurn) GetMembershipType() string {
+	if x != nil {
+		return x.MembershipType
+	}
+	return ""
+}
+
+func (x *MembershipChurn) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *MembershipChurn) GetExposed() int32 {
+	if x != nil {
+		return x.Exposed
+	}
+	return 0
+}
+
+func (x *MembershipChurn) GetChurned() int32 {
+	if x != nil {
+		return x.Churned
+	}
+	return 0
+}
+
+func (x *MembershipChurn) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type SurvivalCurve struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MembershipType string                 `protobuf:"bytes,1,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty"`
+	Members        int32                  `protobuf:"varint,2,opt,name=members,proto3" json:"members,omitempty"`
+	MedianMonths   int32                  `protobuf:"varint,3,opt,name=median_months,json=medianMonths,proto3" json:"median_months,omitempty"`
+	Points         []*SurvivalPoint       `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SurvivalCurve) Reset() {
+	*x = SurvivalCurve{}
+	mi := &file_proto_analytics_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurvivalCurve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurvivalCurve) ProtoMessage() {}
+
+func (x *SurvivalCurve) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurvivalCurve.ProtoReflect.Descriptor instead.
+func (*SurvivalCurve) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SurvivalCurve) GetMembershipType() string {
+	if x != nil {
+		return x.MembershipType
+	}
+	return ""
+}
+
+func (x *SurvivalCurve) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *SurvivalCurve) GetMedianMonths() int32 {
+	if x != nil {
+		return x.MedianMonths
+	}
+	return 0
+}
+
+func (x *SurvivalCurve) GetPoints() []*SurvivalPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type SurvivalPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         int32                  `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	AtRisk        int32                  `protobuf:"varint,2,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	Churned       int32                  `protobuf:"varint,3,opt,name=churned,proto3" json:"churned,omitempty"`
+	Survival      float64                `protobuf:"fixed64,4,opt,name=survival,proto3" json:"survival,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurvivalPoint) Reset() {
+	*x = SurvivalPoint{}
+	mi := &file_proto_analytics_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurvivalPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurvivalPoint) ProtoMessage() {}
+
+func (x *SurvivalPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurvivalPoint.ProtoReflect.Descriptor instead.
+func (*SurvivalPoint) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SurvivalPoint) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *SurvivalPoint) GetAtRisk() int32 {
+	if x != nil {
+		return x.AtRisk
+	}
+	return 0
+}
+
+func (x *SurvivalPoint) GetChurned() int32 {
+	if x != nil {
+		return x.Churned
+	}
+	return 0
+}
+
+func (x *SurvivalPoint) GetSurvival() float64 {
+	if x != nil {
+		return x.Survival
+	}
+	return 0
+}
+
+type UsageRenewal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Months         int32                  `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	Members        int32                  `protobuf:"varint,2,opt,name=members,proto3" json:"members,omitempty"`
+	Bands          []*UsageBand           `protobuf:"bytes,3,rep,name=bands,proto3" json:"bands,omitempty"`
+	Correlation    float64                `protobuf:"fixed64,4,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	HasCorrelation bool                   `protobuf:"varint,5,opt,name=has_correlation,json=hasCorrelation,proto3" json:"has_correlation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UsageRenewal) Reset() {
+	*x = UsageRenewal{}
+	mi := &file_proto_analytics_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRenewal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRenewal) ProtoMessage() {}
+
+func (x *UsageRenewal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRenewal.ProtoReflect.Descriptor instead.
+func (*UsageRenewal) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UsageRenewal) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *UsageRenewal) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *UsageRenewal) GetBands() []*UsageBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *UsageRenewal) GetCorrelation() float64 {
+	if x != nil {
+		return x.Correlation
+	}
+	return 0
+}
+
+func (x *UsageRenewal) GetHasCorrelation() bool {
+	if x != nil {
+		return x.HasCorrelation
+	}
+	return false
+}
+
+type UsageBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Band          string                 `protobuf:"bytes,1,opt,name=band,proto3" json:"band,omitempty"`
+	Members       int32                  `protobuf:"varint,2,opt,name=members,proto3" json:"members,omitempty"`
+	Renewed       int32                  `protobuf:"varint,3,opt,name=renewed,proto3" json:"renewed,omitempty"`
+	RenewalRate   float64                `protobuf:"fixed64,4,opt,name=renewal_rate,json=renewalRate,proto3" json:"renewal_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageBand) Reset() {
+	*x = UsageBand{}
+	mi := &file_proto_analytics_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageBand) ProtoMessage() {}
+
+func (x *UsageBand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageBand.ProtoReflect.Descriptor instead.
+func (*UsageBand) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UsageBand) GetBand() string {
+	if x != nil {
+		return x.Band
+	}
+	return ""
+}
+
+func (x *UsageBand) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *UsageBand) GetRenewed() int32 {
+	if x != nil {
+		return x.Renewed
+	}
+	return 0
+}
+
+func (x *UsageBand) GetRenewalRate() float64 {
+	if x != nil {
+		return x.RenewalRate
+	}
+	return 0
+}
+
+type AtRiskMember struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MemberId       uint32                 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MembershipType string                 `protobuf:"bytes,2,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty"`
+	TenureMonths   int32                  `protobuf:"varint,3,opt,name=tenure_months,json=tenureMonths,proto3" json:"tenure_months,omitempty"`
+	Previous       int32                  `protobuf:"varint,4,opt,name=previous,proto3" json:"previous,omitempty"`
+	Recent         int32                  `protobuf:"varint,5,opt,name=recent,proto3" json:"recent,omitempty"`
+	Decline        float64                `protobuf:"fixed64,6,opt,name=decline,proto3" json:"decline,omitempty"`
+	LastActivityAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AtRiskMember) Reset() {
+	*x = AtRiskMember{}
+	mi := &file_proto_analytics_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AtRiskMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtRiskMember) ProtoMessage() {}
+
+func (x *AtRiskMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtRiskMember.ProtoReflect.Descriptor instead.
+func (*AtRiskMember) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AtRiskMember) GetMemberId() uint32 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *AtRiskMember) GetMembershipType() string {
+	if x != nil {
+		return x.MembershipType
+	}
+	return ""
+}
+
+func (x *AtRiskMember) GetTenureMonths() int32 {
+	if x != nil {
+		return x.TenureMonths
+	}
+	return 0
+}
+
+func (x *AtRiskMember) GetPrevious() int32 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *AtRiskMember) GetRecent() int32 {
+	if x != nil {
+		return x.Recent
+	}
+	return 0
+}
+
+func (x *AtRiskMember) GetDecline() float64 {
+	if x != nil {
+		return x.Decline
+	}
+	return 0
+}
+
+func (x *AtRiskMember) GetLastActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+var File_proto_analytics_proto protoreflect.FileDescriptor
+
+const file_proto_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/analytics.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf9\x03\n" +
+	"\x0eAnalyticsEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x127\n" +
+	"\x04data\x18\x04 \x03(\v2#.analytics.AnalyticsEvent.DataEntryR\x04data\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12C\n" +
+	"\bmetadata\x18\b \x03(\v2'.analytics.AnalyticsEvent.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9e\x03\n" +
+	"\x0fAnalyticsMetric\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x12\x1f\n" +
+	"\vmetric_name\x18\x03 \x01(\tR\n" +
+	"metricName\x12!\n" +
+	"\fmetric_value\x18\x04 \x01(\x01R\vmetricValue\x126\n" +
+	"\vmetric_type\x18\x05 \x01(\x0e2\x15.analytics.MetricTypeR\n" +
+	"metricType\x128\n" +
+	"\x04tags\x18\x06 \x03(\v2$.analytics.AnalyticsMetric.TagsEntryR\x04tags\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\x03\n" +
+	"\x0fAnalyticsReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x126\n" +
+	"\vreport_type\x18\x03 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
+	"reportType\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x128\n" +
+	"\x04data\x18\x05 \x03(\v2$.analytics.AnalyticsReport.DataEntryR\x04data\x12=\n" +
+	"\fgenerated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcf\x02\n" +
+	"\tDashboard\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\x06panels\x18\x05 \x03(\v2\x19.analytics.DashboardPanelR\x06panels\x12\x1b\n" +
+	"\tis_public\x18\x06 \x01(\bR\bisPublic\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa8\x02\n" +
+	"\x0eDashboardPanel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12@\n" +
+	"\aoptions\x18\x05 \x03(\v2&.analytics.DashboardPanel.OptionsEntryR\aoptions\x12\f\n" +
+	"\x01x\x18\x06 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\a \x01(\x05R\x01y\x12\x14\n" +
+	"\x05width\x18\b \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\t \x01(\x05R\x06height\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"k\n" +
+	"\tTimeRange\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"U\n" +
+	"\vQueryFilter\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xd4\x01\n" +
+	"\x0eHealthResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12O\n" +
+	"\fdependencies\x18\x03 \x03(\v2+.analytics.HealthResponse.DependenciesEntryR\fdependencies\x1a?\n" +
+	"\x11DependenciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xde\x01\n" +
+	"\x11GetMetricsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x02 \x01(\tR\ttimeRange\x12!\n" +
+	"\fmetric_names\x18\x03 \x03(\tR\vmetricNames\x12<\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x1a.analytics.TimeGranularityR\vgranularity\x120\n" +
+	"\afilters\x18\x05 \x03(\v2\x16.analytics.QueryFilterR\afilters\"\xc3\x02\n" +
+	"\x12GetMetricsResponse\x12D\n" +
+	"\asummary\x18\x01 \x03(\v2*.analytics.GetMetricsResponse.SummaryEntryR\asummary\x124\n" +
+	"\adetails\x18\x02 \x03(\v2\x1a.analytics.AnalyticsMetricR\adetails\x12\x17\n" +
+	"\aclub_id\x18\x03 \x01(\tR\x06clubId\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x04 \x01(\tR\ttimeRange\x12=\n" +
+	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x1a:\n" +
+	"\fSummaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x01\n" +
+	"\x11GetReportsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x126\n" +
+	"\vreport_type\x18\x02 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
+	"reportType\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\rR\x06offset\"`\n" +
+	"\x12GetReportsResponse\x124\n" +
+	"\areports\x18\x01 \x03(\v2\x1a.analytics.AnalyticsReportR\areports\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xba\x03\n" +
+	"\x12RecordEventRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\x04data\x18\x03 \x03(\v2'.analytics.RecordEventRequest.DataEntryR\x04data\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x12G\n" +
+	"\bmetadata\x18\x06 \x03(\v2+.analytics.RecordEventRequest.MetadataEntryR\bmetadata\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
+	"\x13RecordEventResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\rR\aeventId\"\xdb\x02\n" +
+	"\x13RecordMetricRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1f\n" +
+	"\vmetric_name\x18\x02 \x01(\tR\n" +
+	"metricName\x12!\n" +
+	"\fmetric_value\x18\x03 \x01(\x01R\vmetricValue\x126\n" +
+	"\vmetric_type\x18\x04 \x01(\x0e2\x15.analytics.MetricTypeR\n" +
+	"metricType\x12<\n" +
+	"\x04tags\x18\x05 \x03(\v2(.analytics.RecordMetricRequest.TagsEntryR\x04tags\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
+	"\x14RecordMetricResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tmetric_id\x18\x03 \x01(\rR\bmetricId\"W\n" +
+	"\x19GetRealtimeMetricsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12!\n" +
+	"\fmetric_names\x18\x02 \x03(\tR\vmetricNames\"\xe0\x01\n" +
+	"\x1aGetRealtimeMetricsResponse\x12L\n" +
+	"\ametrics\x18\x01 \x03(\v22.analytics.GetRealtimeMetricsResponse.MetricsEntryR\ametrics\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a:\n" +
+	"\fMetricsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x81\x01\n" +
+	"\x13StreamEventsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\x120\n" +
+	"\afilters\x18\x03 \x03(\v2\x16.analytics.QueryFilterR\afilters\"\x83\x01\n" +
+	"\x13EventStreamResponse\x12/\n" +
+	"\x05event\x18\x01 \x01(\v2\x19.analytics.AnalyticsEventR\x05event\x12;\n" +
+	"\vreceived_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\".\n" +
+	"\x13GetLiveStatsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\"\xcc\x01\n" +
+	"\x14GetLiveStatsResponse\x12@\n" +
+	"\x05stats\x18\x01 \x03(\v2*.analytics.GetLiveStatsResponse.StatsEntryR\x05stats\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a8\n" +
+	"\n" +
+	"StatsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xc4\x02\n" +
+	"\x15GenerateReportRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x126\n" +
+	"\vreport_type\x18\x02 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
+	"reportType\x123\n" +
+	"\n" +
+	"time_range\x18\x03 \x01(\v2\x14.analytics.TimeRangeR\ttimeRange\x12P\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v20.analytics.GenerateReportRequest.ParametersEntryR\n" +
+	"parameters\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x97\x01\n" +
+	"\x16GenerateReportResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x06report\x18\x03 \x01(\v2\x1a.analytics.AnalyticsReportR\x06report\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"/\n" +
+	"\x16GetReportStatusRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x9b\x01\n" +
+	"\x17GetReportStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bprogress\x18\x03 \x01(\x05R\bprogress\x122\n" +
+	"\x06report\x18\x04 \x01(\v2\x1a.analytics.AnalyticsReportR\x06report\"\xac\x03\n" +
+	"\x15ScheduleReportRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x126\n" +
+	"\vreport_type\x18\x02 \x01(\x0e2\x15.analytics.ReportTypeR\n" +
+	"reportType\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\x12P\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v20.analytics.ScheduleReportRequest.ParametersEntryR\n" +
+	"parameters\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12/\n" +
+	"\x06format\x18\x06 \x01(\x0e2\x17.analytics.ExportFormatR\x06format\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\a \x03(\tR\n" +
+	"recipients\x12\x12\n" +
+	"\x04name\x18\b \x01(\tR\x04name\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x01\n" +
+	"\x16ScheduleReportResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\x12:\n" +
+	"\vnext_run_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\"\x99\x01\n" +
+	"\x10GetEventsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x02 \x01(\tR\ttimeRange\x12\x1f\n" +
 	"\vevent_types\x18\x03 \x03(\tR\n" +
 	"eventTypes\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\rR\x05limit\x12\x16\n" +
@@ -5204,7 +6733,135 @@ const file_proto_analytics_proto_rawDesc = "" +
 	"\x0ftotal_anomalies\x18\x01 \x01(\x05R\x0etotalAnomalies\x12#\n" +
 	"\rhigh_severity\x18\x02 \x01(\x05R\fhighSeverity\x12'\n" +
 	"\x0fmedium_severity\x18\x03 \x01(\x05R\x0emediumSeverity\x12!\n" +
-	"\flow_severity\x18\x04 \x01(\x05R\vlowSeverity*\x8d\x01\n" +
+	"\flow_severity\x18\x04 \x01(\x05R\vlowSeverity\"j\n" +
+	"\x1aGetNetworkAnalyticsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x123\n" +
+	"\n" +
+	"time_range\x18\x02 \x01(\v2\x14.analytics.TimeRangeR\ttimeRange\"\xf3\x01\n" +
+	"\x1bGetNetworkAnalyticsResponse\x124\n" +
+	"\boutcomes\x18\x01 \x01(\v2\x18.analytics.VisitOutcomesR\boutcomes\x12)\n" +
+	"\x05flows\x18\x02 \x03(\v2\x13.analytics.ClubFlowR\x05flows\x12@\n" +
+	"\n" +
+	"agreements\x18\x03 \x03(\v2 .analytics.AgreementNetworkStatsR\n" +
+	"agreements\x121\n" +
+	"\x05clubs\x18\x04 \x03(\v2\x1b.analytics.ClubNetworkStatsR\x05clubs\"\xdf\x01\n" +
+	"\rVisitOutcomes\x12\x16\n" +
+	"\x06visits\x18\x01 \x01(\x05R\x06visits\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x05R\x04open\x12\x1a\n" +
+	"\battended\x18\x03 \x01(\x05R\battended\x12\x1c\n" +
+	"\tcancelled\x18\x04 \x01(\x05R\tcancelled\x12\x19\n" +
+	"\bno_shows\x18\x05 \x01(\x05R\anoShows\x12+\n" +
+	"\x11cancellation_rate\x18\x06 \x01(\x01R\x10cancellationRate\x12 \n" +
+	"\fno_show_rate\x18\a \x01(\x01R\n" +
+	"noShowRate\"\xa6\x01\n" +
+	"\bClubFlow\x12 \n" +
+	"\fhome_club_id\x18\x01 \x01(\rR\n" +
+	"homeClubId\x12(\n" +
+	"\x10visiting_club_id\x18\x02 \x01(\rR\x0evisitingClubId\x12\x16\n" +
+	"\x06visits\x18\x03 \x01(\x05R\x06visits\x12\x18\n" +
+	"\amembers\x18\x04 \x01(\x05R\amembers\x12\x1c\n" +
+	"\trequested\x18\x05 \x01(\x05R\trequested\"\xd4\x03\n" +
+	"\x15AgreementNetworkStats\x12!\n" +
+	"\fagreement_id\x18\x01 \x01(\rR\vagreementId\x12*\n" +
+	"\x11proposing_club_id\x18\x02 \x01(\rR\x0fproposingClubId\x12$\n" +
+	"\x0etarget_club_id\x18\x03 \x01(\rR\ftargetClubId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\boutbound\x18\x05 \x01(\x05R\boutbound\x12\x18\n" +
+	"\ainbound\x18\x06 \x01(\x05R\ainbound\x12\x18\n" +
+	"\abalance\x18\a \x01(\x01R\abalance\x12/\n" +
+	"\x14max_visits_per_month\x18\b \x01(\x05R\x11maxVisitsPerMonth\x12A\n" +
+	"\vutilization\x18\t \x01(\v2\x1f.analytics.AgreementUtilizationR\vutilization\x124\n" +
+	"\boutcomes\x18\n" +
+	" \x01(\v2\x18.analytics.VisitOutcomesR\boutcomes\x124\n" +
+	"\x05trend\x18\v \x03(\v2\x1e.analytics.AgreementTrendPointR\x05trend\"\x91\x01\n" +
+	"\x14AgreementUtilization\x12#\n" +
+	"\rmember_months\x18\x01 \x01(\x05R\fmemberMonths\x12%\n" +
+	"\x0eaverage_visits\x18\x02 \x01(\x01R\raverageVisits\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x19\n" +
+	"\bat_limit\x18\x04 \x01(\x05R\aatLimit\"}\n" +
+	"\x13AgreementTrendPoint\x120\n" +
+	"\x05month\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05month\x12\x1a\n" +
+	"\boutbound\x18\x02 \x01(\x05R\boutbound\x12\x18\n" +
+	"\ainbound\x18\x03 \x01(\x05R\ainbound\"\xc8\x02\n" +
+	"\x10ClubNetworkStats\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x120\n" +
+	"\x06hosted\x18\x02 \x01(\v2\x18.analytics.VisitOutcomesR\x06hosted\x12\x1f\n" +
+	"\vvisits_made\x18\x03 \x01(\x05R\n" +
+	"visitsMade\x12\x1a\n" +
+	"\bpartners\x18\x04 \x01(\x05R\bpartners\x127\n" +
+	"\vhost_rating\x18\x05 \x01(\v2\x16.analytics.VisitRatingR\n" +
+	"hostRating\x129\n" +
+	"\fguest_rating\x18\x06 \x01(\v2\x16.analytics.VisitRatingR\vguestRating\x128\n" +
+	"\n" +
+	"facilities\x18\a \x03(\v2\x18.analytics.FacilityUsageR\n" +
+	"facilities\"=\n" +
+	"\vVisitRating\x12\x18\n" +
+	"\aaverage\x18\x01 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"C\n" +
+	"\rFacilityUsage\x12\x1a\n" +
+	"\bfacility\x18\x01 \x01(\tR\bfacility\x12\x16\n" +
+	"\x06visits\x18\x02 \x01(\x05R\x06visits\"\xf7\x01\n" +
+	"\x18GetCohortAnalysisRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x123\n" +
+	"\n" +
+	"time_range\x18\x02 \x01(\v2\x14.analytics.TimeRangeR\ttimeRange\x12\x1d\n" +
+	"\n" +
+	"max_months\x18\x03 \x01(\x05R\tmaxMonths\x12%\n" +
+	"\x0erenewal_months\x18\x04 \x01(\x05R\rrenewalMonths\x12(\n" +
+	"\x10risk_window_days\x18\x05 \x01(\x05R\x0eriskWindowDays\x12\x1d\n" +
+	"\n" +
+	"risk_limit\x18\x06 \x01(\x05R\triskLimit\"\xd0\x02\n" +
+	"\x19GetCohortAnalysisResponse\x121\n" +
+	"\acohorts\x18\x01 \x03(\v2\x17.analytics.MemberCohortR\acohorts\x120\n" +
+	"\x05churn\x18\x02 \x03(\v2\x1a.analytics.MembershipChurnR\x05churn\x124\n" +
+	"\bsurvival\x18\x03 \x03(\v2\x18.analytics.SurvivalCurveR\bsurvival\x12<\n" +
+	"\rusage_renewal\x18\x04 \x01(\v2\x17.analytics.UsageRenewalR\fusageRenewal\x120\n" +
+	"\aat_risk\x18\x05 \x03(\v2\x17.analytics.AtRiskMemberR\x06atRisk\x12(\n" +
+	"\x10risk_window_days\x18\x06 \x01(\x05R\x0eriskWindowDays\"\x92\x01\n" +
+	"\fMemberCohort\x120\n" +
+	"\x05month\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05month\x12\x18\n" +
+	"\amembers\x18\x02 \x01(\x05R\amembers\x126\n" +
+	"\tretention\x18\x03 \x03(\v2\x18.analytics.RetentionCellR\tretention\"m\n" +
+	"\rRetentionCell\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x1a\n" +
+	"\beligible\x18\x02 \x01(\x05R\beligible\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\x05R\x06active\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\"\x9a\x01\n" +
+	"\x0fMembershipChurn\x12'\n" +
+	"\x0fmembership_type\x18\x01 \x01(\tR\x0emembershipType\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\x05R\x06active\x12\x18\n" +
+	"\aexposed\x18\x03 \x01(\x05R\aexposed\x12\x18\n" +
+	"\achurned\x18\x04 \x01(\x05R\achurned\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\x01R\x04rate\"\xa9\x01\n" +
+	"\rSurvivalCurve\x12'\n" +
+	"\x0fmembership_type\x18\x01 \x01(\tR\x0emembershipType\x12\x18\n" +
+	"\amembers\x18\x02 \x01(\x05R\amembers\x12#\n" +
+	"\rmedian_months\x18\x03 \x01(\x05R\fmedianMonths\x120\n" +
+	"\x06points\x18\x04 \x03(\v2\x18.analytics.SurvivalPointR\x06points\"t\n" +
+	"\rSurvivalPoint\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x17\n" +
+	"\aat_risk\x18\x02 \x01(\x05R\x06atRisk\x12\x18\n" +
+	"\achurned\x18\x03 \x01(\x05R\achurned\x12\x1a\n" +
+	"\bsurvival\x18\x04 \x01(\x01R\bsurvival\"\xb7\x01\n" +
+	"\fUsageRenewal\x12\x16\n" +
+	"\x06months\x18\x01 \x01(\x05R\x06months\x12\x18\n" +
+	"\amembers\x18\x02 \x01(\x05R\amembers\x12*\n" +
+	"\x05bands\x18\x03 \x03(\v2\x14.analytics.UsageBandR\x05bands\x12 \n" +
+	"\vcorrelation\x18\x04 \x01(\x01R\vcorrelation\x12'\n" +
+	"\x0fhas_correlation\x18\x05 \x01(\bR\x0ehasCorrelation\"v\n" +
+	"\tUsageBand\x12\x12\n" +
+	"\x04band\x18\x01 \x01(\tR\x04band\x12\x18\n" +
+	"\amembers\x18\x02 \x01(\x05R\amembers\x12\x18\n" +
+	"\arenewed\x18\x03 \x01(\x05R\arenewed\x12!\n" +
+	"\frenewal_rate\x18\x04 \x01(\x01R\vrenewalRate\"\x8d\x02\n" +
+	"\fAtRiskMember\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\rR\bmemberId\x12'\n" +
+	"\x0fmembership_type\x18\x02 \x01(\tR\x0emembershipType\x12#\n" +
+	"\rtenure_months\x18\x03 \x01(\x05R\ftenureMonths\x12\x1a\n" +
+	"\bprevious\x18\x04 \x01(\x05R\bprevious\x12\x16\n" +
+	"\x06recent\x18\x05 \x01(\x05R\x06recent\x12\x18\n" +
+	"\adecline\x18\x06 \x01(\x01R\adecline\x12D\n" +
+	"\x10last_activity_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActivityAt*\x8d\x01\n" +
 	"\n" +
 	"MetricType\x12\x1b\n" +
 	"\x17METRIC_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
@@ -5233,7 +6890,7 @@ const file_proto_analytics_proto_rawDesc = "" +
 	"\x14TIME_GRANULARITY_DAY\x10\x03\x12\x19\n" +
 	"\x15TIME_GRANULARITY_WEEK\x10\x04\x12\x1a\n" +
 	"\x16TIME_GRANULARITY_MONTH\x10\x05\x12\x19\n" +
-	"\x15TIME_GRANULARITY_YEAR\x10\x062\xd4\x13\n" +
+	"\x15TIME_GRANULARITY_YEAR\x10\x062\x9a\x15\n" +
 	"\x10AnalyticsService\x12;\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a\x19.analytics.HealthResponse\x12I\n" +
 	"\n" +
@@ -5266,7 +6923,9 @@ const file_proto_analytics_proto_rawDesc = "" +
 	"\x10GetTrendAnalysis\x12\".analytics.GetTrendAnalysisRequest\x1a#.analytics.GetTrendAnalysisResponse\x12m\n" +
 	"\x16GetCorrelationAnalysis\x12(.analytics.GetCorrelationAnalysisRequest\x1a).analytics.GetCorrelationAnalysisResponse\x12m\n" +
 	"\x16GetPredictiveAnalytics\x12(.analytics.GetPredictiveAnalyticsRequest\x1a).analytics.GetPredictiveAnalyticsResponse\x12d\n" +
-	"\x13GetAnomalyDetection\x12%.analytics.GetAnomalyDetectionRequest\x1a&.analytics.GetAnomalyDetectionResponseB;Z9reciprocal-clubs-backend/services/analytics-service/protob\x06proto3"
+	"\x13GetAnomalyDetection\x12%.analytics.GetAnomalyDetectionRequest\x1a&.analytics.GetAnomalyDetectionResponse\x12d\n" +
+	"\x13GetNetworkAnalytics\x12%.analytics.GetNetworkAnalyticsRequest\x1a&.analytics.GetNetworkAnalyticsResponse\x12^\n" +
+	"\x11GetCohortAnalysis\x12#.analytics.GetCohortAnalysisRequest\x1a$.analytics.GetCohortAnalysisResponseB;Z9reciprocal-clubs-backend/services/analytics-service/protob\x06proto3"
 
 var (
 	file_proto_analytics_proto_rawDescOnce sync.Once
@@ -5281,7 +6940,7 @@ func file_proto_analytics_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
+var file_proto_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_proto_analytics_proto_goTypes = []any{
 	(MetricType)(0),                        // 0: analytics.MetricType
 	(ReportType)(0),                        // 1: analytics.ReportType
@@ -5355,184 +7014,234 @@ var file_proto_analytics_proto_goTypes = []any{
 	(*GetAnomalyDetectionResponse)(nil),    // 69: analytics.GetAnomalyDetectionResponse
 	(*AnomalyDataPoint)(nil),               // 70: analytics.AnomalyDataPoint
 	(*AnomalySummary)(nil),                 // 71: analytics.AnomalySummary
-	nil,                                    // 72: analytics.AnalyticsEvent.DataEntry
-	nil,                                    // 73: analytics.AnalyticsEvent.MetadataEntry
-	nil,                                    // 74: analytics.AnalyticsMetric.TagsEntry
-	nil,                                    // 75: analytics.AnalyticsReport.DataEntry
-	nil,                                    // 76: analytics.DashboardPanel.OptionsEntry
-	nil,                                    // 77: analytics.HealthResponse.DependenciesEntry
-	nil,                                    // 78: analytics.GetMetricsResponse.SummaryEntry
-	nil,                                    // 79: analytics.RecordEventRequest.DataEntry
-	nil,                                    // 80: analytics.RecordEventRequest.MetadataEntry
-	nil,                                    // 81: analytics.RecordMetricRequest.TagsEntry
-	nil,                                    // 82: analytics.GetRealtimeMetricsResponse.MetricsEntry
-	nil,                                    // 83: analytics.GetLiveStatsResponse.StatsEntry
-	nil,                                    // 84: analytics.GenerateReportRequest.ParametersEntry
-	nil,                                    // 85: analytics.ScheduleReportRequest.ParametersEntry
-	nil,                                    // 86: analytics.QueryEventsResponse.AggregationsEntry
-	nil,                                    // 87: analytics.ExportDataRequest.OptionsEntry
-	nil,                                    // 88: analytics.SendMetricsToExternalRequest.MetricsEntry
-	nil,                                    // 89: analytics.SendMetricsToExternalRequest.OptionsEntry
-	nil,                                    // 90: analytics.SystemHealthResponse.ComponentsEntry
-	nil,                                    // 91: analytics.SystemHealthResponse.MetricsEntry
-	nil,                                    // 92: analytics.ServiceMetricsResponse.CountersEntry
-	nil,                                    // 93: analytics.ServiceMetricsResponse.GaugesEntry
-	nil,                                    // 94: analytics.ServiceMetricsResponse.HistogramsEntry
-	nil,                                    // 95: analytics.GetCorrelationAnalysisResponse.CorrelationsEntry
-	(*timestamppb.Timestamp)(nil),          // 96: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 97: google.protobuf.Empty
+	(*GetNetworkAnalyticsRequest)(nil),     // 72: analytics.GetNetworkAnalyticsRequest
+	(*GetNetworkAnalyticsResponse)(nil),    // 73: analytics.GetNetworkAnalyticsResponse
+	(*VisitOutcomes)(nil),                  // 74: analytics.VisitOutcomes
+	(*ClubFlow)(nil),                       // 75: analytics.ClubFlow
+	(*AgreementNetworkStats)(nil),          // 76: analytics.AgreementNetworkStats
+	(*AgreementUtilization)(nil),           // 77: analytics.AgreementUtilization
+	(*AgreementTrendPoint)(nil),            // 78: analytics.AgreementTrendPoint
+	(*ClubNetworkStats)(nil),               // 79: analytics.ClubNetworkStats
+	(*VisitRating)(nil),                    // 80: analytics.VisitRating
+	(*FacilityUsage)(nil),                  // 81: analytics.FacilityUsage
+	(*GetCohortAnalysisRequest)(nil),       // 82: analytics.GetCohortAnalysisRequest
+	(*GetCohortAnalysisResponse)(nil),      // 83: analytics.GetCohortAnalysisResponse
+	(*MemberCohort)(nil),                   // 84: analytics.MemberCohort
+	(*RetentionCell)(nil),                  // 85: analytics.RetentionCell
+	(*MembershipChurn)(nil),                // 86: analytics.MembershipChurn
+	(*SurvivalCurve)(nil),                  // 87: analytics.SurvivalCurve
+	(*SurvivalPoint)(nil),                  // 88: analytics.SurvivalPoint
+	(*UsageRenewal)(nil),                   // 89: analytics.UsageRenewal
+	(*UsageBand)(nil),                      // 90: analytics.UsageBand
+	(*AtRiskMember)(nil),                   // 91: analytics.AtRiskMember
+	nil,                                    // 92: analytics.AnalyticsEvent.DataEntry
+	nil,                                    // 93: analytics.AnalyticsEvent.MetadataEntry
+	nil,                                    // 94: analytics.AnalyticsMetric.TagsEntry
+	nil,                                    // 95: analytics.AnalyticsReport.DataEntry
+	nil,                                    // 96: analytics.DashboardPanel.OptionsEntry
+	nil,                                    // 97: analytics.HealthResponse.DependenciesEntry
+	nil,                                    // 98: analytics.GetMetricsResponse.SummaryEntry
+	nil,                                    // 99: analytics.RecordEventRequest.DataEntry
+	nil,                                    // 100: analytics.RecordEventRequest.MetadataEntry
+	nil,                                    // 101: analytics.RecordMetricRequest.TagsEntry
+	nil,                                    // 102: analytics.GetRealtimeMetricsResponse.MetricsEntry
+	nil,                                    // 103: analytics.GetLiveStatsResponse.StatsEntry
+	nil,                                    // 104: analytics.GenerateReportRequest.ParametersEntry
+	nil,                                    // 105: analytics.ScheduleReportRequest.ParametersEntry
+	nil,                                    // 106: analytics.QueryEventsResponse.AggregationsEntry
+	nil,                                    // 107: analytics.ExportDataRequest.OptionsEntry
+	nil,                                    // 108: analytics.SendMetricsToExternalRequest.MetricsEntry
+	nil,                                    // 109: analytics.SendMetricsToExternalRequest.OptionsEntry
+	nil,                                    // 110: analytics.SystemHealthResponse.ComponentsEntry
+	nil,                                    // 111: analytics.SystemHealthResponse.MetricsEntry
+	nil,                                    // 112: analytics.ServiceMetricsResponse.CountersEntry
+	nil,                                    // 113: analytics.ServiceMetricsResponse.GaugesEntry
+	nil,                                    // 114: analytics.ServiceMetricsResponse.HistogramsEntry
+	nil,                                    // 115: analytics.GetCorrelationAnalysisResponse.CorrelationsEntry
+	(*timestamppb.Timestamp)(nil),          // 116: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 117: google.protobuf.Empty
 }
 var file_proto_analytics_proto_depIdxs = []int32{
-	72,  // 0: analytics.AnalyticsEvent.data:type_name -> analytics.AnalyticsEvent.DataEntry
-	96,  // 1: analytics.AnalyticsEvent.timestamp:type_name -> google.protobuf.Timestamp
-	73,  // 2: analytics.AnalyticsEvent.metadata:type_name -> analytics.AnalyticsEvent.MetadataEntry
-	96,  // 3: analytics.AnalyticsEvent.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 0: analytics.AnalyticsEvent.data:type_name -> analytics.AnalyticsEvent.DataEntry
+	116, // 1: analytics.AnalyticsEvent.timestamp:type_name -> google.protobuf.Timestamp
+	93,  // 2: analytics.AnalyticsEvent.metadata:type_name -> analytics.AnalyticsEvent.MetadataEntry
+	116, // 3: analytics.AnalyticsEvent.created_at:type_name -> google.protobuf.Timestamp
 	0,   // 4: analytics.AnalyticsMetric.metric_type:type_name -> analytics.MetricType
-	74,  // 5: analytics.AnalyticsMetric.tags:type_name -> analytics.AnalyticsMetric.TagsEntry
-	96,  // 6: analytics.AnalyticsMetric.timestamp:type_name -> google.protobuf.Timestamp
-	96,  // 7: analytics.AnalyticsMetric.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 5: analytics.AnalyticsMetric.tags:type_name -> analytics.AnalyticsMetric.TagsEntry
+	116, // 6: analytics.AnalyticsMetric.timestamp:type_name -> google.protobuf.Timestamp
+	116, // 7: analytics.AnalyticsMetric.created_at:type_name -> google.protobuf.Timestamp
 	1,   // 8: analytics.AnalyticsReport.report_type:type_name -> analytics.ReportType
-	75,  // 9: analytics.AnalyticsReport.data:type_name -> analytics.AnalyticsReport.DataEntry
-	96,  // 10: analytics.AnalyticsReport.generated_at:type_name -> google.protobuf.Timestamp
-	96,  // 11: analytics.AnalyticsReport.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 9: analytics.AnalyticsReport.data:type_name -> analytics.AnalyticsReport.DataEntry
+	116, // 10: analytics.AnalyticsReport.generated_at:type_name -> google.protobuf.Timestamp
+	116, // 11: analytics.AnalyticsReport.created_at:type_name -> google.protobuf.Timestamp
 	8,   // 12: analytics.Dashboard.panels:type_name -> analytics.DashboardPanel
-	96,  // 13: analytics.Dashboard.created_at:type_name -> google.protobuf.Timestamp
-	96,  // 14: analytics.Dashboard.updated_at:type_name -> google.protobuf.Timestamp
-	76,  // 15: analytics.DashboardPanel.options:type_name -> analytics.DashboardPanel.OptionsEntry
-	96,  // 16: analytics.TimeRange.start:type_name -> google.protobuf.Timestamp
-	96,  // 17: analytics.TimeRange.end:type_name -> google.protobuf.Timestamp
-	77,  // 18: analytics.HealthResponse.dependencies:type_name -> analytics.HealthResponse.DependenciesEntry
+	116, // 13: analytics.Dashboard.created_at:type_name -> google.protobuf.Timestamp
+	116, // 14: analytics.Dashboard.updated_at:type_name -> google.protobuf.Timestamp
+	96,  // 15: analytics.DashboardPanel.options:type_name -> analytics.DashboardPanel.OptionsEntry
+	116, // 16: analytics.TimeRange.start:type_name -> google.protobuf.Timestamp
+	116, // 17: analytics.TimeRange.end:type_name -> google.protobuf.Timestamp
+	97,  // 18: analytics.HealthResponse.dependencies:type_name -> analytics.HealthResponse.DependenciesEntry
 	3,   // 19: analytics.GetMetricsRequest.granularity:type_name -> analytics.TimeGranularity
 	10,  // 20: analytics.GetMetricsRequest.filters:type_name -> analytics.QueryFilter
-	78,  // 21: analytics.GetMetricsResponse.summary:type_name -> analytics.GetMetricsResponse.SummaryEntry
+	98,  // 21: analytics.GetMetricsResponse.summary:type_name -> analytics.GetMetricsResponse.SummaryEntry
 	5,   // 22: analytics.GetMetricsResponse.details:type_name -> analytics.AnalyticsMetric
-	96,  // 23: analytics.GetMetricsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	116, // 23: analytics.GetMetricsResponse.generated_at:type_name -> google.protobuf.Timestamp
 	1,   // 24: analytics.GetReportsRequest.report_type:type_name -> analytics.ReportType
 	6,   // 25: analytics.GetReportsResponse.reports:type_name -> analytics.AnalyticsReport
-	79,  // 26: analytics.RecordEventRequest.data:type_name -> analytics.RecordEventRequest.DataEntry
-	80,  // 27: analytics.RecordEventRequest.metadata:type_name -> analytics.RecordEventRequest.MetadataEntry
-	96,  // 28: analytics.RecordEventRequest.timestamp:type_name -> google.protobuf.Timestamp
+	99,  // 26: analytics.RecordEventRequest.data:type_name -> analytics.RecordEventRequest.DataEntry
+	100, // 27: analytics.RecordEventRequest.metadata:type_name -> analytics.RecordEventRequest.MetadataEntry
+	116, // 28: analytics.RecordEventRequest.timestamp:type_name -> google.protobuf.Timestamp
 	0,   // 29: analytics.RecordMetricRequest.metric_type:type_name -> analytics.MetricType
-	81,  // 30: analytics.RecordMetricRequest.tags:type_name -> analytics.RecordMetricRequest.TagsEntry
-	96,  // 31: analytics.RecordMetricRequest.timestamp:type_name -> google.protobuf.Timestamp
-	82,  // 32: analytics.GetRealtimeMetricsResponse.metrics:type_name -> analytics.GetRealtimeMetricsResponse.MetricsEntry
-	96,  // 33: analytics.GetRealtimeMetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	101, // 30: analytics.RecordMetricRequest.tags:type_name -> analytics.RecordMetricRequest.TagsEntry
+	116, // 31: analytics.RecordMetricRequest.timestamp:type_name -> google.protobuf.Timestamp
+	102, // 32: analytics.GetRealtimeMetricsResponse.metrics:type_name -> analytics.GetRealtimeMetricsResponse.MetricsEntry
+	116, // 33: analytics.GetRealtimeMetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
 	10,  // 34: analytics.StreamEventsRequest.filters:type_name -> analytics.QueryFilter
 	4,   // 35: analytics.EventStreamResponse.event:type_name -> analytics.AnalyticsEvent
-	96,  // 36: analytics.EventStreamResponse.received_at:type_name -> google.protobuf.Timestamp
-	83,  // 37: analytics.GetLiveStatsResponse.stats:type_name -> analytics.GetLiveStatsResponse.StatsEntry
-	96,  // 38: analytics.GetLiveStatsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	116, // 36: analytics.EventStreamResponse.received_at:type_name -> google.protobuf.Timestamp
+	103, // 37: analytics.GetLiveStatsResponse.stats:type_name -> analytics.GetLiveStatsResponse.StatsEntry
+	116, // 38: analytics.GetLiveStatsResponse.timestamp:type_name -> google.protobuf.Timestamp
 	1,   // 39: analytics.GenerateReportRequest.report_type:type_name -> analytics.ReportType
 	9,   // 40: analytics.GenerateReportRequest.time_range:type_name -> analytics.TimeRange
-	84,  // 41: analytics.GenerateReportRequest.parameters:type_name -> analytics.GenerateReportRequest.ParametersEntry
+	104, // 41: analytics.GenerateReportRequest.parameters:type_name -> analytics.GenerateReportRequest.ParametersEntry
 	6,   // 42: analytics.GenerateReportResponse.report:type_name -> analytics.AnalyticsReport
 	6,   // 43: analytics.GetReportStatusResponse.report:type_name -> analytics.AnalyticsReport
 	1,   // 44: analytics.ScheduleReportRequest.report_type:type_name -> analytics.ReportType
-	85,  // 45: analytics.ScheduleReportRequest.parameters:type_name -> analytics.ScheduleReportRequest.ParametersEntry
-	4,   // 46: analytics.GetEventsResponse.events:type_name -> analytics.AnalyticsEvent
-	10,  // 47: analytics.QueryEventsRequest.filters:type_name -> analytics.QueryFilter
-	9,   // 48: analytics.QueryEventsRequest.time_range:type_name -> analytics.TimeRange
-	4,   // 49: analytics.QueryEventsResponse.events:type_name -> analytics.AnalyticsEvent
-	86,  // 50: analytics.QueryEventsResponse.aggregations:type_name -> analytics.QueryEventsResponse.AggregationsEntry
-	16,  // 51: analytics.BulkRecordEventsRequest.events:type_name -> analytics.RecordEventRequest
-	8,   // 52: analytics.CreateDashboardRequest.panels:type_name -> analytics.DashboardPanel
-	7,   // 53: analytics.CreateDashboardResponse.dashboard:type_name -> analytics.Dashboard
-	7,   // 54: analytics.GetDashboardResponse.dashboard:type_name -> analytics.Dashboard
-	8,   // 55: analytics.UpdateDashboardRequest.panels:type_name -> analytics.DashboardPanel
-	7,   // 56: analytics.UpdateDashboardResponse.dashboard:type_name -> analytics.Dashboard
-	7,   // 57: analytics.ListDashboardsResponse.dashboards:type_name -> analytics.Dashboard
-	2,   // 58: analytics.ExportDataRequest.format:type_name -> analytics.ExportFormat
-	9,   // 59: analytics.ExportDataRequest.time_range:type_name -> analytics.TimeRange
-	10,  // 60: analytics.ExportDataRequest.filters:type_name -> analytics.QueryFilter
-	87,  // 61: analytics.ExportDataRequest.options:type_name -> analytics.ExportDataRequest.OptionsEntry
-	88,  // 62: analytics.SendMetricsToExternalRequest.metrics:type_name -> analytics.SendMetricsToExternalRequest.MetricsEntry
-	89,  // 63: analytics.SendMetricsToExternalRequest.options:type_name -> analytics.SendMetricsToExternalRequest.OptionsEntry
-	90,  // 64: analytics.SystemHealthResponse.components:type_name -> analytics.SystemHealthResponse.ComponentsEntry
-	96,  // 65: analytics.SystemHealthResponse.timestamp:type_name -> google.protobuf.Timestamp
-	91,  // 66: analytics.SystemHealthResponse.metrics:type_name -> analytics.SystemHealthResponse.MetricsEntry
-	92,  // 67: analytics.ServiceMetricsResponse.counters:type_name -> analytics.ServiceMetricsResponse.CountersEntry
-	93,  // 68: analytics.ServiceMetricsResponse.gauges:type_name -> analytics.ServiceMetricsResponse.GaugesEntry
-	94,  // 69: analytics.ServiceMetricsResponse.histograms:type_name -> analytics.ServiceMetricsResponse.HistogramsEntry
-	96,  // 70: analytics.ServiceMetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	9,   // 71: analytics.GetTrendAnalysisRequest.time_range:type_name -> analytics.TimeRange
-	3,   // 72: analytics.GetTrendAnalysisRequest.granularity:type_name -> analytics.TimeGranularity
-	59,  // 73: analytics.GetTrendAnalysisResponse.data_points:type_name -> analytics.TrendDataPoint
-	60,  // 74: analytics.GetTrendAnalysisResponse.summary:type_name -> analytics.TrendSummary
-	96,  // 75: analytics.TrendDataPoint.timestamp:type_name -> google.protobuf.Timestamp
-	9,   // 76: analytics.GetCorrelationAnalysisRequest.time_range:type_name -> analytics.TimeRange
-	95,  // 77: analytics.GetCorrelationAnalysisResponse.correlations:type_name -> analytics.GetCorrelationAnalysisResponse.CorrelationsEntry
-	63,  // 78: analytics.GetCorrelationAnalysisResponse.significant_pairs:type_name -> analytics.CorrelationPair
-	9,   // 79: analytics.GetPredictiveAnalyticsRequest.historical_range:type_name -> analytics.TimeRange
-	66,  // 80: analytics.GetPredictiveAnalyticsResponse.predictions:type_name -> analytics.PredictionDataPoint
-	67,  // 81: analytics.GetPredictiveAnalyticsResponse.summary:type_name -> analytics.PredictionSummary
-	96,  // 82: analytics.PredictionDataPoint.timestamp:type_name -> google.protobuf.Timestamp
-	9,   // 83: analytics.GetAnomalyDetectionRequest.time_range:type_name -> analytics.TimeRange
-	70,  // 84: analytics.GetAnomalyDetectionResponse.anomalies:type_name -> analytics.AnomalyDataPoint
-	71,  // 85: analytics.GetAnomalyDetectionResponse.summary:type_name -> analytics.AnomalySummary
-	96,  // 86: analytics.AnomalyDataPoint.timestamp:type_name -> google.protobuf.Timestamp
-	97,  // 87: analytics.AnalyticsService.Health:input_type -> google.protobuf.Empty
-	12,  // 88: analytics.AnalyticsService.GetMetrics:input_type -> analytics.GetMetricsRequest
-	14,  // 89: analytics.AnalyticsService.GetReports:input_type -> analytics.GetReportsRequest
-	16,  // 90: analytics.AnalyticsService.RecordEvent:input_type -> analytics.RecordEventRequest
-	18,  // 91: analytics.AnalyticsService.RecordMetric:input_type -> analytics.RecordMetricRequest
-	20,  // 92: analytics.AnalyticsService.GetRealtimeMetrics:input_type -> analytics.GetRealtimeMetricsRequest
-	22,  // 93: analytics.AnalyticsService.StreamEvents:input_type -> analytics.StreamEventsRequest
-	24,  // 94: analytics.AnalyticsService.GetLiveStats:input_type -> analytics.GetLiveStatsRequest
-	26,  // 95: analytics.AnalyticsService.GenerateReport:input_type -> analytics.GenerateReportRequest
-	28,  // 96: analytics.AnalyticsService.GetReportStatus:input_type -> analytics.GetReportStatusRequest
-	30,  // 97: analytics.AnalyticsService.ScheduleReport:input_type -> analytics.ScheduleReportRequest
-	32,  // 98: analytics.AnalyticsService.GetEvents:input_type -> analytics.GetEventsRequest
-	34,  // 99: analytics.AnalyticsService.QueryEvents:input_type -> analytics.QueryEventsRequest
-	36,  // 100: analytics.AnalyticsService.BulkRecordEvents:input_type -> analytics.BulkRecordEventsRequest
-	38,  // 101: analytics.AnalyticsService.CreateDashboard:input_type -> analytics.CreateDashboardRequest
-	40,  // 102: analytics.AnalyticsService.GetDashboard:input_type -> analytics.GetDashboardRequest
-	42,  // 103: analytics.AnalyticsService.UpdateDashboard:input_type -> analytics.UpdateDashboardRequest
-	44,  // 104: analytics.AnalyticsService.DeleteDashboard:input_type -> analytics.DeleteDashboardRequest
-	45,  // 105: analytics.AnalyticsService.ListDashboards:input_type -> analytics.ListDashboardsRequest
-	47,  // 106: analytics.AnalyticsService.ExportData:input_type -> analytics.ExportDataRequest
-	49,  // 107: analytics.AnalyticsService.SendMetricsToExternal:input_type -> analytics.SendMetricsToExternalRequest
-	51,  // 108: analytics.AnalyticsService.GetExportStatus:input_type -> analytics.GetExportStatusRequest
-	53,  // 109: analytics.AnalyticsService.CleanupOldData:input_type -> analytics.CleanupOldDataRequest
-	97,  // 110: analytics.AnalyticsService.GetSystemHealth:input_type -> google.protobuf.Empty
-	97,  // 111: analytics.AnalyticsService.GetServiceMetrics:input_type -> google.protobuf.Empty
-	57,  // 112: analytics.AnalyticsService.GetTrendAnalysis:input_type -> analytics.GetTrendAnalysisRequest
-	61,  // 113: analytics.AnalyticsService.GetCorrelationAnalysis:input_type -> analytics.GetCorrelationAnalysisRequest
-	64,  // 114: analytics.AnalyticsService.GetPredictiveAnalytics:input_type -> analytics.GetPredictiveAnalyticsRequest
-	68,  // 115: analytics.AnalyticsService.GetAnomalyDetection:input_type -> analytics.GetAnomalyDetectionRequest
-	11,  // 116: analytics.AnalyticsService.Health:output_type -> analytics.HealthResponse
-	13,  // 117: analytics.AnalyticsService.GetMetrics:output_type -> analytics.GetMetricsResponse
-	15,  // 118: analytics.AnalyticsService.GetReports:output_type -> analytics.GetReportsResponse
-	17,  // 119: analytics.AnalyticsService.RecordEvent:output_type -> analytics.RecordEventResponse
-	19,  // 120: analytics.AnalyticsService.RecordMetric:output_type -> analytics.RecordMetricResponse
-	21,  // 121: analytics.AnalyticsService.GetRealtimeMetrics:output_type -> analytics.GetRealtimeMetricsResponse
-	23,  // 122: analytics.AnalyticsService.StreamEvents:output_type -> analytics.EventStreamResponse
-	25,  // 123: analytics.AnalyticsService.GetLiveStats:output_type -> analytics.GetLiveStatsResponse
-	27,  // 124: analytics.AnalyticsService.GenerateReport:output_type -> analytics.GenerateReportResponse
-	29,  // 125: analytics.AnalyticsService.GetReportStatus:output_type -> analytics.GetReportStatusResponse
-	31,  // 126: analytics.AnalyticsService.ScheduleReport:output_type -> analytics.ScheduleReportResponse
-	33,  // 127: analytics.AnalyticsService.GetEvents:output_type -> analytics.GetEventsResponse
-	35,  // 128: analytics.AnalyticsService.QueryEvents:output_type -> analytics.QueryEventsResponse
-	37,  // 129: analytics.AnalyticsService.BulkRecordEvents:output_type -> analytics.BulkRecordEventsResponse
-	39,  // 130: analytics.AnalyticsService.CreateDashboard:output_type -> analytics.CreateDashboardResponse
-	41,  // 131: analytics.AnalyticsService.GetDashboard:output_type -> analytics.GetDashboardResponse
-	43,  // 132: analytics.AnalyticsService.UpdateDashboard:output_type -> analytics.UpdateDashboardResponse
-	97,  // 133: analytics.AnalyticsService.DeleteDashboard:output_type -> google.protobuf.Empty
-	46,  // 134: analytics.AnalyticsService.ListDashboards:output_type -> analytics.ListDashboardsResponse
-	48,  // 135: analytics.AnalyticsService.ExportData:output_type -> analytics.ExportDataResponse
-	50,  // 136: analytics.AnalyticsService.SendMetricsToExternal:output_type -> analytics.SendMetricsToExternalResponse
-	52,  // 137: analytics.AnalyticsService.GetExportStatus:output_type -> analytics.GetExportStatusResponse
-	54,  // 138: analytics.AnalyticsService.CleanupOldData:output_type -> analytics.CleanupOldDataResponse
-	55,  // 139: analytics.AnalyticsService.GetSystemHealth:output_type -> analytics.SystemHealthResponse
-	56,  // 140: analytics.AnalyticsService.GetServiceMetrics:output_type -> analytics.ServiceMetricsResponse
-	58,  // 141: analytics.AnalyticsService.GetTrendAnalysis:output_type -> analytics.GetTrendAnalysisResponse
-	62,  // 142: analytics.AnalyticsService.GetCorrelationAnalysis:output_type -> analytics.GetCorrelationAnalysisResponse
-	65,  // 143: analytics.AnalyticsService.GetPredictiveAnalytics:output_type -> analytics.GetPredictiveAnalyticsResponse
-	69,  // 144: analytics.AnalyticsService.GetAnomalyDetection:output_type -> analytics.GetAnomalyDetectionResponse
-	116, // [116:145] is the sub-list for method output_type
-	87,  // [87:116] is the sub-list for method input_type
-	87,  // [87:87] is the sub-list for extension type_name
-	87,  // [87:87] is the sub-list for extension extendee
-	0,   // [0:87] is the sub-list for field type_name
+	105, // 45: analytics.ScheduleReportRequest.parameters:type_name -> analytics.ScheduleReportRequest.ParametersEntry
+	2,   // 46: analytics.ScheduleReportRequest.format:type_name -> analytics.ExportFormat
+	116, // 47: analytics.ScheduleReportResponse.next_run_at:type_name -> google.protobuf.Timestamp
+	4,   // 48: analytics.GetEventsResponse.events:type_name -> analytics.AnalyticsEvent
+	10,  // 49: analytics.QueryEventsRequest.filters:type_name -> analytics.QueryFilter
+	9,   // 50: analytics.QueryEventsRequest.time_range:type_name -> analytics.TimeRange
+	4,   // 51: analytics.QueryEventsResponse.events:type_name -> analytics.AnalyticsEvent
+	106, // 52: analytics.QueryEventsResponse.aggregations:type_name -> analytics.QueryEventsResponse.AggregationsEntry
+	16,  // 53: analytics.BulkRecordEventsRequest.events:type_name -> analytics.RecordEventRequest
+	8,   // 54: analytics.CreateDashboardRequest.panels:type_name -> analytics.DashboardPanel
+	7,   // 55: analytics.CreateDashboardResponse.dashboard:type_name -> analytics.Dashboard
+	7,   // 56: analytics.GetDashboardResponse.dashboard:type_name -> analytics.Dashboard
+	8,   // 57: analytics.UpdateDashboardRequest.panels:type_name -> analytics.DashboardPanel
+	7,   // 58: analytics.UpdateDashboardResponse.dashboard:type_name -> analytics.Dashboard
+	7,   // 59: analytics.ListDashboardsResponse.dashboards:type_name -> analytics.Dashboard
+	2,   // 60: analytics.ExportDataRequest.format:type_name -> analytics.ExportFormat
+	9,   // 61: analytics.ExportDataRequest.time_range:type_name -> analytics.TimeRange
+	10,  // 62: analytics.ExportDataRequest.filters:type_name -> analytics.QueryFilter
+	107, // 63: analytics.ExportDataRequest.options:type_name -> analytics.ExportDataRequest.OptionsEntry
+	108, // 64: analytics.SendMetricsToExternalRequest.metrics:type_name -> analytics.SendMetricsToExternalRequest.MetricsEntry
+	109, // 65: analytics.SendMetricsToExternalRequest.options:type_name -> analytics.SendMetricsToExternalRequest.OptionsEntry
+	110, // 66: analytics.SystemHealthResponse.components:type_name -> analytics.SystemHealthResponse.ComponentsEntry
+	116, // 67: analytics.SystemHealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	111, // 68: analytics.SystemHealthResponse.metrics:type_name -> analytics.SystemHealthResponse.MetricsEntry
+	112, // 69: analytics.ServiceMetricsResponse.counters:type_name -> analytics.ServiceMetricsResponse.CountersEntry
+	113, // 70: analytics.ServiceMetricsResponse.gauges:type_name -> analytics.ServiceMetricsResponse.GaugesEntry
+	114, // 71: analytics.ServiceMetricsResponse.histograms:type_name -> analytics.ServiceMetricsResponse.HistogramsEntry
+	116, // 72: analytics.ServiceMetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	9,   // 73: analytics.GetTrendAnalysisRequest.time_range:type_name -> analytics.TimeRange
+	3,   // 74: analytics.GetTrendAnalysisRequest.granularity:type_name -> analytics.TimeGranularity
+	59,  // 75: analytics.GetTrendAnalysisResponse.data_points:type_name -> analytics.TrendDataPoint
+	60,  // 76: analytics.GetTrendAnalysisResponse.summary:type_name -> analytics.TrendSummary
+	116, // 77: analytics.TrendDataPoint.timestamp:type_name -> google.protobuf.Timestamp
+	9,   // 78: analytics.GetCorrelationAnalysisRequest.time_range:type_name -> analytics.TimeRange
+	115, // 79: analytics.GetCorrelationAnalysisResponse.correlations:type_name -> analytics.GetCorrelationAnalysisResponse.CorrelationsEntry
+	63,  // 80: analytics.GetCorrelationAnalysisResponse.significant_pairs:type_name -> analytics.CorrelationPair
+	9,   // 81: analytics.GetPredictiveAnalyticsRequest.historical_range:type_name -> analytics.TimeRange
+	66,  // 82: analytics.GetPredictiveAnalyticsResponse.predictions:type_name -> analytics.PredictionDataPoint
+	67,  // 83: analytics.GetPredictiveAnalyticsResponse.summary:type_name -> analytics.PredictionSummary
+	116, // 84: analytics.PredictionDataPoint.timestamp:type_name -> google.protobuf.Timestamp
+	9,   // 85: analytics.GetAnomalyDetectionRequest.time_range:type_name -> analytics.TimeRange
+	70,  // 86: analytics.GetAnomalyDetectionResponse.anomalies:type_name -> analytics.AnomalyDataPoint
+	71,  // 87: analytics.GetAnomalyDetectionResponse.summary:type_name -> analytics.AnomalySummary
+	116, // 88: analytics.AnomalyDataPoint.timestamp:type_name -> google.protobuf.Timestamp
+	9,   // 89: analytics.GetNetworkAnalyticsRequest.time_range:type_name -> analytics.TimeRange
+	74,  // 90: analytics.GetNetworkAnalyticsResponse.outcomes:type_name -> analytics.VisitOutcomes
+	75,  // 91: analytics.GetNetworkAnalyticsResponse.flows:type_name -> analytics.ClubFlow
+	76,  // 92: analytics.GetNetworkAnalyticsResponse.agreements:type_name -> analytics.AgreementNetworkStats
+	79,  // 93: analytics.GetNetworkAnalyticsResponse.clubs:type_name -> analytics.ClubNetworkStats
+	77,  // 94: analytics.AgreementNetworkStats.utilization:type_name -> analytics.AgreementUtilization
+	74,  // 95: analytics.AgreementNetworkStats.outcomes:type_name -> analytics.VisitOutcomes
+	78,  // 96: analytics.AgreementNetworkStats.trend:type_name -> analytics.AgreementTrendPoint
+	116, // 97: analytics.AgreementTrendPoint.month:type_name -> google.protobuf.Timestamp
+	74,  // 98: analytics.ClubNetworkStats.hosted:type_name -> analytics.VisitOutcomes
+	80,  // 99: analytics.ClubNetworkStats.host_rating:type_name -> analytics.VisitRating
+	80,  // 100: analytics.ClubNetworkStats.guest_rating:type_name -> analytics.VisitRating
+	81,  // 101: analytics.ClubNetworkStats.facilities:type_name -> analytics.FacilityUsage
+	9,   // 102: analytics.GetCohortAnalysisRequest.time_range:type_name -> analytics.TimeRange
+	84,  // 103: analytics.GetCohortAnalysisResponse.cohorts:type_name -> analytics.MemberCohort
+	86,  // 104: analytics.GetCohortAnalysisResponse.churn:type_name -> analytics.MembershipChurn
+	87,  // 105: analytics.GetCohortAnalysisResponse.survival:type_name -> analytics.SurvivalCurve
+	89,  // 106: analytics.GetCohortAnalysisResponse.usage_renewal:type_name -> analytics.UsageRenewal
+	91,  // 107: analytics.GetCohortAnalysisResponse.at_risk:type_name -> analytics.AtRiskMember
+	116, // 108: analytics.MemberCohort.month:type_name -> google.protobuf.Timestamp
+	85,  // 109: analytics.MemberCohort.retention:type_name -> analytics.RetentionCell
+	88,  // 110: analytics.SurvivalCurve.points:type_name -> analytics.SurvivalPoint
+	90,  // 111: analytics.UsageRenewal.bands:type_name -> analytics.UsageBand
+	116, // 112: analytics.AtRiskMember.last_activity_at:type_name -> google.protobuf.Timestamp
+	117, // 113: analytics.AnalyticsService.Health:input_type -> google.protobuf.Empty
+	12,  // 114: analytics.AnalyticsService.GetMetrics:input_type -> analytics.GetMetricsRequest
+	14,  // 115: analytics.AnalyticsService.GetReports:input_type -> analytics.GetReportsRequest
+	16,  // 116: analytics.AnalyticsService.RecordEvent:input_type -> analytics.RecordEventRequest
+	18,  // 117: analytics.AnalyticsService.RecordMetric:input_type -> analytics.RecordMetricRequest
+	20,  // 118: analytics.AnalyticsService.GetRealtimeMetrics:input_type -> analytics.GetRealtimeMetricsRequest
+	22,  // 119: analytics.AnalyticsService.StreamEvents:input_type -> analytics.StreamEventsRequest
+	24,  // 120: analytics.AnalyticsService.GetLiveStats:input_type -> analytics.GetLiveStatsRequest
+	26,  // 121: analytics.AnalyticsService.GenerateReport:input_type -> analytics.GenerateReportRequest
+	28,  // 122: analytics.AnalyticsService.GetReportStatus:input_type -> analytics.GetReportStatusRequest
+	30,  // 123: analytics.AnalyticsService.ScheduleReport:input_type -> analytics.ScheduleReportRequest
+	32,  // 124: analytics.AnalyticsService.GetEvents:input_type -> analytics.GetEventsRequest
+	34,  // 125: analytics.AnalyticsService.QueryEvents:input_type -> analytics.QueryEventsRequest
+	36,  // 126: analytics.AnalyticsService.BulkRecordEvents:input_type -> analytics.BulkRecordEventsRequest
+	38,  // 127: analytics.AnalyticsService.CreateDashboard:input_type -> analytics.CreateDashboardRequest
+	40,  // 128: analytics.AnalyticsService.GetDashboard:input_type -> analytics.GetDashboardRequest
+	42,  // 129: analytics.AnalyticsService.UpdateDashboard:input_type -> analytics.UpdateDashboardRequest
+	44,  // 130: analytics.AnalyticsService.DeleteDashboard:input_type -> analytics.DeleteDashboardRequest
+	45,  // 131: analytics.AnalyticsService.ListDashboards:input_type -> analytics.ListDashboardsRequest
+	47,  // 132: analytics.AnalyticsService.ExportData:input_type -> analytics.ExportDataRequest
+	49,  // 133: analytics.AnalyticsService.SendMetricsToExternal:input_type -> analytics.SendMetricsToExternalRequest
+	51,  // 134: analytics.AnalyticsService.GetExportStatus:input_type -> analytics.GetExportStatusRequest
+	53,  // 135: analytics.AnalyticsService.CleanupOldData:input_type -> analytics.CleanupOldDataRequest
+	117, // 136: analytics.AnalyticsService.GetSystemHealth:input_type -> google.protobuf.Empty
+	117, // 137: analytics.AnalyticsService.GetServiceMetrics:input_type -> google.protobuf.Empty
+	57,  // 138: analytics.AnalyticsService.GetTrendAnalysis:input_type -> analytics.GetTrendAnalysisRequest
+	61,  // 139: analytics.AnalyticsService.GetCorrelationAnalysis:input_type -> analytics.GetCorrelationAnalysisRequest
+	64,  // 140: analytics.AnalyticsService.GetPredictiveAnalytics:input_type -> analytics.GetPredictiveAnalyticsRequest
+	68,  // 141: analytics.AnalyticsService.GetAnomalyDetection:input_type -> analytics.GetAnomalyDetectionRequest
+	72,  // 142: analytics.AnalyticsService.GetNetworkAnalytics:input_type -> analytics.GetNetworkAnalyticsRequest
+	82,  // 143: analytics.AnalyticsService.GetCohortAnalysis:input_type -> analytics.GetCohortAnalysisRequest
+	11,  // 144: analytics.AnalyticsService.Health:output_type -> analytics.HealthResponse
+	13,  // 145: analytics.AnalyticsService.GetMetrics:output_type -> analytics.GetMetricsResponse
+	15,  // 146: analytics.AnalyticsService.GetReports:output_type -> analytics.GetReportsResponse
+	17,  // 147: analytics.AnalyticsService.RecordEvent:output_type -> analytics.RecordEventResponse
+	19,  // 148: analytics.AnalyticsService.RecordMetric:output_type -> analytics.RecordMetricResponse
+	21,  // 149: analytics.AnalyticsService.GetRealtimeMetrics:output_type -> analytics.GetRealtimeMetricsResponse
+	23,  // 150: analytics.AnalyticsService.StreamEvents:output_type -> analytics.EventStreamResponse
+	25,  // 151: analytics.AnalyticsService.GetLiveStats:output_type -> analytics.GetLiveStatsResponse
+	27,  // 152: analytics.AnalyticsService.GenerateReport:output_type -> analytics.GenerateReportResponse
+	29,  // 153: analytics.AnalyticsService.GetReportStatus:output_type -> analytics.GetReportStatusResponse
+	31,  // 154: analytics.AnalyticsService.ScheduleReport:output_type -> analytics.ScheduleReportResponse
+	33,  // 155: analytics.AnalyticsService.GetEvents:output_type -> analytics.GetEventsResponse
+	35,  // 156: analytics.AnalyticsService.QueryEvents:output_type -> analytics.QueryEventsResponse
+	37,  // 157: analytics.AnalyticsService.BulkRecordEvents:output_type -> analytics.BulkRecordEventsResponse
+	39,  // 158: analytics.AnalyticsService.CreateDashboard:output_type -> analytics.CreateDashboardResponse
+	41,  // 159: analytics.AnalyticsService.GetDashboard:output_type -> analytics.GetDashboardResponse
+	43,  // 160: analytics.AnalyticsService.UpdateDashboard:output_type -> analytics.UpdateDashboardResponse
+	117, // 161: analytics.AnalyticsService.DeleteDashboard:output_type -> google.protobuf.Empty
+	46,  // 162: analytics.AnalyticsService.ListDashboards:output_type -> analytics.ListDashboardsResponse
+	48,  // 163: analytics.AnalyticsService.ExportData:output_type -> analytics.ExportDataResponse
+	50,  // 164: analytics.AnalyticsService.SendMetricsToExternal:output_type -> analytics.SendMetricsToExternalResponse
+	52,  // 165: analytics.AnalyticsService.GetExportStatus:output_type -> analytics.GetExportStatusResponse
+	54,  // 166: analytics.AnalyticsService.CleanupOldData:output_type -> analytics.CleanupOldDataResponse
+	55,  // 167: analytics.AnalyticsService.GetSystemHealth:output_type -> analytics.SystemHealthResponse
+	56,  // 168: analytics.AnalyticsService.GetServiceMetrics:output_type -> analytics.ServiceMetricsResponse
+	58,  // 169: analytics.AnalyticsService.GetTrendAnalysis:output_type -> analytics.GetTrendAnalysisResponse
+	62,  // 170: analytics.AnalyticsService.GetCorrelationAnalysis:output_type -> analytics.GetCorrelationAnalysisResponse
+	65,  // 171: analytics.AnalyticsService.GetPredictiveAnalytics:output_type -> analytics.GetPredictiveAnalyticsResponse
+	69,  // 172: analytics.AnalyticsService.GetAnomalyDetection:output_type -> analytics.GetAnomalyDetectionResponse
+	73,  // 173: analytics.AnalyticsService.GetNetworkAnalytics:output_type -> analytics.GetNetworkAnalyticsResponse
+	83,  // 174: analytics.AnalyticsService.GetCohortAnalysis:output_type -> analytics.GetCohortAnalysisResponse
+	144, // [144:175] is the sub-list for method output_type
+	113, // [113:144] is the sub-list for method input_type
+	113, // [113:113] is the sub-list for extension type_name
+	113, // [113:113] is the sub-list for extension extendee
+	0,   // [0:113] is the sub-list for field type_name
 }
 
 func init() { file_proto_analytics_proto_init() }
@@ -5546,7 +7255,7 @@ func file_proto_analytics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_analytics_proto_rawDesc), len(file_proto_analytics_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   92,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
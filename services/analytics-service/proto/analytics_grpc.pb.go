@@ -49,6 +49,8 @@ const (
 	AnalyticsService_GetCorrelationAnalysis_FullMethodName = "/analytics.AnalyticsService/GetCorrelationAnalysis"
 	AnalyticsService_GetPredictiveAnalytics_FullMethodName = "/analytics.AnalyticsService/GetPredictiveAnalytics"
 	AnalyticsService_GetAnomalyDetection_FullMethodName    = "/analytics.AnalyticsService/GetAnomalyDetection"
+	AnalyticsService_GetNetworkAnalytics_FullMethodName    = "/analytics.AnalyticsService/GetNetworkAnalytics"
+	AnalyticsService_GetCohortAnalysis_FullMethodName      = "/analytics.AnalyticsService/GetCohortAnalysis"
 )
 
 // AnalyticsServiceClient is the client API for AnalyticsService service.
@@ -95,6 +97,10 @@ type AnalyticsServiceClient interface {
 	GetCorrelationAnalysis(ctx context.Context, in *GetCorrelationAnalysisRequest, opts ...grpc.CallOption) (*GetCorrelationAnalysisResponse, error)
 	GetPredictiveAnalytics(ctx context.Context, in *GetPredictiveAnalyticsRequest, opts ...grpc.CallOption) (*GetPredictiveAnalyticsResponse, error)
 	GetAnomalyDetection(ctx context.Context, in *GetAnomalyDetectionRequest, opts ...grpc.CallOption) (*GetAnomalyDetectionResponse, error)
+	// Reciprocal network analytics
+	GetNetworkAnalytics(ctx context.Context, in *GetNetworkAnalyticsRequest, opts ...grpc.CallOption) (*GetNetworkAnalyticsResponse, error)
+	// Membership cohorts
+	GetCohortAnalysis(ctx context.Context, in *GetCohortAnalysisRequest, opts ...grpc.CallOption) (*GetCohortAnalysisResponse, error)
 }
 
 type analyticsServiceClient struct {
@@ -404,6 +410,26 @@ func (c *analyticsServiceClient) GetAnomalyDetection(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *analyticsServiceClient) GetNetworkAnalytics(ctx context.Context, in *GetNetworkAnalyticsRequest, opts ...grpc.CallOption) (*GetNetworkAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNetworkAnalyticsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetNetworkAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetCohortAnalysis(ctx context.Context, in *GetCohortAnalysisRequest, opts ...grpc.CallOption) (*GetCohortAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCohortAnalysisResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetCohortAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyticsServiceServer is the server API for AnalyticsService service.
 // All implementations must embed UnimplementedAnalyticsServiceServer
 // for forward compatibility.
@@ -448,6 +474,10 @@ type AnalyticsServiceServer interface {
 	GetCorrelationAnalysis(context.Context, *GetCorrelationAnalysisRequest) (*GetCorrelationAnalysisResponse, error)
 	GetPredictiveAnalytics(context.Context, *GetPredictiveAnalyticsRequest) (*GetPredictiveAnalyticsResponse, error)
 	GetAnomalyDetection(context.Context, *GetAnomalyDetectionRequest) (*GetAnomalyDetectionResponse, error)
+	// Reciprocal network analytics
+	GetNetworkAnalytics(context.Context, *GetNetworkAnalyticsRequest) (*GetNetworkAnalyticsResponse, error)
+	// Membership cohorts
+	GetCohortAnalysis(context.Context, *GetCohortAnalysisRequest) (*GetCohortAnalysisResponse, error)
 	mustEmbedUnimplementedAnalyticsServiceServer()
 }
 
@@ -545,6 +575,12 @@ func (UnimplementedAnalyticsServiceServer) GetPredictiveAnalytics(context.Contex
 func (UnimplementedAnalyticsServiceServer) GetAnomalyDetection(context.Context, *GetAnomalyDetectionRequest) (*GetAnomalyDetectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalyDetection not implemented")
 }
+func (UnimplementedAnalyticsServiceServer) GetNetworkAnalytics(context.Context, *GetNetworkAnalyticsRequest) (*GetNetworkAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkAnalytics not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetCohortAnalysis(context.Context, *GetCohortAnalysisRequest) (*GetCohortAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCohortAnalysis not implemented")
+}
 func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
 func (UnimplementedAnalyticsServiceServer) testEmbeddedByValue()                          {}
 
@@ -1081,6 +1117,42 @@ func _AnalyticsService_GetAnomalyDetection_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyticsService_GetNetworkAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetNetworkAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetNetworkAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetNetworkAnalytics(ctx, req.(*GetNetworkAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetCohortAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCohortAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetCohortAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetCohortAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetCohortAnalysis(ctx, req.(*GetCohortAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1200,6 +1272,14 @@ var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAnomalyDetection",
 			Handler:    _AnalyticsService_GetAnomalyDetection_Handler,
 		},
+		{
+			MethodName: "GetNetworkAnalytics",
+			Handler:    _AnalyticsService_GetNetworkAnalytics_Handler,
+		},
+		{
+			MethodName: "GetCohortAnalysis",
+			Handler:    _AnalyticsService_GetCohortAnalysis_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
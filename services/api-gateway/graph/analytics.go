@@ -0,0 +1,193 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"reciprocal-clubs-backend/services/api-gateway/graph/model"
+	"reciprocal-clubs-backend/services/api-gateway/internal/clients"
+)
+
+const monthFormat = "2006-01"
+
+func formatID(id uint32) string {
+	return fmt.Sprintf("%d", id)
+}
+
+// convertVisitAnalytics reports the visits made by the club's members
+func convertVisitAnalytics(clubID uint32, network *clients.GetNetworkAnalyticsResponse) *model.VisitAnalytics {
+	result := &model.VisitAnalytics{
+		MonthlyVisits:   []*model.MonthlyVisit{},
+		TopDestinations: []*model.ClubVisitCount{},
+	}
+
+	for _, flow := range network.Flows {
+		if flow.HomeClubID != clubID || flow.Visits == 0 {
+			continue
+		}
+		result.TotalVisits += int(flow.Visits)
+		result.TopDestinations = append(result.TopDestinations, &model.ClubVisitCount{
+			Club:  &model.Club{ID: formatID(flow.VisitingClubID)},
+			Count: int(flow.Visits),
+		})
+	}
+	sort.SliceStable(result.TopDestinations, func(i, j int) bool {
+		return result.TopDestinations[i].Count > result.TopDestinations[j].Count
+	})
+
+	result.MonthlyVisits = monthlyUsage(network.Agreements, func(agreement clients.AgreementNetworkStats, point clients.AgreementTrendPoint) int32 {
+		if agreement.ProposingClubID == clubID {
+			return point.Outbound
+		}
+		if agreement.TargetClubID == clubID {
+			return point.Inbound
+		}
+		return 0
+	})
+	return result
+}
+
+func convertMemberAnalytics(members *clients.GetMemberAnalyticsResponse) *model.MemberAnalytics {
+	result := &model.MemberAnalytics{
+		TotalMembers:           int(members.TotalMembers),
+		ActiveMembers:          int(members.ActiveMembers),
+		NewMembersThisMonth:    int(members.NewThisMonth),
+		MembershipDistribution: []*model.MembershipTypeCount{},
+	}
+	for _, membershipType := range model.AllMembershipType {
+		if count, ok := members.MembershipTypes[membershipType.String()]; ok {
+			result.MembershipDistribution = append(result.MembershipDistribution, &model.MembershipTypeCount{
+				Type:  membershipType,
+				Count: int(count),
+			})
+		}
+	}
+	return result
+}
+
+func convertReciprocalAnalytics(agreements *clients.ListAgreementsResponse, network *clients.GetNetworkAnalyticsResponse) *model.ReciprocalAnalytics {
+	result := &model.ReciprocalAnalytics{TotalAgreements: int(agreements.Total)}
+	for _, agreement := range agreements.Agreements {
+		switch {
+		case strings.EqualFold(agreement.Status, "active"):
+			result.ActiveAgreements++
+		case strings.EqualFold(agreement.Status, "pending"):
+			result.PendingAgreements++
+		}
+	}
+
+	result.MonthlyReciprocalUsage = monthlyUsage(network.Agreements, func(_ clients.AgreementNetworkStats, point clients.AgreementTrendPoint) int32 {
+		return point.Outbound + point.Inbound
+	})
+	return result
+}
+
+// monthlyUsage sums a count over the agreements' trends, month by month
+func monthlyUsage(agreements []clients.AgreementNetworkStats, count func(clients.AgreementNetworkStats, clients.AgreementTrendPoint) int32) []*model.MonthlyVisit {
+	totals := make(map[string]int)
+	months := []string{}
+	for _, agreement := range agreements {
+		for _, point := range agreement.Trend {
+			month := point.Month.UTC().Format(monthFormat)
+			if _, ok := totals[month]; !ok {
+				months = append(months, month)
+			}
+			totals[month] += int(count(agreement, point))
+		}
+	}
+	sort.Strings(months)
+
+	result := make([]*model.MonthlyVisit, 0, len(months))
+	for _, month := range months {
+		result = append(result, &model.MonthlyVisit{Month: month, Count: totals[month]})
+	}
+	return result
+}
+
+func convertNetworkAnalytics(network *clients.GetNetworkAnalyticsResponse) *model.NetworkAnalytics {
+	result := &model.NetworkAnalytics{
+		Outcomes:   convertVisitOutcomes(network.Outcomes),
+		Flows:      make([]*model.ClubFlow, 0, len(network.Flows)),
+		Agreements: make([]*model.AgreementNetworkStats, 0, len(network.Agreements)),
+		Clubs:      make([]*model.ClubNetworkStats, 0, len(network.Clubs)),
+	}
+
+	for _, flow := range network.Flows {
+		result.Flows = append(result.Flows, &model.ClubFlow{
+			HomeClubID:     formatID(flow.HomeClubID),
+			VisitingClubID: formatID(flow.VisitingClubID),
+			Visits:         int(flow.Visits),
+			Members:        int(flow.Members),
+			Requested:      int(flow.Requested),
+		})
+	}
+
+	for _, agreement := range network.Agreements {
+		stats := &model.AgreementNetworkStats{
+			AgreementID:       formatID(agreement.AgreementID),
+			ProposingClubID:   formatID(agreement.ProposingClubID),
+			TargetClubID:      formatID(agreement.TargetClubID),
+			Status:            agreement.Status,
+			Outbound:          int(agreement.Outbound),
+			Inbound:           int(agreement.Inbound),
+			Balance:           agreement.Balance,
+			MaxVisitsPerMonth: int(agreement.MaxVisitsPerMonth),
+			Outcomes:          convertVisitOutcomes(agreement.Outcomes),
+			Trend:             make([]*model.AgreementTrendPoint, 0, len(agreement.Trend)),
+		}
+		if u := agreement.Utilization; u != nil {
+			stats.Utilization = &model.AgreementUtilization{
+				MemberMonths:  int(u.MemberMonths),
+				AverageVisits: u.AverageVisits,
+				Rate:          u.Rate,
+				AtLimit:       int(u.AtLimit),
+			}
+		}
+		for _, point := range agreement.Trend {
+			stats.Trend = append(stats.Trend, &model.AgreementTrendPoint{
+				Month:    point.Month,
+				Outbound: int(point.Outbound),
+				Inbound:  int(point.Inbound),
+			})
+		}
+		result.Agreements = append(result.Agreements, stats)
+	}
+
+	for _, club := range network.Clubs {
+		stats := &model.ClubNetworkStats{
+			ClubID:      formatID(club.ClubID),
+			Hosted:      convertVisitOutcomes(club.Hosted),
+			VisitsMade:  int(club.VisitsMade),
+			Partners:    int(club.Partners),
+			HostRating:  convertVisitRating(club.HostRating),
+			GuestRating: convertVisitRating(club.GuestRating),
+			Facilities:  make([]*model.FacilityUsage, 0, len(club.Facilities)),
+		}
+		for _, usage := range club.Facilities {
+			stats.Facilities = append(stats.Facilities, &model.FacilityUsage{Facility: usage.Facility, Visits: int(usage.Visits)})
+		}
+		result.Clubs = append(result.Clubs, stats)
+	}
+
+	return result
+}
+
+func convertVisitOutcomes(outcomes clients.VisitOutcomes) *model.VisitOutcomes {
+	return &model.VisitOutcomes{
+		Visits:           int(outcomes.Visits),
+		Open:             int(outcomes.Open),
+		Attended:         int(outcomes.Attended),
+		Cancelled:        int(outcomes.Cancelled),
+		NoShows:          int(outcomes.NoShows),
+		CancellationRate: outcomes.CancellationRate,
+		NoShowRate:       outcomes.NoShowRate,
+	}
+}
+
+func convertVisitRating(rating *clients.VisitRating) *model.VisitRating {
+	if rating == nil {
+		return nil
+	}
+	return &model.VisitRating{Average: rating.Average, Count: int(rating.Count)}
+}
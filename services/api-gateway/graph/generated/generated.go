@@ -59,6 +59,20 @@ type ComplexityRoot struct {
 		Street     func(childComplexity int) int
 	}
 
+	AgreementNetworkStats struct {
+		AgreementID       func(childComplexity int) int
+		Balance           func(childComplexity int) int
+		Inbound           func(childComplexity int) int
+		MaxVisitsPerMonth func(childComplexity int) int
+		Outbound          func(childComplexity int) int
+		Outcomes          func(childComplexity int) int
+		ProposingClubID   func(childComplexity int) int
+		Status            func(childComplexity int) int
+		TargetClubID      func(childComplexity int) int
+		Trend             func(childComplexity int) int
+		Utilization       func(childComplexity int) int
+	}
+
 	AgreementTerms struct {
 		BlackoutDates     func(childComplexity int) int
 		MaxVisitsPerMonth func(childComplexity int) int
@@ -66,8 +80,22 @@ type ComplexityRoot struct {
 		SpecialConditions func(childComplexity int) int
 	}
 
+	AgreementTrendPoint struct {
+		Inbound  func(childComplexity int) int
+		Month    func(childComplexity int) int
+		Outbound func(childComplexity int) int
+	}
+
+	AgreementUtilization struct {
+		AtLimit       func(childComplexity int) int
+		AverageVisits func(childComplexity int) int
+		MemberMonths  func(childComplexity int) int
+		Rate          func(childComplexity int) int
+	}
+
 	Analytics struct {
 		Members     func(childComplexity int) int
+		Network     func(childComplexity int) int
 		Reciprocals func(childComplexity int) int
 		Visits      func(childComplexity int) int
 	}
@@ -91,6 +119,24 @@ type ComplexityRoot struct {
 		Website     func(childComplexity int) int
 	}
 
+	ClubFlow struct {
+		HomeClubID     func(childComplexity int) int
+		Members        func(childComplexity int) int
+		Requested      func(childComplexity int) int
+		VisitingClubID func(childComplexity int) int
+		Visits         func(childComplexity int) int
+	}
+
+	ClubNetworkStats struct {
+		ClubID      func(childComplexity int) int
+		Facilities  func(childComplexity int) int
+		GuestRating func(childComplexity int) int
+		HostRating  func(childComplexity int) int
+		Hosted      func(childComplexity int) int
+		Partners    func(childComplexity int) int
+		VisitsMade  func(childComplexity int) int
+	}
+
 	ClubSettings struct {
 		AllowReciprocal   func(childComplexity int) int
 		MaxVisitsPerMonth func(childComplexity int) int
@@ -109,6 +155,11 @@ type ComplexityRoot struct {
 		Relationship func(childComplexity int) int
 	}
 
+	FacilityUsage struct {
+		Facility func(childComplexity int) int
+		Visits   func(childComplexity int) int
+	}
+
 	Member struct {
 		BlockchainIdentity func(childComplexity int) int
 		ClubID             func(childComplexity int) int
@@ -163,6 +214,7 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
+		AdminRevokeSession         func(childComplexity int, id string) int
 		ApproveReciprocalAgreement func(childComplexity int, id string) int
 		CancelVisit                func(childComplexity int, id string, reason *string) int
 		CastVote                   func(childComplexity int, input model.CastVoteInput) int
@@ -182,6 +234,9 @@ type ComplexityRoot struct {
 		RefreshToken               func(childComplexity int, refreshToken string) int
 		Register                   func(childComplexity int, input model.RegisterInput) int
 		RejectReciprocalAgreement  func(childComplexity int, id string, reason *string) int
+		RevokeOtherSessions        func(childComplexity int, currentSessionID string) int
+		RevokeSession              func(childComplexity int, id string) int
+		RevokeUserSessions         func(childComplexity int, userID string) int
 		SuspendMember              func(childComplexity int, id string, reason *string) int
 		SuspendReciprocalAgreement func(childComplexity int, id string, reason *string) int
 		SyncBlockchainData         func(childComplexity int) int
@@ -189,6 +244,13 @@ type ComplexityRoot struct {
 		VerifyVisit                func(childComplexity int, id string) int
 	}
 
+	NetworkAnalytics struct {
+		Agreements func(childComplexity int) int
+		Clubs      func(childComplexity int) int
+		Flows      func(childComplexity int) int
+		Outcomes   func(childComplexity int) int
+	}
+
 	Notification struct {
 		Channels    func(childComplexity int) int
 		CreatedAt   func(childComplexity int) int
@@ -237,12 +299,14 @@ type ComplexityRoot struct {
 	Query struct {
 		Analytics               func(childComplexity int, startDate *time.Time, endDate *time.Time) int
 		Club                    func(childComplexity int, id string) int
+		ClubSessions            func(childComplexity int, pagination *model.PaginationInput, userID *string, search *string, activeOnly *bool) int
 		Clubs                   func(childComplexity int) int
 		Me                      func(childComplexity int) int
 		Member                  func(childComplexity int, id string) int
 		MemberByNumber          func(childComplexity int, memberNumber string) int
 		Members                 func(childComplexity int, pagination *model.PaginationInput, status *model.MemberStatus) int
 		MyClub                  func(childComplexity int) int
+		MySessions              func(childComplexity int, currentSessionID *string) int
 		MyVisits                func(childComplexity int, pagination *model.PaginationInput) int
 		MyVotes                 func(childComplexity int, proposalID *string) int
 		Notification            func(childComplexity int, id string) int
@@ -282,6 +346,26 @@ type ComplexityRoot struct {
 		TotalAgreements        func(childComplexity int) int
 	}
 
+	Session struct {
+		Active         func(childComplexity int) int
+		AuthProvider   func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		Current        func(childComplexity int) int
+		Device         func(childComplexity int) int
+		ExpiresAt      func(childComplexity int) int
+		ID             func(childComplexity int) int
+		IPAddress      func(childComplexity int) int
+		LastActivityAt func(childComplexity int) int
+		Location       func(childComplexity int) int
+		UserEmail      func(childComplexity int) int
+		UserID         func(childComplexity int) int
+	}
+
+	SessionConnection struct {
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
 	Subscription struct {
 		NotificationReceived     func(childComplexity int) int
 		ProposalUpdated          func(childComplexity int, proposalID *string) int
@@ -345,6 +429,21 @@ type ComplexityRoot struct {
 		PageInfo func(childComplexity int) int
 	}
 
+	VisitOutcomes struct {
+		Attended         func(childComplexity int) int
+		CancellationRate func(childComplexity int) int
+		Cancelled        func(childComplexity int) int
+		NoShowRate       func(childComplexity int) int
+		NoShows          func(childComplexity int) int
+		Open             func(childComplexity int) int
+		Visits           func(childComplexity int) int
+	}
+
+	VisitRating struct {
+		Average func(childComplexity int) int
+		Count   func(childComplexity int) int
+	}
+
 	Vote struct {
 		Choice     func(childComplexity int) int
 		Comment    func(childComplexity int) int
@@ -360,6 +459,10 @@ type MutationResolver interface {
 	Register(ctx context.Context, input model.RegisterInput) (*model.AuthPayload, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*model.AuthPayload, error)
 	Logout(ctx context.Context) (bool, error)
+	RevokeSession(ctx context.Context, id string) (bool, error)
+	RevokeOtherSessions(ctx context.Context, currentSessionID string) (int, error)
+	AdminRevokeSession(ctx context.Context, id string) (bool, error)
+	RevokeUserSessions(ctx context.Context, userID string) (int, error)
 	CreateMember(ctx context.Context, input model.CreateMemberInput) (*model.Member, error)
 	UpdateMember(ctx context.Context, id string, input model.MemberProfileInput) (*model.Member, error)
 	SuspendMember(ctx context.Context, id string, reason *string) (*model.Member, error)
@@ -383,6 +486,8 @@ type MutationResolver interface {
 }
 type QueryResolver interface {
 	Me(ctx context.Context) (*auth.User, error)
+	MySessions(ctx context.Context, currentSessionID *string) ([]*model.Session, error)
+	ClubSessions(ctx context.Context, pagination *model.PaginationInput, userID *string, search *string, activeOnly *bool) (*model.SessionConnection, error)
 	Members(ctx context.Context, pagination *model.PaginationInput, status *model.MemberStatus) (*model.MemberConnection, error)
 	Member(ctx context.Context, id string) (*model.Member, error)
 	MemberByNumber(ctx context.Context, memberNumber string) (*model.Member, error)
@@ -477,6 +582,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Address.Street(childComplexity), true
 
+	case "AgreementNetworkStats.agreementId":
+		if e.complexity.AgreementNetworkStats.AgreementID == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.AgreementID(childComplexity), true
+
+	case "AgreementNetworkStats.balance":
+		if e.complexity.AgreementNetworkStats.Balance == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.Balance(childComplexity), true
+
+	case "AgreementNetworkStats.inbound":
+		if e.complexity.AgreementNetworkStats.Inbound == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.Inbound(childComplexity), true
+
+	case "AgreementNetworkStats.maxVisitsPerMonth":
+		if e.complexity.AgreementNetworkStats.MaxVisitsPerMonth == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.MaxVisitsPerMonth(childComplexity), true
+
+	case "AgreementNetworkStats.outbound":
+		if e.complexity.AgreementNetworkStats.Outbound == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.Outbound(childComplexity), true
+
+	case "AgreementNetworkStats.outcomes":
+		if e.complexity.AgreementNetworkStats.Outcomes == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.Outcomes(childComplexity), true
+
+	case "AgreementNetworkStats.proposingClubId":
+		if e.complexity.AgreementNetworkStats.ProposingClubID == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.ProposingClubID(childComplexity), true
+
+	case "AgreementNetworkStats.status":
+		if e.complexity.AgreementNetworkStats.Status == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.Status(childComplexity), true
+
+	case "AgreementNetworkStats.targetClubId":
+		if e.complexity.AgreementNetworkStats.TargetClubID == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.TargetClubID(childComplexity), true
+
+	case "AgreementNetworkStats.trend":
+		if e.complexity.AgreementNetworkStats.Trend == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.Trend(childComplexity), true
+
+	case "AgreementNetworkStats.utilization":
+		if e.complexity.AgreementNetworkStats.Utilization == nil {
+			break
+		}
+
+		return e.complexity.AgreementNetworkStats.Utilization(childComplexity), true
+
 	case "AgreementTerms.blackoutDates":
 		if e.complexity.AgreementTerms.BlackoutDates == nil {
 			break
@@ -505,6 +687,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AgreementTerms.SpecialConditions(childComplexity), true
 
+	case "AgreementTrendPoint.inbound":
+		if e.complexity.AgreementTrendPoint.Inbound == nil {
+			break
+		}
+
+		return e.complexity.AgreementTrendPoint.Inbound(childComplexity), true
+
+	case "AgreementTrendPoint.month":
+		if e.complexity.AgreementTrendPoint.Month == nil {
+			break
+		}
+
+		return e.complexity.AgreementTrendPoint.Month(childComplexity), true
+
+	case "AgreementTrendPoint.outbound":
+		if e.complexity.AgreementTrendPoint.Outbound == nil {
+			break
+		}
+
+		return e.complexity.AgreementTrendPoint.Outbound(childComplexity), true
+
+	case "AgreementUtilization.atLimit":
+		if e.complexity.AgreementUtilization.AtLimit == nil {
+			break
+		}
+
+		return e.complexity.AgreementUtilization.AtLimit(childComplexity), true
+
+	case "AgreementUtilization.averageVisits":
+		if e.complexity.AgreementUtilization.AverageVisits == nil {
+			break
+		}
+
+		return e.complexity.AgreementUtilization.AverageVisits(childComplexity), true
+
+	case "AgreementUtilization.memberMonths":
+		if e.complexity.AgreementUtilization.MemberMonths == nil {
+			break
+		}
+
+		return e.complexity.AgreementUtilization.MemberMonths(childComplexity), true
+
+	case "AgreementUtilization.rate":
+		if e.complexity.AgreementUtilization.Rate == nil {
+			break
+		}
+
+		return e.complexity.AgreementUtilization.Rate(childComplexity), true
+
 	case "Analytics.members":
 		if e.complexity.Analytics.Members == nil {
 			break
@@ -512,6 +743,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Analytics.Members(childComplexity), true
 
+	case "Analytics.network":
+		if e.complexity.Analytics.Network == nil {
+			break
+		}
+
+		return e.complexity.Analytics.Network(childComplexity), true
+
 	case "Analytics.reciprocals":
 		if e.complexity.Analytics.Reciprocals == nil {
 			break
@@ -617,6 +855,90 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Club.Website(childComplexity), true
 
+	case "ClubFlow.homeClubId":
+		if e.complexity.ClubFlow.HomeClubID == nil {
+			break
+		}
+
+		return e.complexity.ClubFlow.HomeClubID(childComplexity), true
+
+	case "ClubFlow.members":
+		if e.complexity.ClubFlow.Members == nil {
+			break
+		}
+
+		return e.complexity.ClubFlow.Members(childComplexity), true
+
+	case "ClubFlow.requested":
+		if e.complexity.ClubFlow.Requested == nil {
+			break
+		}
+
+		return e.complexity.ClubFlow.Requested(childComplexity), true
+
+	case "ClubFlow.visitingClubId":
+		if e.complexity.ClubFlow.VisitingClubID == nil {
+			break
+		}
+
+		return e.complexity.ClubFlow.VisitingClubID(childComplexity), true
+
+	case "ClubFlow.visits":
+		if e.complexity.ClubFlow.Visits == nil {
+			break
+		}
+
+		return e.complexity.ClubFlow.Visits(childComplexity), true
+
+	case "ClubNetworkStats.clubId":
+		if e.complexity.ClubNetworkStats.ClubID == nil {
+			break
+		}
+
+		return e.complexity.ClubNetworkStats.ClubID(childComplexity), true
+
+	case "ClubNetworkStats.facilities":
+		if e.complexity.ClubNetworkStats.Facilities == nil {
+			break
+		}
+
+		return e.complexity.ClubNetworkStats.Facilities(childComplexity), true
+
+	case "ClubNetworkStats.guestRating":
+		if e.complexity.ClubNetworkStats.GuestRating == nil {
+			break
+		}
+
+		return e.complexity.ClubNetworkStats.GuestRating(childComplexity), true
+
+	case "ClubNetworkStats.hostRating":
+		if e.complexity.ClubNetworkStats.HostRating == nil {
+			break
+		}
+
+		return e.complexity.ClubNetworkStats.HostRating(childComplexity), true
+
+	case "ClubNetworkStats.hosted":
+		if e.complexity.ClubNetworkStats.Hosted == nil {
+			break
+		}
+
+		return e.complexity.ClubNetworkStats.Hosted(childComplexity), true
+
+	case "ClubNetworkStats.partners":
+		if e.complexity.ClubNetworkStats.Partners == nil {
+			break
+		}
+
+		return e.complexity.ClubNetworkStats.Partners(childComplexity), true
+
+	case "ClubNetworkStats.visitsMade":
+		if e.complexity.ClubNetworkStats.VisitsMade == nil {
+			break
+		}
+
+		return e.complexity.ClubNetworkStats.VisitsMade(childComplexity), true
+
 	case "ClubSettings.allowReciprocal":
 		if e.complexity.ClubSettings.AllowReciprocal == nil {
 			break
@@ -680,6 +1002,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.EmergencyContact.Relationship(childComplexity), true
 
+	case "FacilityUsage.facility":
+		if e.complexity.FacilityUsage.Facility == nil {
+			break
+		}
+
+		return e.complexity.FacilityUsage.Facility(childComplexity), true
+
+	case "FacilityUsage.visits":
+		if e.complexity.FacilityUsage.Visits == nil {
+			break
+		}
+
+		return e.complexity.FacilityUsage.Visits(childComplexity), true
+
 	case "Member.blockchainIdentity":
 		if e.complexity.Member.BlockchainIdentity == nil {
 			break
@@ -904,6 +1240,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MonthlyVisit.Month(childComplexity), true
 
+	case "Mutation.adminRevokeSession":
+		if e.complexity.Mutation.AdminRevokeSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminRevokeSession_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminRevokeSession(childComplexity, args["id"].(string)), true
+
 	case "Mutation.approveReciprocalAgreement":
 		if e.complexity.Mutation.ApproveReciprocalAgreement == nil {
 			break
@@ -1122,6 +1470,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RejectReciprocalAgreement(childComplexity, args["id"].(string), args["reason"].(*string)), true
 
+	case "Mutation.revokeOtherSessions":
+		if e.complexity.Mutation.RevokeOtherSessions == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeOtherSessions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeOtherSessions(childComplexity, args["currentSessionId"].(string)), true
+
+	case "Mutation.revokeSession":
+		if e.complexity.Mutation.RevokeSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeSession_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeSession(childComplexity, args["id"].(string)), true
+
+	case "Mutation.revokeUserSessions":
+		if e.complexity.Mutation.RevokeUserSessions == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeUserSessions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeUserSessions(childComplexity, args["userId"].(string)), true
+
 	case "Mutation.suspendMember":
 		if e.complexity.Mutation.SuspendMember == nil {
 			break
@@ -1177,6 +1561,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.VerifyVisit(childComplexity, args["id"].(string)), true
 
+	case "NetworkAnalytics.agreements":
+		if e.complexity.NetworkAnalytics.Agreements == nil {
+			break
+		}
+
+		return e.complexity.NetworkAnalytics.Agreements(childComplexity), true
+
+	case "NetworkAnalytics.clubs":
+		if e.complexity.NetworkAnalytics.Clubs == nil {
+			break
+		}
+
+		return e.complexity.NetworkAnalytics.Clubs(childComplexity), true
+
+	case "NetworkAnalytics.flows":
+		if e.complexity.NetworkAnalytics.Flows == nil {
+			break
+		}
+
+		return e.complexity.NetworkAnalytics.Flows(childComplexity), true
+
+	case "NetworkAnalytics.outcomes":
+		if e.complexity.NetworkAnalytics.Outcomes == nil {
+			break
+		}
+
+		return e.complexity.NetworkAnalytics.Outcomes(childComplexity), true
+
 	case "Notification.channels":
 		if e.complexity.Notification.Channels == nil {
 			break
@@ -1411,6 +1823,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Club(childComplexity, args["id"].(string)), true
 
+	case "Query.clubSessions":
+		if e.complexity.Query.ClubSessions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_clubSessions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ClubSessions(childComplexity, args["pagination"].(*model.PaginationInput), args["userId"].(*string), args["search"].(*string), args["activeOnly"].(*bool)), true
+
 	case "Query.clubs":
 		if e.complexity.Query.Clubs == nil {
 			break
@@ -1468,6 +1892,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.MyClub(childComplexity), true
 
+	case "Query.mySessions":
+		if e.complexity.Query.MySessions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_mySessions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MySessions(childComplexity, args["currentSessionId"].(*string)), true
+
 	case "Query.myVisits":
 		if e.complexity.Query.MyVisits == nil {
 			break
@@ -1724,57 +2160,155 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ReciprocalAnalytics.TotalAgreements(childComplexity), true
 
-	case "Subscription.notificationReceived":
-		if e.complexity.Subscription.NotificationReceived == nil {
+	case "Session.active":
+		if e.complexity.Session.Active == nil {
 			break
 		}
 
-		return e.complexity.Subscription.NotificationReceived(childComplexity), true
+		return e.complexity.Session.Active(childComplexity), true
 
-	case "Subscription.proposalUpdated":
-		if e.complexity.Subscription.ProposalUpdated == nil {
+	case "Session.authProvider":
+		if e.complexity.Session.AuthProvider == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_proposalUpdated_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Session.AuthProvider(childComplexity), true
+
+	case "Session.createdAt":
+		if e.complexity.Session.CreatedAt == nil {
+			break
 		}
 
-		return e.complexity.Subscription.ProposalUpdated(childComplexity, args["proposalId"].(*string)), true
+		return e.complexity.Session.CreatedAt(childComplexity), true
 
-	case "Subscription.transactionStatusChanged":
-		if e.complexity.Subscription.TransactionStatusChanged == nil {
+	case "Session.current":
+		if e.complexity.Session.Current == nil {
 			break
 		}
 
-		return e.complexity.Subscription.TransactionStatusChanged(childComplexity), true
+		return e.complexity.Session.Current(childComplexity), true
 
-	case "Subscription.visitStatusChanged":
-		if e.complexity.Subscription.VisitStatusChanged == nil {
+	case "Session.device":
+		if e.complexity.Session.Device == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_visitStatusChanged_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Session.Device(childComplexity), true
+
+	case "Session.expiresAt":
+		if e.complexity.Session.ExpiresAt == nil {
+			break
 		}
 
-		return e.complexity.Subscription.VisitStatusChanged(childComplexity, args["clubId"].(*string)), true
+		return e.complexity.Session.ExpiresAt(childComplexity), true
 
-	case "Subscription.voteReceived":
-		if e.complexity.Subscription.VoteReceived == nil {
+	case "Session.id":
+		if e.complexity.Session.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_voteReceived_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Session.ID(childComplexity), true
+
+	case "Session.ipAddress":
+		if e.complexity.Session.IPAddress == nil {
+			break
 		}
 
-		return e.complexity.Subscription.VoteReceived(childComplexity, args["proposalId"].(*string)), true
+		return e.complexity.Session.IPAddress(childComplexity), true
 
-	case "Transaction.args":
+	case "Session.lastActivityAt":
+		if e.complexity.Session.LastActivityAt == nil {
+			break
+		}
+
+		return e.complexity.Session.LastActivityAt(childComplexity), true
+
+	case "Session.location":
+		if e.complexity.Session.Location == nil {
+			break
+		}
+
+		return e.complexity.Session.Location(childComplexity), true
+
+	case "Session.userEmail":
+		if e.complexity.Session.UserEmail == nil {
+			break
+		}
+
+		return e.complexity.Session.UserEmail(childComplexity), true
+
+	case "Session.userId":
+		if e.complexity.Session.UserID == nil {
+			break
+		}
+
+		return e.complexity.Session.UserID(childComplexity), true
+
+	case "SessionConnection.nodes":
+		if e.complexity.SessionConnection.Nodes == nil {
+			break
+		}
+
+		return e.complexity.SessionConnection.Nodes(childComplexity), true
+
+	case "SessionConnection.pageInfo":
+		if e.complexity.SessionConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SessionConnection.PageInfo(childComplexity), true
+
+	case "Subscription.notificationReceived":
+		if e.complexity.Subscription.NotificationReceived == nil {
+			break
+		}
+
+		return e.complexity.Subscription.NotificationReceived(childComplexity), true
+
+	case "Subscription.proposalUpdated":
+		if e.complexity.Subscription.ProposalUpdated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_proposalUpdated_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ProposalUpdated(childComplexity, args["proposalId"].(*string)), true
+
+	case "Subscription.transactionStatusChanged":
+		if e.complexity.Subscription.TransactionStatusChanged == nil {
+			break
+		}
+
+		return e.complexity.Subscription.TransactionStatusChanged(childComplexity), true
+
+	case "Subscription.visitStatusChanged":
+		if e.complexity.Subscription.VisitStatusChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_visitStatusChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.VisitStatusChanged(childComplexity, args["clubId"].(*string)), true
+
+	case "Subscription.voteReceived":
+		if e.complexity.Subscription.VoteReceived == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_voteReceived_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.VoteReceived(childComplexity, args["proposalId"].(*string)), true
+
+	case "Transaction.args":
 		if e.complexity.Transaction.Args == nil {
 			break
 		}
@@ -2054,6 +2588,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VisitConnection.PageInfo(childComplexity), true
 
+	case "VisitOutcomes.attended":
+		if e.complexity.VisitOutcomes.Attended == nil {
+			break
+		}
+
+		return e.complexity.VisitOutcomes.Attended(childComplexity), true
+
+	case "VisitOutcomes.cancellationRate":
+		if e.complexity.VisitOutcomes.CancellationRate == nil {
+			break
+		}
+
+		return e.complexity.VisitOutcomes.CancellationRate(childComplexity), true
+
+	case "VisitOutcomes.cancelled":
+		if e.complexity.VisitOutcomes.Cancelled == nil {
+			break
+		}
+
+		return e.complexity.VisitOutcomes.Cancelled(childComplexity), true
+
+	case "VisitOutcomes.noShowRate":
+		if e.complexity.VisitOutcomes.NoShowRate == nil {
+			break
+		}
+
+		return e.complexity.VisitOutcomes.NoShowRate(childComplexity), true
+
+	case "VisitOutcomes.noShows":
+		if e.complexity.VisitOutcomes.NoShows == nil {
+			break
+		}
+
+		return e.complexity.VisitOutcomes.NoShows(childComplexity), true
+
+	case "VisitOutcomes.open":
+		if e.complexity.VisitOutcomes.Open == nil {
+			break
+		}
+
+		return e.complexity.VisitOutcomes.Open(childComplexity), true
+
+	case "VisitOutcomes.visits":
+		if e.complexity.VisitOutcomes.Visits == nil {
+			break
+		}
+
+		return e.complexity.VisitOutcomes.Visits(childComplexity), true
+
+	case "VisitRating.average":
+		if e.complexity.VisitRating.Average == nil {
+			break
+		}
+
+		return e.complexity.VisitRating.Average(childComplexity), true
+
+	case "VisitRating.count":
+		if e.complexity.VisitRating.Count == nil {
+			break
+		}
+
+		return e.complexity.VisitRating.Count(childComplexity), true
+
 	case "Vote.choice":
 		if e.complexity.Vote.Choice == nil {
 			break
@@ -2264,6 +2861,22 @@ type AuthPayload {
   expiresAt: Time!
 }
 
+# Session types
+type Session {
+  id: ID!
+  userId: ID!
+  userEmail: String
+  device: String!
+  ipAddress: String!
+  location: String
+  authProvider: String!
+  active: Boolean!
+  current: Boolean!
+  createdAt: Time!
+  lastActivityAt: Time!
+  expiresAt: Time!
+}
+
 # Member types
 type Member {
   id: ID!
@@ -2474,6 +3087,7 @@ type Analytics {
   visits: VisitAnalytics!
   members: MemberAnalytics!
   reciprocals: ReciprocalAnalytics!
+  network: NetworkAnalytics!
 }
 
 type VisitAnalytics {
@@ -2512,6 +3126,80 @@ type ReciprocalAnalytics {
   monthlyReciprocalUsage: [MonthlyVisit!]!
 }
 
+# Reciprocal network analytics. Flows and club stats count attended visits;
+# outcome rates are shares of visits that reached an outcome.
+type NetworkAnalytics {
+  outcomes: VisitOutcomes!
+  flows: [ClubFlow!]!
+  agreements: [AgreementNetworkStats!]!
+  clubs: [ClubNetworkStats!]!
+}
+
+type VisitOutcomes {
+  visits: Int!
+  open: Int!
+  attended: Int!
+  cancelled: Int!
+  noShows: Int!
+  cancellationRate: Float!
+  noShowRate: Float!
+}
+
+type ClubFlow {
+  homeClubId: ID!
+  visitingClubId: ID!
+  visits: Int!
+  members: Int!
+  requested: Int!
+}
+
+type AgreementNetworkStats {
+  agreementId: ID!
+  proposingClubId: ID!
+  targetClubId: ID!
+  status: String!
+  outbound: Int!
+  inbound: Int!
+  balance: Float!
+  maxVisitsPerMonth: Int!
+  utilization: AgreementUtilization
+  outcomes: VisitOutcomes!
+  trend: [AgreementTrendPoint!]!
+}
+
+type AgreementUtilization {
+  memberMonths: Int!
+  averageVisits: Float!
+  rate: Float!
+  atLimit: Int!
+}
+
+type AgreementTrendPoint {
+  month: Time!
+  outbound: Int!
+  inbound: Int!
+}
+
+type ClubNetworkStats {
+  clubId: ID!
+  hosted: VisitOutcomes!
+  visitsMade: Int!
+  partners: Int!
+  hostRating: VisitRating
+  guestRating: VisitRating
+  facilities: [FacilityUsage!]!
+}
+
+type VisitRating {
+  average: Float!
+  count: Int!
+}
+
+type FacilityUsage {
+  facility: String!
+  visits: Int!
+}
+
 # Governance types
 type Proposal {
   id: ID!
@@ -2696,10 +3384,17 @@ type ProposalConnection {
   pageInfo: PageInfo!
 }
 
+type SessionConnection {
+  nodes: [Session!]!
+  pageInfo: PageInfo!
+}
+
 # Root types
 type Query {
   # Authentication
   me: User
+  mySessions(currentSessionId: String): [Session!]!
+  clubSessions(pagination: PaginationInput, userId: ID, search: String, activeOnly: Boolean = true): SessionConnection!
   
   # Members
   members(pagination: PaginationInput, status: MemberStatus): MemberConnection!
@@ -2744,6 +3439,10 @@ type Mutation {
   register(input: RegisterInput!): AuthPayload!
   refreshToken(refreshToken: String!): AuthPayload!
   logout: Boolean!
+  revokeSession(id: ID!): Boolean!
+  revokeOtherSessions(currentSessionId: String!): Int!
+  adminRevokeSession(id: ID!): Boolean!
+  revokeUserSessions(userId: ID!): Int!
   
   # Members
   createMember(input: CreateMemberInput!): Member!
@@ -2799,6 +3498,21 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Mutation_adminRevokeSession_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_approveReciprocalAgreement_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3081,6 +3795,51 @@ func (ec *executionContext) field_Mutation_rejectReciprocalAgreement_args(ctx co
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_revokeOtherSessions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["currentSessionId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentSessionId"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["currentSessionId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_revokeSession_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_revokeUserSessions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["userId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["userId"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_suspendMember_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3207,6 +3966,48 @@ func (ec *executionContext) field_Query_analytics_args(ctx context.Context, rawA
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_clubSessions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.PaginationInput
+	if tmp, ok := rawArgs["pagination"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pagination"))
+		arg0, err = ec.unmarshalOPaginationInput2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPaginationInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pagination"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["userId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["userId"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["search"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["search"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["activeOnly"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("activeOnly"))
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["activeOnly"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_club_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3276,6 +4077,21 @@ func (ec *executionContext) field_Query_members_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_mySessions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["currentSessionId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentSessionId"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["currentSessionId"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_myVisits_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3804,8 +4620,8 @@ func (ec *executionContext) fieldContext_Address_country(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _AgreementTerms_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgreementTerms_maxVisitsPerMonth(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_agreementId(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_agreementId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3818,7 +4634,7 @@ func (ec *executionContext) _AgreementTerms_maxVisitsPerMonth(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxVisitsPerMonth, nil
+		return obj.AgreementID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3830,26 +4646,26 @@ func (ec *executionContext) _AgreementTerms_maxVisitsPerMonth(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgreementTerms_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_agreementId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgreementTerms",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AgreementTerms_reciprocalFee(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgreementTerms_reciprocalFee(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_proposingClubId(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_proposingClubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3862,35 +4678,38 @@ func (ec *executionContext) _AgreementTerms_reciprocalFee(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ReciprocalFee, nil
+		return obj.ProposingClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgreementTerms_reciprocalFee(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_proposingClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgreementTerms",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AgreementTerms_blackoutDates(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgreementTerms_blackoutDates(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_targetClubId(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_targetClubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3903,35 +4722,38 @@ func (ec *executionContext) _AgreementTerms_blackoutDates(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlackoutDates, nil
+		return obj.TargetClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	res := resTmp.([]*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTime2ᚕᚖtimeᚐTimeᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgreementTerms_blackoutDates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_targetClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgreementTerms",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AgreementTerms_specialConditions(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgreementTerms_specialConditions(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_status(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3944,23 +4766,26 @@ func (ec *executionContext) _AgreementTerms_specialConditions(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecialConditions, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgreementTerms_specialConditions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgreementTerms",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3971,8 +4796,8 @@ func (ec *executionContext) fieldContext_AgreementTerms_specialConditions(ctx co
 	return fc, nil
 }
 
-func (ec *executionContext) _Analytics_visits(ctx context.Context, field graphql.CollectedField, obj *model.Analytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Analytics_visits(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_outbound(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_outbound(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3985,7 +4810,7 @@ func (ec *executionContext) _Analytics_visits(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Visits, nil
+		return obj.Outbound, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3997,36 +4822,26 @@ func (ec *executionContext) _Analytics_visits(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.VisitAnalytics)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNVisitAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitAnalytics(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Analytics_visits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_outbound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Analytics",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "totalVisits":
-				return ec.fieldContext_VisitAnalytics_totalVisits(ctx, field)
-			case "monthlyVisits":
-				return ec.fieldContext_VisitAnalytics_monthlyVisits(ctx, field)
-			case "topDestinations":
-				return ec.fieldContext_VisitAnalytics_topDestinations(ctx, field)
-			case "averageVisitDuration":
-				return ec.fieldContext_VisitAnalytics_averageVisitDuration(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VisitAnalytics", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Analytics_members(ctx context.Context, field graphql.CollectedField, obj *model.Analytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Analytics_members(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_inbound(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_inbound(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4039,7 +4854,7 @@ func (ec *executionContext) _Analytics_members(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Members, nil
+		return obj.Inbound, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4051,36 +4866,26 @@ func (ec *executionContext) _Analytics_members(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.MemberAnalytics)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNMemberAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberAnalytics(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Analytics_members(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_inbound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Analytics",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "totalMembers":
-				return ec.fieldContext_MemberAnalytics_totalMembers(ctx, field)
-			case "activeMembers":
-				return ec.fieldContext_MemberAnalytics_activeMembers(ctx, field)
-			case "newMembersThisMonth":
-				return ec.fieldContext_MemberAnalytics_newMembersThisMonth(ctx, field)
-			case "membershipDistribution":
-				return ec.fieldContext_MemberAnalytics_membershipDistribution(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MemberAnalytics", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Analytics_reciprocals(ctx context.Context, field graphql.CollectedField, obj *model.Analytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Analytics_reciprocals(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_balance(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_balance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4093,7 +4898,7 @@ func (ec *executionContext) _Analytics_reciprocals(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Reciprocals, nil
+		return obj.Balance, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4105,36 +4910,26 @@ func (ec *executionContext) _Analytics_reciprocals(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ReciprocalAnalytics)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNReciprocalAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAnalytics(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Analytics_reciprocals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_balance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Analytics",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "totalAgreements":
-				return ec.fieldContext_ReciprocalAnalytics_totalAgreements(ctx, field)
-			case "activeAgreements":
-				return ec.fieldContext_ReciprocalAnalytics_activeAgreements(ctx, field)
-			case "pendingAgreements":
-				return ec.fieldContext_ReciprocalAnalytics_pendingAgreements(ctx, field)
-			case "monthlyReciprocalUsage":
-				return ec.fieldContext_ReciprocalAnalytics_monthlyReciprocalUsage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAnalytics", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_token(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_maxVisitsPerMonth(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4147,7 +4942,7 @@ func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Token, nil
+		return obj.MaxVisitsPerMonth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4159,26 +4954,26 @@ func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_token(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_utilization(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_utilization(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4191,38 +4986,45 @@ func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RefreshToken, nil
+		return obj.Utilization, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.AgreementUtilization)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOAgreementUtilization2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementUtilization(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_utilization(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "memberMonths":
+				return ec.fieldContext_AgreementUtilization_memberMonths(ctx, field)
+			case "averageVisits":
+				return ec.fieldContext_AgreementUtilization_averageVisits(ctx, field)
+			case "rate":
+				return ec.fieldContext_AgreementUtilization_rate(ctx, field)
+			case "atLimit":
+				return ec.fieldContext_AgreementUtilization_atLimit(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgreementUtilization", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_user(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_outcomes(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_outcomes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4235,7 +5037,7 @@ func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.Outcomes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4247,50 +5049,42 @@ func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*auth.User)
+	res := resTmp.(*model.VisitOutcomes)
 	fc.Result = res
-	return ec.marshalNUser2ᚖreciprocalᚑclubsᚑbackendᚋpkgᚋsharedᚋauthᚐUser(ctx, field.Selections, res)
+	return ec.marshalNVisitOutcomes2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitOutcomes(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_outcomes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_User_clubId(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "status":
-				return ec.fieldContext_User_status(ctx, field)
-			case "roles":
-				return ec.fieldContext_User_roles(ctx, field)
-			case "permissions":
-				return ec.fieldContext_User_permissions(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			case "visits":
+				return ec.fieldContext_VisitOutcomes_visits(ctx, field)
+			case "open":
+				return ec.fieldContext_VisitOutcomes_open(ctx, field)
+			case "attended":
+				return ec.fieldContext_VisitOutcomes_attended(ctx, field)
+			case "cancelled":
+				return ec.fieldContext_VisitOutcomes_cancelled(ctx, field)
+			case "noShows":
+				return ec.fieldContext_VisitOutcomes_noShows(ctx, field)
+			case "cancellationRate":
+				return ec.fieldContext_VisitOutcomes_cancellationRate(ctx, field)
+			case "noShowRate":
+				return ec.fieldContext_VisitOutcomes_noShowRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitOutcomes", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+func (ec *executionContext) _AgreementNetworkStats_trend(ctx context.Context, field graphql.CollectedField, obj *model.AgreementNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementNetworkStats_trend(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4303,7 +5097,7 @@ func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpiresAt, nil
+		return obj.Trend, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4315,26 +5109,34 @@ func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.AgreementTrendPoint)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNAgreementTrendPoint2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTrendPointᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementNetworkStats_trend(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "AgreementNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "month":
+				return ec.fieldContext_AgreementTrendPoint_month(ctx, field)
+			case "outbound":
+				return ec.fieldContext_AgreementTrendPoint_outbound(ctx, field)
+			case "inbound":
+				return ec.fieldContext_AgreementTrendPoint_inbound(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgreementTrendPoint", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_id(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_id(ctx, field)
+func (ec *executionContext) _AgreementTerms_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementTerms_maxVisitsPerMonth(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4347,7 +5149,7 @@ func (ec *executionContext) _Club_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.MaxVisitsPerMonth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4359,26 +5161,26 @@ func (ec *executionContext) _Club_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementTerms_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementTerms",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_name(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_name(ctx, field)
+func (ec *executionContext) _AgreementTerms_reciprocalFee(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementTerms_reciprocalFee(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4391,38 +5193,35 @@ func (ec *executionContext) _Club_name(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ReciprocalFee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementTerms_reciprocalFee(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementTerms",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_description(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_description(ctx, field)
+func (ec *executionContext) _AgreementTerms_blackoutDates(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementTerms_blackoutDates(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4435,7 +5234,7 @@ func (ec *executionContext) _Club_description(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.BlackoutDates, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4444,26 +5243,26 @@ func (ec *executionContext) _Club_description(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚕᚖtimeᚐTimeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementTerms_blackoutDates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementTerms",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_location(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_location(ctx, field)
+func (ec *executionContext) _AgreementTerms_specialConditions(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTerms) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementTerms_specialConditions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4476,26 +5275,23 @@ func (ec *executionContext) _Club_location(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.SpecialConditions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementTerms_specialConditions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementTerms",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4506,8 +5302,8 @@ func (ec *executionContext) fieldContext_Club_location(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_website(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_website(ctx, field)
+func (ec *executionContext) _AgreementTrendPoint_month(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTrendPoint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementTrendPoint_month(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4520,35 +5316,38 @@ func (ec *executionContext) _Club_website(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Website, nil
+		return obj.Month, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_website(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementTrendPoint_month(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementTrendPoint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_status(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_status(ctx, field)
+func (ec *executionContext) _AgreementTrendPoint_outbound(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTrendPoint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementTrendPoint_outbound(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4561,7 +5360,7 @@ func (ec *executionContext) _Club_status(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Outbound, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4573,26 +5372,26 @@ func (ec *executionContext) _Club_status(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ClubStatus)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNClubStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubStatus(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementTrendPoint_outbound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementTrendPoint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ClubStatus does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_settings(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_settings(ctx, field)
+func (ec *executionContext) _AgreementTrendPoint_inbound(ctx context.Context, field graphql.CollectedField, obj *model.AgreementTrendPoint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementTrendPoint_inbound(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4605,45 +5404,38 @@ func (ec *executionContext) _Club_settings(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Settings, nil
+		return obj.Inbound, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ClubSettings)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOClubSettings2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubSettings(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_settings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementTrendPoint_inbound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementTrendPoint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "allowReciprocal":
-				return ec.fieldContext_ClubSettings_allowReciprocal(ctx, field)
-			case "requireApproval":
-				return ec.fieldContext_ClubSettings_requireApproval(ctx, field)
-			case "maxVisitsPerMonth":
-				return ec.fieldContext_ClubSettings_maxVisitsPerMonth(ctx, field)
-			case "reciprocalFee":
-				return ec.fieldContext_ClubSettings_reciprocalFee(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ClubSettings", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_createdAt(ctx, field)
+func (ec *executionContext) _AgreementUtilization_memberMonths(ctx context.Context, field graphql.CollectedField, obj *model.AgreementUtilization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementUtilization_memberMonths(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4656,7 +5448,7 @@ func (ec *executionContext) _Club_createdAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.MemberMonths, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4668,26 +5460,26 @@ func (ec *executionContext) _Club_createdAt(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementUtilization_memberMonths(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementUtilization",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Club_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Club_updatedAt(ctx, field)
+func (ec *executionContext) _AgreementUtilization_averageVisits(ctx context.Context, field graphql.CollectedField, obj *model.AgreementUtilization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementUtilization_averageVisits(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4700,7 +5492,7 @@ func (ec *executionContext) _Club_updatedAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.AverageVisits, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4712,26 +5504,26 @@ func (ec *executionContext) _Club_updatedAt(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Club_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementUtilization_averageVisits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Club",
+		Object:     "AgreementUtilization",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ClubSettings_allowReciprocal(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ClubSettings_allowReciprocal(ctx, field)
+func (ec *executionContext) _AgreementUtilization_rate(ctx context.Context, field graphql.CollectedField, obj *model.AgreementUtilization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementUtilization_rate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4744,7 +5536,7 @@ func (ec *executionContext) _ClubSettings_allowReciprocal(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AllowReciprocal, nil
+		return obj.Rate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4756,26 +5548,26 @@ func (ec *executionContext) _ClubSettings_allowReciprocal(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ClubSettings_allowReciprocal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementUtilization_rate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ClubSettings",
+		Object:     "AgreementUtilization",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ClubSettings_requireApproval(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ClubSettings_requireApproval(ctx, field)
+func (ec *executionContext) _AgreementUtilization_atLimit(ctx context.Context, field graphql.CollectedField, obj *model.AgreementUtilization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgreementUtilization_atLimit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4788,7 +5580,7 @@ func (ec *executionContext) _ClubSettings_requireApproval(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequireApproval, nil
+		return obj.AtLimit, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4800,26 +5592,26 @@ func (ec *executionContext) _ClubSettings_requireApproval(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ClubSettings_requireApproval(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgreementUtilization_atLimit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ClubSettings",
+		Object:     "AgreementUtilization",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ClubSettings_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ClubSettings_maxVisitsPerMonth(ctx, field)
+func (ec *executionContext) _Analytics_visits(ctx context.Context, field graphql.CollectedField, obj *model.Analytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Analytics_visits(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4832,7 +5624,7 @@ func (ec *executionContext) _ClubSettings_maxVisitsPerMonth(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxVisitsPerMonth, nil
+		return obj.Visits, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4844,26 +5636,36 @@ func (ec *executionContext) _ClubSettings_maxVisitsPerMonth(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.VisitAnalytics)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNVisitAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitAnalytics(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ClubSettings_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Analytics_visits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ClubSettings",
+		Object:     "Analytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "totalVisits":
+				return ec.fieldContext_VisitAnalytics_totalVisits(ctx, field)
+			case "monthlyVisits":
+				return ec.fieldContext_VisitAnalytics_monthlyVisits(ctx, field)
+			case "topDestinations":
+				return ec.fieldContext_VisitAnalytics_topDestinations(ctx, field)
+			case "averageVisitDuration":
+				return ec.fieldContext_VisitAnalytics_averageVisitDuration(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitAnalytics", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ClubSettings_reciprocalFee(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ClubSettings_reciprocalFee(ctx, field)
+func (ec *executionContext) _Analytics_members(ctx context.Context, field graphql.CollectedField, obj *model.Analytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Analytics_members(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4876,35 +5678,48 @@ func (ec *executionContext) _ClubSettings_reciprocalFee(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ReciprocalFee, nil
+		return obj.Members, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*model.MemberAnalytics)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNMemberAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberAnalytics(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ClubSettings_reciprocalFee(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Analytics_members(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ClubSettings",
+		Object:     "Analytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "totalMembers":
+				return ec.fieldContext_MemberAnalytics_totalMembers(ctx, field)
+			case "activeMembers":
+				return ec.fieldContext_MemberAnalytics_activeMembers(ctx, field)
+			case "newMembersThisMonth":
+				return ec.fieldContext_MemberAnalytics_newMembersThisMonth(ctx, field)
+			case "membershipDistribution":
+				return ec.fieldContext_MemberAnalytics_membershipDistribution(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MemberAnalytics", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ClubVisitCount_club(ctx context.Context, field graphql.CollectedField, obj *model.ClubVisitCount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ClubVisitCount_club(ctx, field)
+func (ec *executionContext) _Analytics_reciprocals(ctx context.Context, field graphql.CollectedField, obj *model.Analytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Analytics_reciprocals(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4917,7 +5732,7 @@ func (ec *executionContext) _ClubVisitCount_club(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Club, nil
+		return obj.Reciprocals, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4929,46 +5744,36 @@ func (ec *executionContext) _ClubVisitCount_club(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Club)
+	res := resTmp.(*model.ReciprocalAnalytics)
 	fc.Result = res
-	return ec.marshalNClub2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClub(ctx, field.Selections, res)
+	return ec.marshalNReciprocalAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAnalytics(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ClubVisitCount_club(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Analytics_reciprocals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ClubVisitCount",
+		Object:     "Analytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Club_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Club_name(ctx, field)
-			case "description":
-				return ec.fieldContext_Club_description(ctx, field)
-			case "location":
-				return ec.fieldContext_Club_location(ctx, field)
-			case "website":
-				return ec.fieldContext_Club_website(ctx, field)
-			case "status":
-				return ec.fieldContext_Club_status(ctx, field)
-			case "settings":
-				return ec.fieldContext_Club_settings(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Club_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Club_updatedAt(ctx, field)
+			case "totalAgreements":
+				return ec.fieldContext_ReciprocalAnalytics_totalAgreements(ctx, field)
+			case "activeAgreements":
+				return ec.fieldContext_ReciprocalAnalytics_activeAgreements(ctx, field)
+			case "pendingAgreements":
+				return ec.fieldContext_ReciprocalAnalytics_pendingAgreements(ctx, field)
+			case "monthlyReciprocalUsage":
+				return ec.fieldContext_ReciprocalAnalytics_monthlyReciprocalUsage(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAnalytics", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ClubVisitCount_count(ctx context.Context, field graphql.CollectedField, obj *model.ClubVisitCount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ClubVisitCount_count(ctx, field)
+func (ec *executionContext) _Analytics_network(ctx context.Context, field graphql.CollectedField, obj *model.Analytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Analytics_network(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4981,7 +5786,7 @@ func (ec *executionContext) _ClubVisitCount_count(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.Network, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4993,26 +5798,36 @@ func (ec *executionContext) _ClubVisitCount_count(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.NetworkAnalytics)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNNetworkAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNetworkAnalytics(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ClubVisitCount_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Analytics_network(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ClubVisitCount",
+		Object:     "Analytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "outcomes":
+				return ec.fieldContext_NetworkAnalytics_outcomes(ctx, field)
+			case "flows":
+				return ec.fieldContext_NetworkAnalytics_flows(ctx, field)
+			case "agreements":
+				return ec.fieldContext_NetworkAnalytics_agreements(ctx, field)
+			case "clubs":
+				return ec.fieldContext_NetworkAnalytics_clubs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NetworkAnalytics", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EmergencyContact_name(ctx context.Context, field graphql.CollectedField, obj *model.EmergencyContact) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EmergencyContact_name(ctx, field)
+func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_token(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5025,7 +5840,7 @@ func (ec *executionContext) _EmergencyContact_name(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Token, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5042,9 +5857,9 @@ func (ec *executionContext) _EmergencyContact_name(ctx context.Context, field gr
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EmergencyContact_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_token(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EmergencyContact",
+		Object:     "AuthPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5055,8 +5870,8 @@ func (ec *executionContext) fieldContext_EmergencyContact_name(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _EmergencyContact_relationship(ctx context.Context, field graphql.CollectedField, obj *model.EmergencyContact) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EmergencyContact_relationship(ctx, field)
+func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_refreshToken(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5069,7 +5884,7 @@ func (ec *executionContext) _EmergencyContact_relationship(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Relationship, nil
+		return obj.RefreshToken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5086,9 +5901,9 @@ func (ec *executionContext) _EmergencyContact_relationship(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EmergencyContact_relationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EmergencyContact",
+		Object:     "AuthPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5099,8 +5914,8 @@ func (ec *executionContext) fieldContext_EmergencyContact_relationship(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _EmergencyContact_phoneNumber(ctx context.Context, field graphql.CollectedField, obj *model.EmergencyContact) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EmergencyContact_phoneNumber(ctx, field)
+func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5113,7 +5928,7 @@ func (ec *executionContext) _EmergencyContact_phoneNumber(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PhoneNumber, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5125,26 +5940,50 @@ func (ec *executionContext) _EmergencyContact_phoneNumber(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*auth.User)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖreciprocalᚑclubsᚑbackendᚋpkgᚋsharedᚋauthᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EmergencyContact_phoneNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EmergencyContact",
+		Object:     "AuthPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_User_clubId(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "status":
+				return ec.fieldContext_User_status(ctx, field)
+			case "roles":
+				return ec.fieldContext_User_roles(ctx, field)
+			case "permissions":
+				return ec.fieldContext_User_permissions(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_id(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_id(ctx, field)
+func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_expiresAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5157,7 +5996,7 @@ func (ec *executionContext) _Member_id(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.ExpiresAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5169,26 +6008,26 @@ func (ec *executionContext) _Member_id(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "AuthPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_clubId(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_clubId(ctx, field)
+func (ec *executionContext) _Club_id(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5201,7 +6040,7 @@ func (ec *executionContext) _Member_clubId(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClubID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5218,9 +6057,9 @@ func (ec *executionContext) _Member_clubId(ctx context.Context, field graphql.Co
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5231,8 +6070,8 @@ func (ec *executionContext) fieldContext_Member_clubId(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_userId(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_userId(ctx, field)
+func (ec *executionContext) _Club_name(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5245,7 +6084,7 @@ func (ec *executionContext) _Member_userId(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5259,24 +6098,24 @@ func (ec *executionContext) _Member_userId(ctx context.Context, field graphql.Co
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_memberNumber(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_memberNumber(ctx, field)
+func (ec *executionContext) _Club_description(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5289,26 +6128,23 @@ func (ec *executionContext) _Member_memberNumber(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MemberNumber, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_memberNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5319,8 +6155,8 @@ func (ec *executionContext) fieldContext_Member_memberNumber(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_membershipType(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_membershipType(ctx, field)
+func (ec *executionContext) _Club_location(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5333,7 +6169,7 @@ func (ec *executionContext) _Member_membershipType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MembershipType, nil
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5345,26 +6181,26 @@ func (ec *executionContext) _Member_membershipType(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.MembershipType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNMembershipType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMembershipType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_membershipType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MembershipType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_status(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_status(ctx, field)
+func (ec *executionContext) _Club_website(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_website(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5377,38 +6213,35 @@ func (ec *executionContext) _Member_status(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Website, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.MemberStatus)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNMemberStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_website(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MemberStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_blockchainIdentity(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_blockchainIdentity(ctx, field)
+func (ec *executionContext) _Club_status(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5421,35 +6254,38 @@ func (ec *executionContext) _Member_blockchainIdentity(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockchainIdentity, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.ClubStatus)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNClubStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_blockchainIdentity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ClubStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_profile(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_profile(ctx, field)
+func (ec *executionContext) _Club_settings(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_settings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5462,7 +6298,7 @@ func (ec *executionContext) _Member_profile(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Profile, nil
+		return obj.Settings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5471,42 +6307,36 @@ func (ec *executionContext) _Member_profile(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.MemberProfile)
+	res := resTmp.(*model.ClubSettings)
 	fc.Result = res
-	return ec.marshalOMemberProfile2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberProfile(ctx, field.Selections, res)
+	return ec.marshalOClubSettings2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_settings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "firstName":
-				return ec.fieldContext_MemberProfile_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_MemberProfile_lastName(ctx, field)
-			case "dateOfBirth":
-				return ec.fieldContext_MemberProfile_dateOfBirth(ctx, field)
-			case "phoneNumber":
-				return ec.fieldContext_MemberProfile_phoneNumber(ctx, field)
-			case "address":
-				return ec.fieldContext_MemberProfile_address(ctx, field)
-			case "emergencyContact":
-				return ec.fieldContext_MemberProfile_emergencyContact(ctx, field)
-			case "preferences":
-				return ec.fieldContext_MemberProfile_preferences(ctx, field)
+			case "allowReciprocal":
+				return ec.fieldContext_ClubSettings_allowReciprocal(ctx, field)
+			case "requireApproval":
+				return ec.fieldContext_ClubSettings_requireApproval(ctx, field)
+			case "maxVisitsPerMonth":
+				return ec.fieldContext_ClubSettings_maxVisitsPerMonth(ctx, field)
+			case "reciprocalFee":
+				return ec.fieldContext_ClubSettings_reciprocalFee(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type MemberProfile", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ClubSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_joinedAt(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_joinedAt(ctx, field)
+func (ec *executionContext) _Club_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5519,7 +6349,7 @@ func (ec *executionContext) _Member_joinedAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.JoinedAt, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5536,9 +6366,9 @@ func (ec *executionContext) _Member_joinedAt(ctx context.Context, field graphql.
 	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_joinedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5549,8 +6379,8 @@ func (ec *executionContext) fieldContext_Member_joinedAt(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_createdAt(ctx, field)
+func (ec *executionContext) _Club_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Club) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Club_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5563,7 +6393,7 @@ func (ec *executionContext) _Member_createdAt(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5580,9 +6410,9 @@ func (ec *executionContext) _Member_createdAt(ctx context.Context, field graphql
 	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Club_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "Club",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5593,8 +6423,8 @@ func (ec *executionContext) fieldContext_Member_createdAt(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Member_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Member_updatedAt(ctx, field)
+func (ec *executionContext) _ClubFlow_homeClubId(ctx context.Context, field graphql.CollectedField, obj *model.ClubFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubFlow_homeClubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5607,7 +6437,7 @@ func (ec *executionContext) _Member_updatedAt(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.HomeClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5619,26 +6449,26 @@ func (ec *executionContext) _Member_updatedAt(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Member_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubFlow_homeClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Member",
+		Object:     "ClubFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberAnalytics_totalMembers(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberAnalytics_totalMembers(ctx, field)
+func (ec *executionContext) _ClubFlow_visitingClubId(ctx context.Context, field graphql.CollectedField, obj *model.ClubFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubFlow_visitingClubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5651,7 +6481,7 @@ func (ec *executionContext) _MemberAnalytics_totalMembers(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalMembers, nil
+		return obj.VisitingClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5663,26 +6493,26 @@ func (ec *executionContext) _MemberAnalytics_totalMembers(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberAnalytics_totalMembers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubFlow_visitingClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberAnalytics",
+		Object:     "ClubFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberAnalytics_activeMembers(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberAnalytics_activeMembers(ctx, field)
+func (ec *executionContext) _ClubFlow_visits(ctx context.Context, field graphql.CollectedField, obj *model.ClubFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubFlow_visits(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5695,7 +6525,7 @@ func (ec *executionContext) _MemberAnalytics_activeMembers(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActiveMembers, nil
+		return obj.Visits, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5712,9 +6542,9 @@ func (ec *executionContext) _MemberAnalytics_activeMembers(ctx context.Context,
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberAnalytics_activeMembers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubFlow_visits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberAnalytics",
+		Object:     "ClubFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5725,8 +6555,8 @@ func (ec *executionContext) fieldContext_MemberAnalytics_activeMembers(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberAnalytics_newMembersThisMonth(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberAnalytics_newMembersThisMonth(ctx, field)
+func (ec *executionContext) _ClubFlow_members(ctx context.Context, field graphql.CollectedField, obj *model.ClubFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubFlow_members(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5739,7 +6569,7 @@ func (ec *executionContext) _MemberAnalytics_newMembersThisMonth(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NewMembersThisMonth, nil
+		return obj.Members, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5756,9 +6586,9 @@ func (ec *executionContext) _MemberAnalytics_newMembersThisMonth(ctx context.Con
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberAnalytics_newMembersThisMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubFlow_members(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberAnalytics",
+		Object:     "ClubFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5769,8 +6599,8 @@ func (ec *executionContext) fieldContext_MemberAnalytics_newMembersThisMonth(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberAnalytics_membershipDistribution(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberAnalytics_membershipDistribution(ctx, field)
+func (ec *executionContext) _ClubFlow_requested(ctx context.Context, field graphql.CollectedField, obj *model.ClubFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubFlow_requested(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5783,7 +6613,7 @@ func (ec *executionContext) _MemberAnalytics_membershipDistribution(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MembershipDistribution, nil
+		return obj.Requested, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5795,32 +6625,26 @@ func (ec *executionContext) _MemberAnalytics_membershipDistribution(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MembershipTypeCount)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNMembershipTypeCount2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMembershipTypeCountᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberAnalytics_membershipDistribution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubFlow_requested(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberAnalytics",
+		Object:     "ClubFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "type":
-				return ec.fieldContext_MembershipTypeCount_type(ctx, field)
-			case "count":
-				return ec.fieldContext_MembershipTypeCount_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MembershipTypeCount", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.MemberConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberConnection_nodes(ctx, field)
+func (ec *executionContext) _ClubNetworkStats_clubId(ctx context.Context, field graphql.CollectedField, obj *model.ClubNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubNetworkStats_clubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5833,7 +6657,7 @@ func (ec *executionContext) _MemberConnection_nodes(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nodes, nil
+		return obj.ClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5845,50 +6669,26 @@ func (ec *executionContext) _MemberConnection_nodes(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Member)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNMember2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubNetworkStats_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberConnection",
+		Object:     "ClubNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Member_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Member_clubId(ctx, field)
-			case "userId":
-				return ec.fieldContext_Member_userId(ctx, field)
-			case "memberNumber":
-				return ec.fieldContext_Member_memberNumber(ctx, field)
-			case "membershipType":
-				return ec.fieldContext_Member_membershipType(ctx, field)
-			case "status":
-				return ec.fieldContext_Member_status(ctx, field)
-			case "blockchainIdentity":
-				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
-			case "profile":
-				return ec.fieldContext_Member_profile(ctx, field)
-			case "joinedAt":
-				return ec.fieldContext_Member_joinedAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Member_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Member_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.MemberConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberConnection_pageInfo(ctx, field)
+func (ec *executionContext) _ClubNetworkStats_hosted(ctx context.Context, field graphql.CollectedField, obj *model.ClubNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubNetworkStats_hosted(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5901,7 +6701,7 @@ func (ec *executionContext) _MemberConnection_pageInfo(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Hosted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5913,40 +6713,42 @@ func (ec *executionContext) _MemberConnection_pageInfo(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(*model.VisitOutcomes)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNVisitOutcomes2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitOutcomes(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubNetworkStats_hosted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberConnection",
+		Object:     "ClubNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "pageSize":
-				return ec.fieldContext_PageInfo_pageSize(ctx, field)
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfo_totalPages(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPrevPage":
-				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			case "visits":
+				return ec.fieldContext_VisitOutcomes_visits(ctx, field)
+			case "open":
+				return ec.fieldContext_VisitOutcomes_open(ctx, field)
+			case "attended":
+				return ec.fieldContext_VisitOutcomes_attended(ctx, field)
+			case "cancelled":
+				return ec.fieldContext_VisitOutcomes_cancelled(ctx, field)
+			case "noShows":
+				return ec.fieldContext_VisitOutcomes_noShows(ctx, field)
+			case "cancellationRate":
+				return ec.fieldContext_VisitOutcomes_cancellationRate(ctx, field)
+			case "noShowRate":
+				return ec.fieldContext_VisitOutcomes_noShowRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitOutcomes", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberPreferences_emailNotifications(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberPreferences_emailNotifications(ctx, field)
+func (ec *executionContext) _ClubNetworkStats_visitsMade(ctx context.Context, field graphql.CollectedField, obj *model.ClubNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubNetworkStats_visitsMade(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5959,7 +6761,7 @@ func (ec *executionContext) _MemberPreferences_emailNotifications(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EmailNotifications, nil
+		return obj.VisitsMade, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5971,26 +6773,26 @@ func (ec *executionContext) _MemberPreferences_emailNotifications(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberPreferences_emailNotifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubNetworkStats_visitsMade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberPreferences",
+		Object:     "ClubNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberPreferences_smsNotifications(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberPreferences_smsNotifications(ctx, field)
+func (ec *executionContext) _ClubNetworkStats_partners(ctx context.Context, field graphql.CollectedField, obj *model.ClubNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubNetworkStats_partners(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6003,7 +6805,7 @@ func (ec *executionContext) _MemberPreferences_smsNotifications(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SmsNotifications, nil
+		return obj.Partners, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6015,26 +6817,26 @@ func (ec *executionContext) _MemberPreferences_smsNotifications(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberPreferences_smsNotifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubNetworkStats_partners(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberPreferences",
+		Object:     "ClubNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberPreferences_pushNotifications(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberPreferences_pushNotifications(ctx, field)
+func (ec *executionContext) _ClubNetworkStats_hostRating(ctx context.Context, field graphql.CollectedField, obj *model.ClubNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubNetworkStats_hostRating(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6047,38 +6849,41 @@ func (ec *executionContext) _MemberPreferences_pushNotifications(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PushNotifications, nil
+		return obj.HostRating, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.VisitRating)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOVisitRating2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitRating(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberPreferences_pushNotifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubNetworkStats_hostRating(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberPreferences",
+		Object:     "ClubNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "average":
+				return ec.fieldContext_VisitRating_average(ctx, field)
+			case "count":
+				return ec.fieldContext_VisitRating_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitRating", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberPreferences_marketingEmails(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberPreferences_marketingEmails(ctx, field)
+func (ec *executionContext) _ClubNetworkStats_guestRating(ctx context.Context, field graphql.CollectedField, obj *model.ClubNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubNetworkStats_guestRating(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6091,38 +6896,41 @@ func (ec *executionContext) _MemberPreferences_marketingEmails(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MarketingEmails, nil
+		return obj.GuestRating, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.VisitRating)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOVisitRating2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitRating(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberPreferences_marketingEmails(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubNetworkStats_guestRating(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberPreferences",
+		Object:     "ClubNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "average":
+				return ec.fieldContext_VisitRating_average(ctx, field)
+			case "count":
+				return ec.fieldContext_VisitRating_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitRating", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberProfile_firstName(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberProfile_firstName(ctx, field)
+func (ec *executionContext) _ClubNetworkStats_facilities(ctx context.Context, field graphql.CollectedField, obj *model.ClubNetworkStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubNetworkStats_facilities(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6135,7 +6943,7 @@ func (ec *executionContext) _MemberProfile_firstName(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FirstName, nil
+		return obj.Facilities, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6147,26 +6955,32 @@ func (ec *executionContext) _MemberProfile_firstName(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.FacilityUsage)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFacilityUsage2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐFacilityUsageᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberProfile_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubNetworkStats_facilities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberProfile",
+		Object:     "ClubNetworkStats",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "facility":
+				return ec.fieldContext_FacilityUsage_facility(ctx, field)
+			case "visits":
+				return ec.fieldContext_FacilityUsage_visits(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FacilityUsage", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberProfile_lastName(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberProfile_lastName(ctx, field)
+func (ec *executionContext) _ClubSettings_allowReciprocal(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubSettings_allowReciprocal(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6179,7 +6993,7 @@ func (ec *executionContext) _MemberProfile_lastName(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastName, nil
+		return obj.AllowReciprocal, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6191,26 +7005,26 @@ func (ec *executionContext) _MemberProfile_lastName(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberProfile_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubSettings_allowReciprocal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberProfile",
+		Object:     "ClubSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberProfile_dateOfBirth(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberProfile_dateOfBirth(ctx, field)
+func (ec *executionContext) _ClubSettings_requireApproval(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubSettings_requireApproval(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6223,35 +7037,38 @@ func (ec *executionContext) _MemberProfile_dateOfBirth(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DateOfBirth, nil
+		return obj.RequireApproval, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberProfile_dateOfBirth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubSettings_requireApproval(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberProfile",
+		Object:     "ClubSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberProfile_phoneNumber(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberProfile_phoneNumber(ctx, field)
+func (ec *executionContext) _ClubSettings_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubSettings_maxVisitsPerMonth(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6264,35 +7081,38 @@ func (ec *executionContext) _MemberProfile_phoneNumber(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PhoneNumber, nil
+		return obj.MaxVisitsPerMonth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberProfile_phoneNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubSettings_maxVisitsPerMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberProfile",
+		Object:     "ClubSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberProfile_address(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberProfile_address(ctx, field)
+func (ec *executionContext) _ClubSettings_reciprocalFee(ctx context.Context, field graphql.CollectedField, obj *model.ClubSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubSettings_reciprocalFee(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6305,7 +7125,7 @@ func (ec *executionContext) _MemberProfile_address(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.ReciprocalFee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6314,38 +7134,26 @@ func (ec *executionContext) _MemberProfile_address(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Address)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOAddress2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberProfile_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubSettings_reciprocalFee(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberProfile",
+		Object:     "ClubSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "street":
-				return ec.fieldContext_Address_street(ctx, field)
-			case "city":
-				return ec.fieldContext_Address_city(ctx, field)
-			case "state":
-				return ec.fieldContext_Address_state(ctx, field)
-			case "postalCode":
-				return ec.fieldContext_Address_postalCode(ctx, field)
-			case "country":
-				return ec.fieldContext_Address_country(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberProfile_emergencyContact(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberProfile_emergencyContact(ctx, field)
+func (ec *executionContext) _ClubVisitCount_club(ctx context.Context, field graphql.CollectedField, obj *model.ClubVisitCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubVisitCount_club(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6358,43 +7166,58 @@ func (ec *executionContext) _MemberProfile_emergencyContact(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EmergencyContact, nil
+		return obj.Club, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.EmergencyContact)
+	res := resTmp.(*model.Club)
 	fc.Result = res
-	return ec.marshalOEmergencyContact2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐEmergencyContact(ctx, field.Selections, res)
+	return ec.marshalNClub2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClub(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberProfile_emergencyContact(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubVisitCount_club(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberProfile",
+		Object:     "ClubVisitCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_Club_id(ctx, field)
 			case "name":
-				return ec.fieldContext_EmergencyContact_name(ctx, field)
-			case "relationship":
-				return ec.fieldContext_EmergencyContact_relationship(ctx, field)
-			case "phoneNumber":
-				return ec.fieldContext_EmergencyContact_phoneNumber(ctx, field)
+				return ec.fieldContext_Club_name(ctx, field)
+			case "description":
+				return ec.fieldContext_Club_description(ctx, field)
+			case "location":
+				return ec.fieldContext_Club_location(ctx, field)
+			case "website":
+				return ec.fieldContext_Club_website(ctx, field)
+			case "status":
+				return ec.fieldContext_Club_status(ctx, field)
+			case "settings":
+				return ec.fieldContext_Club_settings(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Club_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Club_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type EmergencyContact", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MemberProfile_preferences(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MemberProfile_preferences(ctx, field)
+func (ec *executionContext) _ClubVisitCount_count(ctx context.Context, field graphql.CollectedField, obj *model.ClubVisitCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ClubVisitCount_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6407,45 +7230,38 @@ func (ec *executionContext) _MemberProfile_preferences(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Preferences, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.MemberPreferences)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOMemberPreferences2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberPreferences(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MemberProfile_preferences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ClubVisitCount_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MemberProfile",
+		Object:     "ClubVisitCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "emailNotifications":
-				return ec.fieldContext_MemberPreferences_emailNotifications(ctx, field)
-			case "smsNotifications":
-				return ec.fieldContext_MemberPreferences_smsNotifications(ctx, field)
-			case "pushNotifications":
-				return ec.fieldContext_MemberPreferences_pushNotifications(ctx, field)
-			case "marketingEmails":
-				return ec.fieldContext_MemberPreferences_marketingEmails(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MemberPreferences", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MembershipTypeCount_type(ctx context.Context, field graphql.CollectedField, obj *model.MembershipTypeCount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MembershipTypeCount_type(ctx, field)
+func (ec *executionContext) _EmergencyContact_name(ctx context.Context, field graphql.CollectedField, obj *model.EmergencyContact) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmergencyContact_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6458,7 +7274,7 @@ func (ec *executionContext) _MembershipTypeCount_type(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6470,26 +7286,26 @@ func (ec *executionContext) _MembershipTypeCount_type(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.MembershipType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNMembershipType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMembershipType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MembershipTypeCount_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EmergencyContact_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MembershipTypeCount",
+		Object:     "EmergencyContact",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MembershipType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MembershipTypeCount_count(ctx context.Context, field graphql.CollectedField, obj *model.MembershipTypeCount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MembershipTypeCount_count(ctx, field)
+func (ec *executionContext) _EmergencyContact_relationship(ctx context.Context, field graphql.CollectedField, obj *model.EmergencyContact) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmergencyContact_relationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6502,7 +7318,7 @@ func (ec *executionContext) _MembershipTypeCount_count(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.Relationship, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6514,26 +7330,26 @@ func (ec *executionContext) _MembershipTypeCount_count(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MembershipTypeCount_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EmergencyContact_relationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MembershipTypeCount",
+		Object:     "EmergencyContact",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MonthlyVisit_month(ctx context.Context, field graphql.CollectedField, obj *model.MonthlyVisit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MonthlyVisit_month(ctx, field)
+func (ec *executionContext) _EmergencyContact_phoneNumber(ctx context.Context, field graphql.CollectedField, obj *model.EmergencyContact) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmergencyContact_phoneNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6546,7 +7362,7 @@ func (ec *executionContext) _MonthlyVisit_month(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Month, nil
+		return obj.PhoneNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6563,9 +7379,9 @@ func (ec *executionContext) _MonthlyVisit_month(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MonthlyVisit_month(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EmergencyContact_phoneNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MonthlyVisit",
+		Object:     "EmergencyContact",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6576,8 +7392,8 @@ func (ec *executionContext) fieldContext_MonthlyVisit_month(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _MonthlyVisit_count(ctx context.Context, field graphql.CollectedField, obj *model.MonthlyVisit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MonthlyVisit_count(ctx, field)
+func (ec *executionContext) _FacilityUsage_facility(ctx context.Context, field graphql.CollectedField, obj *model.FacilityUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FacilityUsage_facility(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6590,7 +7406,7 @@ func (ec *executionContext) _MonthlyVisit_count(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.Facility, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6602,26 +7418,26 @@ func (ec *executionContext) _MonthlyVisit_count(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MonthlyVisit_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FacilityUsage_facility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MonthlyVisit",
+		Object:     "FacilityUsage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_login(ctx, field)
+func (ec *executionContext) _FacilityUsage_visits(ctx context.Context, field graphql.CollectedField, obj *model.FacilityUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FacilityUsage_visits(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6634,7 +7450,7 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Login(rctx, fc.Args["input"].(model.LoginInput))
+		return obj.Visits, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6646,47 +7462,26 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AuthPayload)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNAuthPayload2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAuthPayload(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FacilityUsage_visits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FacilityUsage",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_AuthPayload_token(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_register(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_register(ctx, field)
+func (ec *executionContext) _Member_id(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6699,7 +7494,7 @@ func (ec *executionContext) _Mutation_register(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Register(rctx, fc.Args["input"].(model.RegisterInput))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6711,47 +7506,26 @@ func (ec *executionContext) _Mutation_register(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AuthPayload)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAuthPayload2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAuthPayload(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_register(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_AuthPayload_token(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_register_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_refreshToken(ctx, field)
+func (ec *executionContext) _Member_clubId(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_clubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6764,7 +7538,7 @@ func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RefreshToken(rctx, fc.Args["refreshToken"].(string))
+		return obj.ClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6776,47 +7550,26 @@ func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AuthPayload)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAuthPayload2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAuthPayload(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_AuthPayload_token(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_refreshToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_logout(ctx, field)
+func (ec *executionContext) _Member_userId(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_userId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6829,7 +7582,7 @@ func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Logout(rctx)
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6841,26 +7594,26 @@ func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_logout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createMember(ctx, field)
+func (ec *executionContext) _Member_memberNumber(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_memberNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6873,7 +7626,7 @@ func (ec *executionContext) _Mutation_createMember(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateMember(rctx, fc.Args["input"].(model.CreateMemberInput))
+		return obj.MemberNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6885,61 +7638,26 @@ func (ec *executionContext) _Mutation_createMember(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Member)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_memberNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Member_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Member_clubId(ctx, field)
-			case "userId":
-				return ec.fieldContext_Member_userId(ctx, field)
-			case "memberNumber":
-				return ec.fieldContext_Member_memberNumber(ctx, field)
-			case "membershipType":
-				return ec.fieldContext_Member_membershipType(ctx, field)
-			case "status":
-				return ec.fieldContext_Member_status(ctx, field)
-			case "blockchainIdentity":
-				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
-			case "profile":
-				return ec.fieldContext_Member_profile(ctx, field)
-			case "joinedAt":
-				return ec.fieldContext_Member_joinedAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Member_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Member_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updateMember(ctx, field)
+func (ec *executionContext) _Member_membershipType(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_membershipType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6952,7 +7670,7 @@ func (ec *executionContext) _Mutation_updateMember(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateMember(rctx, fc.Args["id"].(string), fc.Args["input"].(model.MemberProfileInput))
+		return obj.MembershipType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6964,61 +7682,26 @@ func (ec *executionContext) _Mutation_updateMember(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Member)
+	res := resTmp.(model.MembershipType)
 	fc.Result = res
-	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+	return ec.marshalNMembershipType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMembershipType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_membershipType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Member_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Member_clubId(ctx, field)
-			case "userId":
-				return ec.fieldContext_Member_userId(ctx, field)
-			case "memberNumber":
-				return ec.fieldContext_Member_memberNumber(ctx, field)
-			case "membershipType":
-				return ec.fieldContext_Member_membershipType(ctx, field)
-			case "status":
-				return ec.fieldContext_Member_status(ctx, field)
-			case "blockchainIdentity":
-				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
-			case "profile":
-				return ec.fieldContext_Member_profile(ctx, field)
-			case "joinedAt":
-				return ec.fieldContext_Member_joinedAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Member_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Member_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+			return nil, errors.New("field of type MembershipType does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_suspendMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_suspendMember(ctx, field)
+func (ec *executionContext) _Member_status(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7031,7 +7714,7 @@ func (ec *executionContext) _Mutation_suspendMember(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SuspendMember(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7043,61 +7726,67 @@ func (ec *executionContext) _Mutation_suspendMember(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Member)
+	res := resTmp.(model.MemberStatus)
 	fc.Result = res
-	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+	return ec.marshalNMemberStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_suspendMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Member_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Member_clubId(ctx, field)
-			case "userId":
-				return ec.fieldContext_Member_userId(ctx, field)
-			case "memberNumber":
-				return ec.fieldContext_Member_memberNumber(ctx, field)
-			case "membershipType":
-				return ec.fieldContext_Member_membershipType(ctx, field)
-			case "status":
-				return ec.fieldContext_Member_status(ctx, field)
-			case "blockchainIdentity":
-				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
-			case "profile":
-				return ec.fieldContext_Member_profile(ctx, field)
-			case "joinedAt":
-				return ec.fieldContext_Member_joinedAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Member_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Member_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+			return nil, errors.New("field of type MemberStatus does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Member_blockchainIdentity(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_blockchainIdentity(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_suspendMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BlockchainIdentity, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Member_blockchainIdentity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Member",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_reactivateMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_reactivateMember(ctx, field)
+func (ec *executionContext) _Member_profile(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_profile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7110,73 +7799,51 @@ func (ec *executionContext) _Mutation_reactivateMember(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ReactivateMember(rctx, fc.Args["id"].(string))
+		return obj.Profile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Member)
+	res := resTmp.(*model.MemberProfile)
 	fc.Result = res
-	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+	return ec.marshalOMemberProfile2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_reactivateMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Member_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Member_clubId(ctx, field)
-			case "userId":
-				return ec.fieldContext_Member_userId(ctx, field)
-			case "memberNumber":
-				return ec.fieldContext_Member_memberNumber(ctx, field)
-			case "membershipType":
-				return ec.fieldContext_Member_membershipType(ctx, field)
-			case "status":
-				return ec.fieldContext_Member_status(ctx, field)
-			case "blockchainIdentity":
-				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
-			case "profile":
-				return ec.fieldContext_Member_profile(ctx, field)
-			case "joinedAt":
-				return ec.fieldContext_Member_joinedAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Member_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Member_updatedAt(ctx, field)
+			case "firstName":
+				return ec.fieldContext_MemberProfile_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_MemberProfile_lastName(ctx, field)
+			case "dateOfBirth":
+				return ec.fieldContext_MemberProfile_dateOfBirth(ctx, field)
+			case "phoneNumber":
+				return ec.fieldContext_MemberProfile_phoneNumber(ctx, field)
+			case "address":
+				return ec.fieldContext_MemberProfile_address(ctx, field)
+			case "emergencyContact":
+				return ec.fieldContext_MemberProfile_emergencyContact(ctx, field)
+			case "preferences":
+				return ec.fieldContext_MemberProfile_preferences(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MemberProfile", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_reactivateMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createReciprocalAgreement(ctx, field)
+func (ec *executionContext) _Member_joinedAt(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_joinedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7189,7 +7856,7 @@ func (ec *executionContext) _Mutation_createReciprocalAgreement(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateReciprocalAgreement(rctx, fc.Args["input"].(model.CreateReciprocalAgreementInput))
+		return obj.JoinedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7201,57 +7868,26 @@ func (ec *executionContext) _Mutation_createReciprocalAgreement(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ReciprocalAgreement)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_joinedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
-			case "partnerClubId":
-				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
-			case "terms":
-				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
-			case "effectiveDate":
-				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
-			case "expirationDate":
-				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_approveReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_approveReciprocalAgreement(ctx, field)
+func (ec *executionContext) _Member_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7264,7 +7900,7 @@ func (ec *executionContext) _Mutation_approveReciprocalAgreement(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ApproveReciprocalAgreement(rctx, fc.Args["id"].(string))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7276,57 +7912,26 @@ func (ec *executionContext) _Mutation_approveReciprocalAgreement(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ReciprocalAgreement)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_approveReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
-			case "partnerClubId":
-				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
-			case "terms":
-				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
-			case "effectiveDate":
-				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
-			case "expirationDate":
-				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_approveReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_rejectReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_rejectReciprocalAgreement(ctx, field)
+func (ec *executionContext) _Member_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Member) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Member_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7339,7 +7944,7 @@ func (ec *executionContext) _Mutation_rejectReciprocalAgreement(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RejectReciprocalAgreement(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7351,57 +7956,26 @@ func (ec *executionContext) _Mutation_rejectReciprocalAgreement(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ReciprocalAgreement)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_rejectReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Member_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Member",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
-			case "partnerClubId":
-				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
-			case "terms":
-				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
-			case "effectiveDate":
-				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
-			case "expirationDate":
-				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_rejectReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_suspendReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_suspendReciprocalAgreement(ctx, field)
+func (ec *executionContext) _MemberAnalytics_totalMembers(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberAnalytics_totalMembers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7414,7 +7988,7 @@ func (ec *executionContext) _Mutation_suspendReciprocalAgreement(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SuspendReciprocalAgreement(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
+		return obj.TotalMembers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7426,57 +8000,26 @@ func (ec *executionContext) _Mutation_suspendReciprocalAgreement(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ReciprocalAgreement)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_suspendReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberAnalytics_totalMembers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberAnalytics",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
-			case "partnerClubId":
-				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
-			case "terms":
-				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
-			case "effectiveDate":
-				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
-			case "expirationDate":
-				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_suspendReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_recordVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_recordVisit(ctx, field)
+func (ec *executionContext) _MemberAnalytics_activeMembers(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberAnalytics_activeMembers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7489,7 +8032,7 @@ func (ec *executionContext) _Mutation_recordVisit(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RecordVisit(rctx, fc.Args["input"].(model.RecordVisitInput))
+		return obj.ActiveMembers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7501,63 +8044,70 @@ func (ec *executionContext) _Mutation_recordVisit(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Visit)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_recordVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberAnalytics_activeMembers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberAnalytics",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Visit_id(ctx, field)
-			case "memberId":
-				return ec.fieldContext_Visit_memberId(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Visit_clubId(ctx, field)
-			case "visitingClubId":
-				return ec.fieldContext_Visit_visitingClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_Visit_status(ctx, field)
-			case "checkInTime":
-				return ec.fieldContext_Visit_checkInTime(ctx, field)
-			case "checkOutTime":
-				return ec.fieldContext_Visit_checkOutTime(ctx, field)
-			case "services":
-				return ec.fieldContext_Visit_services(ctx, field)
-			case "cost":
-				return ec.fieldContext_Visit_cost(ctx, field)
-			case "verified":
-				return ec.fieldContext_Visit_verified(ctx, field)
-			case "blockchainTxId":
-				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Visit_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberAnalytics_newMembersThisMonth(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberAnalytics_newMembersThisMonth(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_recordVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NewMembersThisMonth, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MemberAnalytics_newMembersThisMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberAnalytics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_checkOutVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_checkOutVisit(ctx, field)
+func (ec *executionContext) _MemberAnalytics_membershipDistribution(ctx context.Context, field graphql.CollectedField, obj *model.MemberAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberAnalytics_membershipDistribution(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7570,7 +8120,7 @@ func (ec *executionContext) _Mutation_checkOutVisit(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CheckOutVisit(rctx, fc.Args["input"].(model.CheckOutVisitInput))
+		return obj.MembershipDistribution, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7582,63 +8132,32 @@ func (ec *executionContext) _Mutation_checkOutVisit(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Visit)
+	res := resTmp.([]*model.MembershipTypeCount)
 	fc.Result = res
-	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
+	return ec.marshalNMembershipTypeCount2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMembershipTypeCountᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_checkOutVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberAnalytics_membershipDistribution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberAnalytics",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Visit_id(ctx, field)
-			case "memberId":
-				return ec.fieldContext_Visit_memberId(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Visit_clubId(ctx, field)
-			case "visitingClubId":
-				return ec.fieldContext_Visit_visitingClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_Visit_status(ctx, field)
-			case "checkInTime":
-				return ec.fieldContext_Visit_checkInTime(ctx, field)
-			case "checkOutTime":
-				return ec.fieldContext_Visit_checkOutTime(ctx, field)
-			case "services":
-				return ec.fieldContext_Visit_services(ctx, field)
-			case "cost":
-				return ec.fieldContext_Visit_cost(ctx, field)
-			case "verified":
-				return ec.fieldContext_Visit_verified(ctx, field)
-			case "blockchainTxId":
-				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Visit_createdAt(ctx, field)
+			case "type":
+				return ec.fieldContext_MembershipTypeCount_type(ctx, field)
+			case "count":
+				return ec.fieldContext_MembershipTypeCount_count(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MembershipTypeCount", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_checkOutVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_cancelVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_cancelVisit(ctx, field)
+func (ec *executionContext) _MemberConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.MemberConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberConnection_nodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7651,7 +8170,7 @@ func (ec *executionContext) _Mutation_cancelVisit(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CancelVisit(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
+		return obj.Nodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7663,63 +8182,50 @@ func (ec *executionContext) _Mutation_cancelVisit(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Visit)
+	res := resTmp.([]*model.Member)
 	fc.Result = res
-	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
+	return ec.marshalNMember2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_cancelVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Visit_id(ctx, field)
-			case "memberId":
-				return ec.fieldContext_Visit_memberId(ctx, field)
+				return ec.fieldContext_Member_id(ctx, field)
 			case "clubId":
-				return ec.fieldContext_Visit_clubId(ctx, field)
-			case "visitingClubId":
-				return ec.fieldContext_Visit_visitingClubId(ctx, field)
+				return ec.fieldContext_Member_clubId(ctx, field)
+			case "userId":
+				return ec.fieldContext_Member_userId(ctx, field)
+			case "memberNumber":
+				return ec.fieldContext_Member_memberNumber(ctx, field)
+			case "membershipType":
+				return ec.fieldContext_Member_membershipType(ctx, field)
 			case "status":
-				return ec.fieldContext_Visit_status(ctx, field)
-			case "checkInTime":
-				return ec.fieldContext_Visit_checkInTime(ctx, field)
-			case "checkOutTime":
-				return ec.fieldContext_Visit_checkOutTime(ctx, field)
-			case "services":
-				return ec.fieldContext_Visit_services(ctx, field)
-			case "cost":
-				return ec.fieldContext_Visit_cost(ctx, field)
-			case "verified":
-				return ec.fieldContext_Visit_verified(ctx, field)
-			case "blockchainTxId":
-				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
+				return ec.fieldContext_Member_status(ctx, field)
+			case "blockchainIdentity":
+				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
+			case "profile":
+				return ec.fieldContext_Member_profile(ctx, field)
+			case "joinedAt":
+				return ec.fieldContext_Member_joinedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Visit_createdAt(ctx, field)
+				return ec.fieldContext_Member_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Member_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_cancelVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_verifyVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_verifyVisit(ctx, field)
+func (ec *executionContext) _MemberConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.MemberConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7732,7 +8238,7 @@ func (ec *executionContext) _Mutation_verifyVisit(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().VerifyVisit(rctx, fc.Args["id"].(string))
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7744,63 +8250,40 @@ func (ec *executionContext) _Mutation_verifyVisit(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Visit)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_verifyVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Visit_id(ctx, field)
-			case "memberId":
-				return ec.fieldContext_Visit_memberId(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Visit_clubId(ctx, field)
-			case "visitingClubId":
-				return ec.fieldContext_Visit_visitingClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_Visit_status(ctx, field)
-			case "checkInTime":
-				return ec.fieldContext_Visit_checkInTime(ctx, field)
-			case "checkOutTime":
-				return ec.fieldContext_Visit_checkOutTime(ctx, field)
-			case "services":
-				return ec.fieldContext_Visit_services(ctx, field)
-			case "cost":
-				return ec.fieldContext_Visit_cost(ctx, field)
-			case "verified":
-				return ec.fieldContext_Visit_verified(ctx, field)
-			case "blockchainTxId":
-				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Visit_createdAt(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "pageSize":
+				return ec.fieldContext_PageInfo_pageSize(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPrevPage":
+				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_verifyVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createNotification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createNotification(ctx, field)
+func (ec *executionContext) _MemberPreferences_emailNotifications(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberPreferences_emailNotifications(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7813,7 +8296,7 @@ func (ec *executionContext) _Mutation_createNotification(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateNotification(rctx, fc.Args["input"].(model.CreateNotificationInput))
+		return obj.EmailNotifications, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7825,61 +8308,26 @@ func (ec *executionContext) _Mutation_createNotification(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Notification)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNNotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createNotification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberPreferences_emailNotifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberPreferences",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Notification_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Notification_userId(ctx, field)
-			case "type":
-				return ec.fieldContext_Notification_type(ctx, field)
-			case "title":
-				return ec.fieldContext_Notification_title(ctx, field)
-			case "message":
-				return ec.fieldContext_Notification_message(ctx, field)
-			case "status":
-				return ec.fieldContext_Notification_status(ctx, field)
-			case "channels":
-				return ec.fieldContext_Notification_channels(ctx, field)
-			case "scheduledAt":
-				return ec.fieldContext_Notification_scheduledAt(ctx, field)
-			case "sentAt":
-				return ec.fieldContext_Notification_sentAt(ctx, field)
-			case "readAt":
-				return ec.fieldContext_Notification_readAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Notification_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createNotification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_markNotificationRead(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_markNotificationRead(ctx, field)
+func (ec *executionContext) _MemberPreferences_smsNotifications(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberPreferences_smsNotifications(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7892,7 +8340,7 @@ func (ec *executionContext) _Mutation_markNotificationRead(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().MarkNotificationRead(rctx, fc.Args["id"].(string))
+		return obj.SmsNotifications, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7904,61 +8352,26 @@ func (ec *executionContext) _Mutation_markNotificationRead(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Notification)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNNotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_markNotificationRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberPreferences_smsNotifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberPreferences",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Notification_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Notification_userId(ctx, field)
-			case "type":
-				return ec.fieldContext_Notification_type(ctx, field)
-			case "title":
-				return ec.fieldContext_Notification_title(ctx, field)
-			case "message":
-				return ec.fieldContext_Notification_message(ctx, field)
-			case "status":
-				return ec.fieldContext_Notification_status(ctx, field)
-			case "channels":
-				return ec.fieldContext_Notification_channels(ctx, field)
-			case "scheduledAt":
-				return ec.fieldContext_Notification_scheduledAt(ctx, field)
-			case "sentAt":
-				return ec.fieldContext_Notification_sentAt(ctx, field)
-			case "readAt":
-				return ec.fieldContext_Notification_readAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Notification_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_markNotificationRead_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_markAllNotificationsRead(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_markAllNotificationsRead(ctx, field)
+func (ec *executionContext) _MemberPreferences_pushNotifications(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberPreferences_pushNotifications(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7971,7 +8384,7 @@ func (ec *executionContext) _Mutation_markAllNotificationsRead(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().MarkAllNotificationsRead(rctx)
+		return obj.PushNotifications, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7988,12 +8401,12 @@ func (ec *executionContext) _Mutation_markAllNotificationsRead(ctx context.Conte
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_markAllNotificationsRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberPreferences_pushNotifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberPreferences",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Boolean does not have child fields")
 		},
@@ -8001,8 +8414,8 @@ func (ec *executionContext) fieldContext_Mutation_markAllNotificationsRead(ctx c
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createProposal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createProposal(ctx, field)
+func (ec *executionContext) _MemberPreferences_marketingEmails(ctx context.Context, field graphql.CollectedField, obj *model.MemberPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberPreferences_marketingEmails(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8015,7 +8428,7 @@ func (ec *executionContext) _Mutation_createProposal(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateProposal(rctx, fc.Args["input"].(model.CreateProposalInput))
+		return obj.MarketingEmails, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8027,57 +8440,26 @@ func (ec *executionContext) _Mutation_createProposal(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Proposal)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createProposal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberPreferences_marketingEmails(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberPreferences",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Proposal_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Proposal_title(ctx, field)
-			case "description":
-				return ec.fieldContext_Proposal_description(ctx, field)
-			case "type":
-				return ec.fieldContext_Proposal_type(ctx, field)
-			case "status":
-				return ec.fieldContext_Proposal_status(ctx, field)
-			case "proposer":
-				return ec.fieldContext_Proposal_proposer(ctx, field)
-			case "votes":
-				return ec.fieldContext_Proposal_votes(ctx, field)
-			case "votingDeadline":
-				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Proposal_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createProposal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_castVote(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_castVote(ctx, field)
+func (ec *executionContext) _MemberProfile_firstName(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberProfile_firstName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8090,7 +8472,7 @@ func (ec *executionContext) _Mutation_castVote(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CastVote(rctx, fc.Args["input"].(model.CastVoteInput))
+		return obj.FirstName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8102,51 +8484,26 @@ func (ec *executionContext) _Mutation_castVote(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Vote)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNVote2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVote(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_castVote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberProfile_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberProfile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Vote_id(ctx, field)
-			case "proposalId":
-				return ec.fieldContext_Vote_proposalId(ctx, field)
-			case "voter":
-				return ec.fieldContext_Vote_voter(ctx, field)
-			case "choice":
-				return ec.fieldContext_Vote_choice(ctx, field)
-			case "comment":
-				return ec.fieldContext_Vote_comment(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Vote_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_castVote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_finalizeProposal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_finalizeProposal(ctx, field)
+func (ec *executionContext) _MemberProfile_lastName(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberProfile_lastName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8159,7 +8516,7 @@ func (ec *executionContext) _Mutation_finalizeProposal(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().FinalizeProposal(rctx, fc.Args["id"].(string))
+		return obj.LastName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8171,57 +8528,26 @@ func (ec *executionContext) _Mutation_finalizeProposal(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Proposal)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_finalizeProposal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberProfile_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberProfile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Proposal_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Proposal_title(ctx, field)
-			case "description":
-				return ec.fieldContext_Proposal_description(ctx, field)
-			case "type":
-				return ec.fieldContext_Proposal_type(ctx, field)
-			case "status":
-				return ec.fieldContext_Proposal_status(ctx, field)
-			case "proposer":
-				return ec.fieldContext_Proposal_proposer(ctx, field)
-			case "votes":
-				return ec.fieldContext_Proposal_votes(ctx, field)
-			case "votingDeadline":
-				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Proposal_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_finalizeProposal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_syncBlockchainData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_syncBlockchainData(ctx, field)
+func (ec *executionContext) _MemberProfile_dateOfBirth(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberProfile_dateOfBirth(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8234,38 +8560,35 @@ func (ec *executionContext) _Mutation_syncBlockchainData(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SyncBlockchainData(rctx)
+		return obj.DateOfBirth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_syncBlockchainData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberProfile_dateOfBirth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberProfile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_generateAnalyticsReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_generateAnalyticsReport(ctx, field)
+func (ec *executionContext) _MemberProfile_phoneNumber(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberProfile_phoneNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8278,49 +8601,35 @@ func (ec *executionContext) _Mutation_generateAnalyticsReport(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().GenerateAnalyticsReport(rctx, fc.Args["startDate"].(time.Time), fc.Args["endDate"].(time.Time))
+		return obj.PhoneNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_generateAnalyticsReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberProfile_phoneNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberProfile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_generateAnalyticsReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_id(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_id(ctx, field)
+func (ec *executionContext) _MemberProfile_address(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberProfile_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8333,38 +8642,47 @@ func (ec *executionContext) _Notification_id(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Address)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOAddress2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberProfile_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "MemberProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "street":
+				return ec.fieldContext_Address_street(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Address_state(ctx, field)
+			case "postalCode":
+				return ec.fieldContext_Address_postalCode(ctx, field)
+			case "country":
+				return ec.fieldContext_Address_country(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_userId(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_userId(ctx, field)
+func (ec *executionContext) _MemberProfile_emergencyContact(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberProfile_emergencyContact(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8377,38 +8695,43 @@ func (ec *executionContext) _Notification_userId(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.EmergencyContact, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.EmergencyContact)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOEmergencyContact2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐEmergencyContact(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberProfile_emergencyContact(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "MemberProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_EmergencyContact_name(ctx, field)
+			case "relationship":
+				return ec.fieldContext_EmergencyContact_relationship(ctx, field)
+			case "phoneNumber":
+				return ec.fieldContext_EmergencyContact_phoneNumber(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EmergencyContact", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_type(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_type(ctx, field)
+func (ec *executionContext) _MemberProfile_preferences(ctx context.Context, field graphql.CollectedField, obj *model.MemberProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberProfile_preferences(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8421,38 +8744,45 @@ func (ec *executionContext) _Notification_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Preferences, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.NotificationType)
+	res := resTmp.(*model.MemberPreferences)
 	fc.Result = res
-	return ec.marshalNNotificationType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationType(ctx, field.Selections, res)
+	return ec.marshalOMemberPreferences2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberPreferences(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberProfile_preferences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "MemberProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type NotificationType does not have child fields")
+			switch field.Name {
+			case "emailNotifications":
+				return ec.fieldContext_MemberPreferences_emailNotifications(ctx, field)
+			case "smsNotifications":
+				return ec.fieldContext_MemberPreferences_smsNotifications(ctx, field)
+			case "pushNotifications":
+				return ec.fieldContext_MemberPreferences_pushNotifications(ctx, field)
+			case "marketingEmails":
+				return ec.fieldContext_MemberPreferences_marketingEmails(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MemberPreferences", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_title(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_title(ctx, field)
+func (ec *executionContext) _MembershipTypeCount_type(ctx context.Context, field graphql.CollectedField, obj *model.MembershipTypeCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MembershipTypeCount_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8465,7 +8795,7 @@ func (ec *executionContext) _Notification_title(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8477,26 +8807,26 @@ func (ec *executionContext) _Notification_title(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.MembershipType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNMembershipType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMembershipType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MembershipTypeCount_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "MembershipTypeCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MembershipType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_message(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_message(ctx, field)
+func (ec *executionContext) _MembershipTypeCount_count(ctx context.Context, field graphql.CollectedField, obj *model.MembershipTypeCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MembershipTypeCount_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8509,7 +8839,7 @@ func (ec *executionContext) _Notification_message(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8521,26 +8851,26 @@ func (ec *executionContext) _Notification_message(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MembershipTypeCount_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "MembershipTypeCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_status(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_status(ctx, field)
+func (ec *executionContext) _MonthlyVisit_month(ctx context.Context, field graphql.CollectedField, obj *model.MonthlyVisit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MonthlyVisit_month(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8553,7 +8883,7 @@ func (ec *executionContext) _Notification_status(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Month, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8565,26 +8895,26 @@ func (ec *executionContext) _Notification_status(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.NotificationStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNNotificationStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MonthlyVisit_month(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "MonthlyVisit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type NotificationStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_channels(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_channels(ctx, field)
+func (ec *executionContext) _MonthlyVisit_count(ctx context.Context, field graphql.CollectedField, obj *model.MonthlyVisit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MonthlyVisit_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8597,7 +8927,7 @@ func (ec *executionContext) _Notification_channels(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Channels, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8609,26 +8939,26 @@ func (ec *executionContext) _Notification_channels(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.NotificationChannel)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNNotificationChannel2ᚕreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationChannelᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_channels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MonthlyVisit_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "MonthlyVisit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type NotificationChannel does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_scheduledAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_scheduledAt(ctx, field)
+func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_login(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8641,35 +8971,59 @@ func (ec *executionContext) _Notification_scheduledAt(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ScheduledAt, nil
+		return ec.resolvers.Mutation().Login(rctx, fc.Args["input"].(model.LoginInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*model.AuthPayload)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNAuthPayload2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAuthPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_scheduledAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_AuthPayload_token(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_sentAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_sentAt(ctx, field)
+func (ec *executionContext) _Mutation_register(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_register(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8682,35 +9036,59 @@ func (ec *executionContext) _Notification_sentAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SentAt, nil
+		return ec.resolvers.Mutation().Register(rctx, fc.Args["input"].(model.RegisterInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*model.AuthPayload)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNAuthPayload2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAuthPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_sentAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_register(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_AuthPayload_token(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_register_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_readAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_readAt(ctx, field)
+func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_refreshToken(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8723,35 +9101,59 @@ func (ec *executionContext) _Notification_readAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ReadAt, nil
+		return ec.resolvers.Mutation().RefreshToken(rctx, fc.Args["refreshToken"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*model.AuthPayload)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNAuthPayload2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAuthPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_readAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_AuthPayload_token(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_refreshToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Notification_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notification_createdAt(ctx, field)
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_logout(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8764,7 +9166,7 @@ func (ec *executionContext) _Notification_createdAt(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.Mutation().Logout(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8776,26 +9178,26 @@ func (ec *executionContext) _Notification_createdAt(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notification_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_logout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notification",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NotificationConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.NotificationConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NotificationConnection_nodes(ctx, field)
+func (ec *executionContext) _Mutation_revokeSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_revokeSession(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8808,7 +9210,7 @@ func (ec *executionContext) _NotificationConnection_nodes(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nodes, nil
+		return ec.resolvers.Mutation().RevokeSession(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8820,50 +9222,37 @@ func (ec *executionContext) _NotificationConnection_nodes(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Notification)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNNotification2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NotificationConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_revokeSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NotificationConnection",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Notification_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Notification_userId(ctx, field)
-			case "type":
-				return ec.fieldContext_Notification_type(ctx, field)
-			case "title":
-				return ec.fieldContext_Notification_title(ctx, field)
-			case "message":
-				return ec.fieldContext_Notification_message(ctx, field)
-			case "status":
-				return ec.fieldContext_Notification_status(ctx, field)
-			case "channels":
-				return ec.fieldContext_Notification_channels(ctx, field)
-			case "scheduledAt":
-				return ec.fieldContext_Notification_scheduledAt(ctx, field)
-			case "sentAt":
-				return ec.fieldContext_Notification_sentAt(ctx, field)
-			case "readAt":
-				return ec.fieldContext_Notification_readAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Notification_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_revokeSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NotificationConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.NotificationConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NotificationConnection_pageInfo(ctx, field)
+func (ec *executionContext) _Mutation_revokeOtherSessions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_revokeOtherSessions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8876,7 +9265,7 @@ func (ec *executionContext) _NotificationConnection_pageInfo(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return ec.resolvers.Mutation().RevokeOtherSessions(rctx, fc.Args["currentSessionId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8888,40 +9277,37 @@ func (ec *executionContext) _NotificationConnection_pageInfo(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NotificationConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_revokeOtherSessions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NotificationConnection",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "pageSize":
-				return ec.fieldContext_PageInfo_pageSize(ctx, field)
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfo_totalPages(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPrevPage":
-				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_revokeOtherSessions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_page(ctx, field)
+func (ec *executionContext) _Mutation_adminRevokeSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_adminRevokeSession(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8934,7 +9320,7 @@ func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return ec.resolvers.Mutation().AdminRevokeSession(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8946,26 +9332,37 @@ func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_adminRevokeSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_adminRevokeSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_pageSize(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_pageSize(ctx, field)
+func (ec *executionContext) _Mutation_revokeUserSessions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_revokeUserSessions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8978,7 +9375,7 @@ func (ec *executionContext) _PageInfo_pageSize(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return ec.resolvers.Mutation().RevokeUserSessions(rctx, fc.Args["userId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8995,21 +9392,32 @@ func (ec *executionContext) _PageInfo_pageSize(ctx context.Context, field graphq
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_pageSize(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_revokeUserSessions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_revokeUserSessions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_total(ctx, field)
+func (ec *executionContext) _Mutation_createMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createMember(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9022,7 +9430,7 @@ func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return ec.resolvers.Mutation().CreateMember(rctx, fc.Args["input"].(model.CreateMemberInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9034,26 +9442,61 @@ func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Member)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Member_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Member_clubId(ctx, field)
+			case "userId":
+				return ec.fieldContext_Member_userId(ctx, field)
+			case "memberNumber":
+				return ec.fieldContext_Member_memberNumber(ctx, field)
+			case "membershipType":
+				return ec.fieldContext_Member_membershipType(ctx, field)
+			case "status":
+				return ec.fieldContext_Member_status(ctx, field)
+			case "blockchainIdentity":
+				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
+			case "profile":
+				return ec.fieldContext_Member_profile(ctx, field)
+			case "joinedAt":
+				return ec.fieldContext_Member_joinedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Member_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Member_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_totalPages(ctx, field)
+func (ec *executionContext) _Mutation_updateMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateMember(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9066,7 +9509,7 @@ func (ec *executionContext) _PageInfo_totalPages(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalPages, nil
+		return ec.resolvers.Mutation().UpdateMember(rctx, fc.Args["id"].(string), fc.Args["input"].(model.MemberProfileInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9078,26 +9521,61 @@ func (ec *executionContext) _PageInfo_totalPages(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Member)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Member_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Member_clubId(ctx, field)
+			case "userId":
+				return ec.fieldContext_Member_userId(ctx, field)
+			case "memberNumber":
+				return ec.fieldContext_Member_memberNumber(ctx, field)
+			case "membershipType":
+				return ec.fieldContext_Member_membershipType(ctx, field)
+			case "status":
+				return ec.fieldContext_Member_status(ctx, field)
+			case "blockchainIdentity":
+				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
+			case "profile":
+				return ec.fieldContext_Member_profile(ctx, field)
+			case "joinedAt":
+				return ec.fieldContext_Member_joinedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Member_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Member_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+func (ec *executionContext) _Mutation_suspendMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_suspendMember(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9110,7 +9588,7 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNextPage, nil
+		return ec.resolvers.Mutation().SuspendMember(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9122,27 +9600,62 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Member)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_suspendMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _PageInfo_hasPrevPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
-	if err != nil {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Member_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Member_clubId(ctx, field)
+			case "userId":
+				return ec.fieldContext_Member_userId(ctx, field)
+			case "memberNumber":
+				return ec.fieldContext_Member_memberNumber(ctx, field)
+			case "membershipType":
+				return ec.fieldContext_Member_membershipType(ctx, field)
+			case "status":
+				return ec.fieldContext_Member_status(ctx, field)
+			case "blockchainIdentity":
+				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
+			case "profile":
+				return ec.fieldContext_Member_profile(ctx, field)
+			case "joinedAt":
+				return ec.fieldContext_Member_joinedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Member_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Member_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_suspendMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_reactivateMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_reactivateMember(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -9154,7 +9667,7 @@ func (ec *executionContext) _PageInfo_hasPrevPage(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasPrevPage, nil
+		return ec.resolvers.Mutation().ReactivateMember(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9166,26 +9679,61 @@ func (ec *executionContext) _PageInfo_hasPrevPage(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Member)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasPrevPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_reactivateMember(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Member_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Member_clubId(ctx, field)
+			case "userId":
+				return ec.fieldContext_Member_userId(ctx, field)
+			case "memberNumber":
+				return ec.fieldContext_Member_memberNumber(ctx, field)
+			case "membershipType":
+				return ec.fieldContext_Member_membershipType(ctx, field)
+			case "status":
+				return ec.fieldContext_Member_status(ctx, field)
+			case "blockchainIdentity":
+				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
+			case "profile":
+				return ec.fieldContext_Member_profile(ctx, field)
+			case "joinedAt":
+				return ec.fieldContext_Member_joinedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Member_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Member_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_reactivateMember_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_id(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_id(ctx, field)
+func (ec *executionContext) _Mutation_createReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createReciprocalAgreement(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9198,7 +9746,7 @@ func (ec *executionContext) _Proposal_id(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().CreateReciprocalAgreement(rctx, fc.Args["input"].(model.CreateReciprocalAgreementInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9210,26 +9758,57 @@ func (ec *executionContext) _Proposal_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ReciprocalAgreement)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+			case "partnerClubId":
+				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+			case "terms":
+				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+			case "expirationDate":
+				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_title(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_title(ctx, field)
+func (ec *executionContext) _Mutation_approveReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_approveReciprocalAgreement(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9242,7 +9821,7 @@ func (ec *executionContext) _Proposal_title(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return ec.resolvers.Mutation().ApproveReciprocalAgreement(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9254,26 +9833,57 @@ func (ec *executionContext) _Proposal_title(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ReciprocalAgreement)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_approveReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+			case "partnerClubId":
+				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+			case "terms":
+				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+			case "expirationDate":
+				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_description(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_description(ctx, field)
+func (ec *executionContext) _Mutation_rejectReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_rejectReciprocalAgreement(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9286,7 +9896,7 @@ func (ec *executionContext) _Proposal_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return ec.resolvers.Mutation().RejectReciprocalAgreement(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9298,26 +9908,57 @@ func (ec *executionContext) _Proposal_description(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ReciprocalAgreement)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_rejectReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+			case "partnerClubId":
+				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+			case "terms":
+				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+			case "expirationDate":
+				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rejectReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_type(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_type(ctx, field)
+func (ec *executionContext) _Mutation_suspendReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_suspendReciprocalAgreement(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9330,7 +9971,7 @@ func (ec *executionContext) _Proposal_type(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Mutation().SuspendReciprocalAgreement(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9342,26 +9983,57 @@ func (ec *executionContext) _Proposal_type(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ProposalType)
+	res := resTmp.(*model.ReciprocalAgreement)
 	fc.Result = res
-	return ec.marshalNProposalType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalType(ctx, field.Selections, res)
+	return ec.marshalNReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_suspendReciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ProposalType does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+			case "partnerClubId":
+				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+			case "terms":
+				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+			case "expirationDate":
+				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_suspendReciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_status(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_status(ctx, field)
+func (ec *executionContext) _Mutation_recordVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_recordVisit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9374,7 +10046,7 @@ func (ec *executionContext) _Proposal_status(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return ec.resolvers.Mutation().RecordVisit(rctx, fc.Args["input"].(model.RecordVisitInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9386,26 +10058,63 @@ func (ec *executionContext) _Proposal_status(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ProposalStatus)
+	res := resTmp.(*model.Visit)
 	fc.Result = res
-	return ec.marshalNProposalStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalStatus(ctx, field.Selections, res)
+	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_recordVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ProposalStatus does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Visit_id(ctx, field)
+			case "memberId":
+				return ec.fieldContext_Visit_memberId(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Visit_clubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_Visit_visitingClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_Visit_status(ctx, field)
+			case "checkInTime":
+				return ec.fieldContext_Visit_checkInTime(ctx, field)
+			case "checkOutTime":
+				return ec.fieldContext_Visit_checkOutTime(ctx, field)
+			case "services":
+				return ec.fieldContext_Visit_services(ctx, field)
+			case "cost":
+				return ec.fieldContext_Visit_cost(ctx, field)
+			case "verified":
+				return ec.fieldContext_Visit_verified(ctx, field)
+			case "blockchainTxId":
+				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Visit_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_proposer(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_proposer(ctx, field)
+func (ec *executionContext) _Mutation_checkOutVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_checkOutVisit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9418,7 +10127,7 @@ func (ec *executionContext) _Proposal_proposer(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Proposer, nil
+		return ec.resolvers.Mutation().CheckOutVisit(rctx, fc.Args["input"].(model.CheckOutVisitInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9430,50 +10139,63 @@ func (ec *executionContext) _Proposal_proposer(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*auth.User)
+	res := resTmp.(*model.Visit)
 	fc.Result = res
-	return ec.marshalNUser2ᚖreciprocalᚑclubsᚑbackendᚋpkgᚋsharedᚋauthᚐUser(ctx, field.Selections, res)
+	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_proposer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_checkOutVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_User_id(ctx, field)
+				return ec.fieldContext_Visit_id(ctx, field)
+			case "memberId":
+				return ec.fieldContext_Visit_memberId(ctx, field)
 			case "clubId":
-				return ec.fieldContext_User_clubId(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
+				return ec.fieldContext_Visit_clubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_Visit_visitingClubId(ctx, field)
 			case "status":
-				return ec.fieldContext_User_status(ctx, field)
-			case "roles":
-				return ec.fieldContext_User_roles(ctx, field)
-			case "permissions":
-				return ec.fieldContext_User_permissions(ctx, field)
+				return ec.fieldContext_Visit_status(ctx, field)
+			case "checkInTime":
+				return ec.fieldContext_Visit_checkInTime(ctx, field)
+			case "checkOutTime":
+				return ec.fieldContext_Visit_checkOutTime(ctx, field)
+			case "services":
+				return ec.fieldContext_Visit_services(ctx, field)
+			case "cost":
+				return ec.fieldContext_Visit_cost(ctx, field)
+			case "verified":
+				return ec.fieldContext_Visit_verified(ctx, field)
+			case "blockchainTxId":
+				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
+				return ec.fieldContext_Visit_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_checkOutVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_votes(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_votes(ctx, field)
+func (ec *executionContext) _Mutation_cancelVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_cancelVisit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9486,7 +10208,7 @@ func (ec *executionContext) _Proposal_votes(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Votes, nil
+		return ec.resolvers.Mutation().CancelVisit(rctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9498,40 +10220,63 @@ func (ec *executionContext) _Proposal_votes(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Vote)
+	res := resTmp.(*model.Visit)
 	fc.Result = res
-	return ec.marshalNVote2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVoteᚄ(ctx, field.Selections, res)
+	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_votes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_cancelVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Vote_id(ctx, field)
-			case "proposalId":
-				return ec.fieldContext_Vote_proposalId(ctx, field)
-			case "voter":
-				return ec.fieldContext_Vote_voter(ctx, field)
-			case "choice":
-				return ec.fieldContext_Vote_choice(ctx, field)
-			case "comment":
-				return ec.fieldContext_Vote_comment(ctx, field)
+				return ec.fieldContext_Visit_id(ctx, field)
+			case "memberId":
+				return ec.fieldContext_Visit_memberId(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Visit_clubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_Visit_visitingClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_Visit_status(ctx, field)
+			case "checkInTime":
+				return ec.fieldContext_Visit_checkInTime(ctx, field)
+			case "checkOutTime":
+				return ec.fieldContext_Visit_checkOutTime(ctx, field)
+			case "services":
+				return ec.fieldContext_Visit_services(ctx, field)
+			case "cost":
+				return ec.fieldContext_Visit_cost(ctx, field)
+			case "verified":
+				return ec.fieldContext_Visit_verified(ctx, field)
+			case "blockchainTxId":
+				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Vote_createdAt(ctx, field)
+				return ec.fieldContext_Visit_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Proposal_votingDeadline(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_votingDeadline(ctx, field)
+func (ec *executionContext) _Mutation_verifyVisit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_verifyVisit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9544,7 +10289,7 @@ func (ec *executionContext) _Proposal_votingDeadline(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.VotingDeadline, nil
+		return ec.resolvers.Mutation().VerifyVisit(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9556,70 +10301,63 @@ func (ec *executionContext) _Proposal_votingDeadline(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.Visit)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Proposal_votingDeadline(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_verifyVisit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Proposal",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Proposal_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Proposal_createdAt(ctx, field)
-	if err != nil {
-		return graphql.Null
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Visit_id(ctx, field)
+			case "memberId":
+				return ec.fieldContext_Visit_memberId(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Visit_clubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_Visit_visitingClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_Visit_status(ctx, field)
+			case "checkInTime":
+				return ec.fieldContext_Visit_checkInTime(ctx, field)
+			case "checkOutTime":
+				return ec.fieldContext_Visit_checkOutTime(ctx, field)
+			case "services":
+				return ec.fieldContext_Visit_services(ctx, field)
+			case "cost":
+				return ec.fieldContext_Visit_cost(ctx, field)
+			case "verified":
+				return ec.fieldContext_Visit_verified(ctx, field)
+			case "blockchainTxId":
+				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Visit_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+		},
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_verifyVisit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Proposal_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Proposal",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProposalConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.ProposalConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProposalConnection_nodes(ctx, field)
+func (ec *executionContext) _Mutation_createNotification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createNotification(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9632,7 +10370,7 @@ func (ec *executionContext) _ProposalConnection_nodes(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nodes, nil
+		return ec.resolvers.Mutation().CreateNotification(rctx, fc.Args["input"].(model.CreateNotificationInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9644,46 +10382,61 @@ func (ec *executionContext) _ProposalConnection_nodes(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Proposal)
+	res := resTmp.(*model.Notification)
 	fc.Result = res
-	return ec.marshalNProposal2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalᚄ(ctx, field.Selections, res)
+	return ec.marshalNNotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProposalConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createNotification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProposalConnection",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Proposal_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Proposal_title(ctx, field)
-			case "description":
-				return ec.fieldContext_Proposal_description(ctx, field)
+				return ec.fieldContext_Notification_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Notification_userId(ctx, field)
 			case "type":
-				return ec.fieldContext_Proposal_type(ctx, field)
+				return ec.fieldContext_Notification_type(ctx, field)
+			case "title":
+				return ec.fieldContext_Notification_title(ctx, field)
+			case "message":
+				return ec.fieldContext_Notification_message(ctx, field)
 			case "status":
-				return ec.fieldContext_Proposal_status(ctx, field)
-			case "proposer":
-				return ec.fieldContext_Proposal_proposer(ctx, field)
-			case "votes":
-				return ec.fieldContext_Proposal_votes(ctx, field)
-			case "votingDeadline":
-				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
+				return ec.fieldContext_Notification_status(ctx, field)
+			case "channels":
+				return ec.fieldContext_Notification_channels(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_Notification_scheduledAt(ctx, field)
+			case "sentAt":
+				return ec.fieldContext_Notification_sentAt(ctx, field)
+			case "readAt":
+				return ec.fieldContext_Notification_readAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Proposal_createdAt(ctx, field)
+				return ec.fieldContext_Notification_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createNotification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProposalConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ProposalConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProposalConnection_pageInfo(ctx, field)
+func (ec *executionContext) _Mutation_markNotificationRead(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_markNotificationRead(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9696,7 +10449,7 @@ func (ec *executionContext) _ProposalConnection_pageInfo(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return ec.resolvers.Mutation().MarkNotificationRead(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9708,40 +10461,61 @@ func (ec *executionContext) _ProposalConnection_pageInfo(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(*model.Notification)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNNotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProposalConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_markNotificationRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProposalConnection",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "pageSize":
-				return ec.fieldContext_PageInfo_pageSize(ctx, field)
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfo_totalPages(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPrevPage":
-				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
+			case "id":
+				return ec.fieldContext_Notification_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Notification_userId(ctx, field)
+			case "type":
+				return ec.fieldContext_Notification_type(ctx, field)
+			case "title":
+				return ec.fieldContext_Notification_title(ctx, field)
+			case "message":
+				return ec.fieldContext_Notification_message(ctx, field)
+			case "status":
+				return ec.fieldContext_Notification_status(ctx, field)
+			case "channels":
+				return ec.fieldContext_Notification_channels(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_Notification_scheduledAt(ctx, field)
+			case "sentAt":
+				return ec.fieldContext_Notification_sentAt(ctx, field)
+			case "readAt":
+				return ec.fieldContext_Notification_readAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Notification_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_markNotificationRead_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_me(ctx, field)
+func (ec *executionContext) _Mutation_markAllNotificationsRead(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_markAllNotificationsRead(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9754,59 +10528,38 @@ func (ec *executionContext) _Query_me(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Me(rctx)
+		return ec.resolvers.Mutation().MarkAllNotificationsRead(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*auth.User)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOUser2ᚖreciprocalᚑclubsᚑbackendᚋpkgᚋsharedᚋauthᚐUser(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_me(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_markAllNotificationsRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_User_clubId(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "status":
-				return ec.fieldContext_User_status(ctx, field)
-			case "roles":
-				return ec.fieldContext_User_roles(ctx, field)
-			case "permissions":
-				return ec.fieldContext_User_permissions(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_members(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_members(ctx, field)
+func (ec *executionContext) _Mutation_createProposal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createProposal(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9819,7 +10572,7 @@ func (ec *executionContext) _Query_members(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Members(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.MemberStatus))
+		return ec.resolvers.Mutation().CreateProposal(rctx, fc.Args["input"].(model.CreateProposalInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9831,25 +10584,39 @@ func (ec *executionContext) _Query_members(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.MemberConnection)
+	res := resTmp.(*model.Proposal)
 	fc.Result = res
-	return ec.marshalNMemberConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberConnection(ctx, field.Selections, res)
+	return ec.marshalNProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_members(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createProposal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "nodes":
-				return ec.fieldContext_MemberConnection_nodes(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_MemberConnection_pageInfo(ctx, field)
+			case "id":
+				return ec.fieldContext_Proposal_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Proposal_title(ctx, field)
+			case "description":
+				return ec.fieldContext_Proposal_description(ctx, field)
+			case "type":
+				return ec.fieldContext_Proposal_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Proposal_status(ctx, field)
+			case "proposer":
+				return ec.fieldContext_Proposal_proposer(ctx, field)
+			case "votes":
+				return ec.fieldContext_Proposal_votes(ctx, field)
+			case "votingDeadline":
+				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Proposal_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type MemberConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
 		},
 	}
 	defer func() {
@@ -9859,15 +10626,15 @@ func (ec *executionContext) fieldContext_Query_members(ctx context.Context, fiel
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_members_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createProposal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_member(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_member(ctx, field)
+func (ec *executionContext) _Mutation_castVote(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_castVote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9880,52 +10647,45 @@ func (ec *executionContext) _Query_member(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Member(rctx, fc.Args["id"].(string))
+		return ec.resolvers.Mutation().CastVote(rctx, fc.Args["input"].(model.CastVoteInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Member)
+	res := resTmp.(*model.Vote)
 	fc.Result = res
-	return ec.marshalOMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+	return ec.marshalNVote2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVote(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_member(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_castVote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Member_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Member_clubId(ctx, field)
-			case "userId":
-				return ec.fieldContext_Member_userId(ctx, field)
-			case "memberNumber":
-				return ec.fieldContext_Member_memberNumber(ctx, field)
-			case "membershipType":
-				return ec.fieldContext_Member_membershipType(ctx, field)
-			case "status":
-				return ec.fieldContext_Member_status(ctx, field)
-			case "blockchainIdentity":
-				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
-			case "profile":
-				return ec.fieldContext_Member_profile(ctx, field)
-			case "joinedAt":
-				return ec.fieldContext_Member_joinedAt(ctx, field)
+				return ec.fieldContext_Vote_id(ctx, field)
+			case "proposalId":
+				return ec.fieldContext_Vote_proposalId(ctx, field)
+			case "voter":
+				return ec.fieldContext_Vote_voter(ctx, field)
+			case "choice":
+				return ec.fieldContext_Vote_choice(ctx, field)
+			case "comment":
+				return ec.fieldContext_Vote_comment(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Member_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Member_updatedAt(ctx, field)
+				return ec.fieldContext_Vote_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
 		},
 	}
 	defer func() {
@@ -9935,15 +10695,15 @@ func (ec *executionContext) fieldContext_Query_member(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_member_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_castVote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_memberByNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_memberByNumber(ctx, field)
+func (ec *executionContext) _Mutation_finalizeProposal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_finalizeProposal(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9956,52 +10716,51 @@ func (ec *executionContext) _Query_memberByNumber(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MemberByNumber(rctx, fc.Args["memberNumber"].(string))
+		return ec.resolvers.Mutation().FinalizeProposal(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Member)
+	res := resTmp.(*model.Proposal)
 	fc.Result = res
-	return ec.marshalOMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+	return ec.marshalNProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_memberByNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_finalizeProposal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Member_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Member_clubId(ctx, field)
-			case "userId":
-				return ec.fieldContext_Member_userId(ctx, field)
-			case "memberNumber":
-				return ec.fieldContext_Member_memberNumber(ctx, field)
-			case "membershipType":
-				return ec.fieldContext_Member_membershipType(ctx, field)
+				return ec.fieldContext_Proposal_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Proposal_title(ctx, field)
+			case "description":
+				return ec.fieldContext_Proposal_description(ctx, field)
+			case "type":
+				return ec.fieldContext_Proposal_type(ctx, field)
 			case "status":
-				return ec.fieldContext_Member_status(ctx, field)
-			case "blockchainIdentity":
-				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
-			case "profile":
-				return ec.fieldContext_Member_profile(ctx, field)
-			case "joinedAt":
-				return ec.fieldContext_Member_joinedAt(ctx, field)
+				return ec.fieldContext_Proposal_status(ctx, field)
+			case "proposer":
+				return ec.fieldContext_Proposal_proposer(ctx, field)
+			case "votes":
+				return ec.fieldContext_Proposal_votes(ctx, field)
+			case "votingDeadline":
+				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Member_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Member_updatedAt(ctx, field)
+				return ec.fieldContext_Proposal_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
 		},
 	}
 	defer func() {
@@ -10011,15 +10770,15 @@ func (ec *executionContext) fieldContext_Query_memberByNumber(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_memberByNumber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_finalizeProposal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_clubs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_clubs(ctx, field)
+func (ec *executionContext) _Mutation_syncBlockchainData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_syncBlockchainData(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10032,7 +10791,7 @@ func (ec *executionContext) _Query_clubs(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Clubs(rctx)
+		return ec.resolvers.Mutation().SyncBlockchainData(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10044,46 +10803,26 @@ func (ec *executionContext) _Query_clubs(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Club)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNClub2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_clubs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_syncBlockchainData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Club_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Club_name(ctx, field)
-			case "description":
-				return ec.fieldContext_Club_description(ctx, field)
-			case "location":
-				return ec.fieldContext_Club_location(ctx, field)
-			case "website":
-				return ec.fieldContext_Club_website(ctx, field)
-			case "status":
-				return ec.fieldContext_Club_status(ctx, field)
-			case "settings":
-				return ec.fieldContext_Club_settings(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Club_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Club_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_club(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_club(ctx, field)
+func (ec *executionContext) _Mutation_generateAnalyticsReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_generateAnalyticsReport(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10096,48 +10835,31 @@ func (ec *executionContext) _Query_club(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Club(rctx, fc.Args["id"].(string))
+		return ec.resolvers.Mutation().GenerateAnalyticsReport(rctx, fc.Args["startDate"].(time.Time), fc.Args["endDate"].(time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Club)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOClub2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClub(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_club(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_generateAnalyticsReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Club_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Club_name(ctx, field)
-			case "description":
-				return ec.fieldContext_Club_description(ctx, field)
-			case "location":
-				return ec.fieldContext_Club_location(ctx, field)
-			case "website":
-				return ec.fieldContext_Club_website(ctx, field)
-			case "status":
-				return ec.fieldContext_Club_status(ctx, field)
-			case "settings":
-				return ec.fieldContext_Club_settings(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Club_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Club_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	defer func() {
@@ -10147,15 +10869,15 @@ func (ec *executionContext) fieldContext_Query_club(ctx context.Context, field g
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_club_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_generateAnalyticsReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_myClub(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_myClub(ctx, field)
+func (ec *executionContext) _NetworkAnalytics_outcomes(ctx context.Context, field graphql.CollectedField, obj *model.NetworkAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NetworkAnalytics_outcomes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10168,55 +10890,54 @@ func (ec *executionContext) _Query_myClub(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MyClub(rctx)
+		return obj.Outcomes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Club)
+	res := resTmp.(*model.VisitOutcomes)
 	fc.Result = res
-	return ec.marshalOClub2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClub(ctx, field.Selections, res)
+	return ec.marshalNVisitOutcomes2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitOutcomes(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_myClub(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NetworkAnalytics_outcomes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "NetworkAnalytics",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Club_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Club_name(ctx, field)
-			case "description":
-				return ec.fieldContext_Club_description(ctx, field)
-			case "location":
-				return ec.fieldContext_Club_location(ctx, field)
-			case "website":
-				return ec.fieldContext_Club_website(ctx, field)
-			case "status":
-				return ec.fieldContext_Club_status(ctx, field)
-			case "settings":
-				return ec.fieldContext_Club_settings(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Club_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Club_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
+			case "visits":
+				return ec.fieldContext_VisitOutcomes_visits(ctx, field)
+			case "open":
+				return ec.fieldContext_VisitOutcomes_open(ctx, field)
+			case "attended":
+				return ec.fieldContext_VisitOutcomes_attended(ctx, field)
+			case "cancelled":
+				return ec.fieldContext_VisitOutcomes_cancelled(ctx, field)
+			case "noShows":
+				return ec.fieldContext_VisitOutcomes_noShows(ctx, field)
+			case "cancellationRate":
+				return ec.fieldContext_VisitOutcomes_cancellationRate(ctx, field)
+			case "noShowRate":
+				return ec.fieldContext_VisitOutcomes_noShowRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitOutcomes", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_reciprocalAgreements(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_reciprocalAgreements(ctx, field)
+func (ec *executionContext) _NetworkAnalytics_flows(ctx context.Context, field graphql.CollectedField, obj *model.NetworkAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NetworkAnalytics_flows(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10229,7 +10950,7 @@ func (ec *executionContext) _Query_reciprocalAgreements(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ReciprocalAgreements(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.AgreementStatus))
+		return obj.Flows, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10241,43 +10962,38 @@ func (ec *executionContext) _Query_reciprocalAgreements(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ReciprocalAgreementConnection)
+	res := resTmp.([]*model.ClubFlow)
 	fc.Result = res
-	return ec.marshalNReciprocalAgreementConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreementConnection(ctx, field.Selections, res)
+	return ec.marshalNClubFlow2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubFlowᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_reciprocalAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NetworkAnalytics_flows(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "NetworkAnalytics",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "nodes":
-				return ec.fieldContext_ReciprocalAgreementConnection_nodes(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_ReciprocalAgreementConnection_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreementConnection", field.Name)
+			case "homeClubId":
+				return ec.fieldContext_ClubFlow_homeClubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_ClubFlow_visitingClubId(ctx, field)
+			case "visits":
+				return ec.fieldContext_ClubFlow_visits(ctx, field)
+			case "members":
+				return ec.fieldContext_ClubFlow_members(ctx, field)
+			case "requested":
+				return ec.fieldContext_ClubFlow_requested(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClubFlow", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_reciprocalAgreements_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_reciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_reciprocalAgreement(ctx, field)
+func (ec *executionContext) _NetworkAnalytics_agreements(ctx context.Context, field graphql.CollectedField, obj *model.NetworkAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NetworkAnalytics_agreements(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10290,66 +11006,62 @@ func (ec *executionContext) _Query_reciprocalAgreement(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ReciprocalAgreement(rctx, fc.Args["id"].(string))
+		return obj.Agreements, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ReciprocalAgreement)
+	res := resTmp.([]*model.AgreementNetworkStats)
 	fc.Result = res
-	return ec.marshalOReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
+	return ec.marshalNAgreementNetworkStats2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementNetworkStatsᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_reciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NetworkAnalytics_agreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "NetworkAnalytics",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
-			case "partnerClubId":
-				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+			case "agreementId":
+				return ec.fieldContext_AgreementNetworkStats_agreementId(ctx, field)
+			case "proposingClubId":
+				return ec.fieldContext_AgreementNetworkStats_proposingClubId(ctx, field)
+			case "targetClubId":
+				return ec.fieldContext_AgreementNetworkStats_targetClubId(ctx, field)
 			case "status":
-				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
-			case "terms":
-				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
-			case "effectiveDate":
-				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
-			case "expirationDate":
-				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+				return ec.fieldContext_AgreementNetworkStats_status(ctx, field)
+			case "outbound":
+				return ec.fieldContext_AgreementNetworkStats_outbound(ctx, field)
+			case "inbound":
+				return ec.fieldContext_AgreementNetworkStats_inbound(ctx, field)
+			case "balance":
+				return ec.fieldContext_AgreementNetworkStats_balance(ctx, field)
+			case "maxVisitsPerMonth":
+				return ec.fieldContext_AgreementNetworkStats_maxVisitsPerMonth(ctx, field)
+			case "utilization":
+				return ec.fieldContext_AgreementNetworkStats_utilization(ctx, field)
+			case "outcomes":
+				return ec.fieldContext_AgreementNetworkStats_outcomes(ctx, field)
+			case "trend":
+				return ec.fieldContext_AgreementNetworkStats_trend(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgreementNetworkStats", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_reciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_visits(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_visits(ctx, field)
+func (ec *executionContext) _NetworkAnalytics_clubs(ctx context.Context, field graphql.CollectedField, obj *model.NetworkAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NetworkAnalytics_clubs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10362,7 +11074,7 @@ func (ec *executionContext) _Query_visits(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Visits(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.VisitStatus))
+		return obj.Clubs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10374,43 +11086,42 @@ func (ec *executionContext) _Query_visits(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.VisitConnection)
+	res := resTmp.([]*model.ClubNetworkStats)
 	fc.Result = res
-	return ec.marshalNVisitConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitConnection(ctx, field.Selections, res)
+	return ec.marshalNClubNetworkStats2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubNetworkStatsᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_visits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NetworkAnalytics_clubs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "NetworkAnalytics",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "nodes":
-				return ec.fieldContext_VisitConnection_nodes(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_VisitConnection_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VisitConnection", field.Name)
+			case "clubId":
+				return ec.fieldContext_ClubNetworkStats_clubId(ctx, field)
+			case "hosted":
+				return ec.fieldContext_ClubNetworkStats_hosted(ctx, field)
+			case "visitsMade":
+				return ec.fieldContext_ClubNetworkStats_visitsMade(ctx, field)
+			case "partners":
+				return ec.fieldContext_ClubNetworkStats_partners(ctx, field)
+			case "hostRating":
+				return ec.fieldContext_ClubNetworkStats_hostRating(ctx, field)
+			case "guestRating":
+				return ec.fieldContext_ClubNetworkStats_guestRating(ctx, field)
+			case "facilities":
+				return ec.fieldContext_ClubNetworkStats_facilities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClubNetworkStats", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_visits_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_visit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_visit(ctx, field)
+func (ec *executionContext) _Notification_id(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10423,72 +11134,38 @@ func (ec *executionContext) _Query_visit(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Visit(rctx, fc.Args["id"].(string))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Visit)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_visit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Visit_id(ctx, field)
-			case "memberId":
-				return ec.fieldContext_Visit_memberId(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Visit_clubId(ctx, field)
-			case "visitingClubId":
-				return ec.fieldContext_Visit_visitingClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_Visit_status(ctx, field)
-			case "checkInTime":
-				return ec.fieldContext_Visit_checkInTime(ctx, field)
-			case "checkOutTime":
-				return ec.fieldContext_Visit_checkOutTime(ctx, field)
-			case "services":
-				return ec.fieldContext_Visit_services(ctx, field)
-			case "cost":
-				return ec.fieldContext_Visit_cost(ctx, field)
-			case "verified":
-				return ec.fieldContext_Visit_verified(ctx, field)
-			case "blockchainTxId":
-				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Visit_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_visit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_myVisits(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_myVisits(ctx, field)
+func (ec *executionContext) _Notification_userId(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_userId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10501,7 +11178,7 @@ func (ec *executionContext) _Query_myVisits(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MyVisits(rctx, fc.Args["pagination"].(*model.PaginationInput))
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10513,43 +11190,26 @@ func (ec *executionContext) _Query_myVisits(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.VisitConnection)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNVisitConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitConnection(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_myVisits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "nodes":
-				return ec.fieldContext_VisitConnection_nodes(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_VisitConnection_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VisitConnection", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_myVisits_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_notifications(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_notifications(ctx, field)
+func (ec *executionContext) _Notification_type(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10562,7 +11222,7 @@ func (ec *executionContext) _Query_notifications(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Notifications(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["unreadOnly"].(*bool))
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10574,43 +11234,26 @@ func (ec *executionContext) _Query_notifications(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.NotificationConnection)
+	res := resTmp.(model.NotificationType)
 	fc.Result = res
-	return ec.marshalNNotificationConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationConnection(ctx, field.Selections, res)
+	return ec.marshalNNotificationType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_notifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "nodes":
-				return ec.fieldContext_NotificationConnection_nodes(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_NotificationConnection_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type NotificationConnection", field.Name)
+			return nil, errors.New("field of type NotificationType does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_notifications_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_notification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_notification(ctx, field)
+func (ec *executionContext) _Notification_title(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10623,70 +11266,38 @@ func (ec *executionContext) _Query_notification(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Notification(rctx, fc.Args["id"].(string))
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Notification)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalONotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_notification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Notification_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Notification_userId(ctx, field)
-			case "type":
-				return ec.fieldContext_Notification_type(ctx, field)
-			case "title":
-				return ec.fieldContext_Notification_title(ctx, field)
-			case "message":
-				return ec.fieldContext_Notification_message(ctx, field)
-			case "status":
-				return ec.fieldContext_Notification_status(ctx, field)
-			case "channels":
-				return ec.fieldContext_Notification_channels(ctx, field)
-			case "scheduledAt":
-				return ec.fieldContext_Notification_scheduledAt(ctx, field)
-			case "sentAt":
-				return ec.fieldContext_Notification_sentAt(ctx, field)
-			case "readAt":
-				return ec.fieldContext_Notification_readAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Notification_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_notification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_unreadNotificationCount(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_unreadNotificationCount(ctx, field)
+func (ec *executionContext) _Notification_message(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10699,7 +11310,7 @@ func (ec *executionContext) _Query_unreadNotificationCount(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().UnreadNotificationCount(rctx)
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10711,26 +11322,26 @@ func (ec *executionContext) _Query_unreadNotificationCount(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_unreadNotificationCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_analytics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_analytics(ctx, field)
+func (ec *executionContext) _Notification_status(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10743,7 +11354,7 @@ func (ec *executionContext) _Query_analytics(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Analytics(rctx, fc.Args["startDate"].(*time.Time), fc.Args["endDate"].(*time.Time))
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10755,45 +11366,26 @@ func (ec *executionContext) _Query_analytics(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Analytics)
+	res := resTmp.(model.NotificationStatus)
 	fc.Result = res
-	return ec.marshalNAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAnalytics(ctx, field.Selections, res)
+	return ec.marshalNNotificationStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_analytics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "visits":
-				return ec.fieldContext_Analytics_visits(ctx, field)
-			case "members":
-				return ec.fieldContext_Analytics_members(ctx, field)
-			case "reciprocals":
-				return ec.fieldContext_Analytics_reciprocals(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Analytics", field.Name)
+			return nil, errors.New("field of type NotificationStatus does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_analytics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_proposals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_proposals(ctx, field)
+func (ec *executionContext) _Notification_channels(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_channels(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10806,7 +11398,7 @@ func (ec *executionContext) _Query_proposals(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Proposals(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.ProposalStatus))
+		return obj.Channels, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10818,43 +11410,26 @@ func (ec *executionContext) _Query_proposals(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ProposalConnection)
+	res := resTmp.([]model.NotificationChannel)
 	fc.Result = res
-	return ec.marshalNProposalConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalConnection(ctx, field.Selections, res)
+	return ec.marshalNNotificationChannel2ᚕreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationChannelᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_proposals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_channels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "nodes":
-				return ec.fieldContext_ProposalConnection_nodes(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_ProposalConnection_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ProposalConnection", field.Name)
+			return nil, errors.New("field of type NotificationChannel does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_proposals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_proposal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_proposal(ctx, field)
+func (ec *executionContext) _Notification_scheduledAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_scheduledAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10867,7 +11442,7 @@ func (ec *executionContext) _Query_proposal(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Proposal(rctx, fc.Args["id"].(string))
+		return obj.ScheduledAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10876,57 +11451,26 @@ func (ec *executionContext) _Query_proposal(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Proposal)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_proposal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_scheduledAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Proposal_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Proposal_title(ctx, field)
-			case "description":
-				return ec.fieldContext_Proposal_description(ctx, field)
-			case "type":
-				return ec.fieldContext_Proposal_type(ctx, field)
-			case "status":
-				return ec.fieldContext_Proposal_status(ctx, field)
-			case "proposer":
-				return ec.fieldContext_Proposal_proposer(ctx, field)
-			case "votes":
-				return ec.fieldContext_Proposal_votes(ctx, field)
-			case "votingDeadline":
-				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Proposal_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_proposal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_myVotes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_myVotes(ctx, field)
+func (ec *executionContext) _Notification_sentAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_sentAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10939,63 +11483,76 @@ func (ec *executionContext) _Query_myVotes(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MyVotes(rctx, fc.Args["proposalId"].(*string))
+		return obj.SentAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Vote)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNVote2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVoteᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_myVotes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_sentAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Vote_id(ctx, field)
-			case "proposalId":
-				return ec.fieldContext_Vote_proposalId(ctx, field)
-			case "voter":
-				return ec.fieldContext_Vote_voter(ctx, field)
-			case "choice":
-				return ec.fieldContext_Vote_choice(ctx, field)
-			case "comment":
-				return ec.fieldContext_Vote_comment(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Vote_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Notification_readAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_readAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_myVotes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ReadAt, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Notification_readAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Notification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_transactions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_transactions(ctx, field)
+func (ec *executionContext) _Notification_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Notification) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notification_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11008,7 +11565,7 @@ func (ec *executionContext) _Query_transactions(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Transactions(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.TransactionStatus))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11020,61 +11577,26 @@ func (ec *executionContext) _Query_transactions(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Notification_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Notification",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Transaction_id(ctx, field)
-			case "type":
-				return ec.fieldContext_Transaction_type(ctx, field)
-			case "chaincode":
-				return ec.fieldContext_Transaction_chaincode(ctx, field)
-			case "function":
-				return ec.fieldContext_Transaction_function(ctx, field)
-			case "args":
-				return ec.fieldContext_Transaction_args(ctx, field)
-			case "status":
-				return ec.fieldContext_Transaction_status(ctx, field)
-			case "txId":
-				return ec.fieldContext_Transaction_txId(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_Transaction_blockNumber(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Transaction_timestamp(ctx, field)
-			case "error":
-				return ec.fieldContext_Transaction_error(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Transaction_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_transactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_transaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_transaction(ctx, field)
+func (ec *executionContext) _NotificationConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.NotificationConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NotificationConnection_nodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11087,70 +11609,62 @@ func (ec *executionContext) _Query_transaction(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Transaction(rctx, fc.Args["id"].(string))
+		return obj.Nodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Transaction)
+	res := resTmp.([]*model.Notification)
 	fc.Result = res
-	return ec.marshalOTransaction2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+	return ec.marshalNNotification2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_transaction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NotificationConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "NotificationConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Transaction_id(ctx, field)
+				return ec.fieldContext_Notification_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Notification_userId(ctx, field)
 			case "type":
-				return ec.fieldContext_Transaction_type(ctx, field)
-			case "chaincode":
-				return ec.fieldContext_Transaction_chaincode(ctx, field)
-			case "function":
-				return ec.fieldContext_Transaction_function(ctx, field)
-			case "args":
-				return ec.fieldContext_Transaction_args(ctx, field)
+				return ec.fieldContext_Notification_type(ctx, field)
+			case "title":
+				return ec.fieldContext_Notification_title(ctx, field)
+			case "message":
+				return ec.fieldContext_Notification_message(ctx, field)
 			case "status":
-				return ec.fieldContext_Transaction_status(ctx, field)
-			case "txId":
-				return ec.fieldContext_Transaction_txId(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_Transaction_blockNumber(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Transaction_timestamp(ctx, field)
-			case "error":
-				return ec.fieldContext_Transaction_error(ctx, field)
+				return ec.fieldContext_Notification_status(ctx, field)
+			case "channels":
+				return ec.fieldContext_Notification_channels(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_Notification_scheduledAt(ctx, field)
+			case "sentAt":
+				return ec.fieldContext_Notification_sentAt(ctx, field)
+			case "readAt":
+				return ec.fieldContext_Notification_readAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Transaction_createdAt(ctx, field)
+				return ec.fieldContext_Notification_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_transaction_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _NotificationConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.NotificationConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NotificationConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11163,68 +11677,52 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NotificationConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "NotificationConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "pageSize":
+				return ec.fieldContext_PageInfo_pageSize(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPrevPage":
+				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11237,49 +11735,38 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_id(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+func (ec *executionContext) _PageInfo_pageSize(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_pageSize(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11292,7 +11779,7 @@ func (ec *executionContext) _ReciprocalAgreement_id(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.PageSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11304,26 +11791,26 @@ func (ec *executionContext) _ReciprocalAgreement_id(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_pageSize(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_clubId(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_total(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11336,7 +11823,7 @@ func (ec *executionContext) _ReciprocalAgreement_clubId(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClubID, nil
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11348,26 +11835,26 @@ func (ec *executionContext) _ReciprocalAgreement_clubId(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_partnerClubId(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+func (ec *executionContext) _PageInfo_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_totalPages(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11380,7 +11867,7 @@ func (ec *executionContext) _ReciprocalAgreement_partnerClubId(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PartnerClubID, nil
+		return obj.TotalPages, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11392,26 +11879,26 @@ func (ec *executionContext) _ReciprocalAgreement_partnerClubId(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_partnerClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_status(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11424,7 +11911,7 @@ func (ec *executionContext) _ReciprocalAgreement_status(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11436,26 +11923,26 @@ func (ec *executionContext) _ReciprocalAgreement_status(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.AgreementStatus)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNAgreementStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementStatus(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type AgreementStatus does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_terms(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+func (ec *executionContext) _PageInfo_hasPrevPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11468,7 +11955,7 @@ func (ec *executionContext) _ReciprocalAgreement_terms(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Terms, nil
+		return obj.HasPrevPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11480,36 +11967,26 @@ func (ec *executionContext) _ReciprocalAgreement_terms(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AgreementTerms)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNAgreementTerms2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTerms(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_terms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasPrevPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "maxVisitsPerMonth":
-				return ec.fieldContext_AgreementTerms_maxVisitsPerMonth(ctx, field)
-			case "reciprocalFee":
-				return ec.fieldContext_AgreementTerms_reciprocalFee(ctx, field)
-			case "blackoutDates":
-				return ec.fieldContext_AgreementTerms_blackoutDates(ctx, field)
-			case "specialConditions":
-				return ec.fieldContext_AgreementTerms_specialConditions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AgreementTerms", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+func (ec *executionContext) _Proposal_id(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11522,7 +11999,7 @@ func (ec *executionContext) _ReciprocalAgreement_effectiveDate(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EffectiveDate, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11534,26 +12011,26 @@ func (ec *executionContext) _ReciprocalAgreement_effectiveDate(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_expirationDate(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+func (ec *executionContext) _Proposal_title(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11566,35 +12043,38 @@ func (ec *executionContext) _ReciprocalAgreement_expirationDate(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpirationDate, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_expirationDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+func (ec *executionContext) _Proposal_description(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11607,7 +12087,7 @@ func (ec *executionContext) _ReciprocalAgreement_createdAt(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11619,26 +12099,26 @@ func (ec *executionContext) _ReciprocalAgreement_createdAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreement_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+func (ec *executionContext) _Proposal_type(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11651,7 +12131,7 @@ func (ec *executionContext) _ReciprocalAgreement_updatedAt(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11663,26 +12143,26 @@ func (ec *executionContext) _ReciprocalAgreement_updatedAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(model.ProposalType)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNProposalType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreement_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreement",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ProposalType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreementConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreementConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreementConnection_nodes(ctx, field)
+func (ec *executionContext) _Proposal_status(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11695,7 +12175,7 @@ func (ec *executionContext) _ReciprocalAgreementConnection_nodes(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nodes, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11707,46 +12187,26 @@ func (ec *executionContext) _ReciprocalAgreementConnection_nodes(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ReciprocalAgreement)
+	res := resTmp.(model.ProposalStatus)
 	fc.Result = res
-	return ec.marshalNReciprocalAgreement2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreementᚄ(ctx, field.Selections, res)
+	return ec.marshalNProposalStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreementConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreementConnection",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
-			case "clubId":
-				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
-			case "partnerClubId":
-				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
-			case "terms":
-				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
-			case "effectiveDate":
-				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
-			case "expirationDate":
-				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+			return nil, errors.New("field of type ProposalStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAgreementConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreementConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAgreementConnection_pageInfo(ctx, field)
+func (ec *executionContext) _Proposal_proposer(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_proposer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11759,7 +12219,7 @@ func (ec *executionContext) _ReciprocalAgreementConnection_pageInfo(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Proposer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11771,40 +12231,50 @@ func (ec *executionContext) _ReciprocalAgreementConnection_pageInfo(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(*auth.User)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖreciprocalᚑclubsᚑbackendᚋpkgᚋsharedᚋauthᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAgreementConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_proposer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAgreementConnection",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "pageSize":
-				return ec.fieldContext_PageInfo_pageSize(ctx, field)
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfo_totalPages(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPrevPage":
-				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_User_clubId(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "status":
+				return ec.fieldContext_User_status(ctx, field)
+			case "roles":
+				return ec.fieldContext_User_roles(ctx, field)
+			case "permissions":
+				return ec.fieldContext_User_permissions(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAnalytics_totalAgreements(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAnalytics_totalAgreements(ctx, field)
+func (ec *executionContext) _Proposal_votes(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_votes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11817,7 +12287,7 @@ func (ec *executionContext) _ReciprocalAnalytics_totalAgreements(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalAgreements, nil
+		return obj.Votes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11829,26 +12299,40 @@ func (ec *executionContext) _ReciprocalAnalytics_totalAgreements(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Vote)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNVote2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVoteᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAnalytics_totalAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_votes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAnalytics",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Vote_id(ctx, field)
+			case "proposalId":
+				return ec.fieldContext_Vote_proposalId(ctx, field)
+			case "voter":
+				return ec.fieldContext_Vote_voter(ctx, field)
+			case "choice":
+				return ec.fieldContext_Vote_choice(ctx, field)
+			case "comment":
+				return ec.fieldContext_Vote_comment(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Vote_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAnalytics_activeAgreements(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAnalytics_activeAgreements(ctx, field)
+func (ec *executionContext) _Proposal_votingDeadline(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_votingDeadline(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11861,7 +12345,7 @@ func (ec *executionContext) _ReciprocalAnalytics_activeAgreements(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActiveAgreements, nil
+		return obj.VotingDeadline, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11873,26 +12357,26 @@ func (ec *executionContext) _ReciprocalAnalytics_activeAgreements(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAnalytics_activeAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_votingDeadline(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAnalytics",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAnalytics_pendingAgreements(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAnalytics_pendingAgreements(ctx, field)
+func (ec *executionContext) _Proposal_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Proposal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Proposal_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11905,7 +12389,7 @@ func (ec *executionContext) _ReciprocalAnalytics_pendingAgreements(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PendingAgreements, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11917,26 +12401,26 @@ func (ec *executionContext) _ReciprocalAnalytics_pendingAgreements(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAnalytics_pendingAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Proposal_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAnalytics",
+		Object:     "Proposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReciprocalAnalytics_monthlyReciprocalUsage(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReciprocalAnalytics_monthlyReciprocalUsage(ctx, field)
+func (ec *executionContext) _ProposalConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.ProposalConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProposalConnection_nodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11949,7 +12433,7 @@ func (ec *executionContext) _ReciprocalAnalytics_monthlyReciprocalUsage(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MonthlyReciprocalUsage, nil
+		return obj.Nodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11961,191 +12445,232 @@ func (ec *executionContext) _ReciprocalAnalytics_monthlyReciprocalUsage(ctx cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MonthlyVisit)
+	res := resTmp.([]*model.Proposal)
 	fc.Result = res
-	return ec.marshalNMonthlyVisit2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMonthlyVisitᚄ(ctx, field.Selections, res)
+	return ec.marshalNProposal2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReciprocalAnalytics_monthlyReciprocalUsage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProposalConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReciprocalAnalytics",
+		Object:     "ProposalConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "month":
-				return ec.fieldContext_MonthlyVisit_month(ctx, field)
-			case "count":
-				return ec.fieldContext_MonthlyVisit_count(ctx, field)
+			case "id":
+				return ec.fieldContext_Proposal_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Proposal_title(ctx, field)
+			case "description":
+				return ec.fieldContext_Proposal_description(ctx, field)
+			case "type":
+				return ec.fieldContext_Proposal_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Proposal_status(ctx, field)
+			case "proposer":
+				return ec.fieldContext_Proposal_proposer(ctx, field)
+			case "votes":
+				return ec.fieldContext_Proposal_votes(ctx, field)
+			case "votingDeadline":
+				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Proposal_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type MonthlyVisit", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_notificationReceived(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_notificationReceived(ctx, field)
+func (ec *executionContext) _ProposalConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ProposalConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProposalConnection_pageInfo(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().NotificationReceived(rctx)
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
+		return graphql.Null
 	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Notification):
-			if !ok {
-				return nil
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProposalConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "pageSize":
+				return ec.fieldContext_PageInfo_pageSize(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPrevPage":
+				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
 			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNNotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_me(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Me(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
 	}
+	res := resTmp.(*auth.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖreciprocalᚑclubsᚑbackendᚋpkgᚋsharedᚋauthᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_notificationReceived(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_me(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Notification_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Notification_userId(ctx, field)
-			case "type":
-				return ec.fieldContext_Notification_type(ctx, field)
-			case "title":
-				return ec.fieldContext_Notification_title(ctx, field)
-			case "message":
-				return ec.fieldContext_Notification_message(ctx, field)
+				return ec.fieldContext_User_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_User_clubId(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
 			case "status":
-				return ec.fieldContext_Notification_status(ctx, field)
-			case "channels":
-				return ec.fieldContext_Notification_channels(ctx, field)
-			case "scheduledAt":
-				return ec.fieldContext_Notification_scheduledAt(ctx, field)
-			case "sentAt":
-				return ec.fieldContext_Notification_sentAt(ctx, field)
-			case "readAt":
-				return ec.fieldContext_Notification_readAt(ctx, field)
+				return ec.fieldContext_User_status(ctx, field)
+			case "roles":
+				return ec.fieldContext_User_roles(ctx, field)
+			case "permissions":
+				return ec.fieldContext_User_permissions(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Notification_createdAt(ctx, field)
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_visitStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_visitStatusChanged(ctx, field)
+func (ec *executionContext) _Query_mySessions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_mySessions(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().VisitStatusChanged(rctx, fc.Args["clubId"].(*string))
+		return ec.resolvers.Query().MySessions(rctx, fc.Args["currentSessionId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Visit):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.([]*model.Session)
+	fc.Result = res
+	return ec.marshalNSession2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSessionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_visitStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_mySessions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Visit_id(ctx, field)
-			case "memberId":
-				return ec.fieldContext_Visit_memberId(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Visit_clubId(ctx, field)
-			case "visitingClubId":
-				return ec.fieldContext_Visit_visitingClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_Visit_status(ctx, field)
-			case "checkInTime":
-				return ec.fieldContext_Visit_checkInTime(ctx, field)
-			case "checkOutTime":
-				return ec.fieldContext_Visit_checkOutTime(ctx, field)
-			case "services":
-				return ec.fieldContext_Visit_services(ctx, field)
-			case "cost":
-				return ec.fieldContext_Visit_cost(ctx, field)
-			case "verified":
-				return ec.fieldContext_Visit_verified(ctx, field)
-			case "blockchainTxId":
-				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
+				return ec.fieldContext_Session_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Session_userId(ctx, field)
+			case "userEmail":
+				return ec.fieldContext_Session_userEmail(ctx, field)
+			case "device":
+				return ec.fieldContext_Session_device(ctx, field)
+			case "ipAddress":
+				return ec.fieldContext_Session_ipAddress(ctx, field)
+			case "location":
+				return ec.fieldContext_Session_location(ctx, field)
+			case "authProvider":
+				return ec.fieldContext_Session_authProvider(ctx, field)
+			case "active":
+				return ec.fieldContext_Session_active(ctx, field)
+			case "current":
+				return ec.fieldContext_Session_current(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Visit_createdAt(ctx, field)
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActivityAt":
+				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Session_expiresAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
 	}
 	defer func() {
@@ -12155,86 +12680,58 @@ func (ec *executionContext) fieldContext_Subscription_visitStatusChanged(ctx con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_visitStatusChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_mySessions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_proposalUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_proposalUpdated(ctx, field)
+func (ec *executionContext) _Query_clubSessions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_clubSessions(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ProposalUpdated(rctx, fc.Args["proposalId"].(*string))
+		return ec.resolvers.Query().ClubSessions(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["userId"].(*string), fc.Args["search"].(*string), fc.Args["activeOnly"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Proposal):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(*model.SessionConnection)
+	fc.Result = res
+	return ec.marshalNSessionConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSessionConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_proposalUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_clubSessions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Proposal_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Proposal_title(ctx, field)
-			case "description":
-				return ec.fieldContext_Proposal_description(ctx, field)
-			case "type":
-				return ec.fieldContext_Proposal_type(ctx, field)
-			case "status":
-				return ec.fieldContext_Proposal_status(ctx, field)
-			case "proposer":
-				return ec.fieldContext_Proposal_proposer(ctx, field)
-			case "votes":
-				return ec.fieldContext_Proposal_votes(ctx, field)
-			case "votingDeadline":
-				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Proposal_createdAt(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SessionConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SessionConnection_pageInfo(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SessionConnection", field.Name)
 		},
 	}
 	defer func() {
@@ -12244,80 +12741,134 @@ func (ec *executionContext) fieldContext_Subscription_proposalUpdated(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_proposalUpdated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_clubSessions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_voteReceived(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_voteReceived(ctx, field)
+func (ec *executionContext) _Query_members(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_members(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().VoteReceived(rctx, fc.Args["proposalId"].(*string))
+		return ec.resolvers.Query().Members(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.MemberStatus))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
+		return graphql.Null
 	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Vote):
-			if !ok {
-				return nil
+	res := resTmp.(*model.MemberConnection)
+	fc.Result = res
+	return ec.marshalNMemberConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMemberConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_members(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nodes":
+				return ec.fieldContext_MemberConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_MemberConnection_pageInfo(ctx, field)
 			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNVote2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVote(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+			return nil, fmt.Errorf("no field named %q was found under type MemberConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_members_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) fieldContext_Subscription_voteReceived(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) _Query_member(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_member(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Member(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Member)
+	fc.Result = res
+	return ec.marshalOMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_member(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Vote_id(ctx, field)
-			case "proposalId":
-				return ec.fieldContext_Vote_proposalId(ctx, field)
-			case "voter":
-				return ec.fieldContext_Vote_voter(ctx, field)
-			case "choice":
-				return ec.fieldContext_Vote_choice(ctx, field)
-			case "comment":
-				return ec.fieldContext_Vote_comment(ctx, field)
+				return ec.fieldContext_Member_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Member_clubId(ctx, field)
+			case "userId":
+				return ec.fieldContext_Member_userId(ctx, field)
+			case "memberNumber":
+				return ec.fieldContext_Member_memberNumber(ctx, field)
+			case "membershipType":
+				return ec.fieldContext_Member_membershipType(ctx, field)
+			case "status":
+				return ec.fieldContext_Member_status(ctx, field)
+			case "blockchainIdentity":
+				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
+			case "profile":
+				return ec.fieldContext_Member_profile(ctx, field)
+			case "joinedAt":
+				return ec.fieldContext_Member_joinedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Vote_createdAt(ctx, field)
+				return ec.fieldContext_Member_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Member_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
 		},
 	}
 	defer func() {
@@ -12327,97 +12878,3528 @@ func (ec *executionContext) fieldContext_Subscription_voteReceived(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_voteReceived_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_member_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_transactionStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_transactionStatusChanged(ctx, field)
+func (ec *executionContext) _Query_memberByNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_memberByNumber(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().TransactionStatusChanged(rctx)
+		return ec.resolvers.Query().MemberByNumber(rctx, fc.Args["memberNumber"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
+		return graphql.Null
 	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Transaction):
-			if !ok {
-				return nil
+	res := resTmp.(*model.Member)
+	fc.Result = res
+	return ec.marshalOMember2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMember(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_memberByNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Member_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Member_clubId(ctx, field)
+			case "userId":
+				return ec.fieldContext_Member_userId(ctx, field)
+			case "memberNumber":
+				return ec.fieldContext_Member_memberNumber(ctx, field)
+			case "membershipType":
+				return ec.fieldContext_Member_membershipType(ctx, field)
+			case "status":
+				return ec.fieldContext_Member_status(ctx, field)
+			case "blockchainIdentity":
+				return ec.fieldContext_Member_blockchainIdentity(ctx, field)
+			case "profile":
+				return ec.fieldContext_Member_profile(ctx, field)
+			case "joinedAt":
+				return ec.fieldContext_Member_joinedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Member_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Member_updatedAt(ctx, field)
 			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNTransaction2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+			return nil, fmt.Errorf("no field named %q was found under type Member", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_memberByNumber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) fieldContext_Subscription_transactionStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+func (ec *executionContext) _Query_clubs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_clubs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Clubs(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Club)
+	fc.Result = res
+	return ec.marshalNClub2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_clubs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Club_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Club_name(ctx, field)
+			case "description":
+				return ec.fieldContext_Club_description(ctx, field)
+			case "location":
+				return ec.fieldContext_Club_location(ctx, field)
+			case "website":
+				return ec.fieldContext_Club_website(ctx, field)
+			case "status":
+				return ec.fieldContext_Club_status(ctx, field)
+			case "settings":
+				return ec.fieldContext_Club_settings(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Club_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Club_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_club(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_club(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Club(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Club)
+	fc.Result = res
+	return ec.marshalOClub2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClub(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_club(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Club_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Club_name(ctx, field)
+			case "description":
+				return ec.fieldContext_Club_description(ctx, field)
+			case "location":
+				return ec.fieldContext_Club_location(ctx, field)
+			case "website":
+				return ec.fieldContext_Club_website(ctx, field)
+			case "status":
+				return ec.fieldContext_Club_status(ctx, field)
+			case "settings":
+				return ec.fieldContext_Club_settings(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Club_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Club_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_club_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_myClub(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_myClub(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MyClub(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Club)
+	fc.Result = res
+	return ec.marshalOClub2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClub(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_myClub(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Club_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Club_name(ctx, field)
+			case "description":
+				return ec.fieldContext_Club_description(ctx, field)
+			case "location":
+				return ec.fieldContext_Club_location(ctx, field)
+			case "website":
+				return ec.fieldContext_Club_website(ctx, field)
+			case "status":
+				return ec.fieldContext_Club_status(ctx, field)
+			case "settings":
+				return ec.fieldContext_Club_settings(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Club_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Club_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Club", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_reciprocalAgreements(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_reciprocalAgreements(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ReciprocalAgreements(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.AgreementStatus))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ReciprocalAgreementConnection)
+	fc.Result = res
+	return ec.marshalNReciprocalAgreementConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreementConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_reciprocalAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nodes":
+				return ec.fieldContext_ReciprocalAgreementConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ReciprocalAgreementConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreementConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_reciprocalAgreements_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_reciprocalAgreement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_reciprocalAgreement(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ReciprocalAgreement(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ReciprocalAgreement)
+	fc.Result = res
+	return ec.marshalOReciprocalAgreement2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreement(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_reciprocalAgreement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+			case "partnerClubId":
+				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+			case "terms":
+				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+			case "expirationDate":
+				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_reciprocalAgreement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_visits(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_visits(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Visits(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.VisitStatus))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.VisitConnection)
+	fc.Result = res
+	return ec.marshalNVisitConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_visits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nodes":
+				return ec.fieldContext_VisitConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_VisitConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_visits_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_visit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_visit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Visit(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Visit)
+	fc.Result = res
+	return ec.marshalOVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_visit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Visit_id(ctx, field)
+			case "memberId":
+				return ec.fieldContext_Visit_memberId(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Visit_clubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_Visit_visitingClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_Visit_status(ctx, field)
+			case "checkInTime":
+				return ec.fieldContext_Visit_checkInTime(ctx, field)
+			case "checkOutTime":
+				return ec.fieldContext_Visit_checkOutTime(ctx, field)
+			case "services":
+				return ec.fieldContext_Visit_services(ctx, field)
+			case "cost":
+				return ec.fieldContext_Visit_cost(ctx, field)
+			case "verified":
+				return ec.fieldContext_Visit_verified(ctx, field)
+			case "blockchainTxId":
+				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Visit_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_visit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_myVisits(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_myVisits(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MyVisits(rctx, fc.Args["pagination"].(*model.PaginationInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.VisitConnection)
+	fc.Result = res
+	return ec.marshalNVisitConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_myVisits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nodes":
+				return ec.fieldContext_VisitConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_VisitConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VisitConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_myVisits_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_notifications(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_notifications(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Notifications(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["unreadOnly"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.NotificationConnection)
+	fc.Result = res
+	return ec.marshalNNotificationConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotificationConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_notifications(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nodes":
+				return ec.fieldContext_NotificationConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_NotificationConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NotificationConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_notifications_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_notification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_notification(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Notification(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Notification)
+	fc.Result = res
+	return ec.marshalONotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_notification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Notification_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Notification_userId(ctx, field)
+			case "type":
+				return ec.fieldContext_Notification_type(ctx, field)
+			case "title":
+				return ec.fieldContext_Notification_title(ctx, field)
+			case "message":
+				return ec.fieldContext_Notification_message(ctx, field)
+			case "status":
+				return ec.fieldContext_Notification_status(ctx, field)
+			case "channels":
+				return ec.fieldContext_Notification_channels(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_Notification_scheduledAt(ctx, field)
+			case "sentAt":
+				return ec.fieldContext_Notification_sentAt(ctx, field)
+			case "readAt":
+				return ec.fieldContext_Notification_readAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Notification_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_notification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_unreadNotificationCount(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_unreadNotificationCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().UnreadNotificationCount(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_unreadNotificationCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_analytics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_analytics(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Analytics(rctx, fc.Args["startDate"].(*time.Time), fc.Args["endDate"].(*time.Time))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Analytics)
+	fc.Result = res
+	return ec.marshalNAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAnalytics(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_analytics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "visits":
+				return ec.fieldContext_Analytics_visits(ctx, field)
+			case "members":
+				return ec.fieldContext_Analytics_members(ctx, field)
+			case "reciprocals":
+				return ec.fieldContext_Analytics_reciprocals(ctx, field)
+			case "network":
+				return ec.fieldContext_Analytics_network(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Analytics", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_analytics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_proposals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_proposals(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Proposals(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.ProposalStatus))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ProposalConnection)
+	fc.Result = res
+	return ec.marshalNProposalConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposalConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_proposals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nodes":
+				return ec.fieldContext_ProposalConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ProposalConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProposalConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_proposals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_proposal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_proposal(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Proposal(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Proposal)
+	fc.Result = res
+	return ec.marshalOProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_proposal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Proposal_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Proposal_title(ctx, field)
+			case "description":
+				return ec.fieldContext_Proposal_description(ctx, field)
+			case "type":
+				return ec.fieldContext_Proposal_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Proposal_status(ctx, field)
+			case "proposer":
+				return ec.fieldContext_Proposal_proposer(ctx, field)
+			case "votes":
+				return ec.fieldContext_Proposal_votes(ctx, field)
+			case "votingDeadline":
+				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Proposal_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_proposal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_myVotes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_myVotes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MyVotes(rctx, fc.Args["proposalId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Vote)
+	fc.Result = res
+	return ec.marshalNVote2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVoteᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_myVotes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Vote_id(ctx, field)
+			case "proposalId":
+				return ec.fieldContext_Vote_proposalId(ctx, field)
+			case "voter":
+				return ec.fieldContext_Vote_voter(ctx, field)
+			case "choice":
+				return ec.fieldContext_Vote_choice(ctx, field)
+			case "comment":
+				return ec.fieldContext_Vote_comment(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Vote_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_myVotes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_transactions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_transactions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Transactions(rctx, fc.Args["pagination"].(*model.PaginationInput), fc.Args["status"].(*model.TransactionStatus))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Transaction)
+	fc.Result = res
+	return ec.marshalNTransaction2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Transaction_id(ctx, field)
+			case "type":
+				return ec.fieldContext_Transaction_type(ctx, field)
+			case "chaincode":
+				return ec.fieldContext_Transaction_chaincode(ctx, field)
+			case "function":
+				return ec.fieldContext_Transaction_function(ctx, field)
+			case "args":
+				return ec.fieldContext_Transaction_args(ctx, field)
+			case "status":
+				return ec.fieldContext_Transaction_status(ctx, field)
+			case "txId":
+				return ec.fieldContext_Transaction_txId(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_Transaction_blockNumber(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Transaction_timestamp(ctx, field)
+			case "error":
+				return ec.fieldContext_Transaction_error(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Transaction_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_transactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_transaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_transaction(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Transaction(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Transaction)
+	fc.Result = res
+	return ec.marshalOTransaction2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_transaction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Transaction_id(ctx, field)
+			case "type":
+				return ec.fieldContext_Transaction_type(ctx, field)
+			case "chaincode":
+				return ec.fieldContext_Transaction_chaincode(ctx, field)
+			case "function":
+				return ec.fieldContext_Transaction_function(ctx, field)
+			case "args":
+				return ec.fieldContext_Transaction_args(ctx, field)
+			case "status":
+				return ec.fieldContext_Transaction_status(ctx, field)
+			case "txId":
+				return ec.fieldContext_Transaction_txId(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_Transaction_blockNumber(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Transaction_timestamp(ctx, field)
+			case "error":
+				return ec.fieldContext_Transaction_error(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Transaction_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_transaction_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_id(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_clubId(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClubID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_partnerClubId(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PartnerClubID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_partnerClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_status(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AgreementStatus)
+	fc.Result = res
+	return ec.marshalNAgreementStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AgreementStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_terms(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Terms, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AgreementTerms)
+	fc.Result = res
+	return ec.marshalNAgreementTerms2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTerms(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_terms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "maxVisitsPerMonth":
+				return ec.fieldContext_AgreementTerms_maxVisitsPerMonth(ctx, field)
+			case "reciprocalFee":
+				return ec.fieldContext_AgreementTerms_reciprocalFee(ctx, field)
+			case "blackoutDates":
+				return ec.fieldContext_AgreementTerms_blackoutDates(ctx, field)
+			case "specialConditions":
+				return ec.fieldContext_AgreementTerms_specialConditions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgreementTerms", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EffectiveDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_expirationDate(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpirationDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_expirationDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreement_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreement) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreement_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreementConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreementConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreementConnection_nodes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Nodes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ReciprocalAgreement)
+	fc.Result = res
+	return ec.marshalNReciprocalAgreement2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐReciprocalAgreementᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreementConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreementConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReciprocalAgreement_id(ctx, field)
+			case "clubId":
+				return ec.fieldContext_ReciprocalAgreement_clubId(ctx, field)
+			case "partnerClubId":
+				return ec.fieldContext_ReciprocalAgreement_partnerClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_ReciprocalAgreement_status(ctx, field)
+			case "terms":
+				return ec.fieldContext_ReciprocalAgreement_terms(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_ReciprocalAgreement_effectiveDate(ctx, field)
+			case "expirationDate":
+				return ec.fieldContext_ReciprocalAgreement_expirationDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReciprocalAgreement_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReciprocalAgreement_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReciprocalAgreement", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAgreementConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAgreementConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAgreementConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAgreementConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAgreementConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "pageSize":
+				return ec.fieldContext_PageInfo_pageSize(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPrevPage":
+				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAnalytics_totalAgreements(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAnalytics_totalAgreements(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalAgreements, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAnalytics_totalAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAnalytics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAnalytics_activeAgreements(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAnalytics_activeAgreements(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ActiveAgreements, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAnalytics_activeAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAnalytics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAnalytics_pendingAgreements(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAnalytics_pendingAgreements(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PendingAgreements, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAnalytics_pendingAgreements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAnalytics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReciprocalAnalytics_monthlyReciprocalUsage(ctx context.Context, field graphql.CollectedField, obj *model.ReciprocalAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReciprocalAnalytics_monthlyReciprocalUsage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MonthlyReciprocalUsage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.MonthlyVisit)
+	fc.Result = res
+	return ec.marshalNMonthlyVisit2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMonthlyVisitᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReciprocalAnalytics_monthlyReciprocalUsage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReciprocalAnalytics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "month":
+				return ec.fieldContext_MonthlyVisit_month(ctx, field)
+			case "count":
+				return ec.fieldContext_MonthlyVisit_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MonthlyVisit", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_id(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_userId(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_userEmail(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_userEmail(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserEmail, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_userEmail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_device(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_device(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Device, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_device(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_ipAddress(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_ipAddress(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IPAddress, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_ipAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_location(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_location(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_authProvider(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_authProvider(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthProvider, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_authProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_active(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_active(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Active, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_current(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_current(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Current, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_current(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_lastActivityAt(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_lastActivityAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastActivityAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_lastActivityAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Session_expiresAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Session_expiresAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SessionConnection_nodes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Nodes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Session)
+	fc.Result = res
+	return ec.marshalNSession2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSessionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SessionConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Session_userId(ctx, field)
+			case "userEmail":
+				return ec.fieldContext_Session_userEmail(ctx, field)
+			case "device":
+				return ec.fieldContext_Session_device(ctx, field)
+			case "ipAddress":
+				return ec.fieldContext_Session_ipAddress(ctx, field)
+			case "location":
+				return ec.fieldContext_Session_location(ctx, field)
+			case "authProvider":
+				return ec.fieldContext_Session_authProvider(ctx, field)
+			case "active":
+				return ec.fieldContext_Session_active(ctx, field)
+			case "current":
+				return ec.fieldContext_Session_current(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActivityAt":
+				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Session_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SessionConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SessionConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "pageSize":
+				return ec.fieldContext_PageInfo_pageSize(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPrevPage":
+				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_notificationReceived(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_notificationReceived(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().NotificationReceived(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Notification):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNNotification2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_notificationReceived(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Notification_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Notification_userId(ctx, field)
+			case "type":
+				return ec.fieldContext_Notification_type(ctx, field)
+			case "title":
+				return ec.fieldContext_Notification_title(ctx, field)
+			case "message":
+				return ec.fieldContext_Notification_message(ctx, field)
+			case "status":
+				return ec.fieldContext_Notification_status(ctx, field)
+			case "channels":
+				return ec.fieldContext_Notification_channels(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_Notification_scheduledAt(ctx, field)
+			case "sentAt":
+				return ec.fieldContext_Notification_sentAt(ctx, field)
+			case "readAt":
+				return ec.fieldContext_Notification_readAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Notification_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Notification", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_visitStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_visitStatusChanged(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().VisitStatusChanged(rctx, fc.Args["clubId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Visit):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNVisit2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisit(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_visitStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Visit_id(ctx, field)
+			case "memberId":
+				return ec.fieldContext_Visit_memberId(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Visit_clubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_Visit_visitingClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_Visit_status(ctx, field)
+			case "checkInTime":
+				return ec.fieldContext_Visit_checkInTime(ctx, field)
+			case "checkOutTime":
+				return ec.fieldContext_Visit_checkOutTime(ctx, field)
+			case "services":
+				return ec.fieldContext_Visit_services(ctx, field)
+			case "cost":
+				return ec.fieldContext_Visit_cost(ctx, field)
+			case "verified":
+				return ec.fieldContext_Visit_verified(ctx, field)
+			case "blockchainTxId":
+				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Visit_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_visitStatusChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_proposalUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_proposalUpdated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ProposalUpdated(rctx, fc.Args["proposalId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Proposal):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNProposal2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐProposal(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_proposalUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Proposal_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Proposal_title(ctx, field)
+			case "description":
+				return ec.fieldContext_Proposal_description(ctx, field)
+			case "type":
+				return ec.fieldContext_Proposal_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Proposal_status(ctx, field)
+			case "proposer":
+				return ec.fieldContext_Proposal_proposer(ctx, field)
+			case "votes":
+				return ec.fieldContext_Proposal_votes(ctx, field)
+			case "votingDeadline":
+				return ec.fieldContext_Proposal_votingDeadline(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Proposal_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Proposal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_proposalUpdated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_voteReceived(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_voteReceived(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().VoteReceived(rctx, fc.Args["proposalId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Vote):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNVote2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVote(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_voteReceived(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Vote_id(ctx, field)
+			case "proposalId":
+				return ec.fieldContext_Vote_proposalId(ctx, field)
+			case "voter":
+				return ec.fieldContext_Vote_voter(ctx, field)
+			case "choice":
+				return ec.fieldContext_Vote_choice(ctx, field)
+			case "comment":
+				return ec.fieldContext_Vote_comment(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Vote_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Vote", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_voteReceived_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_transactionStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_transactionStatusChanged(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().TransactionStatusChanged(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Transaction):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNTransaction2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_transactionStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Transaction_id(ctx, field)
+			case "type":
+				return ec.fieldContext_Transaction_type(ctx, field)
+			case "chaincode":
+				return ec.fieldContext_Transaction_chaincode(ctx, field)
+			case "function":
+				return ec.fieldContext_Transaction_function(ctx, field)
+			case "args":
+				return ec.fieldContext_Transaction_args(ctx, field)
+			case "status":
+				return ec.fieldContext_Transaction_status(ctx, field)
+			case "txId":
+				return ec.fieldContext_Transaction_txId(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_Transaction_blockNumber(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Transaction_timestamp(ctx, field)
+			case "error":
+				return ec.fieldContext_Transaction_error(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Transaction_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_id(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_type(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TransactionType)
+	fc.Result = res
+	return ec.marshalNTransactionType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TransactionType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_chaincode(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_chaincode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Chaincode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_chaincode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_function(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_function(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Function, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_function(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_args(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_status(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TransactionStatus)
+	fc.Result = res
+	return ec.marshalNTransactionStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TransactionStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_txId(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_txId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TxID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_txId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_blockNumber(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BlockNumber, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_timestamp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Transaction_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Transaction_id(ctx, field)
-			case "type":
-				return ec.fieldContext_Transaction_type(ctx, field)
-			case "chaincode":
-				return ec.fieldContext_Transaction_chaincode(ctx, field)
-			case "function":
-				return ec.fieldContext_Transaction_function(ctx, field)
-			case "args":
-				return ec.fieldContext_Transaction_args(ctx, field)
-			case "status":
-				return ec.fieldContext_Transaction_status(ctx, field)
-			case "txId":
-				return ec.fieldContext_Transaction_txId(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_Transaction_blockNumber(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Transaction_timestamp(ctx, field)
-			case "error":
-				return ec.fieldContext_Transaction_error(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Transaction_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_id(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_id(ctx, field)
+func (ec *executionContext) _Transaction_error(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12430,38 +16412,35 @@ func (ec *executionContext) _Transaction_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Transaction_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Transaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_type(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_type(ctx, field)
+func (ec *executionContext) _Transaction_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transaction_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12474,7 +16453,7 @@ func (ec *executionContext) _Transaction_type(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12486,26 +16465,26 @@ func (ec *executionContext) _Transaction_type(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TransactionType)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNTransactionType2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionType(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Transaction_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Transaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type TransactionType does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_chaincode(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_chaincode(ctx, field)
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12518,7 +16497,7 @@ func (ec *executionContext) _Transaction_chaincode(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Chaincode, nil
+		return ec.resolvers.User().ID(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12532,24 +16511,24 @@ func (ec *executionContext) _Transaction_chaincode(ctx context.Context, field gr
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_chaincode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_function(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_function(ctx, field)
+func (ec *executionContext) _User_clubId(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_clubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12562,7 +16541,7 @@ func (ec *executionContext) _Transaction_function(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Function, nil
+		return ec.resolvers.User().ClubID(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12576,24 +16555,24 @@ func (ec *executionContext) _Transaction_function(ctx context.Context, field gra
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_function(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_args(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_args(ctx, field)
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_email(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12606,7 +16585,7 @@ func (ec *executionContext) _Transaction_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Email, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12618,14 +16597,14 @@ func (ec *executionContext) _Transaction_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_email(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12636,8 +16615,8 @@ func (ec *executionContext) fieldContext_Transaction_args(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_status(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_status(ctx, field)
+func (ec *executionContext) _User_username(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_username(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12650,7 +16629,7 @@ func (ec *executionContext) _Transaction_status(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Username, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12662,26 +16641,26 @@ func (ec *executionContext) _Transaction_status(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TransactionStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTransactionStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_username(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type TransactionStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_txId(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_txId(ctx, field)
+func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_firstName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12694,7 +16673,7 @@ func (ec *executionContext) _Transaction_txId(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxID, nil
+		return ec.resolvers.User().FirstName(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12708,12 +16687,12 @@ func (ec *executionContext) _Transaction_txId(ctx context.Context, field graphql
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_txId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -12721,8 +16700,8 @@ func (ec *executionContext) fieldContext_Transaction_txId(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_blockNumber(ctx, field)
+func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_lastName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12735,7 +16714,7 @@ func (ec *executionContext) _Transaction_blockNumber(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockNumber, nil
+		return ec.resolvers.User().LastName(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12744,26 +16723,26 @@ func (ec *executionContext) _Transaction_blockNumber(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_timestamp(ctx, field)
+func (ec *executionContext) _User_status(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12776,35 +16755,38 @@ func (ec *executionContext) _Transaction_timestamp(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
+		return ec.resolvers.User().Status(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(model.UserStatus)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNUserStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐUserStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type UserStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_error(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_error(ctx, field)
+func (ec *executionContext) _User_roles(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_roles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12817,23 +16799,26 @@ func (ec *executionContext) _Transaction_error(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		return obj.Roles, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_roles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12844,8 +16829,8 @@ func (ec *executionContext) fieldContext_Transaction_error(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transaction_createdAt(ctx, field)
+func (ec *executionContext) _User_permissions(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_permissions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12858,7 +16843,7 @@ func (ec *executionContext) _Transaction_createdAt(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Permissions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12870,26 +16855,26 @@ func (ec *executionContext) _Transaction_createdAt(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transaction_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_permissions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "User",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_id(ctx, field)
+func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12902,7 +16887,7 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().ID(rctx, obj)
+		return ec.resolvers.User().CreatedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12914,26 +16899,26 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "User",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_clubId(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_clubId(ctx, field)
+func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12946,7 +16931,7 @@ func (ec *executionContext) _User_clubId(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().ClubID(rctx, obj)
+		return ec.resolvers.User().UpdatedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12958,26 +16943,26 @@ func (ec *executionContext) _User_clubId(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "User",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_email(ctx, field)
+func (ec *executionContext) _Visit_id(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12990,7 +16975,7 @@ func (ec *executionContext) _User_email(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13004,24 +16989,24 @@ func (ec *executionContext) _User_email(ctx context.Context, field graphql.Colle
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_email(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_username(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_username(ctx, field)
+func (ec *executionContext) _Visit_memberId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_memberId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13034,7 +17019,7 @@ func (ec *executionContext) _User_username(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Username, nil
+		return obj.MemberID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13048,24 +17033,24 @@ func (ec *executionContext) _User_username(ctx context.Context, field graphql.Co
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_username(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_memberId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_firstName(ctx, field)
+func (ec *executionContext) _Visit_clubId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_clubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13078,35 +17063,38 @@ func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().FirstName(rctx, obj)
+		return obj.ClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_lastName(ctx, field)
+func (ec *executionContext) _Visit_visitingClubId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_visitingClubId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13119,35 +17107,38 @@ func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().LastName(rctx, obj)
+		return obj.VisitingClubID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_visitingClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_status(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_status(ctx, field)
+func (ec *executionContext) _Visit_status(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13160,7 +17151,7 @@ func (ec *executionContext) _User_status(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Status(rctx, obj)
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13172,26 +17163,26 @@ func (ec *executionContext) _User_status(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.UserStatus)
+	res := resTmp.(model.VisitStatus)
 	fc.Result = res
-	return ec.marshalNUserStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐUserStatus(ctx, field.Selections, res)
+	return ec.marshalNVisitStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UserStatus does not have child fields")
+			return nil, errors.New("field of type VisitStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_roles(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_roles(ctx, field)
+func (ec *executionContext) _Visit_checkInTime(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_checkInTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13204,7 +17195,7 @@ func (ec *executionContext) _User_roles(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Roles, nil
+		return obj.CheckInTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13216,26 +17207,26 @@ func (ec *executionContext) _User_roles(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_roles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_checkInTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_permissions(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_permissions(ctx, field)
+func (ec *executionContext) _Visit_checkOutTime(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_checkOutTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13248,38 +17239,35 @@ func (ec *executionContext) _User_permissions(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Permissions, nil
+		return obj.CheckOutTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_permissions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_checkOutTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_createdAt(ctx, field)
+func (ec *executionContext) _Visit_services(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_services(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13292,38 +17280,35 @@ func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().CreatedAt(rctx, obj)
+		return obj.Services, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_services(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.CollectedField, obj *auth.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_updatedAt(ctx, field)
+func (ec *executionContext) _Visit_cost(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_cost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13336,38 +17321,35 @@ func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().UpdatedAt(rctx, obj)
+		return obj.Cost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_cost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Visit",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_id(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_id(ctx, field)
+func (ec *executionContext) _Visit_verified(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_verified(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13380,7 +17362,7 @@ func (ec *executionContext) _Visit_id(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Verified, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13392,26 +17374,26 @@ func (ec *executionContext) _Visit_id(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_verified(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Visit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_memberId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_memberId(ctx, field)
+func (ec *executionContext) _Visit_blockchainTxId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_blockchainTxId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13424,38 +17406,35 @@ func (ec *executionContext) _Visit_memberId(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MemberID, nil
+		return obj.BlockchainTxID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_memberId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_blockchainTxId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Visit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_clubId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_clubId(ctx, field)
+func (ec *executionContext) _Visit_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Visit_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13468,7 +17447,7 @@ func (ec *executionContext) _Visit_clubId(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClubID, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13480,26 +17459,26 @@ func (ec *executionContext) _Visit_clubId(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_clubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Visit_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Visit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_visitingClubId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_visitingClubId(ctx, field)
+func (ec *executionContext) _VisitAnalytics_totalVisits(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitAnalytics_totalVisits(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13512,7 +17491,7 @@ func (ec *executionContext) _Visit_visitingClubId(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.VisitingClubID, nil
+		return obj.TotalVisits, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13524,26 +17503,26 @@ func (ec *executionContext) _Visit_visitingClubId(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_visitingClubId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitAnalytics_totalVisits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitAnalytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_status(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_status(ctx, field)
+func (ec *executionContext) _VisitAnalytics_monthlyVisits(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitAnalytics_monthlyVisits(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13556,7 +17535,7 @@ func (ec *executionContext) _Visit_status(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.MonthlyVisits, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13568,26 +17547,32 @@ func (ec *executionContext) _Visit_status(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.VisitStatus)
+	res := resTmp.([]*model.MonthlyVisit)
 	fc.Result = res
-	return ec.marshalNVisitStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitStatus(ctx, field.Selections, res)
+	return ec.marshalNMonthlyVisit2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMonthlyVisitᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitAnalytics_monthlyVisits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitAnalytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type VisitStatus does not have child fields")
+			switch field.Name {
+			case "month":
+				return ec.fieldContext_MonthlyVisit_month(ctx, field)
+			case "count":
+				return ec.fieldContext_MonthlyVisit_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MonthlyVisit", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_checkInTime(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_checkInTime(ctx, field)
+func (ec *executionContext) _VisitAnalytics_topDestinations(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitAnalytics_topDestinations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13600,7 +17585,7 @@ func (ec *executionContext) _Visit_checkInTime(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CheckInTime, nil
+		return obj.TopDestinations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13612,26 +17597,32 @@ func (ec *executionContext) _Visit_checkInTime(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.ClubVisitCount)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNClubVisitCount2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubVisitCountᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_checkInTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitAnalytics_topDestinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitAnalytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "club":
+				return ec.fieldContext_ClubVisitCount_club(ctx, field)
+			case "count":
+				return ec.fieldContext_ClubVisitCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClubVisitCount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_checkOutTime(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_checkOutTime(ctx, field)
+func (ec *executionContext) _VisitAnalytics_averageVisitDuration(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitAnalytics_averageVisitDuration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13644,7 +17635,7 @@ func (ec *executionContext) _Visit_checkOutTime(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CheckOutTime, nil
+		return obj.AverageVisitDuration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13653,26 +17644,26 @@ func (ec *executionContext) _Visit_checkOutTime(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_checkOutTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitAnalytics_averageVisitDuration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitAnalytics",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_services(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_services(ctx, field)
+func (ec *executionContext) _VisitConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.VisitConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitConnection_nodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13685,35 +17676,64 @@ func (ec *executionContext) _Visit_services(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Services, nil
+		return obj.Nodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*model.Visit)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNVisit2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_services(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Visit_id(ctx, field)
+			case "memberId":
+				return ec.fieldContext_Visit_memberId(ctx, field)
+			case "clubId":
+				return ec.fieldContext_Visit_clubId(ctx, field)
+			case "visitingClubId":
+				return ec.fieldContext_Visit_visitingClubId(ctx, field)
+			case "status":
+				return ec.fieldContext_Visit_status(ctx, field)
+			case "checkInTime":
+				return ec.fieldContext_Visit_checkInTime(ctx, field)
+			case "checkOutTime":
+				return ec.fieldContext_Visit_checkOutTime(ctx, field)
+			case "services":
+				return ec.fieldContext_Visit_services(ctx, field)
+			case "cost":
+				return ec.fieldContext_Visit_cost(ctx, field)
+			case "verified":
+				return ec.fieldContext_Visit_verified(ctx, field)
+			case "blockchainTxId":
+				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Visit_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_cost(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_cost(ctx, field)
+func (ec *executionContext) _VisitConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.VisitConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13726,35 +17746,52 @@ func (ec *executionContext) _Visit_cost(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cost, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_cost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "pageSize":
+				return ec.fieldContext_PageInfo_pageSize(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPrevPage":
+				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_verified(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_verified(ctx, field)
+func (ec *executionContext) _VisitOutcomes_visits(ctx context.Context, field graphql.CollectedField, obj *model.VisitOutcomes) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitOutcomes_visits(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13767,7 +17804,7 @@ func (ec *executionContext) _Visit_verified(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Verified, nil
+		return obj.Visits, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13779,26 +17816,26 @@ func (ec *executionContext) _Visit_verified(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_verified(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitOutcomes_visits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitOutcomes",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_blockchainTxId(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_blockchainTxId(ctx, field)
+func (ec *executionContext) _VisitOutcomes_open(ctx context.Context, field graphql.CollectedField, obj *model.VisitOutcomes) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitOutcomes_open(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13811,35 +17848,38 @@ func (ec *executionContext) _Visit_blockchainTxId(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockchainTxID, nil
+		return obj.Open, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_blockchainTxId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitOutcomes_open(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitOutcomes",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Visit_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Visit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Visit_createdAt(ctx, field)
+func (ec *executionContext) _VisitOutcomes_attended(ctx context.Context, field graphql.CollectedField, obj *model.VisitOutcomes) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitOutcomes_attended(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13852,7 +17892,7 @@ func (ec *executionContext) _Visit_createdAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Attended, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13864,26 +17904,26 @@ func (ec *executionContext) _Visit_createdAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Visit_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitOutcomes_attended(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Visit",
+		Object:     "VisitOutcomes",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _VisitAnalytics_totalVisits(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_VisitAnalytics_totalVisits(ctx, field)
+func (ec *executionContext) _VisitOutcomes_cancelled(ctx context.Context, field graphql.CollectedField, obj *model.VisitOutcomes) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitOutcomes_cancelled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13896,7 +17936,7 @@ func (ec *executionContext) _VisitAnalytics_totalVisits(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalVisits, nil
+		return obj.Cancelled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13913,9 +17953,9 @@ func (ec *executionContext) _VisitAnalytics_totalVisits(ctx context.Context, fie
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_VisitAnalytics_totalVisits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitOutcomes_cancelled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VisitAnalytics",
+		Object:     "VisitOutcomes",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -13926,8 +17966,8 @@ func (ec *executionContext) fieldContext_VisitAnalytics_totalVisits(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _VisitAnalytics_monthlyVisits(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_VisitAnalytics_monthlyVisits(ctx, field)
+func (ec *executionContext) _VisitOutcomes_noShows(ctx context.Context, field graphql.CollectedField, obj *model.VisitOutcomes) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitOutcomes_noShows(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13940,7 +17980,7 @@ func (ec *executionContext) _VisitAnalytics_monthlyVisits(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MonthlyVisits, nil
+		return obj.NoShows, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13952,32 +17992,26 @@ func (ec *executionContext) _VisitAnalytics_monthlyVisits(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MonthlyVisit)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNMonthlyVisit2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐMonthlyVisitᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_VisitAnalytics_monthlyVisits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitOutcomes_noShows(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VisitAnalytics",
+		Object:     "VisitOutcomes",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "month":
-				return ec.fieldContext_MonthlyVisit_month(ctx, field)
-			case "count":
-				return ec.fieldContext_MonthlyVisit_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MonthlyVisit", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _VisitAnalytics_topDestinations(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_VisitAnalytics_topDestinations(ctx, field)
+func (ec *executionContext) _VisitOutcomes_cancellationRate(ctx context.Context, field graphql.CollectedField, obj *model.VisitOutcomes) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitOutcomes_cancellationRate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13990,7 +18024,7 @@ func (ec *executionContext) _VisitAnalytics_topDestinations(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TopDestinations, nil
+		return obj.CancellationRate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14002,32 +18036,26 @@ func (ec *executionContext) _VisitAnalytics_topDestinations(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ClubVisitCount)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNClubVisitCount2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubVisitCountᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_VisitAnalytics_topDestinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitOutcomes_cancellationRate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VisitAnalytics",
+		Object:     "VisitOutcomes",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "club":
-				return ec.fieldContext_ClubVisitCount_club(ctx, field)
-			case "count":
-				return ec.fieldContext_ClubVisitCount_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ClubVisitCount", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _VisitAnalytics_averageVisitDuration(ctx context.Context, field graphql.CollectedField, obj *model.VisitAnalytics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_VisitAnalytics_averageVisitDuration(ctx, field)
+func (ec *executionContext) _VisitOutcomes_noShowRate(ctx context.Context, field graphql.CollectedField, obj *model.VisitOutcomes) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitOutcomes_noShowRate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14040,23 +18068,26 @@ func (ec *executionContext) _VisitAnalytics_averageVisitDuration(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AverageVisitDuration, nil
+		return obj.NoShowRate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_VisitAnalytics_averageVisitDuration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitOutcomes_noShowRate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VisitAnalytics",
+		Object:     "VisitOutcomes",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -14067,8 +18098,8 @@ func (ec *executionContext) fieldContext_VisitAnalytics_averageVisitDuration(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _VisitConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *model.VisitConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_VisitConnection_nodes(ctx, field)
+func (ec *executionContext) _VisitRating_average(ctx context.Context, field graphql.CollectedField, obj *model.VisitRating) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitRating_average(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14081,7 +18112,7 @@ func (ec *executionContext) _VisitConnection_nodes(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nodes, nil
+		return obj.Average, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14093,52 +18124,26 @@ func (ec *executionContext) _VisitConnection_nodes(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Visit)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNVisit2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_VisitConnection_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitRating_average(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VisitConnection",
+		Object:     "VisitRating",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Visit_id(ctx, field)
-			case "memberId":
-				return ec.fieldContext_Visit_memberId(ctx, field)
-			case "clubId":
-				return ec.fieldContext_Visit_clubId(ctx, field)
-			case "visitingClubId":
-				return ec.fieldContext_Visit_visitingClubId(ctx, field)
-			case "status":
-				return ec.fieldContext_Visit_status(ctx, field)
-			case "checkInTime":
-				return ec.fieldContext_Visit_checkInTime(ctx, field)
-			case "checkOutTime":
-				return ec.fieldContext_Visit_checkOutTime(ctx, field)
-			case "services":
-				return ec.fieldContext_Visit_services(ctx, field)
-			case "cost":
-				return ec.fieldContext_Visit_cost(ctx, field)
-			case "verified":
-				return ec.fieldContext_Visit_verified(ctx, field)
-			case "blockchainTxId":
-				return ec.fieldContext_Visit_blockchainTxId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Visit_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Visit", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _VisitConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.VisitConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_VisitConnection_pageInfo(ctx, field)
+func (ec *executionContext) _VisitRating_count(ctx context.Context, field graphql.CollectedField, obj *model.VisitRating) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VisitRating_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14151,7 +18156,7 @@ func (ec *executionContext) _VisitConnection_pageInfo(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14163,33 +18168,19 @@ func (ec *executionContext) _VisitConnection_pageInfo(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_VisitConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VisitRating_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VisitConnection",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "pageSize":
-				return ec.fieldContext_PageInfo_pageSize(ctx, field)
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfo_totalPages(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPrevPage":
-				return ec.fieldContext_PageInfo_hasPrevPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		Object:     "VisitRating",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
@@ -17047,6 +21038,92 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var agreementNetworkStatsImplementors = []string{"AgreementNetworkStats"}
+
+func (ec *executionContext) _AgreementNetworkStats(ctx context.Context, sel ast.SelectionSet, obj *model.AgreementNetworkStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agreementNetworkStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgreementNetworkStats")
+		case "agreementId":
+			out.Values[i] = ec._AgreementNetworkStats_agreementId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proposingClubId":
+			out.Values[i] = ec._AgreementNetworkStats_proposingClubId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "targetClubId":
+			out.Values[i] = ec._AgreementNetworkStats_targetClubId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._AgreementNetworkStats_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "outbound":
+			out.Values[i] = ec._AgreementNetworkStats_outbound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "inbound":
+			out.Values[i] = ec._AgreementNetworkStats_inbound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "balance":
+			out.Values[i] = ec._AgreementNetworkStats_balance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxVisitsPerMonth":
+			out.Values[i] = ec._AgreementNetworkStats_maxVisitsPerMonth(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "utilization":
+			out.Values[i] = ec._AgreementNetworkStats_utilization(ctx, field, obj)
+		case "outcomes":
+			out.Values[i] = ec._AgreementNetworkStats_outcomes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "trend":
+			out.Values[i] = ec._AgreementNetworkStats_trend(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var agreementTermsImplementors = []string{"AgreementTerms"}
 
 func (ec *executionContext) _AgreementTerms(ctx context.Context, sel ast.SelectionSet, obj *model.AgreementTerms) graphql.Marshaler {
@@ -17092,6 +21169,109 @@ func (ec *executionContext) _AgreementTerms(ctx context.Context, sel ast.Selecti
 	return out
 }
 
+var agreementTrendPointImplementors = []string{"AgreementTrendPoint"}
+
+func (ec *executionContext) _AgreementTrendPoint(ctx context.Context, sel ast.SelectionSet, obj *model.AgreementTrendPoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agreementTrendPointImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgreementTrendPoint")
+		case "month":
+			out.Values[i] = ec._AgreementTrendPoint_month(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "outbound":
+			out.Values[i] = ec._AgreementTrendPoint_outbound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "inbound":
+			out.Values[i] = ec._AgreementTrendPoint_inbound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var agreementUtilizationImplementors = []string{"AgreementUtilization"}
+
+func (ec *executionContext) _AgreementUtilization(ctx context.Context, sel ast.SelectionSet, obj *model.AgreementUtilization) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agreementUtilizationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgreementUtilization")
+		case "memberMonths":
+			out.Values[i] = ec._AgreementUtilization_memberMonths(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "averageVisits":
+			out.Values[i] = ec._AgreementUtilization_averageVisits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rate":
+			out.Values[i] = ec._AgreementUtilization_rate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "atLimit":
+			out.Values[i] = ec._AgreementUtilization_atLimit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var analyticsImplementors = []string{"Analytics"}
 
 func (ec *executionContext) _Analytics(ctx context.Context, sel ast.SelectionSet, obj *model.Analytics) graphql.Marshaler {
@@ -17118,6 +21298,11 @@ func (ec *executionContext) _Analytics(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "network":
+			out.Values[i] = ec._Analytics_network(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -17216,29 +21401,151 @@ func (ec *executionContext) _Club(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "description":
-			out.Values[i] = ec._Club_description(ctx, field, obj)
-		case "location":
-			out.Values[i] = ec._Club_location(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._Club_description(ctx, field, obj)
+		case "location":
+			out.Values[i] = ec._Club_location(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "website":
+			out.Values[i] = ec._Club_website(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._Club_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "settings":
+			out.Values[i] = ec._Club_settings(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._Club_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Club_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var clubFlowImplementors = []string{"ClubFlow"}
+
+func (ec *executionContext) _ClubFlow(ctx context.Context, sel ast.SelectionSet, obj *model.ClubFlow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, clubFlowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ClubFlow")
+		case "homeClubId":
+			out.Values[i] = ec._ClubFlow_homeClubId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "visitingClubId":
+			out.Values[i] = ec._ClubFlow_visitingClubId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "visits":
+			out.Values[i] = ec._ClubFlow_visits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "members":
+			out.Values[i] = ec._ClubFlow_members(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requested":
+			out.Values[i] = ec._ClubFlow_requested(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var clubNetworkStatsImplementors = []string{"ClubNetworkStats"}
+
+func (ec *executionContext) _ClubNetworkStats(ctx context.Context, sel ast.SelectionSet, obj *model.ClubNetworkStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, clubNetworkStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ClubNetworkStats")
+		case "clubId":
+			out.Values[i] = ec._ClubNetworkStats_clubId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hosted":
+			out.Values[i] = ec._ClubNetworkStats_hosted(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "website":
-			out.Values[i] = ec._Club_website(ctx, field, obj)
-		case "status":
-			out.Values[i] = ec._Club_status(ctx, field, obj)
+		case "visitsMade":
+			out.Values[i] = ec._ClubNetworkStats_visitsMade(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "settings":
-			out.Values[i] = ec._Club_settings(ctx, field, obj)
-		case "createdAt":
-			out.Values[i] = ec._Club_createdAt(ctx, field, obj)
+		case "partners":
+			out.Values[i] = ec._ClubNetworkStats_partners(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._Club_updatedAt(ctx, field, obj)
+		case "hostRating":
+			out.Values[i] = ec._ClubNetworkStats_hostRating(ctx, field, obj)
+		case "guestRating":
+			out.Values[i] = ec._ClubNetworkStats_guestRating(ctx, field, obj)
+		case "facilities":
+			out.Values[i] = ec._ClubNetworkStats_facilities(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -17409,6 +21716,50 @@ func (ec *executionContext) _EmergencyContact(ctx context.Context, sel ast.Selec
 	return out
 }
 
+var facilityUsageImplementors = []string{"FacilityUsage"}
+
+func (ec *executionContext) _FacilityUsage(ctx context.Context, sel ast.SelectionSet, obj *model.FacilityUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, facilityUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FacilityUsage")
+		case "facility":
+			out.Values[i] = ec._FacilityUsage_facility(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "visits":
+			out.Values[i] = ec._FacilityUsage_visits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var memberImplementors = []string{"Member"}
 
 func (ec *executionContext) _Member(ctx context.Context, sel ast.SelectionSet, obj *model.Member) graphql.Marshaler {
@@ -17833,6 +22184,34 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "revokeSession":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_revokeSession(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "revokeOtherSessions":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_revokeOtherSessions(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "adminRevokeSession":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_adminRevokeSession(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "revokeUserSessions":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_revokeUserSessions(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "createMember":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_createMember(ctx, field)
@@ -17996,6 +22375,60 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
+var networkAnalyticsImplementors = []string{"NetworkAnalytics"}
+
+func (ec *executionContext) _NetworkAnalytics(ctx context.Context, sel ast.SelectionSet, obj *model.NetworkAnalytics) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, networkAnalyticsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NetworkAnalytics")
+		case "outcomes":
+			out.Values[i] = ec._NetworkAnalytics_outcomes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "flows":
+			out.Values[i] = ec._NetworkAnalytics_flows(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "agreements":
+			out.Values[i] = ec._NetworkAnalytics_agreements(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "clubs":
+			out.Values[i] = ec._NetworkAnalytics_clubs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var notificationImplementors = []string{"Notification"}
 
 func (ec *executionContext) _Notification(ctx context.Context, sel ast.SelectionSet, obj *model.Notification) graphql.Marshaler {
@@ -18344,6 +22777,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "mySessions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_mySessions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "clubSessions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_clubSessions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "members":
 			field := field
@@ -18837,8 +23314,106 @@ func (ec *executionContext) _ReciprocalAgreement(ctx context.Context, sel ast.Se
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._ReciprocalAgreement_updatedAt(ctx, field, obj)
+		case "updatedAt":
+			out.Values[i] = ec._ReciprocalAgreement_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var reciprocalAgreementConnectionImplementors = []string{"ReciprocalAgreementConnection"}
+
+func (ec *executionContext) _ReciprocalAgreementConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ReciprocalAgreementConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reciprocalAgreementConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReciprocalAgreementConnection")
+		case "nodes":
+			out.Values[i] = ec._ReciprocalAgreementConnection_nodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._ReciprocalAgreementConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var reciprocalAnalyticsImplementors = []string{"ReciprocalAnalytics"}
+
+func (ec *executionContext) _ReciprocalAnalytics(ctx context.Context, sel ast.SelectionSet, obj *model.ReciprocalAnalytics) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reciprocalAnalyticsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReciprocalAnalytics")
+		case "totalAgreements":
+			out.Values[i] = ec._ReciprocalAnalytics_totalAgreements(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "activeAgreements":
+			out.Values[i] = ec._ReciprocalAnalytics_activeAgreements(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pendingAgreements":
+			out.Values[i] = ec._ReciprocalAnalytics_pendingAgreements(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "monthlyReciprocalUsage":
+			out.Values[i] = ec._ReciprocalAnalytics_monthlyReciprocalUsage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -18865,24 +23440,68 @@ func (ec *executionContext) _ReciprocalAgreement(ctx context.Context, sel ast.Se
 	return out
 }
 
-var reciprocalAgreementConnectionImplementors = []string{"ReciprocalAgreementConnection"}
+var sessionImplementors = []string{"Session"}
 
-func (ec *executionContext) _ReciprocalAgreementConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ReciprocalAgreementConnection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, reciprocalAgreementConnectionImplementors)
+func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet, obj *model.Session) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ReciprocalAgreementConnection")
-		case "nodes":
-			out.Values[i] = ec._ReciprocalAgreementConnection_nodes(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Session")
+		case "id":
+			out.Values[i] = ec._Session_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageInfo":
-			out.Values[i] = ec._ReciprocalAgreementConnection_pageInfo(ctx, field, obj)
+		case "userId":
+			out.Values[i] = ec._Session_userId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "userEmail":
+			out.Values[i] = ec._Session_userEmail(ctx, field, obj)
+		case "device":
+			out.Values[i] = ec._Session_device(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ipAddress":
+			out.Values[i] = ec._Session_ipAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "location":
+			out.Values[i] = ec._Session_location(ctx, field, obj)
+		case "authProvider":
+			out.Values[i] = ec._Session_authProvider(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "active":
+			out.Values[i] = ec._Session_active(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "current":
+			out.Values[i] = ec._Session_current(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Session_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastActivityAt":
+			out.Values[i] = ec._Session_lastActivityAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._Session_expiresAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -18909,34 +23528,24 @@ func (ec *executionContext) _ReciprocalAgreementConnection(ctx context.Context,
 	return out
 }
 
-var reciprocalAnalyticsImplementors = []string{"ReciprocalAnalytics"}
+var sessionConnectionImplementors = []string{"SessionConnection"}
 
-func (ec *executionContext) _ReciprocalAnalytics(ctx context.Context, sel ast.SelectionSet, obj *model.ReciprocalAnalytics) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, reciprocalAnalyticsImplementors)
+func (ec *executionContext) _SessionConnection(ctx context.Context, sel ast.SelectionSet, obj *model.SessionConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ReciprocalAnalytics")
-		case "totalAgreements":
-			out.Values[i] = ec._ReciprocalAnalytics_totalAgreements(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "activeAgreements":
-			out.Values[i] = ec._ReciprocalAnalytics_activeAgreements(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pendingAgreements":
-			out.Values[i] = ec._ReciprocalAnalytics_pendingAgreements(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SessionConnection")
+		case "nodes":
+			out.Values[i] = ec._SessionConnection_nodes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "monthlyReciprocalUsage":
-			out.Values[i] = ec._ReciprocalAnalytics_monthlyReciprocalUsage(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._SessionConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -19545,6 +24154,119 @@ func (ec *executionContext) _VisitConnection(ctx context.Context, sel ast.Select
 	return out
 }
 
+var visitOutcomesImplementors = []string{"VisitOutcomes"}
+
+func (ec *executionContext) _VisitOutcomes(ctx context.Context, sel ast.SelectionSet, obj *model.VisitOutcomes) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, visitOutcomesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VisitOutcomes")
+		case "visits":
+			out.Values[i] = ec._VisitOutcomes_visits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "open":
+			out.Values[i] = ec._VisitOutcomes_open(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "attended":
+			out.Values[i] = ec._VisitOutcomes_attended(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelled":
+			out.Values[i] = ec._VisitOutcomes_cancelled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "noShows":
+			out.Values[i] = ec._VisitOutcomes_noShows(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancellationRate":
+			out.Values[i] = ec._VisitOutcomes_cancellationRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "noShowRate":
+			out.Values[i] = ec._VisitOutcomes_noShowRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var visitRatingImplementors = []string{"VisitRating"}
+
+func (ec *executionContext) _VisitRating(ctx context.Context, sel ast.SelectionSet, obj *model.VisitRating) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, visitRatingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VisitRating")
+		case "average":
+			out.Values[i] = ec._VisitRating_average(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._VisitRating_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var voteImplementors = []string{"Vote"}
 
 func (ec *executionContext) _Vote(ctx context.Context, sel ast.SelectionSet, obj *model.Vote) graphql.Marshaler {
@@ -19932,6 +24654,60 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAgreementNetworkStats2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementNetworkStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AgreementNetworkStats) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAgreementNetworkStats2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementNetworkStats(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAgreementNetworkStats2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementNetworkStats(ctx context.Context, sel ast.SelectionSet, v *model.AgreementNetworkStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AgreementNetworkStats(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNAgreementStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementStatus(ctx context.Context, v interface{}) (model.AgreementStatus, error) {
 	var res model.AgreementStatus
 	err := res.UnmarshalGQL(v)
@@ -19942,19 +24718,73 @@ func (ec *executionContext) marshalNAgreementStatus2reciprocalᚑclubsᚑbackend
 	return v
 }
 
-func (ec *executionContext) marshalNAgreementTerms2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTerms(ctx context.Context, sel ast.SelectionSet, v *model.AgreementTerms) graphql.Marshaler {
+func (ec *executionContext) marshalNAgreementTerms2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTerms(ctx context.Context, sel ast.SelectionSet, v *model.AgreementTerms) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AgreementTerms(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAgreementTermsInput2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTermsInput(ctx context.Context, v interface{}) (*model.AgreementTermsInput, error) {
+	res, err := ec.unmarshalInputAgreementTermsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAgreementTrendPoint2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTrendPointᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AgreementTrendPoint) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAgreementTrendPoint2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTrendPoint(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAgreementTrendPoint2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTrendPoint(ctx context.Context, sel ast.SelectionSet, v *model.AgreementTrendPoint) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AgreementTerms(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNAgreementTermsInput2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementTermsInput(ctx context.Context, v interface{}) (*model.AgreementTermsInput, error) {
-	res, err := ec.unmarshalInputAgreementTermsInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._AgreementTrendPoint(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNAnalytics2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAnalytics(ctx context.Context, sel ast.SelectionSet, v model.Analytics) graphql.Marshaler {
@@ -20064,6 +24894,114 @@ func (ec *executionContext) marshalNClub2ᚖreciprocalᚑclubsᚑbackendᚋservi
 	return ec._Club(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNClubFlow2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubFlowᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ClubFlow) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNClubFlow2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubFlow(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNClubFlow2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubFlow(ctx context.Context, sel ast.SelectionSet, v *model.ClubFlow) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ClubFlow(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNClubNetworkStats2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubNetworkStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ClubNetworkStats) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNClubNetworkStats2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubNetworkStats(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNClubNetworkStats2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubNetworkStats(ctx context.Context, sel ast.SelectionSet, v *model.ClubNetworkStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ClubNetworkStats(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNClubStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐClubStatus(ctx context.Context, v interface{}) (model.ClubStatus, error) {
 	var res model.ClubStatus
 	err := res.UnmarshalGQL(v)
@@ -20148,6 +25086,75 @@ func (ec *executionContext) unmarshalNCreateReciprocalAgreementInput2reciprocal
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNFacilityUsage2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐFacilityUsageᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FacilityUsage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFacilityUsage2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐFacilityUsage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNFacilityUsage2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐFacilityUsage(ctx context.Context, sel ast.SelectionSet, v *model.FacilityUsage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FacilityUsage(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -20435,6 +25442,16 @@ func (ec *executionContext) marshalNMonthlyVisit2ᚖreciprocalᚑclubsᚑbackend
 	return ec._MonthlyVisit(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNNetworkAnalytics2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNetworkAnalytics(ctx context.Context, sel ast.SelectionSet, v *model.NetworkAnalytics) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._NetworkAnalytics(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNNotification2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐNotification(ctx context.Context, sel ast.SelectionSet, v model.Notification) graphql.Marshaler {
 	return ec._Notification(ctx, sel, &v)
 }
@@ -20792,6 +25809,74 @@ func (ec *executionContext) unmarshalNRegisterInput2reciprocalᚑclubsᚑbackend
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNSession2ᚕᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSessionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Session) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSession2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSession(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSession2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSession(ctx context.Context, sel ast.SelectionSet, v *model.Session) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Session(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSessionConnection2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSessionConnection(ctx context.Context, sel ast.SelectionSet, v model.SessionConnection) graphql.Marshaler {
+	return ec._SessionConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSessionConnection2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐSessionConnection(ctx context.Context, sel ast.SelectionSet, v *model.SessionConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SessionConnection(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -21055,6 +26140,16 @@ func (ec *executionContext) marshalNVisitConnection2ᚖreciprocalᚑclubsᚑback
 	return ec._VisitConnection(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNVisitOutcomes2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitOutcomes(ctx context.Context, sel ast.SelectionSet, v *model.VisitOutcomes) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._VisitOutcomes(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNVisitStatus2reciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitStatus(ctx context.Context, v interface{}) (model.VisitStatus, error) {
 	var res model.VisitStatus
 	err := res.UnmarshalGQL(v)
@@ -21417,6 +26512,13 @@ func (ec *executionContext) marshalOAgreementStatus2ᚖreciprocalᚑclubsᚑback
 	return v
 }
 
+func (ec *executionContext) marshalOAgreementUtilization2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐAgreementUtilization(ctx context.Context, sel ast.SelectionSet, v *model.AgreementUtilization) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AgreementUtilization(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -21755,6 +26857,13 @@ func (ec *executionContext) marshalOVisit2ᚖreciprocalᚑclubsᚑbackendᚋserv
 	return ec._Visit(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOVisitRating2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitRating(ctx context.Context, sel ast.SelectionSet, v *model.VisitRating) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._VisitRating(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOVisitStatus2ᚖreciprocalᚑclubsᚑbackendᚋservicesᚋapiᚑgatewayᚋgraphᚋmodelᚐVisitStatus(ctx context.Context, v interface{}) (*model.VisitStatus, error) {
 	if v == nil {
 		return nil, nil
@@ -26,6 +26,20 @@ type AddressInput struct {
 	Country    string `json:"country"`
 }
 
+type AgreementNetworkStats struct {
+	AgreementID       string                 `json:"agreementId"`
+	ProposingClubID   string                 `json:"proposingClubId"`
+	TargetClubID      string                 `json:"targetClubId"`
+	Status            string                 `json:"status"`
+	Outbound          int                    `json:"outbound"`
+	Inbound           int                    `json:"inbound"`
+	Balance           float64                `json:"balance"`
+	MaxVisitsPerMonth int                    `json:"maxVisitsPerMonth"`
+	Utilization       *AgreementUtilization  `json:"utilization,omitempty"`
+	Outcomes          *VisitOutcomes         `json:"outcomes"`
+	Trend             []*AgreementTrendPoint `json:"trend"`
+}
+
 type AgreementTerms struct {
 	MaxVisitsPerMonth int          `json:"maxVisitsPerMonth"`
 	ReciprocalFee     *float64     `json:"reciprocalFee,omitempty"`
@@ -40,10 +54,24 @@ type AgreementTermsInput struct {
 	SpecialConditions *string      `json:"specialConditions,omitempty"`
 }
 
+type AgreementTrendPoint struct {
+	Month    time.Time `json:"month"`
+	Outbound int       `json:"outbound"`
+	Inbound  int       `json:"inbound"`
+}
+
+type AgreementUtilization struct {
+	MemberMonths  int     `json:"memberMonths"`
+	AverageVisits float64 `json:"averageVisits"`
+	Rate          float64 `json:"rate"`
+	AtLimit       int     `json:"atLimit"`
+}
+
 type Analytics struct {
 	Visits      *VisitAnalytics      `json:"visits"`
 	Members     *MemberAnalytics     `json:"members"`
 	Reciprocals *ReciprocalAnalytics `json:"reciprocals"`
+	Network     *NetworkAnalytics    `json:"network"`
 }
 
 type AuthPayload struct {
@@ -77,6 +105,24 @@ type Club struct {
 	UpdatedAt   time.Time     `json:"updatedAt"`
 }
 
+type ClubFlow struct {
+	HomeClubID     string `json:"homeClubId"`
+	VisitingClubID string `json:"visitingClubId"`
+	Visits         int    `json:"visits"`
+	Members        int    `json:"members"`
+	Requested      int    `json:"requested"`
+}
+
+type ClubNetworkStats struct {
+	ClubID      string           `json:"clubId"`
+	Hosted      *VisitOutcomes   `json:"hosted"`
+	VisitsMade  int              `json:"visitsMade"`
+	Partners    int              `json:"partners"`
+	HostRating  *VisitRating     `json:"hostRating,omitempty"`
+	GuestRating *VisitRating     `json:"guestRating,omitempty"`
+	Facilities  []*FacilityUsage `json:"facilities"`
+}
+
 type ClubSettings struct {
 	AllowReciprocal   bool     `json:"allowReciprocal"`
 	RequireApproval   bool     `json:"requireApproval"`
@@ -130,6 +176,11 @@ type EmergencyContactInput struct {
 	PhoneNumber  string `json:"phoneNumber"`
 }
 
+type FacilityUsage struct {
+	Facility string `json:"facility"`
+	Visits   int    `json:"visits"`
+}
+
 type LoginInput struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -208,6 +259,13 @@ type MonthlyVisit struct {
 type Mutation struct {
 }
 
+type NetworkAnalytics struct {
+	Outcomes   *VisitOutcomes           `json:"outcomes"`
+	Flows      []*ClubFlow              `json:"flows"`
+	Agreements []*AgreementNetworkStats `json:"agreements"`
+	Clubs      []*ClubNetworkStats      `json:"clubs"`
+}
+
 type Notification struct {
 	ID          string                `json:"id"`
 	UserID      string                `json:"userId"`
@@ -301,6 +359,26 @@ type RegisterInput struct {
 	LastName  string `json:"lastName"`
 }
 
+type Session struct {
+	ID             string    `json:"id"`
+	UserID         string    `json:"userId"`
+	UserEmail      *string   `json:"userEmail,omitempty"`
+	Device         string    `json:"device"`
+	IPAddress      string    `json:"ipAddress"`
+	Location       *string   `json:"location,omitempty"`
+	AuthProvider   string    `json:"authProvider"`
+	Active         bool      `json:"active"`
+	Current        bool      `json:"current"`
+	CreatedAt      time.Time `json:"createdAt"`
+	LastActivityAt time.Time `json:"lastActivityAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+type SessionConnection struct {
+	Nodes    []*Session `json:"nodes"`
+	PageInfo *PageInfo  `json:"pageInfo"`
+}
+
 type Subscription struct {
 }
 
@@ -345,6 +423,21 @@ type VisitConnection struct {
 	PageInfo *PageInfo `json:"pageInfo"`
 }
 
+type VisitOutcomes struct {
+	Visits           int     `json:"visits"`
+	Open             int     `json:"open"`
+	Attended         int     `json:"attended"`
+	Cancelled        int     `json:"cancelled"`
+	NoShows          int     `json:"noShows"`
+	CancellationRate float64 `json:"cancellationRate"`
+	NoShowRate       float64 `json:"noShowRate"`
+}
+
+type VisitRating struct {
+	Average float64 `json:"average"`
+	Count   int     `json:"count"`
+}
+
 type Vote struct {
 	ID         string     `json:"id"`
 	ProposalID string     `json:"proposalId"`
@@ -10,6 +10,7 @@ import (
 	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/services/api-gateway/graph/generated"
 	"reciprocal-clubs-backend/services/api-gateway/graph/model"
+	"reciprocal-clubs-backend/services/api-gateway/internal/clients"
 	"time"
 )
 
@@ -93,6 +94,99 @@ func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return false, fmt.Errorf("authentication required")
+	}
+
+	sessionID, err := parseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := r.clients.AuthService.RevokeSession(ctx, &clients.RevokeSessionRequest{
+		ClubID:    uint32(user.ClubID),
+		UserID:    uint32(user.ID),
+		SessionID: sessionID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	r.logger.Info("Session revoked", map[string]interface{}{"user_id": user.ID, "session_id": sessionID})
+	return resp.Success, nil
+}
+
+// RevokeOtherSessions is the resolver for the revokeOtherSessions field.
+func (r *mutationResolver) RevokeOtherSessions(ctx context.Context, currentSessionID string) (int, error) {
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return 0, fmt.Errorf("authentication required")
+	}
+
+	resp, err := r.clients.AuthService.RevokeOtherSessions(ctx, &clients.RevokeOtherSessionsRequest{
+		ClubID:              uint32(user.ClubID),
+		UserID:              uint32(user.ID),
+		CurrentSessionToken: currentSessionID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	r.logger.Info("Other sessions revoked", map[string]interface{}{"user_id": user.ID, "revoked": resp.Revoked})
+	return int(resp.Revoked), nil
+}
+
+// AdminRevokeSession is the resolver for the adminRevokeSession field.
+func (r *mutationResolver) AdminRevokeSession(ctx context.Context, id string) (bool, error) {
+	admin, err := requireClubAdmin(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	sessionID, err := parseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := r.clients.AuthService.AdminRevokeSession(ctx, &clients.AdminRevokeSessionRequest{
+		ClubID:    uint32(admin.ClubID),
+		SessionID: sessionID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	r.logger.Info("Session revoked by admin", map[string]interface{}{"admin_id": admin.ID, "session_id": sessionID})
+	return resp.Success, nil
+}
+
+// RevokeUserSessions is the resolver for the revokeUserSessions field.
+func (r *mutationResolver) RevokeUserSessions(ctx context.Context, userID string) (int, error) {
+	admin, err := requireClubAdmin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	targetID, err := parseID(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := r.clients.AuthService.AdminRevokeUserSessions(ctx, &clients.AdminRevokeUserSessionsRequest{
+		ClubID: uint32(admin.ClubID),
+		UserID: targetID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	r.logger.Info("User sessions revoked by admin", map[string]interface{}{"admin_id": admin.ID, "user_id": targetID, "revoked": resp.Revoked})
+	return int(resp.Revoked), nil
+}
+
 // CreateMember is the resolver for the createMember field.
 func (r *mutationResolver) CreateMember(ctx context.Context, input model.CreateMemberInput) (*model.Member, error) {
 	panic(fmt.Errorf("not implemented: CreateMember - createMember"))
@@ -165,7 +259,21 @@ func (r *mutationResolver) MarkNotificationRead(ctx context.Context, id string)
 
 // MarkAllNotificationsRead is the resolver for the markAllNotificationsRead field.
 func (r *mutationResolver) MarkAllNotificationsRead(ctx context.Context) (bool, error) {
-	panic(fmt.Errorf("not implemented: MarkAllNotificationsRead - markAllNotificationsRead"))
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return false, fmt.Errorf("authentication required")
+	}
+
+	resp, err := r.clients.NotificationService.MarkInboxRead(ctx, &clients.MarkInboxReadRequest{
+		ClubID: uint32(user.ClubID),
+		UserID: fmt.Sprintf("%d", user.ID),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	r.logger.Info("Notifications marked as read", map[string]interface{}{"user_id": user.ID, "updated": resp.Updated})
+	return true, nil
 }
 
 // CreateProposal is the resolver for the createProposal field.
@@ -205,6 +313,72 @@ func (r *queryResolver) Me(ctx context.Context) (*auth.User, error) {
 	return user, nil
 }
 
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context, currentSessionID *string) ([]*model.Session, error) {
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, fmt.Errorf("authentication required")
+	}
+
+	req := &clients.ListSessionsRequest{
+		ClubID: uint32(user.ClubID),
+		UserID: uint32(user.ID),
+	}
+	if currentSessionID != nil {
+		req.CurrentSessionToken = *currentSessionID
+	}
+
+	resp, err := r.clients.AuthService.ListSessions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSessions(resp.Sessions), nil
+}
+
+// ClubSessions is the resolver for the clubSessions field.
+func (r *queryResolver) ClubSessions(ctx context.Context, pagination *model.PaginationInput, userID *string, search *string, activeOnly *bool) (*model.SessionConnection, error) {
+	admin, err := requireClubAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := 1, 10
+	if pagination != nil {
+		if pagination.Page != nil && *pagination.Page > 0 {
+			page = *pagination.Page
+		}
+		if pagination.PageSize != nil && *pagination.PageSize > 0 {
+			pageSize = *pagination.PageSize
+		}
+	}
+
+	req := &clients.SearchSessionsRequest{
+		ClubID:     uint32(admin.ClubID),
+		ActiveOnly: activeOnly == nil || *activeOnly,
+		Limit:      int32(pageSize),
+		Offset:     int32((page - 1) * pageSize),
+	}
+	if userID != nil {
+		if req.UserID, err = parseID(*userID); err != nil {
+			return nil, err
+		}
+	}
+	if search != nil {
+		req.Query = *search
+	}
+
+	resp, err := r.clients.AuthService.SearchSessions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SessionConnection{
+		Nodes:    convertSessions(resp.Sessions),
+		PageInfo: newPageInfo(page, pageSize, int(resp.Total)),
+	}, nil
+}
+
 // Members is the resolver for the members field.
 func (r *queryResolver) Members(ctx context.Context, pagination *model.PaginationInput, status *model.MemberStatus) (*model.MemberConnection, error) {
 	panic(fmt.Errorf("not implemented: Members - members"))
@@ -272,12 +446,68 @@ func (r *queryResolver) Notification(ctx context.Context, id string) (*model.Not
 
 // UnreadNotificationCount is the resolver for the unreadNotificationCount field.
 func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int, error) {
-	panic(fmt.Errorf("not implemented: UnreadNotificationCount - unreadNotificationCount"))
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return 0, fmt.Errorf("authentication required")
+	}
+
+	resp, err := r.clients.NotificationService.GetUnreadCount(ctx, &clients.GetUnreadCountRequest{
+		ClubID: uint32(user.ClubID),
+		UserID: fmt.Sprintf("%d", user.ID),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.UnreadCount), nil
 }
 
 // Analytics is the resolver for the analytics field.
 func (r *queryResolver) Analytics(ctx context.Context, startDate *time.Time, endDate *time.Time) (*model.Analytics, error) {
-	panic(fmt.Errorf("not implemented: Analytics - analytics"))
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, fmt.Errorf("authentication required")
+	}
+
+	// Without dates the last 90 days are reported
+	end := time.Now()
+	if endDate != nil {
+		end = *endDate
+	}
+	start := end.AddDate(0, 0, -90)
+	if startDate != nil {
+		start = *startDate
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("endDate must be after startDate")
+	}
+
+	clubID := uint32(user.ClubID)
+	network, err := r.clients.AnalyticsService.GetNetworkAnalytics(ctx, &clients.GetNetworkAnalyticsRequest{
+		ClubID:    clubID,
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := r.clients.MemberService.GetMemberAnalytics(ctx, &clients.GetMemberAnalyticsRequest{ClubID: clubID})
+	if err != nil {
+		return nil, err
+	}
+
+	agreements, err := r.clients.ReciprocalService.ListAgreements(ctx, &clients.ListAgreementsRequest{ClubID: clubID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Analytics{
+		Visits:      convertVisitAnalytics(clubID, network),
+		Members:     convertMemberAnalytics(members),
+		Reciprocals: convertReciprocalAnalytics(agreements, network),
+		Network:     convertNetworkAnalytics(network),
+	}, nil
 }
 
 // Proposals is the resolver for the proposals field.
@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"reciprocal-clubs-backend/pkg/shared/auth"
+	"reciprocal-clubs-backend/services/api-gateway/graph/model"
+	"reciprocal-clubs-backend/services/api-gateway/internal/clients"
+)
+
+// requireClubAdmin returns the authenticated user if they may manage other users' sessions
+func requireClubAdmin(ctx context.Context) (*auth.User, error) {
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, fmt.Errorf("authentication required")
+	}
+	for _, role := range user.Roles {
+		if role == "admin" {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("admin role required")
+}
+
+func parseID(id string) (uint32, error) {
+	parsed, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id: %s", id)
+	}
+	return uint32(parsed), nil
+}
+
+func convertSession(session *clients.SessionInfo) *model.Session {
+	result := &model.Session{
+		ID:             fmt.Sprintf("%d", session.ID),
+		UserID:         fmt.Sprintf("%d", session.UserID),
+		Device:         session.Device,
+		IPAddress:      session.IPAddress,
+		AuthProvider:   session.AuthProvider,
+		Active:         session.IsActive,
+		Current:        session.Current,
+		CreatedAt:      session.CreatedAt,
+		LastActivityAt: session.LastActivityAt,
+		ExpiresAt:      session.ExpiresAt,
+	}
+	if session.UserEmail != "" {
+		result.UserEmail = &session.UserEmail
+	}
+	if session.Location != "" {
+		result.Location = &session.Location
+	}
+	return result
+}
+
+func convertSessions(sessions []*clients.SessionInfo) []*model.Session {
+	result := make([]*model.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, convertSession(session))
+	}
+	return result
+}
+
+func newPageInfo(page, pageSize, total int) *model.PageInfo {
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = (total + pageSize - 1) / pageSize
+	}
+	return &model.PageInfo{
+		Page:        page,
+		PageSize:    pageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNextPage: page < totalPages,
+		HasPrevPage: page > 1,
+	}
+}
@@ -29,6 +29,14 @@ type AuthServiceClient interface {
 	// Permission methods
 	CheckPermission(ctx context.Context, req *CheckPermissionRequest) (*CheckPermissionResponse, error)
 	GetUserPermissions(ctx context.Context, req *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error)
+
+	// Session management methods
+	ListSessions(ctx context.Context, req *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeOtherSessions(ctx context.Context, req *RevokeOtherSessionsRequest) (*RevokeSessionsResponse, error)
+	SearchSessions(ctx context.Context, req *SearchSessionsRequest) (*SearchSessionsResponse, error)
+	AdminRevokeSession(ctx context.Context, req *AdminRevokeSessionRequest) (*RevokeSessionResponse, error)
+	AdminRevokeUserSessions(ctx context.Context, req *AdminRevokeUserSessionsRequest) (*RevokeSessionsResponse, error)
 }
 
 // MemberServiceClient provides member management operations
@@ -91,20 +99,27 @@ type BlockchainServiceClient interface {
 	GetBlockchainStatus(ctx context.Context, req *GetBlockchainStatusRequest) (*GetBlockchainStatusResponse, error)
 }
 
-// Placeholder interfaces for remaining services (to be implemented when those services are completed)
-
+// NotificationServiceClient provides in-app inbox operations
 type NotificationServiceClient interface {
 	Close() error
 	HealthCheck(ctx context.Context) error
-	// Methods will be added when notification service is completed
+
+	// Inbox operations
+	GetUnreadCount(ctx context.Context, req *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
+	MarkInboxRead(ctx context.Context, req *MarkInboxReadRequest) (*MarkInboxReadResponse, error)
 }
 
+// AnalyticsServiceClient provides reciprocal network analytics
 type AnalyticsServiceClient interface {
 	Close() error
 	HealthCheck(ctx context.Context) error
-	// Methods will be added when analytics service is completed
+
+	// Network analytics
+	GetNetworkAnalytics(ctx context.Context, req *GetNetworkAnalyticsRequest) (*GetNetworkAnalyticsResponse, error)
 }
 
+// Placeholder interfaces for remaining services (to be implemented when those services are completed)
+
 type GovernanceServiceClient interface {
 	Close() error
 	HealthCheck(ctx context.Context) error
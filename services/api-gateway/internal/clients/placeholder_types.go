@@ -1,6 +1,8 @@
 package clients
 
 import (
+	"time"
+
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -146,6 +148,77 @@ type GetUserPermissionsResponse struct {
 	Permissions []string
 }
 
+type SessionInfo struct {
+	ID             uint32
+	UserID         uint32
+	UserEmail      string
+	Device         string
+	IPAddress      string
+	Location       string
+	UserAgent      string
+	AuthProvider   string
+	IsActive       bool
+	Current        bool
+	CreatedAt      time.Time
+	LastActivityAt time.Time
+	ExpiresAt      time.Time
+}
+
+type ListSessionsRequest struct {
+	ClubID              uint32
+	UserID              uint32
+	CurrentSessionToken string
+}
+
+type ListSessionsResponse struct {
+	Sessions []*SessionInfo
+}
+
+type RevokeSessionRequest struct {
+	ClubID    uint32
+	UserID    uint32
+	SessionID uint32
+}
+
+type RevokeSessionResponse struct {
+	Success bool
+}
+
+type RevokeOtherSessionsRequest struct {
+	ClubID              uint32
+	UserID              uint32
+	CurrentSessionToken string
+}
+
+type RevokeSessionsResponse struct {
+	Success bool
+	Revoked int32
+}
+
+type SearchSessionsRequest struct {
+	ClubID     uint32
+	UserID     uint32
+	Query      string
+	ActiveOnly bool
+	Limit      int32
+	Offset     int32
+}
+
+type SearchSessionsResponse struct {
+	Sessions []*SessionInfo
+	Total    int32
+}
+
+type AdminRevokeSessionRequest struct {
+	ClubID    uint32
+	SessionID uint32
+}
+
+type AdminRevokeUserSessionsRequest struct {
+	ClubID uint32
+	UserID uint32
+}
+
 type HealthCheckRequest = emptypb.Empty
 type HealthCheckResponse struct {
 	Status string
@@ -441,4 +514,108 @@ type GetBlockchainStatusResponse struct {
 	Status      string
 	BlockHeight int64
 	NodeCount   int32
-}
\ No newline at end of file
+}
+
+// Notification Service Types
+type GetUnreadCountRequest struct {
+	ClubID uint32
+	UserID string
+}
+
+type GetUnreadCountResponse struct {
+	UnreadCount int64
+}
+
+// MarkInboxReadRequest marks every notification in the inbox as read when
+// NotificationIDs is empty
+type MarkInboxReadRequest struct {
+	ClubID          uint32
+	UserID          string
+	NotificationIDs []uint32
+}
+
+type MarkInboxReadResponse struct {
+	Updated     int32
+	UnreadCount int64
+}
+
+// Analytics Service Types
+
+// GetNetworkAnalyticsRequest reports on the whole network when ClubID is zero
+type GetNetworkAnalyticsRequest struct {
+	ClubID    uint32
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type GetNetworkAnalyticsResponse struct {
+	Outcomes   VisitOutcomes
+	Flows      []ClubFlow
+	Agreements []AgreementNetworkStats
+	Clubs      []ClubNetworkStats
+}
+
+type VisitOutcomes struct {
+	Visits           int32
+	Open             int32
+	Attended         int32
+	Cancelled        int32
+	NoShows          int32
+	CancellationRate float64
+	NoShowRate       float64
+}
+
+type ClubFlow struct {
+	HomeClubID     uint32
+	VisitingClubID uint32
+	Visits         int32
+	Members        int32
+	Requested      int32
+}
+
+type AgreementNetworkStats struct {
+	AgreementID       uint32
+	ProposingClubID   uint32
+	TargetClubID      uint32
+	Status            string
+	Outbound          int32
+	Inbound           int32
+	Balance           float64
+	MaxVisitsPerMonth int32
+	Utilization       *AgreementUtilization
+	Outcomes          VisitOutcomes
+	Trend             []AgreementTrendPoint
+}
+
+type AgreementUtilization struct {
+	MemberMonths  int32
+	AverageVisits float64
+	Rate          float64
+	AtLimit       int32
+}
+
+type AgreementTrendPoint struct {
+	Month    time.Time
+	Outbound int32
+	Inbound  int32
+}
+
+type ClubNetworkStats struct {
+	ClubID      uint32
+	Hosted      VisitOutcomes
+	VisitsMade  int32
+	Partners    int32
+	HostRating  *VisitRating
+	GuestRating *VisitRating
+	Facilities  []FacilityUsage
+}
+
+type VisitRating struct {
+	Average float64
+	Count   int32
+}
+
+type FacilityUsage struct {
+	Facility string
+	Visits   int32
+}
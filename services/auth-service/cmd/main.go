@@ -78,10 +78,30 @@ func main() {
 	repo := repository.NewAuthRepository(db, logger)
 
 	// Initialize service
-	authService := service.NewAuthService(repo, messageBus, cfg, logger)
+	authService, err := service.NewAuthService(repo, messageBus, cfg, logger)
+	if err != nil {
+		logger.Fatal("Failed to create auth service", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// Start idle session sweeper
+	sweeperCtx, stopSweeper := context.WithCancel(context.Background())
+	defer stopSweeper()
+	go authService.RunSessionSweeper(sweeperCtx, time.Duration(cfg.Auth.SessionSweepInterval)*time.Second)
+
+	// Start audit retention and anchoring jobs
+	go authService.RunAuditJobs(sweeperCtx,
+		time.Duration(cfg.Audit.AnchorInterval)*time.Second,
+		time.Duration(cfg.Audit.RetentionInterval)*time.Second)
 
 	// Initialize handlers
 	httpHandler := handlers.NewHTTPHandler(authService, logger, monitor)
+	if err := httpHandler.SetTrustedProxies(cfg.Service.TrustedProxies); err != nil {
+		logger.Fatal("Invalid trusted proxies", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
 	grpcHandler := handlers.NewAuthGRPCServer(authService, logger, monitor)
 
 	// Start HTTP server
@@ -130,6 +150,11 @@ func runMigrations(db *database.Database) error {
 		&models.Club{},
 		&models.UserSession{},
 		&models.AuditLog{},
+		&models.AuditChainState{},
+		&models.AuditAnchor{},
+		&models.PasskeyCredential{},
+		&models.WebAuthnSession{},
+		&models.MFAFactor{},
 	)
 }
 
@@ -0,0 +1,261 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore is an in-memory Store holding a single club's chain
+type memoryStore struct {
+	state   models.AuditChainState
+	entries []*models.AuditLog
+	anchors []*models.AuditAnchor
+}
+
+func (m *memoryStore) append(t *testing.T, action models.AuditAction, metadata map[string]interface{}) *models.AuditLog {
+	userID := uint(7)
+	entry := &models.AuditLog{
+		UserID:    &userID,
+		Action:    action,
+		Details:   "test entry",
+		IPAddress: "192.0.2.10",
+		Success:   true,
+		Metadata:  metadata,
+	}
+	entry.ClubID = m.state.ClubID
+	entry.ID = uint(len(m.entries) + 1)
+
+	head := m.state.HeadHash
+	if head == "" {
+		head = GenesisHash
+	}
+	require.NoError(t, Seal(entry, m.state.Sequence+1, head))
+
+	m.entries = append(m.entries, entry)
+	m.state.Sequence = entry.Sequence
+	m.state.HeadHash = entry.Hash
+	return entry
+}
+
+func (m *memoryStore) GetAuditChainState(ctx context.Context, clubID uint) (*models.AuditChainState, error) {
+	state := m.state
+	return &state, nil
+}
+
+func (m *memoryStore) ListAuditLogChain(ctx context.Context, clubID uint, afterSequence uint64, start, end *time.Time, limit int) ([]*models.AuditLog, error) {
+	var result []*models.AuditLog
+	for _, entry := range m.entries {
+		if entry.Sequence <= afterSequence {
+			continue
+		}
+		if start != nil && entry.CreatedAt.Before(*start) {
+			continue
+		}
+		if end != nil && entry.CreatedAt.After(*end) {
+			continue
+		}
+		// Hand out copies, as a database would
+		copied := *entry
+		result = append(result, &copied)
+		if len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryStore) ListAuditAnchors(ctx context.Context, clubID uint) ([]*models.AuditAnchor, error) {
+	return m.anchors, nil
+}
+
+func newMemoryStore(t *testing.T, entries int) *memoryStore {
+	store := &memoryStore{state: models.AuditChainState{ClubID: 1}}
+	for i := 0; i < entries; i++ {
+		store.append(t, models.AuditActionLogin, map[string]interface{}{"attempt": i, "risk": map[string]interface{}{"score": 10}})
+	}
+	return store
+}
+
+func problemKinds(report *Report) []string {
+	kinds := make([]string, 0, len(report.Problems))
+	for _, problem := range report.Problems {
+		kinds = append(kinds, problem.Kind)
+	}
+	return kinds
+}
+
+func TestSeal_LinksEntries(t *testing.T) {
+	store := newMemoryStore(t, 3)
+
+	assert.Equal(t, GenesisHash, store.entries[0].PrevHash)
+	assert.Equal(t, store.entries[0].Hash, store.entries[1].PrevHash)
+	assert.Equal(t, store.entries[1].Hash, store.entries[2].PrevHash)
+	assert.Equal(t, uint64(3), store.entries[2].Sequence)
+	assert.Len(t, store.entries[2].Hash, 64)
+
+	// Metadata decoded from storage hashes the same as the original values
+	raw, err := json.Marshal(store.entries[1].Metadata)
+	require.NoError(t, err)
+	decoded := *store.entries[1]
+	decoded.Metadata = nil
+	require.NoError(t, json.Unmarshal(raw, &decoded.Metadata))
+	hash, err := ComputeHash(&decoded)
+	require.NoError(t, err)
+	assert.Equal(t, store.entries[1].Hash, hash)
+}
+
+func TestVerifyChain(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("valid chain", func(t *testing.T) {
+		store := newMemoryStore(t, 5)
+		report, err := VerifyChain(ctx, store, 1, 2)
+		require.NoError(t, err)
+		assert.True(t, report.Valid)
+		assert.Equal(t, 5, report.Checked)
+		assert.Equal(t, uint64(1), report.FirstSequence)
+		assert.Equal(t, uint64(5), report.LastSequence)
+		assert.Empty(t, report.Problems)
+	})
+
+	t.Run("modified entry", func(t *testing.T) {
+		store := newMemoryStore(t, 5)
+		store.entries[2].Details = "rewritten"
+		report, err := VerifyChain(ctx, store, 1, 0)
+		require.NoError(t, err)
+		assert.False(t, report.Valid)
+		assert.Equal(t, []string{ProblemHashMismatch}, problemKinds(report))
+		assert.Equal(t, uint64(3), report.Problems[0].Sequence)
+	})
+
+	t.Run("deleted entry", func(t *testing.T) {
+		store := newMemoryStore(t, 5)
+		store.entries = append(store.entries[:2], store.entries[3:]...)
+		report, err := VerifyChain(ctx, store, 1, 0)
+		require.NoError(t, err)
+		assert.False(t, report.Valid)
+		assert.Equal(t, []string{ProblemGap, ProblemChainBreak}, problemKinds(report))
+	})
+
+	t.Run("truncated chain", func(t *testing.T) {
+		store := newMemoryStore(t, 5)
+		store.entries = store.entries[:4]
+		report, err := VerifyChain(ctx, store, 1, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{ProblemHeadMismatch}, problemKinds(report))
+	})
+
+	t.Run("rewritten chain differs from anchor", func(t *testing.T) {
+		store := newMemoryStore(t, 3)
+		store.anchors = []*models.AuditAnchor{{Sequence: 2, HeadHash: store.entries[1].Hash}}
+
+		// Rewrite the second entry and reseal everything after it
+		store.entries[1].Details = "rewritten"
+		require.NoError(t, Seal(store.entries[1], 2, store.entries[0].Hash))
+		require.NoError(t, Seal(store.entries[2], 3, store.entries[1].Hash))
+		store.state.HeadHash = store.entries[2].Hash
+
+		report, err := VerifyChain(ctx, store, 1, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{ProblemAnchorMismatch}, problemKinds(report))
+	})
+
+	t.Run("pruned chain", func(t *testing.T) {
+		store := newMemoryStore(t, 5)
+		store.state.PrunedSequence = 2
+		store.state.PrunedHash = store.entries[1].Hash
+		store.entries = store.entries[2:]
+		report, err := VerifyChain(ctx, store, 1, 0)
+		require.NoError(t, err)
+		assert.True(t, report.Valid)
+		assert.Equal(t, 3, report.Checked)
+		assert.Equal(t, uint64(3), report.FirstSequence)
+	})
+}
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(t, 4)
+	signer, err := GenerateSigner()
+	require.NoError(t, err)
+
+	t.Run("ndjson", func(t *testing.T) {
+		var out bytes.Buffer
+		manifest, err := Export(ctx, store, &out, &ExportRequest{ClubID: 1}, signer)
+		require.NoError(t, err)
+
+		assert.Equal(t, FormatNDJSON, manifest.Format)
+		assert.Equal(t, 4, manifest.Entries)
+		assert.Equal(t, GenesisHash, manifest.FirstPrevHash)
+		assert.Equal(t, store.state.HeadHash, manifest.LastHash)
+		require.NoError(t, VerifyManifest(manifest, out.Bytes()))
+
+		// Exported entries still verify on their own
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 4)
+		verifier := NewVerifier(0, manifest.FirstPrevHash)
+		for _, line := range lines {
+			var entry models.AuditLog
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			verifier.Add(&entry)
+		}
+		assert.Empty(t, verifier.Finish(nil))
+	})
+
+	t.Run("csv with time range", func(t *testing.T) {
+		start := store.entries[1].CreatedAt
+		end := store.entries[2].CreatedAt
+		var out bytes.Buffer
+		manifest, err := Export(ctx, store, &out, &ExportRequest{ClubID: 1, Format: FormatCSV, Start: &start, End: &end}, signer)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(2), manifest.FirstSequence)
+		assert.Equal(t, uint64(3), manifest.LastSequence)
+		assert.Equal(t, store.entries[0].Hash, manifest.FirstPrevHash)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		assert.Equal(t, strings.Join(csvHeader, ","), lines[0])
+		assert.Len(t, lines, 3)
+		require.NoError(t, VerifyManifest(manifest, out.Bytes()))
+	})
+
+	t.Run("tampered export", func(t *testing.T) {
+		var out bytes.Buffer
+		manifest, err := Export(ctx, store, &out, &ExportRequest{ClubID: 1}, signer)
+		require.NoError(t, err)
+
+		altered := bytes.Replace(out.Bytes(), []byte("192.0.2.10"), []byte("192.0.2.99"), 1)
+		assert.Error(t, VerifyManifest(manifest, altered))
+
+		manifest.Entries = 3
+		assert.Error(t, VerifyManifest(manifest, out.Bytes()))
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := Export(ctx, store, &bytes.Buffer{}, &ExportRequest{ClubID: 1, Format: "xml"}, signer)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewSigner(t *testing.T) {
+	_, err := NewSigner("not base64!")
+	assert.Error(t, err)
+
+	_, err = NewSigner("c2hvcnQ=")
+	assert.Error(t, err)
+
+	seed := "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+	first, err := NewSigner(seed)
+	require.NoError(t, err)
+	second, err := NewSigner(seed)
+	require.NoError(t, err)
+	assert.Equal(t, first.PublicKey(), second.PublicKey())
+}
@@ -0,0 +1,103 @@
+// Package audit implements the tamper-evident audit trail: a per-club hash
+// chain over audit log entries, verification of that chain, and signed
+// exports for compliance.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+)
+
+// GenesisHash is the previous hash of the first entry in every club's chain
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// canonicalEntry is the hashed representation of an audit log entry. Field
+// order is fixed; changing it invalidates every existing chain.
+type canonicalEntry struct {
+	ClubID       uint            `json:"club_id"`
+	Sequence     uint64          `json:"sequence"`
+	PrevHash     string          `json:"prev_hash"`
+	CreatedAt    string          `json:"created_at"`
+	UserID       *uint           `json:"user_id"`
+	HankoUserID  string          `json:"hanko_user_id"`
+	Action       string          `json:"action"`
+	Resource     string          `json:"resource"`
+	Details      string          `json:"details"`
+	IPAddress    string          `json:"ip_address"`
+	UserAgent    string          `json:"user_agent"`
+	Success      bool            `json:"success"`
+	ErrorMessage string          `json:"error_message"`
+	Metadata     json.RawMessage `json:"metadata"`
+}
+
+// Seal links an entry to its predecessor and computes its hash. CreatedAt is
+// normalised to microsecond UTC so the hash survives a database round trip.
+func Seal(entry *models.AuditLog, sequence uint64, prevHash string) error {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	entry.CreatedAt = entry.CreatedAt.UTC().Truncate(time.Microsecond)
+	entry.Sequence = sequence
+	entry.PrevHash = prevHash
+
+	hash, err := ComputeHash(entry)
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+	return nil
+}
+
+// ComputeHash returns the hex SHA-256 of an entry's canonical form
+func ComputeHash(entry *models.AuditLog) (string, error) {
+	metadata, err := canonicalMetadata(entry.Metadata)
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := json.Marshal(canonicalEntry{
+		ClubID:       entry.ClubID,
+		Sequence:     entry.Sequence,
+		PrevHash:     entry.PrevHash,
+		CreatedAt:    entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UserID:       entry.UserID,
+		HankoUserID:  entry.HankoUserID,
+		Action:       string(entry.Action),
+		Resource:     entry.Resource,
+		Details:      entry.Details,
+		IPAddress:    entry.IPAddress,
+		UserAgent:    entry.UserAgent,
+		Success:      entry.Success,
+		ErrorMessage: entry.ErrorMessage,
+		Metadata:     metadata,
+	})
+	if err != nil {
+		return "", fmt.Errorf("encode audit entry: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalMetadata encodes metadata as it will read back from storage:
+// numbers become float64 and nested values lose their Go types.
+func canonicalMetadata(metadata map[string]interface{}) (json.RawMessage, error) {
+	if len(metadata) == 0 {
+		return json.RawMessage("null"), nil
+	}
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("encode audit metadata: %w", err)
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, fmt.Errorf("decode audit metadata: %w", err)
+	}
+	return json.Marshal(generic)
+}
@@ -0,0 +1,249 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+)
+
+// Export formats
+const (
+	FormatNDJSON = "ndjson"
+	FormatCSV    = "csv"
+)
+
+var csvHeader = []string{
+	"sequence", "created_at", "user_id", "hanko_user_id", "action", "resource", "details",
+	"ip_address", "user_agent", "success", "error_message", "metadata", "prev_hash", "hash",
+}
+
+// ExportRequest selects the entries of a club's chain to export
+type ExportRequest struct {
+	ClubID uint
+	Format string
+	Start  *time.Time
+	End    *time.Time
+}
+
+// Manifest describes an export so auditors can check it was neither altered
+// nor truncated. FirstPrevHash and LastHash tie the export to the live chain.
+type Manifest struct {
+	ClubID        uint       `json:"club_id"`
+	Format        string     `json:"format"`
+	From          *time.Time `json:"from,omitempty"`
+	To            *time.Time `json:"to,omitempty"`
+	Entries       int        `json:"entries"`
+	FirstSequence uint64     `json:"first_sequence"`
+	LastSequence  uint64     `json:"last_sequence"`
+	FirstPrevHash string     `json:"first_prev_hash"`
+	LastHash      string     `json:"last_hash"`
+	SHA256        string     `json:"sha256"`
+	GeneratedAt   time.Time  `json:"generated_at"`
+	PublicKey     string     `json:"public_key"`
+	Signature     string     `json:"signature"`
+}
+
+// Signer signs export manifests with an Ed25519 key
+type Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewSigner creates a signer from a base64 encoded 32 byte seed
+func NewSigner(seed string) (*Signer, error) {
+	raw, err := base64.StdEncoding.DecodeString(seed)
+	if err != nil {
+		return nil, fmt.Errorf("decode audit signing key: %w", err)
+	}
+	if len(raw) != ed25519.SeedSize {
+		return nil, fmt.Errorf("audit signing key must be %d bytes, got %d", ed25519.SeedSize, len(raw))
+	}
+	return &Signer{key: ed25519.NewKeyFromSeed(raw)}, nil
+}
+
+// GenerateSigner creates a signer with a random key. Manifests it signs can
+// only be verified against the public key embedded in them.
+func GenerateSigner() (*Signer, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate audit signing key: %w", err)
+	}
+	return &Signer{key: key}, nil
+}
+
+// PublicKey returns the base64 encoded public key
+func (s *Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+// Sign sets the manifest's public key and signature
+func (s *Signer) Sign(manifest *Manifest) error {
+	manifest.PublicKey = s.PublicKey()
+	payload, err := manifestPayload(manifest)
+	if err != nil {
+		return err
+	}
+	manifest.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload))
+	return nil
+}
+
+// VerifyManifest checks the manifest signature and that data matches it
+func VerifyManifest(manifest *Manifest, data []byte) error {
+	publicKey, err := base64.StdEncoding.DecodeString(manifest.PublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid manifest public key")
+	}
+	signature, err := base64.StdEncoding.DecodeString(manifest.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid manifest signature encoding")
+	}
+
+	payload, err := manifestPayload(manifest)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(publicKey, payload, signature) {
+		return fmt.Errorf("manifest signature is invalid")
+	}
+
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != manifest.SHA256 {
+		return fmt.Errorf("export data does not match manifest digest")
+	}
+	return nil
+}
+
+// Export writes a club's chain entries to w and returns a signed manifest
+func Export(ctx context.Context, store Store, w io.Writer, req *ExportRequest, signer *Signer) (*Manifest, error) {
+	const batchSize = 1000
+
+	if req.Format == "" {
+		req.Format = FormatNDJSON
+	}
+
+	digest := sha256.New()
+	out := io.MultiWriter(w, digest)
+
+	var write func(entry *models.AuditLog) error
+	var flush func() error
+	switch req.Format {
+	case FormatNDJSON:
+		encoder := json.NewEncoder(out)
+		write = func(entry *models.AuditLog) error { return encoder.Encode(entry) }
+		flush = func() error { return nil }
+	case FormatCSV:
+		writer := csv.NewWriter(out)
+		if err := writer.Write(csvHeader); err != nil {
+			return nil, err
+		}
+		write = func(entry *models.AuditLog) error {
+			row, err := csvRow(entry)
+			if err != nil {
+				return err
+			}
+			return writer.Write(row)
+		}
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", req.Format)
+	}
+
+	manifest := &Manifest{
+		ClubID: req.ClubID,
+		Format: req.Format,
+		From:   req.Start,
+		To:     req.End,
+	}
+
+	var after uint64
+	for {
+		entries, err := store.ListAuditLogChain(ctx, req.ClubID, after, req.Start, req.End, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if manifest.Entries == 0 {
+				manifest.FirstSequence = entry.Sequence
+				manifest.FirstPrevHash = entry.PrevHash
+			}
+			// Relationships are not part of the chain
+			entry.User = nil
+			if err := write(entry); err != nil {
+				return nil, fmt.Errorf("write audit entry %d: %w", entry.Sequence, err)
+			}
+			manifest.Entries++
+			manifest.LastSequence = entry.Sequence
+			manifest.LastHash = entry.Hash
+			after = entry.Sequence
+		}
+
+		if len(entries) < batchSize {
+			break
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, fmt.Errorf("write audit export: %w", err)
+	}
+
+	manifest.SHA256 = hex.EncodeToString(digest.Sum(nil))
+	manifest.GeneratedAt = time.Now().UTC()
+	if err := signer.Sign(manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// manifestPayload is the signed form of a manifest: its JSON without the signature
+func manifestPayload(manifest *Manifest) ([]byte, error) {
+	unsigned := *manifest
+	unsigned.Signature = ""
+	payload, err := json.Marshal(unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("encode manifest: %w", err)
+	}
+	return payload, nil
+}
+
+func csvRow(entry *models.AuditLog) ([]string, error) {
+	metadata, err := canonicalMetadata(entry.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := ""
+	if entry.UserID != nil {
+		userID = strconv.FormatUint(uint64(*entry.UserID), 10)
+	}
+
+	return []string{
+		strconv.FormatUint(entry.Sequence, 10),
+		entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+		userID,
+		entry.HankoUserID,
+		string(entry.Action),
+		entry.Resource,
+		entry.Details,
+		entry.IPAddress,
+		entry.UserAgent,
+		strconv.FormatBool(entry.Success),
+		entry.ErrorMessage,
+		string(metadata),
+		entry.PrevHash,
+		entry.Hash,
+	}, nil
+}
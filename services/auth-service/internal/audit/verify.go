@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+)
+
+// Problem kinds reported by verification
+const (
+	ProblemGap            = "gap"             // sequence numbers missing
+	ProblemChainBreak     = "chain_break"     // prev_hash does not match the previous entry
+	ProblemHashMismatch   = "hash_mismatch"   // entry contents changed after sealing
+	ProblemHeadMismatch   = "head_mismatch"   // entries after the last one seen were removed
+	ProblemAnchorMismatch = "anchor_mismatch" // chain differs from an anchored head
+)
+
+// Store is the storage needed to verify and export a club's chain
+type Store interface {
+	GetAuditChainState(ctx context.Context, clubID uint) (*models.AuditChainState, error)
+	ListAuditLogChain(ctx context.Context, clubID uint, afterSequence uint64, start, end *time.Time, limit int) ([]*models.AuditLog, error)
+	ListAuditAnchors(ctx context.Context, clubID uint) ([]*models.AuditAnchor, error)
+}
+
+// Problem describes one inconsistency found in a chain
+type Problem struct {
+	Kind     string `json:"kind"`
+	Sequence uint64 `json:"sequence"`
+	EntryID  uint   `json:"entry_id,omitempty"`
+	Detail   string `json:"detail"`
+}
+
+// Report is the result of verifying a club's chain
+type Report struct {
+	ClubID        uint      `json:"club_id"`
+	Valid         bool      `json:"valid"`
+	Checked       int       `json:"checked"`
+	FirstSequence uint64    `json:"first_sequence"`
+	LastSequence  uint64    `json:"last_sequence"`
+	HeadHash      string    `json:"head_hash"`
+	Anchors       int       `json:"anchors"`
+	Problems      []Problem `json:"problems"`
+	VerifiedAt    time.Time `json:"verified_at"`
+}
+
+// Verifier checks entries in sequence order
+type Verifier struct {
+	nextSequence uint64
+	prevHash     string
+	lastSequence uint64
+	problems     []Problem
+}
+
+// NewVerifier starts verification after the given sequence and hash, which
+// are the genesis values or the last entry removed by retention
+func NewVerifier(afterSequence uint64, afterHash string) *Verifier {
+	if afterHash == "" {
+		afterHash = GenesisHash
+	}
+	return &Verifier{
+		nextSequence: afterSequence + 1,
+		prevHash:     afterHash,
+		lastSequence: afterSequence,
+	}
+}
+
+// Add checks the next entry of the chain
+func (v *Verifier) Add(entry *models.AuditLog) {
+	if entry.Sequence != v.nextSequence {
+		v.report(ProblemGap, entry, fmt.Sprintf("expected sequence %d, found %d", v.nextSequence, entry.Sequence))
+	}
+	if entry.PrevHash != v.prevHash {
+		v.report(ProblemChainBreak, entry, "previous hash does not match the preceding entry")
+	}
+	if hash, err := ComputeHash(entry); err != nil {
+		v.report(ProblemHashMismatch, entry, err.Error())
+	} else if hash != entry.Hash {
+		v.report(ProblemHashMismatch, entry, "entry contents do not match its hash")
+	}
+
+	v.nextSequence = entry.Sequence + 1
+	v.prevHash = entry.Hash
+	v.lastSequence = entry.Sequence
+}
+
+// Finish compares the last entry seen with the recorded chain head
+func (v *Verifier) Finish(state *models.AuditChainState) []Problem {
+	if state != nil && (state.Sequence != v.lastSequence || state.HeadHash != v.prevHash) {
+		v.problems = append(v.problems, Problem{
+			Kind:     ProblemHeadMismatch,
+			Sequence: state.Sequence,
+			Detail:   fmt.Sprintf("chain head is sequence %d but verification ended at %d", state.Sequence, v.lastSequence),
+		})
+	}
+	return v.problems
+}
+
+func (v *Verifier) report(kind string, entry *models.AuditLog, detail string) {
+	v.problems = append(v.problems, Problem{
+		Kind:     kind,
+		Sequence: entry.Sequence,
+		EntryID:  entry.ID,
+		Detail:   detail,
+	})
+}
+
+// VerifyChain walks a club's whole chain in batches and checks it against the
+// recorded head and every anchor still covered by retained entries
+func VerifyChain(ctx context.Context, store Store, clubID uint, batchSize int) (*Report, error) {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	state, err := store.GetAuditChainState(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	anchors, err := store.ListAuditAnchors(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	anchored := make(map[uint64]string, len(anchors))
+	for _, anchor := range anchors {
+		anchored[anchor.Sequence] = anchor.HeadHash
+	}
+
+	report := &Report{
+		ClubID:     clubID,
+		HeadHash:   state.HeadHash,
+		Anchors:    len(anchors),
+		VerifiedAt: time.Now().UTC(),
+	}
+	verifier := NewVerifier(state.PrunedSequence, state.PrunedHash)
+
+	after := state.PrunedSequence
+	for {
+		entries, err := store.ListAuditLogChain(ctx, clubID, after, nil, nil, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if report.Checked == 0 {
+				report.FirstSequence = entry.Sequence
+			}
+			report.Checked++
+			report.LastSequence = entry.Sequence
+			verifier.Add(entry)
+
+			if hash, ok := anchored[entry.Sequence]; ok && hash != entry.Hash {
+				verifier.report(ProblemAnchorMismatch, entry, "entry hash differs from the anchored chain head")
+			}
+			after = entry.Sequence
+		}
+
+		if len(entries) < batchSize {
+			break
+		}
+	}
+
+	report.Problems = verifier.Finish(state)
+	if report.Problems == nil {
+		report.Problems = []Problem{}
+	}
+	report.Valid = len(report.Problems) == 0
+	return report, nil
+}
@@ -184,20 +184,32 @@ func (s *AuthGRPCServer) InitiatePasskeyLogin(ctx context.Context, req *pb.Initi
 
 func (s *AuthGRPCServer) CompletePasskeyLogin(ctx context.Context, req *pb.CompletePasskeyLoginRequest) (*pb.CompletePasskeyLoginResponse, error) {
 	credentialMap := req.CredentialResult.AsMap()
+	ctx = withClientContext(ctx, req.IpAddress, req.UserAgent, req.DeviceFingerprint)
 
 	response, err := s.service.CompletePasskeyAuthentication(ctx, req.ClubSlug, req.UserId, credentialMap)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
 
-	return &pb.CompletePasskeyLoginResponse{
-		User:         s.convertUserToProto(response.User),
-		Token:        response.Token,
-		RefreshToken: response.RefreshToken,
-		ExpiresAt:    timestamppb.New(response.ExpiresAt),
-		Success:      true,
-		Message:      "Login successful",
-	}, nil
+	return s.convertLoginResponseToProto(response), nil
+}
+
+func (s *AuthGRPCServer) CompleteLoginChallenge(ctx context.Context, req *pb.CompleteLoginChallengeRequest) (*pb.CompletePasskeyLoginResponse, error) {
+	ctx = withClientContext(ctx, req.IpAddress, req.UserAgent, req.DeviceFingerprint)
+
+	response, err := s.service.CompleteMFAChallenge(ctx, &service.MFAChallengeRequest{
+		ClubSlug:         req.ClubSlug,
+		HankoUserID:      req.UserId,
+		Challenge:        req.Challenge,
+		Code:             req.Code,
+		Method:           req.Method,
+		CredentialResult: req.CredentialResult.AsMap(),
+	})
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convertLoginResponseToProto(response), nil
 }
 
 func (s *AuthGRPCServer) InitiatePasskeyRegistration(ctx context.Context, req *pb.InitiatePasskeyRegistrationRequest) (*pb.InitiatePasskeyRegistrationResponse, error) {
@@ -220,7 +232,15 @@ func (s *AuthGRPCServer) InitiatePasskeyRegistration(ctx context.Context, req *p
 }
 
 func (s *AuthGRPCServer) CompletePasskeyRegistration(ctx context.Context, req *pb.CompletePasskeyRegistrationRequest) (*pb.CompletePasskeyRegistrationResponse, error) {
-	// This would require implementing the complete passkey registration in the service
+	_, err := s.service.CompletePasskeyRegistration(ctx, &service.PasskeyRegistrationCompleteRequest{
+		UserID:           uint(req.UserId),
+		ClubID:           uint(req.ClubId),
+		CredentialResult: req.CredentialResult.AsMap(),
+	})
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
 	return &pb.CompletePasskeyRegistrationResponse{
 		Success: true,
 		Message: "Passkey registration completed",
@@ -255,6 +275,84 @@ func (s *AuthGRPCServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb
 	}, nil
 }
 
+// Session and Device Management
+
+func (s *AuthGRPCServer) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	sessions, err := s.service.ListUserSessions(ctx, uint(req.ClubId), uint(req.UserId), req.CurrentSessionToken)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.ListSessionsResponse{
+		Sessions: s.convertSessionsToProto(sessions),
+	}, nil
+}
+
+func (s *AuthGRPCServer) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if err := s.service.RevokeSession(ctx, uint(req.ClubId), uint(req.UserId), uint(req.SessionId)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.RevokeSessionResponse{
+		Success: true,
+		Message: "Session revoked",
+	}, nil
+}
+
+func (s *AuthGRPCServer) RevokeOtherSessions(ctx context.Context, req *pb.RevokeOtherSessionsRequest) (*pb.RevokeSessionsResponse, error) {
+	revoked, err := s.service.RevokeOtherSessions(ctx, uint(req.ClubId), uint(req.UserId), req.CurrentSessionToken)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.RevokeSessionsResponse{
+		Success: true,
+		Revoked: int32(revoked),
+	}, nil
+}
+
+func (s *AuthGRPCServer) SearchSessions(ctx context.Context, req *pb.SearchSessionsRequest) (*pb.SearchSessionsResponse, error) {
+	result, err := s.service.SearchSessions(ctx, &service.SessionSearchRequest{
+		ClubID:     uint(req.ClubId),
+		UserID:     uint(req.UserId),
+		Query:      req.Query,
+		ActiveOnly: req.ActiveOnly,
+		Offset:     int(req.Offset),
+		Limit:      int(req.Limit),
+	})
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.SearchSessionsResponse{
+		Sessions: s.convertSessionsToProto(result.Sessions),
+		Total:    int32(result.Total),
+	}, nil
+}
+
+func (s *AuthGRPCServer) AdminRevokeSession(ctx context.Context, req *pb.AdminRevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if err := s.service.AdminRevokeSession(ctx, uint(req.ClubId), uint(req.SessionId)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.RevokeSessionResponse{
+		Success: true,
+		Message: "Session revoked",
+	}, nil
+}
+
+func (s *AuthGRPCServer) AdminRevokeUserSessions(ctx context.Context, req *pb.AdminRevokeUserSessionsRequest) (*pb.RevokeSessionsResponse, error) {
+	revoked, err := s.service.AdminRevokeUserSessions(ctx, uint(req.ClubId), uint(req.UserId))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.RevokeSessionsResponse{
+		Success: true,
+		Revoked: int32(revoked),
+	}, nil
+}
+
 // Role and Permission Management (placeholder implementations)
 
 func (s *AuthGRPCServer) AssignRole(ctx context.Context, req *pb.AssignRoleRequest) (*pb.AssignRoleResponse, error) {
@@ -351,9 +449,11 @@ func (s *AuthGRPCServer) GetAuditLogs(ctx context.Context, req *pb.GetAuditLogsR
 
 func (s *AuthGRPCServer) SetupMFA(ctx context.Context, req *pb.SetupMFARequest) (*pb.SetupMFAResponse, error) {
 	serviceReq := &service.MFASetupRequest{
-		UserID: uint(req.UserId),
-		ClubID: uint(req.ClubId),
-		Method: req.Method,
+		UserID:     uint(req.UserId),
+		ClubID:     uint(req.ClubId),
+		Method:     req.Method,
+		Name:       req.Name,
+		Attachment: req.Attachment,
 	}
 
 	response, err := s.service.SetupMFA(ctx, serviceReq)
@@ -361,9 +461,16 @@ func (s *AuthGRPCServer) SetupMFA(ctx context.Context, req *pb.SetupMFARequest)
 		return nil, s.handleError(err)
 	}
 
+	options, err := optionalStruct(response.Options)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
 	return &pb.SetupMFAResponse{
+		FactorId:    uint32(response.FactorID),
 		Secret:      response.Secret,
 		QrCodeUrl:   response.QRCodeURL,
+		Options:     options,
 		BackupCodes: response.BackupCodes,
 		Success:     response.Success,
 		Message:     response.Message,
@@ -372,10 +479,12 @@ func (s *AuthGRPCServer) SetupMFA(ctx context.Context, req *pb.SetupMFARequest)
 
 func (s *AuthGRPCServer) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.VerifyMFAResponse, error) {
 	serviceReq := &service.MFAVerifyRequest{
-		UserID: uint(req.UserId),
-		ClubID: uint(req.ClubId),
-		Code:   req.Code,
-		Method: req.Method,
+		UserID:           uint(req.UserId),
+		ClubID:           uint(req.ClubId),
+		FactorID:         uint(req.FactorId),
+		Code:             req.Code,
+		Method:           req.Method,
+		CredentialResult: req.CredentialResult.AsMap(),
 	}
 
 	response, err := s.service.VerifyMFA(ctx, serviceReq)
@@ -383,9 +492,17 @@ func (s *AuthGRPCServer) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest
 		return nil, s.handleError(err)
 	}
 
+	options, err := optionalStruct(response.Options)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
 	return &pb.VerifyMFAResponse{
-		Success: response.Success,
-		Message: response.Message,
+		Success:              response.Success,
+		Message:              response.Message,
+		Factors:              s.convertMFAFactorsToProto(response.Factors),
+		Options:              options,
+		BackupCodesRemaining: int32(response.BackupCodesRemaining),
 	}, nil
 }
 
@@ -401,6 +518,50 @@ func (s *AuthGRPCServer) DisableMFA(ctx context.Context, req *pb.DisableMFAReque
 	}, nil
 }
 
+func (s *AuthGRPCServer) ListMFAFactors(ctx context.Context, req *pb.ListMFAFactorsRequest) (*pb.ListMFAFactorsResponse, error) {
+	factors, err := s.service.ListMFAFactors(ctx, uint(req.ClubId), uint(req.UserId))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.ListMFAFactorsResponse{
+		Factors: s.convertMFAFactorsToProto(factors),
+	}, nil
+}
+
+func (s *AuthGRPCServer) SetPrimaryMFAFactor(ctx context.Context, req *pb.MFAFactorRequest) (*pb.MFAFactorResponse, error) {
+	if _, err := s.service.SetPrimaryMFAFactor(ctx, uint(req.ClubId), uint(req.UserId), uint(req.FactorId)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.MFAFactorResponse{
+		Success: true,
+		Message: "Primary MFA factor updated",
+	}, nil
+}
+
+func (s *AuthGRPCServer) RemoveMFAFactor(ctx context.Context, req *pb.MFAFactorRequest) (*pb.MFAFactorResponse, error) {
+	if err := s.service.RemoveMFAFactor(ctx, uint(req.ClubId), uint(req.UserId), uint(req.FactorId)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.MFAFactorResponse{
+		Success: true,
+		Message: "MFA factor removed",
+	}, nil
+}
+
+func (s *AuthGRPCServer) RegenerateBackupCodes(ctx context.Context, req *pb.RegenerateBackupCodesRequest) (*pb.RegenerateBackupCodesResponse, error) {
+	codes, err := s.service.RegenerateBackupCodes(ctx, uint(req.ClubId), uint(req.UserId))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &pb.RegenerateBackupCodesResponse{
+		BackupCodes: codes,
+	}, nil
+}
+
 // Password Reset Methods
 
 func (s *AuthGRPCServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
@@ -524,6 +685,97 @@ func (s *AuthGRPCServer) convertUserToProto(user *models.User) *pb.User {
 	return pbUser
 }
 
+func (s *AuthGRPCServer) convertLoginResponseToProto(response *service.AuthResponse) *pb.CompletePasskeyLoginResponse {
+	if response.MFARequired {
+		// Options come from JSON encoded WebAuthn structures, so conversion cannot fail
+		options, _ := optionalStruct(response.MFAOptions)
+		return &pb.CompletePasskeyLoginResponse{
+			User:         s.convertUserToProto(response.User),
+			ExpiresAt:    timestamppb.New(response.ExpiresAt),
+			Success:      false,
+			Message:      "Additional verification required",
+			MfaRequired:  true,
+			MfaChallenge: response.MFAChallenge,
+			MfaMethods:   response.MFAMethods,
+			MfaOptions:   options,
+		}
+	}
+
+	return &pb.CompletePasskeyLoginResponse{
+		User:                  s.convertUserToProto(response.User),
+		Token:                 response.Token,
+		RefreshToken:          response.RefreshToken,
+		ExpiresAt:             timestamppb.New(response.ExpiresAt),
+		Success:               true,
+		Message:               "Login successful",
+		MfaEnrollmentRequired: response.MFAEnrollmentRequired,
+	}
+}
+
+// withClientContext exposes client details forwarded by the gateway to the service
+func withClientContext(ctx context.Context, ipAddress, userAgent, deviceFingerprint string) context.Context {
+	if ipAddress != "" {
+		ctx = context.WithValue(ctx, "client_ip", ipAddress)
+	}
+	if userAgent != "" {
+		ctx = context.WithValue(ctx, "user_agent", userAgent)
+	}
+	if deviceFingerprint != "" {
+		ctx = context.WithValue(ctx, "device_fingerprint", deviceFingerprint)
+	}
+	return ctx
+}
+
+func (s *AuthGRPCServer) convertSessionsToProto(sessions []*service.SessionInfo) []*pb.Session {
+	result := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &pb.Session{
+			Id:             uint32(session.ID),
+			UserId:         uint32(session.UserID),
+			UserEmail:      session.UserEmail,
+			Device:         session.Device,
+			IpAddress:      session.IPAddress,
+			Location:       session.Location,
+			UserAgent:      session.UserAgent,
+			AuthProvider:   session.AuthProvider,
+			IsActive:       session.IsActive,
+			Current:        session.Current,
+			CreatedAt:      timestamppb.New(session.CreatedAt),
+			LastActivityAt: timestamppb.New(session.LastActivityAt),
+			ExpiresAt:      timestamppb.New(session.ExpiresAt),
+		})
+	}
+	return result
+}
+
+func (s *AuthGRPCServer) convertMFAFactorsToProto(factors []*models.MFAFactor) []*pb.MFAFactor {
+	result := make([]*pb.MFAFactor, 0, len(factors))
+	for _, factor := range factors {
+		pbFactor := &pb.MFAFactor{
+			Id:         uint32(factor.ID),
+			Type:       string(factor.Type),
+			Name:       factor.Name,
+			Primary:    factor.Primary,
+			Verified:   factor.Verified,
+			Attachment: factor.Attachment,
+			CreatedAt:  timestamppb.New(factor.CreatedAt),
+		}
+		if factor.LastUsedAt != nil {
+			pbFactor.LastUsedAt = timestamppb.New(*factor.LastUsedAt)
+		}
+		result = append(result, pbFactor)
+	}
+	return result
+}
+
+// optionalStruct converts WebAuthn options, leaving them unset when absent
+func optionalStruct(values map[string]interface{}) (*structpb.Struct, error) {
+	if values == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(values)
+}
+
 func (s *AuthGRPCServer) convertModelUserStatusToProto(status models.UserStatus) pb.UserStatus {
 	switch status {
 	case models.UserStatusActive:
@@ -1,9 +1,14 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +16,7 @@ import (
 	apperrors "reciprocal-clubs-backend/pkg/shared/errors"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	auditlog "reciprocal-clubs-backend/services/auth-service/internal/audit"
 	"reciprocal-clubs-backend/services/auth-service/internal/metrics"
 	"reciprocal-clubs-backend/services/auth-service/internal/middleware"
 	"reciprocal-clubs-backend/services/auth-service/internal/models"
@@ -27,6 +33,7 @@ type HTTPHandler struct {
 	authMetrics         *metrics.AuthMetrics
 	rateLimitMiddleware *middleware.RateLimitMiddleware
 	instrumentation     *middleware.InstrumentationMiddleware
+	trustedProxies      []netip.Prefix
 }
 
 // NewHTTPHandler creates a new HTTP handler
@@ -65,15 +72,28 @@ func (h *HTTPHandler) RegisterRoutes(router *mux.Router) {
 	// Authentication endpoints
 	auth := router.PathPrefix("/auth").Subrouter()
 	auth.Use(h.rateLimitAuthMiddleware)
+	auth.Use(h.clientContextMiddleware)
 	auth.HandleFunc("/register", h.register).Methods("POST")
 	auth.HandleFunc("/login/initiate", h.initiatePasskeyLogin).Methods("POST")
 	auth.HandleFunc("/login/complete", h.completePasskeyLogin).Methods("POST")
+	auth.HandleFunc("/login/mfa", h.completeMFAChallenge).Methods("POST")
 	auth.HandleFunc("/logout", h.logout).Methods("POST")
-	auth.HandleFunc("/passkey/register/initiate", h.initiatePasskeyRegistration).Methods("POST")
-	auth.HandleFunc("/passkey/register/complete", h.completePasskeyRegistration).Methods("POST")
+	auth.Handle("/passkey/register/initiate", h.authenticationMiddleware(http.HandlerFunc(h.initiatePasskeyRegistration))).Methods("POST")
+	auth.Handle("/passkey/register/complete", h.authenticationMiddleware(http.HandlerFunc(h.completePasskeyRegistration))).Methods("POST")
+	auth.HandleFunc("/passkey/recovery/initiate", h.initiatePasskeyRecovery).Methods("POST")
+	auth.HandleFunc("/passkey/recovery/complete", h.completePasskeyRecovery).Methods("POST")
+	auth.HandleFunc("/passkey/recovery/register", h.completePasskeyRecoveryRegistration).Methods("POST")
 	auth.HandleFunc("/session/validate", h.validateSession).Methods("POST")
 	auth.HandleFunc("/refresh", h.refreshToken).Methods("POST")
 
+	// Security key enrollment also accepts the enrollment-only sessions given
+	// to administrators who must register one before anything else
+	enrollment := router.PathPrefix("/users").Subrouter()
+	enrollment.Use(h.enrollmentAuthenticationMiddleware)
+	enrollment.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/mfa/factors", h.listMFAFactors).Methods("GET")
+	enrollment.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/mfa/factors", h.setupMFA).Methods("POST")
+	enrollment.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/mfa/verify", h.verifyMFA).Methods("POST")
+
 	// User management endpoints
 	users := router.PathPrefix("/users").Subrouter()
 	users.Use(h.authenticationMiddleware)
@@ -84,6 +104,16 @@ func (h *HTTPHandler) RegisterRoutes(router *mux.Router) {
 	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/activate", h.activateUser).Methods("POST")
 	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}", h.deleteUser).Methods("DELETE")
 	users.HandleFunc("/{clubId:[0-9]+}", h.listUsers).Methods("GET")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/passkeys", h.listPasskeys).Methods("GET")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/passkeys/{passkeyId:[0-9]+}", h.renamePasskey).Methods("PUT")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/passkeys/{passkeyId:[0-9]+}", h.deletePasskey).Methods("DELETE")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/mfa", h.disableMFA).Methods("DELETE")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/mfa/factors/{factorId:[0-9]+}/primary", h.setPrimaryMFAFactor).Methods("PUT")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/mfa/factors/{factorId:[0-9]+}", h.removeMFAFactor).Methods("DELETE")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/mfa/backup-codes", h.regenerateBackupCodes).Methods("POST")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/sessions", h.listSessions).Methods("GET")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/sessions/revoke-others", h.revokeOtherSessions).Methods("POST")
+	users.HandleFunc("/{clubId:[0-9]+}/{userId:[0-9]+}/sessions/{sessionId:[0-9]+}", h.revokeSession).Methods("DELETE")
 
 	// Role management endpoints
 	roles := router.PathPrefix("/roles").Subrouter()
@@ -116,6 +146,8 @@ func (h *HTTPHandler) RegisterRoutes(router *mux.Router) {
 	audit.Use(h.authenticationMiddleware)
 	audit.Use(h.adminAuthorizationMiddleware)
 	audit.HandleFunc("/{clubId:[0-9]+}/logs", h.getAuditLogs).Methods("GET")
+	audit.HandleFunc("/{clubId:[0-9]+}/verify", h.verifyAuditChain).Methods("GET")
+	audit.HandleFunc("/{clubId:[0-9]+}/export", h.exportAuditLogs).Methods("GET")
 
 	// Admin endpoints
 	admin := router.PathPrefix("/admin").Subrouter()
@@ -124,6 +156,9 @@ func (h *HTTPHandler) RegisterRoutes(router *mux.Router) {
 	admin.HandleFunc("/rate-limits", h.getRateLimitStats).Methods("GET")
 	admin.HandleFunc("/circuit-breakers", h.getCircuitBreakerStats).Methods("GET")
 	admin.HandleFunc("/circuit-breakers/reset", h.resetCircuitBreakers).Methods("POST")
+	admin.HandleFunc("/sessions/{clubId:[0-9]+}", h.searchSessions).Methods("GET")
+	admin.HandleFunc("/sessions/{clubId:[0-9]+}/{sessionId:[0-9]+}", h.adminRevokeSession).Methods("DELETE")
+	admin.HandleFunc("/sessions/{clubId:[0-9]+}/users/{userId:[0-9]+}", h.adminRevokeUserSessions).Methods("DELETE")
 
 	// Webhook endpoints
 	webhooks := router.PathPrefix("/webhooks").Subrouter()
@@ -244,9 +279,41 @@ func (h *HTTPHandler) completePasskeyLogin(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	h.logger.Info("Passkey login completed", map[string]interface{}{
+	if response.MFARequired {
+		h.logger.Info("Passkey login requires MFA step-up", map[string]interface{}{
+			"user_id": response.User.ID,
+			"methods": response.MFAMethods,
+		})
+	} else {
+		h.logger.Info("Passkey login completed", map[string]interface{}{
+			"user_id": response.User.ID,
+			"email":   response.User.Email,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *HTTPHandler) completeMFAChallenge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req service.MFAChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+
+	response, err := h.service.CompleteMFAChallenge(ctx, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.logger.Info("Stepped-up login completed", map[string]interface{}{
 		"user_id": response.User.ID,
-		"email":   response.User.Email,
+		"method":  req.Method,
 	})
 
 	w.Header().Set("Content-Type", "application/json")
@@ -300,15 +367,21 @@ func (h *HTTPHandler) initiatePasskeyRegistration(w http.ResponseWriter, r *http
 		return
 	}
 
-	response, err := h.service.InitiatePasskeyRegistration(ctx, req.UserID, req.ClubID)
+	user, err := authenticatedUser(ctx, req.ClubID, req.UserID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	response, err := h.service.InitiatePasskeyRegistration(ctx, user.ID, user.ClubID)
 	if err != nil {
 		h.handleError(w, err)
 		return
 	}
 
 	h.logger.Info("Passkey registration initiated", map[string]interface{}{
-		"user_id": req.UserID,
-		"club_id": req.ClubID,
+		"user_id": user.ID,
+		"club_id": user.ClubID,
 	})
 
 	w.Header().Set("Content-Type", "application/json")
@@ -504,7 +577,6 @@ func (h *HTTPHandler) getHTTPStatusCode(errorCode apperrors.ErrorCode) int {
 
 func (h *HTTPHandler) metricsEndpoint(w http.ResponseWriter, r *http.Request) {
 	// Return metrics in Prometheus format or JSON
-	stats := h.authMetrics.GetRegistry()
 	w.Header().Set("Content-Type", "text/plain")
 	w.WriteHeader(http.StatusOK)
 	// This would normally use promhttp.Handler() but for now return simple response
@@ -535,34 +607,109 @@ func (h *HTTPHandler) statusEndpoint(w http.ResponseWriter, r *http.Request) {
 func (h *HTTPHandler) completePasskeyRegistration(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var req struct {
-		UserID           uint                   `json:"user_id"`
-		ClubID           uint                   `json:"club_id"`
-		CredentialResult map[string]interface{} `json:"credential_result"`
-	}
-
+	var req service.PasskeyRegistrationCompleteRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
 		return
 	}
 
-	// This would call a service method to complete passkey registration
+	user, err := authenticatedUser(ctx, req.ClubID, req.UserID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	req.ClubID, req.UserID = user.ClubID, user.ID
+
+	passkey, err := h.service.CompletePasskeyRegistration(ctx, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
 	h.logger.Info("Passkey registration completed", map[string]interface{}{
 		"user_id": req.UserID,
 		"club_id": req.ClubID,
 	})
 
+	h.writeRegisteredPasskey(w, passkey)
+}
+
+func (h *HTTPHandler) completePasskeyRecoveryRegistration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req service.PasskeyRecoveryRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+
+	passkey, err := h.service.CompletePasskeyRecoveryRegistration(ctx, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeRegisteredPasskey(w, passkey)
+}
+
+// writeRegisteredPasskey returns the stored passkey, or no content when the
+// provider keeps credentials on its own side
+func (h *HTTPHandler) writeRegisteredPasskey(w http.ResponseWriter, passkey *models.PasskeyCredential) {
+	if passkey == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
 		"message": "Passkey registration completed successfully",
+		"passkey": passkey,
 	})
 }
 
-func (h *HTTPHandler) refreshToken(w http.ResponseWriter, r *http.Request) {
+func (h *HTTPHandler) initiatePasskeyRecovery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req service.PasskeyRecoveryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+
+	response, err := h.service.InitiatePasskeyRecovery(ctx, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *HTTPHandler) completePasskeyRecovery(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var req service.PasskeyRecoveryConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+
+	response, err := h.service.CompletePasskeyRecovery(ctx, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *HTTPHandler) refreshToken(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		RefreshToken string `json:"refresh_token"`
 	}
@@ -777,144 +924,634 @@ func (h *HTTPHandler) listUsers(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// Role management handlers
+// Passkey handlers
 
-func (h *HTTPHandler) createRole(w http.ResponseWriter, r *http.Request) {
+func (h *HTTPHandler) listPasskeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	vars := mux.Vars(r)
-	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
 
-	var req struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
-		IsSystem    bool   `json:"is_system"`
+	clubID, err := strconv.ParseUint(vars["clubId"], 10, 32)
+	if err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid club ID", nil, err))
+		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+	userID, err := strconv.ParseUint(vars["userId"], 10, 32)
+	if err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid user ID", nil, err))
 		return
 	}
 
-	h.logger.Info("Role created", map[string]interface{}{
-		"club_id": clubID,
-		"name":    req.Name,
-	})
+	passkeys, err := h.service.ListPasskeys(ctx, uint(clubID), uint(userID))
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"success": true,
-		"message": "Role created successfully",
+		"passkeys": passkeys,
+		"total":    len(passkeys),
 	})
 }
 
-func (h *HTTPHandler) getRoles(w http.ResponseWriter, r *http.Request) {
+func (h *HTTPHandler) renamePasskey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	vars := mux.Vars(r)
-	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
 
-	h.logger.Debug("Getting roles", map[string]interface{}{
-		"club_id": clubID,
-	})
+	clubID, userID, err := parseUserPath(vars)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	passkeyID, err := parsePathID(vars, "passkeyId", "passkey")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+
+	passkey, err := h.service.RenamePasskey(ctx, clubID, userID, passkeyID, req.Name)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"roles": []interface{}{},
-		"total": 0,
-	})
+	json.NewEncoder(w).Encode(passkey)
 }
 
-func (h *HTTPHandler) getRole(w http.ResponseWriter, r *http.Request) {
+func (h *HTTPHandler) deletePasskey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	vars := mux.Vars(r)
-	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
-	roleID, _ := strconv.ParseUint(vars["roleId"], 10, 32)
 
-	h.logger.Debug("Getting role", map[string]interface{}{
-		"club_id": clubID,
-		"role_id": roleID,
-	})
+	clubID, userID, err := parseUserPath(vars)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	passkeyID, err := parsePathID(vars, "passkeyId", "passkey")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	if err := h.service.DeletePasskey(ctx, clubID, userID, passkeyID); err != nil {
+		h.handleError(w, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":   roleID,
-		"name": "sample_role",
+		"success": true,
+		"message": "Passkey removed successfully",
 	})
 }
 
-func (h *HTTPHandler) updateRole(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
-	roleID, _ := strconv.ParseUint(vars["roleId"], 10, 32)
+// Session management handlers
 
-	h.logger.Info("Role updated", map[string]interface{}{
-		"club_id": clubID,
-		"role_id": roleID,
-	})
+func (h *HTTPHandler) listSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	// The caller's own session is only among the listed ones on their account
+	currentSessionID := ""
+	if user, ok := ctx.Value("authenticated_user").(*models.User); ok && user.ID == userID {
+		currentSessionID, _ = ctx.Value("session_id").(string)
+	}
+
+	sessions, err := h.service.ListUserSessions(ctx, clubID, userID, currentSessionID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"success": true,
-		"message": "Role updated successfully",
+		"sessions": sessions,
+		"total":    len(sessions),
 	})
 }
 
-func (h *HTTPHandler) deleteRole(w http.ResponseWriter, r *http.Request) {
+func (h *HTTPHandler) revokeSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	vars := mux.Vars(r)
-	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
-	roleID, _ := strconv.ParseUint(vars["roleId"], 10, 32)
 
-	h.logger.Info("Role deleted", map[string]interface{}{
-		"club_id": clubID,
-		"role_id": roleID,
-	})
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	sessionID, err := parsePathID(vars, "sessionId", "session")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	if err := h.service.RevokeSession(ctx, clubID, userID, sessionID); err != nil {
+		h.handleError(w, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
-		"message": "Role deleted successfully",
+		"message": "Session revoked successfully",
 	})
 }
 
-func (h *HTTPHandler) assignRole(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+func (h *HTTPHandler) revokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	var req struct {
-		UserID uint `json:"user_id"`
-		RoleID uint `json:"role_id"`
+	// Only the account owner has a session to keep; administrators revoke
+	// every session of another user through the admin routes
+	clubID, userID, err := parseUserPath(mux.Vars(r))
+	if err != nil {
+		h.handleError(w, err)
+		return
 	}
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+	if _, err := authenticatedUser(ctx, clubID, userID); err != nil {
+		h.handleError(w, err)
 		return
 	}
+	currentSessionID, _ := ctx.Value("session_id").(string)
 
-	h.logger.Info("Role assigned", map[string]interface{}{
-		"club_id": clubID,
-		"user_id": req.UserID,
-		"role_id": req.RoleID,
-	})
+	revoked, err := h.service.RevokeOtherSessions(ctx, clubID, userID, currentSessionID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
-		"message": "Role assigned successfully",
+		"revoked": revoked,
 	})
 }
 
-func (h *HTTPHandler) removeRole(w http.ResponseWriter, r *http.Request) {
+func (h *HTTPHandler) searchSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	vars := mux.Vars(r)
-	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+	query := r.URL.Query()
 
-	var req struct {
-		UserID uint `json:"user_id"`
-		RoleID uint `json:"role_id"`
+	clubID, err := parsePathID(vars, "clubId", "club")
+	if err != nil {
+		h.handleError(w, err)
+		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	req := &service.SessionSearchRequest{
+		ClubID:     clubID,
+		Query:      query.Get("q"),
+		ActiveOnly: query.Get("active") != "false",
+	}
+	if u := query.Get("user_id"); u != "" {
+		parsed, err := strconv.ParseUint(u, 10, 32)
+		if err != nil {
+			h.handleError(w, apperrors.InvalidInput("Invalid user ID", nil, err))
+			return
+		}
+		req.UserID = uint(parsed)
+	}
+	if l := query.Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil {
+			req.Limit = parsed
+		}
+	}
+	if o := query.Get("offset"); o != "" {
+		if parsed, err := strconv.Atoi(o); err == nil {
+			req.Offset = parsed
+		}
+	}
+
+	result, err := h.service.SearchSessions(ctx, req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+func (h *HTTPHandler) adminRevokeSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	clubID, err := parsePathID(vars, "clubId", "club")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	sessionID, err := parsePathID(vars, "sessionId", "session")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	if err := h.service.AdminRevokeSession(ctx, clubID, sessionID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Session revoked successfully",
+	})
+}
+
+func (h *HTTPHandler) adminRevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	clubID, err := parsePathID(vars, "clubId", "club")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	userID, err := parsePathID(vars, "userId", "user")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	revoked, err := h.service.AdminRevokeUserSessions(ctx, clubID, userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"revoked": revoked,
+	})
+}
+
+func parsePathID(vars map[string]string, key, name string) (uint, error) {
+	id, err := strconv.ParseUint(vars[key], 10, 32)
+	if err != nil {
+		return 0, apperrors.InvalidInput("Invalid "+name+" ID", nil, err)
+	}
+	return uint(id), nil
+}
+
+// MFA handlers
+
+func (h *HTTPHandler) listMFAFactors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	factors, err := h.service.ListMFAFactors(ctx, clubID, userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"factors": factors,
+		"total":   len(factors),
+	})
+}
+
+func (h *HTTPHandler) setupMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	var req service.MFASetupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+	req.ClubID = clubID
+	req.UserID = userID
+
+	response, err := h.service.SetupMFA(ctx, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if !response.Success {
+		status = http.StatusBadRequest
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *HTTPHandler) verifyMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	var req service.MFAVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+	req.ClubID = clubID
+	req.UserID = userID
+
+	response, err := h.service.VerifyMFA(ctx, &req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *HTTPHandler) setPrimaryMFAFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	factorID, err := parsePathID(vars, "factorId", "factor")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	factor, err := h.service.SetPrimaryMFAFactor(ctx, clubID, userID, factorID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(factor)
+}
+
+func (h *HTTPHandler) removeMFAFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	factorID, err := parsePathID(vars, "factorId", "factor")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	if err := h.service.RemoveMFAFactor(ctx, clubID, userID, factorID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "MFA factor removed successfully",
+	})
+}
+
+func (h *HTTPHandler) regenerateBackupCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	codes, err := h.service.RegenerateBackupCodes(ctx, clubID, userID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"backup_codes": codes,
+	})
+}
+
+func (h *HTTPHandler) disableMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clubID, userID, err := parseManagedUserPath(r)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	if err := h.service.DisableMFA(ctx, userID, clubID); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "MFA disabled successfully",
+	})
+}
+
+func parseUserPath(vars map[string]string) (uint, uint, error) {
+	clubID, err := parsePathID(vars, "clubId", "club")
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := parsePathID(vars, "userId", "user")
+	if err != nil {
+		return 0, 0, err
+	}
+	return clubID, userID, nil
+}
+
+// Role management handlers
+
+func (h *HTTPHandler) createRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		IsSystem    bool   `json:"is_system"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+
+	h.logger.Info("Role created", map[string]interface{}{
+		"club_id": clubID,
+		"name":    req.Name,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Role created successfully",
+	})
+}
+
+func (h *HTTPHandler) getRoles(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+
+	h.logger.Debug("Getting roles", map[string]interface{}{
+		"club_id": clubID,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"roles": []interface{}{},
+		"total": 0,
+	})
+}
+
+func (h *HTTPHandler) getRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+	roleID, _ := strconv.ParseUint(vars["roleId"], 10, 32)
+
+	h.logger.Debug("Getting role", map[string]interface{}{
+		"club_id": clubID,
+		"role_id": roleID,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":   roleID,
+		"name": "sample_role",
+	})
+}
+
+func (h *HTTPHandler) updateRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+	roleID, _ := strconv.ParseUint(vars["roleId"], 10, 32)
+
+	h.logger.Info("Role updated", map[string]interface{}{
+		"club_id": clubID,
+		"role_id": roleID,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Role updated successfully",
+	})
+}
+
+func (h *HTTPHandler) deleteRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+	roleID, _ := strconv.ParseUint(vars["roleId"], 10, 32)
+
+	h.logger.Info("Role deleted", map[string]interface{}{
+		"club_id": clubID,
+		"role_id": roleID,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Role deleted successfully",
+	})
+}
+
+func (h *HTTPHandler) assignRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+
+	var req struct {
+		UserID uint `json:"user_id"`
+		RoleID uint `json:"role_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
+		return
+	}
+
+	h.logger.Info("Role assigned", map[string]interface{}{
+		"club_id": clubID,
+		"user_id": req.UserID,
+		"role_id": req.RoleID,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Role assigned successfully",
+	})
+}
+
+func (h *HTTPHandler) removeRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["clubId"], 10, 32)
+
+	var req struct {
+		UserID uint `json:"user_id"`
+		RoleID uint `json:"role_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.handleError(w, apperrors.InvalidInput("Invalid request body", nil, err))
 		return
 	}
@@ -1088,6 +1725,84 @@ func (h *HTTPHandler) getAuditLogs(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (h *HTTPHandler) verifyAuditChain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	clubID, err := parsePathID(vars, "clubId", "club")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	report, err := h.service.VerifyAuditChain(ctx, clubID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
+
+// exportAuditLogs returns the export as the response body and its signed
+// manifest, JSON encoded, in the X-Audit-Manifest header
+func (h *HTTPHandler) exportAuditLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	query := r.URL.Query()
+
+	clubID, err := parsePathID(vars, "clubId", "club")
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	req := &auditlog.ExportRequest{
+		ClubID: clubID,
+		Format: query.Get("format"),
+	}
+	for param, target := range map[string]**time.Time{"start": &req.Start, "end": &req.End} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			h.handleError(w, apperrors.InvalidInput("Invalid "+param+" time, expected RFC 3339", nil, err))
+			return
+		}
+		*target = &parsed
+	}
+
+	// Buffer the export so the manifest header can precede the body
+	var body bytes.Buffer
+	manifest, err := h.service.ExportAuditLogs(ctx, &body, req)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		h.handleError(w, apperrors.Internal("Failed to encode audit manifest", nil, err))
+		return
+	}
+
+	contentType := "application/x-ndjson"
+	if manifest.Format == auditlog.FormatCSV {
+		contentType = "text/csv"
+	}
+	filename := "audit-" + strconv.FormatUint(uint64(clubID), 10) + "." + manifest.Format
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+filename+"\"")
+	w.Header().Set("X-Audit-Manifest", string(manifestJSON))
+	w.WriteHeader(http.StatusOK)
+	w.Write(body.Bytes())
+}
+
 // Admin handlers
 
 func (h *HTTPHandler) getRateLimitStats(w http.ResponseWriter, r *http.Request) {
@@ -1156,7 +1871,8 @@ func (h *HTTPHandler) corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Device-Fingerprint")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Audit-Manifest")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -1172,16 +1888,12 @@ func (h *HTTPHandler) metricsMiddleware(next http.Handler) http.Handler {
 		// Extract method-specific information for metrics
 		method := r.URL.Path
 		clubID := "unknown"
-		userID := "unknown"
 
-		// Extract IDs from path if available
+		// Extract the club from the path if available
 		if vars := mux.Vars(r); vars != nil {
 			if cid := vars["clubId"]; cid != "" {
 				clubID = cid
 			}
-			if uid := vars["userId"]; uid != "" {
-				userID = uid
-			}
 		}
 
 		// Record metrics based on endpoint
@@ -1212,40 +1924,218 @@ func (h *HTTPHandler) rateLimitAuthMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// clientContextMiddleware exposes client details to the service for auditing and risk scoring
+// SetTrustedProxies sets the proxies whose X-Forwarded-For header is
+// believed. Entries are IP addresses or CIDR ranges.
+func (h *HTTPHandler) SetTrustedProxies(proxies []string) error {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	h.trustedProxies = prefixes
+	return nil
+}
+
+func (h *HTTPHandler) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range h.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the address of the client behind a request.
+// X-Forwarded-For is only read when the peer is a trusted proxy, and then
+// from the right, so addresses prepended by the client are never used.
+func (h *HTTPHandler) clientIP(r *http.Request) string {
+	clientIP := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(clientIP); err == nil {
+		clientIP = host
+	}
+	if !h.isTrustedProxy(clientIP) {
+		return clientIP
+	}
+
+	hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			break
+		}
+		clientIP = hop
+		if !h.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return clientIP
+}
+
+func (h *HTTPHandler) clientContextMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "client_ip", h.clientIP(r))
+		ctx = context.WithValue(ctx, "user_agent", r.UserAgent())
+		// The fingerprint is client supplied, so it is only a hint to the
+		// risk engine and never proves a known device on its own
+		if fingerprint := r.Header.Get("X-Device-Fingerprint"); fingerprint != "" {
+			ctx = context.WithValue(ctx, "device_fingerprint", fingerprint)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func (h *HTTPHandler) authenticationMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Extract authorization header
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			h.handleError(w, apperrors.Unauthorized("Missing authorization header", nil))
+		token, err := bearerToken(r)
+		if err != nil {
+			h.handleError(w, err)
 			return
 		}
 
-		// Validate JWT token
-		if !strings.HasPrefix(authHeader, "Bearer ") {
-			h.handleError(w, apperrors.Unauthorized("Invalid authorization header format", nil))
+		user, session, err := h.service.AuthenticateSession(r.Context(), token)
+		if err != nil {
+			h.handleError(w, err)
 			return
 		}
 
-		token := strings.TrimPrefix(authHeader, "Bearer ")
+		ctx := context.WithValue(r.Context(), "authenticated_user", user)
+		ctx = context.WithValue(ctx, "session_token", token)
+		ctx = context.WithValue(ctx, "session_id", session.HankoSessionID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
-		// This would validate the JWT token with the auth provider
-		h.logger.Debug("Validating authentication token", map[string]interface{}{
-			"token_length": len(token),
-		})
+// enrollmentAuthenticationMiddleware also admits enrollment-only sessions,
+// which may only act on their own user
+func (h *HTTPHandler) enrollmentAuthenticationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, err := bearerToken(r)
+		if err != nil {
+			h.handleError(w, err)
+			return
+		}
 
-		// For now, just continue - in production this would validate the token
-		next.ServeHTTP(w, r)
+		user, session, err := h.service.ValidateEnrollmentSession(r.Context(), token)
+		if err != nil {
+			h.handleError(w, err)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), "authenticated_user", user)
+		ctx = context.WithValue(ctx, "session_token", token)
+		ctx = context.WithValue(ctx, "session_id", session.HankoSessionID)
+		if session.EnrollmentOnly {
+			clubID, userID, err := parseUserPath(mux.Vars(r))
+			if err != nil {
+				h.handleError(w, err)
+				return
+			}
+			if _, err := authenticatedUser(ctx, clubID, userID); err != nil {
+				h.handleError(w, err)
+				return
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+func bearerToken(r *http.Request) (string, error) {
+	// Extract authorization header
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return "", apperrors.Unauthorized("Missing authorization header", nil)
+	}
+
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return "", apperrors.Unauthorized("Invalid authorization header format", nil)
+	}
+
+	return strings.TrimPrefix(authHeader, "Bearer "), nil
+}
+
+// authenticatedUser returns the user whose session authenticated the request.
+// Club and user IDs named by the request must be that user's own.
+func authenticatedUser(ctx context.Context, clubID, userID uint) (*models.User, error) {
+	user, ok := ctx.Value("authenticated_user").(*models.User)
+	if !ok {
+		return nil, apperrors.Unauthorized("Authentication required", nil)
+	}
+	if (clubID != 0 && clubID != user.ClubID) || (userID != 0 && userID != user.ID) {
+		return nil, apperrors.Forbidden("Only your own account can be managed with this session", nil)
+	}
+	return user, nil
+}
+
+// parseManagedUserPath parses the club and user a request names and checks
+// that the authenticated user may manage that account: their own, or any
+// account in their club when they are a club administrator
+func parseManagedUserPath(r *http.Request) (uint, uint, error) {
+	clubID, userID, err := parseUserPath(mux.Vars(r))
+	if err != nil {
+		return 0, 0, err
+	}
+	user, ok := r.Context().Value("authenticated_user").(*models.User)
+	if !ok {
+		return 0, 0, apperrors.Unauthorized("Authentication required", nil)
+	}
+	if user.ID == userID && user.ClubID == clubID {
+		return clubID, userID, nil
+	}
+	if user.ClubID != clubID || !user.HasActiveRole(models.RoleAdmin) {
+		return 0, 0, apperrors.Forbidden("Only your own account can be managed with this session", nil)
+	}
+	return clubID, userID, nil
+}
+
+// adminAuthorizationMiddleware admits club administrators. A club named in
+// the path must be the administrator's own.
 func (h *HTTPHandler) adminAuthorizationMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Check if user has admin permissions
-		// This would extract user info from context and check permissions
-		h.logger.Debug("Checking admin authorization", nil)
+		user, ok := r.Context().Value("authenticated_user").(*models.User)
+		if !ok {
+			h.handleError(w, apperrors.Unauthorized("Authentication required", nil))
+			return
+		}
+
+		if !user.HasActiveRole(models.RoleAdmin) {
+			h.logger.Warn("Admin access denied", map[string]interface{}{
+				"user_id": user.ID,
+				"club_id": user.ClubID,
+				"path":    r.URL.Path,
+			})
+			h.handleError(w, apperrors.Forbidden("Administrator role required", nil))
+			return
+		}
+		if _, named := mux.Vars(r)["clubId"]; named {
+			clubID, err := parsePathID(mux.Vars(r), "clubId", "club")
+			if err != nil {
+				h.handleError(w, err)
+				return
+			}
+			if clubID != user.ClubID {
+				h.handleError(w, apperrors.Forbidden("Administrators can only manage their own club", nil))
+				return
+			}
+		}
 
-		// For now, just continue - in production this would check admin role
 		next.ServeHTTP(w, r)
 	})
 }
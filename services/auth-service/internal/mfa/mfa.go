@@ -2,9 +2,13 @@ package mfa
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base32"
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pquerna/otp"
@@ -91,17 +95,16 @@ func (m *MFAService) generateBackupCode() (string, error) {
 	return fmt.Sprintf("%s-%s", code[:4], code[4:]), nil
 }
 
-// HashBackupCode creates a hash of the backup code for storage
+// HashBackupCode creates a hash of the backup code for storage. Codes are
+// normalised first so they can be entered in either case.
 func (m *MFAService) HashBackupCode(code string) string {
-	// In production, use proper hashing like bcrypt
-	// For now, we'll store them directly (this should be changed)
-	return code
+	sum := sha256.Sum256([]byte(strings.ToUpper(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
 }
 
-// VerifyBackupCode verifies a backup code
-func (m *MFAService) VerifyBackupCode(providedCode, storedCode string) bool {
-	// In production, compare against hashed version
-	return providedCode == storedCode
+// VerifyBackupCode verifies a backup code against its stored hash
+func (m *MFAService) VerifyBackupCode(providedCode, storedHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(m.HashBackupCode(providedCode)), []byte(storedHash)) == 1
 }
 
 // GenerateSMSCode generates a 6-digit SMS verification code
@@ -25,33 +25,33 @@ const (
 func (s CircuitBreakerState) String() string {
 	switch s {
 	case StateClosed:
-		return \"closed\"
+		return "closed"
 	case StateOpen:
-		return \"open\"
+		return "open"
 	case StateHalfOpen:
-		return \"half_open\"
+		return "half_open"
 	default:
-		return \"unknown\"
+		return "unknown"
 	}
 }
 
 // CircuitBreakerConfig defines circuit breaker configuration
 type CircuitBreakerConfig struct {
-	Name                   string        `json:\"name\"`
-	FailureThreshold       int           `json:\"failure_threshold\"`
-	SuccessThreshold       int           `json:\"success_threshold\"`
-	Timeout                time.Duration `json:\"timeout\"`
-	MaxConcurrentRequests  int           `json:\"max_concurrent_requests\"`
-	RequestVolumeThreshold int           `json:\"request_volume_threshold\"`
-	SleepWindow            time.Duration `json:\"sleep_window\"`
-	ErrorPercentThreshold  int           `json:\"error_percent_threshold\"`
+	Name                   string        `json:"name"`
+	FailureThreshold       int           `json:"failure_threshold"`
+	SuccessThreshold       int           `json:"success_threshold"`
+	Timeout                time.Duration `json:"timeout"`
+	MaxConcurrentRequests  int           `json:"max_concurrent_requests"`
+	RequestVolumeThreshold int           `json:"request_volume_threshold"`
+	SleepWindow            time.Duration `json:"sleep_window"`
+	ErrorPercentThreshold  int           `json:"error_percent_threshold"`
 }
 
 // DefaultCircuitBreakerConfigs returns default circuit breaker configurations for different services
 func DefaultCircuitBreakerConfigs() map[string]*CircuitBreakerConfig {
 	return map[string]*CircuitBreakerConfig{
-		\"hanko\": {
-			Name:                   \"hanko\",
+		"hanko": {
+			Name:                   "hanko",
 			FailureThreshold:       5,
 			SuccessThreshold:       3,
 			Timeout:                30 * time.Second,
@@ -60,8 +60,8 @@ func DefaultCircuitBreakerConfigs() map[string]*CircuitBreakerConfig {
 			SleepWindow:            60 * time.Second,
 			ErrorPercentThreshold:  50,
 		},
-		\"database\": {
-			Name:                   \"database\",
+		"database": {
+			Name:                   "database",
 			FailureThreshold:       3,
 			SuccessThreshold:       2,
 			Timeout:                10 * time.Second,
@@ -70,8 +70,8 @@ func DefaultCircuitBreakerConfigs() map[string]*CircuitBreakerConfig {
 			SleepWindow:            30 * time.Second,
 			ErrorPercentThreshold:  30,
 		},
-		\"messagebus\": {
-			Name:                   \"messagebus\",
+		"messagebus": {
+			Name:                   "messagebus",
 			FailureThreshold:       10,
 			SuccessThreshold:       5,
 			Timeout:                5 * time.Second,
@@ -170,7 +170,7 @@ func (cb *CircuitBreaker) Execute(ctx context.Context, operation func(context.Co
 	case <-ctx.Done():
 		// Operation timed out
 		cb.recordTimeout()
-		return nil, status.Error(codes.DeadlineExceeded, fmt.Sprintf(\"Circuit breaker timeout for %s\", cb.name))
+		return nil, status.Error(codes.DeadlineExceeded, fmt.Sprintf("Circuit breaker timeout for %s", cb.name))
 	}
 }
 
@@ -183,10 +183,10 @@ func (cb *CircuitBreaker) ExecuteWithFallback(ctx context.Context, operation fun
 		cb.metrics.FallbackSuccess++
 		cb.mu.Unlock()
 
-		cb.logger.Warn(\"Circuit breaker executing fallback\", map[string]interface{}{
-			\"circuit_breaker\": cb.name,
-			\"error\":           err.Error(),
-			\"state\":           cb.state.String(),
+		cb.logger.Warn("Circuit breaker executing fallback", map[string]interface{}{
+			"circuit_breaker": cb.name,
+			"error":           err.Error(),
+			"state":           cb.state.String(),
 		})
 
 		fallbackResult, fallbackErr := fallback(ctx, err)
@@ -214,23 +214,23 @@ func (cb *CircuitBreaker) allowRequest() error {
 		// Check if sleep window has passed
 		if time.Since(cb.lastStateChangeTime) >= cb.config.SleepWindow {
 			cb.setState(StateHalfOpen)
-			cb.logger.Info(\"Circuit breaker transitioning to half-open\", map[string]interface{}{
-				\"circuit_breaker\": cb.name,
-				\"sleep_window\":    cb.config.SleepWindow,
+			cb.logger.Info("Circuit breaker transitioning to half-open", map[string]interface{}{
+				"circuit_breaker": cb.name,
+				"sleep_window":    cb.config.SleepWindow,
 			})
 			return nil
 		}
-		return status.Error(codes.Unavailable, fmt.Sprintf(\"Circuit breaker %s is open\", cb.name))
+		return status.Error(codes.Unavailable, fmt.Sprintf("Circuit breaker %s is open", cb.name))
 
 	case StateHalfOpen:
 		// Allow limited requests in half-open state
 		if cb.concurrentRequests >= int64(cb.config.MaxConcurrentRequests/10) { // Allow 10% of normal traffic
-			return status.Error(codes.Unavailable, fmt.Sprintf(\"Circuit breaker %s is half-open with limited capacity\", cb.name))
+			return status.Error(codes.Unavailable, fmt.Sprintf("Circuit breaker %s is half-open with limited capacity", cb.name))
 		}
 		return nil
 
 	default:
-		return status.Error(codes.Internal, \"Unknown circuit breaker state\")
+		return status.Error(codes.Internal, "Unknown circuit breaker state")
 	}
 }
 
@@ -246,14 +246,14 @@ func (cb *CircuitBreaker) recordSuccess() {
 	cb.lastSuccessTime = time.Now()
 
 	// Record external service metrics
-	cb.authMetrics.RecordHankoOperation(\"success\", \"success\", \"\")
+	cb.authMetrics.RecordHankoOperation("success", "success", "")
 
 	// Check if we should close the circuit in half-open state
 	if cb.state == StateHalfOpen && cb.successCount >= cb.config.SuccessThreshold {
 		cb.setState(StateClosed)
-		cb.logger.Info(\"Circuit breaker closed after successful operations\", map[string]interface{}{
-			\"circuit_breaker\":   cb.name,
-			\"success_threshold\": cb.config.SuccessThreshold,
+		cb.logger.Info("Circuit breaker closed after successful operations", map[string]interface{}{
+			"circuit_breaker":   cb.name,
+			"success_threshold": cb.config.SuccessThreshold,
 		})
 	}
 }
@@ -271,7 +271,7 @@ func (cb *CircuitBreaker) recordFailure(err error) {
 
 	// Record external service metrics
 	errorType := cb.categorizeError(err)
-	cb.authMetrics.RecordHankoOperation(\"failure\", \"error\", errorType)
+	cb.authMetrics.RecordHankoOperation("failure", "error", errorType)
 
 	// Check if we should open the circuit
 	cb.checkAndUpdateState()
@@ -289,7 +289,7 @@ func (cb *CircuitBreaker) recordTimeout() {
 	cb.lastFailureTime = time.Now()
 
 	// Record external service metrics
-	cb.authMetrics.RecordHankoOperation(\"timeout\", \"error\", \"timeout\")
+	cb.authMetrics.RecordHankoOperation("timeout", "error", "timeout")
 
 	// Check if we should open the circuit
 	cb.checkAndUpdateState()
@@ -308,22 +308,22 @@ func (cb *CircuitBreaker) checkAndUpdateState() {
 	// Open circuit if failure threshold or error percentage is exceeded
 	if (cb.failureCount >= cb.config.FailureThreshold || errorRate >= float64(cb.config.ErrorPercentThreshold)) && cb.state != StateOpen {
 		cb.setState(StateOpen)
-		cb.logger.Error(\"Circuit breaker opened due to failures\", map[string]interface{}{
-			\"circuit_breaker\":       cb.name,
-			\"failure_count\":         cb.failureCount,
-			\"failure_threshold\":     cb.config.FailureThreshold,
-			\"error_rate\":            errorRate,
-			\"error_threshold\":       cb.config.ErrorPercentThreshold,
-			\"total_requests\":        cb.metrics.Requests,
+		cb.logger.Error("Circuit breaker opened due to failures", map[string]interface{}{
+			"circuit_breaker":       cb.name,
+			"failure_count":         cb.failureCount,
+			"failure_threshold":     cb.config.FailureThreshold,
+			"error_rate":            errorRate,
+			"error_threshold":       cb.config.ErrorPercentThreshold,
+			"total_requests":        cb.metrics.Requests,
 		})
 
 		// Record security event for circuit opening
 		cb.authMetrics.RecordSecurityEvent(
-			\"external_service\",
-			\"circuit_breaker_opened\",
-			\"high\",
+			"external_service",
+			"circuit_breaker_opened",
+			"high",
 			cb.name,
-			\"system\",
+			"system",
 		)
 	}
 }
@@ -369,25 +369,25 @@ func (cb *CircuitBreaker) decrementConcurrentRequests() {
 // categorizeError categorizes an error for metrics
 func (cb *CircuitBreaker) categorizeError(err error) string {
 	if err == nil {
-		return \"\"
+		return ""
 	}
 
 	grpcStatus := status.Code(err)
 	switch grpcStatus {
 	case codes.DeadlineExceeded:
-		return \"timeout\"
+		return "timeout"
 	case codes.Unavailable:
-		return \"unavailable\"
+		return "unavailable"
 	case codes.Internal:
-		return \"internal\"
+		return "internal"
 	case codes.ResourceExhausted:
-		return \"resource_exhausted\"
+		return "resource_exhausted"
 	case codes.Unauthenticated:
-		return \"unauthenticated\"
+		return "unauthenticated"
 	case codes.PermissionDenied:
-		return \"permission_denied\"
+		return "permission_denied"
 	default:
-		return \"unknown\"
+		return "unknown"
 	}
 }
 
@@ -421,32 +421,32 @@ func (cb *CircuitBreaker) GetStats() map[string]interface{} {
 	}
 
 	return map[string]interface{}{
-		\"name\":                  cb.name,
-		\"state\":                 cb.state.String(),
-		\"total_requests\":        cb.metrics.Requests,
-		\"successes\":             cb.metrics.Successes,
-		\"failures\":              cb.metrics.Failures,
-		\"timeouts\":              cb.metrics.Timeouts,
-		\"success_rate\":          successRate,
-		\"error_rate\":            errorRate,
-		\"concurrent_requests\":   cb.concurrentRequests,
-		\"failure_count\":         cb.failureCount,
-		\"success_count\":         cb.successCount,
-		\"circuit_opens\":         cb.metrics.CircuitOpens,
-		\"circuit_closes\":        cb.metrics.CircuitCloses,
-		\"last_failure_time\":     cb.lastFailureTime,
-		\"last_success_time\":     cb.lastSuccessTime,
-		\"last_state_change\":     cb.lastStateChangeTime,
-		\"fallback_successes\":    cb.metrics.FallbackSuccess,
-		\"fallback_failures\":     cb.metrics.FallbackFailures,
-		\"config\": map[string]interface{}{
-			\"failure_threshold\":       cb.config.FailureThreshold,
-			\"success_threshold\":       cb.config.SuccessThreshold,
-			\"timeout\":                 cb.config.Timeout,
-			\"max_concurrent_requests\": cb.config.MaxConcurrentRequests,
-			\"request_volume_threshold\": cb.config.RequestVolumeThreshold,
-			\"sleep_window\":            cb.config.SleepWindow,
-			\"error_percent_threshold\": cb.config.ErrorPercentThreshold,
+		"name":                  cb.name,
+		"state":                 cb.state.String(),
+		"total_requests":        cb.metrics.Requests,
+		"successes":             cb.metrics.Successes,
+		"failures":              cb.metrics.Failures,
+		"timeouts":              cb.metrics.Timeouts,
+		"success_rate":          successRate,
+		"error_rate":            errorRate,
+		"concurrent_requests":   cb.concurrentRequests,
+		"failure_count":         cb.failureCount,
+		"success_count":         cb.successCount,
+		"circuit_opens":         cb.metrics.CircuitOpens,
+		"circuit_closes":        cb.metrics.CircuitCloses,
+		"last_failure_time":     cb.lastFailureTime,
+		"last_success_time":     cb.lastSuccessTime,
+		"last_state_change":     cb.lastStateChangeTime,
+		"fallback_successes":    cb.metrics.FallbackSuccess,
+		"fallback_failures":     cb.metrics.FallbackFailures,
+		"config": map[string]interface{}{
+			"failure_threshold":       cb.config.FailureThreshold,
+			"success_threshold":       cb.config.SuccessThreshold,
+			"timeout":                 cb.config.Timeout,
+			"max_concurrent_requests": cb.config.MaxConcurrentRequests,
+			"request_volume_threshold": cb.config.RequestVolumeThreshold,
+			"sleep_window":            cb.config.SleepWindow,
+			"error_percent_threshold": cb.config.ErrorPercentThreshold,
 		},
 	}
 }
@@ -462,8 +462,8 @@ func (cb *CircuitBreaker) Reset() {
 	cb.concurrentRequests = 0
 	cb.metrics = &CircuitBreakerMetrics{}
 
-	cb.logger.Info(\"Circuit breaker reset\", map[string]interface{}{
-		\"circuit_breaker\": cb.name,
+	cb.logger.Info("Circuit breaker reset", map[string]interface{}{
+		"circuit_breaker": cb.name,
 	})
 }
 
@@ -497,9 +497,9 @@ func (cbm *CircuitBreakerManager) AddCircuitBreaker(name string, config *Circuit
 	defer cbm.mu.Unlock()
 
 	cbm.circuitBreakers[name] = NewCircuitBreaker(config, cbm.authMetrics, cbm.logger)
-	cbm.logger.Info(\"Circuit breaker added\", map[string]interface{}{
-		\"name\":   name,
-		\"config\": config,
+	cbm.logger.Info("Circuit breaker added", map[string]interface{}{
+		"name":   name,
+		"config": config,
 	})
 }
 
@@ -529,6 +529,6 @@ func (cbm *CircuitBreakerManager) ResetAll() {
 
 	for name, cb := range cbm.circuitBreakers {
 		cb.Reset()
-		cbm.logger.Info(\"Circuit breaker reset\", map[string]interface{}{\"name\": name})
+		cbm.logger.Info("Circuit breaker reset", map[string]interface{}{"name": name})
 	}
 }
\ No newline at end of file
@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"strconv"
 	"time"
 
@@ -41,20 +43,20 @@ func (i *InstrumentationMiddleware) UnaryServerInterceptor() grpc.UnaryServerInt
 
 		// Extract metadata from context
 		correlationID := logging.GetCorrelationID(ctx)
-		if correlationID == \"\" {
-			correlationID = logging.GenerateCorrelationID()
-			ctx = logging.SetCorrelationID(ctx, correlationID)
+		if correlationID == "" {
+			correlationID = newCorrelationID()
+			ctx = logging.ContextWithCorrelationID(ctx, correlationID)
 		}
 
 		// Add request context to logger
 		requestLogger := i.logger.WithContext(ctx).With(map[string]interface{}{
-			\"method\":         info.FullMethod,
-			\"correlation_id\": correlationID,
-			\"start_time\":     startTime,
+			"method":         info.FullMethod,
+			"correlation_id": correlationID,
+			"start_time":     startTime,
 		})
 
-		requestLogger.Info(\"gRPC request started\", map[string]interface{}{
-			\"request_size\": estimateRequestSize(req),
+		requestLogger.Info("gRPC request started", map[string]interface{}{
+			"request_size": estimateRequestSize(req),
 		})
 
 		// Execute the handler
@@ -73,17 +75,17 @@ func (i *InstrumentationMiddleware) UnaryServerInterceptor() grpc.UnaryServerInt
 
 		// Log request completion
 		logFields := map[string]interface{}{
-			\"duration_ms\":    duration.Milliseconds(),
-			\"status\":         statusStr,
-			\"status_code\":    int(grpcStatus),
-			\"response_size\":  estimateResponseSize(resp),
+			"duration_ms":    duration.Milliseconds(),
+			"status":         statusStr,
+			"status_code":    int(grpcStatus),
+			"response_size":  estimateResponseSize(resp),
 		}
 
 		if err != nil {
-			logFields[\"error\"] = err.Error()
-			requestLogger.Error(\"gRPC request failed\", logFields)
+			logFields["error"] = err.Error()
+			requestLogger.Error("gRPC request failed", logFields)
 		} else {
-			requestLogger.Info(\"gRPC request completed\", logFields)
+			requestLogger.Info("gRPC request completed", logFields)
 		}
 
 		return resp, err
@@ -101,18 +103,18 @@ func (i *InstrumentationMiddleware) StreamServerInterceptor() grpc.StreamServerI
 
 		ctx := stream.Context()
 		correlationID := logging.GetCorrelationID(ctx)
-		if correlationID == \"\" {
-			correlationID = logging.GenerateCorrelationID()
-			ctx = logging.SetCorrelationID(ctx, correlationID)
+		if correlationID == "" {
+			correlationID = newCorrelationID()
+			ctx = logging.ContextWithCorrelationID(ctx, correlationID)
 		}
 
 		requestLogger := i.logger.WithContext(ctx).With(map[string]interface{}{
-			\"method\":         info.FullMethod,
-			\"correlation_id\": correlationID,
-			\"is_stream\":      true,
+			"method":         info.FullMethod,
+			"correlation_id": correlationID,
+			"is_stream":      true,
 		})
 
-		requestLogger.Info(\"gRPC stream started\", nil)
+		requestLogger.Info("gRPC stream started", nil)
 
 		// Execute the handler
 		err := handler(srv, stream)
@@ -126,16 +128,16 @@ func (i *InstrumentationMiddleware) StreamServerInterceptor() grpc.StreamServerI
 
 		// Log completion
 		logFields := map[string]interface{}{
-			\"duration_ms\": duration.Milliseconds(),
-			\"status\":      statusStr,
-			\"status_code\": int(grpcStatus),
+			"duration_ms": duration.Milliseconds(),
+			"status":      statusStr,
+			"status_code": int(grpcStatus),
 		}
 
 		if err != nil {
-			logFields[\"error\"] = err.Error()
-			requestLogger.Error(\"gRPC stream failed\", logFields)
+			logFields["error"] = err.Error()
+			requestLogger.Error("gRPC stream failed", logFields)
 		} else {
-			requestLogger.Info(\"gRPC stream completed\", logFields)
+			requestLogger.Info("gRPC stream completed", logFields)
 		}
 
 		return err
@@ -145,60 +147,59 @@ func (i *InstrumentationMiddleware) StreamServerInterceptor() grpc.StreamServerI
 // recordMethodSpecificMetrics records metrics specific to Auth Service methods
 func (i *InstrumentationMiddleware) recordMethodSpecificMetrics(method string, ctx context.Context, duration time.Duration, err error, req, resp interface{}) {
 	clubID := extractClubID(ctx, req)
-	result := \"success\"
+	result := "success"
 	if err != nil {
-		result = \"error\"
+		result = "error"
 	}
 
 	switch method {
-	case \"/auth.AuthService/RegisterUser\":
-		i.authMetrics.RecordUserRegistration(clubID, \"grpc\", result)
-		i.authMetrics.RecordAuthDuration(\"register\", clubID, result, duration)
+	case "/auth.AuthService/RegisterUser":
+		i.authMetrics.RecordUserRegistration(clubID, "grpc", result)
+		i.authMetrics.RecordAuthDuration("register", clubID, result, duration)
 
-	case \"/auth.AuthService/InitiatePasskeyLogin\":
-		i.authMetrics.RecordPasskeyOperation(\"initiate_login\", clubID, result, extractUserID(ctx, req))
-		i.authMetrics.RecordPasskeyDuration(\"initiate_login\", clubID, duration)
+	case "/auth.AuthService/InitiatePasskeyLogin":
+		i.authMetrics.RecordPasskeyOperation("initiate_login", clubID, result, extractUserID(ctx, req))
+		i.authMetrics.RecordPasskeyDuration("initiate_login", clubID, duration)
 
-	case \"/auth.AuthService/CompletePasskeyLogin\":
-		i.authMetrics.RecordPasskeyOperation(\"complete_login\", clubID, result, extractUserID(ctx, req))
-		i.authMetrics.RecordPasskeyDuration(\"complete_login\", clubID, duration)
-		i.authMetrics.RecordAuthDuration(\"passkey_login\", clubID, result, duration)
+	case "/auth.AuthService/CompletePasskeyLogin":
+		i.authMetrics.RecordPasskeyOperation("complete_login", clubID, result, extractUserID(ctx, req))
+		i.authMetrics.RecordPasskeyDuration("complete_login", clubID, duration)
+		i.authMetrics.RecordAuthDuration("passkey_login", clubID, result, duration)
 
 		if err == nil {
-			i.authMetrics.RecordAuthSuccess(\"passkey\", clubID, \"member\")
+			i.authMetrics.RecordAuthSuccess("passkey", clubID, "member")
 		} else {
-			i.authMetrics.RecordAuthFailure(\"passkey\", clubID, getErrorReason(err), extractUserID(ctx, req))
+			i.authMetrics.RecordAuthFailure("passkey", clubID, getErrorReason(err), extractUserID(ctx, req))
 		}
 
-	case \"/auth.AuthService/InitiatePasskeyRegistration\":
-		i.authMetrics.RecordPasskeyOperation(\"initiate_registration\", clubID, result, extractUserID(ctx, req))
-		i.authMetrics.RecordPasskeyDuration(\"initiate_registration\", clubID, duration)
+	case "/auth.AuthService/InitiatePasskeyRegistration":
+		i.authMetrics.RecordPasskeyOperation("initiate_registration", clubID, result, extractUserID(ctx, req))
+		i.authMetrics.RecordPasskeyDuration("initiate_registration", clubID, duration)
 
-	case \"/auth.AuthService/CompletePasskeyRegistration\":
-		i.authMetrics.RecordPasskeyOperation(\"complete_registration\", clubID, result, extractUserID(ctx, req))
-		i.authMetrics.RecordPasskeyDuration(\"complete_registration\", clubID, duration)
+	case "/auth.AuthService/CompletePasskeyRegistration":
+		i.authMetrics.RecordPasskeyOperation("complete_registration", clubID, result, extractUserID(ctx, req))
+		i.authMetrics.RecordPasskeyDuration("complete_registration", clubID, duration)
 
-	case \"/auth.AuthService/ValidateSession\":
-		i.authMetrics.RecordAuthDuration(\"validate_session\", clubID, result, duration)
+	case "/auth.AuthService/ValidateSession":
+		i.authMetrics.RecordAuthDuration("validate_session", clubID, result, duration)
 
-	case \"/auth.AuthService/Logout\":
-		userID := extractUserID(ctx, req)
+	case "/auth.AuthService/Logout":
 		if err == nil {
 			// Calculate session duration (would need session start time)
-			i.authMetrics.RecordSessionDuration(clubID, \"standard\", \"logout\", duration)
+			i.authMetrics.RecordSessionDuration(clubID, "standard", "logout", duration)
 		}
 
-	case \"/auth.AuthService/AssignRole\":
-		i.authMetrics.RecordRoleAssignment(clubID, extractRoleName(req), \"assign\", extractGrantedBy(ctx))
+	case "/auth.AuthService/AssignRole":
+		i.authMetrics.RecordRoleAssignment(clubID, extractRoleName(req), "assign", extractGrantedBy(ctx))
 
-	case \"/auth.AuthService/RemoveRole\":
-		i.authMetrics.RecordRoleAssignment(clubID, extractRoleName(req), \"remove\", extractGrantedBy(ctx))
+	case "/auth.AuthService/RemoveRole":
+		i.authMetrics.RecordRoleAssignment(clubID, extractRoleName(req), "remove", extractGrantedBy(ctx))
 
-	case \"/auth.AuthService/GetUserPermissions\":
-		i.authMetrics.RecordPermissionCheck(clubID, \"*\", \"user_permissions\", result)
+	case "/auth.AuthService/GetUserPermissions":
+		i.authMetrics.RecordPermissionCheck(clubID, "*", "user_permissions", result)
 
-	case \"/auth.AuthService/GetAuditLogs\":
-		i.authMetrics.RecordAuditEvent(clubID, \"query\", \"audit_logs\", extractUserID(ctx, req), result)
+	case "/auth.AuthService/GetAuditLogs":
+		i.authMetrics.RecordAuditEvent(clubID, "query", "audit_logs", extractUserID(ctx, req), result)
 	}
 }
 
@@ -207,7 +208,7 @@ func (i *InstrumentationMiddleware) recordMethodSpecificMetrics(method string, c
 func extractClubID(ctx context.Context, req interface{}) string {
 	// Try to extract club_id from various request types
 	// This would need to be implemented based on your specific request structures
-	if clubIDInterface := ctx.Value(\"club_id\"); clubIDInterface != nil {
+	if clubIDInterface := ctx.Value("club_id"); clubIDInterface != nil {
 		if clubID, ok := clubIDInterface.(string); ok {
 			return clubID
 		}
@@ -215,12 +216,12 @@ func extractClubID(ctx context.Context, req interface{}) string {
 			return strconv.FormatUint(uint64(clubID), 10)
 		}
 	}
-	return \"unknown\"
+	return "unknown"
 }
 
 func extractUserID(ctx context.Context, req interface{}) string {
 	// Try to extract user_id from context or request
-	if userIDInterface := ctx.Value(\"user_id\"); userIDInterface != nil {
+	if userIDInterface := ctx.Value("user_id"); userIDInterface != nil {
 		if userID, ok := userIDInterface.(string); ok {
 			return userID
 		}
@@ -228,51 +229,51 @@ func extractUserID(ctx context.Context, req interface{}) string {
 			return strconv.FormatUint(uint64(userID), 10)
 		}
 	}
-	return \"unknown\"
+	return "unknown"
 }
 
 func extractRoleName(req interface{}) string {
 	// Extract role name from role assignment requests
 	// Implementation depends on your specific request structures
-	return \"unknown\"
+	return "unknown"
 }
 
 func extractGrantedBy(ctx context.Context) string {
 	// Extract who granted the role from context
-	if grantedByInterface := ctx.Value(\"granted_by\"); grantedByInterface != nil {
+	if grantedByInterface := ctx.Value("granted_by"); grantedByInterface != nil {
 		if grantedBy, ok := grantedByInterface.(string); ok {
 			return grantedBy
 		}
 	}
-	return \"system\"
+	return "system"
 }
 
 func getErrorReason(err error) string {
 	if err == nil {
-		return \"\"
+		return ""
 	}
 
 	// Map gRPC status codes to reasons
 	grpcStatus := status.Code(err)
 	switch grpcStatus {
 	case codes.Unauthenticated:
-		return \"invalid_credentials\"
+		return "invalid_credentials"
 	case codes.PermissionDenied:
-		return \"access_denied\"
+		return "access_denied"
 	case codes.NotFound:
-		return \"user_not_found\"
+		return "user_not_found"
 	case codes.InvalidArgument:
-		return \"invalid_request\"
+		return "invalid_request"
 	case codes.FailedPrecondition:
-		return \"precondition_failed\"
+		return "precondition_failed"
 	case codes.ResourceExhausted:
-		return \"rate_limited\"
+		return "rate_limited"
 	case codes.Internal:
-		return \"internal_error\"
+		return "internal_error"
 	case codes.Unavailable:
-		return \"service_unavailable\"
+		return "service_unavailable"
 	default:
-		return \"unknown_error\"
+		return "unknown_error"
 	}
 }
 
@@ -296,22 +297,30 @@ func estimateResponseSize(resp interface{}) int {
 
 // HealthCheckMetrics records health check related metrics
 func (i *InstrumentationMiddleware) RecordHealthCheck(component string, healthy bool, duration time.Duration) {
-	status := \"healthy\"
+	status := "healthy"
 	if !healthy {
-		status = \"unhealthy\"
+		status = "unhealthy"
 	}
 
 	i.monitor.GetMetrics().HealthStatus.WithLabelValues(component, status).Set(1)
 
-	i.logger.Info(\"Health check completed\", map[string]interface{}{
-		\"component\":   component,
-		\"healthy\":     healthy,
-		\"duration_ms\": duration.Milliseconds(),
+	i.logger.Info("Health check completed", map[string]interface{}{
+		"component":   component,
+		"healthy":     healthy,
+		"duration_ms": duration.Milliseconds(),
 	})
 }
 
 // RecordStartup records service startup metrics
 func (i *InstrumentationMiddleware) RecordStartup() {
 	i.monitor.GetMetrics().ServiceUptime.Inc()
-	i.logger.Info(\"Auth service startup recorded\", nil)
-}
\ No newline at end of file
+	i.logger.Info("Auth service startup recorded", nil)
+}
+// newCorrelationID generates an ID for requests that arrive without one
+func newCorrelationID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
@@ -21,31 +21,31 @@ import (
 // RateLimitConfig defines rate limiting configuration
 type RateLimitConfig struct {
 	// Global rate limiting
-	GlobalRequestsPerSecond int           `json:\"global_requests_per_second\"`
-	GlobalBurstSize         int           `json:\"global_burst_size\"`
+	GlobalRequestsPerSecond int           `json:"global_requests_per_second"`
+	GlobalBurstSize         int           `json:"global_burst_size"`
 
 	// Per-IP rate limiting
-	IPRequestsPerSecond     int           `json:\"ip_requests_per_second\"`
-	IPBurstSize             int           `json:\"ip_burst_size\"`
-	IPCleanupInterval       time.Duration `json:\"ip_cleanup_interval\"`
+	IPRequestsPerSecond     int           `json:"ip_requests_per_second"`
+	IPBurstSize             int           `json:"ip_burst_size"`
+	IPCleanupInterval       time.Duration `json:"ip_cleanup_interval"`
 
 	// Per-user rate limiting
-	UserRequestsPerSecond   int           `json:\"user_requests_per_second\"`
-	UserBurstSize           int           `json:\"user_burst_size\"`
-	UserCleanupInterval     time.Duration `json:\"user_cleanup_interval\"`
+	UserRequestsPerSecond   int           `json:"user_requests_per_second"`
+	UserBurstSize           int           `json:"user_burst_size"`
+	UserCleanupInterval     time.Duration `json:"user_cleanup_interval"`
 
 	// Authentication specific limits
-	AuthAttemptsPerMinute   int           `json:\"auth_attempts_per_minute\"`
-	AuthBurstSize          int           `json:\"auth_burst_size\"`
-	AuthWindowDuration     time.Duration `json:\"auth_window_duration\"`
+	AuthAttemptsPerMinute   int           `json:"auth_attempts_per_minute"`
+	AuthBurstSize          int           `json:"auth_burst_size"`
+	AuthWindowDuration     time.Duration `json:"auth_window_duration"`
 
 	// Passkey specific limits
-	PasskeyOpsPerMinute     int           `json:\"passkey_ops_per_minute\"`
-	PasskeyBurstSize       int           `json:\"passkey_burst_size\"`
+	PasskeyOpsPerMinute     int           `json:"passkey_ops_per_minute"`
+	PasskeyBurstSize       int           `json:"passkey_burst_size"`
 
 	// Registration limits
-	RegistrationsPerHour    int           `json:\"registrations_per_hour\"`
-	RegistrationBurstSize  int           `json:\"registration_burst_size\"`
+	RegistrationsPerHour    int           `json:"registrations_per_hour"`
+	RegistrationBurstSize  int           `json:"registration_burst_size"`
 }
 
 // DefaultRateLimitConfig returns default rate limiting configuration
@@ -151,37 +151,37 @@ func (rl *RateLimitMiddleware) UnaryServerInterceptor() grpc.UnaryServerIntercep
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		// Check global rate limit
 		if !rl.globalLimiter.Allow() {
-			rl.authMetrics.RecordSecurityEvent(\"global\", \"rate_limit_exceeded\", \"medium\", \"global\", \"system\")
-			rl.logger.Warn(\"Global rate limit exceeded\", map[string]interface{}{
-				\"method\": info.FullMethod,
+			rl.authMetrics.RecordSecurityEvent("global", "rate_limit_exceeded", "medium", "global", "system")
+			rl.logger.Warn("Global rate limit exceeded", map[string]interface{}{
+				"method": info.FullMethod,
 			})
-			return nil, status.Error(codes.ResourceExhausted, \"Global rate limit exceeded\")
+			return nil, status.Error(codes.ResourceExhausted, "Global rate limit exceeded")
 		}
 
 		// Extract IP address
 		clientIP := rl.extractClientIP(ctx)
-		if clientIP != \"\" {
+		if clientIP != "" {
 			if !rl.checkIPRateLimit(clientIP) {
-				rl.authMetrics.RecordSecurityEvent(\"ip\", \"rate_limit_exceeded\", \"high\", clientIP, \"unknown\")
-				rl.logger.Warn(\"IP rate limit exceeded\", map[string]interface{}{
-					\"method\":    info.FullMethod,
-					\"client_ip\": clientIP,
+				rl.authMetrics.RecordSecurityEvent("ip", "rate_limit_exceeded", "high", clientIP, "unknown")
+				rl.logger.Warn("IP rate limit exceeded", map[string]interface{}{
+					"method":    info.FullMethod,
+					"client_ip": clientIP,
 				})
-				return nil, status.Error(codes.ResourceExhausted, \"IP rate limit exceeded\")
+				return nil, status.Error(codes.ResourceExhausted, "IP rate limit exceeded")
 			}
 		}
 
 		// Extract user ID if available
 		userID := rl.extractUserID(ctx)
-		if userID != \"\" {
+		if userID != "" {
 			if !rl.checkUserRateLimit(userID) {
-				rl.authMetrics.RecordSecurityEvent(\"user\", \"rate_limit_exceeded\", \"medium\", clientIP, userID)
-				rl.logger.Warn(\"User rate limit exceeded\", map[string]interface{}{
-					\"method\":    info.FullMethod,
-					\"user_id\":   userID,
-					\"client_ip\": clientIP,
+				rl.authMetrics.RecordSecurityEvent("user", "rate_limit_exceeded", "medium", clientIP, userID)
+				rl.logger.Warn("User rate limit exceeded", map[string]interface{}{
+					"method":    info.FullMethod,
+					"user_id":   userID,
+					"client_ip": clientIP,
 				})
-				return nil, status.Error(codes.ResourceExhausted, \"User rate limit exceeded\")
+				return nil, status.Error(codes.ResourceExhausted, "User rate limit exceeded")
 			}
 		}
 
@@ -227,13 +227,13 @@ func (rl *RateLimitMiddleware) checkUserRateLimit(userID string) bool {
 // checkMethodSpecificLimits checks method-specific rate limits
 func (rl *RateLimitMiddleware) checkMethodSpecificLimits(method string, ctx context.Context, clientIP, userID string) error {
 	switch method {
-	case \"/auth.AuthService/RegisterUser\":
+	case "/auth.AuthService/RegisterUser":
 		return rl.checkRegistrationLimit(clientIP)
 
-	case \"/auth.AuthService/InitiatePasskeyLogin\", \"/auth.AuthService/CompletePasskeyLogin\":
+	case "/auth.AuthService/InitiatePasskeyLogin", "/auth.AuthService/CompletePasskeyLogin":
 		return rl.checkAuthLimit(clientIP, userID)
 
-	case \"/auth.AuthService/InitiatePasskeyRegistration\", \"/auth.AuthService/CompletePasskeyRegistration\":
+	case "/auth.AuthService/InitiatePasskeyRegistration", "/auth.AuthService/CompletePasskeyRegistration":
 		return rl.checkPasskeyLimit(clientIP, userID)
 	}
 
@@ -242,8 +242,8 @@ func (rl *RateLimitMiddleware) checkMethodSpecificLimits(method string, ctx cont
 
 // checkAuthLimit checks authentication-specific rate limiting
 func (rl *RateLimitMiddleware) checkAuthLimit(clientIP, userID string) error {
-	key := fmt.Sprintf(\"%s:%s\", clientIP, userID)
-	if userID == \"\" {
+	key := fmt.Sprintf("%s:%s", clientIP, userID)
+	if userID == "" {
 		key = clientIP
 	}
 
@@ -261,13 +261,13 @@ func (rl *RateLimitMiddleware) checkAuthLimit(clientIP, userID string) error {
 	rl.authLastAccess[key] = time.Now()
 
 	if !limiter.Allow() {
-		rl.authMetrics.RecordSecurityEvent(\"auth\", \"rate_limit_exceeded\", \"high\", clientIP, userID)
-		rl.logger.Warn(\"Authentication rate limit exceeded\", map[string]interface{}{
-			\"client_ip\": clientIP,
-			\"user_id\":   userID,
-			\"key\":       key,
+		rl.authMetrics.RecordSecurityEvent("auth", "rate_limit_exceeded", "high", clientIP, userID)
+		rl.logger.Warn("Authentication rate limit exceeded", map[string]interface{}{
+			"client_ip": clientIP,
+			"user_id":   userID,
+			"key":       key,
 		})
-		return status.Error(codes.ResourceExhausted, \"Authentication rate limit exceeded\")
+		return status.Error(codes.ResourceExhausted, "Authentication rate limit exceeded")
 	}
 
 	return nil
@@ -275,8 +275,8 @@ func (rl *RateLimitMiddleware) checkAuthLimit(clientIP, userID string) error {
 
 // checkPasskeyLimit checks passkey-specific rate limiting
 func (rl *RateLimitMiddleware) checkPasskeyLimit(clientIP, userID string) error {
-	key := fmt.Sprintf(\"%s:%s\", clientIP, userID)
-	if userID == \"\" {
+	key := fmt.Sprintf("%s:%s", clientIP, userID)
+	if userID == "" {
 		key = clientIP
 	}
 
@@ -293,12 +293,12 @@ func (rl *RateLimitMiddleware) checkPasskeyLimit(clientIP, userID string) error
 	rl.passkeyLastAccess[key] = time.Now()
 
 	if !limiter.Allow() {
-		rl.authMetrics.RecordSecurityEvent(\"passkey\", \"rate_limit_exceeded\", \"medium\", clientIP, userID)
-		rl.logger.Warn(\"Passkey operation rate limit exceeded\", map[string]interface{}{
-			\"client_ip\": clientIP,
-			\"user_id\":   userID,
+		rl.authMetrics.RecordSecurityEvent("passkey", "rate_limit_exceeded", "medium", clientIP, userID)
+		rl.logger.Warn("Passkey operation rate limit exceeded", map[string]interface{}{
+			"client_ip": clientIP,
+			"user_id":   userID,
 		})
-		return status.Error(codes.ResourceExhausted, \"Passkey operation rate limit exceeded\")
+		return status.Error(codes.ResourceExhausted, "Passkey operation rate limit exceeded")
 	}
 
 	return nil
@@ -319,11 +319,11 @@ func (rl *RateLimitMiddleware) checkRegistrationLimit(clientIP string) error {
 	rl.registrationLastAccess[clientIP] = time.Now()
 
 	if !limiter.Allow() {
-		rl.authMetrics.RecordSecurityEvent(\"registration\", \"rate_limit_exceeded\", \"high\", clientIP, \"unknown\")
-		rl.logger.Warn(\"Registration rate limit exceeded\", map[string]interface{}{
-			\"client_ip\": clientIP,
+		rl.authMetrics.RecordSecurityEvent("registration", "rate_limit_exceeded", "high", clientIP, "unknown")
+		rl.logger.Warn("Registration rate limit exceeded", map[string]interface{}{
+			"client_ip": clientIP,
 		})
-		return status.Error(codes.ResourceExhausted, \"Registration rate limit exceeded\")
+		return status.Error(codes.ResourceExhausted, "Registration rate limit exceeded")
 	}
 
 	return nil
@@ -338,21 +338,21 @@ func (rl *RateLimitMiddleware) extractClientIP(ctx context.Context) string {
 
 	// Try to get IP from metadata (for proxy scenarios)
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if xForwardedFor := md.Get(\"x-forwarded-for\"); len(xForwardedFor) > 0 {
+		if xForwardedFor := md.Get("x-forwarded-for"); len(xForwardedFor) > 0 {
 			return xForwardedFor[0]
 		}
-		if xRealIP := md.Get(\"x-real-ip\"); len(xRealIP) > 0 {
+		if xRealIP := md.Get("x-real-ip"); len(xRealIP) > 0 {
 			return xRealIP[0]
 		}
 	}
 
-	return \"\"
+	return ""
 }
 
 // extractUserID extracts the user ID from the gRPC context
 func (rl *RateLimitMiddleware) extractUserID(ctx context.Context) string {
 	// Try to get user ID from context values
-	if userIDValue := ctx.Value(\"user_id\"); userIDValue != nil {
+	if userIDValue := ctx.Value("user_id"); userIDValue != nil {
 		if userID, ok := userIDValue.(string); ok {
 			return userID
 		}
@@ -363,12 +363,12 @@ func (rl *RateLimitMiddleware) extractUserID(ctx context.Context) string {
 
 	// Try to get user ID from metadata
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if userIDs := md.Get(\"user-id\"); len(userIDs) > 0 {
+		if userIDs := md.Get("user-id"); len(userIDs) > 0 {
 			return userIDs[0]
 		}
 	}
 
-	return \"\"
+	return ""
 }
 
 // cleanupRoutine periodically cleans up old rate limiters
@@ -437,12 +437,12 @@ func (rl *RateLimitMiddleware) cleanupOldLimiters() {
 	}
 	rl.registrationMutex.Unlock()
 
-	rl.logger.Debug(\"Rate limiter cleanup completed\", map[string]interface{}{
-		\"ip_limiters\":           len(rl.ipLimiters),
-		\"user_limiters\":         len(rl.userLimiters),
-		\"auth_limiters\":         len(rl.authLimiters),
-		\"passkey_limiters\":      len(rl.passkeyLimiters),
-		\"registration_limiters\": len(rl.registrationLimiters),
+	rl.logger.Debug("Rate limiter cleanup completed", map[string]interface{}{
+		"ip_limiters":           len(rl.ipLimiters),
+		"user_limiters":         len(rl.userLimiters),
+		"auth_limiters":         len(rl.authLimiters),
+		"passkey_limiters":      len(rl.passkeyLimiters),
+		"registration_limiters": len(rl.registrationLimiters),
 	})
 }
 
@@ -469,14 +469,14 @@ func (rl *RateLimitMiddleware) GetStats() map[string]interface{} {
 	rl.registrationMutex.RUnlock()
 
 	return map[string]interface{}{
-		\"active_ip_limiters\":           ipCount,
-		\"active_user_limiters\":         userCount,
-		\"active_auth_limiters\":         authCount,
-		\"active_passkey_limiters\":      passkeyCount,
-		\"active_registration_limiters\": registrationCount,
-		\"global_limit_rps\":             rl.config.GlobalRequestsPerSecond,
-		\"ip_limit_rps\":                 rl.config.IPRequestsPerSecond,
-		\"user_limit_rps\":               rl.config.UserRequestsPerSecond,
+		"active_ip_limiters":           ipCount,
+		"active_user_limiters":         userCount,
+		"active_auth_limiters":         authCount,
+		"active_passkey_limiters":      passkeyCount,
+		"active_registration_limiters": registrationCount,
+		"global_limit_rps":             rl.config.GlobalRequestsPerSecond,
+		"ip_limit_rps":                 rl.config.IPRequestsPerSecond,
+		"user_limit_rps":               rl.config.UserRequestsPerSecond,
 	}
 }
 
@@ -484,5 +484,5 @@ func (rl *RateLimitMiddleware) GetStats() map[string]interface{} {
 func (rl *RateLimitMiddleware) Close() {
 	rl.cleanupTicker.Stop()
 	close(rl.stopCleanup)
-	rl.logger.Info(\"Rate limiting middleware shut down\", nil)
+	rl.logger.Info("Rate limiting middleware shut down", nil)
 }
\ No newline at end of file
@@ -1,10 +1,13 @@
 package models
 
 import (
+	"errors"
 	"strings"
 	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/database"
+
+	"gorm.io/gorm"
 )
 
 // User represents a user in the system
@@ -93,11 +96,24 @@ type ClubSettings struct {
 	MaxVisitsPerMonth       int     `json:"max_visits_per_month" gorm:"default:10"`
 	ReciprocalFee           float64 `json:"reciprocal_fee" gorm:"default:0"`
 	EnablePasskeyAuth       bool    `json:"enable_passkey_auth" gorm:"default:true"`
+	PasskeyProvider         string  `json:"passkey_provider" gorm:"default:'hanko'"` // hanko or native
 	RequirePasskeyAuth      bool    `json:"require_passkey_auth" gorm:"default:false"`
 	SessionTimeoutMinutes   int     `json:"session_timeout_minutes" gorm:"default:480"` // 8 hours
 	MaxFailedAttempts       int     `json:"max_failed_attempts" gorm:"default:5"`
 	LockoutDurationMinutes  int     `json:"lockout_duration_minutes" gorm:"default:30"`
 	EnableAuditLogging      bool    `json:"enable_audit_logging" gorm:"default:true"`
+
+	// Risk-based authentication thresholds (0 disables a decision)
+	EnableRiskEngine        bool    `json:"enable_risk_engine" gorm:"default:true"`
+	RiskNotifyThreshold     int     `json:"risk_notify_threshold" gorm:"default:30"`
+	RiskStepUpThreshold     int     `json:"risk_step_up_threshold" gorm:"default:50"`
+	RiskBlockThreshold      int     `json:"risk_block_threshold" gorm:"default:90"`
+
+	// Audit trail retention in days (0 keeps entries forever)
+	AuditRetentionDays      int     `json:"audit_retention_days" gorm:"default:0"`
+
+	// Administrators must use a WebAuthn second factor rather than codes
+	RequirePhishingResistantAdminMFA bool `json:"require_phishing_resistant_admin_mfa" gorm:"default:false"`
 }
 
 // Role represents a user role within a club
@@ -174,11 +190,95 @@ type UserSession struct {
 	LastActivityAt  time.Time `json:"last_activity_at" gorm:"default:CURRENT_TIMESTAMP"`
 	IsActive        bool      `json:"is_active" gorm:"default:true"`
 	LogoutAt        *time.Time `json:"logout_at"`
+	AuthProvider    string    `json:"auth_provider" gorm:"default:'hanko'"`
+	DeviceFingerprint string  `json:"device_fingerprint"`
+	Location        string    `json:"location"`
+	EnrollmentOnly  bool      `json:"enrollment_only" gorm:"default:false"` // Admin without a required security key
 	
 	// Relationships
 	User User `json:"user" gorm:"foreignKey:UserID"`
 }
 
+// Passkey providers selectable per club
+const (
+	PasskeyProviderHanko  = "hanko"
+	PasskeyProviderNative = "native"
+)
+
+// PasskeyCredential represents a WebAuthn credential registered with the native relying party
+type PasskeyCredential struct {
+	database.BaseModel
+	UserID          uint       `json:"user_id" gorm:"not null;index"`
+	CredentialID    string     `json:"credential_id" gorm:"uniqueIndex;not null"` // base64url encoded
+	PublicKey       []byte     `json:"-" gorm:"not null"`                         // CBOR encoded COSE key
+	Name            string     `json:"name"`
+	AttestationType string     `json:"attestation_type"`
+	AAGUID          string     `json:"aaguid"`
+	SignCount       uint32     `json:"sign_count" gorm:"default:0"`
+	Transports      []string   `json:"transports" gorm:"serializer:json"`
+	BackupEligible  bool       `json:"backup_eligible" gorm:"default:false"`
+	BackupState     bool       `json:"backup_state" gorm:"default:false"`
+	LastUsedAt      *time.Time `json:"last_used_at"`
+
+	// Relationships
+	User User `json:"-" gorm:"foreignKey:UserID"`
+}
+
+// WebAuthnCeremony identifies the kind of in-flight WebAuthn ceremony
+type WebAuthnCeremony string
+
+const (
+	WebAuthnCeremonyRegistration   WebAuthnCeremony = "registration"
+	WebAuthnCeremonyAuthentication WebAuthnCeremony = "authentication"
+	WebAuthnCeremonyMFARegistration   WebAuthnCeremony = "mfa_registration"
+	WebAuthnCeremonyMFAAuthentication WebAuthnCeremony = "mfa_authentication"
+)
+
+// WebAuthnSession stores the challenge of an in-flight WebAuthn ceremony so
+// that any replica can complete it
+type WebAuthnSession struct {
+	database.BaseModel
+	UserID    uint             `json:"user_id" gorm:"not null;index"`
+	Ceremony  WebAuthnCeremony `json:"ceremony" gorm:"not null"`
+	Data      string           `json:"-" gorm:"type:text;not null"` // JSON encoded session data
+	ExpiresAt time.Time        `json:"expires_at"`
+	Used      bool             `json:"used" gorm:"default:false"`
+}
+
+// MFAFactorType identifies the kind of second factor
+type MFAFactorType string
+
+const (
+	MFAFactorTypeTOTP     MFAFactorType = "totp"
+	MFAFactorTypeSMS      MFAFactorType = "sms"
+	MFAFactorTypeEmail    MFAFactorType = "email"
+	MFAFactorTypeWebAuthn MFAFactorType = "webauthn" // security keys and platform authenticators
+)
+
+// MFAFactor is a second factor enrolled by a user. A factor is unverified
+// until its first successful verification; one verified factor is primary.
+type MFAFactor struct {
+	database.BaseModel
+	UserID     uint          `json:"user_id" gorm:"not null;index"`
+	Type       MFAFactorType `json:"type" gorm:"not null"`
+	Name       string        `json:"name"`
+	Primary    bool          `json:"primary" gorm:"default:false"`
+	Verified   bool          `json:"verified" gorm:"default:false"`
+	Secret     string        `json:"-"` // TOTP secret
+	LastUsedAt *time.Time    `json:"last_used_at"`
+
+	// WebAuthn credential
+	CredentialID string   `json:"credential_id,omitempty" gorm:"index"` // base64url encoded
+	PublicKey    []byte   `json:"-"`                                    // CBOR encoded COSE key
+	AAGUID       string   `json:"aaguid,omitempty"`
+	SignCount    uint32   `json:"-" gorm:"default:0"`
+	Transports   []string `json:"transports,omitempty" gorm:"serializer:json"`
+	Attachment   string   `json:"attachment,omitempty"` // platform or cross-platform
+
+	// Relationships
+	User User `json:"-" gorm:"foreignKey:UserID"`
+}
+
 // MFAToken represents MFA verification tokens and backup codes
 type MFAToken struct {
 	database.BaseModel
@@ -201,6 +301,9 @@ const (
 	MFATokenTypeVerification MFATokenType = "verification"
 	MFATokenTypeSMS          MFATokenType = "sms"
 	MFATokenTypeEmail        MFATokenType = "email"
+	MFATokenTypePasskeyRecovery   MFATokenType = "passkey_recovery"
+	MFATokenTypePasskeyEnrollment MFATokenType = "passkey_enrollment"
+	MFATokenTypeLoginChallenge    MFATokenType = "login_challenge"
 )
 
 // AuditLog represents audit trail for authentication events
@@ -217,10 +320,39 @@ type AuditLog struct {
 	ErrorMessage string            `json:"error_message" gorm:"type:text"`
 	Metadata     map[string]interface{} `json:"metadata" gorm:"serializer:json"`
 
+	// Hash chain, per club: Hash covers this entry and PrevHash
+	Sequence     uint64            `json:"sequence" gorm:"index"`
+	PrevHash     string            `json:"prev_hash" gorm:"size:64"`
+	Hash         string            `json:"hash" gorm:"size:64"`
+
 	// Relationships
 	User *User `json:"user" gorm:"foreignKey:UserID"`
 }
 
+// ErrAuditLogImmutable is returned when an audit log entry is modified after creation
+var ErrAuditLogImmutable = errors.New("audit log entries are immutable")
+
+// AuditChainState tracks the head of a club's audit hash chain. The row is
+// locked while appending so entries are sequenced without gaps.
+type AuditChainState struct {
+	ClubID           uint       `json:"club_id" gorm:"primaryKey;autoIncrement:false"`
+	Sequence         uint64     `json:"sequence"`
+	HeadHash         string     `json:"head_hash" gorm:"size:64"`
+	PrunedSequence   uint64     `json:"pruned_sequence"` // last entry removed by retention
+	PrunedHash       string     `json:"pruned_hash" gorm:"size:64"`
+	AnchoredSequence uint64     `json:"anchored_sequence"`
+	AnchoredAt       *time.Time `json:"anchored_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// AuditAnchor records a chain head submitted to blockchain-service
+type AuditAnchor struct {
+	database.BaseModel
+	Sequence uint64 `json:"sequence" gorm:"not null"`
+	HeadHash string `json:"head_hash" gorm:"size:64;not null"`
+	Subject  string `json:"subject"`
+}
+
 type AuditAction string
 
 const (
@@ -231,12 +363,17 @@ const (
 	AuditActionEmailVerification  AuditAction = "email_verification"
 	AuditActionPasskeyRegistration AuditAction = "passkey_registration"
 	AuditActionPasskeyAuthentication AuditAction = "passkey_authentication"
+	AuditActionPasskeyRemoved     AuditAction = "passkey_removed"
+	AuditActionPasskeyRecoveryRequested AuditAction = "passkey_recovery_requested"
+	AuditActionPasskeyRecoveryCompleted AuditAction = "passkey_recovery_completed"
 	AuditActionRoleAssigned       AuditAction = "role_assigned"
 	AuditActionRoleRemoved        AuditAction = "role_removed"
 	AuditActionUserSuspended      AuditAction = "user_suspended"
 	AuditActionUserActivated      AuditAction = "user_activated"
 	AuditActionAccountLocked      AuditAction = "account_locked"
 	AuditActionAccountUnlocked    AuditAction = "account_unlocked"
+	AuditActionRiskAssessment     AuditAction = "risk_assessment"
+	AuditActionSessionRevoked     AuditAction = "session_revoked"
 	AuditActionPermissionGranted  AuditAction = "permission_granted"
 	AuditActionPermissionRevoked  AuditAction = "permission_revoked"
 	// MFA Actions
@@ -244,12 +381,17 @@ const (
 	AuditActionMFADisabled        AuditAction = "mfa_disabled"
 	AuditActionMFAVerification    AuditAction = "mfa_verification"
 	AuditActionMFABackupUsed      AuditAction = "mfa_backup_used"
+	AuditActionMFAFactorRemoved   AuditAction = "mfa_factor_removed"
+	AuditActionMFABackupCodesRegenerated AuditAction = "mfa_backup_codes_regenerated"
+	AuditActionMFAPrimaryChanged  AuditAction = "mfa_primary_changed"
 	// Password Reset Actions
 	AuditActionPasswordResetRequested AuditAction = "password_reset_requested"
 	AuditActionPasswordResetCompleted AuditAction = "password_reset_completed"
 	// Email Verification Actions
 	AuditActionEmailVerificationSent AuditAction = "email_verification_sent"
 	AuditActionEmailVerificationCompleted AuditAction = "email_verification_completed"
+
+	AuditActionAuditPruned AuditAction = "audit_pruned"
 )
 
 // UserWithRoles represents a user with their roles and permissions
@@ -282,6 +424,22 @@ func (u *User) IsActive() bool {
 	return u.Status == UserStatusActive && (u.LockedUntil == nil || u.LockedUntil.Before(time.Now()))
 }
 
+// HasActiveRole reports whether the user holds one of the named roles in an
+// active, unexpired assignment. The user must be loaded with its roles.
+func (u *User) HasActiveRole(names ...string) bool {
+	for _, userRole := range u.Roles {
+		if !userRole.IsActive || (userRole.ExpiresAt != nil && userRole.ExpiresAt.Before(time.Now())) {
+			continue
+		}
+		for _, name := range names {
+			if userRole.Role.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (u *User) IsLocked() bool {
 	return u.LockedUntil != nil && u.LockedUntil.After(time.Now())
 }
@@ -342,6 +500,45 @@ func (s *UserSession) UpdateActivity() {
 	s.LastActivityAt = time.Now()
 }
 
+// IsIdle reports whether the session has had no activity for longer than timeout
+func (s *UserSession) IsIdle(timeout time.Duration) bool {
+	if timeout <= 0 || s.LastActivityAt.IsZero() {
+		return false
+	}
+	return s.LastActivityAt.Add(timeout).Before(time.Now())
+}
+
+// Methods for PasskeyCredential model
+
+func (p *PasskeyCredential) SetClubID(clubID uint) {
+	p.ClubID = clubID
+}
+
+func (p *PasskeyCredential) MarkUsed(signCount uint32, backupState bool) {
+	now := time.Now()
+	p.LastUsedAt = &now
+	p.SignCount = signCount
+	p.BackupState = backupState
+}
+
+// Methods for WebAuthnSession model
+
+func (w *WebAuthnSession) IsExpired() bool {
+	return w.ExpiresAt.Before(time.Now())
+}
+
+// Methods for MFAFactor model
+
+// IsPhishingResistant reports whether the factor is bound to the relying party origin
+func (f *MFAFactor) IsPhishingResistant() bool {
+	return f.Type == MFAFactorTypeWebAuthn
+}
+
+func (f *MFAFactor) MarkUsed() {
+	now := time.Now()
+	f.LastUsedAt = &now
+}
+
 // Methods for User model (MFA related)
 
 func (u *User) EnableMFA(secret string, backupCodes []string) {
@@ -440,6 +637,11 @@ func (a *AuditLog) SetClubID(clubID uint) {
 	a.ClubID = clubID
 }
 
+// BeforeUpdate rejects changes to chained audit entries
+func (a *AuditLog) BeforeUpdate(tx *gorm.DB) error {
+	return ErrAuditLogImmutable
+}
+
 // Helper functions for seeding default data
 
 func GetDefaultRoles() []Role {
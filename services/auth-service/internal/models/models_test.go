@@ -27,6 +27,30 @@ func TestUserStatus(t *testing.T) {
 	}
 }
 
+func TestUserHasActiveRole(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		role     UserRole
+		expected bool
+	}{
+		{"Active admin", UserRole{IsActive: true, Role: Role{Name: RoleAdmin}}, true},
+		{"Inactive admin", UserRole{IsActive: false, Role: Role{Name: RoleAdmin}}, false},
+		{"Expired admin", UserRole{IsActive: true, ExpiresAt: &past, Role: Role{Name: RoleAdmin}}, false},
+		{"Active member", UserRole{IsActive: true, Role: Role{Name: RoleMember}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &User{Roles: []UserRole{tt.role}}
+			if user.HasActiveRole(RoleAdmin) != tt.expected {
+				t.Errorf("HasActiveRole() = %v, want %v", user.HasActiveRole(RoleAdmin), tt.expected)
+			}
+		})
+	}
+}
+
 func TestUserFullName(t *testing.T) {
 	tests := []struct {
 		name      string
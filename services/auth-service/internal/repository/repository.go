@@ -2,16 +2,24 @@ package repository
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/database"
 	"reciprocal-clubs-backend/pkg/shared/errors"
 	"reciprocal-clubs-backend/pkg/shared/logging"
+	"reciprocal-clubs-backend/services/auth-service/internal/audit"
 	"reciprocal-clubs-backend/services/auth-service/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// auditChainMu serialises chain appends within the process; the chain state
+// row lock does the same across replicas on databases that support it
+var auditChainMu sync.Mutex
+
 // AuthRepository handles database operations for authentication
 type AuthRepository struct {
 	*database.BaseRepository
@@ -377,12 +385,13 @@ func (r *AuthRepository) RevokeRole(ctx context.Context, clubID, userID, roleID
 // GetUserRoles retrieves all active roles for a user
 func (r *AuthRepository) GetUserRoles(ctx context.Context, clubID, userID uint) ([]*models.Role, error) {
 	var roles []*models.Role
-	if err := r.db.WithTenant(clubID).WithContext(ctx).
+	// The tenant column is qualified because user_roles has one too
+	if err := r.db.WithContext(ctx).
 		Table("roles").
 		Select("roles.*").
 		Joins("JOIN user_roles ON roles.id = user_roles.role_id").
-		Where("user_roles.user_id = ? AND user_roles.is_active = ? AND (user_roles.expires_at IS NULL OR user_roles.expires_at > ?)",
-			userID, true, time.Now()).
+		Where("roles.club_id = ? AND user_roles.user_id = ? AND user_roles.is_active = ? AND (user_roles.expires_at IS NULL OR user_roles.expires_at > ?)",
+			clubID, userID, true, time.Now()).
 		Preload("RolePermissions.Permission").
 		Find(&roles).Error; err != nil {
 		return nil, errors.Internal("Failed to get user roles", map[string]interface{}{
@@ -491,9 +500,34 @@ func (r *AuthRepository) CleanupExpiredSessions(ctx context.Context, clubID uint
 
 // Audit log operations
 
-// CreateAuditLog creates a new audit log entry
+// CreateAuditLog appends a new audit log entry to its club's hash chain
 func (r *AuthRepository) CreateAuditLog(ctx context.Context, auditLog *models.AuditLog) error {
-	if err := r.db.WithTenant(auditLog.ClubID).WithContext(ctx).Create(auditLog).Error; err != nil {
+	auditChainMu.Lock()
+	defer auditChainMu.Unlock()
+
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		var state models.AuditChainState
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("club_id = ?", auditLog.ClubID).
+			Attrs(models.AuditChainState{ClubID: auditLog.ClubID, HeadHash: audit.GenesisHash}).
+			FirstOrCreate(&state).Error; err != nil {
+			return err
+		}
+
+		if err := audit.Seal(auditLog, state.Sequence+1, state.HeadHash); err != nil {
+			return err
+		}
+		if err := tx.Create(auditLog).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&state).Updates(map[string]interface{}{
+			"sequence":   auditLog.Sequence,
+			"head_hash":  auditLog.Hash,
+			"updated_at": time.Now(),
+		}).Error
+	})
+	if err != nil {
 		r.logger.Error("Failed to create audit log", map[string]interface{}{
 			"error":        err.Error(),
 			"action":       string(auditLog.Action),
@@ -843,6 +877,131 @@ func (r *AuthRepository) GetAuditLogsByTimeRange(ctx context.Context, clubID uin
 	return logs, total, nil
 }
 
+// GetAuditChainState retrieves the head of a club's audit chain
+func (r *AuthRepository) GetAuditChainState(ctx context.Context, clubID uint) (*models.AuditChainState, error) {
+	var state models.AuditChainState
+	if err := r.db.WithContext(ctx).Where("club_id = ?", clubID).First(&state).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// No entries have been written for this club yet
+			return &models.AuditChainState{ClubID: clubID, HeadHash: audit.GenesisHash}, nil
+		}
+		return nil, errors.Internal("Failed to get audit chain state", map[string]interface{}{
+			"club_id": clubID,
+		}, err)
+	}
+
+	return &state, nil
+}
+
+// ListAuditChainStates retrieves the chain heads of every club
+func (r *AuthRepository) ListAuditChainStates(ctx context.Context) ([]*models.AuditChainState, error) {
+	var states []*models.AuditChainState
+	if err := r.db.WithContext(ctx).Order("club_id ASC").Find(&states).Error; err != nil {
+		return nil, errors.Internal("Failed to list audit chain states", nil, err)
+	}
+
+	return states, nil
+}
+
+// ListAuditLogChain retrieves audit logs in chain order after the given
+// sequence, optionally restricted to a creation time range
+func (r *AuthRepository) ListAuditLogChain(ctx context.Context, clubID uint, afterSequence uint64, start, end *time.Time, limit int) ([]*models.AuditLog, error) {
+	var logs []*models.AuditLog
+
+	query := r.db.WithTenant(clubID).WithContext(ctx).Where("sequence > ?", afterSequence)
+	if start != nil {
+		query = query.Where("created_at >= ?", start.UTC())
+	}
+	if end != nil {
+		query = query.Where("created_at <= ?", end.UTC())
+	}
+
+	if err := query.Order("sequence ASC").Limit(limit).Find(&logs).Error; err != nil {
+		return nil, errors.Internal("Failed to list audit log chain", map[string]interface{}{
+			"club_id":        clubID,
+			"after_sequence": afterSequence,
+		}, err)
+	}
+
+	return logs, nil
+}
+
+// PruneAuditLogs permanently removes a club's audit logs created before the
+// cutoff. The chain stays verifiable from the last removed entry's hash.
+func (r *AuthRepository) PruneAuditLogs(ctx context.Context, clubID uint, before time.Time) (int64, error) {
+	auditChainMu.Lock()
+	defer auditChainMu.Unlock()
+
+	var pruned int64
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		var last models.AuditLog
+		result := tx.Where("club_id = ? AND created_at < ?", clubID, before.UTC()).
+			Order("sequence DESC").
+			Limit(1).
+			Find(&last)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		result = tx.Where("club_id = ? AND sequence <= ?", clubID, last.Sequence).Delete(&models.AuditLog{})
+		if result.Error != nil {
+			return result.Error
+		}
+		pruned = result.RowsAffected
+
+		return tx.Model(&models.AuditChainState{}).Where("club_id = ?", clubID).Updates(map[string]interface{}{
+			"pruned_sequence": last.Sequence,
+			"pruned_hash":     last.Hash,
+			"updated_at":      time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return 0, errors.Internal("Failed to prune audit logs", map[string]interface{}{
+			"club_id": clubID,
+			"before":  before,
+		}, err)
+	}
+
+	return pruned, nil
+}
+
+// CreateAuditAnchor records an anchored chain head
+func (r *AuthRepository) CreateAuditAnchor(ctx context.Context, anchor *models.AuditAnchor) error {
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := tx.Create(anchor).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.AuditChainState{}).Where("club_id = ?", anchor.ClubID).Updates(map[string]interface{}{
+			"anchored_sequence": anchor.Sequence,
+			"anchored_at":       anchor.CreatedAt,
+		}).Error
+	})
+	if err != nil {
+		return errors.Internal("Failed to create audit anchor", map[string]interface{}{
+			"club_id":  anchor.ClubID,
+			"sequence": anchor.Sequence,
+		}, err)
+	}
+
+	return nil
+}
+
+// ListAuditAnchors retrieves a club's anchored chain heads
+func (r *AuthRepository) ListAuditAnchors(ctx context.Context, clubID uint) ([]*models.AuditAnchor, error) {
+	var anchors []*models.AuditAnchor
+	if err := r.db.WithTenant(clubID).WithContext(ctx).Order("sequence ASC").Find(&anchors).Error; err != nil {
+		return nil, errors.Internal("Failed to list audit anchors", map[string]interface{}{
+			"club_id": clubID,
+		}, err)
+	}
+
+	return anchors, nil
+}
+
 // User search and filtering
 
 // SearchUsers searches users by various criteria
@@ -1002,6 +1161,459 @@ func (r *AuthRepository) InvalidateAllUserSessions(ctx context.Context, clubID,
 	return nil
 }
 
+// Passkey credential operations
+
+// CreatePasskeyCredential stores a newly registered passkey
+func (r *AuthRepository) CreatePasskeyCredential(ctx context.Context, credential *models.PasskeyCredential) error {
+	if err := r.db.WithTenant(credential.ClubID).WithContext(ctx).Create(credential).Error; err != nil {
+		r.logger.Error("Failed to create passkey credential", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": credential.UserID,
+		})
+		return errors.Internal("Failed to create passkey credential", map[string]interface{}{
+			"user_id": credential.UserID,
+		}, err)
+	}
+
+	return nil
+}
+
+// GetPasskeyCredentialsByUser retrieves all passkeys registered by a user
+func (r *AuthRepository) GetPasskeyCredentialsByUser(ctx context.Context, clubID, userID uint) ([]*models.PasskeyCredential, error) {
+	var credentials []*models.PasskeyCredential
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&credentials).Error; err != nil {
+		return nil, errors.Internal("Failed to get passkey credentials", map[string]interface{}{
+			"user_id": userID,
+		}, err)
+	}
+
+	return credentials, nil
+}
+
+// GetPasskeyCredentialByID retrieves a user's passkey by its database ID
+func (r *AuthRepository) GetPasskeyCredentialByID(ctx context.Context, clubID, userID, id uint) (*models.PasskeyCredential, error) {
+	var credential models.PasskeyCredential
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&credential, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("Passkey not found", map[string]interface{}{
+				"passkey_id": id,
+				"user_id":    userID,
+			})
+		}
+		return nil, errors.Internal("Failed to get passkey", map[string]interface{}{
+			"passkey_id": id,
+		}, err)
+	}
+
+	return &credential, nil
+}
+
+// GetPasskeyCredentialByCredentialID retrieves a passkey by its WebAuthn credential ID
+func (r *AuthRepository) GetPasskeyCredentialByCredentialID(ctx context.Context, credentialID string) (*models.PasskeyCredential, error) {
+	var credential models.PasskeyCredential
+	if err := r.db.WithContext(ctx).
+		Where("credential_id = ?", credentialID).
+		First(&credential).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("Passkey not found", map[string]interface{}{
+				"credential_id": credentialID,
+			})
+		}
+		return nil, errors.Internal("Failed to get passkey", map[string]interface{}{
+			"credential_id": credentialID,
+		}, err)
+	}
+
+	return &credential, nil
+}
+
+// UpdatePasskeyCredential updates a passkey
+func (r *AuthRepository) UpdatePasskeyCredential(ctx context.Context, credential *models.PasskeyCredential) error {
+	if err := r.db.WithTenant(credential.ClubID).WithContext(ctx).Save(credential).Error; err != nil {
+		return errors.Internal("Failed to update passkey", map[string]interface{}{
+			"passkey_id": credential.ID,
+		}, err)
+	}
+
+	return nil
+}
+
+// DeletePasskeyCredential removes a user's passkey
+func (r *AuthRepository) DeletePasskeyCredential(ctx context.Context, clubID, userID, id uint) error {
+	result := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&models.PasskeyCredential{}, id)
+	if result.Error != nil {
+		return errors.Internal("Failed to delete passkey", map[string]interface{}{
+			"passkey_id": id,
+		}, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("Passkey not found", map[string]interface{}{
+			"passkey_id": id,
+			"user_id":    userID,
+		})
+	}
+
+	return nil
+}
+
+// MFA factor operations
+
+// CreateMFAFactor stores a newly enrolled second factor
+func (r *AuthRepository) CreateMFAFactor(ctx context.Context, factor *models.MFAFactor) error {
+	if err := r.db.WithTenant(factor.ClubID).WithContext(ctx).Create(factor).Error; err != nil {
+		r.logger.Error("Failed to create MFA factor", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": factor.UserID,
+			"type":    string(factor.Type),
+		})
+		return errors.Internal("Failed to create MFA factor", map[string]interface{}{
+			"user_id": factor.UserID,
+		}, err)
+	}
+
+	return nil
+}
+
+// GetMFAFactorsByUser retrieves a user's factors, primary first
+func (r *AuthRepository) GetMFAFactorsByUser(ctx context.Context, clubID, userID uint) ([]*models.MFAFactor, error) {
+	var factors []*models.MFAFactor
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("\"primary\" DESC, created_at ASC, id ASC").
+		Find(&factors).Error; err != nil {
+		return nil, errors.Internal("Failed to get MFA factors", map[string]interface{}{
+			"user_id": userID,
+		}, err)
+	}
+
+	return factors, nil
+}
+
+// GetMFAFactorByID retrieves one of a user's factors
+func (r *AuthRepository) GetMFAFactorByID(ctx context.Context, clubID, userID, id uint) (*models.MFAFactor, error) {
+	var factor models.MFAFactor
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&factor, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("MFA factor not found", map[string]interface{}{
+				"factor_id": id,
+				"user_id":   userID,
+			})
+		}
+		return nil, errors.Internal("Failed to get MFA factor", map[string]interface{}{
+			"factor_id": id,
+		}, err)
+	}
+
+	return &factor, nil
+}
+
+// GetMFAFactorByCredentialID retrieves a WebAuthn factor by its credential ID
+func (r *AuthRepository) GetMFAFactorByCredentialID(ctx context.Context, credentialID string) (*models.MFAFactor, error) {
+	var factor models.MFAFactor
+	if err := r.db.WithContext(ctx).
+		Where("credential_id = ?", credentialID).
+		First(&factor).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("MFA factor not found", map[string]interface{}{
+				"credential_id": credentialID,
+			})
+		}
+		return nil, errors.Internal("Failed to get MFA factor", map[string]interface{}{
+			"credential_id": credentialID,
+		}, err)
+	}
+
+	return &factor, nil
+}
+
+// UpdateMFAFactor updates a factor
+func (r *AuthRepository) UpdateMFAFactor(ctx context.Context, factor *models.MFAFactor) error {
+	if err := r.db.WithTenant(factor.ClubID).WithContext(ctx).Save(factor).Error; err != nil {
+		return errors.Internal("Failed to update MFA factor", map[string]interface{}{
+			"factor_id": factor.ID,
+		}, err)
+	}
+
+	return nil
+}
+
+// SetPrimaryMFAFactor makes one of a user's factors primary and clears the flag on the others
+func (r *AuthRepository) SetPrimaryMFAFactor(ctx context.Context, clubID, userID, id uint) error {
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := tx.Model(&models.MFAFactor{}).
+			Where("club_id = ? AND user_id = ?", clubID, userID).
+			Update("primary", false).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.MFAFactor{}).
+			Where("club_id = ? AND user_id = ? AND id = ?", clubID, userID, id).
+			Update("primary", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err == gorm.ErrRecordNotFound {
+		return errors.NotFound("MFA factor not found", map[string]interface{}{
+			"factor_id": id,
+			"user_id":   userID,
+		})
+	}
+	if err != nil {
+		return errors.Internal("Failed to set primary MFA factor", map[string]interface{}{
+			"factor_id": id,
+		}, err)
+	}
+
+	return nil
+}
+
+// DeleteMFAFactor removes one of a user's factors
+func (r *AuthRepository) DeleteMFAFactor(ctx context.Context, clubID, userID, id uint) error {
+	result := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&models.MFAFactor{}, id)
+	if result.Error != nil {
+		return errors.Internal("Failed to delete MFA factor", map[string]interface{}{
+			"factor_id": id,
+		}, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("MFA factor not found", map[string]interface{}{
+			"factor_id": id,
+			"user_id":   userID,
+		})
+	}
+
+	return nil
+}
+
+// DeleteMFAFactorsByUser removes all of a user's factors
+func (r *AuthRepository) DeleteMFAFactorsByUser(ctx context.Context, clubID, userID uint) error {
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&models.MFAFactor{}).Error; err != nil {
+		return errors.Internal("Failed to delete MFA factors", map[string]interface{}{
+			"user_id": userID,
+		}, err)
+	}
+
+	return nil
+}
+
+// WebAuthn ceremony session operations
+
+// CreateWebAuthnSession stores the challenge of a new ceremony
+func (r *AuthRepository) CreateWebAuthnSession(ctx context.Context, session *models.WebAuthnSession) error {
+	if err := r.db.WithTenant(session.ClubID).WithContext(ctx).Create(session).Error; err != nil {
+		return errors.Internal("Failed to create WebAuthn session", map[string]interface{}{
+			"user_id":  session.UserID,
+			"ceremony": session.Ceremony,
+		}, err)
+	}
+
+	return nil
+}
+
+// GetLatestWebAuthnSession retrieves the most recent unused ceremony for a user
+func (r *AuthRepository) GetLatestWebAuthnSession(ctx context.Context, clubID, userID uint, ceremony models.WebAuthnCeremony) (*models.WebAuthnSession, error) {
+	var session models.WebAuthnSession
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ? AND ceremony = ? AND used = ?", userID, ceremony, false).
+		Order("created_at DESC, id DESC").
+		First(&session).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("WebAuthn session not found", map[string]interface{}{
+				"user_id":  userID,
+				"ceremony": ceremony,
+			})
+		}
+		return nil, errors.Internal("Failed to get WebAuthn session", map[string]interface{}{
+			"user_id": userID,
+		}, err)
+	}
+
+	return &session, nil
+}
+
+// ConsumeWebAuthnSession marks a ceremony as used so its challenge cannot be replayed
+func (r *AuthRepository) ConsumeWebAuthnSession(ctx context.Context, session *models.WebAuthnSession) error {
+	result := r.db.WithTenant(session.ClubID).WithContext(ctx).
+		Model(&models.WebAuthnSession{}).
+		Where("id = ? AND used = ?", session.ID, false).
+		Update("used", true)
+	if result.Error != nil {
+		return errors.Internal("Failed to consume WebAuthn session", map[string]interface{}{
+			"session_id": session.ID,
+		}, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Conflict("WebAuthn session already used", map[string]interface{}{
+			"session_id": session.ID,
+		})
+	}
+	session.Used = true
+
+	return nil
+}
+
+// GetSessionBySessionID retrieves a session by its provider session ID across clubs
+func (r *AuthRepository) GetSessionBySessionID(ctx context.Context, sessionID string) (*models.UserSession, error) {
+	var session models.UserSession
+	if err := r.db.WithContext(ctx).
+		Where("hanko_session_id = ?", sessionID).
+		First(&session).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("Session not found", map[string]interface{}{
+				"session_id": sessionID,
+			})
+		}
+		return nil, errors.Internal("Failed to get session", map[string]interface{}{
+			"session_id": sessionID,
+		}, err)
+	}
+
+	return &session, nil
+}
+
+// Risk assessment operations
+
+// GetRecentSessionsByUser retrieves a user's most recent sessions, including ended ones
+func (r *AuthRepository) GetRecentSessionsByUser(ctx context.Context, clubID, userID uint, limit int) ([]*models.UserSession, error) {
+	var sessions []*models.UserSession
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&sessions).Error; err != nil {
+		return nil, errors.Internal("Failed to get recent sessions", map[string]interface{}{
+			"user_id": userID,
+		}, err)
+	}
+
+	return sessions, nil
+}
+
+// CountFailedLogins counts failed login attempts across a club since the given time
+func (r *AuthRepository) CountFailedLogins(ctx context.Context, clubID uint, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.WithTenant(clubID).WithContext(ctx).Model(&models.AuditLog{}).
+		Where("action = ? AND success = ? AND created_at >= ?", models.AuditActionLogin, false, since).
+		Count(&count).Error; err != nil {
+		return 0, errors.Internal("Failed to count failed logins", nil, err)
+	}
+
+	return count, nil
+}
+
+// Session management operations
+
+// GetSessionByID retrieves a session by primary key within a club
+func (r *AuthRepository) GetSessionByID(ctx context.Context, clubID, id uint) (*models.UserSession, error) {
+	var session models.UserSession
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("id = ?", id).
+		First(&session).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("Session not found", map[string]interface{}{
+				"session_id": id,
+			})
+		}
+		return nil, errors.Internal("Failed to get session", map[string]interface{}{
+			"session_id": id,
+		}, err)
+	}
+
+	return &session, nil
+}
+
+// GetActiveSessionsByUser retrieves a user's active, unexpired sessions, most recently used first
+func (r *AuthRepository) GetActiveSessionsByUser(ctx context.Context, clubID, userID uint) ([]*models.UserSession, error) {
+	var sessions []*models.UserSession
+	if err := r.db.WithTenant(clubID).WithContext(ctx).
+		Where("user_id = ? AND is_active = ? AND expires_at > ?", userID, true, time.Now()).
+		Order("last_activity_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, errors.Internal("Failed to get active sessions", map[string]interface{}{
+			"user_id": userID,
+		}, err)
+	}
+
+	return sessions, nil
+}
+
+// SearchSessions searches a club's sessions by user, IP address, device or location
+func (r *AuthRepository) SearchSessions(ctx context.Context, clubID, userID uint, searchTerm string, activeOnly bool, offset, limit int) ([]*models.UserSession, int64, error) {
+	var sessions []*models.UserSession
+	var total int64
+
+	query := r.db.WithTenant(clubID).WithContext(ctx).Model(&models.UserSession{})
+
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	if searchTerm != "" {
+		searchPattern := "%" + strings.ToLower(searchTerm) + "%"
+		query = query.Where("LOWER(ip_address) LIKE ? OR LOWER(user_agent) LIKE ? OR LOWER(location) LIKE ?",
+			searchPattern, searchPattern, searchPattern)
+	}
+
+	if activeOnly {
+		query = query.Where("is_active = ? AND expires_at > ?", true, time.Now())
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Internal("Failed to count sessions", map[string]interface{}{
+			"search_term": searchTerm,
+		}, err)
+	}
+
+	if err := query.
+		Preload("User").
+		Order("last_activity_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&sessions).Error; err != nil {
+		return nil, 0, errors.Internal("Failed to search sessions", map[string]interface{}{
+			"search_term": searchTerm,
+		}, err)
+	}
+
+	return sessions, total, nil
+}
+
+// InvalidateIdleSessions invalidates active sessions with no activity since idleBefore
+func (r *AuthRepository) InvalidateIdleSessions(ctx context.Context, clubID uint, idleBefore time.Time) (int64, error) {
+	result := r.db.WithTenant(clubID).WithContext(ctx).
+		Model(&models.UserSession{}).
+		Where("is_active = ? AND last_activity_at < ?", true, idleBefore).
+		Updates(map[string]interface{}{
+			"is_active": false,
+			"logout_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, errors.Internal("Failed to invalidate idle sessions", map[string]interface{}{
+			"club_id": clubID,
+		}, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
 // WithTransaction executes a function within a database transaction
 func (r *AuthRepository) WithTransaction(ctx context.Context, fn func(*AuthRepository) error) error {
 	return r.db.Transaction(ctx, func(tx *gorm.DB) error {
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/database"
+	"reciprocal-clubs-backend/pkg/shared/errors"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/services/auth-service/internal/models"
 )
@@ -29,6 +30,9 @@ func setupTestDB(t *testing.T) *database.Database {
 		&models.RolePermission{},
 		&models.UserSession{},
 		&models.AuditLog{},
+		&models.AuditChainState{},
+		&models.AuditAnchor{},
+		&models.MFAFactor{},
 	)
 	if err != nil {
 		t.Fatalf("Failed to migrate test database: %v", err)
@@ -328,6 +332,59 @@ func TestAuthRepository_InvalidateSession(t *testing.T) {
 	}
 }
 
+// MFA Factor Tests
+
+func TestAuthRepository_SetPrimaryMFAFactor(t *testing.T) {
+	repo, _ := setupTestRepository(t)
+	club := createTestClub(t, repo)
+	user := createTestUser(t, repo, club.ID)
+	ctx := context.Background()
+
+	var factors []*models.MFAFactor
+	for i, factorType := range []models.MFAFactorType{models.MFAFactorTypeTOTP, models.MFAFactorTypeWebAuthn} {
+		factor := &models.MFAFactor{
+			UserID:   user.ID,
+			Type:     factorType,
+			Primary:  i == 0,
+			Verified: true,
+		}
+		factor.ClubID = club.ID
+		if err := repo.CreateMFAFactor(ctx, factor); err != nil {
+			t.Fatalf("CreateMFAFactor failed: %v", err)
+		}
+		factors = append(factors, factor)
+	}
+
+	if err := repo.SetPrimaryMFAFactor(ctx, club.ID, user.ID, factors[1].ID); err != nil {
+		t.Fatalf("SetPrimaryMFAFactor failed: %v", err)
+	}
+
+	retrieved, err := repo.GetMFAFactorsByUser(ctx, club.ID, user.ID)
+	if err != nil {
+		t.Fatalf("GetMFAFactorsByUser failed: %v", err)
+	}
+	if len(retrieved) != 2 {
+		t.Fatalf("Expected 2 factors, got %d", len(retrieved))
+	}
+	if retrieved[0].ID != factors[1].ID || !retrieved[0].Primary {
+		t.Error("New primary factor should be listed first")
+	}
+	if retrieved[1].Primary {
+		t.Error("Previous primary factor should be cleared")
+	}
+
+	if err := repo.SetPrimaryMFAFactor(ctx, club.ID, user.ID, 9999); !errors.Is(err, errors.ErrNotFound) {
+		t.Errorf("Expected not found for unknown factor, got %v", err)
+	}
+
+	if err := repo.DeleteMFAFactor(ctx, club.ID, user.ID, factors[0].ID); err != nil {
+		t.Errorf("DeleteMFAFactor failed: %v", err)
+	}
+	if _, err := repo.GetMFAFactorByID(ctx, club.ID, user.ID, factors[0].ID); !errors.Is(err, errors.ErrNotFound) {
+		t.Errorf("Expected deleted factor to be gone, got %v", err)
+	}
+}
+
 // Health Check Test
 
 func TestAuthRepository_HealthCheck(t *testing.T) {
@@ -0,0 +1,154 @@
+package risk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Location describes where an IP address is registered
+type Location struct {
+	Country   string  `json:"country"`
+	City      string  `json:"city,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	ASN       uint32  `json:"asn"`
+}
+
+// GeoIP resolves IP addresses to locations
+type GeoIP interface {
+	Lookup(ip string) (*Location, bool)
+}
+
+type geoRange struct {
+	prefix   netip.Prefix
+	location Location
+}
+
+// GeoIPDatabase is an in-memory GeoIP database loaded from a local CSV file.
+//
+// Each line holds one network in CIDR notation followed by its location:
+//
+//	network,country,city,latitude,longitude,asn
+//	81.2.69.0/24,GB,London,51.5142,-0.0931,20712
+//
+// Networks must not overlap. Lines starting with '#' and a header line
+// beginning with "network" are ignored.
+type GeoIPDatabase struct {
+	ranges []geoRange
+}
+
+// LoadGeoIPDatabase reads a GeoIP CSV database from disk
+func LoadGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GeoIP database: %w", err)
+	}
+	defer f.Close()
+
+	return ParseGeoIPDatabase(f)
+}
+
+// ParseGeoIPDatabase reads a GeoIP CSV database from r
+func ParseGeoIPDatabase(r io.Reader) (*GeoIPDatabase, error) {
+	db := &GeoIPDatabase{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, "network") {
+			continue
+		}
+
+		fields := strings.Split(text, ",")
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("GeoIP database line %d: expected 6 fields, got %d", line, len(fields))
+		}
+
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, fmt.Errorf("GeoIP database line %d: %w", line, err)
+		}
+		lat, err := strconv.ParseFloat(strings.TrimSpace(fields[3]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("GeoIP database line %d: invalid latitude: %w", line, err)
+		}
+		lon, err := strconv.ParseFloat(strings.TrimSpace(fields[4]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("GeoIP database line %d: invalid longitude: %w", line, err)
+		}
+		asn, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(fields[5]), "AS"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("GeoIP database line %d: invalid ASN: %w", line, err)
+		}
+
+		db.ranges = append(db.ranges, geoRange{
+			prefix: prefix.Masked(),
+			location: Location{
+				Country:   strings.TrimSpace(fields[1]),
+				City:      strings.TrimSpace(fields[2]),
+				Latitude:  lat,
+				Longitude: lon,
+				ASN:       uint32(asn),
+			},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read GeoIP database: %w", err)
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].prefix.Addr().Less(db.ranges[j].prefix.Addr())
+	})
+
+	return db, nil
+}
+
+// Lookup returns the location of an IP address
+func (db *GeoIPDatabase) Lookup(ip string) (*Location, bool) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return nil, false
+	}
+	addr = addr.Unmap()
+
+	// Find the last network starting at or before addr
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return addr.Less(db.ranges[i].prefix.Addr())
+	})
+	if i == 0 {
+		return nil, false
+	}
+
+	r := db.ranges[i-1]
+	if !r.prefix.Contains(addr) {
+		return nil, false
+	}
+	location := r.location
+	return &location, true
+}
+
+// Len returns the number of networks in the database
+func (db *GeoIPDatabase) Len() int {
+	return len(db.ranges)
+}
+
+const earthRadiusKm = 6371.0
+
+// DistanceKm returns the great-circle distance between two locations
+func DistanceKm(a, b *Location) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
@@ -0,0 +1,344 @@
+// Package risk scores login attempts so the auth service can adapt how much
+// proof it asks for: allow, notify the user, step up to MFA, or block.
+package risk
+
+import (
+	"time"
+)
+
+// Decision is the action taken for a scored login
+type Decision string
+
+const (
+	DecisionAllow  Decision = "allow"
+	DecisionNotify Decision = "notify"
+	DecisionStepUp Decision = "step_up"
+	DecisionBlock  Decision = "block"
+)
+
+// Signal names reported in assessments
+const (
+	SignalNewDevice        = "new_device"
+	SignalNewIP            = "new_ip"
+	SignalNewASN           = "new_asn"
+	SignalImpossibleTravel = "impossible_travel"
+	SignalClubFailures     = "club_failures"
+	SignalUserFailures     = "user_failures"
+	SignalUnusualHour      = "unusual_hour"
+)
+
+// Thresholds map a risk score to a decision. A score at or above a threshold
+// triggers that decision; the most severe matching decision wins. A zero
+// threshold disables the decision.
+type Thresholds struct {
+	Notify int `json:"notify"`
+	StepUp int `json:"step_up"`
+	Block  int `json:"block"`
+}
+
+// DefaultThresholds returns the thresholds used when a club has none configured
+func DefaultThresholds() Thresholds {
+	return Thresholds{Notify: 30, StepUp: 50, Block: 90}
+}
+
+// Weights controls how much each signal contributes to the score
+type Weights struct {
+	NewDevice        int
+	NewIP            int
+	NewASN           int
+	ImpossibleTravel int
+	ClubFailures     int
+	UserFailures     int
+	UnusualHour      int
+}
+
+// DefaultWeights returns the default signal weights
+func DefaultWeights() Weights {
+	return Weights{
+		NewDevice:        25,
+		NewIP:            10,
+		NewASN:           15,
+		ImpossibleTravel: 50,
+		ClubFailures:     20,
+		UserFailures:     20,
+		UnusualHour:      10,
+	}
+}
+
+// Config configures the risk engine
+type Config struct {
+	Weights Weights
+	// MaxTravelSpeedKmh is the fastest plausible travel speed between two logins
+	MaxTravelSpeedKmh float64
+	// MinTravelDistanceKm ignores jumps small enough to be GeoIP noise
+	MinTravelDistanceKm float64
+	// ClubFailureThreshold is the number of recent failed logins across a club
+	// at which the club is considered under attack
+	ClubFailureThreshold int
+	// MinHistoryForHours is the number of past logins needed before the time
+	// of day is considered
+	MinHistoryForHours int
+}
+
+// DefaultConfig returns the default engine configuration
+func DefaultConfig() Config {
+	return Config{
+		Weights:              DefaultWeights(),
+		MaxTravelSpeedKmh:    900,
+		MinTravelDistanceKm:  500,
+		ClubFailureThreshold: 20,
+		MinHistoryForHours:   5,
+	}
+}
+
+// Attempt describes the login being scored
+type Attempt struct {
+	IPAddress         string
+	DeviceFingerprint string
+	Time              time.Time
+	// UserFailures is the number of consecutive failed attempts for the user
+	UserFailures int
+	// ClubFailures is the number of recent failed logins across the club
+	ClubFailures int
+}
+
+// PriorLogin is a previous successful login by the same user
+type PriorLogin struct {
+	IPAddress         string
+	DeviceFingerprint string
+	Time              time.Time
+}
+
+// Signal is one contributing factor of an assessment
+type Signal struct {
+	Name   string                 `json:"name"`
+	Score  int                    `json:"score"`
+	Detail map[string]interface{} `json:"detail,omitempty"`
+}
+
+// Assessment is the outcome of scoring a login attempt
+type Assessment struct {
+	Score    int       `json:"score"`
+	Decision Decision  `json:"decision"`
+	Signals  []Signal  `json:"signals"`
+	Location *Location `json:"location,omitempty"`
+}
+
+// Metadata flattens the assessment for audit logging
+func (a *Assessment) Metadata() map[string]interface{} {
+	signals := make([]map[string]interface{}, 0, len(a.Signals))
+	for _, s := range a.Signals {
+		entry := map[string]interface{}{
+			"name":  s.Name,
+			"score": s.Score,
+		}
+		for k, v := range s.Detail {
+			entry[k] = v
+		}
+		signals = append(signals, entry)
+	}
+
+	metadata := map[string]interface{}{
+		"risk_score":    a.Score,
+		"risk_decision": string(a.Decision),
+		"risk_signals":  signals,
+	}
+	if a.Location != nil {
+		metadata["country"] = a.Location.Country
+		metadata["asn"] = a.Location.ASN
+	}
+	return metadata
+}
+
+// Engine scores login attempts
+type Engine struct {
+	config Config
+	geoip  GeoIP
+}
+
+// NewEngine creates a risk engine. geoip may be nil, in which case network
+// and travel signals are skipped.
+func NewEngine(config Config, geoip GeoIP) *Engine {
+	return &Engine{config: config, geoip: geoip}
+}
+
+// Evaluate scores an attempt against the user's login history (most recent
+// first) and maps the score to a decision using the club's thresholds
+func (e *Engine) Evaluate(attempt Attempt, history []PriorLogin, thresholds Thresholds) *Assessment {
+	if attempt.Time.IsZero() {
+		attempt.Time = time.Now()
+	}
+
+	assessment := &Assessment{Signals: []Signal{}}
+	add := func(name string, score int, detail map[string]interface{}) {
+		if score <= 0 {
+			return
+		}
+		assessment.Signals = append(assessment.Signals, Signal{Name: name, Score: score, Detail: detail})
+		assessment.Score += score
+	}
+
+	var location *Location
+	if e.geoip != nil {
+		if loc, ok := e.geoip.Lookup(attempt.IPAddress); ok {
+			location = loc
+			assessment.Location = loc
+		}
+	}
+
+	w := e.config.Weights
+
+	if len(history) > 0 {
+		if attempt.DeviceFingerprint != "" {
+			switch {
+			case !e.seenDevice(attempt.DeviceFingerprint, history):
+				add(SignalNewDevice, w.NewDevice, nil)
+			case !e.seenDeviceOnNetwork(attempt.DeviceFingerprint, attempt.IPAddress, location, history):
+				// Fingerprints are client supplied and easily copied, so a
+				// match from a network the device was never seen on only
+				// halves the signal
+				add(SignalNewDevice, w.NewDevice/2, map[string]interface{}{"fingerprint_seen": true})
+			}
+		}
+
+		if !e.seenIP(attempt.IPAddress, history) {
+			add(SignalNewIP, w.NewIP, map[string]interface{}{"ip_address": attempt.IPAddress})
+
+			if location != nil && !e.seenASN(location.ASN, history) {
+				add(SignalNewASN, w.NewASN, map[string]interface{}{"asn": location.ASN})
+			}
+		}
+
+		if location != nil {
+			if detail, ok := e.impossibleTravel(location, attempt.Time, history[0]); ok {
+				add(SignalImpossibleTravel, w.ImpossibleTravel, detail)
+			}
+		}
+
+		if len(history) >= e.config.MinHistoryForHours && !usualHour(attempt.Time, history) {
+			add(SignalUnusualHour, w.UnusualHour, map[string]interface{}{"hour_utc": attempt.Time.UTC().Hour()})
+		}
+	}
+
+	if attempt.UserFailures > 0 {
+		// Each failure adds a quarter of the weight, capped at the full weight
+		score := w.UserFailures * attempt.UserFailures / 4
+		if score > w.UserFailures {
+			score = w.UserFailures
+		}
+		add(SignalUserFailures, score, map[string]interface{}{"failures": attempt.UserFailures})
+	}
+
+	if e.config.ClubFailureThreshold > 0 && attempt.ClubFailures > 0 {
+		score := w.ClubFailures * attempt.ClubFailures / e.config.ClubFailureThreshold
+		if score > w.ClubFailures {
+			score = w.ClubFailures
+		}
+		add(SignalClubFailures, score, map[string]interface{}{"failures": attempt.ClubFailures})
+	}
+
+	assessment.Decision = thresholds.decide(assessment.Score)
+	return assessment
+}
+
+func (t Thresholds) decide(score int) Decision {
+	switch {
+	case t.Block > 0 && score >= t.Block:
+		return DecisionBlock
+	case t.StepUp > 0 && score >= t.StepUp:
+		return DecisionStepUp
+	case t.Notify > 0 && score >= t.Notify:
+		return DecisionNotify
+	}
+	return DecisionAllow
+}
+
+func (e *Engine) seenDevice(fingerprint string, history []PriorLogin) bool {
+	for _, h := range history {
+		if h.DeviceFingerprint == fingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+// seenDeviceOnNetwork reports whether the device logged in before from the
+// same IP address or, when its location is known, the same ASN
+func (e *Engine) seenDeviceOnNetwork(fingerprint, ip string, location *Location, history []PriorLogin) bool {
+	for _, h := range history {
+		if h.DeviceFingerprint != fingerprint {
+			continue
+		}
+		if h.IPAddress == ip {
+			return true
+		}
+		if location != nil {
+			if loc, ok := e.geoip.Lookup(h.IPAddress); ok && loc.ASN == location.ASN {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *Engine) seenIP(ip string, history []PriorLogin) bool {
+	for _, h := range history {
+		if h.IPAddress == ip {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Engine) seenASN(asn uint32, history []PriorLogin) bool {
+	for _, h := range history {
+		if loc, ok := e.geoip.Lookup(h.IPAddress); ok && loc.ASN == asn {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Engine) impossibleTravel(current *Location, at time.Time, last PriorLogin) (map[string]interface{}, bool) {
+	previous, ok := e.geoip.Lookup(last.IPAddress)
+	if !ok {
+		return nil, false
+	}
+
+	distance := DistanceKm(previous, current)
+	if distance < e.config.MinTravelDistanceKm {
+		return nil, false
+	}
+
+	hours := at.Sub(last.Time).Hours()
+	// Guard against clock skew and simultaneous logins
+	if hours < 1.0/60 {
+		hours = 1.0 / 60
+	}
+	speed := distance / hours
+	if speed <= e.config.MaxTravelSpeedKmh {
+		return nil, false
+	}
+
+	return map[string]interface{}{
+		"from_country": previous.Country,
+		"to_country":   current.Country,
+		"distance_km":  int(distance),
+		"speed_kmh":    int(speed),
+	}, true
+}
+
+// usualHour reports whether the attempt falls within an hour (±1) of a previous login
+func usualHour(at time.Time, history []PriorLogin) bool {
+	hour := at.UTC().Hour()
+	for _, h := range history {
+		diff := hour - h.Time.UTC().Hour()
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff <= 1 || diff == 23 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+package risk
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGeoIPDB = `network,country,city,latitude,longitude,asn
+# Stockholm
+192.0.2.0/24,SE,Stockholm,59.3293,18.0686,3301
+# Sydney
+198.51.100.0/24,AU,Sydney,-33.8688,151.2093,1221
+# Gothenburg, different ISP
+203.0.113.0/24,SE,Gothenburg,57.7089,11.9746,AS1257
+2001:db8::/32,US,New York,40.7128,-74.0060,701
+`
+
+func testEngine(t *testing.T) *Engine {
+	db, err := ParseGeoIPDatabase(strings.NewReader(testGeoIPDB))
+	require.NoError(t, err)
+	return NewEngine(DefaultConfig(), db)
+}
+
+func signalNames(a *Assessment) []string {
+	names := make([]string, 0, len(a.Signals))
+	for _, s := range a.Signals {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func TestGeoIPDatabase_Lookup(t *testing.T) {
+	db, err := ParseGeoIPDatabase(strings.NewReader(testGeoIPDB))
+	require.NoError(t, err)
+	assert.Equal(t, 4, db.Len())
+
+	loc, ok := db.Lookup("192.0.2.17")
+	require.True(t, ok)
+	assert.Equal(t, "SE", loc.Country)
+	assert.Equal(t, uint32(3301), loc.ASN)
+
+	loc, ok = db.Lookup("203.0.113.5")
+	require.True(t, ok)
+	assert.Equal(t, uint32(1257), loc.ASN)
+
+	loc, ok = db.Lookup("2001:db8::1")
+	require.True(t, ok)
+	assert.Equal(t, "US", loc.Country)
+
+	_, ok = db.Lookup("10.0.0.1")
+	assert.False(t, ok)
+
+	_, ok = db.Lookup("unknown")
+	assert.False(t, ok)
+}
+
+func TestParseGeoIPDatabase_Invalid(t *testing.T) {
+	_, err := ParseGeoIPDatabase(strings.NewReader("192.0.2.0/24,SE,Stockholm,59.3,18.0\n"))
+	assert.Error(t, err)
+
+	_, err = ParseGeoIPDatabase(strings.NewReader("not-a-network,SE,Stockholm,59.3,18.0,3301\n"))
+	assert.Error(t, err)
+}
+
+func TestDistanceKm(t *testing.T) {
+	stockholm := &Location{Latitude: 59.3293, Longitude: 18.0686}
+	sydney := &Location{Latitude: -33.8688, Longitude: 151.2093}
+
+	assert.InDelta(t, 15590, DistanceKm(stockholm, sydney), 100)
+	assert.Zero(t, DistanceKm(stockholm, stockholm))
+}
+
+func TestEngine_Evaluate(t *testing.T) {
+	engine := testEngine(t)
+	now := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+
+	history := []PriorLogin{
+		{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now.Add(-1 * time.Hour)},
+		{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now.Add(-25 * time.Hour)},
+		{IPAddress: "192.0.2.11", DeviceFingerprint: "phone", Time: now.Add(-49 * time.Hour)},
+		{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now.Add(-73 * time.Hour)},
+		{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now.Add(-97 * time.Hour)},
+	}
+
+	tests := []struct {
+		name     string
+		attempt  Attempt
+		history  []PriorLogin
+		signals  []string
+		decision Decision
+	}{
+		{
+			name:     "known device and network",
+			attempt:  Attempt{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now},
+			history:  history,
+			signals:  []string{},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "first login has no history to compare",
+			attempt:  Attempt{IPAddress: "198.51.100.1", DeviceFingerprint: "laptop", Time: now},
+			signals:  []string{},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "new device on known network",
+			attempt:  Attempt{IPAddress: "192.0.2.10", DeviceFingerprint: "tablet", Time: now},
+			history:  history,
+			signals:  []string{SignalNewDevice},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "new device on new network",
+			attempt:  Attempt{IPAddress: "203.0.113.9", DeviceFingerprint: "tablet", Time: now},
+			history:  history,
+			signals:  []string{SignalNewDevice, SignalNewIP, SignalNewASN},
+			decision: DecisionStepUp,
+		},
+		{
+			name:     "known fingerprint on new network",
+			attempt:  Attempt{IPAddress: "203.0.113.9", DeviceFingerprint: "laptop", Time: now},
+			history:  history,
+			signals:  []string{SignalNewDevice, SignalNewIP, SignalNewASN},
+			decision: DecisionNotify,
+		},
+		{
+			name:     "known fingerprint on new address in known network",
+			attempt:  Attempt{IPAddress: "192.0.2.12", DeviceFingerprint: "laptop", Time: now},
+			history:  history,
+			signals:  []string{SignalNewIP},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "impossible travel",
+			attempt:  Attempt{IPAddress: "198.51.100.1", DeviceFingerprint: "laptop", Time: now},
+			history:  history,
+			signals:  []string{SignalNewDevice, SignalNewIP, SignalNewASN, SignalImpossibleTravel},
+			decision: DecisionStepUp,
+		},
+		{
+			name:     "impossible travel from a new device",
+			attempt:  Attempt{IPAddress: "198.51.100.1", DeviceFingerprint: "tablet", Time: now},
+			history:  history,
+			signals:  []string{SignalNewDevice, SignalNewIP, SignalNewASN, SignalImpossibleTravel},
+			decision: DecisionBlock,
+		},
+		{
+			name:     "unusual hour",
+			attempt:  Attempt{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now.Add(-11 * time.Hour)},
+			history:  history,
+			signals:  []string{SignalUnusualHour},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "club under attack and user failures",
+			attempt:  Attempt{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now, ClubFailures: 40, UserFailures: 2},
+			history:  history,
+			signals:  []string{SignalUserFailures, SignalClubFailures},
+			decision: DecisionNotify,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assessment := engine.Evaluate(tt.attempt, tt.history, DefaultThresholds())
+			assert.Equal(t, tt.signals, signalNames(assessment))
+			assert.Equal(t, tt.decision, assessment.Decision, "score %d", assessment.Score)
+		})
+	}
+}
+
+func TestEngine_Evaluate_WithoutGeoIP(t *testing.T) {
+	engine := NewEngine(DefaultConfig(), nil)
+	now := time.Now()
+
+	assessment := engine.Evaluate(Attempt{IPAddress: "198.51.100.1", DeviceFingerprint: "tablet", Time: now}, []PriorLogin{
+		{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now.Add(-time.Hour)},
+	}, DefaultThresholds())
+
+	assert.Equal(t, []string{SignalNewDevice, SignalNewIP}, signalNames(assessment))
+	assert.Nil(t, assessment.Location)
+	assert.Equal(t, DecisionNotify, assessment.Decision)
+}
+
+func TestThresholds_Decide(t *testing.T) {
+	thresholds := Thresholds{Notify: 10, StepUp: 20, Block: 30}
+	assert.Equal(t, DecisionAllow, thresholds.decide(9))
+	assert.Equal(t, DecisionNotify, thresholds.decide(10))
+	assert.Equal(t, DecisionStepUp, thresholds.decide(25))
+	assert.Equal(t, DecisionBlock, thresholds.decide(30))
+
+	// Disabled decisions are skipped
+	assert.Equal(t, DecisionStepUp, Thresholds{StepUp: 20}.decide(100))
+}
+
+func TestAssessment_Metadata(t *testing.T) {
+	engine := testEngine(t)
+	now := time.Now()
+
+	assessment := engine.Evaluate(Attempt{IPAddress: "203.0.113.9", DeviceFingerprint: "tablet", Time: now}, []PriorLogin{
+		{IPAddress: "192.0.2.10", DeviceFingerprint: "laptop", Time: now.Add(-time.Hour)},
+	}, DefaultThresholds())
+
+	metadata := assessment.Metadata()
+	assert.Equal(t, assessment.Score, metadata["risk_score"])
+	assert.Equal(t, "step_up", metadata["risk_decision"])
+	assert.Equal(t, "SE", metadata["country"])
+	assert.Len(t, metadata["risk_signals"], 3)
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/config"
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/pkg/shared/logging"
+	"reciprocal-clubs-backend/services/auth-service/internal/audit"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+)
+
+// auditAnchorSubject is handled by blockchain-service, which records the
+// chain head on the audit channel
+const auditAnchorSubject = "blockchain.audit.anchor"
+
+// AuditRetentionResult summarises a retention sweep
+type AuditRetentionResult struct {
+	Clubs  int   `json:"clubs"`
+	Pruned int64 `json:"pruned"`
+}
+
+// VerifyAuditChain checks a club's audit chain for gaps and tampering
+func (s *AuthService) VerifyAuditChain(ctx context.Context, clubID uint) (*audit.Report, error) {
+	if _, err := s.repo.GetClubByID(ctx, clubID); err != nil {
+		return nil, err
+	}
+
+	report, err := audit.VerifyChain(ctx, s.repo, clubID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if !report.Valid {
+		s.logger.Warn("Audit chain verification failed", map[string]interface{}{
+			"club_id":  clubID,
+			"problems": len(report.Problems),
+		})
+	}
+
+	return report, nil
+}
+
+// ExportAuditLogs writes a club's audit entries to w and returns the signed manifest
+func (s *AuthService) ExportAuditLogs(ctx context.Context, w io.Writer, req *audit.ExportRequest) (*audit.Manifest, error) {
+	if req.Format == "" {
+		req.Format = audit.FormatNDJSON
+	}
+	if req.Format != audit.FormatNDJSON && req.Format != audit.FormatCSV {
+		return nil, errors.InvalidInput("Unsupported export format", map[string]interface{}{
+			"format": req.Format,
+		}, nil)
+	}
+	if req.Start != nil && req.End != nil && req.End.Before(*req.Start) {
+		return nil, errors.InvalidInput("Export end must not be before start", nil, nil)
+	}
+
+	if s.auditSigner == nil {
+		return nil, errors.Unavailable("Audit export signing is unavailable", nil, nil)
+	}
+
+	if _, err := s.repo.GetClubByID(ctx, req.ClubID); err != nil {
+		return nil, err
+	}
+
+	manifest, err := audit.Export(ctx, s.repo, w, req, s.auditSigner)
+	if err != nil {
+		return nil, errors.Internal("Failed to export audit logs", map[string]interface{}{
+			"club_id": req.ClubID,
+		}, err)
+	}
+
+	s.logger.Info("Audit logs exported", map[string]interface{}{
+		"club_id": req.ClubID,
+		"format":  req.Format,
+		"entries": manifest.Entries,
+	})
+
+	return manifest, nil
+}
+
+// EnforceAuditRetention removes audit entries older than each club's
+// AuditRetentionDays. Each pruning is itself recorded in the chain.
+func (s *AuthService) EnforceAuditRetention(ctx context.Context) (*AuditRetentionResult, error) {
+	const pageSize = 100
+	result := &AuditRetentionResult{}
+
+	for offset := 0; ; offset += pageSize {
+		clubs, _, err := s.repo.ListClubs(ctx, offset, pageSize)
+		if err != nil {
+			return result, err
+		}
+
+		for _, club := range clubs {
+			days := club.Settings.AuditRetentionDays
+			if days <= 0 {
+				continue
+			}
+
+			before := time.Now().AddDate(0, 0, -days)
+			pruned, err := s.repo.PruneAuditLogs(ctx, club.ID, before)
+			if err != nil {
+				return result, err
+			}
+			if pruned == 0 {
+				continue
+			}
+			result.Clubs++
+			result.Pruned += pruned
+
+			entry := &models.AuditLog{
+				Action:   models.AuditActionAuditPruned,
+				Resource: "audit_log",
+				Details:  fmt.Sprintf("Removed %d audit entries older than %d days", pruned, days),
+				Success:  true,
+				Metadata: map[string]interface{}{
+					"pruned":         pruned,
+					"retention_days": days,
+					"before":         before.UTC().Format(time.RFC3339),
+				},
+			}
+			entry.ClubID = club.ID
+			if err := s.repo.CreateAuditLog(ctx, entry); err != nil {
+				return result, err
+			}
+		}
+
+		if len(clubs) < pageSize {
+			break
+		}
+	}
+
+	if result.Pruned > 0 {
+		s.logger.Info("Audit retention enforced", map[string]interface{}{
+			"clubs":  result.Clubs,
+			"pruned": result.Pruned,
+		})
+	}
+
+	return result, nil
+}
+
+// AnchorAuditChains submits every chain head that moved since its last
+// anchor to blockchain-service and returns the number of chains anchored
+func (s *AuthService) AnchorAuditChains(ctx context.Context) (int, error) {
+	states, err := s.repo.ListAuditChainStates(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	anchored := 0
+	for _, state := range states {
+		if state.Sequence == 0 || state.Sequence == state.AnchoredSequence {
+			continue
+		}
+
+		if err := s.messageBus.Publish(ctx, auditAnchorSubject, map[string]interface{}{
+			"club_id":   state.ClubID,
+			"sequence":  state.Sequence,
+			"head_hash": state.HeadHash,
+			"timestamp": time.Now().UTC(),
+		}); err != nil {
+			return anchored, errors.Unavailable("Failed to publish audit anchor", map[string]interface{}{
+				"club_id": state.ClubID,
+			}, err)
+		}
+
+		anchor := &models.AuditAnchor{
+			Sequence: state.Sequence,
+			HeadHash: state.HeadHash,
+			Subject:  auditAnchorSubject,
+		}
+		anchor.ClubID = state.ClubID
+		if err := s.repo.CreateAuditAnchor(ctx, anchor); err != nil {
+			return anchored, err
+		}
+		anchored++
+	}
+
+	return anchored, nil
+}
+
+// RunAuditJobs enforces audit retention and anchors chain heads periodically
+// until ctx is cancelled. A zero anchor interval disables anchoring.
+func (s *AuthService) RunAuditJobs(ctx context.Context, anchorInterval, retentionInterval time.Duration) {
+	if retentionInterval <= 0 {
+		retentionInterval = 24 * time.Hour
+	}
+	retention := time.NewTicker(retentionInterval)
+	defer retention.Stop()
+
+	var anchors <-chan time.Time
+	if anchorInterval > 0 {
+		ticker := time.NewTicker(anchorInterval)
+		defer ticker.Stop()
+		anchors = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-retention.C:
+			if _, err := s.EnforceAuditRetention(ctx); err != nil {
+				s.logger.Error("Failed to enforce audit retention", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+		case <-anchors:
+			if _, err := s.AnchorAuditChains(ctx); err != nil {
+				s.logger.Error("Failed to anchor audit chains", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+		}
+	}
+}
+
+// newAuditSigner loads the manifest signing key. Manifests signed with an
+// ephemeral key only verify against their embedded key, so development is
+// the only environment allowed to fall back to one.
+func newAuditSigner(cfg *config.Config, logger logging.Logger) (*audit.Signer, error) {
+	development := strings.EqualFold(cfg.Service.Environment, "development")
+
+	if cfg.Audit.SigningKey != "" {
+		signer, err := audit.NewSigner(cfg.Audit.SigningKey)
+		if err == nil {
+			return signer, nil
+		}
+		if !development {
+			return nil, fmt.Errorf("invalid audit signing key: %w", err)
+		}
+		logger.Warn("Invalid audit signing key, using an ephemeral key", map[string]interface{}{
+			"error": err.Error(),
+		})
+	} else {
+		if !development {
+			return nil, fmt.Errorf("audit.signing_key is required outside development")
+		}
+		logger.Warn("No audit signing key configured, using an ephemeral key", map[string]interface{}{})
+	}
+
+	return audit.GenerateSigner()
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/audit"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/testutil"
+)
+
+// appendAuditLogs writes audit entries synchronously, unlike createAuditLog.
+// A zero createdAt stamps entries with the current time.
+func appendAuditLogs(t *testing.T, service *AuthService, club *models.Club, user *models.User, createdAt time.Time, count int) []*models.AuditLog {
+	ctx := testutil.TestContext()
+	entries := make([]*models.AuditLog, 0, count)
+	for i := 0; i < count; i++ {
+		entry := &models.AuditLog{
+			UserID:   &user.ID,
+			Action:   models.AuditActionLogin,
+			Details:  fmt.Sprintf("Login %d", i),
+			Success:  true,
+			Metadata: map[string]interface{}{"attempt": i},
+		}
+		entry.ClubID = club.ID
+		entry.CreatedAt = createdAt
+		if err := service.repo.CreateAuditLog(ctx, entry); err != nil {
+			t.Fatalf("Failed to create audit log: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestAuthService_VerifyAuditChain(t *testing.T) {
+	service, _, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	entries := appendAuditLogs(t, service, testClub, testUser, time.Time{}, 5)
+
+	report, err := service.VerifyAuditChain(ctx, testClub.ID)
+	testutil.AssertNoError(t, err, "Chain should be verified")
+	testutil.AssertTrue(t, report.Valid, "Untouched chain should be valid")
+	testutil.AssertEqual(t, 5, report.Checked, "All entries should be checked")
+	testutil.AssertEqual(t, entries[4].Hash, report.HeadHash, "Head should be the last entry")
+
+	// Entries cannot be updated through the ORM
+	entries[2].Details = "rewritten"
+	testutil.AssertError(t, db.Save(entries[2]).Error, "Audit entries should be immutable")
+
+	// Tampering directly in the database is detected
+	if err := db.Exec("UPDATE audit_logs SET details = ? WHERE id = ?", "rewritten", entries[2].ID).Error; err != nil {
+		t.Fatalf("Failed to tamper with audit log: %v", err)
+	}
+	if err := db.Exec("DELETE FROM audit_logs WHERE id = ?", entries[3].ID).Error; err != nil {
+		t.Fatalf("Failed to delete audit log: %v", err)
+	}
+
+	report, err = service.VerifyAuditChain(ctx, testClub.ID)
+	testutil.AssertNoError(t, err, "Chain should be verified")
+	testutil.AssertFalse(t, report.Valid, "Tampered chain should be invalid")
+	kinds := map[string]bool{}
+	for _, problem := range report.Problems {
+		kinds[problem.Kind] = true
+	}
+	testutil.AssertTrue(t, kinds[audit.ProblemHashMismatch], "Modified entry should be reported")
+	testutil.AssertTrue(t, kinds[audit.ProblemGap], "Deleted entry should be reported")
+
+	_, err = service.VerifyAuditChain(ctx, 9999)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrNotFound), "Unknown club should not be found")
+}
+
+func TestAuthService_ExportAuditLogs(t *testing.T) {
+	service, _, _, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	appendAuditLogs(t, service, testClub, testUser, time.Time{}, 3)
+
+	var out bytes.Buffer
+	manifest, err := service.ExportAuditLogs(ctx, &out, &audit.ExportRequest{ClubID: testClub.ID, Format: audit.FormatCSV})
+	testutil.AssertNoError(t, err, "Audit logs should be exported")
+	testutil.AssertEqual(t, 3, manifest.Entries, "All entries should be exported")
+	testutil.AssertNoError(t, audit.VerifyManifest(manifest, out.Bytes()), "Manifest should verify the export")
+	testutil.AssertContains(t, out.String(), "Login 2", "Export should contain entries")
+
+	_, err = service.ExportAuditLogs(ctx, &out, &audit.ExportRequest{ClubID: testClub.ID, Format: "pdf"})
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrInvalidInput), "Unsupported formats should be rejected")
+}
+
+func TestAuthService_EnforceAuditRetention(t *testing.T) {
+	service, _, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	appendAuditLogs(t, service, testClub, testUser, time.Now().AddDate(0, 0, -40), 2)
+	appendAuditLogs(t, service, testClub, testUser, time.Time{}, 2)
+
+	// Retention disabled keeps everything
+	result, err := service.EnforceAuditRetention(ctx)
+	testutil.AssertNoError(t, err, "Retention should run")
+	testutil.AssertEqual(t, int64(0), result.Pruned, "Nothing should be pruned without a retention policy")
+
+	testClub.Settings.AuditRetentionDays = 30
+	if err := db.Save(testClub).Error; err != nil {
+		t.Fatalf("Failed to update club settings: %v", err)
+	}
+
+	result, err = service.EnforceAuditRetention(ctx)
+	testutil.AssertNoError(t, err, "Retention should run")
+	testutil.AssertEqual(t, int64(2), result.Pruned, "Entries past retention should be pruned")
+
+	report, err := service.VerifyAuditChain(ctx, testClub.ID)
+	testutil.AssertNoError(t, err, "Chain should be verified")
+	testutil.AssertTrue(t, report.Valid, "Pruned chain should remain valid")
+	testutil.AssertEqual(t, uint64(3), report.FirstSequence, "Chain should resume after pruned entries")
+	testutil.AssertEqual(t, 3, report.Checked, "Remaining entries and the pruning record should be checked")
+
+	logs, _, err := service.repo.GetAuditLogsByAction(ctx, testClub.ID, models.AuditActionAuditPruned, 0, 10)
+	testutil.AssertNoError(t, err, "Audit logs should be listed")
+	testutil.AssertEqual(t, 1, len(logs), "Pruning should be recorded in the chain")
+}
+
+func TestAuthService_AnchorAuditChains(t *testing.T) {
+	service, _, _, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+	bus := service.messageBus.(*testutil.MockMessageBus)
+
+	entries := appendAuditLogs(t, service, testClub, testUser, time.Time{}, 2)
+
+	anchored, err := service.AnchorAuditChains(ctx)
+	testutil.AssertNoError(t, err, "Chains should be anchored")
+	testutil.AssertEqual(t, 1, anchored, "Club chain should be anchored")
+
+	messages := bus.GetMessages()
+	testutil.AssertEqual(t, 1, len(messages), "Anchor should be published")
+	testutil.AssertEqual(t, auditAnchorSubject, messages[0].Subject, "Anchor should go to blockchain-service")
+	payload := messages[0].Data.(map[string]interface{})
+	testutil.AssertEqual(t, entries[1].Hash, payload["head_hash"], "Anchor should carry the chain head")
+
+	anchored, err = service.AnchorAuditChains(ctx)
+	testutil.AssertNoError(t, err, "Chains should be anchored")
+	testutil.AssertEqual(t, 0, anchored, "Unchanged chains should not be anchored again")
+
+	anchors, err := service.repo.ListAuditAnchors(ctx, testClub.ID)
+	testutil.AssertNoError(t, err, "Anchors should be listed")
+	testutil.AssertEqual(t, 1, len(anchors), "Anchor should be recorded")
+	testutil.AssertEqual(t, uint64(2), anchors[0].Sequence, "Anchor should record the head sequence")
+}
+
+func TestNewAuditSigner(t *testing.T) {
+	logger := testutil.NewMockLogger()
+	cfg := testutil.NewMockConfig()
+
+	signer, err := newAuditSigner(cfg, logger)
+	testutil.AssertNoError(t, err, "Configured key should be loaded")
+	testutil.AssertTrue(t, signer != nil, "Signer should be returned")
+
+	cfg.Audit.SigningKey = "not-a-key"
+	_, err = newAuditSigner(cfg, logger)
+	testutil.AssertError(t, err, "Invalid key should be refused outside development")
+
+	cfg.Audit.SigningKey = ""
+	_, err = newAuditSigner(cfg, logger)
+	testutil.AssertError(t, err, "Missing key should be refused outside development")
+
+	cfg.Service.Environment = "development"
+	signer, err = newAuditSigner(cfg, logger)
+	testutil.AssertNoError(t, err, "Development should fall back to an ephemeral key")
+	testutil.AssertTrue(t, signer != nil, "Ephemeral signer should be returned")
+}
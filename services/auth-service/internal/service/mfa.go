@@ -0,0 +1,583 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/webauthn"
+)
+
+// backupCodeCount is the number of recovery codes issued per user
+const backupCodeCount = 8
+
+// ListMFAFactors lists a user's enrolled factors, primary first
+func (s *AuthService) ListMFAFactors(ctx context.Context, clubID, userID uint) ([]*models.MFAFactor, error) {
+	if _, err := s.repo.GetUserByID(ctx, clubID, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetMFAFactorsByUser(ctx, clubID, userID)
+}
+
+// SetPrimaryMFAFactor makes a verified factor the one offered first
+func (s *AuthService) SetPrimaryMFAFactor(ctx context.Context, clubID, userID, factorID uint) (*models.MFAFactor, error) {
+	user, err := s.repo.GetUserByID(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, err := s.repo.GetMFAFactorByID(ctx, clubID, userID, factorID)
+	if err != nil {
+		return nil, err
+	}
+	if !factor.Verified {
+		return nil, errors.InvalidInput("MFA factor must be verified before it can be primary", map[string]interface{}{
+			"factor_id": factorID,
+		}, nil)
+	}
+
+	club, err := s.repo.GetClubByID(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	if requiresPhishingResistantMFA(club, user) && !factor.IsPhishingResistant() {
+		return nil, errors.Forbidden("Club policy requires a phishing-resistant primary factor for administrators", map[string]interface{}{
+			"factor_id": factorID,
+		})
+	}
+
+	if err := s.repo.SetPrimaryMFAFactor(ctx, clubID, userID, factorID); err != nil {
+		return nil, err
+	}
+	factor.Primary = true
+
+	user.MFAMethod = string(factor.Type)
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	s.createAuditLog(ctx, clubID, user, models.AuditActionMFAPrimaryChanged, fmt.Sprintf("Primary MFA factor set to %s", factor.Type), true, "")
+
+	return factor, nil
+}
+
+// RemoveMFAFactor deletes an enrolled factor. Removing the last verified
+// factor disables MFA; administrators under the club's phishing-resistant
+// policy cannot remove their last WebAuthn factor.
+func (s *AuthService) RemoveMFAFactor(ctx context.Context, clubID, userID, factorID uint) error {
+	user, err := s.repo.GetUserByID(ctx, clubID, userID)
+	if err != nil {
+		return err
+	}
+
+	factor, err := s.repo.GetMFAFactorByID(ctx, clubID, userID, factorID)
+	if err != nil {
+		return err
+	}
+
+	factors, err := s.repo.GetMFAFactorsByUser(ctx, clubID, userID)
+	if err != nil {
+		return err
+	}
+	var remaining []*models.MFAFactor
+	for _, other := range verifiedFactors(factors) {
+		if other.ID != factor.ID {
+			remaining = append(remaining, other)
+		}
+	}
+
+	if factor.Verified && factor.IsPhishingResistant() && !hasPhishingResistantFactor(remaining) {
+		club, err := s.repo.GetClubByID(ctx, clubID)
+		if err != nil {
+			return err
+		}
+		if requiresPhishingResistantMFA(club, user) {
+			return errors.Forbidden("Club policy requires administrators to keep a phishing-resistant factor", map[string]interface{}{
+				"factor_id": factorID,
+			})
+		}
+	}
+
+	if err := s.repo.DeleteMFAFactor(ctx, clubID, userID, factorID); err != nil {
+		return err
+	}
+
+	switch {
+	case len(remaining) == 0 && factor.Verified:
+		user.DisableMFA()
+		if err := s.repo.UpdateUser(ctx, user); err != nil {
+			return err
+		}
+		s.createAuditLog(ctx, clubID, user, models.AuditActionMFADisabled, "MFA disabled after its last factor was removed", true, "")
+	case factor.Primary && len(remaining) > 0:
+		if err := s.repo.SetPrimaryMFAFactor(ctx, clubID, userID, remaining[0].ID); err != nil {
+			return err
+		}
+		user.MFAMethod = string(remaining[0].Type)
+		if err := s.repo.UpdateUser(ctx, user); err != nil {
+			return err
+		}
+	}
+
+	s.createAuditLog(ctx, clubID, user, models.AuditActionMFAFactorRemoved, fmt.Sprintf("MFA factor removed: %s", factor.Type), true, "")
+
+	s.logger.Info("MFA factor removed", map[string]interface{}{
+		"user_id":   user.ID,
+		"factor_id": factorID,
+		"type":      string(factor.Type),
+	})
+
+	return nil
+}
+
+// RegenerateBackupCodes replaces a user's backup codes. The new codes are
+// returned once; only their hashes are stored.
+func (s *AuthService) RegenerateBackupCodes(ctx context.Context, clubID, userID uint) ([]string, error) {
+	user, err := s.repo.GetUserByID(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.MFAEnabled {
+		return nil, errors.InvalidInput("MFA must be enabled to issue backup codes", nil, nil)
+	}
+
+	codes, err := s.issueBackupCodes(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	s.createAuditLog(ctx, clubID, user, models.AuditActionMFABackupCodesRegenerated, "MFA backup codes regenerated", true, "")
+
+	return codes, nil
+}
+
+// issueBackupCodes generates new backup codes and stores their hashes on the user
+func (s *AuthService) issueBackupCodes(ctx context.Context, user *models.User) ([]string, error) {
+	codes, err := s.mfaService.GenerateBackupCodes(backupCodeCount)
+	if err != nil {
+		return nil, errors.Internal("Failed to generate backup codes", nil, err)
+	}
+
+	hashed := make([]string, len(codes))
+	for i, code := range codes {
+		hashed[i] = s.mfaService.HashBackupCode(code)
+	}
+	user.MFABackupCodes = strings.Join(hashed, ",")
+
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// useBackupCode consumes a backup code; the caller saves the user
+func (s *AuthService) useBackupCode(user *models.User, code string) bool {
+	if code == "" {
+		return false
+	}
+	return user.UseBackupCode(s.mfaService.HashBackupCode(code))
+}
+
+// verifyBackupCodeMFA handles recovery with a backup code in VerifyMFA
+func (s *AuthService) verifyBackupCodeMFA(ctx context.Context, club *models.Club, user *models.User, code string) (*MFAVerifyResponse, error) {
+	if !user.MFAEnabled || !s.useBackupCode(user, code) {
+		s.recordFailedMFAAttempt(ctx, club, user)
+		s.createAuditLog(ctx, user.ClubID, user, models.AuditActionMFAVerification, "Failed MFA verification: backup", false, "Invalid code")
+		return &MFAVerifyResponse{
+			Success: false,
+			Message: "Backup code verification failed",
+		}, nil
+	}
+
+	user.ResetFailedAttempts()
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	s.createAuditLog(ctx, user.ClubID, user, models.AuditActionMFABackupUsed, "Backup code used for MFA", true, "")
+
+	return &MFAVerifyResponse{
+		Success:              true,
+		Message:              "Backup code verification successful",
+		BackupCodesRemaining: len(user.GetMFABackupCodes()),
+	}, nil
+}
+
+// replaceUnverifiedFactors stores a new factor, dropping abandoned enrollments of the same type
+func (s *AuthService) replaceUnverifiedFactors(ctx context.Context, user *models.User, factor *models.MFAFactor) error {
+	existing, err := s.repo.GetMFAFactorsByUser(ctx, user.ClubID, user.ID)
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if !other.Verified && other.Type == factor.Type {
+			if err := s.repo.DeleteMFAFactor(ctx, user.ClubID, user.ID, other.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.repo.CreateMFAFactor(ctx, factor)
+}
+
+// beginMFAVerification prepares the chosen factor: WebAuthn factors get
+// request options and SMS or email factors are sent a code
+func (s *AuthService) beginMFAVerification(ctx context.Context, user *models.User, factor *models.MFAFactor, factors []*models.MFAFactor) (*MFAVerifyResponse, error) {
+	response := &MFAVerifyResponse{
+		Success:              false,
+		BackupCodesRemaining: len(user.GetMFABackupCodes()),
+	}
+
+	switch factor.Type {
+	case models.MFAFactorTypeWebAuthn:
+		if !factor.Verified {
+			return nil, errors.InvalidInput("Complete the security key registration with its credential result", map[string]interface{}{
+				"factor_id": factor.ID,
+			}, nil)
+		}
+		options, err := s.beginWebAuthnMFA(ctx, user, factors)
+		if err != nil {
+			return nil, err
+		}
+		response.Options = options
+		response.Message = "Use your security key to continue"
+	case models.MFAFactorTypeSMS, models.MFAFactorTypeEmail:
+		if err := s.sendMFACode(ctx, user, string(factor.Type), "mfa_verification"); err != nil {
+			return nil, err
+		}
+		response.Message = fmt.Sprintf("Verification code sent by %s", factor.Type)
+	default:
+		response.Message = "Enter the code from your authenticator app"
+	}
+
+	return response, nil
+}
+
+// checkMFAFactor verifies a code or WebAuthn credential result against a
+// factor and returns the factor that was used
+func (s *AuthService) checkMFAFactor(ctx context.Context, user *models.User, factor *models.MFAFactor, factors []*models.MFAFactor, code string, credentialResult map[string]interface{}) (*models.MFAFactor, bool, error) {
+	switch factor.Type {
+	case models.MFAFactorTypeTOTP:
+		return factor, code != "" && s.mfaService.VerifyTOTPWithSkew(factor.Secret, code, 1), nil
+	case models.MFAFactorTypeSMS, models.MFAFactorTypeEmail:
+		verified, err := s.verifyTokenCode(ctx, user.ID, code, string(factor.Type))
+		if err != nil && !errors.Is(err, errors.ErrNotFound) {
+			return factor, false, err
+		}
+		return factor, verified, nil
+	case models.MFAFactorTypeWebAuthn:
+		if !factor.Verified {
+			verified, err := s.finishWebAuthnMFARegistration(ctx, user, factor, credentialResult)
+			return factor, verified, err
+		}
+		return s.verifyWebAuthnMFA(ctx, user, factors, credentialResult)
+	}
+
+	return factor, false, errors.InvalidInput("Invalid MFA method", map[string]interface{}{
+		"method": string(factor.Type),
+	}, nil)
+}
+
+// completeFactorVerification records a successful verification. A factor's
+// first success completes its enrollment and enables MFA for the user.
+func (s *AuthService) completeFactorVerification(ctx context.Context, user *models.User, factor *models.MFAFactor, factors []*models.MFAFactor) error {
+	primary := user.MFAMethod
+
+	if factor.ID == 0 && !user.MFAEnabled {
+		primary = string(factor.Type)
+	}
+	if factor.ID != 0 {
+		factor.MarkUsed()
+		if !factor.Verified {
+			// The first verified factor becomes primary
+			factor.Primary = len(verifiedFactors(factors)) == 0
+			factor.Verified = true
+		}
+		if err := s.repo.UpdateMFAFactor(ctx, factor); err != nil {
+			return err
+		}
+		if factor.Primary {
+			primary = string(factor.Type)
+		}
+	}
+
+	if user.MFAEnabled && user.MFAMethod == primary {
+		return nil
+	}
+
+	enabling := !user.MFAEnabled
+	user.MFAEnabled = true
+	user.MFAMethod = primary
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
+		return err
+	}
+	if enabling {
+		s.createAuditLog(ctx, user.ClubID, user, models.AuditActionMFAEnabled, "MFA enabled after successful verification", true, "")
+	}
+
+	return nil
+}
+
+// setupWebAuthnMFA starts registration of a security key or platform
+// authenticator as a second factor
+func (s *AuthService) setupWebAuthnMFA(ctx context.Context, user *models.User, factor *models.MFAFactor, attachment string) (*MFASetupResponse, error) {
+	if attachment != "" && attachment != "platform" && attachment != "cross-platform" {
+		return nil, errors.InvalidInput("Invalid authenticator attachment", map[string]interface{}{
+			"attachment": attachment,
+		}, nil)
+	}
+	if s.relyingParty == nil {
+		return nil, errors.Unavailable("WebAuthn is not configured", nil, nil)
+	}
+
+	factors, err := s.repo.GetMFAFactorsByUser(ctx, user.ClubID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, sessionData, err := s.relyingParty.BeginRegistration(webauthnUser(user), webauthnFactorCredentials(factors, false))
+	if err != nil {
+		return nil, errors.Internal("Failed to create registration options", nil, err)
+	}
+	// A second factor is never used to identify the user, so no resident key is needed
+	options.AuthenticatorSelection.AuthenticatorAttachment = attachment
+	options.AuthenticatorSelection.ResidentKey = "discouraged"
+
+	provider := &nativePasskeyProvider{rp: s.relyingParty, repo: s.repo}
+	if err := provider.saveSession(ctx, user, models.WebAuthnCeremonyMFARegistration, sessionData); err != nil {
+		return nil, err
+	}
+
+	optionsMap, err := toOptionsMap(options)
+	if err != nil {
+		return nil, err
+	}
+
+	factor.Attachment = attachment
+	if factor.Name == "" {
+		factor.Name = "Security key"
+		if attachment == "platform" {
+			factor.Name = "This device"
+		}
+	}
+
+	return &MFASetupResponse{
+		Options: optionsMap,
+		Success: true,
+		Message: "Register your security key, then verify it with the credential result",
+	}, nil
+}
+
+// finishWebAuthnMFARegistration verifies the attestation for an unverified
+// WebAuthn factor and stores the credential on it
+func (s *AuthService) finishWebAuthnMFARegistration(ctx context.Context, user *models.User, factor *models.MFAFactor, credentialResult map[string]interface{}) (bool, error) {
+	if s.relyingParty == nil {
+		return false, errors.Unavailable("WebAuthn is not configured", nil, nil)
+	}
+
+	provider := &nativePasskeyProvider{rp: s.relyingParty, repo: s.repo}
+	sessionData, err := provider.consumeSession(ctx, user, models.WebAuthnCeremonyMFARegistration)
+	if err != nil {
+		return false, err
+	}
+
+	var response webauthn.RegistrationResponse
+	if err := decodeCredentialResult(credentialResult, &response); err != nil {
+		return false, err
+	}
+
+	credential, err := s.relyingParty.FinishRegistration(sessionData, &response)
+	if err != nil {
+		s.logger.Warn("Security key registration failed", map[string]interface{}{
+			"error":     err.Error(),
+			"user_id":   user.ID,
+			"factor_id": factor.ID,
+		})
+		return false, nil
+	}
+
+	credentialID := webauthn.EncodeID(credential.ID)
+	if _, err := s.repo.GetMFAFactorByCredentialID(ctx, credentialID); err == nil {
+		return false, errors.Conflict("Security key is already registered", nil)
+	} else if !errors.Is(err, errors.ErrNotFound) {
+		return false, err
+	}
+
+	factor.CredentialID = credentialID
+	factor.PublicKey = credential.PublicKey
+	factor.AAGUID = hex.EncodeToString(credential.AAGUID)
+	factor.SignCount = credential.SignCount
+	factor.Transports = credential.Transports
+
+	return true, nil
+}
+
+// beginWebAuthnMFA creates request options covering all of the user's verified WebAuthn factors
+func (s *AuthService) beginWebAuthnMFA(ctx context.Context, user *models.User, factors []*models.MFAFactor) (map[string]interface{}, error) {
+	if s.relyingParty == nil {
+		return nil, errors.Unavailable("WebAuthn is not configured", nil, nil)
+	}
+
+	credentials := webauthnFactorCredentials(factors, true)
+	if len(credentials) == 0 {
+		return nil, errors.NotFound("No security keys enrolled for this account", map[string]interface{}{
+			"user_id": user.ID,
+		})
+	}
+
+	options, sessionData, err := s.relyingParty.BeginLogin(webauthnUser(user), credentials)
+	if err != nil {
+		return nil, errors.Internal("Failed to create authentication options", nil, err)
+	}
+
+	provider := &nativePasskeyProvider{rp: s.relyingParty, repo: s.repo}
+	if err := provider.saveSession(ctx, user, models.WebAuthnCeremonyMFAAuthentication, sessionData); err != nil {
+		return nil, err
+	}
+
+	return toOptionsMap(options)
+}
+
+// verifyWebAuthnMFA checks an assertion against the user's verified WebAuthn
+// factors and returns the factor that produced it
+func (s *AuthService) verifyWebAuthnMFA(ctx context.Context, user *models.User, factors []*models.MFAFactor, credentialResult map[string]interface{}) (*models.MFAFactor, bool, error) {
+	if s.relyingParty == nil {
+		return nil, false, errors.Unavailable("WebAuthn is not configured", nil, nil)
+	}
+
+	provider := &nativePasskeyProvider{rp: s.relyingParty, repo: s.repo}
+	sessionData, err := provider.consumeSession(ctx, user, models.WebAuthnCeremonyMFAAuthentication)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var response webauthn.AssertionResponse
+	if err := decodeCredentialResult(credentialResult, &response); err != nil {
+		return nil, false, err
+	}
+
+	used, err := s.relyingParty.FinishLogin(sessionData, webauthnFactorCredentials(factors, true), &response)
+	if err != nil {
+		fields := map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.ID,
+		}
+		if stderrors.Is(err, webauthn.ErrSignCountRegression) {
+			fields["possible_cloned_authenticator"] = true
+		}
+		s.logger.Warn("Security key verification failed", fields)
+		return nil, false, nil
+	}
+
+	credentialID := webauthn.EncodeID(used.ID)
+	for _, factor := range factors {
+		if factor.Type == models.MFAFactorTypeWebAuthn && factor.CredentialID == credentialID {
+			factor.SignCount = used.SignCount
+			return factor, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// requiresPhishingResistantMFA reports whether the club requires the user to
+// use a phishing-resistant second factor because they hold an admin role.
+// The user must be loaded with its roles.
+func requiresPhishingResistantMFA(club *models.Club, user *models.User) bool {
+	return club.Settings.RequirePhishingResistantAdminMFA && user.HasActiveRole(models.RoleAdmin, models.RoleReciprocalAdmin)
+}
+
+// selectMFAFactor resolves the factor a verification refers to. A factor ID
+// wins over a method; a method picks a verified factor of that type first.
+// Users enrolled before factors existed get a factor built from their user
+// record. Neither ID nor method selects nothing.
+func selectMFAFactor(user *models.User, factors []*models.MFAFactor, factorID uint, method string) (*models.MFAFactor, error) {
+	if factorID != 0 {
+		for _, factor := range factors {
+			if factor.ID == factorID {
+				return factor, nil
+			}
+		}
+		return nil, errors.NotFound("MFA factor not found", map[string]interface{}{
+			"factor_id": factorID,
+		})
+	}
+	if method == "" {
+		return nil, nil
+	}
+
+	var pending *models.MFAFactor
+	for _, factor := range factors {
+		if string(factor.Type) != method {
+			continue
+		}
+		if factor.Verified {
+			return factor, nil
+		}
+		if pending == nil {
+			pending = factor
+		}
+	}
+	if pending != nil {
+		return pending, nil
+	}
+
+	switch {
+	case method == "totp" && user.MFASecret != "":
+		return &models.MFAFactor{Type: models.MFAFactorTypeTOTP, Secret: user.MFASecret, Verified: user.MFAEnabled}, nil
+	case method == "sms" || method == "email":
+		return &models.MFAFactor{Type: models.MFAFactorType(method), Verified: user.MFAEnabled}, nil
+	}
+
+	return nil, errors.NotFound("No MFA factor enrolled for this method", map[string]interface{}{
+		"method": method,
+	})
+}
+
+// verifiedFactors filters out factors whose enrollment was never completed
+func verifiedFactors(factors []*models.MFAFactor) []*models.MFAFactor {
+	verified := make([]*models.MFAFactor, 0, len(factors))
+	for _, factor := range factors {
+		if factor.Verified {
+			verified = append(verified, factor)
+		}
+	}
+	return verified
+}
+
+func hasPhishingResistantFactor(factors []*models.MFAFactor) bool {
+	for _, factor := range factors {
+		if factor.Verified && factor.IsPhishingResistant() {
+			return true
+		}
+	}
+	return false
+}
+
+// webauthnFactorCredentials converts WebAuthn factors to relying party credentials
+func webauthnFactorCredentials(factors []*models.MFAFactor, verifiedOnly bool) []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(factors))
+	for _, factor := range factors {
+		if factor.Type != models.MFAFactorTypeWebAuthn || factor.CredentialID == "" {
+			continue
+		}
+		if verifiedOnly && !factor.Verified {
+			continue
+		}
+		id, err := webauthn.DecodeID(factor.CredentialID)
+		if err != nil {
+			continue
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:         id,
+			PublicKey:  factor.PublicKey,
+			SignCount:  factor.SignCount,
+			Transports: factor.Transports,
+		})
+	}
+	return credentials
+}
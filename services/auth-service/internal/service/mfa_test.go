@@ -0,0 +1,248 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/database"
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/hanko"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/testutil"
+	"reciprocal-clubs-backend/services/auth-service/internal/webauthn"
+
+	"github.com/pquerna/otp/totp"
+)
+
+func newTestRelyingParty(t *testing.T) *webauthn.RelyingParty {
+	rp, err := webauthn.NewRelyingParty(webauthn.Config{
+		RPID:    "localhost",
+		RPName:  "Test Clubs",
+		Origins: []string{"http://localhost:3000"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create relying party: %v", err)
+	}
+	return rp
+}
+
+// createMFAFactor stores an already verified factor
+func createMFAFactor(t *testing.T, db *database.Database, user *models.User, factorType models.MFAFactorType, primary bool) *models.MFAFactor {
+	factor := &models.MFAFactor{
+		UserID:   user.ID,
+		Type:     factorType,
+		Name:     string(factorType),
+		Primary:  primary,
+		Verified: true,
+	}
+	if factorType == models.MFAFactorTypeWebAuthn {
+		factor.CredentialID = webauthn.EncodeID([]byte("credential-" + user.Email))
+		factor.PublicKey = []byte{0x01}
+	}
+	factor.ClubID = user.ClubID
+	if err := db.Create(factor).Error; err != nil {
+		t.Fatalf("Failed to create MFA factor: %v", err)
+	}
+	return factor
+}
+
+func grantAdminRole(t *testing.T, db *database.Database, user *models.User) {
+	var role models.Role
+	if err := db.Where("club_id = ? AND name = ?", user.ClubID, models.RoleAdmin).First(&role).Error; err != nil {
+		t.Fatalf("Failed to find admin role: %v", err)
+	}
+	userRole := &models.UserRole{UserID: user.ID, RoleID: role.ID, IsActive: true}
+	userRole.ClubID = user.ClubID
+	if err := db.Create(userRole).Error; err != nil {
+		t.Fatalf("Failed to grant admin role: %v", err)
+	}
+}
+
+func TestAuthService_MFAFactors(t *testing.T) {
+	service, _, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	setup, err := service.SetupMFA(ctx, &MFASetupRequest{UserID: testUser.ID, ClubID: testClub.ID, Method: "totp"})
+	testutil.AssertNoError(t, err, "TOTP setup should succeed")
+	testutil.AssertTrue(t, setup.FactorID != 0, "Setup should return the factor")
+	testutil.AssertEqual(t, backupCodeCount, len(setup.BackupCodes), "First factor should issue backup codes")
+
+	var stored models.User
+	db.First(&stored, testUser.ID)
+	testutil.AssertFalse(t, strings.Contains(stored.MFABackupCodes, setup.BackupCodes[0]), "Backup codes should be stored hashed")
+
+	code, err := totp.GenerateCode(setup.Secret, time.Now())
+	testutil.AssertNoError(t, err, "TOTP code should be generated")
+	verify, err := service.VerifyMFA(ctx, &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID, FactorID: setup.FactorID, Code: code})
+	testutil.AssertNoError(t, err, "TOTP verification should succeed")
+	testutil.AssertTrue(t, verify.Success, "Valid TOTP code should verify the factor")
+
+	// A second factor does not issue new backup codes
+	emailSetup, err := service.SetupMFA(ctx, &MFASetupRequest{UserID: testUser.ID, ClubID: testClub.ID, Method: "email"})
+	testutil.AssertNoError(t, err, "Email setup should succeed")
+	testutil.AssertEqual(t, 0, len(emailSetup.BackupCodes), "Backup codes should only be issued once")
+
+	verify, err = service.VerifyMFA(ctx, &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID, FactorID: emailSetup.FactorID, Code: deliveredMFACode(t, service)})
+	testutil.AssertNoError(t, err, "Email verification should succeed")
+	testutil.AssertTrue(t, verify.Success, "Valid email code should verify the factor")
+
+	// Without a code the user is offered the enrolled factors
+	choice, err := service.VerifyMFA(ctx, &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID})
+	testutil.AssertNoError(t, err, "Factor choice should be returned")
+	testutil.AssertFalse(t, choice.Success, "Choosing a factor is not a verification")
+	testutil.AssertEqual(t, 2, len(choice.Factors), "Both factors should be offered")
+	testutil.AssertEqual(t, models.MFAFactorTypeTOTP, choice.Factors[0].Type, "First verified factor should be primary")
+	testutil.AssertTrue(t, choice.Factors[0].Primary, "Primary factor should be flagged")
+
+	factor, err := service.SetPrimaryMFAFactor(ctx, testClub.ID, testUser.ID, emailSetup.FactorID)
+	testutil.AssertNoError(t, err, "Primary factor should be changed")
+	testutil.AssertTrue(t, factor.Primary, "Factor should be primary")
+	db.First(&stored, testUser.ID)
+	testutil.AssertTrue(t, stored.MFAEnabled, "MFA should be enabled")
+	testutil.AssertEqual(t, "email", stored.MFAMethod, "User method should follow the primary factor")
+
+	// Backup codes recover access and are single use
+	backup := &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID, Method: "backup", Code: strings.ToLower(setup.BackupCodes[0])}
+	verify, err = service.VerifyMFA(ctx, backup)
+	testutil.AssertNoError(t, err, "Backup code verification should succeed")
+	testutil.AssertTrue(t, verify.Success, "Backup code should be accepted")
+	testutil.AssertEqual(t, backupCodeCount-1, verify.BackupCodesRemaining, "Used code should be consumed")
+	verify, err = service.VerifyMFA(ctx, backup)
+	testutil.AssertNoError(t, err, "Backup code verification should run")
+	testutil.AssertFalse(t, verify.Success, "Backup code should be single use")
+
+	codes, err := service.RegenerateBackupCodes(ctx, testClub.ID, testUser.ID)
+	testutil.AssertNoError(t, err, "Backup codes should be regenerated")
+	testutil.AssertEqual(t, backupCodeCount, len(codes), "A full set of codes should be issued")
+
+	// Removing the primary promotes the remaining factor; removing the last disables MFA
+	testutil.AssertNoError(t, service.RemoveMFAFactor(ctx, testClub.ID, testUser.ID, emailSetup.FactorID), "Factor should be removed")
+	factors, err := service.ListMFAFactors(ctx, testClub.ID, testUser.ID)
+	testutil.AssertNoError(t, err, "Factors should be listed")
+	testutil.AssertEqual(t, 1, len(factors), "One factor should remain")
+	testutil.AssertTrue(t, factors[0].Primary, "Remaining factor should become primary")
+
+	testutil.AssertNoError(t, service.RemoveMFAFactor(ctx, testClub.ID, testUser.ID, setup.FactorID), "Last factor should be removed")
+	db.First(&stored, testUser.ID)
+	testutil.AssertFalse(t, stored.MFAEnabled, "MFA should be disabled without factors")
+}
+
+func TestAuthService_SetupMFA_WebAuthn(t *testing.T) {
+	service, _, _, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	req := &MFASetupRequest{UserID: testUser.ID, ClubID: testClub.ID, Method: "webauthn", Attachment: "cross-platform"}
+	_, err := service.SetupMFA(ctx, req)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnavailable), "WebAuthn should require a relying party")
+
+	service.relyingParty = newTestRelyingParty(t)
+	setup, err := service.SetupMFA(ctx, req)
+	testutil.AssertNoError(t, err, "WebAuthn setup should succeed")
+	selection, _ := setup.Options["authenticatorSelection"].(map[string]interface{})
+	testutil.AssertEqual(t, "cross-platform", selection["authenticatorAttachment"], "Attachment should be requested")
+	testutil.AssertEqual(t, "discouraged", selection["residentKey"], "Second factors need no resident key")
+
+	_, err = service.repo.GetLatestWebAuthnSession(ctx, testClub.ID, testUser.ID, models.WebAuthnCeremonyMFARegistration)
+	testutil.AssertNoError(t, err, "Registration ceremony should be stored")
+
+	factor, err := service.repo.GetMFAFactorByID(ctx, testClub.ID, testUser.ID, setup.FactorID)
+	testutil.AssertNoError(t, err, "Factor should be stored")
+	testutil.AssertEqual(t, "Security key", factor.Name, "Security keys get a default name")
+	testutil.AssertFalse(t, factor.Verified, "Factor should wait for the attestation")
+
+	_, err = service.VerifyMFA(ctx, &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID, FactorID: setup.FactorID})
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrInvalidInput), "Unregistered key cannot start verification")
+
+	// Starting again replaces the abandoned enrollment
+	_, err = service.SetupMFA(ctx, req)
+	testutil.AssertNoError(t, err, "WebAuthn setup should restart")
+	factors, err := service.ListMFAFactors(ctx, testClub.ID, testUser.ID)
+	testutil.AssertNoError(t, err, "Factors should be listed")
+	testutil.AssertEqual(t, 1, len(factors), "Abandoned enrollment should be replaced")
+}
+
+func TestAuthService_PhishingResistantAdminPolicy(t *testing.T) {
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+	service.relyingParty = newTestRelyingParty(t)
+
+	mockHanko.AddUser(&hanko.HankoUser{ID: testUser.HankoUserID, Email: testUser.Email, EmailVerified: true})
+	grantAdminRole(t, db, testUser)
+	testClub.Settings.RequirePhishingResistantAdminMFA = true
+	if err := db.Save(testClub).Error; err != nil {
+		t.Fatalf("Failed to update club settings: %v", err)
+	}
+
+	// Without a security key the admin is told to enroll one
+	response, err := service.CompletePasskeyLogin(ctx, testClub.Slug, testUser.HankoUserID, map[string]interface{}{})
+	testutil.AssertNoError(t, err, "Login should succeed")
+	testutil.AssertTrue(t, response.MFAEnrollmentRequired, "Admin should be asked to enroll a security key")
+	testutil.AssertEqual(t, "", response.Token, "No token should be issued before a security key is enrolled")
+	testutil.AssertEqual(t, "", response.RefreshToken, "No refresh token should be issued before a security key is enrolled")
+
+	// The session cannot reach admin endpoints, only security key enrollment
+	_, err = service.ValidateSession(ctx, response.SessionID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "Enrollment-only session should be refused")
+	user, session, err := service.ValidateEnrollmentSession(ctx, response.SessionID)
+	testutil.AssertNoError(t, err, "Enrollment-only session should allow enrollment")
+	testutil.AssertTrue(t, session.EnrollmentOnly, "Session should be restricted to enrollment")
+	testutil.AssertEqual(t, testUser.ID, user.ID, "Session should belong to the admin")
+
+	key := createMFAFactor(t, db, testUser, models.MFAFactorTypeWebAuthn, true)
+	totpFactor := createMFAFactor(t, db, testUser, models.MFAFactorTypeTOTP, false)
+
+	// With a security key every login is stepped up to it
+	response, err = service.CompletePasskeyLogin(ctx, testClub.Slug, testUser.HankoUserID, map[string]interface{}{})
+	testutil.AssertNoError(t, err, "Login should be stepped up")
+	testutil.AssertTrue(t, response.MFARequired, "Admin should present the security key")
+	testutil.AssertEqual(t, "webauthn,backup", strings.Join(response.MFAMethods, ","), "Only phishing-resistant methods should be offered")
+	testutil.AssertTrue(t, response.MFAOptions != nil, "WebAuthn options should be returned")
+
+	_, err = service.CompleteMFAChallenge(ctx, &MFAChallengeRequest{
+		ClubSlug:    testClub.Slug,
+		HankoUserID: testUser.HankoUserID,
+		Challenge:   response.MFAChallenge,
+		Code:        "123456",
+		Method:      "totp",
+	})
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrInvalidInput), "TOTP should not satisfy the policy")
+
+	_, err = service.SetPrimaryMFAFactor(ctx, testClub.ID, testUser.ID, totpFactor.ID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "Primary factor must stay phishing-resistant")
+
+	err = service.RemoveMFAFactor(ctx, testClub.ID, testUser.ID, key.ID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "Last security key cannot be removed")
+
+	err = service.DisableMFA(ctx, testUser.ID, testClub.ID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "MFA cannot be disabled under the policy")
+
+	testutil.AssertNoError(t, service.RemoveMFAFactor(ctx, testClub.ID, testUser.ID, totpFactor.ID), "Other factors can be removed")
+}
+
+func TestAuthService_VerifyMFA_AttemptLimit(t *testing.T) {
+	service, _, _, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	setup, err := service.SetupMFA(ctx, &MFASetupRequest{UserID: testUser.ID, ClubID: testClub.ID, Method: "totp"})
+	testutil.AssertNoError(t, err, "TOTP setup should succeed")
+
+	code, err := totp.GenerateCode(setup.Secret, time.Now())
+	testutil.AssertNoError(t, err, "TOTP code should be generated")
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	for i := 0; i < testClub.Settings.MaxFailedAttempts; i++ {
+		verify, err := service.VerifyMFA(ctx, &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID, FactorID: setup.FactorID, Code: wrong})
+		testutil.AssertNoError(t, err, "Wrong code should be reported, not rejected")
+		testutil.AssertFalse(t, verify.Success, "Wrong code should not verify")
+	}
+
+	_, err = service.VerifyMFA(ctx, &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID, FactorID: setup.FactorID, Code: code})
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "Verification should be refused once attempts are used up")
+
+	_, err = service.VerifyMFA(ctx, &MFAVerifyRequest{UserID: testUser.ID, ClubID: testClub.ID, Method: "backup", Code: setup.BackupCodes[0]})
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "Backup codes should be refused once attempts are used up")
+}
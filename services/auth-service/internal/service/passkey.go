@@ -0,0 +1,712 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/repository"
+	"reciprocal-clubs-backend/services/auth-service/internal/webauthn"
+)
+
+// passkeyRecoveryTTL bounds how long an emailed recovery link stays valid
+const passkeyRecoveryTTL = 30 * time.Minute
+
+// passkeyEnrollmentTTL bounds how long a redeemed recovery link may be used
+// to register the replacement passkey
+const passkeyEnrollmentTTL = 10 * time.Minute
+
+// passkeyRecoveryDeliverySubject carries recovery links to the mailer. It is
+// a point-to-point delivery request rather than a domain event, so the token
+// never reaches event subscribers, exports or partner webhooks.
+const passkeyRecoveryDeliverySubject = "auth.delivery.passkey_recovery"
+
+// PasskeyProvider performs passkey ceremonies on behalf of a club. Hanko and
+// the native WebAuthn relying party are the two implementations.
+type PasskeyProvider interface {
+	Name() string
+	BeginRegistration(ctx context.Context, user *models.User) (map[string]interface{}, error)
+	FinishRegistration(ctx context.Context, user *models.User, name string, credentialResult map[string]interface{}) (*models.PasskeyCredential, error)
+	BeginAuthentication(ctx context.Context, user *models.User) (map[string]interface{}, error)
+	FinishAuthentication(ctx context.Context, user *models.User, credentialResult map[string]interface{}) (*PasskeyAssertion, error)
+}
+
+// PasskeyAssertion represents the outcome of a passkey authentication
+type PasskeyAssertion struct {
+	Success     bool
+	SessionID   string
+	ExpiresAt   time.Time
+	ErrorCode   string
+	ErrorDetail string
+}
+
+// PasskeyRegistrationCompleteRequest represents passkey registration completion request
+type PasskeyRegistrationCompleteRequest struct {
+	UserID           uint                   `json:"user_id" validate:"required"`
+	ClubID           uint                   `json:"club_id" validate:"required"`
+	Name             string                 `json:"name"`
+	CredentialResult map[string]interface{} `json:"credential_result" validate:"required"`
+}
+
+// PasskeyRecoveryRegistrationRequest registers a replacement passkey with the
+// enrollment token issued when a recovery link is redeemed
+type PasskeyRecoveryRegistrationRequest struct {
+	Email            string                 `json:"email" validate:"required,email"`
+	ClubSlug         string                 `json:"club_slug" validate:"required"`
+	EnrollmentToken  string                 `json:"enrollment_token" validate:"required"`
+	Name             string                 `json:"name"`
+	CredentialResult map[string]interface{} `json:"credential_result" validate:"required"`
+}
+
+// PasskeyRecoveryRequest represents a request to recover access without a passkey
+type PasskeyRecoveryRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	ClubSlug string `json:"club_slug" validate:"required"`
+}
+
+// PasskeyRecoveryResponse represents passkey recovery response
+type PasskeyRecoveryResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// PasskeyRecoveryConfirmRequest represents passkey recovery confirmation request
+type PasskeyRecoveryConfirmRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	ClubSlug string `json:"club_slug" validate:"required"`
+	Token    string `json:"token" validate:"required"`
+}
+
+// hankoPasskeyProvider delegates passkey ceremonies to the Hanko service
+type hankoPasskeyProvider struct {
+	client HankoClientInterface
+}
+
+func (p *hankoPasskeyProvider) Name() string {
+	return models.PasskeyProviderHanko
+}
+
+func (p *hankoPasskeyProvider) BeginRegistration(ctx context.Context, user *models.User) (map[string]interface{}, error) {
+	response, err := p.client.InitiatePasskeyRegistration(ctx, user.HankoUserID)
+	if err != nil {
+		return nil, err
+	}
+	return response.RegistrationOptions, nil
+}
+
+// FinishRegistration is a no-op for Hanko, which finalizes and stores
+// credentials on its own side, so there is no local credential to return
+func (p *hankoPasskeyProvider) FinishRegistration(ctx context.Context, user *models.User, name string, credentialResult map[string]interface{}) (*models.PasskeyCredential, error) {
+	return nil, nil
+}
+
+func (p *hankoPasskeyProvider) BeginAuthentication(ctx context.Context, user *models.User) (map[string]interface{}, error) {
+	response, err := p.client.InitiatePasskeyAuthentication(ctx, user.Email)
+	if err != nil {
+		return nil, err
+	}
+	return response.AuthenticationOptions, nil
+}
+
+func (p *hankoPasskeyProvider) FinishAuthentication(ctx context.Context, user *models.User, credentialResult map[string]interface{}) (*PasskeyAssertion, error) {
+	response, err := p.client.VerifyPasskey(ctx, user.HankoUserID, credentialResult)
+	if err != nil {
+		return nil, err
+	}
+	return &PasskeyAssertion{
+		Success:     response.Success,
+		SessionID:   response.Session.ID,
+		ExpiresAt:   response.Session.ExpiresAt,
+		ErrorCode:   response.ErrorCode,
+		ErrorDetail: response.ErrorDetail,
+	}, nil
+}
+
+// nativePasskeyProvider runs the WebAuthn relying party inside auth-service
+type nativePasskeyProvider struct {
+	rp   *webauthn.RelyingParty
+	repo *repository.AuthRepository
+}
+
+func (p *nativePasskeyProvider) Name() string {
+	return models.PasskeyProviderNative
+}
+
+func (p *nativePasskeyProvider) BeginRegistration(ctx context.Context, user *models.User) (map[string]interface{}, error) {
+	existing, err := p.credentials(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	options, sessionData, err := p.rp.BeginRegistration(webauthnUser(user), existing)
+	if err != nil {
+		return nil, errors.Internal("Failed to create registration options", nil, err)
+	}
+
+	if err := p.saveSession(ctx, user, models.WebAuthnCeremonyRegistration, sessionData); err != nil {
+		return nil, err
+	}
+
+	return toOptionsMap(options)
+}
+
+func (p *nativePasskeyProvider) FinishRegistration(ctx context.Context, user *models.User, name string, credentialResult map[string]interface{}) (*models.PasskeyCredential, error) {
+	sessionData, err := p.consumeSession(ctx, user, models.WebAuthnCeremonyRegistration)
+	if err != nil {
+		return nil, err
+	}
+
+	var response webauthn.RegistrationResponse
+	if err := decodeCredentialResult(credentialResult, &response); err != nil {
+		return nil, err
+	}
+
+	credential, err := p.rp.FinishRegistration(sessionData, &response)
+	if err != nil {
+		return nil, errors.InvalidInput("Passkey registration could not be verified", nil, err)
+	}
+
+	credentialID := webauthn.EncodeID(credential.ID)
+	if _, err := p.repo.GetPasskeyCredentialByCredentialID(ctx, credentialID); err == nil {
+		return nil, errors.Conflict("Passkey is already registered", nil)
+	} else if !errors.Is(err, errors.ErrNotFound) {
+		return nil, err
+	}
+
+	if name == "" {
+		existing, err := p.repo.GetPasskeyCredentialsByUser(ctx, user.ClubID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		name = fmt.Sprintf("Passkey %d", len(existing)+1)
+	}
+
+	passkey := &models.PasskeyCredential{
+		UserID:          user.ID,
+		CredentialID:    credentialID,
+		PublicKey:       credential.PublicKey,
+		Name:            name,
+		AttestationType: credential.AttestationType,
+		AAGUID:          hex.EncodeToString(credential.AAGUID),
+		SignCount:       credential.SignCount,
+		Transports:      credential.Transports,
+		BackupEligible:  credential.BackupEligible,
+		BackupState:     credential.BackupState,
+	}
+	passkey.ClubID = user.ClubID
+
+	if err := p.repo.CreatePasskeyCredential(ctx, passkey); err != nil {
+		return nil, err
+	}
+
+	return passkey, nil
+}
+
+func (p *nativePasskeyProvider) BeginAuthentication(ctx context.Context, user *models.User) (map[string]interface{}, error) {
+	credentials, err := p.credentials(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) == 0 {
+		return nil, errors.NotFound("No passkeys registered for this account", map[string]interface{}{
+			"user_id": user.ID,
+		})
+	}
+
+	options, sessionData, err := p.rp.BeginLogin(webauthnUser(user), credentials)
+	if err != nil {
+		return nil, errors.Internal("Failed to create authentication options", nil, err)
+	}
+
+	if err := p.saveSession(ctx, user, models.WebAuthnCeremonyAuthentication, sessionData); err != nil {
+		return nil, err
+	}
+
+	return toOptionsMap(options)
+}
+
+func (p *nativePasskeyProvider) FinishAuthentication(ctx context.Context, user *models.User, credentialResult map[string]interface{}) (*PasskeyAssertion, error) {
+	sessionData, err := p.consumeSession(ctx, user, models.WebAuthnCeremonyAuthentication)
+	if err != nil {
+		return &PasskeyAssertion{Success: false, ErrorCode: "session_not_found", ErrorDetail: err.Error()}, nil
+	}
+
+	var response webauthn.AssertionResponse
+	if err := decodeCredentialResult(credentialResult, &response); err != nil {
+		return &PasskeyAssertion{Success: false, ErrorCode: "invalid_credential", ErrorDetail: err.Error()}, nil
+	}
+
+	passkeys, err := p.repo.GetPasskeyCredentialsByUser(ctx, user.ClubID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	credentials := make([]webauthn.Credential, 0, len(passkeys))
+	byID := make(map[string]*models.PasskeyCredential, len(passkeys))
+	for _, passkey := range passkeys {
+		id, err := webauthn.DecodeID(passkey.CredentialID)
+		if err != nil {
+			continue
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:        id,
+			PublicKey: passkey.PublicKey,
+			SignCount: passkey.SignCount,
+		})
+		byID[passkey.CredentialID] = passkey
+	}
+
+	used, err := p.rp.FinishLogin(sessionData, credentials, &response)
+	if err != nil {
+		code := "verification_failed"
+		if stderrors.Is(err, webauthn.ErrSignCountRegression) {
+			code = "possible_cloned_authenticator"
+		}
+		return &PasskeyAssertion{Success: false, ErrorCode: code, ErrorDetail: err.Error()}, nil
+	}
+
+	passkey := byID[webauthn.EncodeID(used.ID)]
+	passkey.MarkUsed(used.SignCount, used.BackupState)
+	if err := p.repo.UpdatePasskeyCredential(ctx, passkey); err != nil {
+		return nil, err
+	}
+
+	club, err := p.repo.GetClubByID(ctx, user.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(club.Settings.SessionTimeoutMinutes) * time.Minute
+	if timeout <= 0 {
+		timeout = 8 * time.Hour
+	}
+
+	sessionID, err := newSessionID()
+	if err != nil {
+		return nil, errors.Internal("Failed to generate session ID", nil, err)
+	}
+
+	return &PasskeyAssertion{
+		Success:   true,
+		SessionID: sessionID,
+		ExpiresAt: time.Now().Add(timeout),
+	}, nil
+}
+
+func (p *nativePasskeyProvider) credentials(ctx context.Context, user *models.User) ([]webauthn.Credential, error) {
+	passkeys, err := p.repo.GetPasskeyCredentialsByUser(ctx, user.ClubID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := make([]webauthn.Credential, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		id, err := webauthn.DecodeID(passkey.CredentialID)
+		if err != nil {
+			continue
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:         id,
+			PublicKey:  passkey.PublicKey,
+			SignCount:  passkey.SignCount,
+			Transports: passkey.Transports,
+		})
+	}
+	return credentials, nil
+}
+
+func (p *nativePasskeyProvider) saveSession(ctx context.Context, user *models.User, ceremony models.WebAuthnCeremony, data *webauthn.SessionData) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return errors.Internal("Failed to encode WebAuthn session", nil, err)
+	}
+
+	session := &models.WebAuthnSession{
+		UserID:    user.ID,
+		Ceremony:  ceremony,
+		Data:      string(encoded),
+		ExpiresAt: data.ExpiresAt,
+	}
+	session.ClubID = user.ClubID
+
+	return p.repo.CreateWebAuthnSession(ctx, session)
+}
+
+func (p *nativePasskeyProvider) consumeSession(ctx context.Context, user *models.User, ceremony models.WebAuthnCeremony) (*webauthn.SessionData, error) {
+	session, err := p.repo.GetLatestWebAuthnSession(ctx, user.ClubID, user.ID, ceremony)
+	if err != nil {
+		return nil, err
+	}
+	if session.IsExpired() {
+		return nil, errors.InvalidInput("Passkey ceremony expired, please start again", nil, webauthn.ErrSessionExpired)
+	}
+	if err := p.repo.ConsumeWebAuthnSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	var data webauthn.SessionData
+	if err := json.Unmarshal([]byte(session.Data), &data); err != nil {
+		return nil, errors.Internal("Failed to decode WebAuthn session", nil, err)
+	}
+	return &data, nil
+}
+
+// passkeyProvider selects the passkey provider configured for a club
+func (s *AuthService) passkeyProvider(club *models.Club) (PasskeyProvider, error) {
+	if !club.Settings.EnablePasskeyAuth {
+		return nil, errors.Forbidden("Passkey authentication is disabled for this club", map[string]interface{}{
+			"club_id": club.ID,
+		})
+	}
+
+	if club.Settings.PasskeyProvider == models.PasskeyProviderNative {
+		if s.relyingParty == nil {
+			return nil, errors.Unavailable("Native passkey provider is not configured", nil, nil)
+		}
+		return &nativePasskeyProvider{rp: s.relyingParty, repo: s.repo}, nil
+	}
+
+	return &hankoPasskeyProvider{client: s.hankoClient}, nil
+}
+
+// CompletePasskeyRegistration verifies and stores a newly created passkey for
+// an authenticated user. Hanko stores the credential itself, so no passkey is
+// returned for Hanko clubs.
+func (s *AuthService) CompletePasskeyRegistration(ctx context.Context, req *PasskeyRegistrationCompleteRequest) (*models.PasskeyCredential, error) {
+	user, err := s.repo.GetUserByID(ctx, req.ClubID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	club, err := s.repo.GetClubByID(ctx, req.ClubID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.finishPasskeyRegistration(ctx, club, user, req.Name, req.CredentialResult)
+}
+
+// CompletePasskeyRecoveryRegistration registers the replacement passkey of a
+// user who redeemed a recovery link. The enrollment token is bound to the
+// user and consumed by the first attempt.
+func (s *AuthService) CompletePasskeyRecoveryRegistration(ctx context.Context, req *PasskeyRecoveryRegistrationRequest) (*models.PasskeyCredential, error) {
+	club, err := s.repo.GetClubBySlug(ctx, req.ClubSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := errors.Unauthorized("Invalid or expired enrollment token", nil)
+
+	user, err := s.repo.GetUserByEmail(ctx, club.ID, req.Email)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	token, err := s.repo.GetLatestMFAToken(ctx, user.ID, models.MFATokenTypePasskeyEnrollment)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	if token.Used || token.IsExpired() || token.Token != hashOneTimeToken(req.EnrollmentToken) {
+		s.createAuditLog(ctx, club.ID, user, models.AuditActionPasskeyRegistration, "Passkey recovery registration failed", false, "Invalid or expired enrollment token")
+		return nil, invalid
+	}
+
+	token.MarkAsUsed()
+	if err := s.repo.UpdateMFAToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return s.finishPasskeyRegistration(ctx, club, user, req.Name, req.CredentialResult)
+}
+
+// finishPasskeyRegistration completes a registration ceremony with the club's provider
+func (s *AuthService) finishPasskeyRegistration(ctx context.Context, club *models.Club, user *models.User, name string, credentialResult map[string]interface{}) (*models.PasskeyCredential, error) {
+	provider, err := s.passkeyProvider(club)
+	if err != nil {
+		return nil, err
+	}
+
+	passkey, err := provider.FinishRegistration(ctx, user, strings.TrimSpace(name), credentialResult)
+	if err != nil {
+		s.createAuditLog(ctx, club.ID, user, models.AuditActionPasskeyRegistration, "Passkey registration failed", false, err.Error())
+		return nil, err
+	}
+
+	s.createAuditLog(ctx, club.ID, user, models.AuditActionPasskeyRegistration, "Passkey registered", true, "")
+	s.publishUserEvent(ctx, "user.passkey_registered", user)
+
+	s.logger.Info("Passkey registration completed", map[string]interface{}{
+		"user_id":  user.ID,
+		"provider": provider.Name(),
+	})
+
+	return passkey, nil
+}
+
+// ListPasskeys lists the native passkeys registered by a user
+func (s *AuthService) ListPasskeys(ctx context.Context, clubID, userID uint) ([]*models.PasskeyCredential, error) {
+	if _, err := s.repo.GetUserByID(ctx, clubID, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPasskeyCredentialsByUser(ctx, clubID, userID)
+}
+
+// RenamePasskey changes the display name of a passkey
+func (s *AuthService) RenamePasskey(ctx context.Context, clubID, userID, passkeyID uint, name string) (*models.PasskeyCredential, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 64 {
+		return nil, errors.InvalidInput("Passkey name must be between 1 and 64 characters", nil, nil)
+	}
+
+	passkey, err := s.repo.GetPasskeyCredentialByID(ctx, clubID, userID, passkeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	passkey.Name = name
+	if err := s.repo.UpdatePasskeyCredential(ctx, passkey); err != nil {
+		return nil, err
+	}
+
+	return passkey, nil
+}
+
+// DeletePasskey removes a passkey from a user's account
+func (s *AuthService) DeletePasskey(ctx context.Context, clubID, userID, passkeyID uint) error {
+	user, err := s.repo.GetUserByID(ctx, clubID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeletePasskeyCredential(ctx, clubID, userID, passkeyID); err != nil {
+		return err
+	}
+
+	s.createAuditLog(ctx, clubID, user, models.AuditActionPasskeyRemoved, fmt.Sprintf("Passkey %d removed", passkeyID), true, "")
+
+	s.logger.Info("Passkey removed", map[string]interface{}{
+		"user_id":    user.ID,
+		"passkey_id": passkeyID,
+	})
+
+	return nil
+}
+
+// InitiatePasskeyRecovery issues a one-time recovery token so a user who lost
+// all passkeys can enroll a new one. The token is handed to the mailer on the
+// delivery subject; the user.passkey_recovery_requested event only records
+// that recovery was requested.
+func (s *AuthService) InitiatePasskeyRecovery(ctx context.Context, req *PasskeyRecoveryRequest) (*PasskeyRecoveryResponse, error) {
+	genericResponse := &PasskeyRecoveryResponse{
+		Success: true,
+		Message: "If an account with that email exists, a recovery link has been sent.",
+	}
+
+	club, err := s.repo.GetClubBySlug(ctx, req.ClubSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.GetUserByEmail(ctx, club.ID, req.Email)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			// Don't reveal that user doesn't exist
+			return genericResponse, nil
+		}
+		return nil, err
+	}
+
+	if !user.IsActive() {
+		return genericResponse, nil
+	}
+
+	token, err := s.mfaService.GenerateRandomToken(32)
+	if err != nil {
+		return nil, errors.Internal("Failed to generate recovery token", nil, err)
+	}
+
+	expiresAt := time.Now().Add(passkeyRecoveryTTL)
+	mfaToken := &models.MFAToken{
+		UserID:    user.ID,
+		TokenType: models.MFATokenTypePasskeyRecovery,
+		Token:     hashOneTimeToken(token),
+		ExpiresAt: &expiresAt,
+	}
+	mfaToken.ClubID = club.ID
+
+	if err := s.repo.CreateMFAToken(ctx, mfaToken); err != nil {
+		return nil, err
+	}
+
+	err = s.messageBus.PublishSync(ctx, passkeyRecoveryDeliverySubject, map[string]interface{}{
+		"user_id":        user.ID,
+		"club_id":        club.ID,
+		"email":          user.Email,
+		"recovery_token": token,
+		"expires_at":     expiresAt.UTC(),
+	})
+	if err != nil {
+		// Don't reveal that the user exists; they can ask for another link
+		s.logger.Error("Failed to deliver passkey recovery link", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.ID,
+		})
+		return genericResponse, nil
+	}
+
+	s.createAuditLog(ctx, club.ID, user, models.AuditActionPasskeyRecoveryRequested, "Passkey recovery requested", true, "")
+	s.publishUserEventWithData(ctx, "user.passkey_recovery_requested", user, map[string]interface{}{
+		"expires_at": expiresAt.UTC(),
+	})
+
+	return genericResponse, nil
+}
+
+// CompletePasskeyRecovery redeems a recovery token and starts registration of
+// a replacement passkey. The response carries a short-lived enrollment token
+// that CompletePasskeyRecoveryRegistration requires, since the user has no
+// session to register with.
+func (s *AuthService) CompletePasskeyRecovery(ctx context.Context, req *PasskeyRecoveryConfirmRequest) (*PasskeyResponse, error) {
+	club, err := s.repo.GetClubBySlug(ctx, req.ClubSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := errors.Unauthorized("Invalid or expired recovery token", nil)
+
+	user, err := s.repo.GetUserByEmail(ctx, club.ID, req.Email)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	token, err := s.repo.GetLatestMFAToken(ctx, user.ID, models.MFATokenTypePasskeyRecovery)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	if token.Used || token.IsExpired() || token.Token != hashOneTimeToken(req.Token) {
+		s.createAuditLog(ctx, club.ID, user, models.AuditActionPasskeyRecoveryCompleted, "Passkey recovery failed", false, "Invalid or expired recovery token")
+		return nil, invalid
+	}
+
+	token.MarkAsUsed()
+	if err := s.repo.UpdateMFAToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.passkeyProvider(club)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := provider.BeginRegistration(ctx, user)
+	if err != nil {
+		return nil, errors.Internal("Failed to initiate passkey registration", nil, err)
+	}
+
+	enrollment, err := s.mfaService.GenerateRandomToken(32)
+	if err != nil {
+		return nil, errors.Internal("Failed to generate enrollment token", nil, err)
+	}
+
+	expiresAt := time.Now().Add(passkeyEnrollmentTTL)
+	enrollmentToken := &models.MFAToken{
+		UserID:    user.ID,
+		TokenType: models.MFATokenTypePasskeyEnrollment,
+		Token:     hashOneTimeToken(enrollment),
+		ExpiresAt: &expiresAt,
+	}
+	enrollmentToken.ClubID = club.ID
+
+	if err := s.repo.CreateMFAToken(ctx, enrollmentToken); err != nil {
+		return nil, err
+	}
+
+	s.createAuditLog(ctx, club.ID, user, models.AuditActionPasskeyRecoveryCompleted, "Passkey recovery token redeemed", true, "")
+
+	return &PasskeyResponse{
+		Options:         options,
+		UserID:          user.HankoUserID,
+		EnrollmentToken: enrollment,
+	}, nil
+}
+
+// validateNativeSession validates a session issued by the native passkey provider
+func (s *AuthService) validateNativeSession(ctx context.Context, session *models.UserSession) (*models.User, error) {
+	if !session.IsValid() {
+		return nil, errors.Unauthorized("Session expired", nil)
+	}
+
+	user, err := s.repo.GetUserByID(ctx, session.ClubID, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.UpdateActivity()
+	s.repo.UpdateSession(ctx, session)
+
+	return user, nil
+}
+
+func webauthnUser(user *models.User) webauthn.User {
+	return webauthn.User{
+		ID:          []byte(user.HankoUserID),
+		Name:        user.Email,
+		DisplayName: user.GetFullName(),
+	}
+}
+
+func decodeCredentialResult(credentialResult map[string]interface{}, out interface{}) error {
+	raw, err := json.Marshal(credentialResult)
+	if err != nil {
+		return errors.InvalidInput("Invalid credential result", nil, err)
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return errors.InvalidInput("Invalid credential result", nil, err)
+	}
+	return nil
+}
+
+func toOptionsMap(options interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, errors.Internal("Failed to encode passkey options", nil, err)
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, errors.Internal("Failed to encode passkey options", nil, err)
+	}
+	return out, nil
+}
+
+func hashOneTimeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "wa_" + hex.EncodeToString(b), nil
+}
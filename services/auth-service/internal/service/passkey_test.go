@@ -0,0 +1,161 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/hanko"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/testutil"
+	"reciprocal-clubs-backend/services/auth-service/internal/webauthn"
+)
+
+func TestAuthService_PasskeyProvider_Selection(t *testing.T) {
+	service, _, _, testClub, _ := setupTestService(t)
+
+	provider, err := service.passkeyProvider(testClub)
+	testutil.AssertNoError(t, err, "Default provider should be available")
+	testutil.AssertEqual(t, models.PasskeyProviderHanko, provider.Name(), "Default provider should be Hanko")
+
+	testClub.Settings.PasskeyProvider = models.PasskeyProviderNative
+	_, err = service.passkeyProvider(testClub)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnavailable), "Native provider without relying party should be unavailable")
+
+	rp, err := webauthn.NewRelyingParty(webauthn.Config{
+		RPID:    "localhost",
+		RPName:  "Test Clubs",
+		Origins: []string{"http://localhost:3000"},
+	})
+	testutil.AssertNoError(t, err, "Relying party should be created")
+	service.relyingParty = rp
+
+	provider, err = service.passkeyProvider(testClub)
+	testutil.AssertNoError(t, err, "Native provider should be available")
+	testutil.AssertEqual(t, models.PasskeyProviderNative, provider.Name(), "Provider should be native")
+
+	testClub.Settings.EnablePasskeyAuth = false
+	_, err = service.passkeyProvider(testClub)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "Disabled passkeys should be forbidden")
+}
+
+func TestAuthService_PasskeyManagement(t *testing.T) {
+	service, _, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	passkey := &models.PasskeyCredential{
+		UserID:       testUser.ID,
+		CredentialID: "cred-1",
+		PublicKey:    []byte{0x01},
+		Name:         "Laptop",
+	}
+	passkey.ClubID = testClub.ID
+	if err := db.Create(passkey).Error; err != nil {
+		t.Fatalf("Failed to create passkey: %v", err)
+	}
+
+	passkeys, err := service.ListPasskeys(ctx, testClub.ID, testUser.ID)
+	testutil.AssertNoError(t, err, "List passkeys should succeed")
+	testutil.AssertEqual(t, 1, len(passkeys), "Should list one passkey")
+
+	_, err = service.RenamePasskey(ctx, testClub.ID, testUser.ID, passkey.ID, "  ")
+	testutil.AssertError(t, err, "Blank passkey name should be rejected")
+
+	renamed, err := service.RenamePasskey(ctx, testClub.ID, testUser.ID, passkey.ID, "Phone")
+	testutil.AssertNoError(t, err, "Rename passkey should succeed")
+	testutil.AssertEqual(t, "Phone", renamed.Name, "Passkey should be renamed")
+
+	err = service.DeletePasskey(ctx, testClub.ID, testUser.ID, passkey.ID)
+	testutil.AssertNoError(t, err, "Delete passkey should succeed")
+
+	err = service.DeletePasskey(ctx, testClub.ID, testUser.ID, passkey.ID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrNotFound), "Deleting a removed passkey should return not found")
+}
+
+func TestAuthService_PasskeyRecovery(t *testing.T) {
+	service, mockHanko, _, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+	bus := service.messageBus.(*testutil.MockMessageBus)
+
+	mockHanko.AddUser(&hanko.HankoUser{
+		ID:            testUser.HankoUserID,
+		Email:         testUser.Email,
+		EmailVerified: true,
+	})
+
+	// Unknown emails get the same response as known ones
+	response, err := service.InitiatePasskeyRecovery(ctx, &PasskeyRecoveryRequest{
+		Email:    "nobody@example.com",
+		ClubSlug: testClub.Slug,
+	})
+	testutil.AssertNoError(t, err, "Recovery for unknown email should not fail")
+	testutil.AssertTrue(t, response.Success, "Recovery response should be generic")
+
+	response, err = service.InitiatePasskeyRecovery(ctx, &PasskeyRecoveryRequest{
+		Email:    testUser.Email,
+		ClubSlug: testClub.Slug,
+	})
+	testutil.AssertNoError(t, err, "Recovery should succeed")
+	testutil.AssertTrue(t, response.Success, "Recovery should report success")
+
+	// The token goes to the mailer only, never on the domain event
+	var token string
+	eventSeen := false
+	for deadline := time.Now().Add(time.Second); !eventSeen && time.Now().Before(deadline); {
+		for _, msg := range bus.GetMessages() {
+			data, _ := msg.Data.(map[string]interface{})
+			switch msg.Subject {
+			case passkeyRecoveryDeliverySubject:
+				token, _ = data["recovery_token"].(string)
+			case "user.passkey_recovery_requested":
+				eventSeen = true
+				_, leaked := data["recovery_token"]
+				testutil.AssertFalse(t, leaked, "Recovery event should not carry the token")
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	testutil.AssertNotEqual(t, "", token, "Recovery token should be delivered")
+	testutil.AssertTrue(t, eventSeen, "Recovery event should be published")
+
+	_, err = service.CompletePasskeyRecovery(ctx, &PasskeyRecoveryConfirmRequest{
+		Email:    testUser.Email,
+		ClubSlug: testClub.Slug,
+		Token:    "wrong-token",
+	})
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Wrong token should be rejected")
+
+	passkeyResponse, err := service.CompletePasskeyRecovery(ctx, &PasskeyRecoveryConfirmRequest{
+		Email:    testUser.Email,
+		ClubSlug: testClub.Slug,
+		Token:    token,
+	})
+	testutil.AssertNoError(t, err, "Valid token should be accepted")
+	testutil.AssertTrue(t, len(passkeyResponse.Options) > 0, "Registration options should be returned")
+	testutil.AssertNotEqual(t, "", passkeyResponse.EnrollmentToken, "Enrollment token should be returned")
+
+	_, err = service.CompletePasskeyRecovery(ctx, &PasskeyRecoveryConfirmRequest{
+		Email:    testUser.Email,
+		ClubSlug: testClub.Slug,
+		Token:    token,
+	})
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Recovery token should be single use")
+
+	// Registration without a session needs the enrollment token bound to the user
+	registration := &PasskeyRecoveryRegistrationRequest{
+		Email:            testUser.Email,
+		ClubSlug:         testClub.Slug,
+		EnrollmentToken:  token,
+		CredentialResult: map[string]interface{}{"id": "credential"},
+	}
+	_, err = service.CompletePasskeyRecoveryRegistration(ctx, registration)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Recovery token should not enroll a passkey")
+
+	registration.EnrollmentToken = passkeyResponse.EnrollmentToken
+	passkey, err := service.CompletePasskeyRecoveryRegistration(ctx, registration)
+	testutil.AssertNoError(t, err, "Enrollment token should register the passkey")
+	testutil.AssertTrue(t, passkey == nil, "Hanko keeps the credential on its side")
+
+	_, err = service.CompletePasskeyRecoveryRegistration(ctx, registration)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Enrollment token should be single use")
+}
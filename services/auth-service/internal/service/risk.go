@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/risk"
+)
+
+// MFAChallengeRequest completes a login that was stepped up to MFA. WebAuthn
+// challenges carry the assertion in CredentialResult instead of a code.
+type MFAChallengeRequest struct {
+	ClubSlug         string                 `json:"club_slug" validate:"required"`
+	HankoUserID      string                 `json:"hanko_user_id" validate:"required"`
+	Challenge        string                 `json:"challenge" validate:"required"`
+	Code             string                 `json:"code" validate:"required_unless=Method webauthn"`
+	Method           string                 `json:"method" validate:"required,oneof=totp sms email webauthn backup"`
+	CredentialResult map[string]interface{} `json:"credential_result,omitempty"`
+}
+
+// assessLoginRisk scores a login that has passed passkey verification and
+// records the decision with the signals used in the audit log
+func (s *AuthService) assessLoginRisk(ctx context.Context, club *models.Club, user *models.User) *risk.Assessment {
+	if s.riskEngine == nil || !club.Settings.EnableRiskEngine {
+		return &risk.Assessment{Decision: risk.DecisionAllow}
+	}
+
+	attempt := risk.Attempt{
+		IPAddress:         s.getIPFromContext(ctx),
+		DeviceFingerprint: s.getDeviceFingerprintFromContext(ctx),
+		Time:              time.Now(),
+		UserFailures:      user.FailedAttempts,
+	}
+
+	window := time.Duration(s.config.Risk.FailureWindow) * time.Second
+	if window <= 0 {
+		window = 15 * time.Minute
+	}
+	if failures, err := s.repo.CountFailedLogins(ctx, club.ID, time.Now().Add(-window)); err == nil {
+		attempt.ClubFailures = int(failures)
+	} else {
+		s.logger.Warn("Failed to count recent club login failures", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": club.ID,
+		})
+	}
+
+	historySize := s.config.Risk.HistorySize
+	if historySize <= 0 {
+		historySize = 20
+	}
+	var history []risk.PriorLogin
+	sessions, err := s.repo.GetRecentSessionsByUser(ctx, club.ID, user.ID, historySize)
+	if err != nil {
+		s.logger.Warn("Failed to load login history", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.ID,
+		})
+	}
+	for _, session := range sessions {
+		fingerprint := session.DeviceFingerprint
+		if fingerprint == "" {
+			fingerprint = fingerprintUserAgent(session.UserAgent)
+		}
+		history = append(history, risk.PriorLogin{
+			IPAddress:         session.IPAddress,
+			DeviceFingerprint: fingerprint,
+			Time:              session.CreatedAt,
+		})
+	}
+
+	assessment := s.riskEngine.Evaluate(attempt, history, risk.Thresholds{
+		Notify: club.Settings.RiskNotifyThreshold,
+		StepUp: club.Settings.RiskStepUpThreshold,
+		Block:  club.Settings.RiskBlockThreshold,
+	})
+
+	s.createAuditLogWithMetadata(ctx, club.ID, user, models.AuditActionRiskAssessment,
+		fmt.Sprintf("Login risk score %d: %s", assessment.Score, assessment.Decision),
+		assessment.Decision != risk.DecisionBlock, "", assessment.Metadata())
+
+	s.logger.Info("Login risk assessed", map[string]interface{}{
+		"user_id":  user.ID,
+		"score":    assessment.Score,
+		"decision": string(assessment.Decision),
+	})
+
+	return assessment
+}
+
+// blockLogin rejects a verified login that scored above the club's block threshold
+func (s *AuthService) blockLogin(ctx context.Context, club *models.Club, user *models.User, assertion *PasskeyAssertion, providerName string, assessment *risk.Assessment) error {
+	if providerName == models.PasskeyProviderHanko {
+		if err := s.hankoClient.InvalidateSession(ctx, assertion.SessionID); err != nil {
+			s.logger.Warn("Failed to invalidate blocked Hanko session", map[string]interface{}{
+				"error":   err.Error(),
+				"user_id": user.ID,
+			})
+		}
+	}
+
+	s.createAuditLog(ctx, club.ID, user, models.AuditActionLogin, "Login blocked by risk engine", false, "Login blocked due to unusual activity")
+	s.publishUserEventWithData(ctx, "user.login_blocked", user, assessment.Metadata())
+
+	return errors.Forbidden("Login blocked due to unusual activity", map[string]interface{}{
+		"decision": string(assessment.Decision),
+	})
+}
+
+// requireStepUp defers session creation until the user passes an MFA
+// challenge. The provider session is held in the challenge: with no local
+// session recorded, ValidateSession rejects it until the challenge is passed.
+func (s *AuthService) requireStepUp(ctx context.Context, club *models.Club, user *models.User, assertion *PasskeyAssertion, providerName string, assessment *risk.Assessment) (*AuthResponse, error) {
+	challenge, err := s.mfaService.GenerateRandomToken(32)
+	if err != nil {
+		return nil, errors.Internal("Failed to generate MFA challenge", nil, err)
+	}
+
+	ttl := time.Duration(s.config.Risk.ChallengeTTL) * time.Second
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	token := &models.MFAToken{
+		UserID:    user.ID,
+		TokenType: models.MFATokenTypeLoginChallenge,
+		Token:     hashOneTimeToken(challenge),
+		ExpiresAt: &expiresAt,
+		Metadata: map[string]interface{}{
+			"session_id":         assertion.SessionID,
+			"session_expires_at": assertion.ExpiresAt.UTC().Format(time.RFC3339Nano),
+			"provider":           providerName,
+			"device_fingerprint": s.getDeviceFingerprintFromContext(ctx),
+			"risk_score":         assessment.Score,
+		},
+	}
+	token.ClubID = club.ID
+
+	if err := s.repo.CreateMFAToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	methods, factors, err := s.stepUpMethods(ctx, club, user)
+	if err != nil {
+		return nil, err
+	}
+	if methods[0] == "sms" || methods[0] == "email" {
+		if err := s.sendMFACode(ctx, user, methods[0], "login_step_up"); err != nil {
+			return nil, err
+		}
+	}
+
+	var options map[string]interface{}
+	for _, method := range methods {
+		if method == "webauthn" {
+			if options, err = s.beginWebAuthnMFA(ctx, user, factors); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	s.publishUserEventWithData(ctx, "user.login_step_up", user, assessment.Metadata())
+
+	s.logger.Info("Login stepped up to MFA", map[string]interface{}{
+		"user_id": user.ID,
+		"methods": methods,
+	})
+
+	return &AuthResponse{
+		User:         user,
+		ExpiresAt:    expiresAt,
+		MFARequired:  true,
+		MFAChallenge: challenge,
+		MFAMethods:   methods,
+		MFAOptions:   options,
+	}, nil
+}
+
+// CompleteMFAChallenge verifies the second factor for a stepped-up login and issues the session
+func (s *AuthService) CompleteMFAChallenge(ctx context.Context, req *MFAChallengeRequest) (*AuthResponse, error) {
+	club, err := s.repo.GetClubBySlug(ctx, req.ClubSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := errors.Unauthorized("Invalid or expired MFA challenge", nil)
+
+	user, err := s.repo.GetUserByHankoID(ctx, club.ID, req.HankoUserID)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	if !user.IsActive() {
+		return nil, errors.Forbidden("Account is not active", map[string]interface{}{
+			"status": string(user.Status),
+		})
+	}
+
+	challenge, err := s.repo.GetLatestMFAToken(ctx, user.ID, models.MFATokenTypeLoginChallenge)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	if challenge.Used || challenge.IsExpired() || challenge.Token != hashOneTimeToken(req.Challenge) {
+		return nil, invalid
+	}
+
+	methods, factors, err := s.stepUpMethods(ctx, club, user)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := false
+	for _, method := range methods {
+		if method == req.Method {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, errors.InvalidInput("MFA method not available for this challenge", map[string]interface{}{
+			"method": req.Method,
+		}, nil)
+	}
+
+	var verified bool
+	if req.Method == "backup" {
+		verified = s.useBackupCode(user, req.Code)
+		if verified {
+			s.createAuditLog(ctx, club.ID, user, models.AuditActionMFABackupUsed, "Backup code used for login step-up", true, "")
+		}
+	} else {
+		factor, err := selectMFAFactor(user, factors, 0, req.Method)
+		if err != nil {
+			return nil, err
+		}
+		var used *models.MFAFactor
+		used, verified, err = s.checkMFAFactor(ctx, user, factor, factors, req.Code, req.CredentialResult)
+		if err != nil {
+			return nil, err
+		}
+		if verified && used.ID != 0 {
+			used.MarkUsed()
+			if err := s.repo.UpdateMFAFactor(ctx, used); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !verified {
+		// Too many wrong codes burns the challenge and the held session so
+		// the passkey must be presented again
+		if s.recordFailedMFAAttempt(ctx, club, user) {
+			challenge.MarkAsUsed()
+			s.repo.UpdateMFAToken(ctx, challenge)
+			s.releaseHeldSession(ctx, user, challenge)
+		}
+		s.createAuditLog(ctx, club.ID, user, models.AuditActionMFAVerification, fmt.Sprintf("Failed login step-up: %s", req.Method), false, "Invalid code")
+		return nil, errors.Unauthorized("Invalid verification code", nil)
+	}
+
+	challenge.MarkAsUsed()
+	if err := s.repo.UpdateMFAToken(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	s.createAuditLog(ctx, club.ID, user, models.AuditActionMFAVerification, fmt.Sprintf("Successful login step-up: %s", req.Method), true, "")
+
+	sessionID, _ := challenge.Metadata["session_id"].(string)
+	providerName, _ := challenge.Metadata["provider"].(string)
+	expiresAt := time.Now().Add(8 * time.Hour)
+	if raw, ok := challenge.Metadata["session_expires_at"].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339Nano, raw); err == nil {
+			expiresAt = parsed
+		}
+	}
+	if fingerprint, ok := challenge.Metadata["device_fingerprint"].(string); ok && fingerprint != "" {
+		ctx = context.WithValue(ctx, "device_fingerprint", fingerprint)
+	}
+
+	return s.establishSession(ctx, club, user, sessionID, expiresAt, providerName)
+}
+
+// recordFailedMFAAttempt counts a wrong second factor against the user and
+// reports whether it used up the club's allowance
+func (s *AuthService) recordFailedMFAAttempt(ctx context.Context, club *models.Club, user *models.User) bool {
+	user.IncrementFailedAttempts()
+	s.repo.UpdateUser(ctx, user)
+	return mfaAttemptsExhausted(club, user)
+}
+
+// mfaAttemptsExhausted reports whether the user has reached the club's limit
+// of failed attempts. The count is cleared by the next completed login or
+// verified factor.
+func mfaAttemptsExhausted(club *models.Club, user *models.User) bool {
+	maxAttempts := club.Settings.MaxFailedAttempts
+	return maxAttempts > 0 && user.FailedAttempts >= maxAttempts
+}
+
+// releaseHeldSession ends the provider session held by an abandoned step-up
+func (s *AuthService) releaseHeldSession(ctx context.Context, user *models.User, challenge *models.MFAToken) {
+	sessionID, _ := challenge.Metadata["session_id"].(string)
+	providerName, _ := challenge.Metadata["provider"].(string)
+	if sessionID == "" || providerName != models.PasskeyProviderHanko {
+		return
+	}
+	if err := s.hankoClient.InvalidateSession(ctx, sessionID); err != nil {
+		s.logger.Warn("Failed to invalidate held Hanko session", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.ID,
+		})
+	}
+}
+
+// mfaCodeDeliverySubject carries SMS and email codes to the notification
+// service. Like passkeyRecoveryDeliverySubject it is a delivery request, not
+// a domain event, so codes never reach event subscribers.
+const mfaCodeDeliverySubject = "auth.delivery.mfa_code"
+
+// sendMFACode issues a one-time code for SMS or email MFA. Only its hash is
+// stored; the code itself goes to the notification service for delivery.
+func (s *AuthService) sendMFACode(ctx context.Context, user *models.User, method, purpose string) error {
+	tokenType := models.MFATokenTypeEmail
+	generate := s.mfaService.GenerateEmailCode
+	if method == "sms" {
+		tokenType = models.MFATokenTypeSMS
+		generate = s.mfaService.GenerateSMSCode
+	}
+
+	code, err := generate()
+	if err != nil {
+		return errors.Internal("Failed to generate verification code", nil, err)
+	}
+
+	expiresAt := time.Now().Add(10 * time.Minute)
+	token := &models.MFAToken{
+		UserID:    user.ID,
+		TokenType: tokenType,
+		Token:     hashOneTimeToken(code),
+		ExpiresAt: &expiresAt,
+	}
+	token.ClubID = user.ClubID
+
+	if err := s.repo.CreateMFAToken(ctx, token); err != nil {
+		return err
+	}
+
+	err = s.messageBus.PublishSync(ctx, mfaCodeDeliverySubject, map[string]interface{}{
+		"user_id":      user.ID,
+		"club_id":      user.ClubID,
+		"method":       method,
+		"email":        user.Email,
+		"phone_number": user.PhoneNumber,
+		"code":         code,
+		"purpose":      purpose,
+		"expires_at":   expiresAt.UTC(),
+	})
+	if err != nil {
+		return errors.Unavailable("Failed to send verification code", nil, err)
+	}
+
+	s.publishUserEventWithData(ctx, "user.mfa_code_requested", user, map[string]interface{}{
+		"method":     method,
+		"purpose":    purpose,
+		"expires_at": expiresAt.UTC(),
+	})
+
+	return nil
+}
+
+// stepUpMethods lists the factors that can satisfy a step-up, preferred
+// first, along with the user's enrolled factors. Administrators under the
+// club's phishing-resistant policy may only use WebAuthn or a backup code.
+// Those without a security key step up with their other factors, but
+// establishSession then only grants them an enrollment session.
+func (s *AuthService) stepUpMethods(ctx context.Context, club *models.Club, user *models.User) ([]string, []*models.MFAFactor, error) {
+	factors, err := s.repo.GetMFAFactorsByUser(ctx, user.ClubID, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if requiresPhishingResistantMFA(club, user) && hasPhishingResistantFactor(factors) {
+		return []string{"webauthn", "backup"}, factors, nil
+	}
+
+	verified := verifiedFactors(factors)
+	if len(verified) == 0 {
+		return legacyStepUpMethods(user), factors, nil
+	}
+
+	// Factors are ordered primary first
+	methods := make([]string, 0, len(verified)+1)
+	seen := make(map[string]bool, len(verified))
+	for _, factor := range verified {
+		if !seen[string(factor.Type)] {
+			seen[string(factor.Type)] = true
+			methods = append(methods, string(factor.Type))
+		}
+	}
+	return append(methods, "backup"), factors, nil
+}
+
+// legacyStepUpMethods covers users enrolled before factors were stored
+// separately. Users without MFA fall back to a code sent to their verified email.
+func legacyStepUpMethods(user *models.User) []string {
+	if !user.MFAEnabled {
+		return []string{"email"}
+	}
+
+	switch user.MFAMethod {
+	case "sms":
+		return []string{"sms", "backup"}
+	case "email":
+		return []string{"email", "backup"}
+	}
+	return []string{"totp", "backup"}
+}
+
+func (s *AuthService) getDeviceFingerprintFromContext(ctx context.Context) string {
+	// Prefer the client supplied fingerprint (set by middleware)
+	if fp, ok := ctx.Value("device_fingerprint").(string); ok && fp != "" {
+		return fp
+	}
+	return fingerprintUserAgent(s.getUserAgentFromContext(ctx))
+}
+
+// fingerprintUserAgent derives a coarse device fingerprint from the user agent
+func fingerprintUserAgent(userAgent string) string {
+	if userAgent == "" || userAgent == "unknown" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(userAgent))
+	return "ua_" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"reciprocal-clubs-backend/pkg/shared/database"
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/hanko"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/risk"
+	"reciprocal-clubs-backend/services/auth-service/internal/testutil"
+)
+
+// setupRiskTest prepares a user with one known login from a laptop
+// deliveredMFACode returns the last SMS or email code handed to the
+// notification service
+func deliveredMFACode(t *testing.T, service *AuthService) string {
+	t.Helper()
+	bus := service.messageBus.(*testutil.MockMessageBus)
+	code := ""
+	for _, msg := range bus.GetMessages() {
+		if msg.Subject == mfaCodeDeliverySubject {
+			data, _ := msg.Data.(map[string]interface{})
+			code, _ = data["code"].(string)
+		}
+	}
+	if code == "" {
+		t.Fatalf("No MFA code was delivered")
+	}
+	return code
+}
+
+func setupRiskTest(t *testing.T, thresholds risk.Thresholds) (*AuthService, *database.Database, *models.Club, *models.User) {
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
+	service.riskEngine = risk.NewEngine(risk.DefaultConfig(), nil)
+
+	mockHanko.AddUser(&hanko.HankoUser{
+		ID:            testUser.HankoUserID,
+		Email:         testUser.Email,
+		EmailVerified: true,
+	})
+
+	testClub.Settings.RiskNotifyThreshold = thresholds.Notify
+	testClub.Settings.RiskStepUpThreshold = thresholds.StepUp
+	testClub.Settings.RiskBlockThreshold = thresholds.Block
+	if err := db.Save(testClub).Error; err != nil {
+		t.Fatalf("Failed to update club settings: %v", err)
+	}
+
+	session := testutil.CreateTestSession(db.DB, testUser.ID, testClub.ID)
+	session.IPAddress = "192.0.2.10"
+	session.DeviceFingerprint = "laptop"
+	if err := db.Save(session).Error; err != nil {
+		t.Fatalf("Failed to update session: %v", err)
+	}
+
+	return service, db, testClub, testUser
+}
+
+func riskContext(ip, fingerprint string) context.Context {
+	ctx := context.WithValue(testutil.TestContext(), "client_ip", ip)
+	return context.WithValue(ctx, "device_fingerprint", fingerprint)
+}
+
+func TestAuthService_CompletePasskeyLogin_KnownDeviceAllowed(t *testing.T) {
+	service, _, testClub, testUser := setupRiskTest(t, risk.Thresholds{StepUp: 20, Block: 90})
+	ctx := riskContext("192.0.2.10", "laptop")
+
+	response, err := service.CompletePasskeyLogin(ctx, testClub.Slug, testUser.HankoUserID, map[string]interface{}{})
+
+	testutil.AssertNoError(t, err, "Login from known device should succeed")
+	testutil.AssertFalse(t, response.MFARequired, "MFA should not be required")
+	testutil.AssertNotEqual(t, "", response.Token, "Token should be generated")
+}
+
+func TestAuthService_CompletePasskeyLogin_StepUp(t *testing.T) {
+	service, _, testClub, testUser := setupRiskTest(t, risk.Thresholds{StepUp: 20, Block: 90})
+	ctx := riskContext("198.51.100.7", "tablet")
+
+	response, err := service.CompletePasskeyLogin(ctx, testClub.Slug, testUser.HankoUserID, map[string]interface{}{})
+
+	testutil.AssertNoError(t, err, "Risky login should be stepped up, not rejected")
+	testutil.AssertTrue(t, response.MFARequired, "MFA should be required")
+	testutil.AssertEqual(t, "", response.Token, "No token should be issued before MFA")
+	testutil.AssertNotEqual(t, "", response.MFAChallenge, "Challenge should be issued")
+	testutil.AssertEqual(t, 1, len(response.MFAMethods), "Users without MFA get one step-up method")
+	testutil.AssertEqual(t, "email", response.MFAMethods[0], "Users without MFA should get an email code")
+
+	code := deliveredMFACode(t, service)
+	stored, err := service.repo.GetLatestMFAToken(ctx, testUser.ID, models.MFATokenTypeEmail)
+	testutil.AssertNoError(t, err, "Email code should be stored")
+	testutil.AssertNotEqual(t, code, stored.Token, "Email code should be stored hashed")
+
+	// The Hanko session is held until the challenge is passed
+	challenge, err := service.repo.GetLatestMFAToken(ctx, testUser.ID, models.MFATokenTypeLoginChallenge)
+	testutil.AssertNoError(t, err, "Challenge should be stored")
+	heldSessionID, _ := challenge.Metadata["session_id"].(string)
+	testutil.AssertNotEqual(t, "", heldSessionID, "Challenge should hold the Hanko session")
+	_, err = service.ValidateSession(ctx, heldSessionID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Held session should not validate before MFA")
+
+	req := &MFAChallengeRequest{
+		ClubSlug:    testClub.Slug,
+		HankoUserID: testUser.HankoUserID,
+		Challenge:   response.MFAChallenge,
+		Code:        "000000",
+		Method:      "email",
+	}
+	if code == req.Code {
+		req.Code = "111111"
+	}
+	_, err = service.CompleteMFAChallenge(ctx, req)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Wrong code should be rejected")
+
+	req.Method = "totp"
+	_, err = service.CompleteMFAChallenge(ctx, req)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrInvalidInput), "Unavailable method should be rejected")
+
+	req.Method = "email"
+	req.Code = code
+	authResponse, err := service.CompleteMFAChallenge(ctx, req)
+	testutil.AssertNoError(t, err, "Correct code should complete the login")
+	testutil.AssertNotEqual(t, "", authResponse.Token, "Token should be generated")
+	testutil.AssertEqual(t, heldSessionID, authResponse.SessionID, "Held session should be issued")
+
+	validated, err := service.ValidateSession(ctx, heldSessionID)
+	testutil.AssertNoError(t, err, "Session should validate after MFA")
+	testutil.AssertEqual(t, testUser.ID, validated.ID, "Session should belong to the user")
+
+	sessions, err := service.repo.GetRecentSessionsByUser(ctx, testClub.ID, testUser.ID, 10)
+	testutil.AssertNoError(t, err, "Sessions should be listed")
+	testutil.AssertEqual(t, 2, len(sessions), "Stepped-up session should be created")
+	testutil.AssertEqual(t, "tablet", sessions[0].DeviceFingerprint, "Session should record the new device")
+
+	_, err = service.CompleteMFAChallenge(ctx, req)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Challenge should be single use")
+}
+
+func TestAuthService_CompletePasskeyLogin_Blocked(t *testing.T) {
+	service, _, testClub, testUser := setupRiskTest(t, risk.Thresholds{StepUp: 20, Block: 30})
+	ctx := riskContext("198.51.100.7", "tablet")
+
+	_, err := service.CompletePasskeyLogin(ctx, testClub.Slug, testUser.HankoUserID, map[string]interface{}{})
+
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrForbidden), "High risk login should be blocked")
+
+	sessions, err := service.repo.GetRecentSessionsByUser(ctx, testClub.ID, testUser.ID, 10)
+	testutil.AssertNoError(t, err, "Sessions should be listed")
+	testutil.AssertEqual(t, 1, len(sessions), "Blocked login should not create a session")
+}
+
+func TestAuthService_CompletePasskeyLogin_RiskEngineDisabled(t *testing.T) {
+	service, db, testClub, testUser := setupRiskTest(t, risk.Thresholds{StepUp: 20, Block: 30})
+	if err := db.Model(testClub).Update("enable_risk_engine", false).Error; err != nil {
+		t.Fatalf("Failed to disable risk engine: %v", err)
+	}
+	ctx := riskContext("198.51.100.7", "tablet")
+
+	response, err := service.CompletePasskeyLogin(ctx, testClub.Slug, testUser.HankoUserID, map[string]interface{}{})
+
+	testutil.AssertNoError(t, err, "Login should succeed when risk engine is disabled")
+	testutil.AssertFalse(t, response.MFARequired, "MFA should not be required")
+}
@@ -10,11 +10,14 @@ import (
 	"reciprocal-clubs-backend/pkg/shared/errors"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/auth-service/internal/audit"
 	"reciprocal-clubs-backend/services/auth-service/internal/hanko"
 	"reciprocal-clubs-backend/services/auth-service/internal/mfa"
 	"reciprocal-clubs-backend/services/auth-service/internal/models"
 	"reciprocal-clubs-backend/services/auth-service/internal/password"
 	"reciprocal-clubs-backend/services/auth-service/internal/repository"
+	"reciprocal-clubs-backend/services/auth-service/internal/risk"
+	"reciprocal-clubs-backend/services/auth-service/internal/webauthn"
 )
 
 // AuthService handles authentication business logic
@@ -27,6 +30,10 @@ type AuthService struct {
 	logger          logging.Logger
 	mfaService      *mfa.MFAService
 	passwordService *password.PasswordService
+	relyingParty    *webauthn.RelyingParty
+	riskEngine      *risk.Engine
+	geoip           risk.GeoIP
+	auditSigner     *audit.Signer
 }
 
 // HankoClientInterface defines the interface for Hanko client
@@ -58,48 +65,70 @@ type RegisterRequest struct {
 	ClubSlug  string `json:"club_slug" validate:"required"`
 }
 
-// AuthResponse represents authentication response
+// AuthResponse represents authentication response. When MFARequired is set
+// no tokens are issued; the login must be finished with CompleteMFAChallenge.
+// MFAEnrollmentRequired means no tokens were issued and the session may only
+// be used to enroll a phishing-resistant factor.
 type AuthResponse struct {
-	User         *models.User `json:"user"`
-	Token        string       `json:"token"`
-	RefreshToken string       `json:"refresh_token"`
-	ExpiresAt    time.Time    `json:"expires_at"`
+	User                  *models.User           `json:"user"`
+	SessionID             string                 `json:"session_id,omitempty"` // Bearer token for session-authenticated endpoints
+	Token                 string                 `json:"token"`
+	RefreshToken          string                 `json:"refresh_token"`
+	ExpiresAt             time.Time              `json:"expires_at"`
+	MFARequired           bool                   `json:"mfa_required,omitempty"`
+	MFAChallenge          string                 `json:"mfa_challenge,omitempty"`
+	MFAMethods            []string               `json:"mfa_methods,omitempty"`
+	MFAOptions            map[string]interface{} `json:"mfa_options,omitempty"` // WebAuthn request options
+	MFAEnrollmentRequired bool                   `json:"mfa_enrollment_required,omitempty"`
 }
 
 // PasskeyResponse represents passkey operation response
 type PasskeyResponse struct {
-	Options map[string]interface{} `json:"options"`
-	UserID  string                 `json:"user_id,omitempty"`
+	Options         map[string]interface{} `json:"options"`
+	UserID          string                 `json:"user_id,omitempty"`
+	EnrollmentToken string                 `json:"enrollment_token,omitempty"` // Passkey recovery only
 }
 
-// MFASetupRequest represents MFA setup request
+// MFASetupRequest represents MFA setup request. Each setup enrolls a new
+// factor; a user may hold several.
 type MFASetupRequest struct {
-	UserID uint   `json:"user_id" validate:"required"`
-	ClubID uint   `json:"club_id" validate:"required"`
-	Method string `json:"method" validate:"required,oneof=totp sms email"`
+	UserID     uint   `json:"user_id" validate:"required"`
+	ClubID     uint   `json:"club_id" validate:"required"`
+	Method     string `json:"method" validate:"required,oneof=totp sms email webauthn"`
+	Name       string `json:"name,omitempty" validate:"max=100"`
+	Attachment string `json:"attachment,omitempty" validate:"omitempty,oneof=platform cross-platform"` // webauthn only
 }
 
 // MFASetupResponse represents MFA setup response
 type MFASetupResponse struct {
-	Secret      string   `json:"secret,omitempty"`
-	QRCodeURL   string   `json:"qr_code_url,omitempty"`
-	BackupCodes []string `json:"backup_codes,omitempty"`
-	Success     bool     `json:"success"`
-	Message     string   `json:"message"`
+	FactorID    uint                   `json:"factor_id,omitempty"`
+	Secret      string                 `json:"secret,omitempty"`
+	QRCodeURL   string                 `json:"qr_code_url,omitempty"`
+	Options     map[string]interface{} `json:"options,omitempty"` // WebAuthn creation options
+	BackupCodes []string               `json:"backup_codes,omitempty"`
+	Success     bool                   `json:"success"`
+	Message     string                 `json:"message"`
 }
 
-// MFAVerifyRequest represents MFA verification request
+// MFAVerifyRequest represents MFA verification request. Without a code or
+// credential result it returns the enrolled factors to choose from, or starts
+// verification of the chosen factor.
 type MFAVerifyRequest struct {
-	UserID uint   `json:"user_id" validate:"required"`
-	ClubID uint   `json:"club_id" validate:"required"`
-	Code   string `json:"code" validate:"required"`
-	Method string `json:"method" validate:"required,oneof=totp sms email backup"`
+	UserID           uint                   `json:"user_id" validate:"required"`
+	ClubID           uint                   `json:"club_id" validate:"required"`
+	FactorID         uint                   `json:"factor_id,omitempty"`
+	Code             string                 `json:"code,omitempty"`
+	Method           string                 `json:"method,omitempty" validate:"omitempty,oneof=totp sms email webauthn backup"`
+	CredentialResult map[string]interface{} `json:"credential_result,omitempty"`
 }
 
 // MFAVerifyResponse represents MFA verification response
 type MFAVerifyResponse struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success              bool                   `json:"success"`
+	Message              string                 `json:"message"`
+	Factors              []*models.MFAFactor    `json:"factors,omitempty"`
+	Options              map[string]interface{} `json:"options,omitempty"` // WebAuthn request options
+	BackupCodesRemaining int                    `json:"backup_codes_remaining"`
 }
 
 // PasswordResetRequest represents password reset request
@@ -152,7 +181,7 @@ type EmailVerificationConfirmResponse struct {
 }
 
 // NewAuthService creates a new auth service
-func NewAuthService(repo *repository.AuthRepository, messageBus messaging.MessageBus, config *config.Config, logger logging.Logger) *AuthService {
+func NewAuthService(repo *repository.AuthRepository, messageBus messaging.MessageBus, config *config.Config, logger logging.Logger) (*AuthService, error) {
 	authProvider := auth.NewJWTProvider(&config.Auth, logger)
 
 	// Initialize Hanko client - use mock for development
@@ -173,6 +202,43 @@ func NewAuthService(repo *repository.AuthRepository, messageBus messaging.Messag
 	// Initialize password service with 1 hour token TTL
 	passwordService := password.NewPasswordService(1 * time.Hour)
 
+	// Initialize native WebAuthn relying party for clubs not using Hanko
+	relyingParty, err := webauthn.NewRelyingParty(webauthn.Config{
+		RPID:             config.WebAuthn.RPID,
+		RPName:           config.WebAuthn.RPName,
+		Origins:          config.WebAuthn.Origins,
+		Timeout:          time.Duration(config.WebAuthn.Timeout) * time.Second,
+		UserVerification: config.WebAuthn.UserVerification,
+	})
+	if err != nil {
+		logger.Warn("Native passkey provider disabled", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// Initialize risk engine; network signals need a local GeoIP database
+	var geoip risk.GeoIP
+	if path := config.Risk.GeoIPDatabase; path != "" {
+		db, err := risk.LoadGeoIPDatabase(path)
+		if err != nil {
+			logger.Warn("GeoIP database unavailable, location signals disabled", map[string]interface{}{
+				"error": err.Error(),
+				"path":  path,
+			})
+		} else {
+			geoip = db
+			logger.Info("GeoIP database loaded", map[string]interface{}{
+				"networks": db.Len(),
+			})
+		}
+	}
+	riskEngine := risk.NewEngine(risk.DefaultConfig(), geoip)
+
+	auditSigner, err := newAuditSigner(config, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &AuthService{
 		repo:            repo,
 		hankoClient:     hankoClient,
@@ -182,7 +248,11 @@ func NewAuthService(repo *repository.AuthRepository, messageBus messaging.Messag
 		logger:          logger,
 		mfaService:      mfaService,
 		passwordService: passwordService,
-	}
+		relyingParty:    relyingParty,
+		riskEngine:      riskEngine,
+		geoip:           geoip,
+		auditSigner:     auditSigner,
+	}, nil
 }
 
 // Register registers a new user
@@ -193,16 +263,26 @@ func (s *AuthService) Register(ctx context.Context, req *RegisterRequest) (*Auth
 		return nil, err
 	}
 
-	// Create user in Hanko first
-	hankoUser, err := s.hankoClient.CreateUser(ctx, req.Email)
-	if err != nil {
-		s.logger.Error("Failed to create user in Hanko", map[string]interface{}{
-			"error": err.Error(),
-			"email": req.Email,
-		})
-		return nil, errors.Internal("Failed to create user account", map[string]interface{}{
-			"email": req.Email,
-		}, err)
+	// Create user in Hanko first, unless the club runs the native passkey provider
+	usesHanko := club.Settings.PasskeyProvider != models.PasskeyProviderNative
+	var hankoUser *hanko.HankoUser
+	if usesHanko {
+		hankoUser, err = s.hankoClient.CreateUser(ctx, req.Email)
+		if err != nil {
+			s.logger.Error("Failed to create user in Hanko", map[string]interface{}{
+				"error": err.Error(),
+				"email": req.Email,
+			})
+			return nil, errors.Internal("Failed to create user account", map[string]interface{}{
+				"email": req.Email,
+			}, err)
+		}
+	} else {
+		userHandle, err := s.mfaService.GenerateRandomToken(16)
+		if err != nil {
+			return nil, errors.Internal("Failed to create user account", nil, err)
+		}
+		hankoUser = &hanko.HankoUser{ID: "native_" + userHandle}
 	}
 
 	// Create user in our database
@@ -243,7 +323,9 @@ func (s *AuthService) Register(ctx context.Context, req *RegisterRequest) (*Auth
 
 	if err != nil {
 		// Cleanup Hanko user if database operation failed
-		s.hankoClient.DeleteUser(ctx, hankoUser.ID)
+		if usesHanko {
+			s.hankoClient.DeleteUser(ctx, hankoUser.ID)
+		}
 		return nil, err
 	}
 
@@ -308,8 +390,13 @@ func (s *AuthService) InitiatePasskeyLogin(ctx context.Context, req *LoginReques
 		})
 	}
 
-	// Initiate passkey authentication with Hanko
-	response, err := s.hankoClient.InitiatePasskeyAuthentication(ctx, req.Email)
+	provider, err := s.passkeyProvider(club)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initiate passkey authentication with the club's provider
+	options, err := provider.BeginAuthentication(ctx, user)
 	if err != nil {
 		s.logger.Error("Failed to initiate passkey authentication", map[string]interface{}{
 			"error":   err.Error(),
@@ -321,12 +408,13 @@ func (s *AuthService) InitiatePasskeyLogin(ctx context.Context, req *LoginReques
 	}
 
 	s.logger.Info("Passkey authentication initiated", map[string]interface{}{
-		"user_id": user.ID,
-		"email":   user.Email,
+		"user_id":  user.ID,
+		"email":    user.Email,
+		"provider": provider.Name(),
 	})
 
 	return &PasskeyResponse{
-		Options: response.AuthenticationOptions,
+		Options: options,
 		UserID:  user.HankoUserID,
 	}, nil
 }
@@ -353,8 +441,13 @@ func (s *AuthService) CompletePasskeyLogin(ctx context.Context, clubSlug string,
 		})
 	}
 
-	// Verify passkey with Hanko
-	response, err := s.hankoClient.VerifyPasskey(ctx, hankoUserID, credentialResult)
+	provider, err := s.passkeyProvider(club)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify passkey with the club's provider
+	response, err := provider.FinishAuthentication(ctx, user, credentialResult)
 	if err != nil {
 		s.logger.Error("Failed to verify passkey", map[string]interface{}{
 			"error":         err.Error(),
@@ -379,6 +472,45 @@ func (s *AuthService) CompletePasskeyLogin(ctx context.Context, clubSlug string,
 		return nil, errors.Unauthorized("Authentication failed", nil)
 	}
 
+	// Score the verified login before issuing a session
+	assessment := s.assessLoginRisk(ctx, club, user)
+	switch assessment.Decision {
+	case risk.DecisionBlock:
+		return nil, s.blockLogin(ctx, club, user, response, provider.Name(), assessment)
+	case risk.DecisionStepUp:
+		return s.requireStepUp(ctx, club, user, response, provider.Name(), assessment)
+	case risk.DecisionNotify:
+		s.publishUserEventWithData(ctx, "user.suspicious_login", user, assessment.Metadata())
+	}
+
+	// Administrators under the club's policy always present a security key.
+	// Those without one only get an enrollment session.
+	if requiresPhishingResistantMFA(club, user) {
+		factors, err := s.repo.GetMFAFactorsByUser(ctx, club.ID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if hasPhishingResistantFactor(factors) {
+			return s.requireStepUp(ctx, club, user, response, provider.Name(), assessment)
+		}
+	}
+
+	return s.establishSession(ctx, club, user, response.SessionID, response.ExpiresAt, provider.Name())
+}
+
+// establishSession records a successful login and issues tokens for the
+// session. Administrators under the club's phishing-resistant policy who
+// have no security key get an enrollment-only session and no tokens.
+func (s *AuthService) establishSession(ctx context.Context, club *models.Club, user *models.User, sessionID string, expiresAt time.Time, providerName string) (*AuthResponse, error) {
+	enrollmentOnly := false
+	if requiresPhishingResistantMFA(club, user) {
+		factors, err := s.repo.GetMFAFactorsByUser(ctx, club.ID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		enrollmentOnly = !hasPhishingResistantFactor(factors)
+	}
+
 	// Authentication successful - update user
 	user.ResetFailedAttempts()
 	user.Unlock()
@@ -387,17 +519,22 @@ func (s *AuthService) CompletePasskeyLogin(ctx context.Context, clubSlug string,
 
 	// Create session
 	session := &models.UserSession{
-		UserID:         user.ID,
-		HankoSessionID: response.Session.ID,
-		IPAddress:      s.getIPFromContext(ctx),
-		UserAgent:      s.getUserAgentFromContext(ctx),
-		ExpiresAt:      response.Session.ExpiresAt,
-		IsActive:       true,
-	}
+		UserID:            user.ID,
+		HankoSessionID:    sessionID,
+		IPAddress:         s.getIPFromContext(ctx),
+		UserAgent:         s.getUserAgentFromContext(ctx),
+		ExpiresAt:         expiresAt,
+		IsActive:          true,
+		AuthProvider:      providerName,
+		DeviceFingerprint: s.getDeviceFingerprintFromContext(ctx),
+		LastActivityAt:    now,
+		EnrollmentOnly:    enrollmentOnly,
+	}
+	session.Location = s.sessionLocation(session.IPAddress)
 	session.ClubID = club.ID
 
 	// Update user and create session in transaction
-	err = s.repo.WithTransaction(ctx, func(txRepo *repository.AuthRepository) error {
+	err := s.repo.WithTransaction(ctx, func(txRepo *repository.AuthRepository) error {
 		if err := txRepo.UpdateUser(ctx, user); err != nil {
 			return err
 		}
@@ -420,6 +557,16 @@ func (s *AuthService) CompletePasskeyLogin(ctx context.Context, clubSlug string,
 		"session_id": session.HankoSessionID,
 	})
 
+	// The session may only be used to enroll a security key
+	if enrollmentOnly {
+		return &AuthResponse{
+			User:                  user,
+			SessionID:             session.HankoSessionID,
+			ExpiresAt:             expiresAt,
+			MFAEnrollmentRequired: true,
+		}, nil
+	}
+
 	// Generate tokens
 	authUser := s.convertToAuthUser(user)
 	token, err := s.authProvider.GenerateToken(authUser, 0)
@@ -439,6 +586,7 @@ func (s *AuthService) CompletePasskeyLogin(ctx context.Context, clubSlug string,
 
 	return &AuthResponse{
 		User:         user,
+		SessionID:    session.HankoSessionID,
 		Token:        token,
 		RefreshToken: refreshToken,
 		ExpiresAt:    time.Now().Add(time.Duration(s.config.Auth.JWTExpiration) * time.Second),
@@ -453,8 +601,18 @@ func (s *AuthService) InitiatePasskeyRegistration(ctx context.Context, userID ui
 		return nil, err
 	}
 
-	// Initiate passkey registration with Hanko
-	response, err := s.hankoClient.InitiatePasskeyRegistration(ctx, user.HankoUserID)
+	club, err := s.repo.GetClubByID(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := s.passkeyProvider(club)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initiate passkey registration with the club's provider
+	options, err := provider.BeginRegistration(ctx, user)
 	if err != nil {
 		s.logger.Error("Failed to initiate passkey registration", map[string]interface{}{
 			"error":   err.Error(),
@@ -470,37 +628,102 @@ func (s *AuthService) InitiatePasskeyRegistration(ctx context.Context, userID ui
 	})
 
 	return &PasskeyResponse{
-		Options: response.RegistrationOptions,
+		Options: options,
 		UserID:  user.HankoUserID,
 	}, nil
 }
 
-// ValidateSession validates a session token
+// ValidateSession validates a session token. Only sessions recorded locally
+// at the end of a completed login are valid, so a provider session held back
+// for an MFA step-up is rejected until the challenge is passed. Revoked and
+// idle sessions are rejected before the provider is consulted, and
+// enrollment-only sessions are refused.
 func (s *AuthService) ValidateSession(ctx context.Context, sessionToken string) (*models.User, error) {
+	user, _, err := s.AuthenticateSession(ctx, sessionToken)
+	return user, err
+}
+
+// AuthenticateSession validates a session token like ValidateSession and
+// also returns the session the token belongs to
+func (s *AuthService) AuthenticateSession(ctx context.Context, sessionToken string) (*models.User, *models.UserSession, error) {
+	user, session, err := s.validateSession(ctx, sessionToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.EnrollmentOnly {
+		return nil, nil, errors.Forbidden("A security key must be enrolled before this session can be used", map[string]interface{}{
+			"mfa_enrollment_required": true,
+		})
+	}
+	return user, session, nil
+}
+
+// ValidateEnrollmentSession validates a session token like
+// AuthenticateSession but also accepts enrollment-only sessions, which the
+// caller must restrict to enrolling a security key
+func (s *AuthService) ValidateEnrollmentSession(ctx context.Context, sessionToken string) (*models.User, *models.UserSession, error) {
+	return s.validateSession(ctx, sessionToken)
+}
+
+func (s *AuthService) validateSession(ctx context.Context, sessionToken string) (*models.User, *models.UserSession, error) {
+	session, err := s.repo.GetSessionBySessionID(ctx, sessionToken)
+	if err != nil && !errors.Is(err, errors.ErrNotFound) {
+		return nil, nil, err
+	}
+	if session != nil {
+		if err := s.checkSessionActive(ctx, session); err != nil {
+			return nil, nil, err
+		}
+
+		// Sessions issued by the native passkey provider are validated locally
+		if session.AuthProvider == models.PasskeyProviderNative {
+			user, err := s.validateNativeSession(ctx, session)
+			if err != nil {
+				return nil, nil, err
+			}
+			return user, session, nil
+		}
+	}
+
 	// Validate session with Hanko
 	response, err := s.hankoClient.ValidateSession(ctx, sessionToken)
 	if err != nil {
-		return nil, errors.Unauthorized("Invalid session", nil)
+		return nil, nil, errors.Unauthorized("Invalid session", nil)
 	}
 
 	if !response.Valid {
-		return nil, errors.Unauthorized("Session expired", nil)
+		return nil, nil, errors.Unauthorized("Session expired", nil)
+	}
+
+	// Hanko tokens that are not the session ID itself are matched to the
+	// local session by the ID Hanko reports
+	if session == nil {
+		session, err = s.repo.GetSessionBySessionID(ctx, response.Session.ID)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				return nil, nil, errors.Unauthorized("Invalid session", nil)
+			}
+			return nil, nil, err
+		}
+		if err := s.checkSessionActive(ctx, session); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Get user from our database
-	user, err := s.repo.GetUserByHankoID(ctx, 1, response.User.ID) // Note: Need to handle club ID properly
+	user, err := s.repo.GetUserByHankoID(ctx, session.ClubID, response.User.ID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if user.ID != session.UserID {
+		return nil, nil, errors.Unauthorized("Invalid session", nil)
 	}
 
 	// Update session activity
-	session, err := s.repo.GetSessionByHankoID(ctx, user.ClubID, response.Session.ID)
-	if err == nil {
-		session.UpdateActivity()
-		s.repo.UpdateSession(ctx, session)
-	}
+	session.UpdateActivity()
+	s.repo.UpdateSession(ctx, session)
 
-	return user, nil
+	return user, session, nil
 }
 
 // Logout logs out a user
@@ -512,7 +735,7 @@ func (s *AuthService) Logout(ctx context.Context, userID, clubID uint, sessionTo
 	}
 
 	// Get session by token
-	_, err = s.repo.GetSessionByHankoID(ctx, clubID, sessionToken)
+	session, err := s.repo.GetSessionByHankoID(ctx, clubID, sessionToken)
 	if err != nil {
 		// Session not found, but still invalidate in Hanko
 		s.hankoClient.InvalidateSession(ctx, sessionToken)
@@ -520,12 +743,14 @@ func (s *AuthService) Logout(ctx context.Context, userID, clubID uint, sessionTo
 	}
 
 	// Invalidate session in both Hanko and our database
-	err = s.hankoClient.InvalidateSession(ctx, sessionToken)
-	if err != nil {
-		s.logger.Warn("Failed to invalidate session in Hanko", map[string]interface{}{
-			"error":      err.Error(),
-			"session_id": sessionToken,
-		})
+	if session.AuthProvider != models.PasskeyProviderNative {
+		err = s.hankoClient.InvalidateSession(ctx, sessionToken)
+		if err != nil {
+			s.logger.Warn("Failed to invalidate session in Hanko", map[string]interface{}{
+				"error":      err.Error(),
+				"session_id": sessionToken,
+			})
+		}
 	}
 
 	err = s.repo.InvalidateSession(ctx, clubID, sessionToken)
@@ -595,6 +820,10 @@ func (s *AuthService) convertToAuthUser(user *models.User) *auth.User {
 }
 
 func (s *AuthService) createAuditLog(ctx context.Context, clubID uint, user *models.User, action models.AuditAction, details string, success bool, errorMessage string) {
+	s.createAuditLogWithMetadata(ctx, clubID, user, action, details, success, errorMessage, nil)
+}
+
+func (s *AuthService) createAuditLogWithMetadata(ctx context.Context, clubID uint, user *models.User, action models.AuditAction, details string, success bool, errorMessage string, metadata map[string]interface{}) {
 	auditLog := &models.AuditLog{
 		UserID:       &user.ID,
 		HankoUserID:  user.HankoUserID,
@@ -604,6 +833,7 @@ func (s *AuthService) createAuditLog(ctx context.Context, clubID uint, user *mod
 		ErrorMessage: errorMessage,
 		IPAddress:    s.getIPFromContext(ctx),
 		UserAgent:    s.getUserAgentFromContext(ctx),
+		Metadata:     metadata,
 	}
 	auditLog.ClubID = clubID
 
@@ -619,6 +849,10 @@ func (s *AuthService) createAuditLog(ctx context.Context, clubID uint, user *mod
 }
 
 func (s *AuthService) publishUserEvent(ctx context.Context, eventType string, user *models.User) {
+	s.publishUserEventWithData(ctx, eventType, user, nil)
+}
+
+func (s *AuthService) publishUserEventWithData(ctx context.Context, eventType string, user *models.User, data map[string]interface{}) {
 	event := map[string]interface{}{
 		"user_id":       user.ID,
 		"club_id":       user.ClubID,
@@ -626,6 +860,9 @@ func (s *AuthService) publishUserEvent(ctx context.Context, eventType string, us
 		"hanko_user_id": user.HankoUserID,
 		"timestamp":     time.Now().UTC(),
 	}
+	for key, value := range data {
+		event[key] = value
+	}
 
 	go func() {
 		ctx := context.Background()
@@ -672,7 +909,8 @@ func (s *AuthService) CompletePasskeyAuthentication(ctx context.Context, clubSlu
 
 // MFA Management Methods
 
-// SetupMFA initiates MFA setup for a user
+// SetupMFA enrolls a new second factor for a user. The factor stays unverified
+// until it passes VerifyMFA. Backup codes are issued with the first factor.
 func (s *AuthService) SetupMFA(ctx context.Context, req *MFASetupRequest) (*MFASetupResponse, error) {
 	// Get user
 	user, err := s.repo.GetUserByID(ctx, req.ClubID, req.UserID)
@@ -680,83 +918,89 @@ func (s *AuthService) SetupMFA(ctx context.Context, req *MFASetupRequest) (*MFAS
 		return nil, err
 	}
 
-	// Check if MFA is already enabled
-	if user.MFAEnabled {
-		return &MFASetupResponse{
-			Success: false,
-			Message: "MFA is already enabled for this user",
-		}, nil
+	factor := &models.MFAFactor{
+		UserID: user.ID,
+		Type:   models.MFAFactorType(req.Method),
+		Name:   req.Name,
 	}
+	factor.ClubID = user.ClubID
 
 	var response *MFASetupResponse
 
 	switch req.Method {
 	case "totp":
-		response, err = s.setupTOTP(ctx, user)
+		response, err = s.setupTOTP(ctx, user, factor)
 	case "sms":
-		response, err = s.setupSMS(ctx, user)
+		response, err = s.setupSMS(ctx, user, factor)
 	case "email":
-		response, err = s.setupEmailMFA(ctx, user)
+		response, err = s.setupEmailMFA(ctx, user, factor)
+	case "webauthn":
+		response, err = s.setupWebAuthnMFA(ctx, user, factor, req.Attachment)
 	default:
-		return nil, errors.BadRequest("Invalid MFA method", map[string]interface{}{
+		return nil, errors.InvalidInput("Invalid MFA method", map[string]interface{}{
 			"method": req.Method,
-		})
+		}, nil)
 	}
 
 	if err != nil {
 		return nil, err
 	}
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := s.replaceUnverifiedFactors(ctx, user, factor); err != nil {
+		return nil, err
+	}
+	response.FactorID = factor.ID
+
+	// Backup codes are shared by all factors, so only the first enrollment issues them
+	if len(user.GetMFABackupCodes()) == 0 {
+		backupCodes, err := s.issueBackupCodes(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		response.BackupCodes = backupCodes
+	}
 
 	// Create audit log
 	s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFAEnabled, fmt.Sprintf("MFA setup initiated with method: %s", req.Method), true, "")
 
 	s.logger.Info("MFA setup initiated", map[string]interface{}{
-		"user_id": user.ID,
-		"method":  req.Method,
+		"user_id":   user.ID,
+		"method":    req.Method,
+		"factor_id": factor.ID,
 	})
 
 	return response, nil
 }
 
 // setupTOTP sets up TOTP MFA for a user
-func (s *AuthService) setupTOTP(ctx context.Context, user *models.User) (*MFASetupResponse, error) {
+func (s *AuthService) setupTOTP(ctx context.Context, user *models.User, factor *models.MFAFactor) (*MFASetupResponse, error) {
 	// Generate TOTP secret
 	secret, err := s.mfaService.GenerateSecret(user.Email)
 	if err != nil {
 		return nil, errors.Internal("Failed to generate TOTP secret", nil, err)
 	}
 
-	// Generate backup codes
-	backupCodes, err := s.mfaService.GenerateBackupCodes(8)
-	if err != nil {
-		return nil, errors.Internal("Failed to generate backup codes", nil, err)
-	}
-
 	// Generate QR code URL
 	qrCodeURL := s.mfaService.GenerateQRCodeURL(user.Email, secret)
 
-	// Update user with MFA settings (not enabled yet, will be enabled after verification)
-	user.MFASecret = secret
-	user.MFAMethod = "totp"
-	user.MFABackupCodes = fmt.Sprintf("%v", backupCodes) // This should be properly hashed in production
-
-	// Save user
-	err = s.repo.UpdateUser(ctx, user)
-	if err != nil {
-		return nil, err
+	factor.Secret = secret
+	if factor.Name == "" {
+		factor.Name = "Authenticator app"
 	}
 
 	return &MFASetupResponse{
-		Secret:      secret,
-		QRCodeURL:   qrCodeURL,
-		BackupCodes: backupCodes,
-		Success:     true,
-		Message:     "TOTP MFA setup completed. Please verify with your authenticator app.",
+		Secret:    secret,
+		QRCodeURL: qrCodeURL,
+		Success:   true,
+		Message:   "TOTP MFA setup completed. Please verify with your authenticator app.",
 	}, nil
 }
 
 // setupSMS sets up SMS MFA for a user
-func (s *AuthService) setupSMS(ctx context.Context, user *models.User) (*MFASetupResponse, error) {
+func (s *AuthService) setupSMS(ctx context.Context, user *models.User, factor *models.MFAFactor) (*MFASetupResponse, error) {
 	// Check if user has a verified phone number
 	if user.PhoneNumber == "" || !user.PhoneVerified {
 		return &MFASetupResponse{
@@ -765,32 +1009,13 @@ func (s *AuthService) setupSMS(ctx context.Context, user *models.User) (*MFASetu
 		}, nil
 	}
 
-	// Generate SMS verification code
-	code, err := s.mfaService.GenerateSMSCode()
-	if err != nil {
-		return nil, errors.Internal("Failed to generate SMS code", nil, err)
-	}
-
-	// Store verification token
-	mfaToken := &models.MFAToken{
-		UserID:    user.ID,
-		TokenType: models.MFATokenTypeSMS,
-		Token:     code,
-		ExpiresAt: &[]time.Time{time.Now().Add(5 * time.Minute)}[0],
-	}
-	mfaToken.ClubID = user.ClubID
-
-	err = s.repo.CreateMFAToken(ctx, mfaToken)
-	if err != nil {
+	if err := s.sendMFACode(ctx, user, "sms", "mfa_setup"); err != nil {
 		return nil, err
 	}
 
-	// TODO: Send SMS code to user's phone number
-	// For now, we'll just log it
-	s.logger.Info("SMS MFA code generated", map[string]interface{}{
-		"user_id": user.ID,
-		"code":    code, // Remove this in production
-	})
+	if factor.Name == "" {
+		factor.Name = "Text message"
+	}
 
 	return &MFASetupResponse{
 		Success: true,
@@ -799,33 +1024,14 @@ func (s *AuthService) setupSMS(ctx context.Context, user *models.User) (*MFASetu
 }
 
 // setupEmailMFA sets up email MFA for a user
-func (s *AuthService) setupEmailMFA(ctx context.Context, user *models.User) (*MFASetupResponse, error) {
-	// Generate email verification code
-	code, err := s.mfaService.GenerateEmailCode()
-	if err != nil {
-		return nil, errors.Internal("Failed to generate email code", nil, err)
-	}
-
-	// Store verification token
-	mfaToken := &models.MFAToken{
-		UserID:    user.ID,
-		TokenType: models.MFATokenTypeEmail,
-		Token:     code,
-		ExpiresAt: &[]time.Time{time.Now().Add(10 * time.Minute)}[0],
-	}
-	mfaToken.ClubID = user.ClubID
-
-	err = s.repo.CreateMFAToken(ctx, mfaToken)
-	if err != nil {
+func (s *AuthService) setupEmailMFA(ctx context.Context, user *models.User, factor *models.MFAFactor) (*MFASetupResponse, error) {
+	if err := s.sendMFACode(ctx, user, "email", "mfa_setup"); err != nil {
 		return nil, err
 	}
 
-	// TODO: Send email code to user's email
-	// For now, we'll just log it
-	s.logger.Info("Email MFA code generated", map[string]interface{}{
-		"user_id": user.ID,
-		"code":    code, // Remove this in production
-	})
+	if factor.Name == "" {
+		factor.Name = "Email"
+	}
 
 	return &MFASetupResponse{
 		Success: true,
@@ -833,7 +1039,8 @@ func (s *AuthService) setupEmailMFA(ctx context.Context, user *models.User) (*MF
 	}, nil
 }
 
-// VerifyMFA verifies an MFA code
+// VerifyMFA verifies a second factor. The first successful verification of a
+// factor completes its enrollment and enables MFA for the user.
 func (s *AuthService) VerifyMFA(ctx context.Context, req *MFAVerifyRequest) (*MFAVerifyResponse, error) {
 	// Get user
 	user, err := s.repo.GetUserByID(ctx, req.ClubID, req.UserID)
@@ -841,55 +1048,75 @@ func (s *AuthService) VerifyMFA(ctx context.Context, req *MFAVerifyRequest) (*MF
 		return nil, err
 	}
 
-	var success bool
-	var message string
+	club, err := s.repo.GetClubByID(ctx, req.ClubID)
+	if err != nil {
+		return nil, err
+	}
 
-	switch req.Method {
-	case "totp":
-		success = s.mfaService.VerifyTOTPWithSkew(user.MFASecret, req.Code, 1)
-		message = "TOTP verification"
-	case "backup":
-		success = user.UseBackupCode(req.Code)
-		if success {
-			// Save updated backup codes
-			s.repo.UpdateUser(ctx, user)
-			s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFABackupUsed, "Backup code used for MFA", true, "")
-		}
-		message = "Backup code verification"
-	case "sms", "email":
-		success, err = s.verifyTokenCode(ctx, user.ID, req.Code, req.Method)
-		if err != nil {
-			return nil, err
-		}
-		message = fmt.Sprintf("%s verification", req.Method)
-	default:
-		return nil, errors.BadRequest("Invalid MFA method", map[string]interface{}{
-			"method": req.Method,
-		})
+	// Wrong codes count against the same allowance as login step-ups
+	if (req.Code != "" || len(req.CredentialResult) > 0) && mfaAttemptsExhausted(club, user) {
+		s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFAVerification, "MFA verification refused", false, "Too many failed attempts")
+		return nil, errors.Forbidden("Too many failed verification attempts; sign in again to retry", nil)
 	}
 
-	if success {
-		// Enable MFA if this is the first successful verification
-		if !user.MFAEnabled {
-			user.MFAEnabled = true
-			err = s.repo.UpdateUser(ctx, user)
-			if err != nil {
-				return nil, err
-			}
-			s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFAEnabled, "MFA enabled after successful verification", true, "")
+	if req.Method == "backup" {
+		return s.verifyBackupCodeMFA(ctx, club, user, req.Code)
+	}
+
+	factors, err := s.repo.GetMFAFactorsByUser(ctx, user.ClubID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, err := selectMFAFactor(user, factors, req.FactorID, req.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing to check yet: offer the enrolled factors or start the chosen one
+	if req.Code == "" && len(req.CredentialResult) == 0 {
+		if factor == nil {
+			return &MFAVerifyResponse{
+				Success:              false,
+				Message:              "Choose a second factor to verify",
+				Factors:              verifiedFactors(factors),
+				BackupCodesRemaining: len(user.GetMFABackupCodes()),
+			}, nil
 		}
+		return s.beginMFAVerification(ctx, user, factor, factors)
+	}
+	if factor == nil {
+		return nil, errors.InvalidInput("A factor or method is required", nil, nil)
+	}
 
-		s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFAVerification, fmt.Sprintf("Successful MFA verification: %s", req.Method), true, "")
+	used, success, err := s.checkMFAFactor(ctx, user, factor, factors, req.Code, req.CredentialResult)
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("%s verification", factor.Type)
+	if !success {
+		s.recordFailedMFAAttempt(ctx, club, user)
+		s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFAVerification, fmt.Sprintf("Failed MFA verification: %s", factor.Type), false, "Invalid code")
 		return &MFAVerifyResponse{
-			Success: true,
-			Message: fmt.Sprintf("%s successful", message),
+			Success: false,
+			Message: fmt.Sprintf("%s failed", message),
 		}, nil
 	}
 
-	s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFAVerification, fmt.Sprintf("Failed MFA verification: %s", req.Method), false, "Invalid code")
+	user.ResetFailedAttempts()
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.completeFactorVerification(ctx, user, used, factors); err != nil {
+		return nil, err
+	}
+
+	s.createAuditLog(ctx, req.ClubID, user, models.AuditActionMFAVerification, fmt.Sprintf("Successful MFA verification: %s", factor.Type), true, "")
 	return &MFAVerifyResponse{
-		Success: false,
-		Message: fmt.Sprintf("%s failed", message),
+		Success:              true,
+		Message:              fmt.Sprintf("%s successful", message),
+		BackupCodesRemaining: len(user.GetMFABackupCodes()),
 	}, nil
 }
 
@@ -911,8 +1138,8 @@ func (s *AuthService) verifyTokenCode(ctx context.Context, userID uint, code, me
 		return false, nil
 	}
 
-	// Verify code
-	if token.Token != code {
+	// Codes are stored hashed
+	if token.Token != hashOneTimeToken(code) {
 		return false, nil
 	}
 
@@ -926,7 +1153,7 @@ func (s *AuthService) verifyTokenCode(ctx context.Context, userID uint, code, me
 	return true, nil
 }
 
-// DisableMFA disables MFA for a user
+// DisableMFA disables MFA for a user and removes all enrolled factors
 func (s *AuthService) DisableMFA(ctx context.Context, userID, clubID uint) error {
 	// Get user
 	user, err := s.repo.GetUserByID(ctx, clubID, userID)
@@ -934,11 +1161,24 @@ func (s *AuthService) DisableMFA(ctx context.Context, userID, clubID uint) error
 		return err
 	}
 
+	club, err := s.repo.GetClubByID(ctx, clubID)
+	if err != nil {
+		return err
+	}
+	if requiresPhishingResistantMFA(club, user) {
+		return errors.Forbidden("Club policy requires administrators to keep a phishing-resistant factor", nil)
+	}
+
 	// Disable MFA
 	user.DisableMFA()
 
-	// Save user
-	err = s.repo.UpdateUser(ctx, user)
+	// Save user and drop factors together
+	err = s.repo.WithTransaction(ctx, func(txRepo *repository.AuthRepository) error {
+		if err := txRepo.UpdateUser(ctx, user); err != nil {
+			return err
+		}
+		return txRepo.DeleteMFAFactorsByUser(ctx, clubID, userID)
+	})
 	if err != nil {
 		return err
 	}
@@ -1062,16 +1302,13 @@ func (s *AuthService) ConfirmPasswordReset(ctx context.Context, req *PasswordRes
 		}, nil
 	}
 
-	// Hash new password
-	hashedPassword, err := s.passwordService.HashPassword(req.NewPassword)
-	if err != nil {
+	// Hash new password. The hash is not stored until the Hanko password
+	// update API is integrated.
+	// TODO: Integrate with Hanko password update API
+	if _, err := s.passwordService.HashPassword(req.NewPassword); err != nil {
 		return nil, errors.Internal("Failed to hash password", nil, err)
 	}
 
-	// Update user password in Hanko (if using Hanko for password storage)
-	// For now, we'll store it locally
-	// TODO: Integrate with Hanko password update API
-
 	// Clear reset token
 	user.ClearPasswordResetToken()
 
@@ -7,7 +7,9 @@ import (
 
 	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/database"
+	"reciprocal-clubs-backend/pkg/shared/errors"
 	"reciprocal-clubs-backend/services/auth-service/internal/hanko"
+	"reciprocal-clubs-backend/services/auth-service/internal/mfa"
 	"reciprocal-clubs-backend/services/auth-service/internal/models"
 	"reciprocal-clubs-backend/services/auth-service/internal/repository"
 	"reciprocal-clubs-backend/services/auth-service/internal/testutil"
@@ -34,6 +36,12 @@ func setupTestService(t *testing.T) (*AuthService, *testutil.MockHankoClient, *d
 		&models.RolePermission{},
 		&models.UserSession{},
 		&models.AuditLog{},
+		&models.AuditChainState{},
+		&models.AuditAnchor{},
+		&models.MFAToken{},
+		&models.MFAFactor{},
+		&models.PasskeyCredential{},
+		&models.WebAuthnSession{},
 	)
 	if err != nil {
 		t.Fatalf("Failed to migrate test database: %v", err)
@@ -100,6 +108,11 @@ func setupTestService(t *testing.T) (*AuthService, *testutil.MockHankoClient, *d
 	// Create auth provider
 	authProvider := auth.NewJWTProvider(&mockConfig.Auth, logger)
 
+	auditSigner, err := newAuditSigner(mockConfig, logger)
+	if err != nil {
+		t.Fatalf("Failed to load audit signing key: %v", err)
+	}
+
 	// Create service
 	service := &AuthService{
 		repo:         repo,
@@ -108,6 +121,8 @@ func setupTestService(t *testing.T) (*AuthService, *testutil.MockHankoClient, *d
 		messageBus:   mockMessageBus,
 		config:       mockConfig,
 		logger:       logger,
+		mfaService:   mfa.NewMFAService("Test Clubs"),
+		auditSigner:  auditSigner,
 	}
 
 	return service, mockHanko, dbWrapper, club, user
@@ -210,7 +225,9 @@ func TestAuthService_InitiatePasskeyLogin_Success(t *testing.T) {
 	_ = testClub // avoid unused variable warning
 	ctx := testutil.TestContext()
 
+	// Clubs use the Hanko provider by default, which looks the user up there
 	mockHanko.Clear()
+	mockHanko.AddUser(&hanko.HankoUser{ID: testUser.HankoUserID, Email: testUser.Email})
 
 	req := &LoginRequest{
 		Email:    testUser.Email,
@@ -334,7 +351,7 @@ func TestAuthService_CompletePasskeyLogin_VerificationFailed(t *testing.T) {
 // Session Management Tests
 
 func TestAuthService_ValidateSession_Success(t *testing.T) {
-	service, mockHanko, _, testClub, testUser := setupTestService(t)
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
 	_ = testUser // avoid unused variable warning
 	_ = testClub // avoid unused variable warning
 	ctx := testutil.TestContext()
@@ -355,6 +372,16 @@ func TestAuthService_ValidateSession_Success(t *testing.T) {
 		ExpiresAt: time.Now().Add(24 * time.Hour),
 	})
 
+	// Hanko sessions without a completed local login are rejected
+	_, err := service.ValidateSession(ctx, sessionToken)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Session unknown locally should be rejected")
+
+	session := testutil.CreateTestSession(db.DB, testUser.ID, testClub.ID)
+	session.HankoSessionID = sessionToken
+	if err := db.Save(session).Error; err != nil {
+		t.Fatalf("Failed to update session: %v", err)
+	}
+
 	user, err := service.ValidateSession(ctx, sessionToken)
 
 	testutil.AssertNoError(t, err, "Validate session should succeed")
@@ -436,11 +463,25 @@ func TestAuthService_GetUser_NotFound(t *testing.T) {
 }
 
 func TestAuthService_GetUserWithRoles_Success(t *testing.T) {
-	service, _, _, testClub, testUser := setupTestService(t)
-	_ = testUser // avoid unused variable warning
-	_ = testClub // avoid unused variable warning
+	service, _, db, testClub, testUser := setupTestService(t)
 	ctx := testutil.TestContext()
 
+	grantAdminRole(t, db, testUser)
+	var role models.Role
+	if err := db.Where("club_id = ? AND name = ?", testClub.ID, models.RoleAdmin).First(&role).Error; err != nil {
+		t.Fatalf("Failed to find admin role: %v", err)
+	}
+	permission := &models.Permission{Name: "users.read", Resource: "users", Action: "read"}
+	permission.ClubID = testClub.ID
+	if err := db.Create(permission).Error; err != nil {
+		t.Fatalf("Failed to create permission: %v", err)
+	}
+	rolePermission := &models.RolePermission{RoleID: role.ID, PermissionID: permission.ID}
+	rolePermission.ClubID = testClub.ID
+	if err := db.Create(rolePermission).Error; err != nil {
+		t.Fatalf("Failed to grant permission: %v", err)
+	}
+
 	userWithRoles, err := service.GetUserWithRoles(ctx, testClub.ID, testUser.ID)
 
 	testutil.AssertNoError(t, err, "Get user with roles should succeed")
@@ -563,13 +604,8 @@ func TestAuthService_FullAuthFlow_Success(t *testing.T) {
 	testutil.AssertNoError(t, err, "Complete login should succeed")
 	testutil.AssertEqual(t, registerReq.Email, loginResp.User.Email, "User email should match")
 
-	// 4. Validate session (using a mock session token)
-	sessionToken := "mock-session-for-validation"
-	mockHanko.AddSession(sessionToken, &hanko.HankoSession{
-		ID:        sessionToken,
-		UserID:    registerResp.User.HankoUserID,
-		ExpiresAt: time.Now().Add(24 * time.Hour),
-	})
+	// 4. Validate the session issued by the login
+	sessionToken := loginResp.SessionID
 
 	validatedUser, err := service.ValidateSession(ctx, sessionToken)
 	testutil.AssertNoError(t, err, "Session validation should succeed")
@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+)
+
+// SessionInfo describes a user session without its tokens
+type SessionInfo struct {
+	ID             uint      `json:"id"`
+	UserID         uint      `json:"user_id"`
+	UserEmail      string    `json:"user_email,omitempty"`
+	Device         string    `json:"device"`
+	IPAddress      string    `json:"ip_address"`
+	Location       string    `json:"location,omitempty"`
+	UserAgent      string    `json:"user_agent"`
+	AuthProvider   string    `json:"auth_provider"`
+	IsActive       bool      `json:"is_active"`
+	Current        bool      `json:"current"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastActivityAt time.Time `json:"last_activity_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// SessionSearchRequest represents an admin session search within a club
+type SessionSearchRequest struct {
+	ClubID     uint   `json:"club_id" validate:"required"`
+	UserID     uint   `json:"user_id,omitempty"`
+	Query      string `json:"query,omitempty"` // matches IP address, user agent or location
+	ActiveOnly bool   `json:"active_only"`
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit"`
+}
+
+// SessionSearchResponse represents a page of session search results
+type SessionSearchResponse struct {
+	Sessions []*SessionInfo `json:"sessions"`
+	Total    int64          `json:"total"`
+	Offset   int            `json:"offset"`
+	Limit    int            `json:"limit"`
+}
+
+// ListUserSessions lists a user's active sessions; currentSessionID marks the caller's own session
+func (s *AuthService) ListUserSessions(ctx context.Context, clubID, userID uint, currentSessionID string) ([]*SessionInfo, error) {
+	if _, err := s.repo.GetUserByID(ctx, clubID, userID); err != nil {
+		return nil, err
+	}
+
+	club, err := s.repo.GetClubByID(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	idleTimeout := sessionIdleTimeout(club)
+
+	sessions, err := s.repo.GetActiveSessionsByUser(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		// Idle sessions are invalidated by the sweeper; hide them until then
+		if session.IsIdle(idleTimeout) {
+			continue
+		}
+		infos = append(infos, toSessionInfo(session, currentSessionID))
+	}
+
+	return infos, nil
+}
+
+// RevokeSession revokes one of a user's own sessions
+func (s *AuthService) RevokeSession(ctx context.Context, clubID, userID, sessionID uint) error {
+	user, err := s.repo.GetUserByID(ctx, clubID, userID)
+	if err != nil {
+		return err
+	}
+
+	session, err := s.repo.GetSessionByID(ctx, clubID, sessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID != user.ID {
+		return errors.NotFound("Session not found", map[string]interface{}{
+			"session_id": sessionID,
+		})
+	}
+
+	return s.revokeSession(ctx, user, session, "Session revoked by user")
+}
+
+// RevokeOtherSessions revokes all of a user's sessions except the current one
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, clubID, userID uint, currentSessionID string) (int, error) {
+	if currentSessionID == "" {
+		return 0, errors.InvalidInput("Current session is required", nil, nil)
+	}
+
+	user, err := s.repo.GetUserByID(ctx, clubID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.revokeUserSessions(ctx, user, currentSessionID, "Session revoked by user")
+}
+
+// AdminRevokeSession revokes any session within a club
+func (s *AuthService) AdminRevokeSession(ctx context.Context, clubID, sessionID uint) error {
+	session, err := s.repo.GetSessionByID(ctx, clubID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, clubID, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.revokeSession(ctx, user, session, "Session revoked by administrator")
+}
+
+// AdminRevokeUserSessions revokes every active session of a user, e.g. for a stolen device
+func (s *AuthService) AdminRevokeUserSessions(ctx context.Context, clubID, userID uint) (int, error) {
+	user, err := s.repo.GetUserByID(ctx, clubID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.revokeUserSessions(ctx, user, "", "Session revoked by administrator")
+}
+
+// SearchSessions searches a club's sessions for administrators
+func (s *AuthService) SearchSessions(ctx context.Context, req *SessionSearchRequest) (*SessionSearchResponse, error) {
+	if req.ClubID == 0 {
+		return nil, errors.InvalidInput("Club ID is required", nil, nil)
+	}
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 50
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	sessions, total, err := s.repo.SearchSessions(ctx, req.ClubID, req.UserID, req.Query, req.ActiveOnly, req.Offset, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		info := toSessionInfo(session, "")
+		info.UserEmail = session.User.Email
+		infos = append(infos, info)
+	}
+
+	return &SessionSearchResponse{
+		Sessions: infos,
+		Total:    total,
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+	}, nil
+}
+
+// EnforceIdleTimeouts invalidates sessions idle for longer than each club's
+// SessionTimeoutMinutes and returns the number of sessions ended
+func (s *AuthService) EnforceIdleTimeouts(ctx context.Context) (int64, error) {
+	const pageSize = 100
+	var invalidated int64
+
+	for offset := 0; ; offset += pageSize {
+		clubs, _, err := s.repo.ListClubs(ctx, offset, pageSize)
+		if err != nil {
+			return invalidated, err
+		}
+
+		for _, club := range clubs {
+			timeout := sessionIdleTimeout(club)
+			if timeout <= 0 {
+				continue
+			}
+			count, err := s.repo.InvalidateIdleSessions(ctx, club.ID, time.Now().Add(-timeout))
+			if err != nil {
+				return invalidated, err
+			}
+			invalidated += count
+		}
+
+		if len(clubs) < pageSize {
+			break
+		}
+	}
+
+	if invalidated > 0 {
+		s.logger.Info("Idle sessions invalidated", map[string]interface{}{
+			"count": invalidated,
+		})
+	}
+
+	return invalidated, nil
+}
+
+// RunSessionSweeper enforces idle timeouts periodically until ctx is cancelled
+func (s *AuthService) RunSessionSweeper(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.EnforceIdleTimeouts(ctx); err != nil {
+				s.logger.Error("Failed to enforce session idle timeouts", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+		}
+	}
+}
+
+// checkSessionActive rejects revoked, expired and idle sessions
+func (s *AuthService) checkSessionActive(ctx context.Context, session *models.UserSession) error {
+	if !session.IsValid() {
+		return errors.Unauthorized("Session expired", nil)
+	}
+
+	club, err := s.repo.GetClubByID(ctx, session.ClubID)
+	if err != nil {
+		return err
+	}
+
+	if session.IsIdle(sessionIdleTimeout(club)) {
+		session.Invalidate()
+		if err := s.repo.UpdateSession(ctx, session); err != nil {
+			s.logger.Warn("Failed to invalidate idle session", map[string]interface{}{
+				"error":      err.Error(),
+				"session_id": session.ID,
+			})
+		}
+		return errors.Unauthorized("Session expired due to inactivity", nil)
+	}
+
+	return nil
+}
+
+// revokeUserSessions revokes a user's active sessions, keeping exceptSessionID if set
+func (s *AuthService) revokeUserSessions(ctx context.Context, user *models.User, exceptSessionID string, details string) (int, error) {
+	sessions, err := s.repo.GetActiveSessionsByUser(ctx, user.ClubID, user.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if exceptSessionID != "" && session.HankoSessionID == exceptSessionID {
+			continue
+		}
+		if err := s.revokeSession(ctx, user, session, details); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+// revokeSession ends a session locally, so ValidateSession rejects it at once,
+// and with Hanko on a best-effort basis
+func (s *AuthService) revokeSession(ctx context.Context, user *models.User, session *models.UserSession, details string) error {
+	if !session.IsActive {
+		return nil
+	}
+
+	if session.AuthProvider != models.PasskeyProviderNative {
+		if err := s.hankoClient.InvalidateSession(ctx, session.HankoSessionID); err != nil {
+			s.logger.Warn("Failed to invalidate session in Hanko", map[string]interface{}{
+				"error":      err.Error(),
+				"session_id": session.ID,
+			})
+		}
+	}
+
+	session.Invalidate()
+	if err := s.repo.UpdateSession(ctx, session); err != nil {
+		return err
+	}
+
+	metadata := map[string]interface{}{
+		"session_id": session.ID,
+		"ip_address": session.IPAddress,
+		"device":     describeDevice(session.UserAgent),
+	}
+	s.createAuditLogWithMetadata(ctx, user.ClubID, user, models.AuditActionSessionRevoked, details, true, "", metadata)
+	s.publishUserEventWithData(ctx, "user.session_revoked", user, metadata)
+
+	s.logger.Info("Session revoked", map[string]interface{}{
+		"user_id":    user.ID,
+		"session_id": session.ID,
+	})
+
+	return nil
+}
+
+// sessionLocation resolves a coarse "City, Country" location for an IP address
+func (s *AuthService) sessionLocation(ip string) string {
+	if s.geoip == nil {
+		return ""
+	}
+	location, ok := s.geoip.Lookup(ip)
+	if !ok {
+		return ""
+	}
+	if location.City == "" {
+		return location.Country
+	}
+	return fmt.Sprintf("%s, %s", location.City, location.Country)
+}
+
+func sessionIdleTimeout(club *models.Club) time.Duration {
+	return time.Duration(club.Settings.SessionTimeoutMinutes) * time.Minute
+}
+
+func toSessionInfo(session *models.UserSession, currentSessionID string) *SessionInfo {
+	return &SessionInfo{
+		ID:             session.ID,
+		UserID:         session.UserID,
+		Device:         describeDevice(session.UserAgent),
+		IPAddress:      session.IPAddress,
+		Location:       session.Location,
+		UserAgent:      session.UserAgent,
+		AuthProvider:   session.AuthProvider,
+		IsActive:       session.IsValid(),
+		Current:        currentSessionID != "" && session.HankoSessionID == currentSessionID,
+		CreatedAt:      session.CreatedAt,
+		LastActivityAt: session.LastActivityAt,
+		ExpiresAt:      session.ExpiresAt,
+	}
+}
+
+// describeDevice summarises a user agent as "Browser on OS" for display
+func describeDevice(userAgent string) string {
+	if userAgent == "" || userAgent == "unknown" {
+		return "Unknown device"
+	}
+
+	browser := "Unknown browser"
+	for _, candidate := range []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+		{"grpc-", "gRPC client"},
+	} {
+		if strings.Contains(userAgent, candidate.token) {
+			browser = candidate.name
+			break
+		}
+	}
+
+	for _, candidate := range []struct{ token, name string }{
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	} {
+		if strings.Contains(userAgent, candidate.token) {
+			return browser + " on " + candidate.name
+		}
+	}
+
+	return browser
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/database"
+	"reciprocal-clubs-backend/pkg/shared/errors"
+	"reciprocal-clubs-backend/services/auth-service/internal/hanko"
+	"reciprocal-clubs-backend/services/auth-service/internal/models"
+	"reciprocal-clubs-backend/services/auth-service/internal/testutil"
+)
+
+// createSession stores a local session that Hanko also considers valid
+func createSession(t *testing.T, db *database.Database, mockHanko *testutil.MockHankoClient, user *models.User, userAgent, ip string) *models.UserSession {
+	session := testutil.CreateTestSession(db.DB, user.ID, user.ClubID)
+	session.UserAgent = userAgent
+	session.IPAddress = ip
+	session.LastActivityAt = time.Now()
+	if err := db.Save(session).Error; err != nil {
+		t.Fatalf("Failed to update session: %v", err)
+	}
+
+	mockHanko.AddSession(session.HankoSessionID, &hanko.HankoSession{
+		ID:        session.HankoSessionID,
+		UserID:    user.HankoUserID,
+		ExpiresAt: session.ExpiresAt,
+	})
+	return session
+}
+
+func TestAuthService_ListUserSessions(t *testing.T) {
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	laptop := createSession(t, db, mockHanko, testUser, "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_0) AppleWebKit/605.1.15 Version/17.0 Safari/605.1.15", "192.0.2.10")
+	phone := createSession(t, db, mockHanko, testUser, "Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 Chrome/120.0 Mobile Safari/537.36", "198.51.100.7")
+	ended := createSession(t, db, mockHanko, testUser, "curl/8.0", "203.0.113.1")
+	ended.Invalidate()
+	db.Save(ended)
+
+	sessions, err := service.ListUserSessions(ctx, testClub.ID, testUser.ID, laptop.HankoSessionID)
+
+	testutil.AssertNoError(t, err, "Sessions should be listed")
+	testutil.AssertEqual(t, 2, len(sessions), "Only active sessions should be listed")
+	for _, session := range sessions {
+		switch session.ID {
+		case laptop.ID:
+			testutil.AssertTrue(t, session.Current, "Caller's session should be marked current")
+			testutil.AssertEqual(t, "Safari on macOS", session.Device, "Device should be described")
+		case phone.ID:
+			testutil.AssertFalse(t, session.Current, "Other sessions should not be current")
+			testutil.AssertEqual(t, "Chrome on Android", session.Device, "Device should be described")
+			testutil.AssertEqual(t, "198.51.100.7", session.IPAddress, "IP address should be listed")
+		default:
+			t.Fatalf("Unexpected session %d listed", session.ID)
+		}
+	}
+
+	_, err = service.ListUserSessions(ctx, testClub.ID, 9999, "")
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrNotFound), "Unknown user should not be found")
+}
+
+func TestAuthService_AuthenticateSession(t *testing.T) {
+	service, mockHanko, db, _, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	mockHanko.AddUser(&hanko.HankoUser{
+		ID:            testUser.HankoUserID,
+		Email:         testUser.Email,
+		EmailVerified: true,
+	})
+	session := createSession(t, db, mockHanko, testUser, "curl/8.0", "192.0.2.10")
+
+	user, current, err := service.AuthenticateSession(ctx, session.HankoSessionID)
+
+	testutil.AssertNoError(t, err, "Session should authenticate")
+	testutil.AssertEqual(t, testUser.ID, user.ID, "Session user should be returned")
+	testutil.AssertEqual(t, session.ID, current.ID, "Session behind the token should be returned")
+}
+
+func TestAuthService_RevokeSession(t *testing.T) {
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	mockHanko.AddUser(&hanko.HankoUser{
+		ID:            testUser.HankoUserID,
+		Email:         testUser.Email,
+		EmailVerified: true,
+	})
+	session := createSession(t, db, mockHanko, testUser, "Mozilla/5.0 (iPhone) Safari/604.1", "192.0.2.10")
+
+	_, err := service.ValidateSession(ctx, session.HankoSessionID)
+	testutil.AssertNoError(t, err, "Session should be valid before revocation")
+
+	err = service.RevokeSession(ctx, testClub.ID, testUser.ID+1, session.ID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrNotFound), "Users cannot revoke sessions they do not own")
+
+	err = service.RevokeSession(ctx, testClub.ID, testUser.ID, session.ID)
+	testutil.AssertNoError(t, err, "Session should be revoked")
+
+	// Even if the provider still honours the session, local revocation wins
+	mockHanko.AddSession(session.HankoSessionID, &hanko.HankoSession{
+		ID:        session.HankoSessionID,
+		UserID:    testUser.HankoUserID,
+		ExpiresAt: session.ExpiresAt,
+	})
+	_, err = service.ValidateSession(ctx, session.HankoSessionID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Revoked session should be rejected immediately")
+
+	err = service.RevokeSession(ctx, testClub.ID, testUser.ID, session.ID)
+	testutil.AssertNoError(t, err, "Revoking an ended session should be a no-op")
+}
+
+func TestAuthService_RevokeOtherSessions(t *testing.T) {
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	current := createSession(t, db, mockHanko, testUser, "Firefox/120.0", "192.0.2.10")
+	createSession(t, db, mockHanko, testUser, "Chrome/120.0", "192.0.2.11")
+	createSession(t, db, mockHanko, testUser, "Chrome/120.0", "192.0.2.12")
+
+	_, err := service.RevokeOtherSessions(ctx, testClub.ID, testUser.ID, "")
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrInvalidInput), "Current session is required")
+
+	revoked, err := service.RevokeOtherSessions(ctx, testClub.ID, testUser.ID, current.HankoSessionID)
+	testutil.AssertNoError(t, err, "Other sessions should be revoked")
+	testutil.AssertEqual(t, 2, revoked, "Two sessions should be revoked")
+
+	sessions, err := service.ListUserSessions(ctx, testClub.ID, testUser.ID, current.HankoSessionID)
+	testutil.AssertNoError(t, err, "Sessions should be listed")
+	testutil.AssertEqual(t, 1, len(sessions), "Only the current session should remain")
+	testutil.AssertTrue(t, sessions[0].Current, "Remaining session should be the current one")
+}
+
+func TestAuthService_AdminSessionManagement(t *testing.T) {
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	stolen := createSession(t, db, mockHanko, testUser, "Chrome/120.0 (Windows NT 10.0)", "203.0.113.50")
+	createSession(t, db, mockHanko, testUser, "Safari/604.1 (iPhone)", "192.0.2.10")
+
+	result, err := service.SearchSessions(ctx, &SessionSearchRequest{ClubID: testClub.ID, Query: "203.0.113", ActiveOnly: true})
+	testutil.AssertNoError(t, err, "Sessions should be searchable")
+	testutil.AssertEqual(t, int64(1), result.Total, "Search should match by IP address")
+	testutil.AssertEqual(t, stolen.ID, result.Sessions[0].ID, "Matching session should be returned")
+	testutil.AssertEqual(t, testUser.Email, result.Sessions[0].UserEmail, "Search results should include the user")
+
+	err = service.AdminRevokeSession(ctx, testClub.ID, stolen.ID)
+	testutil.AssertNoError(t, err, "Admin should revoke any session in the club")
+
+	err = service.AdminRevokeSession(ctx, testClub.ID+1, stolen.ID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrNotFound), "Sessions from other clubs should not be found")
+
+	result, err = service.SearchSessions(ctx, &SessionSearchRequest{ClubID: testClub.ID, UserID: testUser.ID, ActiveOnly: true})
+	testutil.AssertNoError(t, err, "Sessions should be searchable")
+	testutil.AssertEqual(t, int64(1), result.Total, "Revoked session should no longer be active")
+
+	revoked, err := service.AdminRevokeUserSessions(ctx, testClub.ID, testUser.ID)
+	testutil.AssertNoError(t, err, "Admin should revoke all user sessions")
+	testutil.AssertEqual(t, 1, revoked, "Remaining session should be revoked")
+
+	result, err = service.SearchSessions(ctx, &SessionSearchRequest{ClubID: testClub.ID})
+	testutil.AssertNoError(t, err, "Sessions should be searchable")
+	testutil.AssertEqual(t, int64(2), result.Total, "Inactive sessions should be included when requested")
+}
+
+func TestAuthService_SessionIdleTimeout(t *testing.T) {
+	service, mockHanko, db, testClub, testUser := setupTestService(t)
+	ctx := testutil.TestContext()
+
+	mockHanko.AddUser(&hanko.HankoUser{
+		ID:            testUser.HankoUserID,
+		Email:         testUser.Email,
+		EmailVerified: true,
+	})
+
+	testClub.Settings.SessionTimeoutMinutes = 30
+	if err := db.Save(testClub).Error; err != nil {
+		t.Fatalf("Failed to update club settings: %v", err)
+	}
+
+	idle := createSession(t, db, mockHanko, testUser, "Firefox/120.0", "192.0.2.10")
+	idle.LastActivityAt = time.Now().Add(-time.Hour)
+	db.Save(idle)
+	active := createSession(t, db, mockHanko, testUser, "Chrome/120.0", "192.0.2.11")
+
+	_, err := service.ValidateSession(ctx, idle.HankoSessionID)
+	testutil.AssertTrue(t, errors.Is(err, errors.ErrUnauthorized), "Idle session should be rejected")
+
+	_, err = service.ValidateSession(ctx, active.HankoSessionID)
+	testutil.AssertNoError(t, err, "Recently used session should be valid")
+
+	stale := createSession(t, db, mockHanko, testUser, "Edg/120.0", "192.0.2.12")
+	stale.LastActivityAt = time.Now().Add(-2 * time.Hour)
+	db.Save(stale)
+
+	count, err := service.EnforceIdleTimeouts(ctx)
+	testutil.AssertNoError(t, err, "Idle timeouts should be enforced")
+	testutil.AssertEqual(t, int64(1), count, "Only the stale session should be invalidated")
+
+	sessions, err := service.ListUserSessions(ctx, testClub.ID, testUser.ID, "")
+	testutil.AssertNoError(t, err, "Sessions should be listed")
+	testutil.AssertEqual(t, 1, len(sessions), "Only the active session should remain")
+	testutil.AssertEqual(t, active.ID, sessions[0].ID, "Active session should remain")
+}
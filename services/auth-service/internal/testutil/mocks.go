@@ -487,6 +487,12 @@ func (m *MockHankoClient) InvalidateSession(ctx context.Context, sessionID strin
 }
 
 func (m *MockHankoClient) HealthCheck(ctx context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.shouldFail["HealthCheck"] {
+		return fmt.Errorf("mock error: health check failed")
+	}
 	return nil
 }
 
@@ -508,6 +514,9 @@ func NewMockConfig() *config.Config {
 			Environment: "test",
 			Port:        8080,
 		},
+		Audit: config.AuditConfig{
+			SigningKey: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+		},
 		Auth: config.AuthConfig{
 			JWTSecret:    "test-jwt-secret",
 			JWTExpiration: 3600,
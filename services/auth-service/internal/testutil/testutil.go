@@ -67,6 +67,8 @@ func NewTestDB(t *testing.T) *TestDB {
 		&models.RolePermission{},
 		&models.UserSession{},
 		&models.AuditLog{},
+		&models.AuditChainState{},
+		&models.AuditAnchor{},
 	)
 	if err != nil {
 		t.Fatalf("Failed to run migrations: %v", err)
@@ -361,6 +363,8 @@ func findInString(haystack, needle string) bool {
 
 // TestContext returns a context with timeout for tests
 func TestContext() context.Context {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	// The context is released when it times out
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	_ = cancel
 	return ctx
 }
\ No newline at end of file
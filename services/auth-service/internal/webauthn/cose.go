@@ -0,0 +1,190 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// COSEAlgorithm identifies a COSE signature algorithm (RFC 8152 / IANA registry)
+type COSEAlgorithm int64
+
+const (
+	AlgES256 COSEAlgorithm = -7
+	AlgES384 COSEAlgorithm = -35
+	AlgES512 COSEAlgorithm = -36
+	AlgEdDSA COSEAlgorithm = -8
+	AlgRS256 COSEAlgorithm = -257
+)
+
+// COSE key parameters used by WebAuthn credential public keys
+const (
+	coseKeyKty = 1
+	coseKeyAlg = 3
+	coseKeyCrv = -1
+	coseKeyX   = -2
+	coseKeyY   = -3
+	coseKeyN   = -1
+	coseKeyE   = -2
+
+	coseKtyOKP = 1
+	coseKtyEC2 = 2
+	coseKtyRSA = 3
+
+	coseCrvP256    = 1
+	coseCrvP384    = 2
+	coseCrvP521    = 3
+	coseCrvEd25519 = 6
+)
+
+// ErrUnsupportedAlgorithm is returned for credential keys we cannot verify
+var ErrUnsupportedAlgorithm = errors.New("webauthn: unsupported COSE algorithm")
+
+// PublicKey is a parsed COSE credential public key
+type PublicKey struct {
+	Algorithm COSEAlgorithm
+	key       crypto.PublicKey
+}
+
+// ParsePublicKey parses a CBOR encoded COSE_Key as stored on a credential
+func ParsePublicKey(coseKey []byte) (*PublicKey, error) {
+	raw, n, err := decodeCBOR(coseKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode COSE key: %w", err)
+	}
+	if n != len(coseKey) {
+		return nil, errors.New("webauthn: trailing data after COSE key")
+	}
+	m, ok := raw.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("webauthn: COSE key is not a map")
+	}
+
+	kty, _ := m[int64(coseKeyKty)].(int64)
+	alg, ok := m[int64(coseKeyAlg)].(int64)
+	if !ok {
+		return nil, errors.New("webauthn: COSE key missing algorithm")
+	}
+
+	pk := &PublicKey{Algorithm: COSEAlgorithm(alg)}
+
+	switch kty {
+	case coseKtyEC2:
+		crv, _ := m[int64(coseKeyCrv)].(int64)
+		x, _ := m[int64(coseKeyX)].([]byte)
+		y, _ := m[int64(coseKeyY)].([]byte)
+		var curve elliptic.Curve
+		switch crv {
+		case coseCrvP256:
+			curve = elliptic.P256()
+		case coseCrvP384:
+			curve = elliptic.P384()
+		case coseCrvP521:
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("webauthn: unsupported EC2 curve %d", crv)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, errors.New("webauthn: invalid EC2 coordinates")
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("webauthn: EC2 point is not on curve")
+		}
+		pk.key = key
+	case coseKtyOKP:
+		crv, _ := m[int64(coseKeyCrv)].(int64)
+		x, _ := m[int64(coseKeyX)].([]byte)
+		if crv != coseCrvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("webauthn: unsupported OKP key")
+		}
+		pk.key = ed25519.PublicKey(x)
+	case coseKtyRSA:
+		n, _ := m[int64(coseKeyN)].([]byte)
+		e, _ := m[int64(coseKeyE)].([]byte)
+		if len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("webauthn: invalid RSA key")
+		}
+		exponent := 0
+		for _, b := range e {
+			exponent = exponent<<8 | int(b)
+		}
+		pk.key = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}
+	default:
+		return nil, fmt.Errorf("webauthn: unsupported key type %d", kty)
+	}
+
+	return pk, nil
+}
+
+// Verify checks a WebAuthn signature over the given message
+func (k *PublicKey) Verify(message, signature []byte) error {
+	return verifySignature(k.Algorithm, k.key, message, signature)
+}
+
+func verifySignature(alg COSEAlgorithm, key crypto.PublicKey, message, signature []byte) error {
+	switch alg {
+	case AlgES256, AlgES384, AlgES512:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("webauthn: key does not match ECDSA algorithm")
+		}
+		var digest []byte
+		switch alg {
+		case AlgES256:
+			sum := sha256.Sum256(message)
+			digest = sum[:]
+		case AlgES384:
+			sum := sha512.Sum384(message)
+			digest = sum[:]
+		default:
+			sum := sha512.Sum512(message)
+			digest = sum[:]
+		}
+		if !ecdsa.VerifyASN1(ecKey, digest, signature) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case AlgEdDSA:
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return errors.New("webauthn: key does not match EdDSA algorithm")
+		}
+		if !ed25519.Verify(edKey, message, signature) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case AlgRS256:
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("webauthn: key does not match RSA algorithm")
+		}
+		digest := sha256.Sum256(message)
+		if err := rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+	return ErrUnsupportedAlgorithm
+}
+
+// uncompressedEC2 returns the ANSI X9.62 uncompressed point used by FIDO U2F
+func (k *PublicKey) uncompressedEC2() ([]byte, error) {
+	ecKey, ok := k.key.(*ecdsa.PublicKey)
+	if !ok || ecKey.Curve != elliptic.P256() {
+		return nil, errors.New("webauthn: fido-u2f requires a P-256 credential key")
+	}
+	point := make([]byte, 65)
+	point[0] = 0x04
+	ecKey.X.FillBytes(point[1:33])
+	ecKey.Y.FillBytes(point[33:])
+	return point, nil
+}
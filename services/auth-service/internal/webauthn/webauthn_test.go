@@ -0,0 +1,340 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+)
+
+const testOrigin = "https://clubs.example.com"
+
+// testAuthenticator emulates a platform authenticator holding one P-256 key
+type testAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	id := make([]byte, 16)
+	rand.Read(id)
+	return &testAuthenticator{key: key, credentialID: id}
+}
+
+func (a *testAuthenticator) coseKey() []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	a.key.X.FillBytes(x)
+	a.key.Y.FillBytes(y)
+	return encodeTestCBOR(map[interface{}]interface{}{
+		int64(1):  int64(2),
+		int64(3):  int64(-7),
+		int64(-1): int64(1),
+		int64(-2): x,
+		int64(-3): y,
+	})
+}
+
+func (a *testAuthenticator) authData(rpID string, flags byte, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	if attested {
+		flags |= flagAttestedData
+	}
+	data = append(data, flags)
+	counter := make([]byte, 4)
+	binary.BigEndian.PutUint32(counter, a.signCount)
+	data = append(data, counter...)
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		idLen := make([]byte, 2)
+		binary.BigEndian.PutUint16(idLen, uint16(len(a.credentialID)))
+		data = append(data, idLen...)
+		data = append(data, a.credentialID...)
+		data = append(data, a.coseKey()...)
+	}
+	return data
+}
+
+func (a *testAuthenticator) sign(t *testing.T, authData, clientDataJSON []byte) []byte {
+	hash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), hash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	return sig
+}
+
+func (a *testAuthenticator) create(t *testing.T, options *CreationOptions, origin, format string) *RegistrationResponse {
+	clientDataJSON, _ := json.Marshal(clientData{Type: "webauthn.create", Challenge: options.Challenge, Origin: origin})
+	authData := a.authData(options.RP.ID, flagUserPresent|flagUserVerified, true)
+
+	attStmt := map[interface{}]interface{}{}
+	if format == "packed" {
+		attStmt["alg"] = int64(-7)
+		attStmt["sig"] = a.sign(t, authData, clientDataJSON)
+	}
+	attObj := encodeTestCBOR(map[interface{}]interface{}{
+		"fmt":      format,
+		"attStmt":  attStmt,
+		"authData": authData,
+	})
+
+	resp := &RegistrationResponse{ID: encode(a.credentialID), RawID: encode(a.credentialID), Type: "public-key"}
+	resp.Response.ClientDataJSON = encode(clientDataJSON)
+	resp.Response.AttestationObject = encode(attObj)
+	resp.Response.Transports = []string{"internal"}
+	return resp
+}
+
+func (a *testAuthenticator) get(t *testing.T, options *RequestOptions, origin string, userHandle []byte) *AssertionResponse {
+	a.signCount++
+	clientDataJSON, _ := json.Marshal(clientData{Type: "webauthn.get", Challenge: options.Challenge, Origin: origin})
+	authData := a.authData(options.RPID, flagUserPresent|flagUserVerified, false)
+
+	resp := &AssertionResponse{ID: encode(a.credentialID), RawID: encode(a.credentialID), Type: "public-key"}
+	resp.Response.ClientDataJSON = encode(clientDataJSON)
+	resp.Response.AuthenticatorData = encode(authData)
+	resp.Response.Signature = encode(a.sign(t, authData, clientDataJSON))
+	resp.Response.UserHandle = encode(userHandle)
+	return resp
+}
+
+// encodeTestCBOR encodes the small subset of CBOR needed by the tests
+func encodeTestCBOR(v interface{}) []byte {
+	head := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n < 256:
+			return []byte{major<<5 | 24, byte(n)}
+		default:
+			b := []byte{major<<5 | 25, 0, 0}
+			binary.BigEndian.PutUint16(b[1:], uint16(n))
+			return b
+		}
+	}
+
+	switch val := v.(type) {
+	case int64:
+		if val >= 0 {
+			return head(0, uint64(val))
+		}
+		return head(1, uint64(-1-val))
+	case []byte:
+		return append(head(2, uint64(len(val))), val...)
+	case string:
+		return append(head(3, uint64(len(val))), val...)
+	case map[interface{}]interface{}:
+		keys := make([]interface{}, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return string(encodeTestCBOR(keys[i])) < string(encodeTestCBOR(keys[j]))
+		})
+		out := head(5, uint64(len(val)))
+		for _, k := range keys {
+			out = append(out, encodeTestCBOR(k)...)
+			out = append(out, encodeTestCBOR(val[k])...)
+		}
+		return out
+	}
+	panic("unsupported test CBOR type")
+}
+
+func newTestRelyingParty(t *testing.T) *RelyingParty {
+	rp, err := NewRelyingParty(Config{
+		RPID:    "clubs.example.com",
+		RPName:  "Reciprocal Clubs",
+		Origins: []string{testOrigin},
+	})
+	if err != nil {
+		t.Fatalf("NewRelyingParty failed: %v", err)
+	}
+	return rp
+}
+
+func registerTestCredential(t *testing.T, rp *RelyingParty, authenticator *testAuthenticator, user User) *Credential {
+	options, session, err := rp.BeginRegistration(user, nil)
+	if err != nil {
+		t.Fatalf("BeginRegistration failed: %v", err)
+	}
+	credential, err := rp.FinishRegistration(session, authenticator.create(t, options, testOrigin, "none"))
+	if err != nil {
+		t.Fatalf("FinishRegistration failed: %v", err)
+	}
+	return credential
+}
+
+func TestNewRelyingParty_Validation(t *testing.T) {
+	if _, err := NewRelyingParty(Config{Origins: []string{testOrigin}}); err == nil {
+		t.Error("Expected error for missing RP ID")
+	}
+	if _, err := NewRelyingParty(Config{RPID: "clubs.example.com"}); err == nil {
+		t.Error("Expected error for missing origins")
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	tests := []struct {
+		name            string
+		format          string
+		origin          string
+		tamperChallenge bool
+		expectedType    string
+		expectedErr     error
+	}{
+		{name: "none attestation", format: "none", origin: testOrigin, expectedType: "none"},
+		{name: "packed self attestation", format: "packed", origin: testOrigin, expectedType: "self"},
+		{name: "wrong origin", format: "none", origin: "https://evil.example.com", expectedErr: ErrOriginNotAllowed},
+		{name: "wrong challenge", format: "none", origin: testOrigin, tamperChallenge: true, expectedErr: ErrChallengeMismatch},
+		{name: "unsupported format", format: "tpm", origin: testOrigin, expectedErr: ErrUnsupportedAttestation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := newTestRelyingParty(t)
+			authenticator := newTestAuthenticator(t)
+			user := User{ID: []byte("user-1"), Name: "member@example.com", DisplayName: "Member"}
+
+			options, session, err := rp.BeginRegistration(user, nil)
+			if err != nil {
+				t.Fatalf("BeginRegistration failed: %v", err)
+			}
+			if options.User.ID != encode(user.ID) {
+				t.Errorf("Expected user ID %s, got %s", encode(user.ID), options.User.ID)
+			}
+
+			if tt.tamperChallenge {
+				other, _ := newChallenge()
+				options.Challenge = other
+			}
+
+			credential, err := rp.FinishRegistration(session, authenticator.create(t, options, tt.origin, tt.format))
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FinishRegistration failed: %v", err)
+			}
+			if credential.AttestationType != tt.expectedType {
+				t.Errorf("Expected attestation type %s, got %s", tt.expectedType, credential.AttestationType)
+			}
+			if string(credential.ID) != string(authenticator.credentialID) {
+				t.Error("Credential ID does not match authenticator")
+			}
+			if !credential.UserVerified {
+				t.Error("Expected user verified flag")
+			}
+		})
+	}
+}
+
+func TestRegistration_ExpiredSession(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newTestAuthenticator(t)
+	user := User{ID: []byte("user-1"), Name: "member@example.com"}
+
+	options, session, _ := rp.BeginRegistration(user, nil)
+	session.ExpiresAt = time.Now().Add(-time.Second)
+
+	if _, err := rp.FinishRegistration(session, authenticator.create(t, options, testOrigin, "none")); !errors.Is(err, ErrSessionExpired) {
+		t.Errorf("Expected ErrSessionExpired, got %v", err)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newTestAuthenticator(t)
+	user := User{ID: []byte("user-1"), Name: "member@example.com"}
+	credential := registerTestCredential(t, rp, authenticator, user)
+
+	options, session, err := rp.BeginLogin(user, []Credential{*credential})
+	if err != nil {
+		t.Fatalf("BeginLogin failed: %v", err)
+	}
+	if len(options.AllowCredentials) != 1 {
+		t.Fatalf("Expected 1 allowed credential, got %d", len(options.AllowCredentials))
+	}
+
+	used, err := rp.FinishLogin(session, []Credential{*credential}, authenticator.get(t, options, testOrigin, user.ID))
+	if err != nil {
+		t.Fatalf("FinishLogin failed: %v", err)
+	}
+	if used.SignCount != 1 {
+		t.Errorf("Expected sign count 1, got %d", used.SignCount)
+	}
+}
+
+func TestLogin_Failures(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(resp *AssertionResponse, cred *Credential, other *testAuthenticator, t *testing.T)
+		expectedErr error
+	}{
+		{
+			name: "sign count regression",
+			mutate: func(resp *AssertionResponse, cred *Credential, other *testAuthenticator, t *testing.T) {
+				cred.SignCount = 10
+			},
+			expectedErr: ErrSignCountRegression,
+		},
+		{
+			name: "unknown credential",
+			mutate: func(resp *AssertionResponse, cred *Credential, other *testAuthenticator, t *testing.T) {
+				resp.RawID = encode(other.credentialID)
+			},
+			expectedErr: ErrUnknownCredential,
+		},
+		{
+			name: "invalid signature",
+			mutate: func(resp *AssertionResponse, cred *Credential, other *testAuthenticator, t *testing.T) {
+				authData, _ := decode(resp.Response.AuthenticatorData)
+				clientDataJSON, _ := decode(resp.Response.ClientDataJSON)
+				resp.Response.Signature = encode(other.sign(t, authData, clientDataJSON))
+			},
+			expectedErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := newTestRelyingParty(t)
+			authenticator := newTestAuthenticator(t)
+			other := newTestAuthenticator(t)
+			user := User{ID: []byte("user-1"), Name: "member@example.com"}
+			credential := registerTestCredential(t, rp, authenticator, user)
+
+			options, session, _ := rp.BeginLogin(user, []Credential{*credential})
+			resp := authenticator.get(t, options, testOrigin, user.ID)
+			tt.mutate(resp, credential, other, t)
+
+			if _, err := rp.FinishLogin(session, []Credential{*credential}, resp); !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestDecodeCBOR_Truncated(t *testing.T) {
+	data := encodeTestCBOR(map[interface{}]interface{}{"fmt": "none"})
+	if _, _, err := decodeCBOR(data[:len(data)-2]); err == nil {
+		t.Error("Expected error for truncated CBOR")
+	}
+}
@@ -1110,14 +1110,15 @@ func (x *InitiatePasskeyLoginResponse) GetMessage() string {
 }
 
 type CompletePasskeyLoginRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ClubSlug         string                 `protobuf:"bytes,1,opt,name=club_slug,json=clubSlug,proto3" json:"club_slug,omitempty"`
-	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CredentialResult *structpb.Struct       `protobuf:"bytes,3,opt,name=credential_result,json=credentialResult,proto3" json:"credential_result,omitempty"`
-	IpAddress        string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	UserAgent        string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ClubSlug          string                 `protobuf:"bytes,1,opt,name=club_slug,json=clubSlug,proto3" json:"club_slug,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CredentialResult  *structpb.Struct       `protobuf:"bytes,3,opt,name=credential_result,json=credentialResult,proto3" json:"credential_result,omitempty"`
+	IpAddress         string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent         string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	DeviceFingerprint string                 `protobuf:"bytes,6,opt,name=device_fingerprint,json=deviceFingerprint,proto3" json:"device_fingerprint,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CompletePasskeyLoginRequest) Reset() {
@@ -1185,16 +1186,28 @@ func (x *CompletePasskeyLoginRequest) GetUserAgent() string {
 	return ""
 }
 
+func (x *CompletePasskeyLoginRequest) GetDeviceFingerprint() string {
+	if x != nil {
+		return x.DeviceFingerprint
+	}
+	return ""
+}
+
 type CompletePasskeyLoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	User                  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token                 string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Success               bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Message               string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	MfaRequired           bool                   `protobuf:"varint,7,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaChallenge          string                 `protobuf:"bytes,8,opt,name=mfa_challenge,json=mfaChallenge,proto3" json:"mfa_challenge,omitempty"`
+	MfaMethods            []string               `protobuf:"bytes,9,rep,name=mfa_methods,json=mfaMethods,proto3" json:"mfa_methods,omitempty"`
+	MfaOptions            *structpb.Struct       `protobuf:"bytes,10,opt,name=mfa_options,json=mfaOptions,proto3" json:"mfa_options,omitempty"`
+	MfaEnrollmentRequired bool                   `protobuf:"varint,11,opt,name=mfa_enrollment_required,json=mfaEnrollmentRequired,proto3" json:"mfa_enrollment_required,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CompletePasskeyLoginResponse) Reset() {
@@ -1269,6 +1282,149 @@ func (x *CompletePasskeyLoginResponse) GetMessage() string {
 	return ""
 }
 
+func (x *CompletePasskeyLoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *CompletePasskeyLoginResponse) GetMfaChallenge() string {
+	if x != nil {
+		return x.MfaChallenge
+	}
+	return ""
+}
+
+func (x *CompletePasskeyLoginResponse) GetMfaMethods() []string {
+	if x != nil {
+		return x.MfaMethods
+	}
+	return nil
+}
+
+func (x *CompletePasskeyLoginResponse) GetMfaOptions() *structpb.Struct {
+	if x != nil {
+		return x.MfaOptions
+	}
+	return nil
+}
+
+func (x *CompletePasskeyLoginResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil {
+		return x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+type CompleteLoginChallengeRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ClubSlug          string                 `protobuf:"bytes,1,opt,name=club_slug,json=clubSlug,proto3" json:"club_slug,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Challenge         string                 `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code              string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Method            string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	IpAddress         string                 `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent         string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	DeviceFingerprint string                 `protobuf:"bytes,8,opt,name=device_fingerprint,json=deviceFingerprint,proto3" json:"device_fingerprint,omitempty"`
+	CredentialResult  *structpb.Struct       `protobuf:"bytes,9,opt,name=credential_result,json=credentialResult,proto3" json:"credential_result,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CompleteLoginChallengeRequest) Reset() {
+	*x = CompleteLoginChallengeRequest{}
+	mi := &file_proto_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteLoginChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteLoginChallengeRequest) ProtoMessage() {}
+
+func (x *CompleteLoginChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteLoginChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CompleteLoginChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CompleteLoginChallengeRequest) GetClubSlug() string {
+	if x != nil {
+		return x.ClubSlug
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetDeviceFingerprint() string {
+	if x != nil {
+		return x.DeviceFingerprint
+	}
+	return ""
+}
+
+func (x *CompleteLoginChallengeRequest) GetCredentialResult() *structpb.Struct {
+	if x != nil {
+		return x.CredentialResult
+	}
+	return nil
+}
+
 type InitiatePasskeyRegistrationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
@@ -1279,7 +1435,7 @@ type InitiatePasskeyRegistrationRequest struct {
 
 func (x *InitiatePasskeyRegistrationRequest) Reset() {
 	*x = InitiatePasskeyRegistrationRequest{}
-	mi := &file_proto_auth_proto_msgTypes[16]
+	mi := &file_proto_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1291,7 +1447,7 @@ func (x *InitiatePasskeyRegistrationRequest) String() string {
 func (*InitiatePasskeyRegistrationRequest) ProtoMessage() {}
 
 func (x *InitiatePasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[16]
+	mi := &file_proto_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,7 +1460,7 @@ func (x *InitiatePasskeyRegistrationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InitiatePasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
 func (*InitiatePasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{16}
+	return file_proto_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *InitiatePasskeyRegistrationRequest) GetClubId() uint32 {
@@ -1333,7 +1489,7 @@ type InitiatePasskeyRegistrationResponse struct {
 
 func (x *InitiatePasskeyRegistrationResponse) Reset() {
 	*x = InitiatePasskeyRegistrationResponse{}
-	mi := &file_proto_auth_proto_msgTypes[17]
+	mi := &file_proto_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1345,7 +1501,7 @@ func (x *InitiatePasskeyRegistrationResponse) String() string {
 func (*InitiatePasskeyRegistrationResponse) ProtoMessage() {}
 
 func (x *InitiatePasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[17]
+	mi := &file_proto_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,7 +1514,7 @@ func (x *InitiatePasskeyRegistrationResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use InitiatePasskeyRegistrationResponse.ProtoReflect.Descriptor instead.
 func (*InitiatePasskeyRegistrationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{17}
+	return file_proto_auth_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *InitiatePasskeyRegistrationResponse) GetOptions() *structpb.Struct {
@@ -1368,51 +1524,1803 @@ func (x *InitiatePasskeyRegistrationResponse) GetOptions() *structpb.Struct {
 	return nil
 }
 
-func (x *InitiatePasskeyRegistrationResponse) GetUserId() string {
+func (x *InitiatePasskeyRegistrationResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InitiatePasskeyRegistrationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InitiatePasskeyRegistrationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CompletePasskeyRegistrationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClubId           uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CredentialResult *structpb.Struct       `protobuf:"bytes,3,opt,name=credential_result,json=credentialResult,proto3" json:"credential_result,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CompletePasskeyRegistrationRequest) Reset() {
+	*x = CompletePasskeyRegistrationRequest{}
+	mi := &file_proto_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *CompletePasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*CompletePasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CompletePasskeyRegistrationRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *CompletePasskeyRegistrationRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompletePasskeyRegistrationRequest) GetCredentialResult() *structpb.Struct {
+	if x != nil {
+		return x.CredentialResult
+	}
+	return nil
+}
+
+type CompletePasskeyRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletePasskeyRegistrationResponse) Reset() {
+	*x = CompletePasskeyRegistrationResponse{}
+	mi := &file_proto_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePasskeyRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePasskeyRegistrationResponse) ProtoMessage() {}
+
+func (x *CompletePasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePasskeyRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*CompletePasskeyRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompletePasskeyRegistrationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CompletePasskeyRegistrationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	ClubId        uint32                 `protobuf:"varint,2,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateSessionRequest) Reset() {
+	*x = ValidateSessionRequest{}
+	mi := &file_proto_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSessionRequest) ProtoMessage() {}
+
+func (x *ValidateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSessionRequest.ProtoReflect.Descriptor instead.
+func (*ValidateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ValidateSessionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ValidateSessionRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+type ValidateSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateSessionResponse) Reset() {
+	*x = ValidateSessionResponse{}
+	mi := &file_proto_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSessionResponse) ProtoMessage() {}
+
+func (x *ValidateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSessionResponse.ProtoReflect.Descriptor instead.
+func (*ValidateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ValidateSessionResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ValidateSessionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateSessionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ValidateSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LogoutRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *LogoutRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LogoutRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_proto_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LogoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListSessionsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ClubId              uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId              uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CurrentSessionToken string                 `protobuf:"bytes,3,opt,name=current_session_token,json=currentSessionToken,proto3" json:"current_session_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSessionsRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetCurrentSessionToken() string {
+	if x != nil {
+		return x.CurrentSessionToken
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_proto_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     uint32                 `protobuf:"varint,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_proto_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevokeSessionRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *RevokeSessionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeSessionRequest) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_proto_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RevokeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RevokeOtherSessionsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ClubId              uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId              uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CurrentSessionToken string                 `protobuf:"bytes,3,opt,name=current_session_token,json=currentSessionToken,proto3" json:"current_session_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsRequest) Reset() {
+	*x = RevokeOtherSessionsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeOtherSessionsRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *RevokeOtherSessionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeOtherSessionsRequest) GetCurrentSessionToken() string {
+	if x != nil {
+		return x.CurrentSessionToken
+	}
+	return ""
+}
+
+type RevokeSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Revoked       int32                  `protobuf:"varint,2,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionsResponse) Reset() {
+	*x = RevokeSessionsResponse{}
+	mi := &file_proto_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeSessionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeSessionsResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type SetupMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Attachment    string                 `protobuf:"bytes,5,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetupMFARequest) Reset() {
+	*x = SetupMFARequest{}
+	mi := &file_proto_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupMFARequest) ProtoMessage() {}
+
+func (x *SetupMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupMFARequest.ProtoReflect.Descriptor instead.
+func (*SetupMFARequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetupMFARequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *SetupMFARequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetupMFARequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SetupMFARequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetupMFARequest) GetAttachment() string {
+	if x != nil {
+		return x.Attachment
+	}
+	return ""
+}
+
+type SetupMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	QrCodeUrl     string                 `protobuf:"bytes,2,opt,name=qr_code_url,json=qrCodeUrl,proto3" json:"qr_code_url,omitempty"`
+	BackupCodes   []string               `protobuf:"bytes,3,rep,name=backup_codes,json=backupCodes,proto3" json:"backup_codes,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	FactorId      uint32                 `protobuf:"varint,6,opt,name=factor_id,json=factorId,proto3" json:"factor_id,omitempty"`
+	Options       *structpb.Struct       `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetupMFAResponse) Reset() {
+	*x = SetupMFAResponse{}
+	mi := &file_proto_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupMFAResponse) ProtoMessage() {}
+
+func (x *SetupMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupMFAResponse.ProtoReflect.Descriptor instead.
+func (*SetupMFAResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetupMFAResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SetupMFAResponse) GetQrCodeUrl() string {
+	if x != nil {
+		return x.QrCodeUrl
+	}
+	return ""
+}
+
+func (x *SetupMFAResponse) GetBackupCodes() []string {
+	if x != nil {
+		return x.BackupCodes
+	}
+	return nil
+}
+
+func (x *SetupMFAResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetupMFAResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetupMFAResponse) GetFactorId() uint32 {
+	if x != nil {
+		return x.FactorId
+	}
+	return 0
+}
+
+func (x *SetupMFAResponse) GetOptions() *structpb.Struct {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type VerifyMFARequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClubId           uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code             string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Method           string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	FactorId         uint32                 `protobuf:"varint,5,opt,name=factor_id,json=factorId,proto3" json:"factor_id,omitempty"`
+	CredentialResult *structpb.Struct       `protobuf:"bytes,6,opt,name=credential_result,json=credentialResult,proto3" json:"credential_result,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VerifyMFARequest) Reset() {
+	*x = VerifyMFARequest{}
+	mi := &file_proto_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARequest) ProtoMessage() {}
+
+func (x *VerifyMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFARequest.ProtoReflect.Descriptor instead.
+func (*VerifyMFARequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VerifyMFARequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *VerifyMFARequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifyMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetFactorId() uint32 {
+	if x != nil {
+		return x.FactorId
+	}
+	return 0
+}
+
+func (x *VerifyMFARequest) GetCredentialResult() *structpb.Struct {
+	if x != nil {
+		return x.CredentialResult
+	}
+	return nil
+}
+
+type VerifyMFAResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Factors              []*MFAFactor           `protobuf:"bytes,3,rep,name=factors,proto3" json:"factors,omitempty"`
+	Options              *structpb.Struct       `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	BackupCodesRemaining int32                  `protobuf:"varint,5,opt,name=backup_codes_remaining,json=backupCodesRemaining,proto3" json:"backup_codes_remaining,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *VerifyMFAResponse) Reset() {
+	*x = VerifyMFAResponse{}
+	mi := &file_proto_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAResponse) ProtoMessage() {}
+
+func (x *VerifyMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMFAResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VerifyMFAResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyMFAResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyMFAResponse) GetFactors() []*MFAFactor {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+func (x *VerifyMFAResponse) GetOptions() *structpb.Struct {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *VerifyMFAResponse) GetBackupCodesRemaining() int32 {
+	if x != nil {
+		return x.BackupCodesRemaining
+	}
+	return 0
+}
+
+type DisableMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMFARequest) Reset() {
+	*x = DisableMFARequest{}
+	mi := &file_proto_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMFARequest) ProtoMessage() {}
+
+func (x *DisableMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMFARequest.ProtoReflect.Descriptor instead.
+func (*DisableMFARequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DisableMFARequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *DisableMFARequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DisableMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMFAResponse) Reset() {
+	*x = DisableMFAResponse{}
+	mi := &file_proto_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMFAResponse) ProtoMessage() {}
+
+func (x *DisableMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMFAResponse.ProtoReflect.Descriptor instead.
+func (*DisableMFAResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DisableMFAResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DisableMFAResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListMFAFactorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMFAFactorsRequest) Reset() {
+	*x = ListMFAFactorsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMFAFactorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMFAFactorsRequest) ProtoMessage() {}
+
+func (x *ListMFAFactorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMFAFactorsRequest.ProtoReflect.Descriptor instead.
+func (*ListMFAFactorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListMFAFactorsRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *ListMFAFactorsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListMFAFactorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Factors       []*MFAFactor           `protobuf:"bytes,1,rep,name=factors,proto3" json:"factors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMFAFactorsResponse) Reset() {
+	*x = ListMFAFactorsResponse{}
+	mi := &file_proto_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMFAFactorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMFAFactorsResponse) ProtoMessage() {}
+
+func (x *ListMFAFactorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMFAFactorsResponse.ProtoReflect.Descriptor instead.
+func (*ListMFAFactorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMFAFactorsResponse) GetFactors() []*MFAFactor {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+type MFAFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FactorId      uint32                 `protobuf:"varint,3,opt,name=factor_id,json=factorId,proto3" json:"factor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFAFactorRequest) Reset() {
+	*x = MFAFactorRequest{}
+	mi := &file_proto_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAFactorRequest) ProtoMessage() {}
+
+func (x *MFAFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAFactorRequest.ProtoReflect.Descriptor instead.
+func (*MFAFactorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MFAFactorRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *MFAFactorRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MFAFactorRequest) GetFactorId() uint32 {
+	if x != nil {
+		return x.FactorId
+	}
+	return 0
+}
+
+type MFAFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFAFactorResponse) Reset() {
+	*x = MFAFactorResponse{}
+	mi := &file_proto_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAFactorResponse) ProtoMessage() {}
+
+func (x *MFAFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAFactorResponse.ProtoReflect.Descriptor instead.
+func (*MFAFactorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MFAFactorResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MFAFactorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RegenerateBackupCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateBackupCodesRequest) Reset() {
+	*x = RegenerateBackupCodesRequest{}
+	mi := &file_proto_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateBackupCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateBackupCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateBackupCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateBackupCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateBackupCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RegenerateBackupCodesRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *RegenerateBackupCodesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RegenerateBackupCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BackupCodes   []string               `protobuf:"bytes,1,rep,name=backup_codes,json=backupCodes,proto3" json:"backup_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateBackupCodesResponse) Reset() {
+	*x = RegenerateBackupCodesResponse{}
+	mi := &file_proto_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateBackupCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateBackupCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateBackupCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateBackupCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateBackupCodesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RegenerateBackupCodesResponse) GetBackupCodes() []string {
+	if x != nil {
+		return x.BackupCodes
+	}
+	return nil
+}
+
+type SearchSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,4,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSessionsRequest) Reset() {
+	*x = SearchSessionsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSessionsRequest) ProtoMessage() {}
+
+func (x *SearchSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSessionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchSessionsRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *SearchSessionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchSessionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSessionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *SearchSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchSessionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSessionsResponse) Reset() {
+	*x = SearchSessionsResponse{}
+	mi := &file_proto_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSessionsResponse) ProtoMessage() {}
+
+func (x *SearchSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSessionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *SearchSessionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AdminRevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	SessionId     uint32                 `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRevokeSessionRequest) Reset() {
+	*x = AdminRevokeSessionRequest{}
+	mi := &file_proto_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeSessionRequest) ProtoMessage() {}
+
+func (x *AdminRevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*AdminRevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AdminRevokeSessionRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *AdminRevokeSessionRequest) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type AdminRevokeUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRevokeUserSessionsRequest) Reset() {
+	*x = AdminRevokeUserSessionsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeUserSessionsRequest) ProtoMessage() {}
+
+func (x *AdminRevokeUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*AdminRevokeUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AdminRevokeUserSessionsRequest) GetClubId() uint32 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *AdminRevokeUserSessionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	ClubSlug      string                 `protobuf:"bytes,2,opt,name=club_slug,json=clubSlug,proto3" json:"club_slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_proto_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetClubSlug() string {
+	if x != nil {
+		return x.ClubSlug
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_proto_auth_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[48]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InitiatePasskeyRegistrationResponse) GetSuccess() bool {
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RequestPasswordResetResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *InitiatePasskeyRegistrationResponse) GetMessage() string {
+func (x *RequestPasswordResetResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type CompletePasskeyRegistrationRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ClubId           uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
-	UserId           uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CredentialResult *structpb.Struct       `protobuf:"bytes,3,opt,name=credential_result,json=credentialResult,proto3" json:"credential_result,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ConfirmPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	ClubSlug      string                 `protobuf:"bytes,3,opt,name=club_slug,json=clubSlug,proto3" json:"club_slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompletePasskeyRegistrationRequest) Reset() {
-	*x = CompletePasskeyRegistrationRequest{}
-	mi := &file_proto_auth_proto_msgTypes[18]
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_proto_auth_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompletePasskeyRegistrationRequest) String() string {
+func (x *ConfirmPasswordResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompletePasskeyRegistrationRequest) ProtoMessage() {}
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
 
-func (x *CompletePasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[18]
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,33 +3331,33 @@ func (x *CompletePasskeyRegistrationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompletePasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
-func (*CompletePasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CompletePasskeyRegistrationRequest) GetClubId() uint32 {
+func (x *ConfirmPasswordResetRequest) GetToken() string {
 	if x != nil {
-		return x.ClubId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *CompletePasskeyRegistrationRequest) GetUserId() uint32 {
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
 	if x != nil {
-		return x.UserId
+		return x.NewPassword
 	}
-	return 0
+	return ""
 }
 
-func (x *CompletePasskeyRegistrationRequest) GetCredentialResult() *structpb.Struct {
+func (x *ConfirmPasswordResetRequest) GetClubSlug() string {
 	if x != nil {
-		return x.CredentialResult
+		return x.ClubSlug
 	}
-	return nil
+	return ""
 }
 
-type CompletePasskeyRegistrationResponse struct {
+type ConfirmPasswordResetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -1457,21 +3365,21 @@ type CompletePasskeyRegistrationResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompletePasskeyRegistrationResponse) Reset() {
-	*x = CompletePasskeyRegistrationResponse{}
-	mi := &file_proto_auth_proto_msgTypes[19]
+func (x *ConfirmPasswordResetResponse) Reset() {
+	*x = ConfirmPasswordResetResponse{}
+	mi := &file_proto_auth_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompletePasskeyRegistrationResponse) String() string {
+func (x *ConfirmPasswordResetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompletePasskeyRegistrationResponse) ProtoMessage() {}
+func (*ConfirmPasswordResetResponse) ProtoMessage() {}
 
-func (x *CompletePasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[19]
+func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,48 +3390,48 @@ func (x *CompletePasskeyRegistrationResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompletePasskeyRegistrationResponse.ProtoReflect.Descriptor instead.
-func (*CompletePasskeyRegistrationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CompletePasskeyRegistrationResponse) GetSuccess() bool {
+func (x *ConfirmPasswordResetResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CompletePasskeyRegistrationResponse) GetMessage() string {
+func (x *ConfirmPasswordResetResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ValidateSessionRequest struct {
+type RequestEmailVerificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	ClubId        uint32                 `protobuf:"varint,2,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	ClubSlug      string                 `protobuf:"bytes,2,opt,name=club_slug,json=clubSlug,proto3" json:"club_slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateSessionRequest) Reset() {
-	*x = ValidateSessionRequest{}
-	mi := &file_proto_auth_proto_msgTypes[20]
+func (x *RequestEmailVerificationRequest) Reset() {
+	*x = RequestEmailVerificationRequest{}
+	mi := &file_proto_auth_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateSessionRequest) String() string {
+func (x *RequestEmailVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateSessionRequest) ProtoMessage() {}
+func (*RequestEmailVerificationRequest) ProtoMessage() {}
 
-func (x *ValidateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[20]
+func (x *RequestEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,50 +3442,48 @@ func (x *ValidateSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateSessionRequest.ProtoReflect.Descriptor instead.
-func (*ValidateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RequestEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ValidateSessionRequest) GetSessionToken() string {
+func (x *RequestEmailVerificationRequest) GetEmail() string {
 	if x != nil {
-		return x.SessionToken
+		return x.Email
 	}
 	return ""
 }
 
-func (x *ValidateSessionRequest) GetClubId() uint32 {
+func (x *RequestEmailVerificationRequest) GetClubSlug() string {
 	if x != nil {
-		return x.ClubId
+		return x.ClubSlug
 	}
-	return 0
+	return ""
 }
 
-type ValidateSessionResponse struct {
+type RequestEmailVerificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateSessionResponse) Reset() {
-	*x = ValidateSessionResponse{}
-	mi := &file_proto_auth_proto_msgTypes[21]
+func (x *RequestEmailVerificationResponse) Reset() {
+	*x = RequestEmailVerificationResponse{}
+	mi := &file_proto_auth_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateSessionResponse) String() string {
+func (x *RequestEmailVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateSessionResponse) ProtoMessage() {}
+func (*RequestEmailVerificationResponse) ProtoMessage() {}
 
-func (x *ValidateSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[21]
+func (x *RequestEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1588,63 +3494,48 @@ func (x *ValidateSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateSessionResponse.ProtoReflect.Descriptor instead.
-func (*ValidateSessionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *ValidateSessionResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use RequestEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ValidateSessionResponse) GetValid() bool {
+func (x *RequestEmailVerificationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Valid
+		return x.Success
 	}
 	return false
 }
 
-func (x *ValidateSessionResponse) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
-
-func (x *ValidateSessionResponse) GetMessage() string {
+func (x *RequestEmailVerificationResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type LogoutRequest struct {
+type ConfirmEmailVerificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClubId        uint32                 `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
-	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ClubSlug      string                 `protobuf:"bytes,2,opt,name=club_slug,json=clubSlug,proto3" json:"club_slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
-	mi := &file_proto_auth_proto_msgTypes[22]
+func (x *ConfirmEmailVerificationRequest) Reset() {
+	*x = ConfirmEmailVerificationRequest{}
+	mi := &file_proto_auth_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *ConfirmEmailVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*ConfirmEmailVerificationRequest) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[22]
+func (x *ConfirmEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,33 +3546,26 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *LogoutRequest) GetClubId() uint32 {
-	if x != nil {
-		return x.ClubId
-	}
-	return 0
+// Deprecated: Use ConfirmEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *LogoutRequest) GetUserId() uint32 {
+func (x *ConfirmEmailVerificationRequest) GetToken() string {
 	if x != nil {
-		return x.UserId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *LogoutRequest) GetSessionToken() string {
+func (x *ConfirmEmailVerificationRequest) GetClubSlug() string {
 	if x != nil {
-		return x.SessionToken
+		return x.ClubSlug
 	}
 	return ""
 }
 
-type LogoutResponse struct {
+type ConfirmEmailVerificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -1689,21 +3573,21 @@ type LogoutResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_proto_auth_proto_msgTypes[23]
+func (x *ConfirmEmailVerificationResponse) Reset() {
+	*x = ConfirmEmailVerificationResponse{}
+	mi := &file_proto_auth_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *ConfirmEmailVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*ConfirmEmailVerificationResponse) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[23]
+func (x *ConfirmEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,19 +3598,19 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ConfirmEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *LogoutResponse) GetSuccess() bool {
+func (x *ConfirmEmailVerificationResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *LogoutResponse) GetMessage() string {
+func (x *ConfirmEmailVerificationResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -1746,7 +3630,7 @@ type AssignRoleRequest struct {
 
 func (x *AssignRoleRequest) Reset() {
 	*x = AssignRoleRequest{}
-	mi := &file_proto_auth_proto_msgTypes[24]
+	mi := &file_proto_auth_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1758,7 +3642,7 @@ func (x *AssignRoleRequest) String() string {
 func (*AssignRoleRequest) ProtoMessage() {}
 
 func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[24]
+	mi := &file_proto_auth_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,7 +3655,7 @@ func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
 func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+	return file_proto_auth_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *AssignRoleRequest) GetClubId() uint32 {
@@ -1819,7 +3703,7 @@ type AssignRoleResponse struct {
 
 func (x *AssignRoleResponse) Reset() {
 	*x = AssignRoleResponse{}
-	mi := &file_proto_auth_proto_msgTypes[25]
+	mi := &file_proto_auth_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1831,7 +3715,7 @@ func (x *AssignRoleResponse) String() string {
 func (*AssignRoleResponse) ProtoMessage() {}
 
 func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[25]
+	mi := &file_proto_auth_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,7 +3728,7 @@ func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
 func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{25}
+	return file_proto_auth_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AssignRoleResponse) GetSuccess() bool {
@@ -1872,7 +3756,7 @@ type RemoveRoleRequest struct {
 
 func (x *RemoveRoleRequest) Reset() {
 	*x = RemoveRoleRequest{}
-	mi := &file_proto_auth_proto_msgTypes[26]
+	mi := &file_proto_auth_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1884,7 +3768,7 @@ func (x *RemoveRoleRequest) String() string {
 func (*RemoveRoleRequest) ProtoMessage() {}
 
 func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[26]
+	mi := &file_proto_auth_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1897,7 +3781,7 @@ func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveRoleRequest.ProtoReflect.Descriptor instead.
 func (*RemoveRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{26}
+	return file_proto_auth_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *RemoveRoleRequest) GetClubId() uint32 {
@@ -1931,7 +3815,7 @@ type RemoveRoleResponse struct {
 
 func (x *RemoveRoleResponse) Reset() {
 	*x = RemoveRoleResponse{}
-	mi := &file_proto_auth_proto_msgTypes[27]
+	mi := &file_proto_auth_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1943,7 +3827,7 @@ func (x *RemoveRoleResponse) String() string {
 func (*RemoveRoleResponse) ProtoMessage() {}
 
 func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[27]
+	mi := &file_proto_auth_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +3840,7 @@ func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveRoleResponse.ProtoReflect.Descriptor instead.
 func (*RemoveRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{27}
+	return file_proto_auth_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *RemoveRoleResponse) GetSuccess() bool {
@@ -1983,7 +3867,7 @@ type GetUserRolesRequest struct {
 
 func (x *GetUserRolesRequest) Reset() {
 	*x = GetUserRolesRequest{}
-	mi := &file_proto_auth_proto_msgTypes[28]
+	mi := &file_proto_auth_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1995,7 +3879,7 @@ func (x *GetUserRolesRequest) String() string {
 func (*GetUserRolesRequest) ProtoMessage() {}
 
 func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[28]
+	mi := &file_proto_auth_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +3892,7 @@ func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{28}
+	return file_proto_auth_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetUserRolesRequest) GetClubId() uint32 {
@@ -2034,7 +3918,7 @@ type GetUserRolesResponse struct {
 
 func (x *GetUserRolesResponse) Reset() {
 	*x = GetUserRolesResponse{}
-	mi := &file_proto_auth_proto_msgTypes[29]
+	mi := &file_proto_auth_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2046,7 +3930,7 @@ func (x *GetUserRolesResponse) String() string {
 func (*GetUserRolesResponse) ProtoMessage() {}
 
 func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[29]
+	mi := &file_proto_auth_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2059,7 +3943,7 @@ func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{29}
+	return file_proto_auth_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetUserRolesResponse) GetRoles() []*Role {
@@ -2079,7 +3963,7 @@ type GetUserPermissionsRequest struct {
 
 func (x *GetUserPermissionsRequest) Reset() {
 	*x = GetUserPermissionsRequest{}
-	mi := &file_proto_auth_proto_msgTypes[30]
+	mi := &file_proto_auth_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2091,7 +3975,7 @@ func (x *GetUserPermissionsRequest) String() string {
 func (*GetUserPermissionsRequest) ProtoMessage() {}
 
 func (x *GetUserPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[30]
+	mi := &file_proto_auth_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2104,7 +3988,7 @@ func (x *GetUserPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{30}
+	return file_proto_auth_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetUserPermissionsRequest) GetClubId() uint32 {
@@ -2130,7 +4014,7 @@ type GetUserPermissionsResponse struct {
 
 func (x *GetUserPermissionsResponse) Reset() {
 	*x = GetUserPermissionsResponse{}
-	mi := &file_proto_auth_proto_msgTypes[31]
+	mi := &file_proto_auth_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2142,7 +4026,7 @@ func (x *GetUserPermissionsResponse) String() string {
 func (*GetUserPermissionsResponse) ProtoMessage() {}
 
 func (x *GetUserPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[31]
+	mi := &file_proto_auth_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,7 +4039,7 @@ func (x *GetUserPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{31}
+	return file_proto_auth_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetUserPermissionsResponse) GetPermissions() []*Permission {
@@ -2177,7 +4061,7 @@ type CreateRoleRequest struct {
 
 func (x *CreateRoleRequest) Reset() {
 	*x = CreateRoleRequest{}
-	mi := &file_proto_auth_proto_msgTypes[32]
+	mi := &file_proto_auth_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2189,7 +4073,7 @@ func (x *CreateRoleRequest) String() string {
 func (*CreateRoleRequest) ProtoMessage() {}
 
 func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[32]
+	mi := &file_proto_auth_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2202,7 +4086,7 @@ func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
 func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{32}
+	return file_proto_auth_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CreateRoleRequest) GetClubId() uint32 {
@@ -2244,7 +4128,7 @@ type CreateRoleResponse struct {
 
 func (x *CreateRoleResponse) Reset() {
 	*x = CreateRoleResponse{}
-	mi := &file_proto_auth_proto_msgTypes[33]
+	mi := &file_proto_auth_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2256,7 +4140,7 @@ func (x *CreateRoleResponse) String() string {
 func (*CreateRoleResponse) ProtoMessage() {}
 
 func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[33]
+	mi := &file_proto_auth_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2269,7 +4153,7 @@ func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
 func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{33}
+	return file_proto_auth_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *CreateRoleResponse) GetRole() *Role {
@@ -2306,7 +4190,7 @@ type UpdateRoleRequest struct {
 
 func (x *UpdateRoleRequest) Reset() {
 	*x = UpdateRoleRequest{}
-	mi := &file_proto_auth_proto_msgTypes[34]
+	mi := &file_proto_auth_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2318,7 +4202,7 @@ func (x *UpdateRoleRequest) String() string {
 func (*UpdateRoleRequest) ProtoMessage() {}
 
 func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[34]
+	mi := &file_proto_auth_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,7 +4215,7 @@ func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{34}
+	return file_proto_auth_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *UpdateRoleRequest) GetClubId() uint32 {
@@ -2380,7 +4264,7 @@ type UpdateRoleResponse struct {
 
 func (x *UpdateRoleResponse) Reset() {
 	*x = UpdateRoleResponse{}
-	mi := &file_proto_auth_proto_msgTypes[35]
+	mi := &file_proto_auth_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2392,7 +4276,7 @@ func (x *UpdateRoleResponse) String() string {
 func (*UpdateRoleResponse) ProtoMessage() {}
 
 func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[35]
+	mi := &file_proto_auth_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2405,7 +4289,7 @@ func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{35}
+	return file_proto_auth_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *UpdateRoleResponse) GetRole() *Role {
@@ -2439,7 +4323,7 @@ type DeleteRoleRequest struct {
 
 func (x *DeleteRoleRequest) Reset() {
 	*x = DeleteRoleRequest{}
-	mi := &file_proto_auth_proto_msgTypes[36]
+	mi := &file_proto_auth_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2451,7 +4335,7 @@ func (x *DeleteRoleRequest) String() string {
 func (*DeleteRoleRequest) ProtoMessage() {}
 
 func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[36]
+	mi := &file_proto_auth_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,7 +4348,7 @@ func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{36}
+	return file_proto_auth_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *DeleteRoleRequest) GetClubId() uint32 {
@@ -2491,7 +4375,7 @@ type DeleteRoleResponse struct {
 
 func (x *DeleteRoleResponse) Reset() {
 	*x = DeleteRoleResponse{}
-	mi := &file_proto_auth_proto_msgTypes[37]
+	mi := &file_proto_auth_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2503,7 +4387,7 @@ func (x *DeleteRoleResponse) String() string {
 func (*DeleteRoleResponse) ProtoMessage() {}
 
 func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[37]
+	mi := &file_proto_auth_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,7 +4400,7 @@ func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{37}
+	return file_proto_auth_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *DeleteRoleResponse) GetSuccess() bool {
@@ -2544,7 +4428,7 @@ type GetRolesRequest struct {
 
 func (x *GetRolesRequest) Reset() {
 	*x = GetRolesRequest{}
-	mi := &file_proto_auth_proto_msgTypes[38]
+	mi := &file_proto_auth_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2556,7 +4440,7 @@ func (x *GetRolesRequest) String() string {
 func (*GetRolesRequest) ProtoMessage() {}
 
 func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[38]
+	mi := &file_proto_auth_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2569,7 +4453,7 @@ func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetRolesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{38}
+	return file_proto_auth_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *GetRolesRequest) GetClubId() uint32 {
@@ -2603,7 +4487,7 @@ type GetRolesResponse struct {
 
 func (x *GetRolesResponse) Reset() {
 	*x = GetRolesResponse{}
-	mi := &file_proto_auth_proto_msgTypes[39]
+	mi := &file_proto_auth_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2615,7 +4499,7 @@ func (x *GetRolesResponse) String() string {
 func (*GetRolesResponse) ProtoMessage() {}
 
 func (x *GetRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[39]
+	mi := &file_proto_auth_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,7 +4512,7 @@ func (x *GetRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolesResponse.ProtoReflect.Descriptor instead.
 func (*GetRolesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{39}
+	return file_proto_auth_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetRolesResponse) GetRoles() []*Role {
@@ -2662,7 +4546,7 @@ type CreateClubRequest struct {
 
 func (x *CreateClubRequest) Reset() {
 	*x = CreateClubRequest{}
-	mi := &file_proto_auth_proto_msgTypes[40]
+	mi := &file_proto_auth_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2674,7 +4558,7 @@ func (x *CreateClubRequest) String() string {
 func (*CreateClubRequest) ProtoMessage() {}
 
 func (x *CreateClubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[40]
+	mi := &file_proto_auth_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2687,7 +4571,7 @@ func (x *CreateClubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateClubRequest.ProtoReflect.Descriptor instead.
 func (*CreateClubRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{40}
+	return file_proto_auth_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CreateClubRequest) GetName() string {
@@ -2764,7 +4648,7 @@ type CreateClubResponse struct {
 
 func (x *CreateClubResponse) Reset() {
 	*x = CreateClubResponse{}
-	mi := &file_proto_auth_proto_msgTypes[41]
+	mi := &file_proto_auth_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2776,7 +4660,7 @@ func (x *CreateClubResponse) String() string {
 func (*CreateClubResponse) ProtoMessage() {}
 
 func (x *CreateClubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[41]
+	mi := &file_proto_auth_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,7 +4673,7 @@ func (x *CreateClubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateClubResponse.ProtoReflect.Descriptor instead.
 func (*CreateClubResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{41}
+	return file_proto_auth_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CreateClubResponse) GetClub() *Club {
@@ -2826,7 +4710,7 @@ type GetClubRequest struct {
 
 func (x *GetClubRequest) Reset() {
 	*x = GetClubRequest{}
-	mi := &file_proto_auth_proto_msgTypes[42]
+	mi := &file_proto_auth_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2838,7 +4722,7 @@ func (x *GetClubRequest) String() string {
 func (*GetClubRequest) ProtoMessage() {}
 
 func (x *GetClubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[42]
+	mi := &file_proto_auth_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,7 +4735,7 @@ func (x *GetClubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClubRequest.ProtoReflect.Descriptor instead.
 func (*GetClubRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{42}
+	return file_proto_auth_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetClubRequest) GetIdentifier() isGetClubRequest_Identifier {
@@ -2904,7 +4788,7 @@ type GetClubResponse struct {
 
 func (x *GetClubResponse) Reset() {
 	*x = GetClubResponse{}
-	mi := &file_proto_auth_proto_msgTypes[43]
+	mi := &file_proto_auth_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2916,7 +4800,7 @@ func (x *GetClubResponse) String() string {
 func (*GetClubResponse) ProtoMessage() {}
 
 func (x *GetClubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[43]
+	mi := &file_proto_auth_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2929,7 +4813,7 @@ func (x *GetClubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClubResponse.ProtoReflect.Descriptor instead.
 func (*GetClubResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{43}
+	return file_proto_auth_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetClubResponse) GetClub() *Club {
@@ -2957,7 +4841,7 @@ type UpdateClubRequest struct {
 
 func (x *UpdateClubRequest) Reset() {
 	*x = UpdateClubRequest{}
-	mi := &file_proto_auth_proto_msgTypes[44]
+	mi := &file_proto_auth_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2969,7 +4853,7 @@ func (x *UpdateClubRequest) String() string {
 func (*UpdateClubRequest) ProtoMessage() {}
 
 func (x *UpdateClubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[44]
+	mi := &file_proto_auth_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,7 +4866,7 @@ func (x *UpdateClubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateClubRequest.ProtoReflect.Descriptor instead.
 func (*UpdateClubRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{44}
+	return file_proto_auth_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *UpdateClubRequest) GetClubId() uint32 {
@@ -3066,7 +4950,7 @@ type UpdateClubResponse struct {
 
 func (x *UpdateClubResponse) Reset() {
 	*x = UpdateClubResponse{}
-	mi := &file_proto_auth_proto_msgTypes[45]
+	mi := &file_proto_auth_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3078,7 +4962,7 @@ func (x *UpdateClubResponse) String() string {
 func (*UpdateClubResponse) ProtoMessage() {}
 
 func (x *UpdateClubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[45]
+	mi := &file_proto_auth_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3091,7 +4975,7 @@ func (x *UpdateClubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateClubResponse.ProtoReflect.Descriptor instead.
 func (*UpdateClubResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{45}
+	return file_proto_auth_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *UpdateClubResponse) GetClub() *Club {
@@ -3126,7 +5010,7 @@ type GetClubsRequest struct {
 
 func (x *GetClubsRequest) Reset() {
 	*x = GetClubsRequest{}
-	mi := &file_proto_auth_proto_msgTypes[46]
+	mi := &file_proto_auth_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3138,7 +5022,7 @@ func (x *GetClubsRequest) String() string {
 func (*GetClubsRequest) ProtoMessage() {}
 
 func (x *GetClubsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[46]
+	mi := &file_proto_auth_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3151,7 +5035,7 @@ func (x *GetClubsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClubsRequest.ProtoReflect.Descriptor instead.
 func (*GetClubsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{46}
+	return file_proto_auth_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetClubsRequest) GetLimit() int32 {
@@ -3185,7 +5069,7 @@ type GetClubsResponse struct {
 
 func (x *GetClubsResponse) Reset() {
 	*x = GetClubsResponse{}
-	mi := &file_proto_auth_proto_msgTypes[47]
+	mi := &file_proto_auth_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3197,7 +5081,7 @@ func (x *GetClubsResponse) String() string {
 func (*GetClubsResponse) ProtoMessage() {}
 
 func (x *GetClubsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[47]
+	mi := &file_proto_auth_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3210,7 +5094,7 @@ func (x *GetClubsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClubsResponse.ProtoReflect.Descriptor instead.
 func (*GetClubsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{47}
+	return file_proto_auth_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetClubsResponse) GetClubs() []*Club {
@@ -3242,7 +5126,7 @@ type GetAuditLogsRequest struct {
 
 func (x *GetAuditLogsRequest) Reset() {
 	*x = GetAuditLogsRequest{}
-	mi := &file_proto_auth_proto_msgTypes[48]
+	mi := &file_proto_auth_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3254,7 +5138,7 @@ func (x *GetAuditLogsRequest) String() string {
 func (*GetAuditLogsRequest) ProtoMessage() {}
 
 func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[48]
+	mi := &file_proto_auth_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3267,7 +5151,7 @@ func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetAuditLogsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{48}
+	return file_proto_auth_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetAuditLogsRequest) GetClubId() uint32 {
@@ -3329,7 +5213,7 @@ type GetAuditLogsResponse struct {
 
 func (x *GetAuditLogsResponse) Reset() {
 	*x = GetAuditLogsResponse{}
-	mi := &file_proto_auth_proto_msgTypes[49]
+	mi := &file_proto_auth_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3341,7 +5225,7 @@ func (x *GetAuditLogsResponse) String() string {
 func (*GetAuditLogsResponse) ProtoMessage() {}
 
 func (x *GetAuditLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[49]
+	mi := &file_proto_auth_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3354,7 +5238,7 @@ func (x *GetAuditLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetAuditLogsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{49}
+	return file_proto_auth_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetAuditLogsResponse) GetAuditLogs() []*AuditLog {
@@ -3379,7 +5263,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_proto_auth_proto_msgTypes[50]
+	mi := &file_proto_auth_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3391,7 +5275,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[50]
+	mi := &file_proto_auth_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3404,7 +5288,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{50}
+	return file_proto_auth_proto_rawDescGZIP(), []int{81}
 }
 
 type HealthCheckResponse struct {
@@ -3418,7 +5302,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_proto_auth_proto_msgTypes[51]
+	mi := &file_proto_auth_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3430,7 +5314,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[51]
+	mi := &file_proto_auth_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3443,7 +5327,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{51}
+	return file_proto_auth_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *HealthCheckResponse) GetHealthy() bool {
@@ -3489,7 +5373,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_proto_auth_proto_msgTypes[52]
+	mi := &file_proto_auth_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3501,7 +5385,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[52]
+	mi := &file_proto_auth_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3514,7 +5398,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{52}
+	return file_proto_auth_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *User) GetId() uint32 {
@@ -3615,6 +5499,246 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type MFAFactor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Primary       bool                   `protobuf:"varint,4,opt,name=primary,proto3" json:"primary,omitempty"`
+	Verified      bool                   `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
+	Attachment    string                 `protobuf:"bytes,6,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFAFactor) Reset() {
+	*x = MFAFactor{}
+	mi := &file_proto_auth_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAFactor) ProtoMessage() {}
+
+func (x *MFAFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAFactor.ProtoReflect.Descriptor instead.
+func (*MFAFactor) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MFAFactor) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MFAFactor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MFAFactor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MFAFactor) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *MFAFactor) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *MFAFactor) GetAttachment() string {
+	if x != nil {
+		return x.Attachment
+	}
+	return ""
+}
+
+func (x *MFAFactor) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *MFAFactor) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Session struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Device         string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	IpAddress      string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Location       string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	UserAgent      string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	AuthProvider   string                 `protobuf:"bytes,8,opt,name=auth_provider,json=authProvider,proto3" json:"auth_provider,omitempty"`
+	IsActive       bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Current        bool                   `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastActivityAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_proto_auth_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Session) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Session) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Session) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetAuthProvider() string {
+	if x != nil {
+		return x.AuthProvider
+	}
+	return ""
+}
+
+func (x *Session) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type Role struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3630,7 +5754,7 @@ type Role struct {
 
 func (x *Role) Reset() {
 	*x = Role{}
-	mi := &file_proto_auth_proto_msgTypes[53]
+	mi := &file_proto_auth_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3642,7 +5766,7 @@ func (x *Role) String() string {
 func (*Role) ProtoMessage() {}
 
 func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[53]
+	mi := &file_proto_auth_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3655,7 +5779,7 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Role.ProtoReflect.Descriptor instead.
 func (*Role) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{53}
+	return file_proto_auth_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *Role) GetId() uint32 {
@@ -3723,7 +5847,7 @@ type Permission struct {
 
 func (x *Permission) Reset() {
 	*x = Permission{}
-	mi := &file_proto_auth_proto_msgTypes[54]
+	mi := &file_proto_auth_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3735,7 +5859,7 @@ func (x *Permission) String() string {
 func (*Permission) ProtoMessage() {}
 
 func (x *Permission) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[54]
+	mi := &file_proto_auth_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3748,7 +5872,7 @@ func (x *Permission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Permission.ProtoReflect.Descriptor instead.
 func (*Permission) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{54}
+	return file_proto_auth_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *Permission) GetId() uint32 {
@@ -3831,7 +5955,7 @@ type Club struct {
 
 func (x *Club) Reset() {
 	*x = Club{}
-	mi := &file_proto_auth_proto_msgTypes[55]
+	mi := &file_proto_auth_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3843,7 +5967,7 @@ func (x *Club) String() string {
 func (*Club) ProtoMessage() {}
 
 func (x *Club) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[55]
+	mi := &file_proto_auth_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3856,7 +5980,7 @@ func (x *Club) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Club.ProtoReflect.Descriptor instead.
 func (*Club) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{55}
+	return file_proto_auth_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *Club) GetId() uint32 {
@@ -3989,7 +6113,7 @@ type ClubSettings struct {
 
 func (x *ClubSettings) Reset() {
 	*x = ClubSettings{}
-	mi := &file_proto_auth_proto_msgTypes[56]
+	mi := &file_proto_auth_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4001,7 +6125,7 @@ func (x *ClubSettings) String() string {
 func (*ClubSettings) ProtoMessage() {}
 
 func (x *ClubSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[56]
+	mi := &file_proto_auth_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4014,7 +6138,7 @@ func (x *ClubSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClubSettings.ProtoReflect.Descriptor instead.
 func (*ClubSettings) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{56}
+	return file_proto_auth_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ClubSettings) GetAllowReciprocal() bool {
@@ -4109,7 +6233,7 @@ type UserSession struct {
 
 func (x *UserSession) Reset() {
 	*x = UserSession{}
-	mi := &file_proto_auth_proto_msgTypes[57]
+	mi := &file_proto_auth_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4121,7 +6245,7 @@ func (x *UserSession) String() string {
 func (*UserSession) ProtoMessage() {}
 
 func (x *UserSession) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[57]
+	mi := &file_proto_auth_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4134,7 +6258,7 @@ func (x *UserSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
 func (*UserSession) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{57}
+	return file_proto_auth_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *UserSession) GetId() uint32 {
@@ -4256,7 +6380,7 @@ type AuditLog struct {
 
 func (x *AuditLog) Reset() {
 	*x = AuditLog{}
-	mi := &file_proto_auth_proto_msgTypes[58]
+	mi := &file_proto_auth_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4268,7 +6392,7 @@ func (x *AuditLog) String() string {
 func (*AuditLog) ProtoMessage() {}
 
 func (x *AuditLog) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[58]
+	mi := &file_proto_auth_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4281,7 +6405,7 @@ func (x *AuditLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
 func (*AuditLog) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{58}
+	return file_proto_auth_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *AuditLog) GetId() uint32 {
@@ -4451,7 +6575,7 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\aoptions\x18\x01 \x01(\v2\x17.google.protobuf.StructR\aoptions\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
 	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\xd7\x01\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x86\x02\n" +
 	"\x1bCompletePasskeyLoginRequest\x12\x1b\n" +
 	"\tclub_slug\x18\x01 \x01(\tR\bclubSlug\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12D\n" +
@@ -4459,7 +6583,8 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\n" +
 	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x1d\n" +
 	"\n" +
-	"user_agent\x18\x05 \x01(\tR\tuserAgent\"\xe8\x01\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12-\n" +
+	"\x12device_fingerprint\x18\x06 \x01(\tR\x11deviceFingerprint\"\xc3\x03\n" +
 	"\x1cCompletePasskeyLoginResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".auth.UserR\x04user\x12\x14\n" +
@@ -4468,7 +6593,27 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
 	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x06 \x01(\tR\amessage\"V\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12!\n" +
+	"\fmfa_required\x18\a \x01(\bR\vmfaRequired\x12#\n" +
+	"\rmfa_challenge\x18\b \x01(\tR\fmfaChallenge\x12\x1f\n" +
+	"\vmfa_methods\x18\t \x03(\tR\n" +
+	"mfaMethods\x128\n" +
+	"\vmfa_options\x18\n" +
+	" \x01(\v2\x17.google.protobuf.StructR\n" +
+	"mfaOptions\x126\n" +
+	"\x17mfa_enrollment_required\x18\v \x01(\bR\x15mfaEnrollmentRequired\"\xd2\x02\n" +
+	"\x1dCompleteLoginChallengeRequest\x12\x1b\n" +
+	"\tclub_slug\x18\x01 \x01(\tR\bclubSlug\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tchallenge\x18\x03 \x01(\tR\tchallenge\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x06 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12-\n" +
+	"\x12device_fingerprint\x18\b \x01(\tR\x11deviceFingerprint\x12D\n" +
+	"\x11credential_result\x18\t \x01(\v2\x17.google.protobuf.StructR\x10credentialResult\"V\n" +
 	"\"InitiatePasskeyRegistrationRequest\x12\x17\n" +
 	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xa5\x01\n" +
@@ -4500,6 +6645,122 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\"D\n" +
 	"\x0eLogoutResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"{\n" +
+	"\x13ListSessionsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x122\n" +
+	"\x15current_session_token\x18\x03 \x01(\tR\x13currentSessionToken\"A\n" +
+	"\x14ListSessionsResponse\x12)\n" +
+	"\bsessions\x18\x01 \x03(\v2\r.auth.SessionR\bsessions\"g\n" +
+	"\x14RevokeSessionRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\rR\tsessionId\"K\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x82\x01\n" +
+	"\x1aRevokeOtherSessionsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x122\n" +
+	"\x15current_session_token\x18\x03 \x01(\tR\x13currentSessionToken\"L\n" +
+	"\x16RevokeSessionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\arevoked\x18\x02 \x01(\x05R\arevoked\"\x8f\x01\n" +
+	"\x0fSetupMFARequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x05 \x01(\tR\n" +
+	"attachment\"\xf1\x01\n" +
+	"\x10SetupMFAResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1e\n" +
+	"\vqr_code_url\x18\x02 \x01(\tR\tqrCodeUrl\x12!\n" +
+	"\fbackup_codes\x18\x03 \x03(\tR\vbackupCodes\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1b\n" +
+	"\tfactor_id\x18\x06 \x01(\rR\bfactorId\x121\n" +
+	"\aoptions\x18\a \x01(\v2\x17.google.protobuf.StructR\aoptions\"\xd3\x01\n" +
+	"\x10VerifyMFARequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x1b\n" +
+	"\tfactor_id\x18\x05 \x01(\rR\bfactorId\x12D\n" +
+	"\x11credential_result\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x10credentialResult\"\xdb\x01\n" +
+	"\x11VerifyMFAResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\afactors\x18\x03 \x03(\v2\x0f.auth.MFAFactorR\afactors\x121\n" +
+	"\aoptions\x18\x04 \x01(\v2\x17.google.protobuf.StructR\aoptions\x124\n" +
+	"\x16backup_codes_remaining\x18\x05 \x01(\x05R\x14backupCodesRemaining\"E\n" +
+	"\x11DisableMFARequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"H\n" +
+	"\x12DisableMFAResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"I\n" +
+	"\x15ListMFAFactorsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"C\n" +
+	"\x16ListMFAFactorsResponse\x12)\n" +
+	"\afactors\x18\x01 \x03(\v2\x0f.auth.MFAFactorR\afactors\"a\n" +
+	"\x10MFAFactorRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1b\n" +
+	"\tfactor_id\x18\x03 \x01(\rR\bfactorId\"G\n" +
+	"\x11MFAFactorResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"P\n" +
+	"\x1cRegenerateBackupCodesRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"B\n" +
+	"\x1dRegenerateBackupCodesResponse\x12!\n" +
+	"\fbackup_codes\x18\x01 \x03(\tR\vbackupCodes\"\xae\x01\n" +
+	"\x15SearchSessionsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x1f\n" +
+	"\vactive_only\x18\x04 \x01(\bR\n" +
+	"activeOnly\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"Y\n" +
+	"\x16SearchSessionsResponse\x12)\n" +
+	"\bsessions\x18\x01 \x03(\v2\r.auth.SessionR\bsessions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"S\n" +
+	"\x19AdminRevokeSessionRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\rR\tsessionId\"R\n" +
+	"\x1eAdminRevokeUserSessionsRequest\x12\x17\n" +
+	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"P\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\tclub_slug\x18\x02 \x01(\tR\bclubSlug\"R\n" +
+	"\x1cRequestPasswordResetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"s\n" +
+	"\x1bConfirmPasswordResetRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12\x1b\n" +
+	"\tclub_slug\x18\x03 \x01(\tR\bclubSlug\"R\n" +
+	"\x1cConfirmPasswordResetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"T\n" +
+	"\x1fRequestEmailVerificationRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\tclub_slug\x18\x02 \x01(\tR\bclubSlug\"V\n" +
+	" RequestEmailVerificationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"T\n" +
+	"\x1fConfirmEmailVerificationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tclub_slug\x18\x02 \x01(\tR\bclubSlug\"V\n" +
+	" ConfirmEmailVerificationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\xb8\x01\n" +
 	"\x11AssignRoleRequest\x12\x17\n" +
 	"\aclub_id\x18\x01 \x01(\rR\x06clubId\x12\x17\n" +
@@ -4649,7 +6910,40 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf8\x01\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x92\x02\n" +
+	"\tMFAFactor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aprimary\x18\x04 \x01(\bR\aprimary\x12\x1a\n" +
+	"\bverified\x18\x05 \x01(\bR\bverified\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x06 \x01(\tR\n" +
+	"attachment\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xdb\x03\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12#\n" +
+	"\rauth_provider\x18\b \x01(\tR\fauthProvider\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x12\x18\n" +
+	"\acurrent\x18\n" +
+	" \x01(\bR\acurrent\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12D\n" +
+	"\x10last_activity_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActivityAt\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xf8\x01\n" +
 	"\x04Role\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
 	"\aclub_id\x18\x02 \x01(\rR\x06clubId\x12\x12\n" +
@@ -4774,7 +7068,7 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\x1bAUDIT_ACTION_ACCOUNT_LOCKED\x10\f\x12!\n" +
 	"\x1dAUDIT_ACTION_ACCOUNT_UNLOCKED\x10\r\x12#\n" +
 	"\x1fAUDIT_ACTION_PERMISSION_GRANTED\x10\x0e\x12#\n" +
-	"\x1fAUDIT_ACTION_PERMISSION_REVOKED\x10\x0f2\xe4\x0e\n" +
+	"\x1fAUDIT_ACTION_PERMISSION_REVOKED\x10\x0f2\xb8\x1a\n" +
 	"\vAuthService\x12E\n" +
 	"\fRegisterUser\x12\x19.auth.RegisterUserRequest\x1a\x1a.auth.RegisterUserResponse\x126\n" +
 	"\aGetUser\x12\x14.auth.GetUserRequest\x1a\x15.auth.GetUserResponse\x12?\n" +
@@ -4785,11 +7079,30 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\n" +
 	"DeleteUser\x12\x17.auth.DeleteUserRequest\x1a\x18.auth.DeleteUserResponse\x12]\n" +
 	"\x14InitiatePasskeyLogin\x12!.auth.InitiatePasskeyLoginRequest\x1a\".auth.InitiatePasskeyLoginResponse\x12]\n" +
-	"\x14CompletePasskeyLogin\x12!.auth.CompletePasskeyLoginRequest\x1a\".auth.CompletePasskeyLoginResponse\x12r\n" +
+	"\x14CompletePasskeyLogin\x12!.auth.CompletePasskeyLoginRequest\x1a\".auth.CompletePasskeyLoginResponse\x12a\n" +
+	"\x16CompleteLoginChallenge\x12#.auth.CompleteLoginChallengeRequest\x1a\".auth.CompletePasskeyLoginResponse\x12r\n" +
 	"\x1bInitiatePasskeyRegistration\x12(.auth.InitiatePasskeyRegistrationRequest\x1a).auth.InitiatePasskeyRegistrationResponse\x12r\n" +
 	"\x1bCompletePasskeyRegistration\x12(.auth.CompletePasskeyRegistrationRequest\x1a).auth.CompletePasskeyRegistrationResponse\x12N\n" +
 	"\x0fValidateSession\x12\x1c.auth.ValidateSessionRequest\x1a\x1d.auth.ValidateSessionResponse\x123\n" +
-	"\x06Logout\x12\x13.auth.LogoutRequest\x1a\x14.auth.LogoutResponse\x12?\n" +
+	"\x06Logout\x12\x13.auth.LogoutRequest\x1a\x14.auth.LogoutResponse\x12E\n" +
+	"\fListSessions\x12\x19.auth.ListSessionsRequest\x1a\x1a.auth.ListSessionsResponse\x12H\n" +
+	"\rRevokeSession\x12\x1a.auth.RevokeSessionRequest\x1a\x1b.auth.RevokeSessionResponse\x12U\n" +
+	"\x13RevokeOtherSessions\x12 .auth.RevokeOtherSessionsRequest\x1a\x1c.auth.RevokeSessionsResponse\x12K\n" +
+	"\x0eSearchSessions\x12\x1b.auth.SearchSessionsRequest\x1a\x1c.auth.SearchSessionsResponse\x12R\n" +
+	"\x12AdminRevokeSession\x12\x1f.auth.AdminRevokeSessionRequest\x1a\x1b.auth.RevokeSessionResponse\x12]\n" +
+	"\x17AdminRevokeUserSessions\x12$.auth.AdminRevokeUserSessionsRequest\x1a\x1c.auth.RevokeSessionsResponse\x129\n" +
+	"\bSetupMFA\x12\x15.auth.SetupMFARequest\x1a\x16.auth.SetupMFAResponse\x12<\n" +
+	"\tVerifyMFA\x12\x16.auth.VerifyMFARequest\x1a\x17.auth.VerifyMFAResponse\x12?\n" +
+	"\n" +
+	"DisableMFA\x12\x17.auth.DisableMFARequest\x1a\x18.auth.DisableMFAResponse\x12K\n" +
+	"\x0eListMFAFactors\x12\x1b.auth.ListMFAFactorsRequest\x1a\x1c.auth.ListMFAFactorsResponse\x12F\n" +
+	"\x13SetPrimaryMFAFactor\x12\x16.auth.MFAFactorRequest\x1a\x17.auth.MFAFactorResponse\x12B\n" +
+	"\x0fRemoveMFAFactor\x12\x16.auth.MFAFactorRequest\x1a\x17.auth.MFAFactorResponse\x12`\n" +
+	"\x15RegenerateBackupCodes\x12\".auth.RegenerateBackupCodesRequest\x1a#.auth.RegenerateBackupCodesResponse\x12]\n" +
+	"\x14RequestPasswordReset\x12!.auth.RequestPasswordResetRequest\x1a\".auth.RequestPasswordResetResponse\x12]\n" +
+	"\x14ConfirmPasswordReset\x12!.auth.ConfirmPasswordResetRequest\x1a\".auth.ConfirmPasswordResetResponse\x12i\n" +
+	"\x18RequestEmailVerification\x12%.auth.RequestEmailVerificationRequest\x1a&.auth.RequestEmailVerificationResponse\x12i\n" +
+	"\x18ConfirmEmailVerification\x12%.auth.ConfirmEmailVerificationRequest\x1a&.auth.ConfirmEmailVerificationResponse\x12?\n" +
 	"\n" +
 	"AssignRole\x12\x17.auth.AssignRoleRequest\x1a\x18.auth.AssignRoleResponse\x12?\n" +
 	"\n" +
@@ -4825,7 +7138,7 @@ func file_proto_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
 var file_proto_auth_proto_goTypes = []any{
 	(UserStatus)(0),                             // 0: auth.UserStatus
 	(ClubStatus)(0),                             // 1: auth.ClubStatus
@@ -4846,166 +7159,249 @@ var file_proto_auth_proto_goTypes = []any{
 	(*InitiatePasskeyLoginResponse)(nil),        // 16: auth.InitiatePasskeyLoginResponse
 	(*CompletePasskeyLoginRequest)(nil),         // 17: auth.CompletePasskeyLoginRequest
 	(*CompletePasskeyLoginResponse)(nil),        // 18: auth.CompletePasskeyLoginResponse
-	(*InitiatePasskeyRegistrationRequest)(nil),  // 19: auth.InitiatePasskeyRegistrationRequest
-	(*InitiatePasskeyRegistrationResponse)(nil), // 20: auth.InitiatePasskeyRegistrationResponse
-	(*CompletePasskeyRegistrationRequest)(nil),  // 21: auth.CompletePasskeyRegistrationRequest
-	(*CompletePasskeyRegistrationResponse)(nil), // 22: auth.CompletePasskeyRegistrationResponse
-	(*ValidateSessionRequest)(nil),              // 23: auth.ValidateSessionRequest
-	(*ValidateSessionResponse)(nil),             // 24: auth.ValidateSessionResponse
-	(*LogoutRequest)(nil),                       // 25: auth.LogoutRequest
-	(*LogoutResponse)(nil),                      // 26: auth.LogoutResponse
-	(*AssignRoleRequest)(nil),                   // 27: auth.AssignRoleRequest
-	(*AssignRoleResponse)(nil),                  // 28: auth.AssignRoleResponse
-	(*RemoveRoleRequest)(nil),                   // 29: auth.RemoveRoleRequest
-	(*RemoveRoleResponse)(nil),                  // 30: auth.RemoveRoleResponse
-	(*GetUserRolesRequest)(nil),                 // 31: auth.GetUserRolesRequest
-	(*GetUserRolesResponse)(nil),                // 32: auth.GetUserRolesResponse
-	(*GetUserPermissionsRequest)(nil),           // 33: auth.GetUserPermissionsRequest
-	(*GetUserPermissionsResponse)(nil),          // 34: auth.GetUserPermissionsResponse
-	(*CreateRoleRequest)(nil),                   // 35: auth.CreateRoleRequest
-	(*CreateRoleResponse)(nil),                  // 36: auth.CreateRoleResponse
-	(*UpdateRoleRequest)(nil),                   // 37: auth.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil),                  // 38: auth.UpdateRoleResponse
-	(*DeleteRoleRequest)(nil),                   // 39: auth.DeleteRoleRequest
-	(*DeleteRoleResponse)(nil),                  // 40: auth.DeleteRoleResponse
-	(*GetRolesRequest)(nil),                     // 41: auth.GetRolesRequest
-	(*GetRolesResponse)(nil),                    // 42: auth.GetRolesResponse
-	(*CreateClubRequest)(nil),                   // 43: auth.CreateClubRequest
-	(*CreateClubResponse)(nil),                  // 44: auth.CreateClubResponse
-	(*GetClubRequest)(nil),                      // 45: auth.GetClubRequest
-	(*GetClubResponse)(nil),                     // 46: auth.GetClubResponse
-	(*UpdateClubRequest)(nil),                   // 47: auth.UpdateClubRequest
-	(*UpdateClubResponse)(nil),                  // 48: auth.UpdateClubResponse
-	(*GetClubsRequest)(nil),                     // 49: auth.GetClubsRequest
-	(*GetClubsResponse)(nil),                    // 50: auth.GetClubsResponse
-	(*GetAuditLogsRequest)(nil),                 // 51: auth.GetAuditLogsRequest
-	(*GetAuditLogsResponse)(nil),                // 52: auth.GetAuditLogsResponse
-	(*HealthCheckRequest)(nil),                  // 53: auth.HealthCheckRequest
-	(*HealthCheckResponse)(nil),                 // 54: auth.HealthCheckResponse
-	(*User)(nil),                                // 55: auth.User
-	(*Role)(nil),                                // 56: auth.Role
-	(*Permission)(nil),                          // 57: auth.Permission
-	(*Club)(nil),                                // 58: auth.Club
-	(*ClubSettings)(nil),                        // 59: auth.ClubSettings
-	(*UserSession)(nil),                         // 60: auth.UserSession
-	(*AuditLog)(nil),                            // 61: auth.AuditLog
-	(*timestamppb.Timestamp)(nil),               // 62: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                     // 63: google.protobuf.Struct
+	(*CompleteLoginChallengeRequest)(nil),       // 19: auth.CompleteLoginChallengeRequest
+	(*InitiatePasskeyRegistrationRequest)(nil),  // 20: auth.InitiatePasskeyRegistrationRequest
+	(*InitiatePasskeyRegistrationResponse)(nil), // 21: auth.InitiatePasskeyRegistrationResponse
+	(*CompletePasskeyRegistrationRequest)(nil),  // 22: auth.CompletePasskeyRegistrationRequest
+	(*CompletePasskeyRegistrationResponse)(nil), // 23: auth.CompletePasskeyRegistrationResponse
+	(*ValidateSessionRequest)(nil),              // 24: auth.ValidateSessionRequest
+	(*ValidateSessionResponse)(nil),             // 25: auth.ValidateSessionResponse
+	(*LogoutRequest)(nil),                       // 26: auth.LogoutRequest
+	(*LogoutResponse)(nil),                      // 27: auth.LogoutResponse
+	(*ListSessionsRequest)(nil),                 // 28: auth.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                // 29: auth.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                // 30: auth.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),               // 31: auth.RevokeSessionResponse
+	(*RevokeOtherSessionsRequest)(nil),          // 32: auth.RevokeOtherSessionsRequest
+	(*RevokeSessionsResponse)(nil),              // 33: auth.RevokeSessionsResponse
+	(*SetupMFARequest)(nil),                     // 34: auth.SetupMFARequest
+	(*SetupMFAResponse)(nil),                    // 35: auth.SetupMFAResponse
+	(*VerifyMFARequest)(nil),                    // 36: auth.VerifyMFARequest
+	(*VerifyMFAResponse)(nil),                   // 37: auth.VerifyMFAResponse
+	(*DisableMFARequest)(nil),                   // 38: auth.DisableMFARequest
+	(*DisableMFAResponse)(nil),                  // 39: auth.DisableMFAResponse
+	(*ListMFAFactorsRequest)(nil),               // 40: auth.ListMFAFactorsRequest
+	(*ListMFAFactorsResponse)(nil),              // 41: auth.ListMFAFactorsResponse
+	(*MFAFactorRequest)(nil),                    // 42: auth.MFAFactorRequest
+	(*MFAFactorResponse)(nil),                   // 43: auth.MFAFactorResponse
+	(*RegenerateBackupCodesRequest)(nil),        // 44: auth.RegenerateBackupCodesRequest
+	(*RegenerateBackupCodesResponse)(nil),       // 45: auth.RegenerateBackupCodesResponse
+	(*SearchSessionsRequest)(nil),               // 46: auth.SearchSessionsRequest
+	(*SearchSessionsResponse)(nil),              // 47: auth.SearchSessionsResponse
+	(*AdminRevokeSessionRequest)(nil),           // 48: auth.AdminRevokeSessionRequest
+	(*AdminRevokeUserSessionsRequest)(nil),      // 49: auth.AdminRevokeUserSessionsRequest
+	(*RequestPasswordResetRequest)(nil),         // 50: auth.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),        // 51: auth.RequestPasswordResetResponse
+	(*ConfirmPasswordResetRequest)(nil),         // 52: auth.ConfirmPasswordResetRequest
+	(*ConfirmPasswordResetResponse)(nil),        // 53: auth.ConfirmPasswordResetResponse
+	(*RequestEmailVerificationRequest)(nil),     // 54: auth.RequestEmailVerificationRequest
+	(*RequestEmailVerificationResponse)(nil),    // 55: auth.RequestEmailVerificationResponse
+	(*ConfirmEmailVerificationRequest)(nil),     // 56: auth.ConfirmEmailVerificationRequest
+	(*ConfirmEmailVerificationResponse)(nil),    // 57: auth.ConfirmEmailVerificationResponse
+	(*AssignRoleRequest)(nil),                   // 58: auth.AssignRoleRequest
+	(*AssignRoleResponse)(nil),                  // 59: auth.AssignRoleResponse
+	(*RemoveRoleRequest)(nil),                   // 60: auth.RemoveRoleRequest
+	(*RemoveRoleResponse)(nil),                  // 61: auth.RemoveRoleResponse
+	(*GetUserRolesRequest)(nil),                 // 62: auth.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),                // 63: auth.GetUserRolesResponse
+	(*GetUserPermissionsRequest)(nil),           // 64: auth.GetUserPermissionsRequest
+	(*GetUserPermissionsResponse)(nil),          // 65: auth.GetUserPermissionsResponse
+	(*CreateRoleRequest)(nil),                   // 66: auth.CreateRoleRequest
+	(*CreateRoleResponse)(nil),                  // 67: auth.CreateRoleResponse
+	(*UpdateRoleRequest)(nil),                   // 68: auth.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),                  // 69: auth.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),                   // 70: auth.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),                  // 71: auth.DeleteRoleResponse
+	(*GetRolesRequest)(nil),                     // 72: auth.GetRolesRequest
+	(*GetRolesResponse)(nil),                    // 73: auth.GetRolesResponse
+	(*CreateClubRequest)(nil),                   // 74: auth.CreateClubRequest
+	(*CreateClubResponse)(nil),                  // 75: auth.CreateClubResponse
+	(*GetClubRequest)(nil),                      // 76: auth.GetClubRequest
+	(*GetClubResponse)(nil),                     // 77: auth.GetClubResponse
+	(*UpdateClubRequest)(nil),                   // 78: auth.UpdateClubRequest
+	(*UpdateClubResponse)(nil),                  // 79: auth.UpdateClubResponse
+	(*GetClubsRequest)(nil),                     // 80: auth.GetClubsRequest
+	(*GetClubsResponse)(nil),                    // 81: auth.GetClubsResponse
+	(*GetAuditLogsRequest)(nil),                 // 82: auth.GetAuditLogsRequest
+	(*GetAuditLogsResponse)(nil),                // 83: auth.GetAuditLogsResponse
+	(*HealthCheckRequest)(nil),                  // 84: auth.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                 // 85: auth.HealthCheckResponse
+	(*User)(nil),                                // 86: auth.User
+	(*MFAFactor)(nil),                           // 87: auth.MFAFactor
+	(*Session)(nil),                             // 88: auth.Session
+	(*Role)(nil),                                // 89: auth.Role
+	(*Permission)(nil),                          // 90: auth.Permission
+	(*Club)(nil),                                // 91: auth.Club
+	(*ClubSettings)(nil),                        // 92: auth.ClubSettings
+	(*UserSession)(nil),                         // 93: auth.UserSession
+	(*AuditLog)(nil),                            // 94: auth.AuditLog
+	(*timestamppb.Timestamp)(nil),               // 95: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                     // 96: google.protobuf.Struct
 }
 var file_proto_auth_proto_depIdxs = []int32{
-	55, // 0: auth.RegisterUserResponse.user:type_name -> auth.User
-	62, // 1: auth.RegisterUserResponse.expires_at:type_name -> google.protobuf.Timestamp
-	55, // 2: auth.GetUserResponse.user:type_name -> auth.User
-	0,  // 3: auth.UpdateUserRequest.status:type_name -> auth.UserStatus
-	55, // 4: auth.UpdateUserResponse.user:type_name -> auth.User
-	62, // 5: auth.SuspendUserRequest.suspended_until:type_name -> google.protobuf.Timestamp
-	55, // 6: auth.SuspendUserResponse.user:type_name -> auth.User
-	55, // 7: auth.ActivateUserResponse.user:type_name -> auth.User
-	63, // 8: auth.InitiatePasskeyLoginResponse.options:type_name -> google.protobuf.Struct
-	63, // 9: auth.CompletePasskeyLoginRequest.credential_result:type_name -> google.protobuf.Struct
-	55, // 10: auth.CompletePasskeyLoginResponse.user:type_name -> auth.User
-	62, // 11: auth.CompletePasskeyLoginResponse.expires_at:type_name -> google.protobuf.Timestamp
-	63, // 12: auth.InitiatePasskeyRegistrationResponse.options:type_name -> google.protobuf.Struct
-	63, // 13: auth.CompletePasskeyRegistrationRequest.credential_result:type_name -> google.protobuf.Struct
-	55, // 14: auth.ValidateSessionResponse.user:type_name -> auth.User
-	62, // 15: auth.ValidateSessionResponse.expires_at:type_name -> google.protobuf.Timestamp
-	62, // 16: auth.AssignRoleRequest.expires_at:type_name -> google.protobuf.Timestamp
-	56, // 17: auth.GetUserRolesResponse.roles:type_name -> auth.Role
-	57, // 18: auth.GetUserPermissionsResponse.permissions:type_name -> auth.Permission
-	56, // 19: auth.CreateRoleResponse.role:type_name -> auth.Role
-	56, // 20: auth.UpdateRoleResponse.role:type_name -> auth.Role
-	56, // 21: auth.GetRolesResponse.roles:type_name -> auth.Role
-	59, // 22: auth.CreateClubRequest.settings:type_name -> auth.ClubSettings
-	58, // 23: auth.CreateClubResponse.club:type_name -> auth.Club
-	58, // 24: auth.GetClubResponse.club:type_name -> auth.Club
-	59, // 25: auth.UpdateClubRequest.settings:type_name -> auth.ClubSettings
-	1,  // 26: auth.UpdateClubRequest.status:type_name -> auth.ClubStatus
-	58, // 27: auth.UpdateClubResponse.club:type_name -> auth.Club
-	1,  // 28: auth.GetClubsRequest.status:type_name -> auth.ClubStatus
-	58, // 29: auth.GetClubsResponse.clubs:type_name -> auth.Club
-	2,  // 30: auth.GetAuditLogsRequest.action:type_name -> auth.AuditAction
-	62, // 31: auth.GetAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
-	62, // 32: auth.GetAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
-	61, // 33: auth.GetAuditLogsResponse.audit_logs:type_name -> auth.AuditLog
-	62, // 34: auth.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 35: auth.User.status:type_name -> auth.UserStatus
-	62, // 36: auth.User.last_login_at:type_name -> google.protobuf.Timestamp
-	62, // 37: auth.User.locked_until:type_name -> google.protobuf.Timestamp
-	62, // 38: auth.User.created_at:type_name -> google.protobuf.Timestamp
-	62, // 39: auth.User.updated_at:type_name -> google.protobuf.Timestamp
-	62, // 40: auth.Role.created_at:type_name -> google.protobuf.Timestamp
-	62, // 41: auth.Role.updated_at:type_name -> google.protobuf.Timestamp
-	62, // 42: auth.Permission.created_at:type_name -> google.protobuf.Timestamp
-	62, // 43: auth.Permission.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 44: auth.Club.status:type_name -> auth.ClubStatus
-	59, // 45: auth.Club.settings:type_name -> auth.ClubSettings
-	62, // 46: auth.Club.created_at:type_name -> google.protobuf.Timestamp
-	62, // 47: auth.Club.updated_at:type_name -> google.protobuf.Timestamp
-	62, // 48: auth.UserSession.expires_at:type_name -> google.protobuf.Timestamp
-	62, // 49: auth.UserSession.last_activity_at:type_name -> google.protobuf.Timestamp
-	62, // 50: auth.UserSession.logout_at:type_name -> google.protobuf.Timestamp
-	62, // 51: auth.UserSession.created_at:type_name -> google.protobuf.Timestamp
-	62, // 52: auth.UserSession.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 53: auth.AuditLog.action:type_name -> auth.AuditAction
-	63, // 54: auth.AuditLog.metadata:type_name -> google.protobuf.Struct
-	62, // 55: auth.AuditLog.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 56: auth.AuthService.RegisterUser:input_type -> auth.RegisterUserRequest
-	5,  // 57: auth.AuthService.GetUser:input_type -> auth.GetUserRequest
-	7,  // 58: auth.AuthService.UpdateUser:input_type -> auth.UpdateUserRequest
-	9,  // 59: auth.AuthService.SuspendUser:input_type -> auth.SuspendUserRequest
-	11, // 60: auth.AuthService.ActivateUser:input_type -> auth.ActivateUserRequest
-	13, // 61: auth.AuthService.DeleteUser:input_type -> auth.DeleteUserRequest
-	15, // 62: auth.AuthService.InitiatePasskeyLogin:input_type -> auth.InitiatePasskeyLoginRequest
-	17, // 63: auth.AuthService.CompletePasskeyLogin:input_type -> auth.CompletePasskeyLoginRequest
-	19, // 64: auth.AuthService.InitiatePasskeyRegistration:input_type -> auth.InitiatePasskeyRegistrationRequest
-	21, // 65: auth.AuthService.CompletePasskeyRegistration:input_type -> auth.CompletePasskeyRegistrationRequest
-	23, // 66: auth.AuthService.ValidateSession:input_type -> auth.ValidateSessionRequest
-	25, // 67: auth.AuthService.Logout:input_type -> auth.LogoutRequest
-	27, // 68: auth.AuthService.AssignRole:input_type -> auth.AssignRoleRequest
-	29, // 69: auth.AuthService.RemoveRole:input_type -> auth.RemoveRoleRequest
-	31, // 70: auth.AuthService.GetUserRoles:input_type -> auth.GetUserRolesRequest
-	33, // 71: auth.AuthService.GetUserPermissions:input_type -> auth.GetUserPermissionsRequest
-	35, // 72: auth.AuthService.CreateRole:input_type -> auth.CreateRoleRequest
-	37, // 73: auth.AuthService.UpdateRole:input_type -> auth.UpdateRoleRequest
-	39, // 74: auth.AuthService.DeleteRole:input_type -> auth.DeleteRoleRequest
-	41, // 75: auth.AuthService.GetRoles:input_type -> auth.GetRolesRequest
-	43, // 76: auth.AuthService.CreateClub:input_type -> auth.CreateClubRequest
-	45, // 77: auth.AuthService.GetClub:input_type -> auth.GetClubRequest
-	47, // 78: auth.AuthService.UpdateClub:input_type -> auth.UpdateClubRequest
-	49, // 79: auth.AuthService.GetClubs:input_type -> auth.GetClubsRequest
-	51, // 80: auth.AuthService.GetAuditLogs:input_type -> auth.GetAuditLogsRequest
-	53, // 81: auth.AuthService.HealthCheck:input_type -> auth.HealthCheckRequest
-	4,  // 82: auth.AuthService.RegisterUser:output_type -> auth.RegisterUserResponse
-	6,  // 83: auth.AuthService.GetUser:output_type -> auth.GetUserResponse
-	8,  // 84: auth.AuthService.UpdateUser:output_type -> auth.UpdateUserResponse
-	10, // 85: auth.AuthService.SuspendUser:output_type -> auth.SuspendUserResponse
-	12, // 86: auth.AuthService.ActivateUser:output_type -> auth.ActivateUserResponse
-	14, // 87: auth.AuthService.DeleteUser:output_type -> auth.DeleteUserResponse
-	16, // 88: auth.AuthService.InitiatePasskeyLogin:output_type -> auth.InitiatePasskeyLoginResponse
-	18, // 89: auth.AuthService.CompletePasskeyLogin:output_type -> auth.CompletePasskeyLoginResponse
-	20, // 90: auth.AuthService.InitiatePasskeyRegistration:output_type -> auth.InitiatePasskeyRegistrationResponse
-	22, // 91: auth.AuthService.CompletePasskeyRegistration:output_type -> auth.CompletePasskeyRegistrationResponse
-	24, // 92: auth.AuthService.ValidateSession:output_type -> auth.ValidateSessionResponse
-	26, // 93: auth.AuthService.Logout:output_type -> auth.LogoutResponse
-	28, // 94: auth.AuthService.AssignRole:output_type -> auth.AssignRoleResponse
-	30, // 95: auth.AuthService.RemoveRole:output_type -> auth.RemoveRoleResponse
-	32, // 96: auth.AuthService.GetUserRoles:output_type -> auth.GetUserRolesResponse
-	34, // 97: auth.AuthService.GetUserPermissions:output_type -> auth.GetUserPermissionsResponse
-	36, // 98: auth.AuthService.CreateRole:output_type -> auth.CreateRoleResponse
-	38, // 99: auth.AuthService.UpdateRole:output_type -> auth.UpdateRoleResponse
-	40, // 100: auth.AuthService.DeleteRole:output_type -> auth.DeleteRoleResponse
-	42, // 101: auth.AuthService.GetRoles:output_type -> auth.GetRolesResponse
-	44, // 102: auth.AuthService.CreateClub:output_type -> auth.CreateClubResponse
-	46, // 103: auth.AuthService.GetClub:output_type -> auth.GetClubResponse
-	48, // 104: auth.AuthService.UpdateClub:output_type -> auth.UpdateClubResponse
-	50, // 105: auth.AuthService.GetClubs:output_type -> auth.GetClubsResponse
-	52, // 106: auth.AuthService.GetAuditLogs:output_type -> auth.GetAuditLogsResponse
-	54, // 107: auth.AuthService.HealthCheck:output_type -> auth.HealthCheckResponse
-	82, // [82:108] is the sub-list for method output_type
-	56, // [56:82] is the sub-list for method input_type
-	56, // [56:56] is the sub-list for extension type_name
-	56, // [56:56] is the sub-list for extension extendee
-	0,  // [0:56] is the sub-list for field type_name
+	86,  // 0: auth.RegisterUserResponse.user:type_name -> auth.User
+	95,  // 1: auth.RegisterUserResponse.expires_at:type_name -> google.protobuf.Timestamp
+	86,  // 2: auth.GetUserResponse.user:type_name -> auth.User
+	0,   // 3: auth.UpdateUserRequest.status:type_name -> auth.UserStatus
+	86,  // 4: auth.UpdateUserResponse.user:type_name -> auth.User
+	95,  // 5: auth.SuspendUserRequest.suspended_until:type_name -> google.protobuf.Timestamp
+	86,  // 6: auth.SuspendUserResponse.user:type_name -> auth.User
+	86,  // 7: auth.ActivateUserResponse.user:type_name -> auth.User
+	96,  // 8: auth.InitiatePasskeyLoginResponse.options:type_name -> google.protobuf.Struct
+	96,  // 9: auth.CompletePasskeyLoginRequest.credential_result:type_name -> google.protobuf.Struct
+	86,  // 10: auth.CompletePasskeyLoginResponse.user:type_name -> auth.User
+	95,  // 11: auth.CompletePasskeyLoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	96,  // 12: auth.CompletePasskeyLoginResponse.mfa_options:type_name -> google.protobuf.Struct
+	96,  // 13: auth.CompleteLoginChallengeRequest.credential_result:type_name -> google.protobuf.Struct
+	96,  // 14: auth.InitiatePasskeyRegistrationResponse.options:type_name -> google.protobuf.Struct
+	96,  // 15: auth.CompletePasskeyRegistrationRequest.credential_result:type_name -> google.protobuf.Struct
+	86,  // 16: auth.ValidateSessionResponse.user:type_name -> auth.User
+	95,  // 17: auth.ValidateSessionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	88,  // 18: auth.ListSessionsResponse.sessions:type_name -> auth.Session
+	96,  // 19: auth.SetupMFAResponse.options:type_name -> google.protobuf.Struct
+	96,  // 20: auth.VerifyMFARequest.credential_result:type_name -> google.protobuf.Struct
+	87,  // 21: auth.VerifyMFAResponse.factors:type_name -> auth.MFAFactor
+	96,  // 22: auth.VerifyMFAResponse.options:type_name -> google.protobuf.Struct
+	87,  // 23: auth.ListMFAFactorsResponse.factors:type_name -> auth.MFAFactor
+	88,  // 24: auth.SearchSessionsResponse.sessions:type_name -> auth.Session
+	95,  // 25: auth.AssignRoleRequest.expires_at:type_name -> google.protobuf.Timestamp
+	89,  // 26: auth.GetUserRolesResponse.roles:type_name -> auth.Role
+	90,  // 27: auth.GetUserPermissionsResponse.permissions:type_name -> auth.Permission
+	89,  // 28: auth.CreateRoleResponse.role:type_name -> auth.Role
+	89,  // 29: auth.UpdateRoleResponse.role:type_name -> auth.Role
+	89,  // 30: auth.GetRolesResponse.roles:type_name -> auth.Role
+	92,  // 31: auth.CreateClubRequest.settings:type_name -> auth.ClubSettings
+	91,  // 32: auth.CreateClubResponse.club:type_name -> auth.Club
+	91,  // 33: auth.GetClubResponse.club:type_name -> auth.Club
+	92,  // 34: auth.UpdateClubRequest.settings:type_name -> auth.ClubSettings
+	1,   // 35: auth.UpdateClubRequest.status:type_name -> auth.ClubStatus
+	91,  // 36: auth.UpdateClubResponse.club:type_name -> auth.Club
+	1,   // 37: auth.GetClubsRequest.status:type_name -> auth.ClubStatus
+	91,  // 38: auth.GetClubsResponse.clubs:type_name -> auth.Club
+	2,   // 39: auth.GetAuditLogsRequest.action:type_name -> auth.AuditAction
+	95,  // 40: auth.GetAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	95,  // 41: auth.GetAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	94,  // 42: auth.GetAuditLogsResponse.audit_logs:type_name -> auth.AuditLog
+	95,  // 43: auth.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 44: auth.User.status:type_name -> auth.UserStatus
+	95,  // 45: auth.User.last_login_at:type_name -> google.protobuf.Timestamp
+	95,  // 46: auth.User.locked_until:type_name -> google.protobuf.Timestamp
+	95,  // 47: auth.User.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 48: auth.User.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 49: auth.MFAFactor.last_used_at:type_name -> google.protobuf.Timestamp
+	95,  // 50: auth.MFAFactor.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 51: auth.Session.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 52: auth.Session.last_activity_at:type_name -> google.protobuf.Timestamp
+	95,  // 53: auth.Session.expires_at:type_name -> google.protobuf.Timestamp
+	95,  // 54: auth.Role.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 55: auth.Role.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 56: auth.Permission.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 57: auth.Permission.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 58: auth.Club.status:type_name -> auth.ClubStatus
+	92,  // 59: auth.Club.settings:type_name -> auth.ClubSettings
+	95,  // 60: auth.Club.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 61: auth.Club.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 62: auth.UserSession.expires_at:type_name -> google.protobuf.Timestamp
+	95,  // 63: auth.UserSession.last_activity_at:type_name -> google.protobuf.Timestamp
+	95,  // 64: auth.UserSession.logout_at:type_name -> google.protobuf.Timestamp
+	95,  // 65: auth.UserSession.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 66: auth.UserSession.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 67: auth.AuditLog.action:type_name -> auth.AuditAction
+	96,  // 68: auth.AuditLog.metadata:type_name -> google.protobuf.Struct
+	95,  // 69: auth.AuditLog.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 70: auth.AuthService.RegisterUser:input_type -> auth.RegisterUserRequest
+	5,   // 71: auth.AuthService.GetUser:input_type -> auth.GetUserRequest
+	7,   // 72: auth.AuthService.UpdateUser:input_type -> auth.UpdateUserRequest
+	9,   // 73: auth.AuthService.SuspendUser:input_type -> auth.SuspendUserRequest
+	11,  // 74: auth.AuthService.ActivateUser:input_type -> auth.ActivateUserRequest
+	13,  // 75: auth.AuthService.DeleteUser:input_type -> auth.DeleteUserRequest
+	15,  // 76: auth.AuthService.InitiatePasskeyLogin:input_type -> auth.InitiatePasskeyLoginRequest
+	17,  // 77: auth.AuthService.CompletePasskeyLogin:input_type -> auth.CompletePasskeyLoginRequest
+	19,  // 78: auth.AuthService.CompleteLoginChallenge:input_type -> auth.CompleteLoginChallengeRequest
+	20,  // 79: auth.AuthService.InitiatePasskeyRegistration:input_type -> auth.InitiatePasskeyRegistrationRequest
+	22,  // 80: auth.AuthService.CompletePasskeyRegistration:input_type -> auth.CompletePasskeyRegistrationRequest
+	24,  // 81: auth.AuthService.ValidateSession:input_type -> auth.ValidateSessionRequest
+	26,  // 82: auth.AuthService.Logout:input_type -> auth.LogoutRequest
+	28,  // 83: auth.AuthService.ListSessions:input_type -> auth.ListSessionsRequest
+	30,  // 84: auth.AuthService.RevokeSession:input_type -> auth.RevokeSessionRequest
+	32,  // 85: auth.AuthService.RevokeOtherSessions:input_type -> auth.RevokeOtherSessionsRequest
+	46,  // 86: auth.AuthService.SearchSessions:input_type -> auth.SearchSessionsRequest
+	48,  // 87: auth.AuthService.AdminRevokeSession:input_type -> auth.AdminRevokeSessionRequest
+	49,  // 88: auth.AuthService.AdminRevokeUserSessions:input_type -> auth.AdminRevokeUserSessionsRequest
+	34,  // 89: auth.AuthService.SetupMFA:input_type -> auth.SetupMFARequest
+	36,  // 90: auth.AuthService.VerifyMFA:input_type -> auth.VerifyMFARequest
+	38,  // 91: auth.AuthService.DisableMFA:input_type -> auth.DisableMFARequest
+	40,  // 92: auth.AuthService.ListMFAFactors:input_type -> auth.ListMFAFactorsRequest
+	42,  // 93: auth.AuthService.SetPrimaryMFAFactor:input_type -> auth.MFAFactorRequest
+	42,  // 94: auth.AuthService.RemoveMFAFactor:input_type -> auth.MFAFactorRequest
+	44,  // 95: auth.AuthService.RegenerateBackupCodes:input_type -> auth.RegenerateBackupCodesRequest
+	50,  // 96: auth.AuthService.RequestPasswordReset:input_type -> auth.RequestPasswordResetRequest
+	52,  // 97: auth.AuthService.ConfirmPasswordReset:input_type -> auth.ConfirmPasswordResetRequest
+	54,  // 98: auth.AuthService.RequestEmailVerification:input_type -> auth.RequestEmailVerificationRequest
+	56,  // 99: auth.AuthService.ConfirmEmailVerification:input_type -> auth.ConfirmEmailVerificationRequest
+	58,  // 100: auth.AuthService.AssignRole:input_type -> auth.AssignRoleRequest
+	60,  // 101: auth.AuthService.RemoveRole:input_type -> auth.RemoveRoleRequest
+	62,  // 102: auth.AuthService.GetUserRoles:input_type -> auth.GetUserRolesRequest
+	64,  // 103: auth.AuthService.GetUserPermissions:input_type -> auth.GetUserPermissionsRequest
+	66,  // 104: auth.AuthService.CreateRole:input_type -> auth.CreateRoleRequest
+	68,  // 105: auth.AuthService.UpdateRole:input_type -> auth.UpdateRoleRequest
+	70,  // 106: auth.AuthService.DeleteRole:input_type -> auth.DeleteRoleRequest
+	72,  // 107: auth.AuthService.GetRoles:input_type -> auth.GetRolesRequest
+	74,  // 108: auth.AuthService.CreateClub:input_type -> auth.CreateClubRequest
+	76,  // 109: auth.AuthService.GetClub:input_type -> auth.GetClubRequest
+	78,  // 110: auth.AuthService.UpdateClub:input_type -> auth.UpdateClubRequest
+	80,  // 111: auth.AuthService.GetClubs:input_type -> auth.GetClubsRequest
+	82,  // 112: auth.AuthService.GetAuditLogs:input_type -> auth.GetAuditLogsRequest
+	84,  // 113: auth.AuthService.HealthCheck:input_type -> auth.HealthCheckRequest
+	4,   // 114: auth.AuthService.RegisterUser:output_type -> auth.RegisterUserResponse
+	6,   // 115: auth.AuthService.GetUser:output_type -> auth.GetUserResponse
+	8,   // 116: auth.AuthService.UpdateUser:output_type -> auth.UpdateUserResponse
+	10,  // 117: auth.AuthService.SuspendUser:output_type -> auth.SuspendUserResponse
+	12,  // 118: auth.AuthService.ActivateUser:output_type -> auth.ActivateUserResponse
+	14,  // 119: auth.AuthService.DeleteUser:output_type -> auth.DeleteUserResponse
+	16,  // 120: auth.AuthService.InitiatePasskeyLogin:output_type -> auth.InitiatePasskeyLoginResponse
+	18,  // 121: auth.AuthService.CompletePasskeyLogin:output_type -> auth.CompletePasskeyLoginResponse
+	18,  // 122: auth.AuthService.CompleteLoginChallenge:output_type -> auth.CompletePasskeyLoginResponse
+	21,  // 123: auth.AuthService.InitiatePasskeyRegistration:output_type -> auth.InitiatePasskeyRegistrationResponse
+	23,  // 124: auth.AuthService.CompletePasskeyRegistration:output_type -> auth.CompletePasskeyRegistrationResponse
+	25,  // 125: auth.AuthService.ValidateSession:output_type -> auth.ValidateSessionResponse
+	27,  // 126: auth.AuthService.Logout:output_type -> auth.LogoutResponse
+	29,  // 127: auth.AuthService.ListSessions:output_type -> auth.ListSessionsResponse
+	31,  // 128: auth.AuthService.RevokeSession:output_type -> auth.RevokeSessionResponse
+	33,  // 129: auth.AuthService.RevokeOtherSessions:output_type -> auth.RevokeSessionsResponse
+	47,  // 130: auth.AuthService.SearchSessions:output_type -> auth.SearchSessionsResponse
+	31,  // 131: auth.AuthService.AdminRevokeSession:output_type -> auth.RevokeSessionResponse
+	33,  // 132: auth.AuthService.AdminRevokeUserSessions:output_type -> auth.RevokeSessionsResponse
+	35,  // 133: auth.AuthService.SetupMFA:output_type -> auth.SetupMFAResponse
+	37,  // 134: auth.AuthService.VerifyMFA:output_type -> auth.VerifyMFAResponse
+	39,  // 135: auth.AuthService.DisableMFA:output_type -> auth.DisableMFAResponse
+	41,  // 136: auth.AuthService.ListMFAFactors:output_type -> auth.ListMFAFactorsResponse
+	43,  // 137: auth.AuthService.SetPrimaryMFAFactor:output_type -> auth.MFAFactorResponse
+	43,  // 138: auth.AuthService.RemoveMFAFactor:output_type -> auth.MFAFactorResponse
+	45,  // 139: auth.AuthService.RegenerateBackupCodes:output_type -> auth.RegenerateBackupCodesResponse
+	51,  // 140: auth.AuthService.RequestPasswordReset:output_type -> auth.RequestPasswordResetResponse
+	53,  // 141: auth.AuthService.ConfirmPasswordReset:output_type -> auth.ConfirmPasswordResetResponse
+	55,  // 142: auth.AuthService.RequestEmailVerification:output_type -> auth.RequestEmailVerificationResponse
+	57,  // 143: auth.AuthService.ConfirmEmailVerification:output_type -> auth.ConfirmEmailVerificationResponse
+	59,  // 144: auth.AuthService.AssignRole:output_type -> auth.AssignRoleResponse
+	61,  // 145: auth.AuthService.RemoveRole:output_type -> auth.RemoveRoleResponse
+	63,  // 146: auth.AuthService.GetUserRoles:output_type -> auth.GetUserRolesResponse
+	65,  // 147: auth.AuthService.GetUserPermissions:output_type -> auth.GetUserPermissionsResponse
+	67,  // 148: auth.AuthService.CreateRole:output_type -> auth.CreateRoleResponse
+	69,  // 149: auth.AuthService.UpdateRole:output_type -> auth.UpdateRoleResponse
+	71,  // 150: auth.AuthService.DeleteRole:output_type -> auth.DeleteRoleResponse
+	73,  // 151: auth.AuthService.GetRoles:output_type -> auth.GetRolesResponse
+	75,  // 152: auth.AuthService.CreateClub:output_type -> auth.CreateClubResponse
+	77,  // 153: auth.AuthService.GetClub:output_type -> auth.GetClubResponse
+	79,  // 154: auth.AuthService.UpdateClub:output_type -> auth.UpdateClubResponse
+	81,  // 155: auth.AuthService.GetClubs:output_type -> auth.GetClubsResponse
+	83,  // 156: auth.AuthService.GetAuditLogs:output_type -> auth.GetAuditLogsResponse
+	85,  // 157: auth.AuthService.HealthCheck:output_type -> auth.HealthCheckResponse
+	114, // [114:158] is the sub-list for method output_type
+	70,  // [70:114] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_proto_init() }
@@ -5013,7 +7409,7 @@ func file_proto_auth_proto_init() {
 	if File_proto_auth_proto != nil {
 		return
 	}
-	file_proto_auth_proto_msgTypes[42].OneofWrappers = []any{
+	file_proto_auth_proto_msgTypes[73].OneofWrappers = []any{
 		(*GetClubRequest_ClubId)(nil),
 		(*GetClubRequest_ClubSlug)(nil),
 	}
@@ -5023,7 +7419,7 @@ func file_proto_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   59,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -85,6 +85,13 @@ func main() {
 	// Initialize service
 	blockchainService := service.NewService(repo, logger, messageBus, monitor)
 
+	// Record audit chain heads published by other services
+	if err := blockchainService.StartAuditAnchoring(); err != nil {
+		logger.Fatal("Failed to start audit anchoring", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
 	// Initialize HTTP handlers
 	httpHandler := httpHandlers.NewHTTPHandler(blockchainService, logger, monitor)
 
@@ -27,6 +27,7 @@ const (
 	FabricTransactionTypePaymentRecord      FabricTransactionType = "payment_record"
 	FabricTransactionTypeQuery              FabricTransactionType = "query"
 	FabricTransactionTypeInvoke             FabricTransactionType = "invoke"
+	FabricTransactionTypeAuditAnchor        FabricTransactionType = "audit_anchor"
 )
 
 // ChannelType represents different Fabric channel types
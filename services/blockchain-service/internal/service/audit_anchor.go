@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/blockchain-service/internal/models"
+)
+
+const (
+	// AuditAnchorSubject carries audit chain heads published by auth-service
+	AuditAnchorSubject = "blockchain.audit.anchor"
+
+	auditAnchorQueue     = "blockchain-service"
+	auditAnchorChaincode = "audit"
+	auditAnchorFunction  = "AnchorChainHead"
+)
+
+// AuditAnchorRequest is a service's audit chain head to record on the audit channel
+type AuditAnchorRequest struct {
+	ClubID   uint   `json:"club_id"`
+	Sequence uint64 `json:"sequence"`
+	HeadHash string `json:"head_hash"`
+}
+
+// StartAuditAnchoring subscribes to audit chain heads; the queue group makes
+// sure each head is recorded by a single replica
+func (s *BlockchainService) StartAuditAnchoring() error {
+	err := s.messaging.SubscribeQueue(AuditAnchorSubject, auditAnchorQueue, func(ctx context.Context, msg *messaging.Message) error {
+		var req AuditAnchorRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			s.logger.Error("Failed to unmarshal audit anchor", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return err
+		}
+
+		_, err := s.AnchorAuditChain(ctx, &req)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to audit anchors: %w", err)
+	}
+
+	s.logger.Info("Audit anchoring started", map[string]interface{}{
+		"subject": AuditAnchorSubject,
+	})
+	return nil
+}
+
+// AnchorAuditChain records an audit chain head as a transaction on the audit channel
+func (s *BlockchainService) AnchorAuditChain(ctx context.Context, req *AuditAnchorRequest) (*models.FabricTransaction, error) {
+	if req.ClubID == 0 || req.Sequence == 0 || req.HeadHash == "" {
+		return nil, fmt.Errorf("audit anchor requires club_id, sequence and head_hash")
+	}
+
+	sequence := strconv.FormatUint(req.Sequence, 10)
+	return s.CreateTransaction(ctx, &CreateTransactionRequest{
+		ClubID:        req.ClubID,
+		UserID:        "auth-service",
+		Type:          models.FabricTransactionTypeAuditAnchor,
+		ChannelID:     string(models.ChannelTypeAudit),
+		ChaincodeName: auditAnchorChaincode,
+		Function:      auditAnchorFunction,
+		Args:          []string{strconv.FormatUint(uint64(req.ClubID), 10), sequence, req.HeadHash},
+		Metadata: map[string]interface{}{
+			"source":    "auth-service",
+			"sequence":  sequence,
+			"head_hash": req.HeadHash,
+		},
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/database"
 	"reciprocal-clubs-backend/pkg/shared/logging"
@@ -55,7 +56,19 @@ func main() {
 	if err := db.Migrate(
 		&models.Notification{},
 		&models.NotificationTemplate{},
+		&models.NotificationTemplateVersion{},
 		&models.NotificationPreference{},
+		&models.UserPreferences{},
+		&models.NotificationRule{},
+		&models.ProcessedEvent{},
+		&models.EventDeadLetter{},
+		&models.SuppressedRecipient{},
+		&models.DigestPreference{},
+		&models.InboxEvent{},
+		&models.Device{},
+		&models.WebhookSubscription{},
+		&models.WebhookDelivery{},
+		&models.WebhookDeliveryAttempt{},
 	); err != nil {
 		logger.Fatal("Failed to migrate database", map[string]interface{}{
 			"error": err.Error(),
@@ -90,9 +103,10 @@ func main() {
 			FromEmail:    getEnvOrDefault("FROM_EMAIL", "noreply@clubland.com"),
 		},
 		SMS: &providers.SMSConfig{
-			AccountSID: getEnvOrDefault("TWILIO_ACCOUNT_SID", ""),
-			AuthToken:  getEnvOrDefault("TWILIO_AUTH_TOKEN", ""),
-			FromNumber: getEnvOrDefault("TWILIO_FROM_NUMBER", ""),
+			AccountSID:        getEnvOrDefault("TWILIO_ACCOUNT_SID", ""),
+			AuthToken:         getEnvOrDefault("TWILIO_AUTH_TOKEN", ""),
+			FromNumber:        getEnvOrDefault("TWILIO_FROM_NUMBER", ""),
+			StatusCallbackURL: getEnvOrDefault("TWILIO_STATUS_CALLBACK_URL", ""),
 		},
 		Push: &providers.PushConfig{
 			ServerKey: getEnvOrDefault("FCM_SERVER_KEY", ""),
@@ -101,6 +115,45 @@ func main() {
 		Webhook: &providers.WebhookConfig{
 			SecretKey: getEnvOrDefault("WEBHOOK_SECRET_KEY", ""),
 		},
+		ReceiptSecret: getEnvOrDefault("RECEIPT_WEBHOOK_SECRET", ""),
+	}
+
+	// APNs and Web Push reach iOS devices and browsers registered for push
+	if signingKey := os.Getenv("APNS_SIGNING_KEY"); signingKey != "" {
+		providersConfig.APNs = &providers.APNsConfig{
+			SigningKey: signingKey,
+			KeyID:      getEnvOrDefault("APNS_KEY_ID", ""),
+			TeamID:     getEnvOrDefault("APNS_TEAM_ID", ""),
+			Topic:      getEnvOrDefault("APNS_TOPIC", ""),
+			Sandbox:    os.Getenv("APNS_SANDBOX") == "true",
+		}
+	}
+	if vapidKey := os.Getenv("VAPID_PRIVATE_KEY"); vapidKey != "" {
+		providersConfig.WebPush = &providers.WebPushConfig{
+			VAPIDPrivateKey: vapidKey,
+			Subject:         getEnvOrDefault("VAPID_SUBJECT", "mailto:noreply@clubland.com"),
+		}
+	}
+
+	// Secondary providers take traffic when the primary is throttled or failing
+	if host := os.Getenv("SMTP_FAILOVER_HOST"); host != "" {
+		providersConfig.EmailFailover = append(providersConfig.EmailFailover, &providers.EmailConfig{
+			SMTPHost:     host,
+			SMTPPort:     getEnvOrDefault("SMTP_FAILOVER_PORT", "587"),
+			SMTPUsername: getEnvOrDefault("SMTP_FAILOVER_USERNAME", ""),
+			SMTPPassword: getEnvOrDefault("SMTP_FAILOVER_PASSWORD", ""),
+			FromEmail:    getEnvOrDefault("FROM_EMAIL", "noreply@clubland.com"),
+			Routing:      providers.RoutingConfig{Name: "smtp-failover"},
+		})
+	}
+	if accountSID := os.Getenv("TWILIO_FAILOVER_ACCOUNT_SID"); accountSID != "" {
+		providersConfig.SMSFailover = append(providersConfig.SMSFailover, &providers.SMSConfig{
+			AccountSID:        accountSID,
+			AuthToken:         getEnvOrDefault("TWILIO_FAILOVER_AUTH_TOKEN", ""),
+			FromNumber:        getEnvOrDefault("TWILIO_FAILOVER_FROM_NUMBER", ""),
+			StatusCallbackURL: getEnvOrDefault("TWILIO_STATUS_CALLBACK_URL", ""),
+			Routing:           providers.RoutingConfig{Name: "twilio-failover"},
+		})
 	}
 
 	notificationProviders := providers.NewNotificationProviders(providersConfig, logger)
@@ -124,8 +177,37 @@ func main() {
 	// Initialize service
 	notificationService := service.NewService(repo, notificationProviders, logger, messageBus, monitor)
 
+	// Deliver notifications with bounded per-channel worker pools
+	if err := notificationService.StartDispatcher(service.DefaultDispatcherConfig()); err != nil {
+		logger.Fatal("Failed to start notification dispatcher", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// Turn domain events from other services into notifications
+	if err := notificationService.StartEventRules(); err != nil {
+		logger.Fatal("Failed to start notification rules", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// Queue domain events for the clubs' webhook subscriptions
+	if err := notificationService.StartWebhookSubscriptions(); err != nil {
+		logger.Fatal("Failed to start webhook subscriptions", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// Wake inbox streams on this replica when another replica changes their inbox
+	if err := notificationService.StartInboxFanout(); err != nil {
+		logger.Fatal("Failed to start inbox fan-out", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
 	// Initialize HTTP handlers
 	httpHandler := httpHandlers.NewHTTPHandler(notificationService, logger, monitor)
+	httpHandler.SetAuthProvider(auth.NewJWTProvider(&cfg.Auth, logger))
 
 	// Initialize gRPC handlers
 	grpcHandler := grpcHandlers.NewGRPCHandler(notificationService, logger, monitor)
@@ -135,6 +217,8 @@ func main() {
 		Addr:    fmt.Sprintf(":%d", cfg.Service.Port),
 		Handler: httpHandler.SetupRoutes(),
 	}
+	// Inbox streams never finish on their own, so end them when shutting down
+	httpServer.RegisterOnShutdown(notificationService.CloseInboxStreams)
 
 	go func() {
 		logger.Info("HTTP server listening", map[string]interface{}{
@@ -194,6 +278,13 @@ func main() {
 	// Stop gRPC server
 	grpcServer.GracefulStop()
 
+	// Let in-flight deliveries finish; unfinished claims expire and are picked up by another replica
+	if err := notificationService.StopDispatcher(shutdownCtx); err != nil {
+		logger.Error("Dispatcher shutdown error", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
 	// Close database connection
 	db.Close()
 
@@ -3,6 +3,7 @@ module reciprocal-clubs-backend/services/notification-service
 go 1.25
 
 require (
+	github.com/golang-jwt/jwt/v5 v5.2.0
 	github.com/gorilla/mux v1.8.1
 	github.com/stretchr/testify v1.11.1
 	golang.org/x/time v0.13.0
@@ -10,6 +11,7 @@ require (
 	google.golang.org/protobuf v1.36.9
 	gorm.io/driver/sqlite v1.6.0
 	gorm.io/gorm v1.31.0
+	reciprocal-clubs-backend/pkg/shared/auth v0.0.0
 	reciprocal-clubs-backend/pkg/shared/config v0.0.0
 	reciprocal-clubs-backend/pkg/shared/database v0.0.0
 	reciprocal-clubs-backend/pkg/shared/logging v0.0.0
@@ -27,6 +29,7 @@ require (
 	go.yaml.in/yaml/v2 v2.4.2 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
+	reciprocal-clubs-backend/pkg/shared/errors v0.0.0-00010101000000-000000000000 // indirect
 )
 
 require (
@@ -243,8 +243,16 @@ func (h *GRPCHandler) SendImmediate(ctx context.Context, req *pb.SendImmediateRe
 		}, nil
 	}
 
-	// Process immediately
-	go h.service.ProcessNotification(ctx, notification.ID)
+	// Deliver now rather than waiting for the dispatcher
+	if err := h.service.ProcessNotification(ctx, notification.ID); err != nil {
+		h.logger.Warn("Immediate delivery failed", map[string]interface{}{
+			"error":           err.Error(),
+			"notification_id": notification.ID,
+		})
+	}
+	if delivered, err := h.service.GetNotificationByID(ctx, notification.ID); err == nil {
+		notification = delivered
+	}
 
 	return &pb.SendResponse{
 		Success:      true,
@@ -258,10 +266,11 @@ func (h *GRPCHandler) CreateTemplate(ctx context.Context, req *pb.CreateTemplate
 	h.monitoring.RecordBusinessEvent("grpc_create_template", "notification")
 
 	serviceReq := &service.CreateTemplateRequest{
-		ClubID: uint(req.ClubId),
-		Name:   req.Name,
-		Type:   h.convertNotificationType(req.Type),
-		// Note: service layer has different structure
+		ClubID:  uint(req.ClubId),
+		Name:    req.Name,
+		Type:    h.convertNotificationType(req.Type),
+		Subject: req.SubjectTemplate,
+		Body:    req.BodyTemplate,
 	}
 
 	template, err := h.service.CreateNotificationTemplate(ctx, serviceReq)
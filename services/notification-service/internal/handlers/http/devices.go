@@ -0,0 +1,99 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/service"
+)
+
+// Device handlers
+
+func (h *HTTPHandler) registerDevice(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	var req service.RegisterDeviceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	device, err := h.service.RegisterDevice(r.Context(), user.clubID, user.userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to register device", map[string]interface{}{
+			"error":    err.Error(),
+			"user_id":  user.userID,
+			"club_id":  user.clubID,
+			"platform": req.Platform,
+		})
+		h.writeServiceError(w, err, "Failed to register device")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, device)
+}
+
+func (h *HTTPHandler) getDevices(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	devices, err := h.service.GetDevices(r.Context(), user.clubID, user.userID)
+	if err != nil {
+		h.logger.Error("Failed to get devices", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.userID,
+			"club_id": user.clubID,
+		})
+		h.writeServiceError(w, err, "Failed to get devices")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, devices)
+}
+
+func (h *HTTPHandler) unregisterDevice(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid device ID")
+		return
+	}
+
+	if err := h.service.UnregisterDevice(r.Context(), user.clubID, user.userID, uint(id)); err != nil {
+		h.logger.Error("Failed to unregister device", map[string]interface{}{
+			"error":   err.Error(),
+			"id":      id,
+			"user_id": user.userID,
+		})
+		h.writeServiceError(w, err, "Failed to unregister device")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getWebPushKey returns the VAPID public key browsers subscribe with
+func (h *HTTPHandler) getWebPushKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := h.service.WebPushPublicKey()
+	if !ok {
+		h.writeError(w, http.StatusNotFound, "Web Push is not configured")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, map[string]string{
+		"public_key": key,
+	})
+}
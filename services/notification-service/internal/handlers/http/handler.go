@@ -1,22 +1,31 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
 	"reciprocal-clubs-backend/services/notification-service/internal/service"
+	"reciprocal-clubs-backend/services/notification-service/internal/templating"
 )
 
 // HTTPHandler handles HTTP requests for notification service
 type HTTPHandler struct {
 	service    *service.NotificationService
+	auth       auth.AuthProvider
 	logger     logging.Logger
 	monitoring monitoring.MonitoringInterface
 }
@@ -30,6 +39,12 @@ func NewHTTPHandler(service *service.NotificationService, logger logging.Logger,
 	}
 }
 
+// SetAuthProvider sets the provider validating the bearer tokens inbox and
+// device requests are made with; without one those endpoints are unavailable
+func (h *HTTPHandler) SetAuthProvider(provider auth.AuthProvider) {
+	h.auth = provider
+}
+
 // SetupRoutes configures the HTTP routes
 func (h *HTTPHandler) SetupRoutes() http.Handler {
 	router := mux.NewRouter()
@@ -45,14 +60,18 @@ func (h *HTTPHandler) SetupRoutes() http.Handler {
 
 	// Notification routes
 	api.HandleFunc("/notifications", h.createNotification).Methods("POST")
+	api.HandleFunc("/notifications/from-template", h.createNotificationFromTemplate).Methods("POST")
 	api.HandleFunc("/notifications/{id}", h.getNotification).Methods("GET")
 	api.HandleFunc("/notifications/{id}/read", h.markAsRead).Methods("POST")
+	api.HandleFunc("/notifications/{id}/render", h.renderNotification).Methods("GET")
 	api.HandleFunc("/clubs/{clubId}/notifications", h.getClubNotifications).Methods("GET")
 	api.HandleFunc("/users/{userId}/notifications", h.getUserNotifications).Methods("GET")
 
 	// Template routes
 	api.HandleFunc("/templates", h.createTemplate).Methods("POST")
 	api.HandleFunc("/clubs/{clubId}/templates", h.getClubTemplates).Methods("GET")
+	api.HandleFunc("/templates/{id}/preview", h.previewTemplate).Methods("POST")
+	api.HandleFunc("/templates/{id}/versions", h.getTemplateVersions).Methods("GET")
 
 	// Stats routes
 	api.HandleFunc("/clubs/{clubId}/stats", h.getNotificationStats).Methods("GET")
@@ -64,6 +83,44 @@ func (h *HTTPHandler) SetupRoutes() http.Handler {
 	admin.HandleFunc("/notifications/bulk", h.markMultipleAsRead).Methods("POST")
 	admin.HandleFunc("/templates/{id}", h.updateTemplate).Methods("PUT")
 	admin.HandleFunc("/templates/{id}", h.deleteTemplate).Methods("DELETE")
+	admin.HandleFunc("/rules", h.createRule).Methods("POST")
+	admin.HandleFunc("/rules/{id}", h.getRule).Methods("GET")
+	admin.HandleFunc("/rules/{id}", h.updateRule).Methods("PUT")
+	admin.HandleFunc("/rules/{id}", h.deleteRule).Methods("DELETE")
+	admin.HandleFunc("/clubs/{clubId}/rules", h.getClubRules).Methods("GET")
+	admin.HandleFunc("/events/dead-letters", h.getEventDeadLetters).Methods("GET")
+	admin.HandleFunc("/events/dead-letters/{id}/replay", h.replayEventDeadLetter).Methods("POST")
+	admin.HandleFunc("/suppressions", h.getSuppressedRecipients).Methods("GET")
+	admin.HandleFunc("/suppressions/{id}", h.deleteSuppressedRecipient).Methods("DELETE")
+	admin.HandleFunc("/webhooks", h.createWebhookSubscription).Methods("POST")
+	admin.HandleFunc("/webhooks/{id}", h.getWebhookSubscription).Methods("GET")
+	admin.HandleFunc("/webhooks/{id}", h.updateWebhookSubscription).Methods("PUT")
+	admin.HandleFunc("/webhooks/{id}", h.deleteWebhookSubscription).Methods("DELETE")
+	admin.HandleFunc("/webhooks/{id}/rotate-secret", h.rotateWebhookSecret).Methods("POST")
+	admin.HandleFunc("/webhooks/{id}/deliveries", h.getWebhookDeliveries).Methods("GET")
+	admin.HandleFunc("/webhook-deliveries/{id}", h.getWebhookDelivery).Methods("GET")
+	admin.HandleFunc("/webhook-deliveries/{id}/redeliver", h.redeliverWebhook).Methods("POST")
+	admin.HandleFunc("/clubs/{clubId}/webhooks", h.getClubWebhookSubscriptions).Methods("GET")
+
+	// Provider delivery receipt webhooks, authenticated by their signatures
+	api.HandleFunc("/webhooks/sms/status", h.smsStatusCallback).Methods("POST")
+	api.HandleFunc("/webhooks/email/events", h.emailReceipts).Methods("POST")
+	api.HandleFunc("/webhooks/push/events", h.pushReceipts).Methods("POST")
+
+	// Inbox routes, for the user the bearer token was issued to
+	api.HandleFunc("/inbox", h.getInbox).Methods("GET")
+	api.HandleFunc("/inbox/unread-count", h.getUnreadCount).Methods("GET")
+	api.HandleFunc("/inbox/read", h.markInboxRead).Methods("POST")
+	api.HandleFunc("/inbox/archive", h.archiveInbox).Methods("POST")
+	api.HandleFunc("/inbox/stream", h.streamInbox).Methods("GET")
+	api.HandleFunc("/inbox/{id}/pin", h.pinInboxNotification).Methods("PUT")
+	api.HandleFunc("/inbox/{id}/pin", h.unpinInboxNotification).Methods("DELETE")
+
+	// Push device routes, for the user the bearer token was issued to
+	api.HandleFunc("/devices", h.registerDevice).Methods("POST")
+	api.HandleFunc("/devices", h.getDevices).Methods("GET")
+	api.HandleFunc("/devices/web-push-key", h.getWebPushKey).Methods("GET")
+	api.HandleFunc("/devices/{id}", h.unregisterDevice).Methods("DELETE")
 
 	// User preferences routes
 	api.HandleFunc("/users/{userId}/preferences", h.getUserPreferences).Methods("GET")
@@ -137,6 +194,47 @@ func (h *HTTPHandler) createNotification(w http.ResponseWriter, r *http.Request)
 	h.writeJSON(w, http.StatusCreated, notification)
 }
 
+func (h *HTTPHandler) createNotificationFromTemplate(w http.ResponseWriter, r *http.Request) {
+	var req service.CreateNotificationFromTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	notification, err := h.service.CreateNotificationFromTemplate(r.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create notification from template", map[string]interface{}{
+			"error":         err.Error(),
+			"template_name": req.TemplateName,
+		})
+		h.writeServiceError(w, err, "Failed to create notification")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, notification)
+}
+
+func (h *HTTPHandler) renderNotification(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	rendered, err := h.service.RenderNotification(r.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to render notification", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		})
+		h.writeServiceError(w, err, "Failed to render notification")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, rendered)
+}
+
 func (h *HTTPHandler) getNotification(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id, err := strconv.ParseUint(vars["id"], 10, 32)
@@ -282,7 +380,7 @@ func (h *HTTPHandler) createTemplate(w http.ResponseWriter, r *http.Request) {
 		h.logger.Error("Failed to create template", map[string]interface{}{
 			"error": err.Error(),
 		})
-		h.writeError(w, http.StatusInternalServerError, "Failed to create template")
+		h.writeServiceError(w, err, "Failed to create template")
 		return
 	}
 
@@ -310,6 +408,56 @@ func (h *HTTPHandler) getClubTemplates(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, templates)
 }
 
+func (h *HTTPHandler) previewTemplate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid template ID")
+		return
+	}
+
+	var req service.PreviewTemplateRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.writeError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	preview, err := h.service.PreviewTemplate(r.Context(), uint(id), &req)
+	if err != nil {
+		h.logger.Error("Failed to preview template", map[string]interface{}{
+			"error":       err.Error(),
+			"template_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to preview template")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, preview)
+}
+
+func (h *HTTPHandler) getTemplateVersions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid template ID")
+		return
+	}
+
+	versions, err := h.service.GetNotificationTemplateVersions(r.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to get template versions", map[string]interface{}{
+			"error":       err.Error(),
+			"template_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to get template versions")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, versions)
+}
+
 // Stats handlers
 
 func (h *HTTPHandler) getNotificationStats(w http.ResponseWriter, r *http.Request) {
@@ -368,6 +516,76 @@ func (h *HTTPHandler) writeError(w http.ResponseWriter, status int, message stri
 	})
 }
 
+// writeServiceError maps service errors onto HTTP status codes, exposing the
+// error text only for client errors
+func (h *HTTPHandler) writeServiceError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, service.ErrTemplateNotFound):
+		h.writeError(w, http.StatusNotFound, "Not found")
+	case errors.Is(err, service.ErrTemplateVariantExists):
+		h.writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrInvalidSignature):
+		h.writeError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, service.ErrValidation),
+		errors.Is(err, templating.ErrInvalidTemplate),
+		errors.Is(err, templating.ErrInvalidVariables):
+		h.writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// requestUser identifies the user an inbox or device request is made for
+type requestUser struct {
+	clubID uint
+	userID string
+}
+
+// authenticateUser resolves the user a request is made for from its bearer
+// token, writing the error response when it cannot be
+func (h *HTTPHandler) authenticateUser(w http.ResponseWriter, r *http.Request, allowQueryToken bool) (requestUser, bool) {
+	if h.auth == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "User authentication is not configured")
+		return requestUser{}, false
+	}
+
+	token := ""
+	if header := r.Header.Get("Authorization"); header != "" {
+		scheme, value, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "bearer") {
+			h.writeError(w, http.StatusUnauthorized, "Invalid authorization header format")
+			return requestUser{}, false
+		}
+		token = value
+	} else if allowQueryToken {
+		token = r.URL.Query().Get("access_token")
+	}
+	if token == "" {
+		h.writeError(w, http.StatusUnauthorized, "Authorization header required")
+		return requestUser{}, false
+	}
+
+	claims, err := h.auth.ValidateToken(token)
+	if err != nil {
+		h.logger.Warn("User authentication failed", map[string]interface{}{
+			"error": err.Error(),
+			"path":  r.URL.Path,
+		})
+		h.writeError(w, http.StatusUnauthorized, "Invalid token")
+		return requestUser{}, false
+	}
+
+	return userFromClaims(claims), true
+}
+
+// userFromClaims identifies the user a token was issued to
+func userFromClaims(claims *auth.Claims) requestUser {
+	return requestUser{
+		clubID: claims.ClubID,
+		userID: strconv.FormatUint(uint64(claims.UserID), 10),
+	}
+}
+
 // Middleware
 
 func (h *HTTPHandler) loggingMiddleware(next http.Handler) http.Handler {
@@ -412,6 +630,11 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer to flush streams
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Admin handlers
 
 func (h *HTTPHandler) processPendingNotifications(w http.ResponseWriter, r *http.Request) {
@@ -478,18 +701,23 @@ func (h *HTTPHandler) updateTemplate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var updates map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+	var req service.UpdateTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	// For simplicity, this is a placeholder - would need proper update logic
-	h.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"id":      id,
-		"message": "Template update not fully implemented yet",
-		"updates": updates,
-	})
+	template, err := h.service.UpdateNotificationTemplate(r.Context(), uint(id), &req)
+	if err != nil {
+		h.logger.Error("Failed to update template", map[string]interface{}{
+			"error":       err.Error(),
+			"template_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to update template")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, template)
 }
 
 func (h *HTTPHandler) deleteTemplate(w http.ResponseWriter, r *http.Request) {
@@ -507,6 +735,256 @@ func (h *HTTPHandler) deleteTemplate(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// Notification rule handlers
+
+func (h *HTTPHandler) createRule(w http.ResponseWriter, r *http.Request) {
+	var req service.CreateRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	rule, err := h.service.CreateNotificationRule(r.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create notification rule", map[string]interface{}{
+			"error": err.Error(),
+		})
+		h.writeServiceError(w, err, "Failed to create rule")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, rule)
+}
+
+func (h *HTTPHandler) getRule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid rule ID")
+		return
+	}
+
+	rule, err := h.service.GetNotificationRule(r.Context(), uint(id))
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to get rule")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, rule)
+}
+
+func (h *HTTPHandler) getClubRules(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, err := strconv.ParseUint(vars["clubId"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid club ID")
+		return
+	}
+
+	rules, err := h.service.GetNotificationRulesByClub(r.Context(), uint(clubID))
+	if err != nil {
+		h.logger.Error("Failed to get club rules", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		h.writeError(w, http.StatusInternalServerError, "Failed to get rules")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, rules)
+}
+
+func (h *HTTPHandler) updateRule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid rule ID")
+		return
+	}
+
+	var req service.UpdateRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	rule, err := h.service.UpdateNotificationRule(r.Context(), uint(id), &req)
+	if err != nil {
+		h.logger.Error("Failed to update notification rule", map[string]interface{}{
+			"error":   err.Error(),
+			"rule_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to update rule")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, rule)
+}
+
+func (h *HTTPHandler) deleteRule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid rule ID")
+		return
+	}
+
+	if err := h.service.DeleteNotificationRule(r.Context(), uint(id)); err != nil {
+		h.writeServiceError(w, err, "Failed to delete rule")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) getEventDeadLetters(w http.ResponseWriter, r *http.Request) {
+	limit := 50
+	offset := 0
+
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+	includeReplayed := r.URL.Query().Get("include_replayed") == "true"
+
+	deadLetters, err := h.service.GetEventDeadLetters(r.Context(), includeReplayed, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get event dead letters", map[string]interface{}{
+			"error": err.Error(),
+		})
+		h.writeError(w, http.StatusInternalServerError, "Failed to get dead letters")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, deadLetters)
+}
+
+func (h *HTTPHandler) replayEventDeadLetter(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid dead letter ID")
+		return
+	}
+
+	result, err := h.service.ReplayEventDeadLetter(r.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to replay dead-lettered event", map[string]interface{}{
+			"error":          err.Error(),
+			"dead_letter_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to replay event")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+func (h *HTTPHandler) getSuppressedRecipients(w http.ResponseWriter, r *http.Request) {
+	limit := 50
+	offset := 0
+
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+	notificationType := models.NotificationType(r.URL.Query().Get("type"))
+
+	suppressions, err := h.service.GetSuppressedRecipients(r.Context(), notificationType, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get suppressed recipients", map[string]interface{}{
+			"error": err.Error(),
+		})
+		h.writeError(w, http.StatusInternalServerError, "Failed to get suppressed recipients")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, suppressions)
+}
+
+func (h *HTTPHandler) deleteSuppressedRecipient(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid suppression ID")
+		return
+	}
+
+	if err := h.service.DeleteSuppressedRecipient(r.Context(), uint(id)); err != nil {
+		h.logger.Error("Failed to delete suppressed recipient", map[string]interface{}{
+			"error":          err.Error(),
+			"suppression_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to delete suppressed recipient")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Delivery receipt webhook handlers
+
+func (h *HTTPHandler) smsStatusCallback(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid form body")
+		return
+	}
+
+	// Twilio signs the URL it posted to, so rebuild it as the caller saw it
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	callbackURL := scheme + "://" + r.Host + r.URL.RequestURI()
+
+	result, err := h.service.HandleSMSStatusCallback(r.Context(), callbackURL, r.PostForm, r.Header.Get("X-Twilio-Signature"))
+	if err != nil {
+		h.logger.Warn("Failed to handle SMS status callback", map[string]interface{}{
+			"error": err.Error(),
+		})
+		h.writeServiceError(w, err, "Failed to handle status callback")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+func (h *HTTPHandler) emailReceipts(w http.ResponseWriter, r *http.Request) {
+	h.handleReceiptWebhook(w, r, h.service.HandleEmailReceipts)
+}
+
+func (h *HTTPHandler) pushReceipts(w http.ResponseWriter, r *http.Request) {
+	h.handleReceiptWebhook(w, r, h.service.HandlePushReceipts)
+}
+
+// handleReceiptWebhook passes a signed receipt webhook body to the service
+func (h *HTTPHandler) handleReceiptWebhook(w http.ResponseWriter, r *http.Request, handle func(ctx context.Context, payload []byte, signature string) (*service.ReceiptResult, error)) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	result, err := handle(r.Context(), payload, r.Header.Get("X-Webhook-Signature"))
+	if err != nil {
+		h.logger.Warn("Failed to handle delivery receipts", map[string]interface{}{
+			"error": err.Error(),
+			"path":  r.URL.Path,
+		})
+		h.writeServiceError(w, err, "Failed to handle delivery receipts")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
 // User preferences handlers
 
 func (h *HTTPHandler) getUserPreferences(w http.ResponseWriter, r *http.Request) {
@@ -525,18 +1003,18 @@ func (h *HTTPHandler) getUserPreferences(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// This would call a service method that doesn't exist yet
-	h.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"user_id":         userID,
-		"club_id":         clubID,
-		"email_enabled":   true,
-		"sms_enabled":     true,
-		"push_enabled":    true,
-		"in_app_enabled":  true,
-		"timezone":        "UTC",
-		"preferred_lang":  "en",
-		"message":         "User preferences endpoint - implementation pending",
-	})
+	preferences, err := h.service.GetUserPreferences(r.Context(), userID, uint(clubID))
+	if err != nil {
+		h.logger.Error("Failed to get user preferences", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		h.writeServiceError(w, err, "Failed to get user preferences")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, preferences)
 }
 
 func (h *HTTPHandler) updateUserPreferences(w http.ResponseWriter, r *http.Request) {
@@ -555,19 +1033,24 @@ func (h *HTTPHandler) updateUserPreferences(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	var prefs map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&prefs); err != nil {
+	var req service.UpdateUserPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	// This would call a service method that doesn't exist yet
-	h.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"user_id":     userID,
-		"club_id":     clubID,
-		"preferences": prefs,
-		"message":     "User preferences update endpoint - implementation pending",
-	})
+	preferences, err := h.service.UpdateUserPreferences(r.Context(), userID, uint(clubID), &req)
+	if err != nil {
+		h.logger.Error("Failed to update user preferences", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		h.writeServiceError(w, err, "Failed to update user preferences")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, preferences)
 }
 
 // Bulk operations handlers
@@ -630,8 +1113,16 @@ func (h *HTTPHandler) sendImmediate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Process immediately
-	go h.service.ProcessNotification(r.Context(), notification.ID)
+	// Deliver now rather than waiting for the dispatcher
+	if err := h.service.ProcessNotification(r.Context(), notification.ID); err != nil {
+		h.logger.Warn("Immediate delivery failed", map[string]interface{}{
+			"error":           err.Error(),
+			"notification_id": notification.ID,
+		})
+	}
+	if delivered, err := h.service.GetNotificationByID(r.Context(), notification.ID); err == nil {
+		notification = delivered
+	}
 
 	h.writeJSON(w, http.StatusCreated, map[string]interface{}{
 		"success":      true,
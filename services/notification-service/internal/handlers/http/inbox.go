@@ -0,0 +1,312 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/service"
+)
+
+// inboxStreamRetry is how long clients wait before reconnecting a dropped stream
+const inboxStreamRetry = 3 * time.Second
+
+// inboxStreamHeartbeat keeps idle streams from being closed by proxies
+const inboxStreamHeartbeat = 25 * time.Second
+
+// Inbox handlers
+
+func (h *HTTPHandler) getInbox(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	query := service.InboxQuery{
+		UnreadOnly: r.URL.Query().Get("unread") == "true",
+		Archived:   r.URL.Query().Get("archived") == "true",
+		Limit:      50,
+	}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			query.Limit = l
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			query.Offset = o
+		}
+	}
+
+	page, err := h.service.GetInbox(r.Context(), user.clubID, user.userID, query)
+	if err != nil {
+		h.logger.Error("Failed to get inbox", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.userID,
+			"club_id": user.clubID,
+		})
+		h.writeServiceError(w, err, "Failed to get inbox")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, page)
+}
+
+func (h *HTTPHandler) getUnreadCount(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	count, err := h.service.GetUnreadCount(r.Context(), user.clubID, user.userID)
+	if err != nil {
+		h.logger.Error("Failed to count unread notifications", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.userID,
+			"club_id": user.clubID,
+		})
+		h.writeServiceError(w, err, "Failed to count unread notifications")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"unread_count": count,
+	})
+}
+
+func (h *HTTPHandler) markInboxRead(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	// Without notification IDs every notification in the inbox is marked as read
+	var req struct {
+		NotificationIDs []uint `json:"notification_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	update, err := h.service.MarkInboxRead(r.Context(), user.clubID, user.userID, req.NotificationIDs)
+	if err != nil {
+		h.logger.Error("Failed to mark inbox notifications as read", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.userID,
+			"club_id": user.clubID,
+		})
+		h.writeServiceError(w, err, "Failed to mark notifications as read")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, update)
+}
+
+func (h *HTTPHandler) archiveInbox(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		NotificationIDs []uint `json:"notification_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	update, err := h.service.ArchiveInboxNotifications(r.Context(), user.clubID, user.userID, req.NotificationIDs)
+	if err != nil {
+		h.logger.Error("Failed to archive inbox notifications", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.userID,
+			"club_id": user.clubID,
+		})
+		h.writeServiceError(w, err, "Failed to archive notifications")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, update)
+}
+
+func (h *HTTPHandler) pinInboxNotification(w http.ResponseWriter, r *http.Request) {
+	h.setInboxPinned(w, r, true)
+}
+
+func (h *HTTPHandler) unpinInboxNotification(w http.ResponseWriter, r *http.Request) {
+	h.setInboxPinned(w, r, false)
+}
+
+func (h *HTTPHandler) setInboxPinned(w http.ResponseWriter, r *http.Request, pinned bool) {
+	user, ok := h.authenticateUser(w, r, false)
+	if !ok {
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid notification ID")
+		return
+	}
+
+	notification, err := h.service.PinInboxNotification(r.Context(), user.clubID, user.userID, uint(id), pinned)
+	if err != nil {
+		h.logger.Error("Failed to pin inbox notification", map[string]interface{}{
+			"error":   err.Error(),
+			"id":      id,
+			"user_id": user.userID,
+		})
+		h.writeServiceError(w, err, "Failed to pin notification")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, notification)
+}
+
+// streamInbox streams a user's inbox changes as server-sent events. Each event
+// is named after its type and carries the user's unread count; clients that
+// reconnect with the Last-Event-ID header (or last_event_id parameter) are
+// sent the changes they missed first. Browsers' EventSource cannot set
+// headers, so the token may also be passed as the access_token parameter.
+func (h *HTTPHandler) streamInbox(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.authenticateUser(w, r, true)
+	if !ok {
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+	var after uint
+	if lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 32)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "Invalid Last-Event-ID")
+			return
+		}
+		after = uint(id)
+	}
+
+	// Subscribe before reading so no change made in between is missed
+	subscription := h.service.SubscribeInbox(user.clubID, user.userID)
+	defer subscription.Close()
+
+	ctx := r.Context()
+	var snapshot *models.InboxEvent
+	if lastEventID == "" {
+		latest, err := h.service.LatestInboxEventID(ctx, user.clubID, user.userID)
+		if err == nil {
+			var unread int64
+			unread, err = h.service.GetUnreadCount(ctx, user.clubID, user.userID)
+			snapshot = &models.InboxEvent{ID: latest, ClubID: user.clubID, UserID: user.userID, Type: "unread_count", UnreadCount: unread}
+		}
+		if err != nil {
+			h.logger.Error("Failed to open inbox stream", map[string]interface{}{
+				"error":   err.Error(),
+				"user_id": user.userID,
+				"club_id": user.clubID,
+			})
+			h.writeServiceError(w, err, "Failed to open inbox stream")
+			return
+		}
+		after = latest
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+	fmt.Fprintf(w, "retry: %d\n\n", inboxStreamRetry.Milliseconds())
+	if snapshot != nil {
+		writeInboxEvent(w, snapshot)
+	}
+	if err := controller.Flush(); err != nil {
+		h.logger.Error("Inbox stream cannot be flushed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	metrics := h.service.GetMetrics()
+	metrics.InboxStreamOpened()
+	defer metrics.InboxStreamClosed()
+
+	heartbeat := time.NewTicker(inboxStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	var err error
+	for {
+		if after, err = h.writeInboxEvents(ctx, w, user, after); err != nil {
+			if ctx.Err() == nil {
+				h.logger.Warn("Inbox stream ended", map[string]interface{}{
+					"error":   err.Error(),
+					"user_id": user.userID,
+				})
+			}
+			return
+		}
+		if err := controller.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-subscription.Done():
+			return
+		case <-subscription.C:
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+	}
+}
+
+// writeInboxEvents writes the user's inbox events after the given ID and
+// returns the ID of the last one written
+func (h *HTTPHandler) writeInboxEvents(ctx context.Context, w io.Writer, user requestUser, after uint) (uint, error) {
+	for {
+		events, err := h.service.InboxEventsSince(ctx, user.clubID, user.userID, after)
+		if err != nil {
+			return after, err
+		}
+
+		for i := range events {
+			if err := writeInboxEvent(w, &events[i]); err != nil {
+				return after, err
+			}
+			after = events[i].ID
+		}
+
+		if len(events) < service.InboxEventBatch {
+			return after, nil
+		}
+	}
+}
+
+// writeInboxEvent writes an inbox event in the server-sent events format
+func writeInboxEvent(w io.Writer, event *models.InboxEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
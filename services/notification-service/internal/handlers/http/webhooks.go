@@ -0,0 +1,216 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/service"
+)
+
+// Webhook subscription handlers
+
+func (h *HTTPHandler) createWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	var req service.CreateWebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	created, err := h.service.CreateWebhookSubscription(r.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create webhook subscription", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": req.ClubID,
+		})
+		h.writeServiceError(w, err, "Failed to create webhook subscription")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, created)
+}
+
+func (h *HTTPHandler) getWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid webhook subscription ID")
+		return
+	}
+
+	subscription, err := h.service.GetWebhookSubscription(r.Context(), uint(id))
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to get webhook subscription")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, subscription)
+}
+
+func (h *HTTPHandler) getClubWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, err := strconv.ParseUint(vars["clubId"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid club ID")
+		return
+	}
+
+	subscriptions, err := h.service.GetWebhookSubscriptionsByClub(r.Context(), uint(clubID))
+	if err != nil {
+		h.logger.Error("Failed to get club webhook subscriptions", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		h.writeError(w, http.StatusInternalServerError, "Failed to get webhook subscriptions")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, subscriptions)
+}
+
+func (h *HTTPHandler) updateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid webhook subscription ID")
+		return
+	}
+
+	var req service.UpdateWebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	subscription, err := h.service.UpdateWebhookSubscription(r.Context(), uint(id), &req)
+	if err != nil {
+		h.logger.Error("Failed to update webhook subscription", map[string]interface{}{
+			"error":           err.Error(),
+			"subscription_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to update webhook subscription")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, subscription)
+}
+
+func (h *HTTPHandler) deleteWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid webhook subscription ID")
+		return
+	}
+
+	if err := h.service.DeleteWebhookSubscription(r.Context(), uint(id)); err != nil {
+		h.writeServiceError(w, err, "Failed to delete webhook subscription")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) rotateWebhookSecret(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid webhook subscription ID")
+		return
+	}
+
+	// The body is optional
+	var req service.RotateWebhookSecretRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	rotated, err := h.service.RotateWebhookSecret(r.Context(), uint(id), &req)
+	if err != nil {
+		h.logger.Error("Failed to rotate webhook secret", map[string]interface{}{
+			"error":           err.Error(),
+			"subscription_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to rotate webhook secret")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, rotated)
+}
+
+func (h *HTTPHandler) getWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid webhook subscription ID")
+		return
+	}
+
+	query := &service.WebhookDeliveryQuery{
+		Status:    models.WebhookDeliveryStatus(r.URL.Query().Get("status")),
+		EventType: r.URL.Query().Get("event_type"),
+		Limit:     50,
+	}
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 100 {
+		query.Limit = l
+	}
+	if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o >= 0 {
+		query.Offset = o
+	}
+
+	deliveries, err := h.service.GetWebhookDeliveries(r.Context(), uint(id), query)
+	if err != nil {
+		h.logger.Error("Failed to get webhook deliveries", map[string]interface{}{
+			"error":           err.Error(),
+			"subscription_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to get webhook deliveries")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, deliveries)
+}
+
+func (h *HTTPHandler) getWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid webhook delivery ID")
+		return
+	}
+
+	delivery, err := h.service.GetWebhookDelivery(r.Context(), uint(id))
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to get webhook delivery")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, delivery)
+}
+
+func (h *HTTPHandler) redeliverWebhook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid webhook delivery ID")
+		return
+	}
+
+	delivery, err := h.service.RedeliverWebhook(r.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to redeliver webhook", map[string]interface{}{
+			"error":       err.Error(),
+			"delivery_id": id,
+		})
+		h.writeServiceError(w, err, "Failed to redeliver webhook")
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, delivery)
+}
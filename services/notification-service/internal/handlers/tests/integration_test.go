@@ -1,11 +1,17 @@
 package tests
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,6 +26,8 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 
+	"reciprocal-clubs-backend/pkg/shared/auth"
+	"reciprocal-clubs-backend/pkg/shared/config"
 	"reciprocal-clubs-backend/pkg/shared/logging"
 	"reciprocal-clubs-backend/pkg/shared/messaging"
 	"reciprocal-clubs-backend/pkg/shared/monitoring"
@@ -46,19 +54,34 @@ type NotificationIntegrationTestSuite struct {
 	grpcConn    *grpc.ClientConn
 	listener    *bufconn.Listener
 	httpServer  *httptest.Server
+	messageBus  *MockMessageBus
+	auth        *auth.JWTProvider
+	providers   *providers.NotificationProviders
 }
 
 func (suite *NotificationIntegrationTestSuite) SetupSuite() {
-	// Setup in-memory database
-	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	// Setup database; a file rather than :memory:, where every pooled
+	// connection would open a new, empty database
+	dsn := filepath.Join(suite.T().TempDir(), "notifications.db") + "?_busy_timeout=5000"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
 	suite.Require().NoError(err)
 
 	// Migrate the schema
 	err = db.AutoMigrate(
 		&models.Notification{},
 		&models.NotificationTemplate{},
+		&models.NotificationTemplateVersion{},
 		&models.NotificationPreference{},
 		&models.UserPreferences{},
+		&models.NotificationRule{},
+		&models.ProcessedEvent{},
+		&models.EventDeadLetter{},
+		&models.SuppressedRecipient{},
+		&models.DigestPreference{},
+		&models.InboxEvent{},
+		&models.WebhookSubscription{},
+		&models.WebhookDelivery{},
+		&models.WebhookDeliveryAttempt{},
 	)
 	suite.Require().NoError(err)
 
@@ -68,14 +91,25 @@ func (suite *NotificationIntegrationTestSuite) SetupSuite() {
 	logger := &TestLogger{}
 	repo := repository.NewRepository(db, logger)
 	mockProviders := &providers.NotificationProviders{} // Use actual struct with nil providers for testing
+	suite.providers = mockProviders
 	mockMessaging := &MockMessageBus{}
+	suite.messageBus = mockMessaging
 	mockMonitor := &MockMonitor{}
 
 	// Initialize service
 	suite.service = service.NewService(repo, mockProviders, logger, mockMessaging, mockMonitor)
 
+	suite.Require().NoError(suite.service.StartEventRules())
+
 	// Initialize handlers
 	suite.httpHandler = httpHandlers.NewHTTPHandler(suite.service, logger, mockMonitor)
+	suite.auth = auth.NewJWTProvider(&config.AuthConfig{
+		JWTSecret:     "test-secret",
+		JWTExpiration: 3600,
+		Issuer:        "reciprocal-clubs",
+		Audience:      "reciprocal-clubs-api",
+	}, logger)
+	suite.httpHandler.SetAuthProvider(suite.auth)
 	suite.grpcHandler = grpcHandlers.NewGRPCHandler(suite.service, logger, mockMonitor)
 
 	// Setup gRPC server with in-memory connection
@@ -108,7 +142,18 @@ func (suite *NotificationIntegrationTestSuite) SetupTest() {
 	// Clean up before each test
 	suite.db.Exec("DELETE FROM notifications")
 	suite.db.Exec("DELETE FROM notification_templates")
+	suite.db.Exec("DELETE FROM notification_template_versions")
 	suite.db.Exec("DELETE FROM notification_preferences")
+	suite.db.Exec("DELETE FROM user_preferences")
+	suite.db.Exec("DELETE FROM notification_rules")
+	suite.db.Exec("DELETE FROM processed_events")
+	suite.db.Exec("DELETE FROM event_dead_letters")
+	suite.db.Exec("DELETE FROM suppressed_recipients")
+	suite.db.Exec("DELETE FROM digest_preferences")
+	suite.db.Exec("DELETE FROM inbox_events")
+	suite.db.Exec("DELETE FROM webhook_subscriptions")
+	suite.db.Exec("DELETE FROM webhook_deliveries")
+	suite.db.Exec("DELETE FROM webhook_delivery_attempts")
 }
 
 func (suite *NotificationIntegrationTestSuite) TearDownSuite() {
@@ -224,6 +269,906 @@ func (suite *NotificationIntegrationTestSuite) TestHTTP_CreateNotification_Succe
 	assert.NotNil(suite.T(), response["notification"])
 }
 
+// Test HTTP template rendering with locale fallback and re-rendering
+func (suite *NotificationIntegrationTestSuite) TestHTTP_CreateNotificationFromTemplate_Success() {
+	postJSON := func(path string, body interface{}) *http.Response {
+		jsonBody, err := json.Marshal(body)
+		suite.Require().NoError(err)
+		resp, err := http.Post(suite.httpServer.URL+path, "application/json", bytes.NewReader(jsonBody))
+		suite.Require().NoError(err)
+		return resp
+	}
+
+	for _, variant := range []map[string]interface{}{
+		{"locale": "en", "subject": "Booking confirmed", "body": "Hi {{.member_name}}, see you at {{.club}}."},
+		{"locale": "fr", "subject": "Réservation confirmée", "body": "Bonjour {{.member_name}}, à bientôt au {{.club}}."},
+	} {
+		variant["club_id"] = 1
+		variant["name"] = "booking_confirmed"
+		variant["type"] = "email"
+		variant["created_by_id"] = "admin1"
+		variant["variables"] = `{"member_name": {"type": "string", "required": true}, "club": "string"}`
+		resp := postJSON("/api/v1/templates", variant)
+		resp.Body.Close()
+		suite.Require().Equal(http.StatusCreated, resp.StatusCode)
+	}
+
+	suite.Require().NoError(suite.db.Create(&models.UserPreferences{
+		ClubID:        1,
+		UserID:        "user123",
+		PreferredLang: "fr-CA",
+	}).Error)
+
+	resp := postJSON("/api/v1/notifications/from-template", map[string]interface{}{
+		"club_id":       1,
+		"user_id":       "user123",
+		"template_name": "booking_confirmed",
+		"recipient":     "member@example.com",
+		"variables":     map[string]interface{}{"member_name": "Camille", "club": "Club <Nord>"},
+	})
+	defer resp.Body.Close()
+	suite.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	var notification models.Notification
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&notification))
+	assert.Equal(suite.T(), "Réservation confirmée", notification.Subject)
+	assert.Equal(suite.T(), "Bonjour Camille, à bientôt au Club <Nord>.", notification.Message)
+	assert.Contains(suite.T(), notification.HTMLMessage, "Club &lt;Nord&gt;")
+	assert.Equal(suite.T(), "fr", notification.TemplateLocale)
+	assert.Equal(suite.T(), 1, notification.TemplateVersion)
+
+	invalid := postJSON("/api/v1/notifications/from-template", map[string]interface{}{
+		"club_id":       1,
+		"template_name": "booking_confirmed",
+		"recipient":     "member@example.com",
+	})
+	invalid.Body.Close()
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.StatusCode)
+
+	rendered, err := http.Get(fmt.Sprintf("%s/api/v1/notifications/%d/render", suite.httpServer.URL, notification.ID))
+	suite.Require().NoError(err)
+	defer rendered.Body.Close()
+	suite.Require().Equal(http.StatusOK, rendered.StatusCode)
+
+	var preview map[string]interface{}
+	suite.Require().NoError(json.NewDecoder(rendered.Body).Decode(&preview))
+	assert.Equal(suite.T(), notification.Message, preview["text"])
+	assert.Equal(suite.T(), notification.HTMLMessage, preview["html"])
+}
+
+// Test HTTP notification creation honours user preferences
+func (suite *NotificationIntegrationTestSuite) TestHTTP_CreateNotification_AppliesUserPreferences() {
+	sendJSON := func(method, path string, body interface{}) *http.Response {
+		jsonBody, err := json.Marshal(body)
+		suite.Require().NoError(err)
+		req, err := http.NewRequest(method, suite.httpServer.URL+path, bytes.NewReader(jsonBody))
+		suite.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		suite.Require().NoError(err)
+		return resp
+	}
+
+	// Quiet hours covering the current time, in the user's timezone
+	location, err := time.LoadLocation("Europe/Paris")
+	suite.Require().NoError(err)
+	now := time.Now().In(location)
+	resp := sendJSON(http.MethodPut, "/api/v1/users/user123/preferences?club_id=1", map[string]interface{}{
+		"email_enabled":     false,
+		"blocked_types":     []string{"marketing"},
+		"timezone":          "Europe/Paris",
+		"quiet_hours_start": now.Add(-time.Hour).Format("15:04"),
+		"quiet_hours_end":   now.Add(time.Hour).Format("15:04"),
+	})
+	resp.Body.Close()
+	suite.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	create := func(priority, category string) models.Notification {
+		resp := sendJSON(http.MethodPost, "/api/v1/notifications", map[string]interface{}{
+			"club_id":   1,
+			"user_id":   "user123",
+			"type":      "email",
+			"priority":  priority,
+			"category":  category,
+			"subject":   "Club news",
+			"message":   "Something happened",
+			"recipient": "member@example.com",
+		})
+		defer resp.Body.Close()
+		suite.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+		var notification models.Notification
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&notification))
+		return notification
+	}
+
+	deferred := create("normal", "")
+	assert.Equal(suite.T(), models.NotificationTypeInApp, deferred.Type)
+	assert.Equal(suite.T(), "user123", deferred.Recipient)
+	suite.Require().NotNil(deferred.ScheduledFor)
+	assert.WithinDuration(suite.T(), now.Add(time.Hour).Truncate(time.Minute), *deferred.ScheduledFor, time.Second)
+	suite.Require().Len(deferred.Decisions, 2)
+	assert.Equal(suite.T(), models.DeliveryActionRerouted, deferred.Decisions[0].Action)
+	assert.Equal(suite.T(), models.DeliveryActionDeferred, deferred.Decisions[1].Action)
+
+	critical := create("critical", "")
+	assert.Equal(suite.T(), models.NotificationTypeInApp, critical.Type)
+	assert.Nil(suite.T(), critical.ScheduledFor)
+
+	blocked := create("critical", "Marketing")
+	assert.Equal(suite.T(), models.NotificationStatusSuppressed, blocked.Status)
+	suite.Require().Len(blocked.Decisions, 1)
+	assert.Equal(suite.T(), "blocked_category", blocked.Decisions[0].Reason)
+
+	invalid := sendJSON(http.MethodPut, "/api/v1/users/user123/preferences?club_id=1", map[string]interface{}{
+		"timezone": "Mars/Olympus_Mons",
+	})
+	invalid.Body.Close()
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.StatusCode)
+}
+
+// Test low and normal priority notifications are collected into a digest
+func (suite *NotificationIntegrationTestSuite) TestDigest_CollectsAndCollapsesNotifications() {
+	ctx := context.Background()
+	userID := "digest-user"
+	timezone := "Asia/Tokyo"
+
+	_, err := suite.service.UpdateUserPreferences(ctx, userID, 1, &service.UpdateUserPreferencesRequest{
+		Timezone: &timezone,
+		TypePreferences: []service.TypePreferenceUpdate{
+			{NotificationType: models.NotificationTypeInApp, IsEnabled: true, DeliveryTime: "07:30"},
+		},
+		Digests: []service.DigestPreferenceUpdate{{Category: "Proposals", Frequency: "daily"}},
+	})
+	suite.Require().NoError(err)
+
+	create := func(priority models.NotificationPriority, category, collapseKey, subject string) *models.Notification {
+		notification, err := suite.service.CreateNotification(ctx, &service.CreateNotificationRequest{
+			ClubID:      1,
+			UserID:      &userID,
+			Type:        models.NotificationTypeInApp,
+			Priority:    priority,
+			Subject:     subject,
+			Message:     subject,
+			Recipient:   userID,
+			Category:    category,
+			CollapseKey: collapseKey,
+		})
+		suite.Require().NoError(err)
+		return notification
+	}
+
+	batched := []*models.Notification{
+		create(models.NotificationPriorityNormal, "proposals", "proposal:7", "1 vote on Court lighting"),
+		create(models.NotificationPriorityLow, "proposals", "", "New proposal: Winter hours"),
+		create(models.NotificationPriorityNormal, "proposals", "proposal:7", "2 votes on Court lighting"),
+	}
+	urgent := create(models.NotificationPriorityHigh, "proposals", "", "Voting closes in an hour")
+	other := create(models.NotificationPriorityNormal, "bookings", "", "Booking confirmed")
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	suite.Require().NoError(err)
+	local := time.Now().In(tokyo)
+	due := time.Date(local.Year(), local.Month(), local.Day(), 7, 30, 0, 0, tokyo)
+	if !due.After(local) {
+		due = due.AddDate(0, 0, 1)
+	}
+
+	digestID := batched[0].DigestID
+	suite.Require().NotNil(digestID)
+	for _, notification := range batched {
+		assert.Equal(suite.T(), models.NotificationStatusBatched, notification.Status)
+		assert.Equal(suite.T(), *digestID, *notification.DigestID)
+	}
+	assert.Nil(suite.T(), urgent.DigestID)
+	assert.Nil(suite.T(), other.DigestID)
+
+	var digest models.Notification
+	suite.Require().NoError(suite.db.First(&digest, *digestID).Error)
+	assert.Equal(suite.T(), models.DigestFrequencyDaily, digest.DigestFrequency)
+	suite.Require().NotNil(digest.ScheduledFor)
+	assert.WithinDuration(suite.T(), due, *digest.ScheduledFor, time.Second)
+
+	// Bring the digest due and deliver it
+	suite.Require().NoError(suite.db.Model(&digest).Update("scheduled_for", time.Now().Add(-time.Minute)).Error)
+	suite.Require().NoError(suite.service.ProcessNotification(ctx, digest.ID))
+
+	suite.Require().NoError(suite.db.First(&digest, *digestID).Error)
+	assert.Equal(suite.T(), models.NotificationStatusSent, digest.Status)
+	assert.Equal(suite.T(), "Your daily summary: 3 updates", digest.Subject)
+	assert.Contains(suite.T(), digest.Message, "- 2 votes on Court lighting (2 updates)\n- New proposal: Winter hours\n")
+
+	for _, notification := range batched {
+		var item models.Notification
+		suite.Require().NoError(suite.db.First(&item, notification.ID).Error)
+		assert.Equal(suite.T(), models.NotificationStatusDigested, item.Status)
+	}
+
+	view, err := suite.service.GetUserPreferences(ctx, userID, 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(view.Digests, 1)
+	assert.Equal(suite.T(), "proposals", view.Digests[0].Category)
+}
+
+// Test the in-app inbox and its resumable event stream
+func (suite *NotificationIntegrationTestSuite) TestInbox_StreamsAndResumesChanges() {
+	ctx := context.Background()
+	userID := "42"
+	token, err := suite.auth.GenerateToken(&auth.User{ID: 42, ClubID: 1, Username: "member"}, time.Hour)
+	suite.Require().NoError(err)
+
+	request := func(method, path string, body interface{}, headers map[string]string) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			jsonBody, err := json.Marshal(body)
+			suite.Require().NoError(err)
+			reader = bytes.NewReader(jsonBody)
+		}
+		req, err := http.NewRequest(method, suite.httpServer.URL+path, reader)
+		suite.Require().NoError(err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		suite.Require().NoError(err)
+		return resp
+	}
+	decode := func(resp *http.Response, into interface{}) {
+		defer resp.Body.Close()
+		suite.Require().Equal(http.StatusOK, resp.StatusCode)
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(into))
+	}
+	deliver := func(priority models.NotificationPriority, subject string) *models.Notification {
+		notification, err := suite.service.CreateNotification(ctx, &service.CreateNotificationRequest{
+			ClubID:    1,
+			UserID:    &userID,
+			Type:      models.NotificationTypeInApp,
+			Priority:  priority,
+			Subject:   subject,
+			Message:   subject,
+			Recipient: userID,
+		})
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.service.ProcessNotification(ctx, notification.ID))
+		return notification
+	}
+
+	// Other users and channels stay out of the inbox
+	otherUser := "43"
+	_, err = suite.service.CreateNotification(ctx, &service.CreateNotificationRequest{
+		ClubID: 1, UserID: &otherUser, Type: models.NotificationTypeInApp, Subject: "Hi", Message: "Hi", Recipient: otherUser,
+	})
+	suite.Require().NoError(err)
+
+	notice := deliver(models.NotificationPritorityCritical, "Courts closed for storm")
+	update := deliver(models.NotificationPriorityNormal, "New tournament posted")
+
+	var page service.InboxPage
+	decode(request("GET", "/api/v1/inbox", nil, nil), &page)
+	suite.Require().Len(page.Notifications, 2)
+	assert.Equal(suite.T(), notice.ID, page.Notifications[0].ID, "critical notices are pinned first")
+	assert.NotNil(suite.T(), page.Notifications[0].PinnedAt)
+	assert.Equal(suite.T(), int64(2), page.UnreadCount)
+
+	// A new stream starts with the unread count, then follows changes
+	type sseEvent struct {
+		id, event, data string
+	}
+	stream := func(headers map[string]string) (<-chan sseEvent, func()) {
+		resp := request("GET", "/api/v1/inbox/stream", nil, headers)
+		suite.Require().Equal(http.StatusOK, resp.StatusCode)
+		assert.Equal(suite.T(), "text/event-stream", resp.Header.Get("Content-Type"))
+
+		events := make(chan sseEvent, 10)
+		go func() {
+			defer close(events)
+			var current sseEvent
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				field, value, _ := strings.Cut(scanner.Text(), ": ")
+				switch field {
+				case "id":
+					current.id = value
+				case "event":
+					current.event = value
+				case "data":
+					current.data = value
+				case "":
+					if current.event != "" {
+						events <- current
+					}
+					current = sseEvent{}
+				}
+			}
+		}()
+		return events, func() { resp.Body.Close() }
+	}
+	next := func(events <-chan sseEvent) (sseEvent, models.InboxEvent) {
+		select {
+		case event, ok := <-events:
+			suite.Require().True(ok, "inbox stream closed")
+			var decoded models.InboxEvent
+			suite.Require().NoError(json.Unmarshal([]byte(event.data), &decoded))
+			return event, decoded
+		case <-time.After(2 * time.Second):
+			suite.FailNow("no inbox event streamed")
+		}
+		return sseEvent{}, models.InboxEvent{}
+	}
+
+	events, closeStream := stream(nil)
+	event, snapshot := next(events)
+	assert.Equal(suite.T(), "unread_count", event.event)
+	assert.Equal(suite.T(), int64(2), snapshot.UnreadCount)
+
+	var result service.InboxUpdate
+	decode(request("POST", "/api/v1/inbox/read", map[string]interface{}{"notification_ids": []uint{update.ID}}, nil), &result)
+	assert.Equal(suite.T(), service.InboxUpdate{Updated: 1, UnreadCount: 1}, result)
+
+	event, read := next(events)
+	assert.Equal(suite.T(), models.InboxEventRead, event.event)
+	assert.Equal(suite.T(), []uint{update.ID}, read.NotificationIDs)
+	assert.Equal(suite.T(), int64(1), read.UnreadCount)
+	closeStream()
+
+	// Changes made while disconnected are replayed after the last event seen
+	decode(request("POST", "/api/v1/inbox/archive", map[string]interface{}{"notification_ids": []uint{notice.ID}}, nil), &result)
+	assert.Equal(suite.T(), service.InboxUpdate{Updated: 1, UnreadCount: 0}, result)
+	latest := deliver(models.NotificationPriorityLow, "Newsletter")
+
+	events, closeStream = stream(map[string]string{"Last-Event-ID": event.id})
+	defer closeStream()
+	event, archived := next(events)
+	assert.Equal(suite.T(), models.InboxEventArchived, event.event)
+	assert.Equal(suite.T(), []uint{notice.ID}, archived.NotificationIDs)
+	event, delivered := next(events)
+	assert.Equal(suite.T(), models.InboxEventNotification, event.event)
+	suite.Require().NotNil(delivered.Notification)
+	assert.Equal(suite.T(), "Newsletter", delivered.Notification.Subject)
+	assert.Equal(suite.T(), int64(1), delivered.UnreadCount)
+
+	// Marking everything read reaches connected streams too
+	decode(request("POST", "/api/v1/inbox/read", nil, nil), &result)
+	assert.Equal(suite.T(), service.InboxUpdate{Updated: 1, UnreadCount: 0}, result)
+	event, read = next(events)
+	assert.Equal(suite.T(), []uint{latest.ID}, read.NotificationIDs)
+
+	var pinned models.Notification
+	decode(request("PUT", fmt.Sprintf("/api/v1/inbox/%d/pin", update.ID), nil, nil), &pinned)
+	assert.NotNil(suite.T(), pinned.PinnedAt)
+	event, _ = next(events)
+	assert.Equal(suite.T(), models.InboxEventPinned, event.event)
+
+	var archivedPage service.InboxPage
+	decode(request("GET", "/api/v1/inbox?archived=true", nil, nil), &archivedPage)
+	suite.Require().Len(archivedPage.Notifications, 1)
+	assert.Nil(suite.T(), archivedPage.Notifications[0].PinnedAt, "archiving unpins")
+
+	var count map[string]int64
+	decode(request("GET", "/api/v1/inbox/unread-count", nil, nil), &count)
+	assert.Equal(suite.T(), int64(0), count["unread_count"])
+
+	// Notifications of other users cannot be pinned, and requests need a valid token
+	resp := request("PUT", "/api/v1/inbox/999999/pin", nil, nil)
+	resp.Body.Close()
+	assert.Equal(suite.T(), http.StatusNotFound, resp.StatusCode)
+
+	resp = request("GET", "/api/v1/inbox", nil, map[string]string{"Authorization": "Bearer invalid"})
+	resp.Body.Close()
+	assert.Equal(suite.T(), http.StatusUnauthorized, resp.StatusCode)
+}
+
+// Test domain events are turned into notifications by club rules
+func (suite *NotificationIntegrationTestSuite) TestEventRules_NotifyAndDeadLetter() {
+	postJSON := func(path string, body interface{}) *http.Response {
+		jsonBody, err := json.Marshal(body)
+		suite.Require().NoError(err)
+		resp, err := http.Post(suite.httpServer.URL+path, "application/json", bytes.NewReader(jsonBody))
+		suite.Require().NoError(err)
+		return resp
+	}
+	createTemplates := func() {
+		for _, channel := range []string{"email", "in_app"} {
+			resp := postJSON("/api/v1/templates", map[string]interface{}{
+				"club_id":       1,
+				"name":          "visit_confirmed",
+				"type":          channel,
+				"subject":       "Visit confirmed",
+				"body":          "Your visit {{.visit_id}} on {{formatDate \"2 Jan 2006\" .visit_date}} is confirmed.",
+				"variables":     `{"visit_id": "integer", "visit_date": {"type": "date", "required": true}}`,
+				"created_by_id": "admin1",
+			})
+			resp.Body.Close()
+			suite.Require().Equal(http.StatusCreated, resp.StatusCode)
+		}
+	}
+	// The reciprocal service publishes pre-marshalled payloads, which arrive base64 encoded
+	visitEvent := func(id string, retries int) *messaging.Message {
+		payload, err := json.Marshal(map[string]interface{}{
+			"visit_id":     7,
+			"member_id":    42,
+			"home_club_id": 1,
+			"status":       "confirmed",
+			"visit_date":   "2024-05-01T10:00:00Z",
+			"member_email": "member@example.com",
+		})
+		suite.Require().NoError(err)
+		data, err := json.Marshal(payload)
+		suite.Require().NoError(err)
+		return &messaging.Message{ID: id, Subject: "visit.confirmed", Data: data, Retries: retries, MaxRetries: 3}
+	}
+	countNotifications := func() int64 {
+		var count int64
+		suite.Require().NoError(suite.db.Model(&models.Notification{}).Where("source_event_id <> ''").Count(&count).Error)
+		return count
+	}
+
+	createTemplates()
+
+	resp := postJSON("/api/v1/admin/rules", map[string]interface{}{
+		"club_id":       1,
+		"name":          "Visit confirmed to member",
+		"event_type":    "visit.*",
+		"club_field":    "home_club_id",
+		"filter":        map[string]interface{}{"status": []string{"confirmed", "checked_in"}},
+		"template_name": "visit_confirmed",
+		"audience": map[string]interface{}{
+			"kind":             "event_user",
+			"user_field":       "member_id",
+			"recipient_fields": map[string]string{"email": "member_email"},
+		},
+		"channels":      []string{"email", "in_app"},
+		"created_by_id": "admin1",
+	})
+	resp.Body.Close()
+	suite.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	invalid := postJSON("/api/v1/admin/rules", map[string]interface{}{
+		"club_id":       1,
+		"name":          "No such channel variant",
+		"event_type":    "visit.confirmed",
+		"template_name": "visit_confirmed",
+		"audience":      map[string]interface{}{"kind": "event_user"},
+		"channels":      []string{"sms"},
+	})
+	invalid.Body.Close()
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.StatusCode)
+
+	ctx := context.Background()
+	suite.Require().NoError(suite.messageBus.Deliver(ctx, visitEvent("msg_1", 0)))
+
+	var notifications []models.Notification
+	suite.Require().NoError(suite.db.Where("source_event_id = ?", "msg_1").Order("id").Find(&notifications).Error)
+	suite.Require().Len(notifications, 2)
+	assert.Equal(suite.T(), models.NotificationTypeEmail, notifications[0].Type)
+	assert.Equal(suite.T(), "member@example.com", notifications[0].Recipient)
+	assert.Equal(suite.T(), "Your visit 7 on 1 May 2024 is confirmed.", notifications[0].Message)
+	assert.Equal(suite.T(), models.NotificationTypeInApp, notifications[1].Type)
+	assert.Equal(suite.T(), "42", notifications[1].Recipient)
+	suite.Require().NotNil(notifications[1].RuleID)
+
+	// Redelivery of the same message is ignored
+	suite.Require().NoError(suite.messageBus.Deliver(ctx, visitEvent("msg_1", 0)))
+	assert.Equal(suite.T(), int64(2), countNotifications())
+
+	// Without its template the rule fails; the last attempt is dead-lettered
+	suite.db.Exec("DELETE FROM notification_templates")
+	suite.Require().Error(suite.messageBus.Deliver(ctx, visitEvent("msg_2", 0)))
+	suite.Require().NoError(suite.messageBus.Deliver(ctx, visitEvent("msg_2", 3)))
+
+	deadLetters, err := http.Get(suite.httpServer.URL + "/api/v1/admin/events/dead-letters")
+	suite.Require().NoError(err)
+	defer deadLetters.Body.Close()
+	var stored []models.EventDeadLetter
+	suite.Require().NoError(json.NewDecoder(deadLetters.Body).Decode(&stored))
+	suite.Require().Len(stored, 1)
+	assert.Equal(suite.T(), "msg_2", stored[0].MessageID)
+	assert.Equal(suite.T(), 4, stored[0].Attempts)
+
+	createTemplates()
+	replay := postJSON(fmt.Sprintf("/api/v1/admin/events/dead-letters/%d/replay", stored[0].ID), nil)
+	defer replay.Body.Close()
+	suite.Require().Equal(http.StatusOK, replay.StatusCode)
+
+	var result service.EventReplayResult
+	suite.Require().NoError(json.NewDecoder(replay.Body).Decode(&result))
+	assert.Equal(suite.T(), 2, result.NotificationsCreated)
+	assert.NotNil(suite.T(), result.DeadLetter.ReplayedAt)
+	assert.Equal(suite.T(), int64(4), countNotifications())
+}
+
+// Test the dispatcher delivers with bounded workers, retries with backoff and dead-letters
+func (suite *NotificationIntegrationTestSuite) TestDispatcher_DeliversRetriesAndDeadLetters() {
+	var mu sync.Mutex
+	delivered := 0
+	emailAttempts := 0
+	inFlight, maxInFlight := 0, 0
+
+	suite.service.SetSender(models.NotificationTypeInApp, service.SenderFunc(func(ctx context.Context, n *models.Notification) error {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		delivered++
+		mu.Unlock()
+		return nil
+	}))
+	suite.service.SetSender(models.NotificationTypeEmail, service.SenderFunc(func(ctx context.Context, n *models.Notification) error {
+		mu.Lock()
+		emailAttempts++
+		mu.Unlock()
+		return fmt.Errorf("smtp unavailable")
+	}))
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		_, err := suite.service.CreateNotification(ctx, &service.CreateNotificationRequest{
+			ClubID:    1,
+			Type:      models.NotificationTypeInApp,
+			Priority:  models.NotificationPriorityNormal,
+			Subject:   "Update",
+			Message:   fmt.Sprintf("Message %d", i),
+			Recipient: "user123",
+		})
+		suite.Require().NoError(err)
+	}
+	email, err := suite.service.CreateNotification(ctx, &service.CreateNotificationRequest{
+		ClubID:    1,
+		Type:      models.NotificationTypeEmail,
+		Priority:  models.NotificationPriorityNormal,
+		Subject:   "Update",
+		Message:   "Hello",
+		Recipient: "member@example.com",
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.service.StartDispatcher(service.DispatcherConfig{
+		Workers: map[models.NotificationType]int{
+			models.NotificationTypeInApp: 2,
+			models.NotificationTypeEmail: 1,
+		},
+		PollInterval: 5 * time.Millisecond,
+		Lease:        time.Minute,
+		RetryBase:    2 * time.Millisecond,
+		RetryMax:     10 * time.Millisecond,
+	}))
+	suite.Require().ErrorIs(suite.service.StartDispatcher(service.DefaultDispatcherConfig()), service.ErrDispatcherRunning)
+
+	statusOf := func(id uint) models.NotificationStatus {
+		var notification models.Notification
+		suite.Require().NoError(suite.db.First(&notification, id).Error)
+		return notification.Status
+	}
+	countSent := func() int64 {
+		var count int64
+		suite.Require().NoError(suite.db.Model(&models.Notification{}).
+			Where("type = ? AND status = ?", models.NotificationTypeInApp, models.NotificationStatusSent).
+			Count(&count).Error)
+		return count
+	}
+
+	suite.Require().Eventually(func() bool { return countSent() == 10 }, 2*time.Second, 5*time.Millisecond)
+	suite.Require().Eventually(func() bool {
+		return statusOf(email.ID) == models.NotificationStatusDeadLetter
+	}, 2*time.Second, 5*time.Millisecond)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	suite.Require().NoError(suite.service.StopDispatcher(shutdownCtx))
+
+	var deadLetter models.Notification
+	suite.Require().NoError(suite.db.First(&deadLetter, email.ID).Error)
+	assert.Equal(suite.T(), models.MaxDeliveryAttempts, deadLetter.RetryCount)
+	assert.Equal(suite.T(), "smtp unavailable", deadLetter.ErrorMessage)
+	assert.Empty(suite.T(), deadLetter.ClaimedBy)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(suite.T(), 10, delivered)
+	assert.LessOrEqual(suite.T(), maxInFlight, 2)
+	assert.Equal(suite.T(), models.MaxDeliveryAttempts, emailAttempts)
+}
+
+// Test delivery receipts and the suppression list
+func (suite *NotificationIntegrationTestSuite) TestDeliveryReceipts_SuppressUndeliverableRecipients() {
+	ctx := context.Background()
+	request := &service.CreateNotificationRequest{
+		ClubID:    1,
+		Type:      models.NotificationTypeSMS,
+		Priority:  models.NotificationPriorityNormal,
+		Subject:   "Booking",
+		Message:   "Court 3 is ready",
+		Recipient: "+15551234567",
+	}
+
+	sent, err := suite.service.CreateNotification(ctx, request)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.db.Model(&models.Notification{}).Where("id = ?", sent.ID).Updates(map[string]interface{}{
+		"status":              models.NotificationStatusSent,
+		"provider":            "twilio",
+		"provider_message_id": "SM123",
+	}).Error)
+
+	receipts := []service.DeliveryReceipt{
+		{Type: models.NotificationTypeSMS, MessageID: "SM123", Status: service.ReceiptStatusUndeliverable, Permanent: true, Reason: "Twilio undelivered (error 30005)"},
+		{Type: models.NotificationTypeSMS, MessageID: "SM123", Status: service.ReceiptStatusDelivered},
+		{Type: models.NotificationTypeSMS, MessageID: "SM-unknown", Status: service.ReceiptStatusDelivered},
+	}
+	result, err := suite.service.ProcessDeliveryReceipts(ctx, receipts)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 3, result.Received)
+	assert.Equal(suite.T(), 2, result.Matched)
+	assert.Equal(suite.T(), 1, result.Unmatched)
+	assert.Equal(suite.T(), 1, result.Suppressed)
+
+	// A late delivered receipt does not move the notification backwards
+	var undeliverable models.Notification
+	suite.Require().NoError(suite.db.First(&undeliverable, sent.ID).Error)
+	assert.Equal(suite.T(), models.NotificationStatusUndeliverable, undeliverable.Status)
+	assert.Equal(suite.T(), "Twilio undelivered (error 30005)", undeliverable.ErrorMessage)
+
+	// Later notifications to the recipient are suppressed instead of sent
+	next, err := suite.service.CreateNotification(ctx, request)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.service.ProcessNotification(ctx, next.ID))
+
+	var suppressed models.Notification
+	suite.Require().NoError(suite.db.First(&suppressed, next.ID).Error)
+	assert.Equal(suite.T(), models.NotificationStatusSuppressed, suppressed.Status)
+
+	resp, err := http.Get(suite.httpServer.URL + "/api/v1/admin/suppressions?type=sms")
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+	suite.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	var suppressions []models.SuppressedRecipient
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&suppressions))
+	suite.Require().Len(suppressions, 1)
+	assert.Equal(suite.T(), "+15551234567", suppressions[0].Recipient)
+	assert.Equal(suite.T(), models.SuppressionReasonInvalidRecipient, suppressions[0].Reason)
+	assert.Equal(suite.T(), "twilio", suppressions[0].Provider)
+
+	deleteReq, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v1/admin/suppressions/%d", suite.httpServer.URL, suppressions[0].ID), nil)
+	suite.Require().NoError(err)
+	deleteResp, err := http.DefaultClient.Do(deleteReq)
+	suite.Require().NoError(err)
+	deleteResp.Body.Close()
+	assert.Equal(suite.T(), http.StatusNoContent, deleteResp.StatusCode)
+
+	// Unsigned webhooks are rejected
+	webhookResp, err := http.Post(suite.httpServer.URL+"/api/v1/webhooks/email/events", "application/json",
+		strings.NewReader(`{"events":[{"message_id":"x","event":"complaint"}]}`))
+	suite.Require().NoError(err)
+	webhookResp.Body.Close()
+	assert.Equal(suite.T(), http.StatusUnauthorized, webhookResp.StatusCode)
+}
+
+// Test webhook subscriptions: signed deliveries, retries, the delivery log,
+// redelivery after a secret rotation and disabling a gone endpoint
+func (suite *NotificationIntegrationTestSuite) TestWebhookSubscriptions_DeliverRetryAndDisable() {
+	ctx := context.Background()
+
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	var (
+		mu       sync.Mutex
+		requests []received
+	)
+	endpoint := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, received{header: r.Header.Clone(), body: body})
+		first := len(requests) == 1
+		mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/gone":
+			w.WriteHeader(http.StatusGone)
+		case first:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, "maintenance")
+		default:
+			fmt.Fprint(w, `{"ok":true}`)
+		}
+	}))
+	defer endpoint.Close()
+	receivedCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(requests)
+	}
+
+	// Subscriptions must name a public host; the test client routes it to the local endpoint
+	client := endpoint.Client()
+	client.Transport.(*http.Transport).DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, endpoint.Listener.Addr().String())
+	}
+	webhooks := providers.NewWebhookProvider("", &TestLogger{})
+	webhooks.SetHTTPClient(client)
+	suite.providers.Webhook = webhooks
+	defer func() { suite.providers.Webhook = nil }()
+
+	request := func(method, path string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			jsonBody, err := json.Marshal(body)
+			suite.Require().NoError(err)
+			reader = bytes.NewReader(jsonBody)
+		}
+		req, err := http.NewRequest(method, suite.httpServer.URL+path, reader)
+		suite.Require().NoError(err)
+		resp, err := http.DefaultClient.Do(req)
+		suite.Require().NoError(err)
+		return resp
+	}
+	decode := func(resp *http.Response, status int, into interface{}) {
+		defer resp.Body.Close()
+		suite.Require().Equal(status, resp.StatusCode)
+		if into != nil {
+			suite.Require().NoError(json.NewDecoder(resp.Body).Decode(into))
+		}
+	}
+	runDispatcher := func(until func() bool) {
+		suite.Require().NoError(suite.service.StartDispatcher(service.DispatcherConfig{
+			PollInterval:     5 * time.Millisecond,
+			Lease:            time.Minute,
+			WebhookWorkers:   2,
+			WebhookRetryBase: 2 * time.Millisecond,
+			WebhookRetryMax:  10 * time.Millisecond,
+		}))
+		suite.Require().Eventually(until, 2*time.Second, 5*time.Millisecond)
+
+		shutdownCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		suite.Require().NoError(suite.service.StopDispatcher(shutdownCtx))
+	}
+
+	// Partners subscribe over https only
+	resp := request("POST", "/api/v1/admin/webhooks", map[string]interface{}{
+		"club_id": 1, "name": "Partner", "url": "http://partner.example.com/hooks", "event_types": []string{"visit.*"},
+	})
+	resp.Body.Close()
+	suite.Require().Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// and never to internal addresses
+	for _, internal := range []string{"https://127.0.0.1/hooks", "https://10.0.0.5/hooks", "https://[::1]/hooks", "https://localhost/hooks"} {
+		resp = request("POST", "/api/v1/admin/webhooks", map[string]interface{}{
+			"club_id": 1, "name": "Partner", "url": internal, "event_types": []string{"visit.*"},
+		})
+		resp.Body.Close()
+		suite.Require().Equal(http.StatusBadRequest, resp.StatusCode, internal)
+	}
+
+	var created service.WebhookSubscriptionSecret
+	decode(request("POST", "/api/v1/admin/webhooks", map[string]interface{}{
+		"club_id": 1, "name": "Partner", "url": "https://example.com/hooks", "event_types": []string{"visit.*"},
+	}), http.StatusCreated, &created)
+	subscriptionID := created.Subscription.ID
+	suite.Require().True(strings.HasPrefix(created.Secret, "whsec_"))
+	assert.True(suite.T(), created.Subscription.IsActive)
+
+	// Events are queued once per subscription, for the subscribing club and the types it chose
+	visit := &service.DomainEvent{
+		ID:      "evt_1",
+		Subject: "visit.confirmed",
+		Type:    "visit.confirmed",
+		Payload: map[string]interface{}{"club_id": float64(1), "visit_id": float64(7)},
+	}
+	queued, err := suite.service.QueueWebhookEvent(ctx, visit)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 1, queued)
+	queued, err = suite.service.QueueWebhookEvent(ctx, visit)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 0, queued)
+	for _, other := range []*service.DomainEvent{
+		{ID: "evt_2", Type: "visit.confirmed", Payload: map[string]interface{}{"club_id": float64(2)}},
+		{ID: "evt_3", Type: "agreement.signed", Payload: map[string]interface{}{"club_id": float64(1)}},
+	} {
+		queued, err = suite.service.QueueWebhookEvent(ctx, other)
+		suite.Require().NoError(err)
+		assert.Equal(suite.T(), 0, queued)
+	}
+
+	// The first attempt fails and is retried
+	var deliveries []models.WebhookDelivery
+	runDispatcher(func() bool {
+		decode(request("GET", fmt.Sprintf("/api/v1/admin/webhooks/%d/deliveries", subscriptionID), nil), http.StatusOK, &deliveries)
+		return len(deliveries) == 1 && deliveries[0].Status == models.WebhookDeliverySucceeded
+	})
+	assert.Equal(suite.T(), 2, deliveries[0].Attempts)
+	assert.Equal(suite.T(), http.StatusOK, deliveries[0].ResponseStatus)
+
+	var delivery models.WebhookDelivery
+	decode(request("GET", fmt.Sprintf("/api/v1/admin/webhook-deliveries/%d", deliveries[0].ID), nil), http.StatusOK, &delivery)
+	suite.Require().Len(delivery.AttemptLog, 2)
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, delivery.AttemptLog[0].ResponseStatus)
+	assert.Equal(suite.T(), "maintenance", delivery.AttemptLog[0].ResponseBody)
+	assert.Equal(suite.T(), `{"ok":true}`, delivery.AttemptLog[1].ResponseBody)
+
+	var payload providers.WebhookPayload
+	suite.Require().NoError(json.Unmarshal([]byte(delivery.Payload), &payload))
+	assert.Equal(suite.T(), "evt_1", payload.ID)
+	assert.Equal(suite.T(), "visit.confirmed", payload.Event)
+	assert.Equal(suite.T(), float64(7), payload.Data["visit_id"])
+
+	// Receivers verify the timestamped signature with their secret
+	mu.Lock()
+	last := requests[len(requests)-1]
+	mu.Unlock()
+	assert.Equal(suite.T(), delivery.Payload, string(last.body))
+	assert.Equal(suite.T(), fmt.Sprint(delivery.ID), last.header.Get(providers.WebhookIDHeader))
+	assert.Equal(suite.T(), "visit.confirmed", last.header.Get(providers.WebhookEventHeader))
+	signature := last.header.Get(providers.WebhookSignatureHeader)
+	suite.Require().NoError(providers.VerifyWebhook(last.body, signature, created.Secret, 5*time.Minute, time.Now()))
+	assert.ErrorIs(suite.T(), providers.VerifyWebhook(last.body, signature, created.Secret, 5*time.Minute, time.Now().Add(time.Hour)), providers.ErrWebhookSignature)
+
+	// After a rotation, deliveries verify with the old and the new secret until the grace period ends
+	var rotated service.WebhookSubscriptionSecret
+	decode(request("POST", fmt.Sprintf("/api/v1/admin/webhooks/%d/rotate-secret", subscriptionID), map[string]interface{}{"grace_hours": 1}), http.StatusOK, &rotated)
+	suite.Require().NotEqual(created.Secret, rotated.Secret)
+	suite.Require().NotNil(rotated.Subscription.PreviousSecretExpiresAt)
+
+	decode(request("POST", fmt.Sprintf("/api/v1/admin/webhook-deliveries/%d/redeliver", delivery.ID), nil), http.StatusAccepted, &delivery)
+	assert.Equal(suite.T(), models.WebhookDeliveryPending, delivery.Status)
+	runDispatcher(func() bool { return receivedCount() == 3 })
+
+	mu.Lock()
+	last = requests[2]
+	mu.Unlock()
+	signature = last.header.Get(providers.WebhookSignatureHeader)
+	assert.NoError(suite.T(), providers.VerifyWebhook(last.body, signature, rotated.Secret, 5*time.Minute, time.Now()))
+	assert.NoError(suite.T(), providers.VerifyWebhook(last.body, signature, created.Secret, 5*time.Minute, time.Now()))
+	assert.Equal(suite.T(), fmt.Sprint(delivery.ID), last.header.Get(providers.WebhookIDHeader))
+
+	// An endpoint answering 410 Gone is disabled, and its deliveries wait until it is enabled again
+	var gone service.WebhookSubscriptionSecret
+	decode(request("POST", "/api/v1/admin/webhooks", map[string]interface{}{
+		"club_id": 1, "name": "Retired partner", "url": "https://example.com/gone", "event_types": []string{"agreement.*"},
+	}), http.StatusCreated, &gone)
+	queued, err = suite.service.QueueWebhookEvent(ctx, &service.DomainEvent{
+		ID: "evt_4", Type: "agreement.signed", Payload: map[string]interface{}{"club_id": "1"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, queued)
+
+	var disabled models.WebhookSubscription
+	runDispatcher(func() bool {
+		decode(request("GET", fmt.Sprintf("/api/v1/admin/webhooks/%d", gone.Subscription.ID), nil), http.StatusOK, &disabled)
+		return !disabled.IsActive
+	})
+	assert.Equal(suite.T(), "endpoint_gone", disabled.DisabledReason)
+	assert.Equal(suite.T(), 4, receivedCount())
+
+	decode(request("GET", fmt.Sprintf("/api/v1/admin/webhooks/%d/deliveries?status=failed", gone.Subscription.ID), nil), http.StatusOK, &deliveries)
+	suite.Require().Len(deliveries, 1)
+	resp = request("POST", fmt.Sprintf("/api/v1/admin/webhook-deliveries/%d/redeliver", deliveries[0].ID), nil)
+	resp.Body.Close()
+	assert.Equal(suite.T(), http.StatusBadRequest, resp.StatusCode)
+
+	// Deleting a subscription dead-letters what it was still owed
+	resp = request("DELETE", fmt.Sprintf("/api/v1/admin/webhooks/%d", gone.Subscription.ID), nil)
+	resp.Body.Close()
+	suite.Require().Equal(http.StatusNoContent, resp.StatusCode)
+	var owed models.WebhookDelivery
+	suite.Require().NoError(suite.db.First(&owed, deliveries[0].ID).Error)
+	assert.Equal(suite.T(), models.WebhookDeliveryDeadLetter, owed.Status)
+
+	var subscriptions []models.WebhookSubscription
+	decode(request("GET", "/api/v1/admin/clubs/1/webhooks", nil), http.StatusOK, &subscriptions)
+	suite.Require().Len(subscriptions, 1)
+	assert.Equal(suite.T(), subscriptionID, subscriptions[0].ID)
+}
+
 // Test HTTP Health endpoint
 func (suite *NotificationIntegrationTestSuite) TestHTTP_Health_Success() {
 	resp, err := http.Get(suite.httpServer.URL + "/health")
@@ -317,7 +1262,27 @@ func (l *TestLogger) Fatal(msg string, fields map[string]interface{})   {}
 func (l *TestLogger) With(fields map[string]interface{}) logging.Logger { return l }
 func (l *TestLogger) WithContext(ctx context.Context) logging.Logger    { return l }
 
-type MockMessageBus struct{}
+type MockMessageBus struct {
+	mu       sync.Mutex
+	handlers map[string]messaging.MessageHandler
+}
+
+// Deliver hands a message to the queue subscription whose subject pattern matches it
+func (m *MockMessageBus) Deliver(ctx context.Context, msg *messaging.Message) error {
+	m.mu.Lock()
+	var handler messaging.MessageHandler
+	for pattern, h := range m.handlers {
+		if pattern == msg.Subject || (strings.HasSuffix(pattern, ">") && strings.HasPrefix(msg.Subject, strings.TrimSuffix(pattern, ">"))) {
+			handler = h
+		}
+	}
+	m.mu.Unlock()
+
+	if handler == nil {
+		return fmt.Errorf("no subscription for %s", msg.Subject)
+	}
+	return handler(ctx, msg)
+}
 
 func (m *MockMessageBus) Publish(ctx context.Context, subject string, data interface{}) error {
 	return nil
@@ -332,6 +1297,12 @@ func (m *MockMessageBus) Subscribe(subject string, handler messaging.MessageHand
 }
 
 func (m *MockMessageBus) SubscribeQueue(subject, queue string, handler messaging.MessageHandler) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.handlers == nil {
+		m.handlers = make(map[string]messaging.MessageHandler)
+	}
+	m.handlers[subject] = handler
 	return nil
 }
 
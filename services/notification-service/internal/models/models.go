@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 	"gorm.io/gorm"
 )
@@ -9,13 +10,25 @@ import (
 type NotificationStatus string
 
 const (
-	NotificationStatusPending   NotificationStatus = "pending"
-	NotificationStatusSent      NotificationStatus = "sent"
-	NotificationStatusDelivered NotificationStatus = "delivered"
-	NotificationStatusFailed    NotificationStatus = "failed"
-	NotificationStatusRead      NotificationStatus = "read"
+	NotificationStatusPending    NotificationStatus = "pending"
+	NotificationStatusSent       NotificationStatus = "sent"
+	NotificationStatusDelivered  NotificationStatus = "delivered"
+	NotificationStatusFailed     NotificationStatus = "failed"
+	NotificationStatusRead       NotificationStatus = "read"
+	NotificationStatusSuppressed NotificationStatus = "suppressed"
+	NotificationStatusDeadLetter NotificationStatus = "dead_letter"
+	// Reported by the provider after the message was accepted
+	NotificationStatusBounced       NotificationStatus = "bounced"
+	NotificationStatusUndeliverable NotificationStatus = "undeliverable"
+	// Held for a digest, and included in a digest that was sent
+	NotificationStatusBatched  NotificationStatus = "batched"
+	NotificationStatusDigested NotificationStatus = "digested"
 )
 
+// MaxDeliveryAttempts is how many times delivery is attempted before a
+// notification is dead-lettered
+const MaxDeliveryAttempts = 3
+
 // NotificationType represents the type of notification
 type NotificationType string
 
@@ -39,41 +52,82 @@ const (
 
 // Notification represents a notification to be sent
 type Notification struct {
-	ID           uint                 `json:"id" gorm:"primaryKey"`
-	ClubID       uint                 `json:"club_id" gorm:"not null;index"`
-	UserID       *string              `json:"user_id,omitempty" gorm:"index"`
-	Type         NotificationType     `json:"type" gorm:"size:50;not null"`
-	Priority     NotificationPriority `json:"priority" gorm:"size:50;default:'normal'"`
-	Status       NotificationStatus   `json:"status" gorm:"size:50;default:'pending'"`
-	Subject      string               `json:"subject" gorm:"size:255"`
-	Message      string               `json:"message" gorm:"type:text;not null"`
-	Recipient    string               `json:"recipient" gorm:"size:255;not null"`
-	Metadata     string               `json:"metadata,omitempty" gorm:"type:json"`
-	ScheduledFor *time.Time           `json:"scheduled_for,omitempty"`
-	SentAt       *time.Time           `json:"sent_at,omitempty"`
-	DeliveredAt  *time.Time           `json:"delivered_at,omitempty"`
-	ReadAt       *time.Time           `json:"read_at,omitempty"`
-	FailedAt     *time.Time           `json:"failed_at,omitempty"`
-	ErrorMessage string               `json:"error_message,omitempty" gorm:"type:text"`
-	RetryCount   int                  `json:"retry_count" gorm:"default:0"`
-	CreatedAt    time.Time            `json:"created_at"`
-	UpdatedAt    time.Time            `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt       `json:"-" gorm:"index"`
+	ID                uint                 `json:"id" gorm:"primaryKey"`
+	ClubID            uint                 `json:"club_id" gorm:"not null;index"`
+	UserID            *string              `json:"user_id,omitempty" gorm:"index"`
+	Type              NotificationType     `json:"type" gorm:"size:50;not null"`
+	Priority          NotificationPriority `json:"priority" gorm:"size:50;default:'normal'"`
+	Status            NotificationStatus   `json:"status" gorm:"size:50;default:'pending'"`
+	Subject           string               `json:"subject" gorm:"size:255"`
+	Message           string               `json:"message" gorm:"type:text;not null"`
+	HTMLMessage       string               `json:"html_message,omitempty" gorm:"type:text"`
+	Recipient         string               `json:"recipient" gorm:"size:255;not null"`
+	Category          string               `json:"category,omitempty" gorm:"size:100;index"`
+	Metadata          string               `json:"metadata,omitempty" gorm:"type:json"`
+	TemplateID        *uint                `json:"template_id,omitempty" gorm:"index"`
+	TemplateVersion   int                  `json:"template_version,omitempty"`
+	TemplateLocale    string               `json:"template_locale,omitempty" gorm:"size:20"`
+	TemplateData      string               `json:"template_data,omitempty" gorm:"type:json"`
+	RuleID            *uint                `json:"rule_id,omitempty" gorm:"index"`
+	SourceEventID     string               `json:"source_event_id,omitempty" gorm:"size:100;index"`
+	ScheduledFor      *time.Time           `json:"scheduled_for,omitempty"`
+	SentAt            *time.Time           `json:"sent_at,omitempty"`
+	DeliveredAt       *time.Time           `json:"delivered_at,omitempty"`
+	ReadAt            *time.Time           `json:"read_at,omitempty"`
+	ArchivedAt        *time.Time           `json:"archived_at,omitempty" gorm:"index"`
+	PinnedAt          *time.Time           `json:"pinned_at,omitempty"`
+	FailedAt          *time.Time           `json:"failed_at,omitempty"`
+	Provider          string               `json:"provider,omitempty" gorm:"size:100"`
+	ProviderMessageID string               `json:"provider_message_id,omitempty" gorm:"size:255;index"`
+	CollapseKey       string               `json:"collapse_key,omitempty" gorm:"size:255"`
+	DigestID          *uint                `json:"digest_id,omitempty" gorm:"index"`
+	DigestFrequency   DigestFrequency      `json:"digest_frequency,omitempty" gorm:"size:20"`
+	ErrorMessage      string               `json:"error_message,omitempty" gorm:"type:text"`
+	RetryCount        int                  `json:"retry_count" gorm:"default:0"`
+	NextAttemptAt     *time.Time           `json:"next_attempt_at,omitempty" gorm:"index"`
+	ClaimedBy         string               `json:"-" gorm:"size:100"`
+	ClaimedUntil      *time.Time           `json:"-" gorm:"index"`
+	Decisions         []DeliveryDecision   `json:"delivery_decisions,omitempty" gorm:"type:json;serializer:json"`
+	CreatedAt         time.Time            `json:"created_at"`
+	UpdatedAt         time.Time            `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt       `json:"-" gorm:"index"`
 }
 
 func (Notification) TableName() string {
 	return "notifications"
 }
 
-// NotificationTemplate represents a reusable notification template
+// DeliveryDecision actions
+const (
+	DeliveryActionSuppressed = "suppressed"
+	DeliveryActionDeferred   = "deferred"
+	DeliveryActionRerouted   = "rerouted"
+	DeliveryActionBatched    = "batched"
+)
+
+// DeliveryDecision records why preference resolution changed how or when a
+// notification is delivered, so support staff can explain what happened
+type DeliveryDecision struct {
+	Action string     `json:"action"`
+	Reason string     `json:"reason"`
+	Detail string     `json:"detail,omitempty"`
+	Until  *time.Time `json:"until,omitempty"`
+	At     time.Time  `json:"at"`
+}
+
+// NotificationTemplate represents a reusable notification template.
+// Templates sharing a Name and Type are locale variants of each other.
 type NotificationTemplate struct {
 	ID          uint             `json:"id" gorm:"primaryKey"`
-	ClubID      uint             `json:"club_id" gorm:"not null;index"`
-	Name        string           `json:"name" gorm:"size:255;not null"`
-	Type        NotificationType `json:"type" gorm:"size:50;not null"`
+	ClubID      uint             `json:"club_id" gorm:"not null;index;index:idx_template_variant,priority:1"`
+	Name        string           `json:"name" gorm:"size:255;not null;index:idx_template_variant,priority:2"`
+	Type        NotificationType `json:"type" gorm:"size:50;not null;index:idx_template_variant,priority:3"`
+	Locale      string           `json:"locale" gorm:"size:20;default:'en';index:idx_template_variant,priority:4"`
 	Subject     string           `json:"subject" gorm:"size:255"`
 	Body        string           `json:"body" gorm:"type:text;not null"`
+	HTMLBody    string           `json:"html_body,omitempty" gorm:"type:text"`
 	Variables   string           `json:"variables,omitempty" gorm:"type:json"`
+	Version     int              `json:"version" gorm:"default:1"`
 	IsActive    bool             `json:"is_active" gorm:"default:true"`
 	CreatedByID string           `json:"created_by_id" gorm:"size:255"`
 	CreatedAt   time.Time        `json:"created_at"`
@@ -85,6 +139,41 @@ func (NotificationTemplate) TableName() string {
 	return "notification_templates"
 }
 
+// NotificationTemplateVersion is an immutable snapshot of a template's content,
+// kept so sent notifications can be re-rendered exactly as delivered
+type NotificationTemplateVersion struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	TemplateID  uint      `json:"template_id" gorm:"not null;uniqueIndex:idx_template_version"`
+	ClubID      uint      `json:"club_id" gorm:"not null;index"`
+	Version     int       `json:"version" gorm:"not null;uniqueIndex:idx_template_version"`
+	Locale      string    `json:"locale" gorm:"size:20"`
+	Subject     string    `json:"subject" gorm:"size:255"`
+	Body        string    `json:"body" gorm:"type:text;not null"`
+	HTMLBody    string    `json:"html_body,omitempty" gorm:"type:text"`
+	Variables   string    `json:"variables,omitempty" gorm:"type:json"`
+	CreatedByID string    `json:"created_by_id" gorm:"size:255"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (NotificationTemplateVersion) TableName() string {
+	return "notification_template_versions"
+}
+
+// Snapshot returns the version record for the template's current content
+func (t *NotificationTemplate) Snapshot(createdByID string) *NotificationTemplateVersion {
+	return &NotificationTemplateVersion{
+		TemplateID:  t.ID,
+		ClubID:      t.ClubID,
+		Version:     t.Version,
+		Locale:      t.Locale,
+		Subject:     t.Subject,
+		Body:        t.Body,
+		HTMLBody:    t.HTMLBody,
+		Variables:   t.Variables,
+		CreatedByID: createdByID,
+	}
+}
+
 // NotificationPreference represents user notification preferences
 type NotificationPreference struct {
 	ID              uint             `json:"id" gorm:"primaryKey"`
@@ -123,6 +212,377 @@ func (UserPreferences) TableName() string {
 	return "user_preferences"
 }
 
+// Rule audience kinds
+const (
+	AudienceEventUser  = "event_user"
+	AudienceEventUsers = "event_users"
+	AudienceClubUsers  = "club_users"
+	AudienceUsers      = "users"
+)
+
+// RuleAudience describes who a rule notifies. Field names are dot-separated
+// paths into the event payload.
+type RuleAudience struct {
+	Kind            string                      `json:"kind"`
+	UserField       string                      `json:"user_field,omitempty"`
+	UsersField      string                      `json:"users_field,omitempty"`
+	UserIDs         []string                    `json:"user_ids,omitempty"`
+	RecipientFields map[NotificationType]string `json:"recipient_fields,omitempty"`
+}
+
+// NotificationRule maps a domain event to notifications for a club. EventType
+// is an exact event type or a prefix ending in ".*"; Filter lists payload
+// fields that must match (a list value matches any of its entries).
+
+type NotificationRule struct {
+	ID            uint                   `json:"id" gorm:"primaryKey"`
+	ClubID        uint                   `json:"club_id" gorm:"not null;index"`
+	Name          string                 `json:"name" gorm:"size:255;not null"`
+	EventType     string                 `json:"event_type" gorm:"size:100;not null;index"`
+	ClubField     string                 `json:"club_field,omitempty" gorm:"size:100"`
+	Filter        map[string]interface{} `json:"filter,omitempty" gorm:"type:json;serializer:json"`
+	TemplateName  string                 `json:"template_name" gorm:"size:255;not null"`
+	Variables     map[string]string      `json:"variables,omitempty" gorm:"type:json;serializer:json"`
+	Audience      RuleAudience           `json:"audience" gorm:"type:json;serializer:json"`
+	Channels      []NotificationType     `json:"channels" gorm:"type:json;serializer:json"`
+	Priority      NotificationPriority   `json:"priority" gorm:"size:50;default:'normal'"`
+	Category      string                 `json:"category,omitempty" gorm:"size:100"`
+	CollapseField string                 `json:"collapse_field,omitempty" gorm:"size:100"`
+	IsActive      bool                   `json:"is_active" gorm:"default:true"`
+	CreatedByID   string                 `json:"created_by_id" gorm:"size:255"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt         `json:"-" gorm:"index"`
+}
+
+func (NotificationRule) TableName() string {
+	return "notification_rules"
+}
+
+// ProcessedEvent records a domain event the rules engine has handled, so
+// redelivered messages are not acted on twice
+type ProcessedEvent struct {
+	ID                uint      `json:"id" gorm:"primaryKey"`
+	MessageID         string    `json:"message_id" gorm:"size:100;not null;uniqueIndex"`
+	EventType         string    `json:"event_type" gorm:"size:100;index"`
+	NotificationCount int       `json:"notification_count"`
+	ProcessedAt       time.Time `json:"processed_at"`
+}
+
+func (ProcessedEvent) TableName() string {
+	return "processed_events"
+}
+
+// EventDeadLetter keeps a domain event the rules engine failed to process
+// after all retries, for inspection and replay
+type EventDeadLetter struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	MessageID  string     `json:"message_id" gorm:"size:100;not null;index"`
+	Subject    string     `json:"subject" gorm:"size:255"`
+	EventType  string     `json:"event_type" gorm:"size:100;index"`
+	Payload    string     `json:"payload" gorm:"type:text"`
+	Error      string     `json:"error" gorm:"type:text"`
+	Attempts   int        `json:"attempts"`
+	ReplayedAt *time.Time `json:"replayed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func (EventDeadLetter) TableName() string {
+	return "event_dead_letters"
+}
+
+// Reasons a recipient is suppressed
+const (
+	SuppressionReasonHardBounce       = "hard_bounce"
+	SuppressionReasonComplaint        = "complaint"
+	SuppressionReasonInvalidRecipient = "invalid_recipient"
+)
+
+// SuppressedRecipient is an address, phone number or device token that must
+// not be sent to again on its channel, typically after a provider reported it
+// as permanently unreachable
+type SuppressedRecipient struct {
+	ID             uint             `json:"id" gorm:"primaryKey"`
+	Type           NotificationType `json:"type" gorm:"size:50;not null;uniqueIndex:idx_suppressed_recipient"`
+	Recipient      string           `json:"recipient" gorm:"size:255;not null;uniqueIndex:idx_suppressed_recipient"`
+	Reason         string           `json:"reason" gorm:"size:50;not null"`
+	Detail         string           `json:"detail,omitempty" gorm:"type:text"`
+	Provider       string           `json:"provider,omitempty" gorm:"size:100"`
+	NotificationID *uint            `json:"notification_id,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+func (SuppressedRecipient) TableName() string {
+	return "suppressed_recipients"
+}
+
+// NormalizeRecipient returns the form recipients are suppressed under, so
+// differently cased email addresses match
+func NormalizeRecipient(notificationType NotificationType, recipient string) string {
+	recipient = strings.TrimSpace(recipient)
+	if notificationType == NotificationTypeEmail {
+		return strings.ToLower(recipient)
+	}
+	return recipient
+}
+
+// DigestFrequency is how often a digest is sent
+type DigestFrequency string
+
+const (
+	DigestFrequencyDaily  DigestFrequency = "daily"
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+// CategoryDigest is the category of digest notifications
+const CategoryDigest = "digest"
+
+// DigestPreference opts a user into receiving a category's low and normal
+// priority notifications as a digest. An empty Category covers every category;
+// Weekday is the day weekly digests are sent.
+type DigestPreference struct {
+	ID        uint            `json:"id" gorm:"primaryKey"`
+	ClubID    uint            `json:"club_id" gorm:"not null;uniqueIndex:idx_digest_preference"`
+	UserID    string          `json:"user_id" gorm:"size:255;not null;uniqueIndex:idx_digest_preference"`
+	Category  string          `json:"category" gorm:"size:100;uniqueIndex:idx_digest_preference"`
+	Frequency DigestFrequency `json:"frequency" gorm:"size:20;not null"`
+	Weekday   time.Weekday    `json:"weekday"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+func (DigestPreference) TableName() string {
+	return "digest_preferences"
+}
+
+// Inbox event types
+const (
+	InboxEventNotification = "notification"
+	InboxEventRead         = "read"
+	InboxEventArchived     = "archived"
+	InboxEventPinned       = "pinned"
+	InboxEventUnpinned     = "unpinned"
+)
+
+// InboxEvent is a change to a user's in-app inbox. Its ID orders the user's
+// events and is the event ID inbox streams resume from; UnreadCount is the
+// user's unread count once the change was made.
+type InboxEvent struct {
+	ID              uint          `json:"id" gorm:"primaryKey"`
+	ClubID          uint          `json:"club_id" gorm:"not null;index:idx_inbox_event_user"`
+	UserID          string        `json:"user_id" gorm:"size:255;not null;index:idx_inbox_event_user"`
+	Type            string        `json:"type" gorm:"size:50;not null"`
+	NotificationIDs []uint        `json:"notification_ids" gorm:"type:json;serializer:json"`
+	UnreadCount     int64         `json:"unread_count"`
+	Notification    *Notification `json:"notification,omitempty" gorm:"-"`
+	CreatedAt       time.Time     `json:"created_at" gorm:"index"`
+}
+
+func (InboxEvent) TableName() string {
+	return "inbox_events"
+}
+
+// DevicePlatform is the push service a device receives notifications through
+type DevicePlatform string
+
+const (
+	DevicePlatformAndroid DevicePlatform = "android" // Firebase Cloud Messaging
+	DevicePlatformIOS     DevicePlatform = "ios"     // Apple Push Notification service
+	DevicePlatformWeb     DevicePlatform = "web"     // Web Push
+)
+
+// Device is an app install or browser a user registered for push
+// notifications. Token is the FCM registration token, the APNs device token
+// or the Web Push subscription endpoint; web devices also carry the keys
+// their payloads are encrypted for. A token belongs to one device, so
+// registering it again moves it to the registering user.
+type Device struct {
+	ID         uint           `json:"id" gorm:"primaryKey"`
+	ClubID     uint           `json:"club_id" gorm:"not null;index:idx_device_user"`
+	UserID     string         `json:"user_id" gorm:"size:255;not null;index:idx_device_user"`
+	Platform   DevicePlatform `json:"platform" gorm:"size:20;not null;uniqueIndex:idx_device_token"`
+	Token      string         `json:"token" gorm:"size:512;not null;uniqueIndex:idx_device_token"`
+	P256dh     string         `json:"-" gorm:"size:255"`
+	Auth       string         `json:"-" gorm:"size:255"`
+	AppVersion string         `json:"app_version,omitempty" gorm:"size:50"`
+	LastSeenAt time.Time      `json:"last_seen_at" gorm:"index"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+func (Device) TableName() string {
+	return "devices"
+}
+
+// WebhookDeliveryStatus is where a webhook delivery is in its retries
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending    WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded  WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed     WebhookDeliveryStatus = "failed"
+	WebhookDeliveryDeadLetter WebhookDeliveryStatus = "dead_letter"
+)
+
+// MaxWebhookAttempts is how many times an event is sent to a subscription
+// before its delivery is dead-lettered
+const MaxWebhookAttempts = 8
+
+// WebhookSubscription sends a club's domain events to an endpoint of a
+// partner. EventTypes are exact event types or prefixes ending in ".*".
+// Deliveries are signed with Secret and, until PreviousSecretExpiresAt, with
+// the secret it replaced, so receivers can roll over without missing events.
+// A subscription whose endpoint keeps failing is disabled until it is
+// re-enabled.
+type WebhookSubscription struct {
+	ID                      uint           `json:"id" gorm:"primaryKey"`
+	ClubID                  uint           `json:"club_id" gorm:"not null;index"`
+	Name                    string         `json:"name" gorm:"size:255;not null"`
+	URL                     string         `json:"url" gorm:"size:2048;not null"`
+	EventTypes              []string       `json:"event_types" gorm:"type:json;serializer:json"`
+	Secret                  string         `json:"-" gorm:"size:100;not null"`
+	PreviousSecret          string         `json:"-" gorm:"size:100"`
+	PreviousSecretExpiresAt *time.Time     `json:"previous_secret_expires_at,omitempty"`
+	IsActive                bool           `json:"is_active" gorm:"default:true;index"`
+	ConsecutiveFailures     int            `json:"consecutive_failures"`
+	FailingSince            *time.Time     `json:"failing_since,omitempty"`
+	DisabledAt              *time.Time     `json:"disabled_at,omitempty"`
+	DisabledReason          string         `json:"disabled_reason,omitempty" gorm:"size:255"`
+	CreatedByID             string         `json:"created_by_id" gorm:"size:255"`
+	CreatedAt               time.Time      `json:"created_at"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+	DeletedAt               gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDelivery is one event sent to one subscription. Payload is the exact
+// request body, which stays the same across retries and redeliveries.
+type WebhookDelivery struct {
+	ID             uint                     `json:"id" gorm:"primaryKey"`
+	SubscriptionID uint                     `json:"subscription_id" gorm:"not null;uniqueIndex:idx_webhook_delivery_event"`
+	ClubID         uint                     `json:"club_id" gorm:"not null;index"`
+	EventID        string                   `json:"event_id" gorm:"size:100;not null;uniqueIndex:idx_webhook_delivery_event"`
+	EventType      string                   `json:"event_type" gorm:"size:100;index"`
+	Payload        string                   `json:"payload" gorm:"type:text"`
+	Status         WebhookDeliveryStatus    `json:"status" gorm:"size:20;default:'pending';index"`
+	Attempts       int                      `json:"attempts"`
+	NextAttemptAt  *time.Time               `json:"next_attempt_at,omitempty" gorm:"index"`
+	ResponseStatus int                      `json:"response_status,omitempty"`
+	LastError      string                   `json:"last_error,omitempty" gorm:"type:text"`
+	DeliveredAt    *time.Time               `json:"delivered_at,omitempty"`
+	ClaimedBy      string                   `json:"-" gorm:"size:100"`
+	ClaimedUntil   *time.Time               `json:"-" gorm:"index"`
+	AttemptLog     []WebhookDeliveryAttempt `json:"attempt_log,omitempty" gorm:"foreignKey:DeliveryID"`
+	CreatedAt      time.Time                `json:"created_at" gorm:"index"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookDeliveryAttempt is the request and response of one attempt at a
+// webhook delivery. Response bodies are truncated.
+type WebhookDeliveryAttempt struct {
+	ID              uint              `json:"id" gorm:"primaryKey"`
+	DeliveryID      uint              `json:"delivery_id" gorm:"not null;index"`
+	Attempt         int               `json:"attempt"`
+	RequestHeaders  map[string]string `json:"request_headers" gorm:"type:json;serializer:json"`
+	ResponseStatus  int               `json:"response_status,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty" gorm:"type:json;serializer:json"`
+	ResponseBody    string            `json:"response_body,omitempty" gorm:"type:text"`
+	Error           string            `json:"error,omitempty" gorm:"type:text"`
+	DurationMs      int64             `json:"duration_ms"`
+	CreatedAt       time.Time         `json:"created_at"`
+}
+
+func (WebhookDeliveryAttempt) TableName() string {
+	return "webhook_delivery_attempts"
+}
+
+// MatchesEventType reports whether the rule applies to the event type
+func (r *NotificationRule) MatchesEventType(eventType string) bool {
+	return matchEventType(r.EventType, eventType)
+}
+
+// MatchesEventType reports whether the subscription receives the event type
+func (w *WebhookSubscription) MatchesEventType(eventType string) bool {
+	for _, pattern := range w.EventTypes {
+		if matchEventType(pattern, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// SigningSecrets returns the secrets deliveries are signed with at now
+func (w *WebhookSubscription) SigningSecrets(now time.Time) []string {
+	secrets := []string{w.Secret}
+	if w.PreviousSecret != "" && w.PreviousSecretExpiresAt != nil && now.Before(*w.PreviousSecretExpiresAt) {
+		secrets = append(secrets, w.PreviousSecret)
+	}
+	return secrets
+}
+
+// matchEventType matches an event type against an exact type, "*" or a prefix ending in "*"
+func matchEventType(pattern, eventType string) bool {
+	if pattern == "*" || pattern == eventType {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(eventType, prefix)
+	}
+	return false
+}
+
+// IsCritical reports whether the notification bypasses quiet hours and delivery windows
+func (n *Notification) IsCritical() bool {
+	return n.Priority == NotificationPritorityCritical
+}
+
+// IsDigest reports whether the notification is a digest of other notifications
+func (n *Notification) IsDigest() bool {
+	return n.DigestFrequency != ""
+}
+
+// MarkAsBatched holds the notification for the digest it was added to
+func (n *Notification) MarkAsBatched(digest *Notification, detail string) {
+	n.Status = NotificationStatusBatched
+	n.DigestID = &digest.ID
+	n.ScheduledFor = digest.ScheduledFor
+	n.RecordDecision(DeliveryActionBatched, "digest", detail, digest.ScheduledFor)
+}
+
+// RecordDecision appends a delivery decision to the notification
+func (n *Notification) RecordDecision(action, reason, detail string, until *time.Time) {
+	n.Decisions = append(n.Decisions, DeliveryDecision{
+		Action: action,
+		Reason: reason,
+		Detail: detail,
+		Until:  until,
+		At:     time.Now(),
+	})
+}
+
+// HasDecision reports whether a decision with the given reason was already recorded
+func (n *Notification) HasDecision(reason string) bool {
+	for _, decision := range n.Decisions {
+		if decision.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkAsSuppressed stops delivery of the notification
+func (n *Notification) MarkAsSuppressed(reason, detail string) {
+	n.Status = NotificationStatusSuppressed
+	n.RecordDecision(DeliveryActionSuppressed, reason, detail, nil)
+}
+
 // IsScheduled checks if the notification is scheduled for future delivery
 func (n *Notification) IsScheduled() bool {
 	return n.ScheduledFor != nil && n.ScheduledFor.After(time.Now())
@@ -130,7 +590,7 @@ func (n *Notification) IsScheduled() bool {
 
 // CanRetry checks if the notification can be retried
 func (n *Notification) CanRetry() bool {
-	return n.Status == NotificationStatusFailed && n.RetryCount < 3
+	return n.Status == NotificationStatusFailed && n.RetryCount < MaxDeliveryAttempts
 }
 
 // MarkAsSent updates the notification status to sent
@@ -154,6 +614,24 @@ func (n *Notification) MarkAsRead() {
 	n.ReadAt = &now
 }
 
+// IsInInbox reports whether the notification is shown in the user's in-app inbox
+func (n *Notification) IsInInbox() bool {
+	if n.Type != NotificationTypeInApp || n.UserID == nil {
+		return false
+	}
+	switch n.Status {
+	case NotificationStatusSent, NotificationStatusDelivered, NotificationStatusRead:
+		return true
+	}
+	return false
+}
+
+// Pin keeps the notification at the top of the user's inbox
+func (n *Notification) Pin() {
+	now := time.Now()
+	n.PinnedAt = &now
+}
+
 // MarkAsFailed updates the notification status to failed
 func (n *Notification) MarkAsFailed(errorMsg string) {
 	n.Status = NotificationStatusFailed
@@ -162,3 +640,33 @@ func (n *Notification) MarkAsFailed(errorMsg string) {
 	n.FailedAt = &now
 	n.RetryCount++
 }
+
+// MarkAsBounced records that the provider could not deliver an accepted message
+func (n *Notification) MarkAsBounced(reason string) {
+	n.Status = NotificationStatusBounced
+	n.ErrorMessage = reason
+	now := time.Now()
+	n.FailedAt = &now
+	n.NextAttemptAt = nil
+}
+
+// MarkAsUndeliverable stops delivery to a recipient that can never be reached
+func (n *Notification) MarkAsUndeliverable(reason string) {
+	n.Status = NotificationStatusUndeliverable
+	n.ErrorMessage = reason
+	now := time.Now()
+	n.FailedAt = &now
+	n.NextAttemptAt = nil
+}
+
+// MarkAsDeadLetter stops retrying a notification whose delivery attempts are exhausted
+func (n *Notification) MarkAsDeadLetter() {
+	n.Status = NotificationStatusDeadLetter
+	n.NextAttemptAt = nil
+}
+
+// Release clears the delivery claim so the next save hands the notification back
+func (n *Notification) Release() {
+	n.ClaimedBy = ""
+	n.ClaimedUntil = nil
+}
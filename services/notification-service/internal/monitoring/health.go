@@ -64,6 +64,10 @@ func (h *HealthChecker) HealthCheck(ctx context.Context) *SystemHealth {
 		health.Components["sms_provider"] = h.checkSMSProvider(ctx)
 		health.Components["push_provider"] = h.checkPushProvider(ctx)
 		health.Components["webhook_provider"] = h.checkWebhookProvider(ctx)
+
+		for channel, statuses := range h.providers.PoolStatus() {
+			health.Components[channel+"_provider_pool"] = h.checkProviderPool(channel, statuses)
+		}
 	}
 
 	// Check system resources
@@ -246,6 +250,40 @@ func (h *HealthChecker) checkWebhookProvider(ctx context.Context) HealthStatus {
 	}
 }
 
+// checkProviderPool reports a channel's pool as degraded while some of its
+// providers have open circuits and unhealthy once all of them do
+func (h *HealthChecker) checkProviderPool(channel string, statuses []providers.ProviderStatus) HealthStatus {
+	details := make(map[string]string, len(statuses))
+	open := 0
+	for _, status := range statuses {
+		details[status.Name] = status.State
+		if status.State == "open" {
+			open++
+		}
+	}
+
+	switch {
+	case open == len(statuses):
+		return HealthStatus{
+			Status:  "unhealthy",
+			Message: fmt.Sprintf("All %s providers have open circuits", channel),
+			Details: details,
+		}
+	case open > 0:
+		return HealthStatus{
+			Status:  "degraded",
+			Message: fmt.Sprintf("%d of %d %s providers have open circuits", open, len(statuses), channel),
+			Details: details,
+		}
+	default:
+		return HealthStatus{
+			Status:  "healthy",
+			Message: fmt.Sprintf("All %s providers are accepting traffic", channel),
+			Details: details,
+		}
+	}
+}
+
 // checkSystemResources checks system resource utilization
 func (h *HealthChecker) checkSystemResources() HealthStatus {
 	// In a real implementation, you would check:
@@ -17,10 +17,40 @@ type NotificationMetrics struct {
 	NotificationsFailed  *prometheus.CounterVec
 	NotificationsRead    *prometheus.CounterVec
 
+	// Preference metrics
+	PreferenceDecisions *prometheus.CounterVec
+
+	// Rule metrics
+	RuleEvents *prometheus.CounterVec
+
 	// Delivery metrics by provider
 	DeliveryDuration *prometheus.HistogramVec
 	DeliveryAttempts *prometheus.CounterVec
 
+	// Provider pool metrics
+	ProviderRequests     *prometheus.CounterVec
+	ProviderCircuitState *prometheus.GaugeVec
+
+	// Delivery receipt metrics
+	DeliveryReceipts *prometheus.CounterVec
+
+	// Digest metrics
+	DigestsSent *prometheus.CounterVec
+	DigestItems *prometheus.CounterVec
+
+	// Inbox metrics
+	InboxEvents  *prometheus.CounterVec
+	InboxStreams prometheus.Gauge
+
+	// Push device metrics
+	DevicesRegistered *prometheus.CounterVec
+	DevicesPruned     *prometheus.CounterVec
+
+	// Webhook subscription metrics
+	WebhookDeliveries            *prometheus.CounterVec
+	WebhookDeliveryDuration      *prometheus.HistogramVec
+	WebhookSubscriptionsDisabled *prometheus.CounterVec
+
 	// Queue metrics
 	PendingNotifications prometheus.Gauge
 	FailedNotifications  prometheus.Gauge
@@ -79,6 +109,24 @@ func NewNotificationMetrics(logger logging.Logger) *NotificationMetrics {
 			[]string{"club_id", "type"},
 		),
 
+		// Preference metrics
+		PreferenceDecisions: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_preference_decisions_total",
+				Help: "Total number of notifications suppressed, deferred or rerouted by user preferences",
+			},
+			[]string{"club_id", "type", "action", "reason"},
+		),
+
+		// Rule metrics
+		RuleEvents: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_rule_events_total",
+				Help: "Total number of domain events handled by the notification rules engine",
+			},
+			[]string{"event_type", "outcome"},
+		),
+
 		// Delivery metrics
 		DeliveryDuration: promauto.NewHistogramVec(
 			prometheus.HistogramOpts{
@@ -97,6 +145,108 @@ func NewNotificationMetrics(logger logging.Logger) *NotificationMetrics {
 			[]string{"type", "provider", "attempt"},
 		),
 
+		// Provider pool metrics
+		ProviderRequests: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_provider_requests_total",
+				Help: "Total number of provider pool decisions by outcome (success, failed, throttled, circuit_open)",
+			},
+			[]string{"channel", "provider", "outcome"},
+		),
+
+		ProviderCircuitState: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "notification_provider_circuit_state",
+				Help: "Circuit breaker state per provider (0 closed, 1 half-open, 2 open)",
+			},
+			[]string{"channel", "provider"},
+		),
+
+		// Delivery receipt metrics
+		DeliveryReceipts: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_delivery_receipts_total",
+				Help: "Total number of provider delivery receipts by reported status",
+			},
+			[]string{"type", "status"},
+		),
+
+		// Digest metrics
+		DigestsSent: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_digests_sent_total",
+				Help: "Total number of digests sent",
+			},
+			[]string{"type", "frequency"},
+		),
+
+		DigestItems: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_digest_items_total",
+				Help: "Total number of notifications delivered inside digests",
+			},
+			[]string{"type", "frequency"},
+		),
+
+		// Inbox metrics
+		InboxEvents: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_inbox_events_total",
+				Help: "Total number of inbox changes streamed to users by event type",
+			},
+			[]string{"event"},
+		),
+
+		InboxStreams: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "notification_inbox_streams",
+				Help: "Number of connected inbox event streams",
+			},
+		),
+
+		// Push device metrics
+		DevicesRegistered: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_push_devices_registered_total",
+				Help: "Total number of push device registrations by platform",
+			},
+			[]string{"platform"},
+		),
+
+		DevicesPruned: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_push_devices_pruned_total",
+				Help: "Total number of push devices unregistered as stale by platform and reason",
+			},
+			[]string{"platform", "reason"},
+		),
+
+		// Webhook subscription metrics
+		WebhookDeliveries: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_webhook_deliveries_total",
+				Help: "Total number of webhook subscription delivery attempts by event type and outcome",
+			},
+			[]string{"event_type", "outcome"},
+		),
+
+		WebhookDeliveryDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "notification_webhook_delivery_duration_seconds",
+				Help:    "Time taken by subscriber endpoints to answer webhook deliveries",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"outcome"},
+		),
+
+		WebhookSubscriptionsDisabled: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "notification_webhook_subscriptions_disabled_total",
+				Help: "Total number of webhook subscriptions disabled automatically by reason",
+			},
+			[]string{"reason"},
+		),
+
 		// Queue metrics
 		PendingNotifications: promauto.NewGauge(
 			prometheus.GaugeOpts{
@@ -196,6 +346,16 @@ func (m *NotificationMetrics) RecordNotificationRead(clubID, notificationType st
 	m.NotificationsRead.WithLabelValues(clubID, notificationType).Inc()
 }
 
+// RecordPreferenceDecision records a notification being suppressed, deferred or rerouted by user preferences
+func (m *NotificationMetrics) RecordPreferenceDecision(clubID, notificationType, action, reason string) {
+	m.PreferenceDecisions.WithLabelValues(clubID, notificationType, action, reason).Inc()
+}
+
+// RecordRuleEvent records the outcome of handling a domain event in the rules engine
+func (m *NotificationMetrics) RecordRuleEvent(eventType, outcome string) {
+	m.RuleEvents.WithLabelValues(eventType, outcome).Inc()
+}
+
 // RecordDeliveryDuration records the duration of a delivery attempt
 func (m *NotificationMetrics) RecordDeliveryDuration(notificationType, provider, status string, duration time.Duration) {
 	m.DeliveryDuration.WithLabelValues(notificationType, provider, status).Observe(duration.Seconds())
@@ -214,6 +374,70 @@ func (m *NotificationMetrics) RecordDeliveryAttempt(notificationType, provider s
 	m.DeliveryAttempts.WithLabelValues(notificationType, provider, attempt).Inc()
 }
 
+// RecordProviderRequest records the outcome of routing a delivery to a pooled provider
+func (m *NotificationMetrics) RecordProviderRequest(channel, provider, outcome string) {
+	m.ProviderRequests.WithLabelValues(channel, provider, outcome).Inc()
+}
+
+// SetProviderCircuitState records a pooled provider's circuit breaker state
+func (m *NotificationMetrics) SetProviderCircuitState(channel, provider, state string) {
+	value := 0.0
+	switch state {
+	case "half-open":
+		value = 1
+	case "open":
+		value = 2
+	}
+	m.ProviderCircuitState.WithLabelValues(channel, provider).Set(value)
+}
+
+// RecordDeliveryReceipt records a delivery receipt reported by a provider
+func (m *NotificationMetrics) RecordDeliveryReceipt(notificationType, status string) {
+	m.DeliveryReceipts.WithLabelValues(notificationType, status).Inc()
+}
+
+// RecordDigestSent records a sent digest and the notifications it contained
+func (m *NotificationMetrics) RecordDigestSent(notificationType, frequency string, items int) {
+	m.DigestsSent.WithLabelValues(notificationType, frequency).Inc()
+	m.DigestItems.WithLabelValues(notificationType, frequency).Add(float64(items))
+}
+
+// RecordInboxEvent records a change to a user's inbox
+func (m *NotificationMetrics) RecordInboxEvent(event string) {
+	m.InboxEvents.WithLabelValues(event).Inc()
+}
+
+// InboxStreamOpened counts a connected inbox stream
+func (m *NotificationMetrics) InboxStreamOpened() {
+	m.InboxStreams.Inc()
+}
+
+// InboxStreamClosed uncounts a disconnected inbox stream
+func (m *NotificationMetrics) InboxStreamClosed() {
+	m.InboxStreams.Dec()
+}
+
+// RecordDeviceRegistered records a device registering for push
+func (m *NotificationMetrics) RecordDeviceRegistered(platform string) {
+	m.DevicesRegistered.WithLabelValues(platform).Inc()
+}
+
+// RecordDevicesPruned records stale devices that were unregistered
+func (m *NotificationMetrics) RecordDevicesPruned(platform, reason string, count int64) {
+	m.DevicesPruned.WithLabelValues(platform, reason).Add(float64(count))
+}
+
+// RecordWebhookDelivery records one attempt to deliver an event to a webhook subscription
+func (m *NotificationMetrics) RecordWebhookDelivery(eventType, outcome string, duration time.Duration) {
+	m.WebhookDeliveries.WithLabelValues(eventType, outcome).Inc()
+	m.WebhookDeliveryDuration.WithLabelValues(outcome).Observe(duration.Seconds())
+}
+
+// RecordWebhookSubscriptionDisabled records a subscription disabled after its endpoint kept failing
+func (m *NotificationMetrics) RecordWebhookSubscriptionDisabled(reason string) {
+	m.WebhookSubscriptionsDisabled.WithLabelValues(reason).Inc()
+}
+
 // UpdatePendingNotifications updates the pending notifications gauge
 func (m *NotificationMetrics) UpdatePendingNotifications(count float64) {
 	m.PendingNotifications.Set(count)
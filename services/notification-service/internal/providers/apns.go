@@ -0,0 +1,273 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"reciprocal-clubs-backend/pkg/shared/logging"
+)
+
+// APNs endpoints
+const (
+	APNsProductionURL = "https://api.push.apple.com"
+	APNsSandboxURL    = "https://api.sandbox.push.apple.com"
+)
+
+// apnsTokenRefresh is how long a provider token is reused; APNs rejects
+// tokens older than an hour and throttles ones replaced more often than
+// every twenty minutes
+const apnsTokenRefresh = 50 * time.Minute
+
+// apnsInvalidTokenReasons are APNs rejection reasons meaning the device token
+// will never be valid again
+var apnsInvalidTokenReasons = map[string]bool{
+	"BadDeviceToken":         true,
+	"Unregistered":           true,
+	"DeviceTokenNotForTopic": true,
+}
+
+// APNsProvider handles push notifications to iOS devices through the Apple
+// Push Notification service, authenticating with a token signing key
+type APNsProvider struct {
+	key        *ecdsa.PrivateKey
+	keyID      string
+	teamID     string
+	topic      string
+	baseURL    string
+	httpClient *http.Client
+	logger     logging.Logger
+
+	mu            sync.Mutex
+	token         string
+	tokenIssuedAt time.Time
+}
+
+// NewAPNsProvider creates an APNs provider from a PEM encoded .p8 signing
+// key; topic is the app's bundle ID
+func NewAPNsProvider(signingKey, keyID, teamID, topic string, logger logging.Logger) (*APNsProvider, error) {
+	key, err := parseAPNsKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &APNsProvider{
+		key:     key,
+		keyID:   keyID,
+		teamID:  teamID,
+		topic:   topic,
+		baseURL: APNsProductionURL,
+		// net/http negotiates the HTTP/2 APNs requires over TLS
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		logger: logger,
+	}, nil
+}
+
+// SetEndpoint overrides the APNs URL, for the sandbox and local stand-ins
+func (p *APNsProvider) SetEndpoint(endpoint string) {
+	if endpoint != "" {
+		p.baseURL = endpoint
+	}
+}
+
+// APNsPayload is the JSON body of an APNs request; custom data sits beside aps
+type APNsPayload struct {
+	APS  APNsAPS           `json:"aps"`
+	Data map[string]string `json:"-"`
+}
+
+// APNsAPS is the Apple-defined part of an APNs payload
+type APNsAPS struct {
+	Alert    APNsAlert `json:"alert"`
+	Sound    string    `json:"sound,omitempty"`
+	Category string    `json:"category,omitempty"`
+	ThreadID string    `json:"thread-id,omitempty"`
+}
+
+// APNsAlert is the visible content of an APNs notification
+type APNsAlert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body"`
+}
+
+// MarshalJSON flattens the custom data beside aps
+func (p APNsPayload) MarshalJSON() ([]byte, error) {
+	body := make(map[string]interface{}, len(p.Data)+1)
+	for key, value := range p.Data {
+		body[key] = value
+	}
+	body["aps"] = p.APS
+	return json.Marshal(body)
+}
+
+// APNsError is the body APNs returns with a rejected request
+type APNsError struct {
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp,omitempty"`
+}
+
+// Deliver sends a pooled message to the APNs device token in message.To
+func (p *APNsProvider) Deliver(ctx context.Context, message *Message) (string, error) {
+	if message.To == "" {
+		return "", fmt.Errorf("device token is required")
+	}
+	if message.Text == "" {
+		return "", fmt.Errorf("notification body is required")
+	}
+
+	payload := APNsPayload{
+		APS: APNsAPS{
+			Alert: APNsAlert{Title: message.Subject, Body: message.Text},
+			Sound: "default",
+		},
+		Data: message.Metadata,
+	}
+	if sound, ok := message.Metadata["ios_sound"]; ok {
+		payload.APS.Sound = sound
+	}
+	if category, ok := message.Metadata["ios_category"]; ok {
+		payload.APS.Category = category
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal APNs payload: %w", err)
+	}
+
+	token, err := p.providerToken()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/3/device/"+url.PathEscape(message.To), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+token)
+	req.Header.Set("apns-topic", p.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-expiration", fmt.Sprintf("%d", time.Now().Add(time.Hour).Unix()))
+	if message.Priority == "low" {
+		req.Header.Set("apns-priority", "5")
+	} else {
+		req.Header.Set("apns-priority", "10")
+	}
+	if message.CollapseKey != "" {
+		req.Header.Set("apns-collapse-id", message.CollapseKey)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		p.logger.Error("Failed to send APNs request", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return "", fmt.Errorf("failed to send APNs request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		messageID := resp.Header.Get("apns-id")
+		p.logger.Info("APNs notification sent successfully", map[string]interface{}{
+			"message_id": messageID,
+		})
+		return messageID, nil
+	}
+
+	var apnsErr APNsError
+	if err := json.NewDecoder(resp.Body).Decode(&apnsErr); err != nil {
+		apnsErr.Reason = http.StatusText(resp.StatusCode)
+	}
+
+	p.logger.Error("APNs API error", map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"reason":      apnsErr.Reason,
+	})
+
+	if resp.StatusCode == http.StatusGone || apnsInvalidTokenReasons[apnsErr.Reason] {
+		return "", fmt.Errorf("%w: APNs %s", ErrInvalidRecipient, apnsErr.Reason)
+	}
+	if apnsErr.Reason == "ExpiredProviderToken" {
+		p.resetProviderToken()
+	}
+	return "", fmt.Errorf("APNs API error: status %d: %s", resp.StatusCode, apnsErr.Reason)
+}
+
+// providerToken returns the signed JWT requests are authorized with,
+// signing a new one when the current one is due to be replaced
+func (p *APNsProvider) providerToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && time.Since(p.tokenIssuedAt) < apnsTokenRefresh {
+		return p.token, nil
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": p.teamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = p.keyID
+
+	signed, err := token.SignedString(p.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign APNs provider token: %w", err)
+	}
+
+	p.token, p.tokenIssuedAt = signed, now
+	return signed, nil
+}
+
+// resetProviderToken makes the next request sign a new provider token
+func (p *APNsProvider) resetProviderToken() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.token = ""
+}
+
+// ValidateConfig validates the APNs provider configuration
+func (p *APNsProvider) ValidateConfig() error {
+	if p.keyID == "" {
+		return fmt.Errorf("APNs key ID is required")
+	}
+	if p.teamID == "" {
+		return fmt.Errorf("APNs team ID is required")
+	}
+	if p.topic == "" {
+		return fmt.Errorf("APNs topic is required")
+	}
+	return nil
+}
+
+// parseAPNsKey parses the PKCS #8 P-256 key Apple issues as a .p8 file
+func parseAPNsKey(signingKey string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(signingKey))
+	if block == nil {
+		return nil, fmt.Errorf("APNs signing key is not PEM encoded")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse APNs signing key: %w", err)
+	}
+
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("APNs signing key must be an ECDSA key")
+	}
+	return ecKey, nil
+}
@@ -2,9 +2,14 @@ package providers
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/smtp"
+	"net/textproto"
 	"strings"
+	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/logging"
 )
@@ -71,6 +76,91 @@ func (e *EmailProvider) SendEmail(ctx context.Context, to, subject, body string,
 	return nil
 }
 
+// SendMultipartEmail sends an email with plain-text and HTML alternatives
+func (e *EmailProvider) SendMultipartEmail(ctx context.Context, to, subject, textBody, htmlBody string, metadata map[string]string) error {
+	if to == "" {
+		return fmt.Errorf("recipient email is required")
+	}
+	if subject == "" {
+		return fmt.Errorf("email subject is required")
+	}
+	if textBody == "" && htmlBody == "" {
+		return fmt.Errorf("email body is required")
+	}
+
+	auth := smtp.PlainAuth("", e.smtpUsername, e.smtpPassword, e.smtpHost)
+	msg := e.composeMultipartMessage(to, subject, textBody, htmlBody, metadata)
+
+	addr := fmt.Sprintf("%s:%s", e.smtpHost, e.smtpPort)
+	if err := smtp.SendMail(addr, auth, e.fromEmail, []string{to}, []byte(msg)); err != nil {
+		e.logger.Error("Failed to send email", map[string]interface{}{
+			"error":     err.Error(),
+			"recipient": to,
+			"subject":   subject,
+		})
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	e.logger.Info("Email sent successfully", map[string]interface{}{
+		"recipient": to,
+		"subject":   subject,
+		"multipart": true,
+	})
+
+	return nil
+}
+
+// Deliver sends a pooled message, with a plain-text alternative when it has
+// HTML. SMTP does not return an ID, so the message is given a Message-ID that
+// bounce and complaint reports refer back to.
+func (e *EmailProvider) Deliver(ctx context.Context, message *Message) (string, error) {
+	messageID, err := e.newMessageID()
+	if err != nil {
+		return "", err
+	}
+
+	metadata := make(map[string]string, len(message.Metadata)+1)
+	for key, value := range message.Metadata {
+		metadata[key] = value
+	}
+	metadata["header_Message-ID"] = "<" + messageID + ">"
+
+	if message.HTML != "" {
+		err = e.SendMultipartEmail(ctx, message.To, message.Subject, message.Text, message.HTML, metadata)
+	} else {
+		err = e.SendEmail(ctx, message.To, message.Subject, message.Text, metadata)
+	}
+	if err != nil {
+		var smtpErr *textproto.Error
+		if errors.As(err, &smtpErr) && permanentSMTPCodes[smtpErr.Code] {
+			return "", fmt.Errorf("%w: %v", ErrInvalidRecipient, err)
+		}
+		return "", err
+	}
+	return messageID, nil
+}
+
+// permanentSMTPCodes are SMTP replies rejecting the recipient mailbox for good
+var permanentSMTPCodes = map[int]bool{
+	550: true,
+	551: true,
+	553: true,
+}
+
+// newMessageID returns a globally unique Message-ID in the sender's domain
+func (e *EmailProvider) newMessageID() (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate message ID: %w", err)
+	}
+
+	domain := "localhost"
+	if at := strings.LastIndex(e.fromEmail, "@"); at >= 0 && at < len(e.fromEmail)-1 {
+		domain = e.fromEmail[at+1:]
+	}
+	return hex.EncodeToString(random) + "@" + domain, nil
+}
+
 // composeMessage creates the email message with proper headers
 func (e *EmailProvider) composeMessage(to, subject, body string, metadata map[string]string) string {
 	var msg strings.Builder
@@ -111,6 +201,45 @@ func (e *EmailProvider) composeMessage(to, subject, body string, metadata map[st
 	return msg.String()
 }
 
+// composeMultipartMessage creates a multipart/alternative message so clients
+// without HTML support fall back to the plain-text part
+func (e *EmailProvider) composeMultipartMessage(to, subject, textBody, htmlBody string, metadata map[string]string) string {
+	var msg strings.Builder
+	boundary := fmt.Sprintf("=_alt_%d", time.Now().UnixNano())
+
+	msg.WriteString(fmt.Sprintf("From: %s\r\n", e.fromEmail))
+	msg.WriteString(fmt.Sprintf("To: %s\r\n", to))
+	msg.WriteString(fmt.Sprintf("Subject: %s\r\n", subject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString(fmt.Sprintf("Content-Type: multipart/alternative; boundary=\"%s\"\r\n", boundary))
+
+	for key, value := range metadata {
+		if strings.HasPrefix(key, "header_") {
+			msg.WriteString(fmt.Sprintf("%s: %s\r\n", strings.TrimPrefix(key, "header_"), value))
+		}
+	}
+
+	msg.WriteString("\r\n")
+
+	if textBody != "" {
+		msg.WriteString(fmt.Sprintf("--%s\r\n", boundary))
+		msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+		msg.WriteString(textBody)
+		msg.WriteString("\r\n")
+	}
+
+	if htmlBody != "" {
+		msg.WriteString(fmt.Sprintf("--%s\r\n", boundary))
+		msg.WriteString("Content-Type: text/html; charset=utf-8\r\n\r\n")
+		msg.WriteString(htmlBody)
+		msg.WriteString("\r\n")
+	}
+
+	msg.WriteString(fmt.Sprintf("--%s--\r\n", boundary))
+
+	return msg.String()
+}
+
 // ValidateConfig validates the email provider configuration
 func (e *EmailProvider) ValidateConfig() error {
 	if e.smtpHost == "" {
@@ -0,0 +1,473 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"reciprocal-clubs-backend/pkg/shared/logging"
+	"reciprocal-clubs-backend/services/notification-service/internal/middleware"
+)
+
+// ErrNoProviderAvailable is returned when every provider in a pool has an open circuit
+var ErrNoProviderAvailable = errors.New("no provider available")
+
+// ErrInvalidRecipient is wrapped by providers when the recipient can never be
+// reached (unknown mailbox, invalid number, unregistered device token). Pools
+// do not fail over on it and it does not count against a provider's circuit.
+var ErrInvalidRecipient = errors.New("recipient is permanently undeliverable")
+
+// Message is a channel-neutral payload handed to a provider
+type Message struct {
+	To       string
+	Subject  string
+	Text     string
+	HTML     string
+	Metadata map[string]string
+	// Priority and CollapseKey are the notification's; push providers map
+	// them to their platform's urgency and collapsing
+	Priority    string
+	CollapseKey string
+}
+
+// ChannelProvider delivers messages for one channel through one upstream
+// provider, returning the provider's ID for the message
+type ChannelProvider interface {
+	Deliver(ctx context.Context, message *Message) (string, error)
+}
+
+// Delivery identifies a message accepted by a pooled provider
+type Delivery struct {
+	Provider  string
+	MessageID string
+}
+
+// PushTarget is one device a multicast push is sent to
+type PushTarget struct {
+	// Token is the FCM registration token, APNs device token or Web Push endpoint
+	Token string
+	// P256dh and Auth are the keys of a Web Push subscription, base64url encoded
+	P256dh string
+	Auth   string
+}
+
+// PushResult is what happened to one target of a multicast push. Err wraps
+// ErrInvalidRecipient when the target's token will never be valid again.
+type PushResult struct {
+	Token     string
+	MessageID string
+	// CanonicalToken is set when the push service reports the device is now
+	// registered under a different token
+	CanonicalToken string
+	Err            error
+}
+
+// MulticastProvider is implemented by providers that send one push to many
+// devices themselves; pools deliver to each target in turn through providers
+// that do not
+type MulticastProvider interface {
+	SendMulticast(ctx context.Context, message *Message, targets []PushTarget) ([]PushResult, error)
+}
+
+// MulticastDelivery identifies the provider that accepted a multicast push
+// and what happened to each of its targets
+type MulticastDelivery struct {
+	Provider string
+	Results  []PushResult
+}
+
+// RoutingConfig controls how a provider takes part in its channel's pool
+type RoutingConfig struct {
+	// Name identifies the provider in metrics and health; it must be unique within the pool
+	Name string `json:"name"`
+	// Priority orders providers, lowest first; providers only receive traffic
+	// when every provider with a lower priority is throttled, open or failing
+	Priority int `json:"priority"`
+	// Weight shares traffic between providers of equal priority
+	Weight int `json:"weight"`
+	// RateLimit is the sustained messages per second; negative means unlimited
+	RateLimit float64 `json:"rate_limit"`
+	Burst     int     `json:"burst"`
+	// FailureThreshold is the consecutive failures that open the circuit
+	FailureThreshold uint32 `json:"failure_threshold"`
+	// OpenTimeout is how long an open circuit rejects traffic before probing
+	OpenTimeout time.Duration `json:"open_timeout"`
+}
+
+// PoolObserver receives per-provider outcomes, typically to export metrics
+type PoolObserver interface {
+	RecordProviderRequest(channel, provider, outcome string)
+	SetProviderCircuitState(channel, provider, state string)
+}
+
+// ProviderStatus describes the health of one provider in a pool
+type ProviderStatus struct {
+	Name                string  `json:"name"`
+	Priority            int     `json:"priority"`
+	Weight              int     `json:"weight"`
+	State               string  `json:"state"`
+	ConsecutiveFailures uint32  `json:"consecutive_failures"`
+	AvailableTokens     float64 `json:"available_tokens"`
+}
+
+type poolMember struct {
+	routing  RoutingConfig
+	provider ChannelProvider
+	limiter  *rate.Limiter
+	breaker  *middleware.CircuitBreaker
+}
+
+// ProviderPool delivers a channel's messages through several providers with
+// per-provider rate limits and circuit breakers, failing over by priority
+type ProviderPool struct {
+	channel  string
+	defaults RoutingConfig
+	logger   logging.Logger
+
+	mu       sync.RWMutex
+	members  []*poolMember
+	observer PoolObserver
+	rand     *rand.Rand
+}
+
+// NewProviderPool creates an empty pool; defaults fill unset routing fields of added providers
+func NewProviderPool(channel string, defaults RoutingConfig, logger logging.Logger) *ProviderPool {
+	return &ProviderPool{
+		channel:  channel,
+		defaults: defaults,
+		logger:   logger,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Add registers a provider with the pool
+func (p *ProviderPool) Add(provider ChannelProvider, routing RoutingConfig) error {
+	routing = p.withDefaults(routing)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, member := range p.members {
+		if member.routing.Name == routing.Name {
+			return fmt.Errorf("%s provider %q already registered", p.channel, routing.Name)
+		}
+	}
+
+	member := &poolMember{
+		routing:  routing,
+		provider: provider,
+	}
+	if routing.RateLimit > 0 {
+		member.limiter = rate.NewLimiter(rate.Limit(routing.RateLimit), routing.Burst)
+	}
+
+	name := routing.Name
+	threshold := routing.FailureThreshold
+	member.breaker = middleware.NewCircuitBreaker(middleware.CircuitBreakerConfig{
+		Name:        p.channel + ":" + name,
+		MaxRequests: 1,
+		Timeout:     routing.OpenTimeout,
+		ReadyToTrip: func(counts middleware.Counts) bool {
+			return counts.ConsecutiveFailures >= threshold
+		},
+		OnStateChange: func(_ string, from, to middleware.CircuitState) {
+			if observer := p.getObserver(); observer != nil {
+				observer.SetProviderCircuitState(p.channel, name, to.String())
+			}
+		},
+	}, p.logger)
+
+	p.members = append(p.members, member)
+	return nil
+}
+
+// SetObserver registers the observer notified of provider outcomes
+func (p *ProviderPool) SetObserver(observer PoolObserver) {
+	p.mu.Lock()
+	p.observer = observer
+	members := p.members
+	p.mu.Unlock()
+
+	for _, member := range members {
+		observer.SetProviderCircuitState(p.channel, member.routing.Name, member.breaker.State().String())
+	}
+}
+
+// Len returns the number of providers in the pool
+func (p *ProviderPool) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.members)
+}
+
+// Deliver sends the message through the first available provider. Providers
+// with an open circuit or no rate limit tokens are skipped; a failing provider
+// falls through to the next. When every remaining provider is only throttled,
+// Deliver waits for the earliest token rather than failing.
+func (p *ProviderPool) Deliver(ctx context.Context, message *Message) (*Delivery, error) {
+	var messageID string
+	provider, err := p.route(ctx, 1, func(ctx context.Context, provider ChannelProvider) error {
+		var err error
+		messageID, err = provider.Deliver(ctx, message)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Delivery{Provider: provider, MessageID: messageID}, nil
+}
+
+// Multicast sends the message to every target through the first available
+// provider, routing and failing over as Deliver does. Only a failure of the
+// whole send fails over; what happened to each target is in the results.
+func (p *ProviderPool) Multicast(ctx context.Context, message *Message, targets []PushTarget) (*MulticastDelivery, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("at least one push target is required")
+	}
+
+	var results []PushResult
+	provider, err := p.route(ctx, len(targets), func(ctx context.Context, provider ChannelProvider) error {
+		var err error
+		results, err = sendMulticast(ctx, provider, message, targets)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MulticastDelivery{Provider: provider, Results: results}, nil
+}
+
+// route makes send with the first available provider and returns the name of
+// the provider that succeeded. cost is how many messages send delivers, which
+// is taken from the provider's rate limit, up to its burst.
+func (p *ProviderPool) route(ctx context.Context, cost int, send func(ctx context.Context, provider ChannelProvider) error) (string, error) {
+	var (
+		errs      []error
+		throttled *poolMember
+		wait      time.Duration
+	)
+
+	for _, member := range p.ordered() {
+		name := member.routing.Name
+
+		if member.breaker.State() == middleware.StateOpen {
+			p.record(name, "circuit_open")
+			continue
+		}
+
+		if member.limiter != nil {
+			reservation := member.limiter.ReserveN(time.Now(), min(cost, member.limiter.Burst()))
+			if delay := reservation.Delay(); delay > 0 {
+				reservation.Cancel()
+				p.record(name, "throttled")
+				if throttled == nil || delay < wait {
+					throttled, wait = member, delay
+				}
+				continue
+			}
+		}
+
+		err := p.attempt(ctx, member, send)
+		if err == nil {
+			return name, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		if ctx.Err() != nil || errors.Is(err, ErrInvalidRecipient) {
+			return "", errors.Join(errs...)
+		}
+	}
+
+	if throttled != nil {
+		if err := throttled.limiter.WaitN(ctx, min(cost, throttled.limiter.Burst())); err != nil {
+			errs = append(errs, fmt.Errorf("%s: rate limited: %w", throttled.routing.Name, err))
+			return "", errors.Join(errs...)
+		}
+		err := p.attempt(ctx, throttled, send)
+		if err == nil {
+			return throttled.routing.Name, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", throttled.routing.Name, err))
+	}
+
+	if len(errs) == 0 {
+		return "", fmt.Errorf("%w: every %s provider has an open circuit", ErrNoProviderAvailable, p.channel)
+	}
+	return "", errors.Join(errs...)
+}
+
+// Status returns the health of every provider, in priority order
+func (p *ProviderPool) Status() []ProviderStatus {
+	p.mu.RLock()
+	members := append([]*poolMember(nil), p.members...)
+	p.mu.RUnlock()
+
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].routing.Priority < members[j].routing.Priority
+	})
+
+	statuses := make([]ProviderStatus, 0, len(members))
+	for _, member := range members {
+		status := ProviderStatus{
+			Name:                member.routing.Name,
+			Priority:            member.routing.Priority,
+			Weight:              member.routing.Weight,
+			State:               member.breaker.State().String(),
+			ConsecutiveFailures: member.breaker.Counts().ConsecutiveFailures,
+			AvailableTokens:     -1,
+		}
+		if member.limiter != nil {
+			status.AvailableTokens = member.limiter.Tokens()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// attempt sends through one provider behind its circuit breaker
+func (p *ProviderPool) attempt(ctx context.Context, member *poolMember, send func(ctx context.Context, provider ChannelProvider) error) error {
+	var rejected error
+	err := member.breaker.Call(ctx, func(ctx context.Context) error {
+		err := send(ctx, member.provider)
+		if errors.Is(err, ErrInvalidRecipient) {
+			// The provider is healthy; it is the recipient that is bad
+			rejected = err
+			return nil
+		}
+		return err
+	})
+	if rejected != nil {
+		p.record(member.routing.Name, "invalid_recipient")
+		return rejected
+	}
+	if err != nil {
+		p.record(member.routing.Name, "failed")
+		p.logger.Warn("Provider delivery failed", map[string]interface{}{
+			"channel":  p.channel,
+			"provider": member.routing.Name,
+			"error":    err.Error(),
+		})
+		return err
+	}
+	p.record(member.routing.Name, "success")
+	return nil
+}
+
+// ordered returns the members by ascending priority, shuffled by weight within a priority
+func (p *ProviderPool) ordered() []*poolMember {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keys := make(map[*poolMember]float64, len(p.members))
+	for _, member := range p.members {
+		// Weighted random sampling without replacement (Efraimidis-Spirakis)
+		keys[member] = -p.rand.ExpFloat64() / float64(member.routing.Weight)
+	}
+
+	members := append([]*poolMember(nil), p.members...)
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].routing.Priority != members[j].routing.Priority {
+			return members[i].routing.Priority < members[j].routing.Priority
+		}
+		return keys[members[i]] > keys[members[j]]
+	})
+	return members
+}
+
+// withDefaults fills unset routing fields from the pool defaults
+func (p *ProviderPool) withDefaults(routing RoutingConfig) RoutingConfig {
+	if routing.Name == "" {
+		routing.Name = p.defaults.Name
+	}
+	if routing.Priority <= 0 {
+		routing.Priority = p.defaults.Priority
+	}
+	if routing.Weight <= 0 {
+		routing.Weight = p.defaults.Weight
+	}
+	if routing.Weight <= 0 {
+		routing.Weight = 1
+	}
+	if routing.RateLimit == 0 {
+		routing.RateLimit = p.defaults.RateLimit
+		if routing.Burst <= 0 {
+			routing.Burst = p.defaults.Burst
+		}
+	}
+	if routing.RateLimit > 0 && routing.Burst <= 0 {
+		routing.Burst = 1
+	}
+	if routing.FailureThreshold == 0 {
+		routing.FailureThreshold = p.defaults.FailureThreshold
+	}
+	if routing.FailureThreshold == 0 {
+		routing.FailureThreshold = 5
+	}
+	if routing.OpenTimeout <= 0 {
+		routing.OpenTimeout = p.defaults.OpenTimeout
+	}
+	if routing.OpenTimeout <= 0 {
+		routing.OpenTimeout = 30 * time.Second
+	}
+	return routing
+}
+
+func (p *ProviderPool) getObserver() PoolObserver {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.observer
+}
+
+func (p *ProviderPool) record(provider, outcome string) {
+	if observer := p.getObserver(); observer != nil {
+		observer.RecordProviderRequest(p.channel, provider, outcome)
+	}
+}
+
+// sendMulticast sends through the provider's own multicast when it has one
+func sendMulticast(ctx context.Context, provider ChannelProvider, message *Message, targets []PushTarget) ([]PushResult, error) {
+	if multicast, ok := provider.(MulticastProvider); ok {
+		return multicast.SendMulticast(ctx, message, targets)
+	}
+
+	return eachTarget(targets, func(target PushTarget) (string, error) {
+		single := *message
+		single.To = target.Token
+		return provider.Deliver(ctx, &single)
+	})
+}
+
+// eachTarget sends to the targets one at a time
+func eachTarget(targets []PushTarget, send func(target PushTarget) (string, error)) ([]PushResult, error) {
+	results := make([]PushResult, len(targets))
+	for i, target := range targets {
+		results[i].Token = target.Token
+		results[i].MessageID, results[i].Err = send(target)
+	}
+
+	if err := multicastFailure(results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// multicastFailure returns the error a multicast fails with as a whole, so
+// the pool fails over: one of its failures when no target was accepted and
+// not every target was rejected as invalid
+func multicastFailure(results []PushResult) error {
+	var failure error
+	for _, result := range results {
+		if result.Err == nil {
+			return nil
+		}
+		if failure == nil && !errors.Is(result.Err, ErrInvalidRecipient) {
+			failure = result.Err
+		}
+	}
+	return failure
+}
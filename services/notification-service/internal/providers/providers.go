@@ -2,6 +2,12 @@ package providers
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/logging"
 )
@@ -11,45 +17,86 @@ type NotificationProviders struct {
 	Email   *EmailProvider
 	SMS     *SMSProvider
 	Push    *PushProvider
+	APNs    *APNsProvider
+	WebPush *WebPushProvider
 	Webhook *WebhookProvider
-	logger  logging.Logger
+
+	// Pools route each channel across its primary and failover providers.
+	// PushPool delivers through FCM; APNSPool and WebPushPool reach iOS
+	// devices and browsers registered for push.
+	EmailPool   *ProviderPool
+	SMSPool     *ProviderPool
+	PushPool    *ProviderPool
+	APNSPool    *ProviderPool
+	WebPushPool *ProviderPool
+
+	smsProviders  []*SMSProvider
+	receiptSecret string
+	logger        logging.Logger
 }
 
 // NewNotificationProviders creates a new providers instance
 func NewNotificationProviders(config *ProvidersConfig, logger logging.Logger) *NotificationProviders {
 	providers := &NotificationProviders{
-		logger: logger,
+		EmailPool:     NewProviderPool("email", DefaultRouting("email"), logger),
+		SMSPool:       NewProviderPool("sms", DefaultRouting("sms"), logger),
+		PushPool:      NewProviderPool("push", DefaultRouting("push"), logger),
+		APNSPool:      NewProviderPool("apns", DefaultRouting("apns"), logger),
+		WebPushPool:   NewProviderPool("webpush", DefaultRouting("webpush"), logger),
+		receiptSecret: config.ReceiptSecret,
+		logger:        logger,
 	}
 
-	// Initialize email provider
+	// Initialize email providers
 	if config.Email != nil {
-		providers.Email = NewEmailProvider(
-			config.Email.SMTPHost,
-			config.Email.SMTPPort,
-			config.Email.SMTPUsername,
-			config.Email.SMTPPassword,
-			config.Email.FromEmail,
-			logger,
-		)
+		providers.Email = newEmailProvider(config.Email, logger)
+		providers.addToPool(providers.EmailPool, providers.Email, config.Email.Routing, 0)
+	}
+	for i, failover := range config.EmailFailover {
+		providers.addToPool(providers.EmailPool, newEmailProvider(failover, logger), failover.Routing, i+1)
 	}
 
-	// Initialize SMS provider
+	// Initialize SMS providers
 	if config.SMS != nil {
-		providers.SMS = NewSMSProvider(
-			config.SMS.AccountSID,
-			config.SMS.AuthToken,
-			config.SMS.FromNumber,
-			logger,
-		)
+		providers.SMS = newSMSProvider(config.SMS, logger)
+		providers.smsProviders = append(providers.smsProviders, providers.SMS)
+		providers.addToPool(providers.SMSPool, providers.SMS, config.SMS.Routing, 0)
+	}
+	for i, failover := range config.SMSFailover {
+		provider := newSMSProvider(failover, logger)
+		providers.smsProviders = append(providers.smsProviders, provider)
+		providers.addToPool(providers.SMSPool, provider, failover.Routing, i+1)
 	}
 
-	// Initialize push provider
+	// Initialize push providers
 	if config.Push != nil {
-		providers.Push = NewPushProvider(
-			config.Push.ServerKey,
-			config.Push.ProjectID,
-			logger,
-		)
+		providers.Push = newPushProvider(config.Push, logger)
+		providers.addToPool(providers.PushPool, providers.Push, config.Push.Routing, 0)
+	}
+	for i, failover := range config.PushFailover {
+		providers.addToPool(providers.PushPool, newPushProvider(failover, logger), failover.Routing, i+1)
+	}
+
+	// Initialize APNs and Web Push providers
+	if config.APNs != nil {
+		if provider, err := newAPNsProvider(config.APNs, logger); err == nil {
+			providers.APNs = provider
+			providers.addToPool(providers.APNSPool, provider, config.APNs.Routing, 0)
+		} else {
+			logger.Error("Failed to initialize APNs provider", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+	if config.WebPush != nil {
+		if provider, err := NewWebPushProvider(config.WebPush.VAPIDPrivateKey, config.WebPush.Subject, logger); err == nil {
+			providers.WebPush = provider
+			providers.addToPool(providers.WebPushPool, provider, config.WebPush.Routing, 0)
+		} else {
+			logger.Error("Failed to initialize Web Push provider", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
 	}
 
 	// Initialize webhook provider
@@ -63,12 +110,164 @@ func NewNotificationProviders(config *ProvidersConfig, logger logging.Logger) *N
 	return providers
 }
 
+// addToPool registers a provider; failover providers (position > 0) default
+// to a priority after the primary and to a name derived from the channel default
+func (np *NotificationProviders) addToPool(pool *ProviderPool, provider ChannelProvider, routing RoutingConfig, position int) {
+	if position > 0 {
+		if routing.Name == "" {
+			routing.Name = fmt.Sprintf("%s-%d", pool.defaults.Name, position+1)
+		}
+		if routing.Priority <= 0 {
+			routing.Priority = pool.defaults.Priority + position
+		}
+	}
+
+	if err := pool.Add(provider, routing); err != nil {
+		np.logger.Error("Failed to register provider", map[string]interface{}{
+			"channel": pool.channel,
+			"error":   err.Error(),
+		})
+	}
+}
+
+// SetObserver reports provider outcomes of every pool to observer
+func (np *NotificationProviders) SetObserver(observer PoolObserver) {
+	for _, pool := range []*ProviderPool{np.EmailPool, np.SMSPool, np.PushPool, np.APNSPool, np.WebPushPool} {
+		if pool != nil {
+			pool.SetObserver(observer)
+		}
+	}
+}
+
+// PoolStatus returns the health of each channel's providers
+func (np *NotificationProviders) PoolStatus() map[string][]ProviderStatus {
+	status := make(map[string][]ProviderStatus)
+	for _, pool := range []*ProviderPool{np.EmailPool, np.SMSPool, np.PushPool, np.APNSPool, np.WebPushPool} {
+		if pool != nil && pool.Len() > 0 {
+			status[pool.channel] = pool.Status()
+		}
+	}
+	return status
+}
+
+// VerifySMSCallback reports whether a Twilio status callback is signed by any
+// configured SMS account
+func (np *NotificationProviders) VerifySMSCallback(callbackURL string, params url.Values, signature string) bool {
+	for _, provider := range np.smsProviders {
+		if provider.VerifyCallbackSignature(callbackURL, params, signature) {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyReceiptSignature checks the X-Webhook-Signature of an email or push
+// receipt webhook, using the same "sha256=<hex HMAC>" scheme as outbound
+// webhooks. Receipts are rejected when no secret is configured.
+func (np *NotificationProviders) VerifyReceiptSignature(payload []byte, signature string) bool {
+	if np.receiptSecret == "" || signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(np.receiptSecret))
+	mac.Write(payload)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// DefaultRouting returns the routing defaults of a channel's pool
+func DefaultRouting(channel string) RoutingConfig {
+	routing := RoutingConfig{
+		Priority:         1,
+		Weight:           1,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+
+	switch channel {
+	case "email":
+		routing.Name, routing.RateLimit, routing.Burst = "smtp", 10, 50
+	case "sms":
+		routing.Name, routing.RateLimit, routing.Burst = "twilio", 5, 20
+	case "push":
+		routing.Name, routing.RateLimit, routing.Burst = "fcm", 50, 200
+	case "apns":
+		routing.Name, routing.RateLimit, routing.Burst = "apns", 50, 200
+	case "webpush":
+		routing.Name, routing.RateLimit, routing.Burst = "webpush", 50, 200
+	default:
+		routing.Name = channel
+	}
+	return routing
+}
+
+func newEmailProvider(config *EmailConfig, logger logging.Logger) *EmailProvider {
+	return NewEmailProvider(
+		config.SMTPHost,
+		config.SMTPPort,
+		config.SMTPUsername,
+		config.SMTPPassword,
+		config.FromEmail,
+		logger,
+	)
+}
+
+func newSMSProvider(config *SMSConfig, logger logging.Logger) *SMSProvider {
+	provider := NewSMSProvider(
+		config.AccountSID,
+		config.AuthToken,
+		config.FromNumber,
+		logger,
+	)
+	provider.SetStatusCallbackURL(config.StatusCallbackURL)
+	return provider
+}
+
+func newPushProvider(config *PushConfig, logger logging.Logger) *PushProvider {
+	provider := NewPushProvider(
+		config.ServerKey,
+		config.ProjectID,
+		logger,
+	)
+	provider.SetEndpoint(config.Endpoint)
+	return provider
+}
+
+func newAPNsProvider(config *APNsConfig, logger logging.Logger) (*APNsProvider, error) {
+	provider, err := NewAPNsProvider(
+		config.SigningKey,
+		config.KeyID,
+		config.TeamID,
+		config.Topic,
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Sandbox {
+		provider.SetEndpoint(APNsSandboxURL)
+	}
+	provider.SetEndpoint(config.Endpoint)
+	return provider, nil
+}
+
 // ProvidersConfig holds configuration for all providers
 type ProvidersConfig struct {
 	Email   *EmailConfig   `json:"email,omitempty"`
 	SMS     *SMSConfig     `json:"sms,omitempty"`
 	Push    *PushConfig    `json:"push,omitempty"`
+	APNs    *APNsConfig    `json:"apns,omitempty"`
+	WebPush *WebPushConfig `json:"web_push,omitempty"`
 	Webhook *WebhookConfig `json:"webhook,omitempty"`
+
+	// Failover providers are tried in order when the primary is throttled or failing
+	EmailFailover []*EmailConfig `json:"email_failover,omitempty"`
+	SMSFailover   []*SMSConfig   `json:"sms_failover,omitempty"`
+	PushFailover  []*PushConfig  `json:"push_failover,omitempty"`
+
+	// ReceiptSecret signs inbound email and push delivery receipt webhooks
+	ReceiptSecret string `json:"receipt_secret,omitempty"`
 }
 
 // EmailConfig holds email provider configuration
@@ -78,6 +277,8 @@ type EmailConfig struct {
 	SMTPUsername string `json:"smtp_username"`
 	SMTPPassword string `json:"smtp_password"`
 	FromEmail    string `json:"from_email"`
+
+	Routing RoutingConfig `json:"routing"`
 }
 
 // SMSConfig holds SMS provider configuration
@@ -85,12 +286,45 @@ type SMSConfig struct {
 	AccountSID string `json:"account_sid"`
 	AuthToken  string `json:"auth_token"`
 	FromNumber string `json:"from_number"`
+	// StatusCallbackURL is the public URL of the SMS status webhook Twilio posts delivery status to
+	StatusCallbackURL string `json:"status_callback_url,omitempty"`
+
+	Routing RoutingConfig `json:"routing"`
 }
 
 // PushConfig holds push notification provider configuration
 type PushConfig struct {
 	ServerKey string `json:"server_key"`
 	ProjectID string `json:"project_id"`
+	// Endpoint overrides the FCM send URL
+	Endpoint string `json:"endpoint,omitempty"`
+
+	Routing RoutingConfig `json:"routing"`
+}
+
+// APNsConfig holds Apple Push Notification service configuration
+type APNsConfig struct {
+	// SigningKey is the PEM encoded .p8 token signing key
+	SigningKey string `json:"signing_key"`
+	KeyID      string `json:"key_id"`
+	TeamID     string `json:"team_id"`
+	// Topic is the app's bundle ID
+	Topic   string `json:"topic"`
+	Sandbox bool   `json:"sandbox,omitempty"`
+	// Endpoint overrides the APNs URL
+	Endpoint string `json:"endpoint,omitempty"`
+
+	Routing RoutingConfig `json:"routing"`
+}
+
+// WebPushConfig holds Web Push provider configuration
+type WebPushConfig struct {
+	// VAPIDPrivateKey is the base64url encoded P-256 private key requests are signed with
+	VAPIDPrivateKey string `json:"vapid_private_key"`
+	// Subject is the mailto: or https: contact URI sent to push services
+	Subject string `json:"subject"`
+
+	Routing RoutingConfig `json:"routing"`
 }
 
 // WebhookConfig holds webhook provider configuration
@@ -118,6 +352,18 @@ func (np *NotificationProviders) ValidateConfig() error {
 		}
 	}
 
+	if np.APNs != nil {
+		if err := np.APNs.ValidateConfig(); err != nil {
+			return err
+		}
+	}
+
+	if np.WebPush != nil {
+		if err := np.WebPush.ValidateConfig(); err != nil {
+			return err
+		}
+	}
+
 	// Webhook provider doesn't require validation as secret key is optional
 
 	return nil
@@ -171,7 +417,7 @@ func (np *NotificationProviders) GetEnabledProviders() []string {
 	if np.SMS != nil {
 		enabled = append(enabled, "sms")
 	}
-	if np.Push != nil {
+	if np.Push != nil || np.APNs != nil || np.WebPush != nil {
 		enabled = append(enabled, "push")
 	}
 	if np.Webhook != nil {
@@ -189,7 +435,7 @@ func (np *NotificationProviders) IsProviderEnabled(providerType string) bool {
 	case "sms":
 		return np.SMS != nil
 	case "push":
-		return np.Push != nil
+		return np.Push != nil || np.APNs != nil || np.WebPush != nil
 	case "webhook":
 		return np.Webhook != nil
 	default:
@@ -20,6 +20,9 @@ type PushProvider struct {
 	logger      logging.Logger
 }
 
+// fcmMulticastLimit is the most registration tokens one FCM request may address
+const fcmMulticastLimit = 1000
+
 // NewPushProvider creates a new push notification provider
 func NewPushProvider(serverKey, projectID string, logger logging.Logger) *PushProvider {
 	return &PushProvider{
@@ -33,6 +36,13 @@ func NewPushProvider(serverKey, projectID string, logger logging.Logger) *PushPr
 	}
 }
 
+// SetEndpoint overrides the FCM send URL, for proxies and local stand-ins
+func (p *PushProvider) SetEndpoint(endpoint string) {
+	if endpoint != "" {
+		p.baseURL = endpoint
+	}
+}
+
 // FCMMessage represents a Firebase Cloud Messaging message
 type FCMMessage struct {
 	To           string                 `json:"to,omitempty"`
@@ -43,6 +53,7 @@ type FCMMessage struct {
 	APNS         *FCMAPNSConfig         `json:"apns,omitempty"`
 	Priority     string                 `json:"priority,omitempty"`
 	TimeToLive   int                    `json:"time_to_live,omitempty"`
+	CollapseKey  string                 `json:"collapse_key,omitempty"`
 }
 
 // FCMNotification represents the notification payload
@@ -112,17 +123,30 @@ type FCMResult struct {
 	Error          string `json:"error,omitempty"`
 }
 
+// invalidTokenErrors are FCM result errors meaning the device token will never be valid again
+var invalidTokenErrors = map[string]bool{
+	"NotRegistered":       true,
+	"InvalidRegistration": true,
+	"MismatchSenderId":    true,
+}
+
 // SendPush sends a push notification via FCM
 func (p *PushProvider) SendPush(ctx context.Context, deviceToken, title, body string, metadata map[string]string) error {
+	_, err := p.sendPush(ctx, deviceToken, title, body, metadata)
+	return err
+}
+
+// sendPush sends a push notification via FCM and returns the FCM message ID
+func (p *PushProvider) sendPush(ctx context.Context, deviceToken, title, body string, metadata map[string]string) (string, error) {
 	// Validate inputs
 	if deviceToken == "" {
-		return fmt.Errorf("device token is required")
+		return "", fmt.Errorf("device token is required")
 	}
 	if title == "" {
-		return fmt.Errorf("notification title is required")
+		return "", fmt.Errorf("notification title is required")
 	}
 	if body == "" {
-		return fmt.Errorf("notification body is required")
+		return "", fmt.Errorf("notification body is required")
 	}
 
 	// Build FCM message
@@ -149,13 +173,13 @@ func (p *PushProvider) SendPush(ctx context.Context, deviceToken, title, body st
 	// Convert to JSON
 	jsonData, err := json.Marshal(message)
 	if err != nil {
-		return fmt.Errorf("failed to marshal FCM message: %w", err)
+		return "", fmt.Errorf("failed to marshal FCM message: %w", err)
 	}
 
 	// Create HTTP request
 	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
@@ -169,14 +193,14 @@ func (p *PushProvider) SendPush(ctx context.Context, deviceToken, title, body st
 			"error":        err.Error(),
 			"device_token": deviceToken,
 		})
-		return fmt.Errorf("failed to send push request: %w", err)
+		return "", fmt.Errorf("failed to send push request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Parse response
 	var fcmResp FCMResponse
 	if err := json.NewDecoder(resp.Body).Decode(&fcmResp); err != nil {
-		return fmt.Errorf("failed to parse FCM response: %w", err)
+		return "", fmt.Errorf("failed to parse FCM response: %w", err)
 	}
 
 	// Check for errors
@@ -186,7 +210,7 @@ func (p *PushProvider) SendPush(ctx context.Context, deviceToken, title, body st
 			"device_token": deviceToken,
 			"response":     fcmResp,
 		})
-		return fmt.Errorf("FCM API error: status %d", resp.StatusCode)
+		return "", fmt.Errorf("FCM API error: status %d", resp.StatusCode)
 	}
 
 	// Check individual message results
@@ -197,17 +221,30 @@ func (p *PushProvider) SendPush(ctx context.Context, deviceToken, title, body st
 				"error":        result.Error,
 				"device_token": deviceToken,
 			})
-			return fmt.Errorf("FCM delivery failed: %s", result.Error)
+			if invalidTokenErrors[result.Error] {
+				return "", fmt.Errorf("%w: FCM %s", ErrInvalidRecipient, result.Error)
+			}
+			return "", fmt.Errorf("FCM delivery failed: %s", result.Error)
 		}
 	}
 
+	messageID := ""
+	if len(fcmResp.Results) > 0 {
+		messageID = fcmResp.Results[0].MessageID
+	}
+
 	p.logger.Info("Push notification sent successfully", map[string]interface{}{
 		"device_token":  deviceToken,
-		"message_id":    fcmResp.Results[0].MessageID,
+		"message_id":    messageID,
 		"multicast_id":  fcmResp.MulticastID,
 	})
 
-	return nil
+	return messageID, nil
+}
+
+// Deliver sends a pooled message as a push notification
+func (p *PushProvider) Deliver(ctx context.Context, message *Message) (string, error) {
+	return p.sendPush(ctx, message.To, message.Subject, message.Text, message.Metadata)
 }
 
 // addPlatformSpecificConfig adds iOS and Android specific configurations
@@ -272,35 +309,70 @@ func (p *PushProvider) addPlatformSpecificConfig(message *FCMMessage, metadata m
 	}
 }
 
-// SendMulticast sends a push notification to multiple devices
-func (p *PushProvider) SendMulticast(ctx context.Context, deviceTokens []string, title, body string, metadata map[string]string) error {
-	if len(deviceTokens) == 0 {
-		return fmt.Errorf("at least one device token is required")
+// SendMulticast sends a push notification to many devices, one FCM request
+// per thousand tokens; FCM reports a result for each token in order
+func (p *PushProvider) SendMulticast(ctx context.Context, message *Message, targets []PushTarget) ([]PushResult, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("at least one device token is required")
+	}
+	if message.Text == "" {
+		return nil, fmt.Errorf("notification body is required")
+	}
+
+	results := make([]PushResult, 0, len(targets))
+	for start := 0; start < len(targets); start += fcmMulticastLimit {
+		batch := targets[start:min(start+fcmMulticastLimit, len(targets))]
+		batchResults, err := p.sendMulticastBatch(ctx, message, batch)
+		if err != nil {
+			// Earlier batches were accepted, so only this one is reported as failed
+			for _, target := range batch {
+				results = append(results, PushResult{Token: target.Token, Err: err})
+			}
+			continue
+		}
+		results = append(results, batchResults...)
 	}
 
-	message := FCMMessage{
-		RegistrationIDs: deviceTokens,
+	if err := multicastFailure(results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// sendMulticastBatch sends one FCM request addressed to up to fcmMulticastLimit devices
+func (p *PushProvider) sendMulticastBatch(ctx context.Context, message *Message, targets []PushTarget) ([]PushResult, error) {
+	tokens := make([]string, len(targets))
+	for i, target := range targets {
+		tokens[i] = target.Token
+	}
+
+	fcmMessage := FCMMessage{
+		RegistrationIDs: tokens,
 		Notification: FCMNotification{
-			Title: title,
-			Body:  body,
+			Title: message.Subject,
+			Body:  message.Text,
 		},
-		Priority:   "high",
-		TimeToLive: 3600,
+		Priority:    "high",
+		TimeToLive:  3600,
+		CollapseKey: message.CollapseKey,
+	}
+	if message.Priority == "low" {
+		fcmMessage.Priority = "normal"
 	}
 
-	if metadata != nil {
-		message.Data = metadata
-		p.addPlatformSpecificConfig(&message, metadata)
+	if message.Metadata != nil {
+		fcmMessage.Data = message.Metadata
+		p.addPlatformSpecificConfig(&fcmMessage, message.Metadata)
 	}
 
-	jsonData, err := json.Marshal(message)
+	jsonData, err := json.Marshal(fcmMessage)
 	if err != nil {
-		return fmt.Errorf("failed to marshal FCM message: %w", err)
+		return nil, fmt.Errorf("failed to marshal FCM message: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -308,23 +380,51 @@ func (p *PushProvider) SendMulticast(ctx context.Context, deviceTokens []string,
 
 	resp, err := p.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send multicast push: %w", err)
+		return nil, fmt.Errorf("failed to send multicast push: %w", err)
 	}
 	defer resp.Body.Close()
 
+	// FCM only returns a JSON body for requests it accepted
+	if resp.StatusCode >= 400 {
+		p.logger.Error("FCM API error", map[string]interface{}{
+			"status_code":  resp.StatusCode,
+			"device_count": len(targets),
+		})
+		return nil, fmt.Errorf("FCM API error: status %d", resp.StatusCode)
+	}
+
 	var fcmResp FCMResponse
 	if err := json.NewDecoder(resp.Body).Decode(&fcmResp); err != nil {
-		return fmt.Errorf("failed to parse FCM response: %w", err)
+		return nil, fmt.Errorf("failed to parse FCM response: %w", err)
+	}
+	if len(fcmResp.Results) != len(targets) {
+		return nil, fmt.Errorf("FCM returned %d results for %d device tokens", len(fcmResp.Results), len(targets))
+	}
+
+	results := make([]PushResult, len(targets))
+	for i, result := range fcmResp.Results {
+		results[i] = PushResult{
+			Token:          targets[i].Token,
+			MessageID:      result.MessageID,
+			CanonicalToken: result.RegistrationID,
+		}
+		switch {
+		case result.Error == "":
+		case invalidTokenErrors[result.Error]:
+			results[i].Err = fmt.Errorf("%w: FCM %s", ErrInvalidRecipient, result.Error)
+		default:
+			results[i].Err = fmt.Errorf("FCM delivery failed: %s", result.Error)
+		}
 	}
 
 	p.logger.Info("Multicast push notification sent", map[string]interface{}{
-		"device_count":  len(deviceTokens),
+		"device_count":  len(targets),
 		"success_count": fcmResp.Success,
 		"failure_count": fcmResp.Failure,
 		"multicast_id":  fcmResp.MulticastID,
 	})
 
-	return nil
+	return results, nil
 }
 
 // ValidateConfig validates the push provider configuration
@@ -2,9 +2,14 @@ package providers
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,12 +18,13 @@ import (
 
 // SMSProvider handles SMS delivery via Twilio API
 type SMSProvider struct {
-	accountSID    string
-	authToken     string
-	fromNumber    string
-	baseURL       string
-	httpClient    *http.Client
-	logger        logging.Logger
+	accountSID        string
+	authToken         string
+	fromNumber        string
+	statusCallbackURL string
+	baseURL           string
+	httpClient        *http.Client
+	logger            logging.Logger
 }
 
 // NewSMSProvider creates a new SMS provider
@@ -50,14 +56,40 @@ type TwilioResponse struct {
 	ErrorMessage *string `json:"error_message,omitempty"`
 }
 
+// permanentTwilioErrors are Twilio error codes meaning the number can never
+// receive messages from us: invalid, not a mobile, opted out (STOP), unknown or landline
+var permanentTwilioErrors = map[int]bool{
+	21211: true,
+	21610: true,
+	21614: true,
+	30005: true,
+	30006: true,
+}
+
+// IsPermanentTwilioError reports whether a Twilio error code means the number is unreachable for good
+func IsPermanentTwilioError(code int) bool {
+	return permanentTwilioErrors[code]
+}
+
+// SetStatusCallbackURL asks Twilio to post delivery status to callbackURL
+func (s *SMSProvider) SetStatusCallbackURL(callbackURL string) {
+	s.statusCallbackURL = callbackURL
+}
+
 // SendSMS sends an SMS notification via Twilio
 func (s *SMSProvider) SendSMS(ctx context.Context, to, body string, metadata map[string]string) error {
+	_, err := s.sendSMS(ctx, to, body, metadata)
+	return err
+}
+
+// sendSMS sends an SMS via Twilio and returns the message SID
+func (s *SMSProvider) sendSMS(ctx context.Context, to, body string, metadata map[string]string) (string, error) {
 	// Validate inputs
 	if to == "" {
-		return fmt.Errorf("recipient phone number is required")
+		return "", fmt.Errorf("recipient phone number is required")
 	}
 	if body == "" {
-		return fmt.Errorf("SMS body is required")
+		return "", fmt.Errorf("SMS body is required")
 	}
 
 	// Ensure phone number is in E.164 format
@@ -73,13 +105,19 @@ func (s *SMSProvider) SendSMS(ctx context.Context, to, body string, metadata map
 	}
 
 	// Convert to form data (Twilio expects form-encoded data)
-	formData := fmt.Sprintf("From=%s&To=%s&Body=%s", s.fromNumber, to, body)
+	formData := url.Values{}
+	formData.Set("From", s.fromNumber)
+	formData.Set("To", to)
+	formData.Set("Body", body)
+	if s.statusCallbackURL != "" {
+		formData.Set("StatusCallback", s.statusCallbackURL)
+	}
 
 	// Create HTTP request
-	url := fmt.Sprintf("%s/Accounts/%s/Messages.json", s.baseURL, s.accountSID)
-	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(formData))
+	endpoint := fmt.Sprintf("%s/Accounts/%s/Messages.json", s.baseURL, s.accountSID)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(formData.Encode()))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
@@ -93,14 +131,14 @@ func (s *SMSProvider) SendSMS(ctx context.Context, to, body string, metadata map
 			"error":     err.Error(),
 			"recipient": to,
 		})
-		return fmt.Errorf("failed to send SMS request: %w", err)
+		return "", fmt.Errorf("failed to send SMS request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Parse response
 	var twilioResp TwilioResponse
 	if err := json.NewDecoder(resp.Body).Decode(&twilioResp); err != nil {
-		return fmt.Errorf("failed to parse Twilio response: %w", err)
+		return "", fmt.Errorf("failed to parse Twilio response: %w", err)
 	}
 
 	// Check for errors
@@ -115,7 +153,10 @@ func (s *SMSProvider) SendSMS(ctx context.Context, to, body string, metadata map
 			"error_message": errorMsg,
 			"recipient":     to,
 		})
-		return fmt.Errorf("Twilio API error (%d): %s", resp.StatusCode, errorMsg)
+		if twilioResp.ErrorCode != nil && IsPermanentTwilioError(*twilioResp.ErrorCode) {
+			return "", fmt.Errorf("%w: Twilio error %d: %s", ErrInvalidRecipient, *twilioResp.ErrorCode, errorMsg)
+		}
+		return "", fmt.Errorf("Twilio API error (%d): %s", resp.StatusCode, errorMsg)
 	}
 
 	s.logger.Info("SMS sent successfully", map[string]interface{}{
@@ -124,7 +165,45 @@ func (s *SMSProvider) SendSMS(ctx context.Context, to, body string, metadata map
 		"status":      twilioResp.Status,
 	})
 
-	return nil
+	return twilioResp.SID, nil
+}
+
+// Deliver sends a pooled message as an SMS
+func (s *SMSProvider) Deliver(ctx context.Context, message *Message) (string, error) {
+	return s.sendSMS(ctx, message.To, message.Text, message.Metadata)
+}
+
+// VerifyCallbackSignature checks the X-Twilio-Signature of a status callback
+// posted to callbackURL: base64(HMAC-SHA1(auth token, URL + sorted params)).
+// The configured status callback URL takes precedence over callbackURL, since
+// that is the URL Twilio signed.
+func (s *SMSProvider) VerifyCallbackSignature(callbackURL string, params url.Values, signature string) bool {
+	if s.authToken == "" || signature == "" {
+		return false
+	}
+	if s.statusCallbackURL != "" {
+		callbackURL = s.statusCallbackURL
+	}
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var data strings.Builder
+	data.WriteString(callbackURL)
+	for _, key := range keys {
+		for _, value := range params[key] {
+			data.WriteString(key)
+			data.WriteString(value)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(s.authToken))
+	mac.Write([]byte(data.String()))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
 }
 
 // normalizePhoneNumber ensures phone number is in E.164 format
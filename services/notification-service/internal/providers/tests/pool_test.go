@@ -0,0 +1,219 @@
+package tests
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"reciprocal-clubs-backend/pkg/shared/logging"
+	"reciprocal-clubs-backend/services/notification-service/internal/providers"
+)
+
+// fakeProvider records deliveries and fails while err is set
+type fakeProvider struct {
+	mu        sync.Mutex
+	err       error
+	delivered int
+}
+
+func (f *fakeProvider) Deliver(ctx context.Context, message *providers.Message) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return "", f.err
+	}
+	f.delivered++
+	return fmt.Sprintf("msg-%d", f.delivered), nil
+}
+
+func (f *fakeProvider) setErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// recordingObserver collects pool outcomes
+type recordingObserver struct {
+	mu       sync.Mutex
+	outcomes map[string]int
+	states   map[string]string
+}
+
+func newRecordingObserver() *recordingObserver {
+	return &recordingObserver{outcomes: make(map[string]int), states: make(map[string]string)}
+}
+
+func (o *recordingObserver) RecordProviderRequest(channel, provider, outcome string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.outcomes[provider+":"+outcome]++
+}
+
+func (o *recordingObserver) SetProviderCircuitState(channel, provider, state string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.states[provider] = state
+}
+
+func newPool(t *testing.T) *providers.ProviderPool {
+	t.Helper()
+	return providers.NewProviderPool("sms", providers.RoutingConfig{Name: "twilio", Priority: 1, RateLimit: -1}, &TestLogger{})
+}
+
+var message = &providers.Message{To: "+15551234567", Text: "Court 3 is ready"}
+
+func TestProviderPool_FailsOverAndOpensCircuit(t *testing.T) {
+	pool := newPool(t)
+	primary, secondary := &fakeProvider{err: errors.New("twilio 503")}, &fakeProvider{}
+	require.NoError(t, pool.Add(primary, providers.RoutingConfig{Name: "primary", FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond}))
+	require.NoError(t, pool.Add(secondary, providers.RoutingConfig{Name: "secondary", Priority: 2}))
+	require.Error(t, pool.Add(&fakeProvider{}, providers.RoutingConfig{Name: "primary"}))
+
+	observer := newRecordingObserver()
+	pool.SetObserver(observer)
+
+	for i := 0; i < 3; i++ {
+		delivery, err := pool.Deliver(context.Background(), message)
+		require.NoError(t, err)
+		assert.Equal(t, "secondary", delivery.Provider)
+		assert.Equal(t, fmt.Sprintf("msg-%d", i+1), delivery.MessageID)
+	}
+	assert.Equal(t, 3, secondary.delivered)
+
+	// Two failures opened the circuit, so the third delivery skipped the primary
+	assert.Equal(t, 2, observer.outcomes["primary:failed"])
+	assert.Equal(t, 1, observer.outcomes["primary:circuit_open"])
+	assert.Equal(t, "open", observer.states["primary"])
+
+	status := pool.Status()
+	require.Len(t, status, 2)
+	assert.Equal(t, "primary", status[0].Name)
+	assert.Equal(t, "open", status[0].State)
+	assert.Equal(t, "closed", status[1].State)
+
+	// After the open timeout a successful probe closes the circuit again
+	primary.setErr(nil)
+	time.Sleep(60 * time.Millisecond)
+	delivery, err := pool.Deliver(context.Background(), message)
+	require.NoError(t, err)
+	assert.Equal(t, "primary", delivery.Provider)
+	assert.Equal(t, "closed", observer.states["primary"])
+}
+
+func TestProviderPool_RateLimitOverflowsToSecondary(t *testing.T) {
+	pool := newPool(t)
+	primary, secondary := &fakeProvider{}, &fakeProvider{}
+	require.NoError(t, pool.Add(primary, providers.RoutingConfig{Name: "primary", RateLimit: 0.001, Burst: 2}))
+	require.NoError(t, pool.Add(secondary, providers.RoutingConfig{Name: "secondary", Priority: 2}))
+
+	for i := 0; i < 5; i++ {
+		_, err := pool.Deliver(context.Background(), message)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, primary.delivered)
+	assert.Equal(t, 3, secondary.delivered)
+}
+
+func TestProviderPool_WaitsWhenEveryProviderIsThrottled(t *testing.T) {
+	pool := newPool(t)
+	primary := &fakeProvider{}
+	require.NoError(t, pool.Add(primary, providers.RoutingConfig{Name: "primary", RateLimit: 20, Burst: 1}))
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := pool.Deliver(context.Background(), message)
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+	assert.Equal(t, 3, primary.delivered)
+
+	// A deadline shorter than the next token fails instead of waiting
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err := pool.Deliver(ctx, message)
+	assert.Error(t, err)
+}
+
+func TestProviderPool_SharesTrafficByWeight(t *testing.T) {
+	pool := newPool(t)
+	heavy, light := &fakeProvider{}, &fakeProvider{}
+	require.NoError(t, pool.Add(heavy, providers.RoutingConfig{Name: "heavy", Weight: 4}))
+	require.NoError(t, pool.Add(light, providers.RoutingConfig{Name: "light", Weight: 1}))
+
+	for i := 0; i < 1000; i++ {
+		_, err := pool.Deliver(context.Background(), message)
+		require.NoError(t, err)
+	}
+
+	assert.InDelta(t, 800, heavy.delivered, 80)
+	assert.Equal(t, 1000, heavy.delivered+light.delivered)
+}
+
+func TestProviderPool_AllCircuitsOpen(t *testing.T) {
+	pool := newPool(t)
+	require.NoError(t, pool.Add(&fakeProvider{err: errors.New("down")}, providers.RoutingConfig{Name: "primary", FailureThreshold: 1, OpenTimeout: time.Minute}))
+
+	_, err := pool.Deliver(context.Background(), message)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "primary: down")
+
+	_, err = pool.Deliver(context.Background(), message)
+	assert.ErrorIs(t, err, providers.ErrNoProviderAvailable)
+}
+
+func TestProviderPool_InvalidRecipientDoesNotFailOver(t *testing.T) {
+	pool := newPool(t)
+	primary, secondary := &fakeProvider{}, &fakeProvider{}
+	require.NoError(t, pool.Add(primary, providers.RoutingConfig{Name: "primary", FailureThreshold: 1}))
+	require.NoError(t, pool.Add(secondary, providers.RoutingConfig{Name: "secondary", Priority: 2}))
+
+	primary.setErr(fmt.Errorf("%w: FCM NotRegistered", providers.ErrInvalidRecipient))
+	_, err := pool.Deliver(context.Background(), message)
+	assert.ErrorIs(t, err, providers.ErrInvalidRecipient)
+	assert.Equal(t, 0, secondary.delivered)
+
+	// The provider itself is healthy, so its circuit stays closed
+	assert.Equal(t, "closed", pool.Status()[0].State)
+}
+
+func TestSMSProvider_VerifyCallbackSignature(t *testing.T) {
+	provider := providers.NewSMSProvider("AC123", "secret-token", "+15550000000", &TestLogger{})
+	callbackURL := "https://notifications.example.com/api/v1/webhooks/sms/status"
+	params := url.Values{
+		"MessageSid":    {"SM123"},
+		"MessageStatus": {"delivered"},
+		"AccountSid":    {"AC123"},
+	}
+
+	// Twilio signs the URL followed by each parameter name and value, sorted by name
+	mac := hmac.New(sha1.New, []byte("secret-token"))
+	mac.Write([]byte(callbackURL + "AccountSidAC123MessageSidSM123MessageStatusdelivered"))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, provider.VerifyCallbackSignature(callbackURL, params, signature))
+	assert.False(t, provider.VerifyCallbackSignature(callbackURL+"?x=1", params, signature))
+	params.Set("MessageStatus", "failed")
+	assert.False(t, provider.VerifyCallbackSignature(callbackURL, params, signature))
+}
+
+// TestLogger discards log output
+type TestLogger struct{}
+
+func (l *TestLogger) Debug(msg string, fields map[string]interface{})   {}
+func (l *TestLogger) Info(msg string, fields map[string]interface{})    {}
+func (l *TestLogger) Warn(msg string, fields map[string]interface{})    {}
+func (l *TestLogger) Error(msg string, fields map[string]interface{})   {}
+func (l *TestLogger) Fatal(msg string, fields map[string]interface{})   {}
+func (l *TestLogger) With(fields map[string]interface{}) logging.Logger { return l }
+func (l *TestLogger) WithContext(ctx context.Context) logging.Logger    { return l }
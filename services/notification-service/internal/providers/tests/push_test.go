@@ -0,0 +1,360 @@
+package tests
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/providers"
+)
+
+var pushMessage = &providers.Message{
+	Subject:     "Court booked",
+	Text:        "Court 3 is yours at 18:00",
+	Metadata:    map[string]string{"booking_id": "42"},
+	Priority:    "high",
+	CollapseKey: "booking-42",
+}
+
+// browserSubscription is the key pair and auth secret a browser creates when subscribing
+type browserSubscription struct {
+	key        *ecdh.PrivateKey
+	authSecret []byte
+}
+
+func newBrowserSubscription(t *testing.T) *browserSubscription {
+	t.Helper()
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	require.NoError(t, err)
+	return &browserSubscription{key: key, authSecret: authSecret}
+}
+
+func (b *browserSubscription) target(endpoint string) providers.PushTarget {
+	return providers.PushTarget{
+		Token:  endpoint,
+		P256dh: base64.RawURLEncoding.EncodeToString(b.key.PublicKey().Bytes()),
+		Auth:   base64.RawURLEncoding.EncodeToString(b.authSecret),
+	}
+}
+
+// decrypt reads an aes128gcm body the way the browser does (RFC 8291)
+func (b *browserSubscription) decrypt(t *testing.T, body []byte) []byte {
+	t.Helper()
+	require.Greater(t, len(body), 21)
+	salt := body[:16]
+	assert.Equal(t, uint32(4096), binary.BigEndian.Uint32(body[16:20]))
+	idLen := int(body[20])
+	asPublic := body[21 : 21+idLen]
+
+	senderKey, err := ecdh.P256().NewPublicKey(asPublic)
+	require.NoError(t, err)
+	secret, err := b.key.ECDH(senderKey)
+	require.NoError(t, err)
+
+	keyInfo := "WebPush: info\x00" + string(b.key.PublicKey().Bytes()) + string(asPublic)
+	ikm, err := hkdf.Key(sha256.New, secret, b.authSecret, keyInfo, 32)
+	require.NoError(t, err)
+	cek, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	require.NoError(t, err)
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(cek)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	record, err := gcm.Open(nil, nonce, body[21+idLen:], nil)
+	require.NoError(t, err)
+
+	// A single record ends with the last-record padding delimiter
+	require.NotEmpty(t, record)
+	assert.Equal(t, byte(0x02), record[len(record)-1])
+	return record[:len(record)-1]
+}
+
+// pushRequests collects what a stand-in push service received
+type pushRequests struct {
+	mu       sync.Mutex
+	headers  []http.Header
+	bodies   [][]byte
+	paths    []string
+	received int
+}
+
+func (p *pushRequests) record(r *http.Request) []byte {
+	body, _ := io.ReadAll(r.Body)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.headers = append(p.headers, r.Header.Clone())
+	p.bodies = append(p.bodies, body)
+	p.paths = append(p.paths, r.URL.Path)
+	p.received++
+	return body
+}
+
+func TestWebPushProvider_EncryptsAndSignsForEachSubscription(t *testing.T) {
+	requests := &pushRequests{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.record(r)
+		if r.URL.Path == "/push/unsubscribed" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.Header().Set("Location", "https://push.example.com/message/"+strings.TrimPrefix(r.URL.Path, "/push/"))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	vapidKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rawKey, err := vapidKey.Bytes()
+	require.NoError(t, err)
+
+	provider, err := providers.NewWebPushProvider(base64.RawURLEncoding.EncodeToString(rawKey), "mailto:ops@clubland.com", &TestLogger{})
+	require.NoError(t, err)
+	provider.SetHTTPClient(server.Client())
+	require.NoError(t, provider.ValidateConfig())
+
+	pool := providers.NewProviderPool("webpush", providers.RoutingConfig{Name: "webpush", RateLimit: -1}, &TestLogger{})
+	require.NoError(t, pool.Add(provider, providers.RoutingConfig{}))
+
+	browser, unsubscribed := newBrowserSubscription(t), newBrowserSubscription(t)
+	delivery, err := pool.Multicast(context.Background(), pushMessage, []providers.PushTarget{
+		browser.target(server.URL + "/push/browser"),
+		unsubscribed.target(server.URL + "/push/unsubscribed"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "webpush", delivery.Provider)
+	require.Len(t, delivery.Results, 2)
+	assert.NoError(t, delivery.Results[0].Err)
+	assert.Equal(t, "https://push.example.com/message/browser", delivery.Results[0].MessageID)
+	assert.ErrorIs(t, delivery.Results[1].Err, providers.ErrInvalidRecipient)
+
+	requests.mu.Lock()
+	defer requests.mu.Unlock()
+	require.Equal(t, 2, requests.received)
+	header := requests.headers[0]
+	assert.Equal(t, "aes128gcm", header.Get("Content-Encoding"))
+	assert.Equal(t, "86400", header.Get("TTL"))
+	assert.Equal(t, "high", header.Get("Urgency"))
+	assert.Equal(t, "booking-42", header.Get("Topic"))
+
+	// The browser can decrypt the payload with its subscription keys
+	var payload providers.WebPushPayload
+	require.NoError(t, json.Unmarshal(browser.decrypt(t, requests.bodies[0]), &payload))
+	assert.Equal(t, "Court booked", payload.Title)
+	assert.Equal(t, "Court 3 is yours at 18:00", payload.Body)
+	assert.Equal(t, "42", payload.Data["booking_id"])
+
+	// The VAPID token is signed by the key browsers subscribed with, for this push service
+	scheme, params, found := strings.Cut(header.Get("Authorization"), " ")
+	require.True(t, found)
+	assert.Equal(t, "vapid", scheme)
+	token, key := "", ""
+	for _, param := range strings.Split(params, ", ") {
+		name, value, _ := strings.Cut(param, "=")
+		switch name {
+		case "t":
+			token = value
+		case "k":
+			key = value
+		}
+	}
+	assert.Equal(t, provider.PublicKey(), key)
+
+	rawPublic, err := base64.RawURLEncoding.DecodeString(key)
+	require.NoError(t, err)
+	publicKey, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), rawPublic)
+	require.NoError(t, err)
+	assert.True(t, publicKey.Equal(&vapidKey.PublicKey))
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) { return publicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}), jwt.WithAudience(server.URL), jwt.WithExpirationRequired())
+	require.NoError(t, err)
+	assert.Equal(t, "mailto:ops@clubland.com", claims["sub"])
+}
+
+func TestWebPushProvider_RejectsInvalidSubscriptions(t *testing.T) {
+	vapidKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rawKey, err := vapidKey.Bytes()
+	require.NoError(t, err)
+	provider, err := providers.NewWebPushProvider(base64.RawURLEncoding.EncodeToString(rawKey), "mailto:ops@clubland.com", &TestLogger{})
+	require.NoError(t, err)
+
+	browser := newBrowserSubscription(t)
+	plain := browser.target("http://push.example.com/push/1")
+	badKey := browser.target("https://push.example.com/push/1")
+	badKey.P256dh = base64.RawURLEncoding.EncodeToString([]byte("not a key"))
+
+	results, err := provider.SendMulticast(context.Background(), pushMessage, []providers.PushTarget{plain, badKey})
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, providers.ErrInvalidRecipient)
+	}
+
+	_, err = provider.Deliver(context.Background(), &providers.Message{To: "not a subscription", Text: "Hello"})
+	assert.ErrorIs(t, err, providers.ErrInvalidRecipient)
+
+	_, err = providers.NewWebPushProvider("not-a-key", "mailto:ops@clubland.com", &TestLogger{})
+	assert.Error(t, err)
+}
+
+func TestAPNsProvider_SendsWithProviderToken(t *testing.T) {
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(signingKey)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	requests := &pushRequests{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.record(r)
+		switch r.URL.Path {
+		case "/3/device/unregistered":
+			w.WriteHeader(http.StatusGone)
+			fmt.Fprint(w, `{"reason":"Unregistered","timestamp":1700000000000}`)
+		case "/3/device/bad":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"reason":"BadDeviceToken"}`)
+		default:
+			w.Header().Set("apns-id", "EC1BF194-B3B2-424A-89A9-5A918A6E6B5D")
+		}
+	}))
+	defer server.Close()
+
+	provider, err := providers.NewAPNsProvider(string(keyPEM), "KEY123", "TEAM123", "com.clubland.app", &TestLogger{})
+	require.NoError(t, err)
+	provider.SetEndpoint(server.URL)
+	require.NoError(t, provider.ValidateConfig())
+
+	pool := providers.NewProviderPool("apns", providers.RoutingConfig{Name: "apns", RateLimit: -1}, &TestLogger{})
+	require.NoError(t, pool.Add(provider, providers.RoutingConfig{}))
+
+	delivery, err := pool.Multicast(context.Background(), pushMessage, []providers.PushTarget{
+		{Token: "a1b2c3"}, {Token: "unregistered"}, {Token: "bad"},
+	})
+	require.NoError(t, err)
+	require.Len(t, delivery.Results, 3)
+	assert.NoError(t, delivery.Results[0].Err)
+	assert.Equal(t, "EC1BF194-B3B2-424A-89A9-5A918A6E6B5D", delivery.Results[0].MessageID)
+	assert.ErrorIs(t, delivery.Results[1].Err, providers.ErrInvalidRecipient)
+	assert.ErrorIs(t, delivery.Results[2].Err, providers.ErrInvalidRecipient)
+
+	requests.mu.Lock()
+	defer requests.mu.Unlock()
+	require.Equal(t, 3, requests.received)
+	header := requests.headers[0]
+	assert.Equal(t, "com.clubland.app", header.Get("apns-topic"))
+	assert.Equal(t, "alert", header.Get("apns-push-type"))
+	assert.Equal(t, "10", header.Get("apns-priority"))
+	assert.Equal(t, "booking-42", header.Get("apns-collapse-id"))
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(requests.bodies[0], &payload))
+	assert.Equal(t, "42", payload["booking_id"])
+	aps := payload["aps"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"title": "Court booked", "body": "Court 3 is yours at 18:00"}, aps["alert"])
+
+	// Every request reuses one provider token signed with the team's key
+	bearer := strings.TrimPrefix(header.Get("Authorization"), "bearer ")
+	assert.Equal(t, header.Get("Authorization"), requests.headers[2].Get("Authorization"))
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(bearer, claims, func(*jwt.Token) (interface{}, error) { return &signingKey.PublicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}), jwt.WithIssuer("TEAM123"), jwt.WithIssuedAt())
+	require.NoError(t, err)
+	assert.Equal(t, "KEY123", token.Header["kid"])
+}
+
+func TestPushProvider_SendMulticastReportsEachToken(t *testing.T) {
+	requests := &pushRequests{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := requests.record(r)
+		var message providers.FCMMessage
+		if err := json.Unmarshal(body, &message); err != nil || len(message.RegistrationIDs) != 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(providers.FCMResponse{
+			MulticastID: 7,
+			Success:     2,
+			Failure:     1,
+			Results: []providers.FCMResult{
+				{MessageID: "0:1"},
+				{Error: "NotRegistered"},
+				{MessageID: "0:3", RegistrationID: "rotated-token"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	provider := providers.NewPushProvider("server-key", "clubland", &TestLogger{})
+	provider.SetEndpoint(server.URL)
+
+	results, err := provider.SendMulticast(context.Background(), pushMessage, []providers.PushTarget{
+		{Token: "token-1"}, {Token: "token-2"}, {Token: "token-3"},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "0:1", results[0].MessageID)
+	assert.ErrorIs(t, results[1].Err, providers.ErrInvalidRecipient)
+	assert.Equal(t, "token-2", results[1].Token)
+	assert.Equal(t, "rotated-token", results[2].CanonicalToken)
+
+	requests.mu.Lock()
+	defer requests.mu.Unlock()
+	assert.Equal(t, "key=server-key", requests.headers[0].Get("Authorization"))
+}
+
+func TestProviderPool_MulticastFailsOverWhenNoTargetIsAccepted(t *testing.T) {
+	pool := newPool(t)
+	primary, secondary := &fakeProvider{err: errors.New("push service unavailable")}, &fakeProvider{}
+	require.NoError(t, pool.Add(primary, providers.RoutingConfig{Name: "primary"}))
+	require.NoError(t, pool.Add(secondary, providers.RoutingConfig{Name: "secondary", Priority: 2}))
+
+	targets := []providers.PushTarget{{Token: "device-1"}, {Token: "device-2"}}
+	delivery, err := pool.Multicast(context.Background(), pushMessage, targets)
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", delivery.Provider)
+	assert.Equal(t, 2, secondary.delivered)
+
+	// Targets rejected as invalid are reported rather than failed over
+	primary.setErr(fmt.Errorf("%w: unregistered", providers.ErrInvalidRecipient))
+	delivery, err = pool.Multicast(context.Background(), pushMessage, targets)
+	require.NoError(t, err)
+	assert.Equal(t, "primary", delivery.Provider)
+	for _, result := range delivery.Results {
+		assert.ErrorIs(t, result.Err, providers.ErrInvalidRecipient)
+	}
+	assert.Equal(t, 2, secondary.delivered)
+
+	_, err = pool.Multicast(context.Background(), pushMessage, nil)
+	assert.Error(t, err)
+}
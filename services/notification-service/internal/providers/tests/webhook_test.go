@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/providers"
+)
+
+func TestSignWebhook_VerifiesWithEitherSecretWithinTolerance(t *testing.T) {
+	body := []byte(`{"id":"evt_1","event":"visit.confirmed"}`)
+	signedAt := time.Unix(1700000000, 0)
+	signature := providers.SignWebhook(body, signedAt, "whsec_new", "whsec_old")
+
+	parts := strings.Split(signature, ",")
+	require.Len(t, parts, 3)
+	assert.Equal(t, "t=1700000000", parts[0])
+
+	tolerance := 5 * time.Minute
+	assert.NoError(t, providers.VerifyWebhook(body, signature, "whsec_new", tolerance, signedAt.Add(time.Minute)))
+	assert.NoError(t, providers.VerifyWebhook(body, signature, "whsec_old", tolerance, signedAt.Add(-time.Minute)))
+
+	for name, err := range map[string]error{
+		"unknown secret": providers.VerifyWebhook(body, signature, "whsec_other", tolerance, signedAt),
+		"altered body":   providers.VerifyWebhook([]byte(`{"id":"evt_2"}`), signature, "whsec_new", tolerance, signedAt),
+		"replayed":       providers.VerifyWebhook(body, signature, "whsec_new", tolerance, signedAt.Add(time.Hour)),
+		"restamped":      providers.VerifyWebhook(body, strings.Replace(signature, "t=1700000000", "t=1700003600", 1), "whsec_new", tolerance, signedAt.Add(time.Hour)),
+		"unsigned":       providers.VerifyWebhook(body, "t=1700000000", "whsec_new", tolerance, signedAt),
+		"legacy scheme":  providers.VerifyWebhook(body, "sha256=abc", "whsec_new", tolerance, signedAt),
+	} {
+		assert.ErrorIs(t, err, providers.ErrWebhookSignature, name)
+	}
+}
+
+func TestWebhookProvider_PostWebhookReturnsEveryResponse(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, strings.Repeat("x", 20<<10))
+	}))
+	defer server.Close()
+
+	provider := providers.NewWebhookProvider("", &TestLogger{})
+	provider.SetHTTPClient(server.Client())
+	response, err := provider.PostWebhook(context.Background(), server.URL, map[string]string{
+		providers.WebhookEventHeader: "visit.confirmed",
+	}, []byte(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, "120", response.Headers["Retry-After"])
+	assert.Len(t, response.Body, 16<<10)
+	assert.Equal(t, "visit.confirmed", received.Get(providers.WebhookEventHeader))
+	assert.Equal(t, "application/json", received.Get("Content-Type"))
+
+	server.Close()
+	_, err = provider.PostWebhook(context.Background(), server.URL, nil, []byte(`{}`))
+	assert.Error(t, err)
+}
+
+func TestWebhookProvider_RefusesNonPublicAddresses(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer server.Close()
+
+	provider := providers.NewWebhookProvider("", &TestLogger{})
+	_, err := provider.PostWebhook(context.Background(), server.URL, nil, []byte(`{}`))
+	assert.ErrorIs(t, err, providers.ErrWebhookAddress)
+	assert.Zero(t, hits, "a loopback endpoint must not be reached")
+
+	for address, public := range map[string]bool{
+		"93.184.216.34":   true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"::1":             false,
+		"fd00::1":         false,
+		"fe80::1":         false,
+		"::ffff:10.0.0.1": false,
+		"64:ff9b::a00:1":  false,
+	} {
+		assert.Equal(t, public, providers.IsPublicAddress(netip.MustParseAddr(address)), address)
+	}
+}
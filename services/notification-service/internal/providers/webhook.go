@@ -7,8 +7,15 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/logging"
@@ -21,17 +28,91 @@ type WebhookProvider struct {
 	logger     logging.Logger
 }
 
-// NewWebhookProvider creates a new webhook provider
+// NewWebhookProvider creates a new webhook provider. Webhooks are only sent
+// to public addresses, checked when connecting so DNS cannot be used to reach
+// internal services, and redirects are not followed.
 func NewWebhookProvider(secretKey string, logger logging.Logger) *WebhookProvider {
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   publicAddressControl,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // a proxy would connect on our behalf, past the check
+	transport.DialContext = dialer.DialContext
+
 	return &WebhookProvider{
 		secretKey: secretKey,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
 		},
 		logger: logger,
 	}
 }
 
+// Headers sent with webhook subscription deliveries
+const (
+	WebhookIDHeader        = "X-Webhook-ID"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// webhookResponseLimit bounds how much of a subscriber's response is kept
+const webhookResponseLimit = 16 << 10
+
+// ErrWebhookSignature is returned when a subscription delivery's signature does not verify
+var ErrWebhookSignature = errors.New("invalid webhook signature")
+
+// ErrWebhookAddress is returned when a webhook host is not a public address
+var ErrWebhookAddress = errors.New("webhook address is not public")
+
+// nonPublicPrefixes are special-purpose ranges not covered by the netip
+// predicates used in IsPublicAddress
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, may embed a private IPv4 address
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2001::/32"),      // Teredo
+	netip.MustParsePrefix("2002::/16"),      // 6to4
+}
+
+// IsPublicAddress reports whether webhooks may be sent to the address
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// publicAddressControl refuses connections to non-public addresses. It runs
+// after name resolution, on the address actually being dialled.
+func publicAddressControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !IsPublicAddress(addr) {
+		return fmt.Errorf("%w: %s", ErrWebhookAddress, host)
+	}
+	return nil
+}
+
 // WebhookPayload represents the webhook notification payload
 type WebhookPayload struct {
 	ID        string                 `json:"id"`
@@ -180,6 +261,104 @@ func (w *WebhookProvider) SendWebhook(ctx context.Context, url, notificationID,
 	return fmt.Errorf("webhook delivery failed after %d attempts", maxRetries)
 }
 
+// SetHTTPClient replaces the client webhooks are sent with
+func (w *WebhookProvider) SetHTTPClient(client *http.Client) {
+	w.httpClient = client
+}
+
+// PostWebhook makes a single attempt to deliver a subscription webhook; the
+// caller decides whether and when to retry. A response is returned for every
+// status code, and an error only when no response was received.
+func (w *WebhookProvider) PostWebhook(ctx context.Context, url string, headers map[string]string, body []byte) (*WebhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Clubland-Webhooks/1.0")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(resp.Body, webhookResponseLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook response: %w", err)
+	}
+
+	response := &WebhookResponse{
+		StatusCode: resp.StatusCode,
+		Body:       string(responseBody),
+		Headers:    make(map[string]string, len(resp.Header)),
+	}
+	for name := range resp.Header {
+		response.Headers[name] = resp.Header.Get(name)
+	}
+	return response, nil
+}
+
+// SignWebhook returns the X-Webhook-Signature of a subscription delivery:
+// "t=<unix timestamp>,v1=<hex HMAC-SHA256 of "<timestamp>.<body>">", with a
+// v1 entry per secret while a rotated secret is still honoured. Signing the
+// timestamp lets receivers reject replayed deliveries.
+func SignWebhook(body []byte, timestamp time.Time, secrets ...string) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	parts := []string{"t=" + t}
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(t))
+		mac.Write([]byte("."))
+		mac.Write(body)
+		parts = append(parts, "v1="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// VerifyWebhook checks a subscription delivery's X-Webhook-Signature against
+// secret, rejecting signatures whose timestamp is more than tolerance away
+// from now. It is what receivers are expected to do.
+func VerifyWebhook(body []byte, header, secret string, tolerance time.Duration, now time.Time) error {
+	var (
+		timestamp  int64
+		signatures []string
+	)
+	for _, part := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%w: malformed timestamp", ErrWebhookSignature)
+			}
+			timestamp = parsed
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == 0 || len(signatures) == 0 {
+		return fmt.Errorf("%w: missing timestamp or signature", ErrWebhookSignature)
+	}
+
+	signedAt := time.Unix(timestamp, 0)
+	if now.Sub(signedAt) > tolerance || signedAt.Sub(now) > tolerance {
+		return fmt.Errorf("%w: timestamp outside tolerance", ErrWebhookSignature)
+	}
+
+	expected := strings.TrimPrefix(SignWebhook(body, signedAt, secret), "t="+strconv.FormatInt(timestamp, 10)+",v1=")
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(expected), []byte(signature)) {
+			return nil
+		}
+	}
+	return ErrWebhookSignature
+}
+
 // generateSignature creates HMAC-SHA256 signature for webhook payload
 func (w *WebhookProvider) generateSignature(payload []byte) string {
 	h := hmac.New(sha256.New, []byte(w.secretKey))
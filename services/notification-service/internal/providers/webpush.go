@@ -0,0 +1,341 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"reciprocal-clubs-backend/pkg/shared/logging"
+)
+
+// webPushRecordSize is the aes128gcm record size. Push services accept
+// payloads of at least 4096 bytes, so every message is a single record.
+const webPushRecordSize = 4096
+
+// webPushHeaderSize is the aes128gcm header: salt, record size, key ID
+// length and the sender's uncompressed P-256 public key
+const webPushHeaderSize = 16 + 4 + 1 + 65
+
+// webPushMaxPayload is the largest plaintext that fits a record alongside the
+// header, the padding delimiter and the authentication tag
+const webPushMaxPayload = webPushRecordSize - webPushHeaderSize - 1 - 16
+
+// webPushTTL is how long push services hold a message for an offline browser
+const webPushTTL = 24 * time.Hour
+
+// vapidTokenLifetime is how long a VAPID token is valid; RFC 8292 allows at most a day
+const vapidTokenLifetime = 12 * time.Hour
+
+// webPushTopicPattern is what push services accept as a Topic header
+var webPushTopicPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// WebPushProvider handles push notifications to browsers through the Web
+// Push protocol (RFC 8030). Requests are identified with VAPID (RFC 8292)
+// and payloads are encrypted for each subscription (RFC 8291).
+type WebPushProvider struct {
+	key        *ecdsa.PrivateKey
+	publicKey  string
+	subject    string
+	httpClient *http.Client
+	logger     logging.Logger
+}
+
+// NewWebPushProvider creates a Web Push provider from a base64url encoded
+// P-256 VAPID private key; subject is a mailto: or https: contact URI push
+// services can reach the sender at
+func NewWebPushProvider(privateKey, subject string, logger logging.Logger) (*WebPushProvider, error) {
+	raw, err := decodeBase64URL(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("VAPID private key is not base64url encoded: %w", err)
+	}
+
+	key, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse VAPID private key: %w", err)
+	}
+
+	publicKey, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode VAPID public key: %w", err)
+	}
+
+	return &WebPushProvider{
+		key:       key,
+		publicKey: base64.RawURLEncoding.EncodeToString(publicKey),
+		subject:   subject,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		logger: logger,
+	}, nil
+}
+
+// SetHTTPClient replaces the client push services are called with
+func (p *WebPushProvider) SetHTTPClient(client *http.Client) {
+	p.httpClient = client
+}
+
+// PublicKey returns the VAPID public key browsers pass as the
+// applicationServerKey when they subscribe
+func (p *WebPushProvider) PublicKey() string {
+	return p.publicKey
+}
+
+// WebPushSubscription is a browser's PushSubscription as its toJSON serialises it
+type WebPushSubscription struct {
+	Endpoint string `json:"endpoint"`
+	Keys     struct {
+		P256dh string `json:"p256dh"`
+		Auth   string `json:"auth"`
+	} `json:"keys"`
+}
+
+// WebPushPayload is the JSON a service worker receives in its push event
+type WebPushPayload struct {
+	Title string            `json:"title,omitempty"`
+	Body  string            `json:"body"`
+	Tag   string            `json:"tag,omitempty"`
+	Data  map[string]string `json:"data,omitempty"`
+}
+
+// Deliver sends a pooled message to the subscription in message.To, a
+// PushSubscription serialised as JSON
+func (p *WebPushProvider) Deliver(ctx context.Context, message *Message) (string, error) {
+	var subscription WebPushSubscription
+	if err := json.Unmarshal([]byte(message.To), &subscription); err != nil {
+		return "", fmt.Errorf("%w: web push recipient is not a subscription: %v", ErrInvalidRecipient, err)
+	}
+
+	return p.send(ctx, message, PushTarget{
+		Token:  subscription.Endpoint,
+		P256dh: subscription.Keys.P256dh,
+		Auth:   subscription.Keys.Auth,
+	})
+}
+
+// SendMulticast sends a push notification to each subscription; every
+// subscription is a request to its own endpoint
+func (p *WebPushProvider) SendMulticast(ctx context.Context, message *Message, targets []PushTarget) ([]PushResult, error) {
+	return eachTarget(targets, func(target PushTarget) (string, error) {
+		return p.send(ctx, message, target)
+	})
+}
+
+// send encrypts the message for one subscription and posts it to its
+// endpoint, returning the push service's message URL
+func (p *WebPushProvider) send(ctx context.Context, message *Message, target PushTarget) (string, error) {
+	endpoint, err := url.Parse(target.Token)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return "", fmt.Errorf("%w: web push endpoint must be an https URL", ErrInvalidRecipient)
+	}
+	if message.Text == "" {
+		return "", fmt.Errorf("notification body is required")
+	}
+
+	plaintext, err := json.Marshal(WebPushPayload{
+		Title: message.Subject,
+		Body:  message.Text,
+		Tag:   message.CollapseKey,
+		Data:  message.Metadata,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal web push payload: %w", err)
+	}
+	if len(plaintext) > webPushMaxPayload {
+		return "", fmt.Errorf("web push payload is %d bytes, more than the %d allowed", len(plaintext), webPushMaxPayload)
+	}
+
+	body, err := encryptWebPush(plaintext, target.P256dh, target.Auth)
+	if err != nil {
+		return "", err
+	}
+
+	authorization, err := p.vapidAuthorization(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("TTL", fmt.Sprintf("%d", int(webPushTTL.Seconds())))
+	req.Header.Set("Urgency", webPushUrgency(message.Priority))
+	if webPushTopicPattern.MatchString(message.CollapseKey) {
+		req.Header.Set("Topic", message.CollapseKey)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		p.logger.Error("Failed to send web push request", map[string]interface{}{
+			"error": err.Error(),
+			"host":  endpoint.Host,
+		})
+		return "", fmt.Errorf("failed to send web push request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		messageID := resp.Header.Get("Location")
+		p.logger.Info("Web push notification sent successfully", map[string]interface{}{
+			"host":       endpoint.Host,
+			"message_id": messageID,
+		})
+		return messageID, nil
+	}
+
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	p.logger.Error("Web push service error", map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"host":        endpoint.Host,
+		"response":    string(detail),
+	})
+
+	// The subscription expired or the user unsubscribed
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return "", fmt.Errorf("%w: web push subscription %s", ErrInvalidRecipient, http.StatusText(resp.StatusCode))
+	}
+	return "", fmt.Errorf("web push service error: status %d", resp.StatusCode)
+}
+
+// vapidAuthorization returns the VAPID Authorization header for requests to
+// the endpoint's push service
+func (p *WebPushProvider) vapidAuthorization(endpoint *url.URL) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": endpoint.Scheme + "://" + endpoint.Host,
+		"exp": time.Now().Add(vapidTokenLifetime).Unix(),
+		"sub": p.subject,
+	})
+
+	signed, err := token.SignedString(p.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign VAPID token: %w", err)
+	}
+	return "vapid t=" + signed + ", k=" + p.publicKey, nil
+}
+
+// ValidateConfig validates the Web Push provider configuration
+func (p *WebPushProvider) ValidateConfig() error {
+	if !strings.HasPrefix(p.subject, "mailto:") && !strings.HasPrefix(p.subject, "https:") {
+		return fmt.Errorf("VAPID subject must be a mailto: or https: URI")
+	}
+	return nil
+}
+
+// webPushUrgency maps a notification priority to the Urgency push services
+// use to decide whether to wake a device
+func webPushUrgency(priority string) string {
+	switch priority {
+	case "low":
+		return "low"
+	case "high", "critical":
+		return "high"
+	default:
+		return "normal"
+	}
+}
+
+// encryptWebPush encrypts a payload for a subscription's keys with a new
+// sender key pair and salt
+func encryptWebPush(plaintext []byte, p256dh, auth string) ([]byte, error) {
+	uaPublic, err := decodeBase64URL(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("%w: web push p256dh key is not base64url encoded", ErrInvalidRecipient)
+	}
+	authSecret, err := decodeBase64URL(auth)
+	if err != nil {
+		return nil, fmt.Errorf("%w: web push auth secret is not base64url encoded", ErrInvalidRecipient)
+	}
+
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate web push key: %w", err)
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate web push salt: %w", err)
+	}
+
+	return encryptWebPushRecord(plaintext, uaPublic, authSecret, asPrivate, salt)
+}
+
+// encryptWebPushRecord encrypts a payload as a single aes128gcm record
+// (RFC 8188) with the keys RFC 8291 derives from the subscription's public
+// key and auth secret
+func encryptWebPushRecord(plaintext, uaPublic, authSecret []byte, asPrivate *ecdh.PrivateKey, salt []byte) ([]byte, error) {
+	uaKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid web push p256dh key", ErrInvalidRecipient)
+	}
+	if len(authSecret) != 16 {
+		return nil, fmt.Errorf("%w: web push auth secret must be 16 bytes", ErrInvalidRecipient)
+	}
+
+	ecdhSecret, err := asPrivate.ECDH(uaKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to agree web push key: %w", err)
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	keyInfo := "WebPush: info\x00" + string(uaPublic) + string(asPublic)
+	ikm, err := hkdf.Key(sha256.New, ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, webPushHeaderSize+len(plaintext)+1+gcm.Overhead())
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, webPushRecordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	// The last (and only) record ends with the 0x02 padding delimiter
+	record := append(append([]byte(nil), plaintext...), 0x02)
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// decodeBase64URL decodes base64url with or without padding, as browsers and
+// key generators differ
+func decodeBase64URL(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
@@ -9,6 +9,7 @@ import (
 	"reciprocal-clubs-backend/services/notification-service/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Repository handles database operations for notification service
@@ -144,7 +145,7 @@ func (r *Repository) GetPendingNotifications(ctx context.Context, limit int) ([]
 func (r *Repository) GetFailedNotifications(ctx context.Context, limit int) ([]models.Notification, error) {
 	var notifications []models.Notification
 	query := r.db.WithContext(ctx).
-		Where("status = ? AND retry_count < ?", models.NotificationStatusFailed, 3)
+		Where("status = ? AND retry_count < ?", models.NotificationStatusFailed, models.MaxDeliveryAttempts)
 
 	if limit > 0 {
 		query = query.Limit(limit)
@@ -160,6 +161,115 @@ func (r *Repository) GetFailedNotifications(ctx context.Context, limit int) ([]m
 	return notifications, nil
 }
 
+// priorityOrder sorts critical notifications first; priorities are stored as strings
+const priorityOrder = "CASE priority WHEN 'critical' THEN 0 WHEN 'high' THEN 1 WHEN 'normal' THEN 2 ELSE 3 END, created_at ASC"
+
+// ClaimNotifications leases up to limit deliverable notifications of one type
+// to a worker: pending ones that are due and failed ones whose backoff has
+// elapsed. Rows leased by another worker are skipped until the lease expires,
+// so replicas never deliver the same notification concurrently.
+func (r *Repository) ClaimNotifications(ctx context.Context, notificationType models.NotificationType, workerID string, limit int, lease time.Duration) ([]models.Notification, error) {
+	now := time.Now()
+	var claimed []models.Notification
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.Notification{}).
+			Where("type = ?", notificationType).
+			Where("claimed_until IS NULL OR claimed_until < ?", now).
+			Where("(status = ? AND (scheduled_for IS NULL OR scheduled_for <= ?)) OR (status = ? AND retry_count < ? AND (next_attempt_at IS NULL OR next_attempt_at <= ?))",
+				models.NotificationStatusPending, now,
+				models.NotificationStatusFailed, models.MaxDeliveryAttempts, now).
+			Order(priorityOrder).
+			Limit(limit)
+
+		// Where supported, skip rows another transaction is claiming instead of waiting for it
+		if tx.Dialector.Name() == "postgres" {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+
+		var ids []uint
+		if err := query.Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		// The lease condition is repeated so rows claimed concurrently are left alone
+		err := tx.Model(&models.Notification{}).
+			Where("id IN ?", ids).
+			Where("claimed_until IS NULL OR claimed_until < ?", now).
+			Updates(map[string]interface{}{
+				"claimed_by":    workerID,
+				"claimed_until": now.Add(lease),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("id IN ? AND claimed_by = ?", ids, workerID).Order(priorityOrder).Find(&claimed).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to claim notifications", map[string]interface{}{
+			"error":     err.Error(),
+			"type":      notificationType,
+			"worker_id": workerID,
+		})
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// ClaimNotification leases a single pending or retryable notification to a
+// worker regardless of its schedule. It returns nil when the notification is
+// not deliverable or is leased by another worker.
+func (r *Repository) ClaimNotification(ctx context.Context, id uint, workerID string, lease time.Duration) (*models.Notification, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("id = ?", id).
+		Where("claimed_until IS NULL OR claimed_until < ?", now).
+		Where("status = ? OR (status = ? AND retry_count < ?)",
+			models.NotificationStatusPending, models.NotificationStatusFailed, models.MaxDeliveryAttempts).
+		Updates(map[string]interface{}{
+			"claimed_by":    workerID,
+			"claimed_until": now.Add(lease),
+		})
+
+	if result.Error != nil {
+		r.logger.Error("Failed to claim notification", map[string]interface{}{
+			"error":           result.Error.Error(),
+			"notification_id": id,
+			"worker_id":       workerID,
+		})
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return r.GetNotificationByID(ctx, id)
+}
+
+// RescheduleFailedNotifications makes every retryable failed notification due immediately
+func (r *Repository) RescheduleFailedNotifications(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("status = ? AND retry_count < ?", models.NotificationStatusFailed, models.MaxDeliveryAttempts).
+		Update("next_attempt_at", time.Now())
+
+	if result.Error != nil {
+		r.logger.Error("Failed to reschedule failed notifications", map[string]interface{}{
+			"error": result.Error.Error(),
+		})
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
 // UpdateNotification updates an existing notification
 func (r *Repository) UpdateNotification(ctx context.Context, notification *models.Notification) error {
 	if err := r.db.WithContext(ctx).Save(notification).Error; err != nil {
@@ -180,9 +290,19 @@ func (r *Repository) UpdateNotification(ctx context.Context, notification *model
 
 // NotificationTemplate operations
 
-// CreateNotificationTemplate creates a new notification template
+// CreateNotificationTemplate creates a new notification template along with its first version
 func (r *Repository) CreateNotificationTemplate(ctx context.Context, template *models.NotificationTemplate) error {
-	if err := r.db.WithContext(ctx).Create(template).Error; err != nil {
+	if template.Version == 0 {
+		template.Version = 1
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(template).Error; err != nil {
+			return err
+		}
+		return tx.Create(template.Snapshot(template.CreatedByID)).Error
+	})
+	if err != nil {
 		r.logger.Error("Failed to create notification template", map[string]interface{}{
 			"error":   err.Error(),
 			"club_id": template.ClubID,
@@ -251,6 +371,87 @@ func (r *Repository) UpdateNotificationTemplate(ctx context.Context, template *m
 	return nil
 }
 
+// UpdateNotificationTemplateContent saves a template whose content changed and
+// records the new content as an immutable version
+func (r *Repository) UpdateNotificationTemplateContent(ctx context.Context, template *models.NotificationTemplate, updatedByID string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(template).Error; err != nil {
+			return err
+		}
+		return tx.Create(template.Snapshot(updatedByID)).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to update notification template content", map[string]interface{}{
+			"error":       err.Error(),
+			"template_id": template.ID,
+			"version":     template.Version,
+		})
+		return err
+	}
+
+	r.logger.Info("Notification template version created", map[string]interface{}{
+		"template_id": template.ID,
+		"version":     template.Version,
+	})
+
+	return nil
+}
+
+// GetNotificationTemplateVariants retrieves the active locale and channel variants of a named template
+func (r *Repository) GetNotificationTemplateVariants(ctx context.Context, clubID uint, name string) ([]models.NotificationTemplate, error) {
+	var templates []models.NotificationTemplate
+	if err := r.db.WithContext(ctx).
+		Where("club_id = ? AND name = ? AND is_active = ?", clubID, name, true).
+		Order("id ASC").
+		Find(&templates).Error; err != nil {
+		r.logger.Error("Failed to get notification template variants", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+			"name":    name,
+		})
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// GetNotificationTemplateVersion retrieves a specific version of a template
+func (r *Repository) GetNotificationTemplateVersion(ctx context.Context, templateID uint, version int) (*models.NotificationTemplateVersion, error) {
+	var templateVersion models.NotificationTemplateVersion
+	if err := r.db.WithContext(ctx).
+		Where("template_id = ? AND version = ?", templateID, version).
+		First(&templateVersion).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, err
+		}
+		r.logger.Error("Failed to get notification template version", map[string]interface{}{
+			"error":       err.Error(),
+			"template_id": templateID,
+			"version":     version,
+		})
+		return nil, err
+	}
+
+	return &templateVersion, nil
+}
+
+// GetNotificationTemplateVersions retrieves all versions of a template, newest first
+func (r *Repository) GetNotificationTemplateVersions(ctx context.Context, templateID uint) ([]models.NotificationTemplateVersion, error) {
+	var versions []models.NotificationTemplateVersion
+	if err := r.db.WithContext(ctx).
+		Where("template_id = ?", templateID).
+		Order("version DESC").
+		Find(&versions).Error; err != nil {
+		r.logger.Error("Failed to get notification template versions", map[string]interface{}{
+			"error":       err.Error(),
+			"template_id": templateID,
+		})
+		return nil, err
+	}
+
+	return versions, nil
+}
+
 // NotificationPreference operations
 
 // CreateNotificationPreference creates a new notification preference
@@ -454,9 +655,38 @@ func (r *Repository) GetUserPreferences(ctx context.Context, userID string, club
 
 // UpsertUserPreferences creates or updates user preferences
 func (r *Repository) UpsertUserPreferences(ctx context.Context, preference *models.UserPreferences) error {
-	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND club_id = ?", preference.UserID, preference.ClubID).
-		FirstOrCreate(preference).Error
+	// Channel flags default to true in the schema, so they are written
+	// explicitly; otherwise a disabled channel would be stored as enabled
+	channels := map[string]interface{}{
+		"email_enabled":  preference.EmailEnabled,
+		"sms_enabled":    preference.SMSEnabled,
+		"push_enabled":   preference.PushEnabled,
+		"in_app_enabled": preference.InAppEnabled,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.UserPreferences
+		err := tx.Where("user_id = ? AND club_id = ?", preference.UserID, preference.ClubID).First(&existing).Error
+		switch {
+		case err == nil:
+			preference.ID = existing.ID
+			preference.CreatedAt = existing.CreatedAt
+			if err := tx.Save(preference).Error; err != nil {
+				return err
+			}
+		case err == gorm.ErrRecordNotFound:
+			if err := tx.Create(preference).Error; err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+
+		if err := tx.Model(preference).Updates(channels).Error; err != nil {
+			return err
+		}
+		return tx.First(preference, preference.ID).Error
+	})
 
 	if err != nil {
 		r.logger.Error("Failed to upsert user preferences", map[string]interface{}{
@@ -475,6 +705,1274 @@ func (r *Repository) UpsertUserPreferences(ctx context.Context, preference *mode
 	return nil
 }
 
+// UpsertNotificationPreference creates or updates the preference for one notification type
+func (r *Repository) UpsertNotificationPreference(ctx context.Context, preference *models.NotificationPreference) error {
+	isEnabled := preference.IsEnabled
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.NotificationPreference
+		err := tx.Where("user_id = ? AND club_id = ? AND notification_type = ?",
+			preference.UserID, preference.ClubID, preference.NotificationType).First(&existing).Error
+		switch {
+		case err == nil:
+			preference.ID = existing.ID
+			preference.CreatedAt = existing.CreatedAt
+			if err := tx.Save(preference).Error; err != nil {
+				return err
+			}
+		case err == gorm.ErrRecordNotFound:
+			if err := tx.Create(preference).Error; err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+
+		// is_enabled defaults to true in the schema, so write it explicitly
+		preference.IsEnabled = isEnabled
+		return tx.Model(preference).Update("is_enabled", isEnabled).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to upsert notification preference", map[string]interface{}{
+			"error":             err.Error(),
+			"user_id":           preference.UserID,
+			"club_id":           preference.ClubID,
+			"notification_type": preference.NotificationType,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetClubUserIDs retrieves the users who have notification preferences in a club
+func (r *Repository) GetClubUserIDs(ctx context.Context, clubID uint) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&models.UserPreferences{}).
+		Where("club_id = ?", clubID).
+		Distinct().
+		Order("user_id").
+		Pluck("user_id", &userIDs).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get club user IDs", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// NotificationRule operations
+
+// CreateNotificationRule creates a new notification rule
+func (r *Repository) CreateNotificationRule(ctx context.Context, rule *models.NotificationRule) error {
+	isActive := rule.IsActive
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(rule).Error; err != nil {
+			return err
+		}
+
+		// is_active defaults to true in the schema, so write it explicitly
+		rule.IsActive = isActive
+		return tx.Model(rule).Update("is_active", isActive).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to create notification rule", map[string]interface{}{
+			"error":      err.Error(),
+			"club_id":    rule.ClubID,
+			"event_type": rule.EventType,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetNotificationRuleByID retrieves a notification rule by ID
+func (r *Repository) GetNotificationRuleByID(ctx context.Context, id uint) (*models.NotificationRule, error) {
+	var rule models.NotificationRule
+	if err := r.db.WithContext(ctx).First(&rule, id).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			r.logger.Error("Failed to get notification rule", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			})
+		}
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// GetNotificationRulesByClub retrieves all notification rules for a club
+func (r *Repository) GetNotificationRulesByClub(ctx context.Context, clubID uint) ([]models.NotificationRule, error) {
+	var rules []models.NotificationRule
+	if err := r.db.WithContext(ctx).Where("club_id = ?", clubID).Order("id").Find(&rules).Error; err != nil {
+		r.logger.Error("Failed to get notification rules by club", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// GetActiveNotificationRules retrieves the active notification rules of every club
+func (r *Repository) GetActiveNotificationRules(ctx context.Context) ([]models.NotificationRule, error) {
+	var rules []models.NotificationRule
+	if err := r.db.WithContext(ctx).Where("is_active = ?", true).Order("id").Find(&rules).Error; err != nil {
+		r.logger.Error("Failed to get active notification rules", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// UpdateNotificationRule updates an existing notification rule
+func (r *Repository) UpdateNotificationRule(ctx context.Context, rule *models.NotificationRule) error {
+	if err := r.db.WithContext(ctx).Save(rule).Error; err != nil {
+		r.logger.Error("Failed to update notification rule", map[string]interface{}{
+			"error": err.Error(),
+			"id":    rule.ID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNotificationRule soft deletes a notification rule
+func (r *Repository) DeleteNotificationRule(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.NotificationRule{}, id)
+	if result.Error != nil {
+		r.logger.Error("Failed to delete notification rule", map[string]interface{}{
+			"error": result.Error.Error(),
+			"id":    id,
+		})
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Event processing operations
+
+// IsEventProcessed reports whether a domain event was already handled
+func (r *Repository) IsEventProcessed(ctx context.Context, messageID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.ProcessedEvent{}).
+		Where("message_id = ?", messageID).
+		Count(&count).Error
+
+	if err != nil {
+		r.logger.Error("Failed to check processed event", map[string]interface{}{
+			"error":      err.Error(),
+			"message_id": messageID,
+		})
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// MarkEventProcessed records a handled domain event
+func (r *Repository) MarkEventProcessed(ctx context.Context, event *models.ProcessedEvent) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		r.logger.Error("Failed to mark event processed", map[string]interface{}{
+			"error":      err.Error(),
+			"message_id": event.MessageID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetEventNotifiedUsers retrieves the users a rule already notified for an event
+func (r *Repository) GetEventNotifiedUsers(ctx context.Context, eventID string, ruleID uint) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("source_event_id = ? AND rule_id = ?", eventID, ruleID).
+		Distinct().
+		Pluck("user_id", &userIDs).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get event notified users", map[string]interface{}{
+			"error":    err.Error(),
+			"event_id": eventID,
+			"rule_id":  ruleID,
+		})
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// CreateEventDeadLetter stores an event that could not be processed
+func (r *Repository) CreateEventDeadLetter(ctx context.Context, deadLetter *models.EventDeadLetter) error {
+	if err := r.db.WithContext(ctx).Create(deadLetter).Error; err != nil {
+		r.logger.Error("Failed to create event dead letter", map[string]interface{}{
+			"error":      err.Error(),
+			"message_id": deadLetter.MessageID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetEventDeadLetters retrieves dead-lettered events, newest first
+func (r *Repository) GetEventDeadLetters(ctx context.Context, includeReplayed bool, limit, offset int) ([]models.EventDeadLetter, error) {
+	var deadLetters []models.EventDeadLetter
+	query := r.db.WithContext(ctx).Order("created_at DESC")
+
+	if !includeReplayed {
+		query = query.Where("replayed_at IS NULL")
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	if err := query.Find(&deadLetters).Error; err != nil {
+		r.logger.Error("Failed to get event dead letters", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	return deadLetters, nil
+}
+
+// GetEventDeadLetterByID retrieves a dead-lettered event by ID
+func (r *Repository) GetEventDeadLetterByID(ctx context.Context, id uint) (*models.EventDeadLetter, error) {
+	var deadLetter models.EventDeadLetter
+	if err := r.db.WithContext(ctx).First(&deadLetter, id).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			r.logger.Error("Failed to get event dead letter", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			})
+		}
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+// UpdateEventDeadLetter updates a dead-lettered event
+func (r *Repository) UpdateEventDeadLetter(ctx context.Context, deadLetter *models.EventDeadLetter) error {
+	if err := r.db.WithContext(ctx).Save(deadLetter).Error; err != nil {
+		r.logger.Error("Failed to update event dead letter", map[string]interface{}{
+			"error": err.Error(),
+			"id":    deadLetter.ID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// Delivery receipt operations
+
+// GetNotificationByProviderMessageID retrieves the notification a provider accepted under messageID
+func (r *Repository) GetNotificationByProviderMessageID(ctx context.Context, messageID string) (*models.Notification, error) {
+	var notification models.Notification
+	err := r.db.WithContext(ctx).
+		Where("provider_message_id = ?", messageID).
+		First(&notification).Error
+
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			r.logger.Error("Failed to get notification by provider message ID", map[string]interface{}{
+				"error":      err.Error(),
+				"message_id": messageID,
+			})
+		}
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+// SuppressRecipient adds a recipient to the suppression list; suppressing an
+// already suppressed recipient keeps the original entry
+func (r *Repository) SuppressRecipient(ctx context.Context, suppression *models.SuppressedRecipient) error {
+	suppression.Recipient = models.NormalizeRecipient(suppression.Type, suppression.Recipient)
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(suppression).Error
+
+	if err != nil {
+		r.logger.Error("Failed to suppress recipient", map[string]interface{}{
+			"error": err.Error(),
+			"type":  suppression.Type,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetSuppressedRecipient retrieves the suppression of a recipient, or nil if it is not suppressed
+func (r *Repository) GetSuppressedRecipient(ctx context.Context, notificationType models.NotificationType, recipient string) (*models.SuppressedRecipient, error) {
+	var suppressions []models.SuppressedRecipient
+	err := r.db.WithContext(ctx).
+		Where("type = ? AND recipient = ?", notificationType, models.NormalizeRecipient(notificationType, recipient)).
+		Limit(1).
+		Find(&suppressions).Error
+
+	if err != nil {
+		r.logger.Error("Failed to check suppressed recipient", map[string]interface{}{
+			"error": err.Error(),
+			"type":  notificationType,
+		})
+		return nil, err
+	}
+	if len(suppressions) == 0 {
+		return nil, nil
+	}
+
+	return &suppressions[0], nil
+}
+
+// GetSuppressedRecipients retrieves suppressed recipients, newest first,
+// optionally filtered by channel
+func (r *Repository) GetSuppressedRecipients(ctx context.Context, notificationType models.NotificationType, limit, offset int) ([]models.SuppressedRecipient, error) {
+	var suppressions []models.SuppressedRecipient
+	query := r.db.WithContext(ctx).Order("created_at DESC")
+
+	if notificationType != "" {
+		query = query.Where("type = ?", notificationType)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	if err := query.Find(&suppressions).Error; err != nil {
+		r.logger.Error("Failed to get suppressed recipients", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	return suppressions, nil
+}
+
+// DeleteSuppressedRecipient removes a recipient from the suppression list
+func (r *Repository) DeleteSuppressedRecipient(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.SuppressedRecipient{}, id)
+	if result.Error != nil {
+		r.logger.Error("Failed to delete suppressed recipient", map[string]interface{}{
+			"error": result.Error.Error(),
+			"id":    id,
+		})
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Digest operations
+
+// GetDigestPreferences retrieves a user's digest preferences
+func (r *Repository) GetDigestPreferences(ctx context.Context, userID string, clubID uint) ([]models.DigestPreference, error) {
+	var preferences []models.DigestPreference
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND club_id = ?", userID, clubID).
+		Order("category").
+		Find(&preferences).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get digest preferences", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+// UpsertDigestPreference creates or updates the digest preference for a user's category
+func (r *Repository) UpsertDigestPreference(ctx context.Context, preference *models.DigestPreference) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "club_id"}, {Name: "user_id"}, {Name: "category"}},
+			DoUpdates: clause.AssignmentColumns([]string{"frequency", "weekday", "updated_at"}),
+		}).
+		Create(preference).Error
+
+	if err != nil {
+		r.logger.Error("Failed to upsert digest preference", map[string]interface{}{
+			"error":    err.Error(),
+			"user_id":  preference.UserID,
+			"club_id":  preference.ClubID,
+			"category": preference.Category,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDigestPreference returns a user's category to real-time delivery
+func (r *Repository) DeleteDigestPreference(ctx context.Context, userID string, clubID uint, category string) error {
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND club_id = ? AND category = ?", userID, clubID, category).
+		Delete(&models.DigestPreference{}).Error
+
+	if err != nil {
+		r.logger.Error("Failed to delete digest preference", map[string]interface{}{
+			"error":    err.Error(),
+			"user_id":  userID,
+			"club_id":  clubID,
+			"category": category,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetOpenDigest retrieves the pending digest a user's notifications on a
+// channel are collected into, or nil if none is due after the given time
+func (r *Repository) GetOpenDigest(ctx context.Context, clubID uint, userID string, notificationType models.NotificationType, frequency models.DigestFrequency, after time.Time) (*models.Notification, error) {
+	var digests []models.Notification
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND user_id = ? AND type = ? AND digest_frequency = ?", clubID, userID, notificationType, frequency).
+		Where("status = ? AND scheduled_for > ?", models.NotificationStatusPending, after).
+		Order("scheduled_for").
+		Limit(1).
+		Find(&digests).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get open digest", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+	if len(digests) == 0 {
+		return nil, nil
+	}
+
+	return &digests[0], nil
+}
+
+// GetDigestItems retrieves the notifications held for a digest, oldest first
+func (r *Repository) GetDigestItems(ctx context.Context, digestID uint) ([]models.Notification, error) {
+	var items []models.Notification
+	err := r.db.WithContext(ctx).
+		Where("digest_id = ? AND status = ?", digestID, models.NotificationStatusBatched).
+		Order("created_at, id").
+		Find(&items).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get digest items", map[string]interface{}{
+			"error":     err.Error(),
+			"digest_id": digestID,
+		})
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// MarkDigestItemsDigested records that the given items went out in their digest
+func (r *Repository) MarkDigestItemsDigested(ctx context.Context, digestID uint, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("digest_id = ? AND id IN ?", digestID, ids).
+		Updates(map[string]interface{}{
+			"status":  models.NotificationStatusDigested,
+			"sent_at": time.Now(),
+		}).Error
+
+	if err != nil {
+		r.logger.Error("Failed to mark digest items", map[string]interface{}{
+			"error":     err.Error(),
+			"digest_id": digestID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// Inbox operations
+
+// inbox scopes a query to the in-app notifications delivered to a user
+func inbox(db *gorm.DB, clubID uint, userID string) *gorm.DB {
+	return db.Model(&models.Notification{}).
+		Where("club_id = ? AND user_id = ? AND type = ?", clubID, userID, models.NotificationTypeInApp).
+		Where("status IN ?", []models.NotificationStatus{
+			models.NotificationStatusSent,
+			models.NotificationStatusDelivered,
+			models.NotificationStatusRead,
+		})
+}
+
+// GetInbox retrieves a page of a user's inbox, pinned notifications first.
+// Archived notifications are only returned, newest archived first, when
+// archived is set.
+func (r *Repository) GetInbox(ctx context.Context, clubID uint, userID string, unreadOnly, archived bool, limit, offset int) ([]models.Notification, error) {
+	var notifications []models.Notification
+	query := inbox(r.db.WithContext(ctx), clubID, userID)
+
+	if archived {
+		query = query.Where("archived_at IS NOT NULL").Order("archived_at DESC")
+	} else {
+		query = query.Where("archived_at IS NULL").Order("pinned_at IS NULL, pinned_at DESC")
+	}
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	if err := query.Order("created_at DESC, id DESC").Find(&notifications).Error; err != nil {
+		r.logger.Error("Failed to get inbox", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// CountUnreadInbox counts the unread notifications in a user's inbox
+func (r *Repository) CountUnreadInbox(ctx context.Context, clubID uint, userID string) (int64, error) {
+	var count int64
+	err := inbox(r.db.WithContext(ctx), clubID, userID).
+		Where("read_at IS NULL AND archived_at IS NULL").
+		Count(&count).Error
+
+	if err != nil {
+		r.logger.Error("Failed to count unread inbox notifications", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// MarkInboxRead marks unread notifications in a user's inbox as read, every
+// one not archived when no IDs are given, and returns the IDs that changed
+func (r *Repository) MarkInboxRead(ctx context.Context, clubID uint, userID string, ids []uint) ([]uint, error) {
+	condition := "read_at IS NULL"
+	if len(ids) == 0 {
+		condition += " AND archived_at IS NULL"
+	}
+	return r.updateInbox(ctx, clubID, userID, ids, condition, map[string]interface{}{
+		"status":  models.NotificationStatusRead,
+		"read_at": time.Now(),
+	})
+}
+
+// ArchiveInbox moves notifications out of a user's inbox, unpinning them, and
+// returns the IDs that changed
+func (r *Repository) ArchiveInbox(ctx context.Context, clubID uint, userID string, ids []uint) ([]uint, error) {
+	return r.updateInbox(ctx, clubID, userID, ids, "archived_at IS NULL", map[string]interface{}{
+		"archived_at": time.Now(),
+		"pinned_at":   nil,
+	})
+}
+
+// SetInboxPinned pins or unpins a notification in a user's inbox. Archived
+// notifications cannot be pinned.
+func (r *Repository) SetInboxPinned(ctx context.Context, clubID uint, userID string, id uint, pinned bool) (*models.Notification, error) {
+	var pinnedAt *time.Time
+	if pinned {
+		now := time.Now()
+		pinnedAt = &now
+	}
+
+	result := inbox(r.db.WithContext(ctx), clubID, userID).
+		Where("id = ? AND archived_at IS NULL", id).
+		Update("pinned_at", pinnedAt)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to pin inbox notification", map[string]interface{}{
+			"error":           result.Error.Error(),
+			"notification_id": id,
+			"user_id":         userID,
+		})
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return r.GetNotificationByID(ctx, id)
+}
+
+// updateInbox applies updates to the notifications in a user's inbox matching
+// condition, restricted to ids when given, and returns the IDs it updated
+func (r *Repository) updateInbox(ctx context.Context, clubID uint, userID string, ids []uint, condition string, updates map[string]interface{}) ([]uint, error) {
+	var changed []uint
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := inbox(tx, clubID, userID).Where(condition)
+		if len(ids) > 0 {
+			query = query.Where("id IN ?", ids)
+		}
+		if err := query.Pluck("id", &changed).Error; err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+		return tx.Model(&models.Notification{}).Where("id IN ?", changed).Updates(updates).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to update inbox notifications", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return changed, nil
+}
+
+// CreateInboxEvent records a change to a user's inbox
+func (r *Repository) CreateInboxEvent(ctx context.Context, event *models.InboxEvent) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		r.logger.Error("Failed to create inbox event", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": event.UserID,
+			"club_id": event.ClubID,
+			"type":    event.Type,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetInboxEvents retrieves a user's inbox events after the given event ID, oldest first
+func (r *Repository) GetInboxEvents(ctx context.Context, clubID uint, userID string, afterID uint, limit int) ([]models.InboxEvent, error) {
+	var events []models.InboxEvent
+	query := r.db.WithContext(ctx).
+		Where("club_id = ? AND user_id = ? AND id > ?", clubID, userID, afterID).
+		Order("id")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&events).Error; err != nil {
+		r.logger.Error("Failed to get inbox events", map[string]interface{}{
+			"error":    err.Error(),
+			"user_id":  userID,
+			"club_id":  clubID,
+			"after_id": afterID,
+		})
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GetLatestInboxEventID returns the ID of a user's latest inbox event, or 0 when there is none
+func (r *Repository) GetLatestInboxEventID(ctx context.Context, clubID uint, userID string) (uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&models.InboxEvent{}).
+		Where("club_id = ? AND user_id = ?", clubID, userID).
+		Order("id DESC").
+		Limit(1).
+		Pluck("id", &ids).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get latest inbox event", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	return ids[0], nil
+}
+
+// DeleteInboxEventsBefore removes a user's inbox events recorded before the given time
+func (r *Repository) DeleteInboxEventsBefore(ctx context.Context, clubID uint, userID string, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("club_id = ? AND user_id = ? AND created_at < ?", clubID, userID, before).
+		Delete(&models.InboxEvent{})
+
+	if result.Error != nil {
+		r.logger.Error("Failed to delete inbox events", map[string]interface{}{
+			"error":   result.Error.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Device operations
+
+// RegisterDevice registers a device for push notifications. A token that is
+// already registered is moved to the device's user and its keys, app version
+// and last seen time are updated.
+func (r *Repository) RegisterDevice(ctx context.Context, device *models.Device) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "platform"}, {Name: "token"}},
+			DoUpdates: clause.AssignmentColumns([]string{"club_id", "user_id", "p256dh", "auth", "app_version", "last_seen_at", "updated_at"}),
+		}).Create(device).Error
+		if err != nil {
+			return err
+		}
+
+		// Not every database returns the existing row's ID from an upsert
+		return tx.Where("platform = ? AND token = ?", device.Platform, device.Token).First(device).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to register device", map[string]interface{}{
+			"error":    err.Error(),
+			"user_id":  device.UserID,
+			"club_id":  device.ClubID,
+			"platform": device.Platform,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetUserDevices retrieves the devices a user registered, most recently seen first
+func (r *Repository) GetUserDevices(ctx context.Context, clubID uint, userID string) ([]models.Device, error) {
+	var devices []models.Device
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND user_id = ?", clubID, userID).
+		Order("last_seen_at DESC, id DESC").
+		Find(&devices).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get user devices", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return devices, nil
+}
+
+// DeleteDevice unregisters one of a user's devices
+func (r *Repository) DeleteDevice(ctx context.Context, clubID uint, userID string, id uint) error {
+	result := r.db.WithContext(ctx).
+		Where("club_id = ? AND user_id = ?", clubID, userID).
+		Delete(&models.Device{}, id)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to delete device", map[string]interface{}{
+			"error":   result.Error.Error(),
+			"id":      id,
+			"user_id": userID,
+		})
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// DeleteDeviceTokens unregisters the devices with the given tokens on a
+// platform, or on any platform when platform is empty
+func (r *Repository) DeleteDeviceTokens(ctx context.Context, platform models.DevicePlatform, tokens []string) (int64, error) {
+	if len(tokens) == 0 {
+		return 0, nil
+	}
+
+	query := r.db.WithContext(ctx).Where("token IN ?", tokens)
+	if platform != "" {
+		query = query.Where("platform = ?", platform)
+	}
+
+	result := query.Delete(&models.Device{})
+
+	if result.Error != nil {
+		r.logger.Error("Failed to delete device tokens", map[string]interface{}{
+			"error":    result.Error.Error(),
+			"platform": platform,
+			"count":    len(tokens),
+		})
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ReplaceDeviceToken moves a device to the new token its push service
+// reported for it. When the new token is already registered the old
+// registration is a duplicate and is removed.
+func (r *Repository) ReplaceDeviceToken(ctx context.Context, platform models.DevicePlatform, oldToken, newToken string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing int64
+		if err := tx.Model(&models.Device{}).
+			Where("platform = ? AND token = ?", platform, newToken).
+			Count(&existing).Error; err != nil {
+			return err
+		}
+
+		old := tx.Where("platform = ? AND token = ?", platform, oldToken)
+		if existing > 0 {
+			return old.Delete(&models.Device{}).Error
+		}
+		return old.Model(&models.Device{}).Update("token", newToken).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to replace device token", map[string]interface{}{
+			"error":    err.Error(),
+			"platform": platform,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// Webhook subscription operations
+
+// CreateWebhookSubscription creates a new webhook subscription
+func (r *Repository) CreateWebhookSubscription(ctx context.Context, subscription *models.WebhookSubscription) error {
+	if err := r.db.WithContext(ctx).Create(subscription).Error; err != nil {
+		r.logger.Error("Failed to create webhook subscription", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": subscription.ClubID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// GetWebhookSubscriptionByID retrieves a webhook subscription by ID
+func (r *Repository) GetWebhookSubscriptionByID(ctx context.Context, id uint) (*models.WebhookSubscription, error) {
+	var subscription models.WebhookSubscription
+	if err := r.db.WithContext(ctx).First(&subscription, id).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			r.logger.Error("Failed to get webhook subscription", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			})
+		}
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+// GetWebhookSubscriptionsByClub retrieves the webhook subscriptions of a club
+func (r *Repository) GetWebhookSubscriptionsByClub(ctx context.Context, clubID uint) ([]models.WebhookSubscription, error) {
+	var subscriptions []models.WebhookSubscription
+	if err := r.db.WithContext(ctx).Where("club_id = ?", clubID).Order("id").Find(&subscriptions).Error; err != nil {
+		r.logger.Error("Failed to get webhook subscriptions by club", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// GetActiveWebhookSubscriptions retrieves the enabled webhook subscriptions of a club
+func (r *Repository) GetActiveWebhookSubscriptions(ctx context.Context, clubID uint) ([]models.WebhookSubscription, error) {
+	var subscriptions []models.WebhookSubscription
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND is_active = ?", clubID, true).
+		Order("id").
+		Find(&subscriptions).Error
+
+	if err != nil {
+		r.logger.Error("Failed to get active webhook subscriptions", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// UpdateWebhookSubscription updates an existing webhook subscription
+func (r *Repository) UpdateWebhookSubscription(ctx context.Context, subscription *models.WebhookSubscription) error {
+	if err := r.db.WithContext(ctx).Save(subscription).Error; err != nil {
+		r.logger.Error("Failed to update webhook subscription", map[string]interface{}{
+			"error": err.Error(),
+			"id":    subscription.ID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// DeleteWebhookSubscription soft deletes a webhook subscription and
+// dead-letters the deliveries still waiting to be sent to it
+func (r *Repository) DeleteWebhookSubscription(ctx context.Context, id uint) error {
+	var deleted int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.WebhookSubscription{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		if deleted == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("subscription_id = ? AND status IN ?", id, []models.WebhookDeliveryStatus{
+				models.WebhookDeliveryPending, models.WebhookDeliveryFailed,
+			}).
+			Updates(map[string]interface{}{
+				"status":          models.WebhookDeliveryDeadLetter,
+				"last_error":      "subscription deleted",
+				"next_attempt_at": nil,
+			}).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to delete webhook subscription", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		})
+		return err
+	}
+	if deleted == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// RecordWebhookSubscriptionResult updates a subscription's run of failed
+// attempts after a delivery attempt and returns the updated subscription. A
+// success ends the run; a failure extends it, starting it at the given time.
+func (r *Repository) RecordWebhookSubscriptionResult(ctx context.Context, id uint, succeeded bool, at time.Time) (*models.WebhookSubscription, error) {
+	updates := map[string]interface{}{
+		"consecutive_failures": 0,
+		"failing_since":        nil,
+	}
+	if !succeeded {
+		updates = map[string]interface{}{
+			"consecutive_failures": gorm.Expr("consecutive_failures + 1"),
+			"failing_since":        gorm.Expr("COALESCE(failing_since, ?)", at),
+		}
+	}
+
+	var subscription models.WebhookSubscription
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.WebhookSubscription{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return err
+		}
+		return tx.First(&subscription, id).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to record webhook subscription result", map[string]interface{}{
+			"error":     err.Error(),
+			"id":        id,
+			"succeeded": succeeded,
+		})
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+// DisableWebhookSubscription disables an active subscription and reports
+// whether it was this call that disabled it
+func (r *Repository) DisableWebhookSubscription(ctx context.Context, id uint, reason string, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.WebhookSubscription{}).
+		Where("id = ? AND is_active = ?", id, true).
+		Updates(map[string]interface{}{
+			"is_active":       false,
+			"disabled_at":     at,
+			"disabled_reason": reason,
+		})
+
+	if result.Error != nil {
+		r.logger.Error("Failed to disable webhook subscription", map[string]interface{}{
+			"error": result.Error.Error(),
+			"id":    id,
+		})
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// CreateWebhookDeliveries queues deliveries, skipping events already queued
+// for a subscription, and returns how many were queued
+func (r *Repository) CreateWebhookDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) (int64, error) {
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(&deliveries)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to queue webhook deliveries", map[string]interface{}{
+			"error":    result.Error.Error(),
+			"event_id": deliveries[0].EventID,
+		})
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ClaimWebhookDeliveries leases up to limit due deliveries of enabled
+// subscriptions to a worker: pending ones and failed ones whose backoff has
+// elapsed. Deliveries leased by another worker are skipped until the lease
+// expires.
+func (r *Repository) ClaimWebhookDeliveries(ctx context.Context, workerID string, limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	now := time.Now()
+	var claimed []models.WebhookDelivery
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		active := tx.Model(&models.WebhookSubscription{}).Select("id").Where("is_active = ?", true)
+		query := tx.Model(&models.WebhookDelivery{}).
+			Where("claimed_until IS NULL OR claimed_until < ?", now).
+			Where("status IN ?", []models.WebhookDeliveryStatus{models.WebhookDeliveryPending, models.WebhookDeliveryFailed}).
+			Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+			Where("subscription_id IN (?)", active).
+			Order("id").
+			Limit(limit)
+
+		// Where supported, skip rows another transaction is claiming instead of waiting for it
+		if tx.Dialector.Name() == "postgres" {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+
+		var ids []uint
+		if err := query.Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		// The lease condition is repeated so rows claimed concurrently are left alone
+		err := tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Where("claimed_until IS NULL OR claimed_until < ?", now).
+			Updates(map[string]interface{}{
+				"claimed_by":    workerID,
+				"claimed_until": now.Add(lease),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("id IN ? AND claimed_by = ?", ids, workerID).Order("id").Find(&claimed).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to claim webhook deliveries", map[string]interface{}{
+			"error":     err.Error(),
+			"worker_id": workerID,
+		})
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// GetWebhookDeliveryByID retrieves a webhook delivery with its attempts, oldest first
+func (r *Repository) GetWebhookDeliveryByID(ctx context.Context, id uint) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	err := r.db.WithContext(ctx).
+		Preload("AttemptLog", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		First(&delivery, id).Error
+
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			r.logger.Error("Failed to get webhook delivery", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			})
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// GetWebhookDeliveries retrieves a subscription's deliveries, newest first,
+// optionally with one status or event type
+func (r *Repository) GetWebhookDeliveries(ctx context.Context, subscriptionID uint, status models.WebhookDeliveryStatus, eventType string, limit, offset int) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	query := r.db.WithContext(ctx).Where("subscription_id = ?", subscriptionID)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if eventType != "" {
+		query = query.Where("event_type = ?", eventType)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	if err := query.Order("id DESC").Find(&deliveries).Error; err != nil {
+		r.logger.Error("Failed to get webhook deliveries", map[string]interface{}{
+			"error":           err.Error(),
+			"subscription_id": subscriptionID,
+		})
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// SaveWebhookDeliveryAttempt records an attempt and the delivery's outcome
+// together; saving the delivery releases its claim
+func (r *Repository) SaveWebhookDeliveryAttempt(ctx context.Context, delivery *models.WebhookDelivery, attempt *models.WebhookDeliveryAttempt) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(attempt).Error; err != nil {
+			return err
+		}
+		return tx.Omit("AttemptLog").Save(delivery).Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to save webhook delivery attempt", map[string]interface{}{
+			"error":       err.Error(),
+			"delivery_id": delivery.ID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// ResetWebhookDelivery queues a delivery to be sent again with a fresh set of
+// attempts. It returns false when a worker is currently sending it.
+func (r *Repository) ResetWebhookDelivery(ctx context.Context, id uint) (bool, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&models.WebhookDelivery{}).
+		Where("id = ?", id).
+		Where("claimed_until IS NULL OR claimed_until < ?", now).
+		Updates(map[string]interface{}{
+			"status":          models.WebhookDeliveryPending,
+			"attempts":        0,
+			"next_attempt_at": nil,
+			"claimed_by":      "",
+			"claimed_until":   nil,
+		})
+
+	if result.Error != nil {
+		r.logger.Error("Failed to reset webhook delivery", map[string]interface{}{
+			"error": result.Error.Error(),
+			"id":    id,
+		})
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteWebhookDeliveriesBefore removes a club's finished webhook deliveries,
+// and their attempts, created before the given time
+func (r *Repository) DeleteWebhookDeliveriesBefore(ctx context.Context, clubID uint, before time.Time) (int64, error) {
+	var deleted int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		finished := tx.Model(&models.WebhookDelivery{}).
+			Select("id").
+			Where("club_id = ? AND created_at < ?", clubID, before).
+			Where("status IN ?", []models.WebhookDeliveryStatus{models.WebhookDeliverySucceeded, models.WebhookDeliveryDeadLetter})
+
+		if err := tx.Where("delivery_id IN (?)", finished).Delete(&models.WebhookDeliveryAttempt{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("club_id = ? AND created_at < ?", clubID, before).
+			Where("status IN ?", []models.WebhookDeliveryStatus{models.WebhookDeliverySucceeded, models.WebhookDeliveryDeadLetter}).
+			Delete(&models.WebhookDelivery{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to delete webhook deliveries", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
 // Advanced query methods
 
 // GetNotificationsByStatus retrieves notifications by status with pagination
@@ -32,6 +32,7 @@ func (suite *NotificationRepositoryTestSuite) SetupSuite() {
 	err = db.AutoMigrate(
 		&models.Notification{},
 		&models.NotificationTemplate{},
+		&models.NotificationTemplateVersion{},
 		&models.NotificationPreference{},
 		&models.UserPreferences{},
 	)
@@ -46,6 +47,7 @@ func (suite *NotificationRepositoryTestSuite) SetupTest() {
 	// Clean up tables before each test
 	suite.db.Exec("DELETE FROM notifications")
 	suite.db.Exec("DELETE FROM notification_templates")
+	suite.db.Exec("DELETE FROM notification_template_versions")
 	suite.db.Exec("DELETE FROM notification_preferences")
 }
 
@@ -276,6 +278,102 @@ func (suite *NotificationRepositoryTestSuite) TestCreateNotificationTemplate_Suc
 	assert.NotZero(suite.T(), template.CreatedAt)
 }
 
+// Test template versioning
+func (suite *NotificationRepositoryTestSuite) TestNotificationTemplateVersions() {
+	ctx := context.Background()
+	template := &models.NotificationTemplate{
+		ClubID:      1,
+		Name:        "booking_confirmed",
+		Type:        models.NotificationTypeEmail,
+		Locale:      "en",
+		Subject:     "Booking confirmed",
+		Body:        "See you on {{.date}}",
+		IsActive:    true,
+		CreatedByID: "user123",
+	}
+
+	err := suite.repo.CreateNotificationTemplate(ctx, template)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 1, template.Version)
+
+	template.Body = "See you on {{.date}} at {{.club}}"
+	template.Version++
+	err = suite.repo.UpdateNotificationTemplateContent(ctx, template, "user456")
+	suite.Require().NoError(err)
+
+	versions, err := suite.repo.GetNotificationTemplateVersions(ctx, template.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(versions, 2)
+	assert.Equal(suite.T(), 2, versions[0].Version)
+	assert.Equal(suite.T(), "user456", versions[0].CreatedByID)
+
+	original, err := suite.repo.GetNotificationTemplateVersion(ctx, template.ID, 1)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "See you on {{.date}}", original.Body)
+
+	variants, err := suite.repo.GetNotificationTemplateVariants(ctx, 1, "booking_confirmed")
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), variants, 1)
+}
+
+// Test ClaimNotifications leases due notifications to one worker at a time
+func (suite *NotificationRepositoryTestSuite) TestClaimNotifications_LeasesDueNotifications() {
+	ctx := context.Background()
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	newNotification := func(priority models.NotificationPriority, status models.NotificationStatus) *models.Notification {
+		notification := &models.Notification{
+			ClubID:    1,
+			Type:      models.NotificationTypeEmail,
+			Priority:  priority,
+			Message:   "Test Message",
+			Recipient: "test@example.com",
+			Status:    status,
+		}
+		suite.Require().NoError(suite.repo.CreateNotification(ctx, notification))
+		return notification
+	}
+
+	normal := newNotification(models.NotificationPriorityNormal, models.NotificationStatusPending)
+	critical := newNotification(models.NotificationPritorityCritical, models.NotificationStatusPending)
+	retryDue := newNotification(models.NotificationPriorityNormal, models.NotificationStatusFailed)
+	suite.Require().NoError(suite.db.Model(retryDue).Updates(map[string]interface{}{"retry_count": 1, "next_attempt_at": past}).Error)
+
+	backingOff := newNotification(models.NotificationPriorityNormal, models.NotificationStatusFailed)
+	suite.Require().NoError(suite.db.Model(backingOff).Update("next_attempt_at", future).Error)
+	exhausted := newNotification(models.NotificationPriorityNormal, models.NotificationStatusFailed)
+	suite.Require().NoError(suite.db.Model(exhausted).Update("retry_count", models.MaxDeliveryAttempts).Error)
+	scheduled := newNotification(models.NotificationPriorityNormal, models.NotificationStatusPending)
+	suite.Require().NoError(suite.db.Model(scheduled).Update("scheduled_for", future).Error)
+
+	first, err := suite.repo.ClaimNotifications(ctx, models.NotificationTypeEmail, "worker-a", 2, time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Len(first, 2)
+	assert.Equal(suite.T(), critical.ID, first[0].ID)
+	assert.Equal(suite.T(), normal.ID, first[1].ID)
+
+	second, err := suite.repo.ClaimNotifications(ctx, models.NotificationTypeEmail, "worker-b", 10, time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Len(second, 1)
+	assert.Equal(suite.T(), retryDue.ID, second[0].ID)
+
+	none, err := suite.repo.ClaimNotifications(ctx, models.NotificationTypeEmail, "worker-b", 10, time.Minute)
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), none)
+
+	// A claimed notification cannot be claimed directly either, until its lease expires
+	direct, err := suite.repo.ClaimNotification(ctx, normal.ID, "direct", time.Minute)
+	suite.Require().NoError(err)
+	assert.Nil(suite.T(), direct)
+
+	suite.Require().NoError(suite.db.Model(&models.Notification{}).Where("id = ?", normal.ID).Update("claimed_until", past).Error)
+	direct, err = suite.repo.ClaimNotification(ctx, normal.ID, "direct", time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(direct)
+	assert.Equal(suite.T(), "direct", direct.ClaimedBy)
+}
+
 // Test GetUserPreferences
 func (suite *NotificationRepositoryTestSuite) TestGetUserPreferences_DefaultWhenNotFound() {
 	ctx := context.Background()
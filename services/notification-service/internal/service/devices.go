@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/providers"
+)
+
+// deviceStaleAfter is how long a device can go unseen before it is
+// unregistered; FCM itself expires tokens that are inactive for 270 days
+const deviceStaleAfter = 270 * 24 * time.Hour
+
+// Reasons a device is unregistered without the user asking
+const (
+	devicePruneInvalidToken = "invalid_token"
+	devicePruneInactive     = "inactive"
+)
+
+// RegisterDeviceRequest registers an app install or browser for push
+// notifications. Apps send their platform's token; browsers send the
+// PushSubscription they received when subscribing.
+type RegisterDeviceRequest struct {
+	Platform     models.DevicePlatform          `json:"platform"`
+	Token        string                         `json:"token,omitempty"`
+	Subscription *providers.WebPushSubscription `json:"subscription,omitempty"`
+	AppVersion   string                         `json:"app_version,omitempty"`
+}
+
+// RegisterDevice registers or refreshes one of a user's devices. Apps
+// register on every launch, which keeps the device from being pruned.
+func (s *NotificationService) RegisterDevice(ctx context.Context, clubID uint, userID string, req *RegisterDeviceRequest) (*models.Device, error) {
+	device, err := newDevice(clubID, userID, req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+
+	if err := s.repo.RegisterDevice(ctx, device); err != nil {
+		return nil, err
+	}
+	s.metrics.RecordDeviceRegistered(string(device.Platform))
+
+	s.logger.Info("Device registered for push", map[string]interface{}{
+		"device_id":   device.ID,
+		"user_id":     userID,
+		"club_id":     clubID,
+		"platform":    device.Platform,
+		"app_version": device.AppVersion,
+	})
+
+	return device, nil
+}
+
+// GetDevices retrieves the devices a user registered for push
+func (s *NotificationService) GetDevices(ctx context.Context, clubID uint, userID string) ([]models.Device, error) {
+	devices, err := s.repo.GetUserDevices(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.pruneInactiveDevices(ctx, devices), nil
+}
+
+// UnregisterDevice stops sending push notifications to one of a user's devices
+func (s *NotificationService) UnregisterDevice(ctx context.Context, clubID uint, userID string, id uint) error {
+	return s.repo.DeleteDevice(ctx, clubID, userID, id)
+}
+
+// WebPushPublicKey returns the VAPID public key browsers subscribe with, if
+// Web Push is configured
+func (s *NotificationService) WebPushPublicKey() (string, bool) {
+	if s.providers == nil || s.providers.WebPush == nil {
+		return "", false
+	}
+	return s.providers.WebPush.PublicKey(), true
+}
+
+// sendPushToDevices sends a push to every device the notification's user
+// registered, one multicast per platform. Tokens a push service rejects as
+// stale are unregistered. The notification is sent once any device accepts
+// it; it is only undeliverable when the user has no valid device left.
+func (s *NotificationService) sendPushToDevices(ctx context.Context, notification *models.Notification, message *providers.Message) error {
+	devices, err := s.GetDevices(ctx, notification.ClubID, *notification.UserID)
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		return fmt.Errorf("%w: user has no devices registered for push", providers.ErrInvalidRecipient)
+	}
+
+	var platforms []models.DevicePlatform
+	targets := make(map[models.DevicePlatform][]providers.PushTarget)
+	for _, device := range devices {
+		if _, ok := targets[device.Platform]; !ok {
+			platforms = append(platforms, device.Platform)
+		}
+		targets[device.Platform] = append(targets[device.Platform], providers.PushTarget{
+			Token:  device.Token,
+			P256dh: device.P256dh,
+			Auth:   device.Auth,
+		})
+	}
+
+	var (
+		accepted int
+		errs     []error
+	)
+	for _, platform := range platforms {
+		pool := s.pushPool(platform)
+		if pool == nil || pool.Len() == 0 {
+			errs = append(errs, fmt.Errorf("%s push provider not configured", platform))
+			continue
+		}
+
+		delivery, err := pool.Multicast(ctx, message, targets[platform])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", platform, err))
+			continue
+		}
+
+		s.applyPushResults(ctx, platform, delivery.Results)
+		for _, result := range delivery.Results {
+			switch {
+			case result.Err == nil:
+				accepted++
+				// Receipts are matched by the message ID of the first device that accepted
+				if notification.ProviderMessageID == "" {
+					notification.Provider = delivery.Provider
+					notification.ProviderMessageID = result.MessageID
+				}
+			case !errors.Is(result.Err, providers.ErrInvalidRecipient):
+				errs = append(errs, fmt.Errorf("%s: %w", platform, result.Err))
+			}
+		}
+	}
+
+	if accepted > 0 {
+		s.logger.Info("Push notification sent to user devices", map[string]interface{}{
+			"notification_id": notification.ID,
+			"user_id":         *notification.UserID,
+			"devices":         len(devices),
+			"accepted":        accepted,
+		})
+		return nil
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("%w: every device of the user was unregistered", providers.ErrInvalidRecipient)
+	}
+	return errors.Join(errs...)
+}
+
+// applyPushResults unregisters the devices a push service rejected as stale
+// and moves the ones it reported under a new token
+func (s *NotificationService) applyPushResults(ctx context.Context, platform models.DevicePlatform, results []providers.PushResult) {
+	var stale []string
+	for _, result := range results {
+		switch {
+		case errors.Is(result.Err, providers.ErrInvalidRecipient):
+			stale = append(stale, result.Token)
+		case result.Err == nil && result.CanonicalToken != "" && result.CanonicalToken != result.Token:
+			// Failures are logged by the repository; the old token keeps working until it expires
+			_ = s.repo.ReplaceDeviceToken(ctx, platform, result.Token, result.CanonicalToken)
+		}
+	}
+
+	s.unregisterDeviceTokens(ctx, platform, stale, devicePruneInvalidToken)
+}
+
+// pruneInactiveDevices unregisters devices not seen for deviceStaleAfter and
+// returns the rest
+func (s *NotificationService) pruneInactiveDevices(ctx context.Context, devices []models.Device) []models.Device {
+	cutoff := time.Now().Add(-deviceStaleAfter)
+	active := devices[:0]
+	stale := make(map[models.DevicePlatform][]string)
+	for _, device := range devices {
+		if device.LastSeenAt.Before(cutoff) {
+			stale[device.Platform] = append(stale[device.Platform], device.Token)
+			continue
+		}
+		active = append(active, device)
+	}
+
+	for platform, tokens := range stale {
+		s.unregisterDeviceTokens(ctx, platform, tokens, devicePruneInactive)
+	}
+	return active
+}
+
+// unregisterDeviceTokens removes stale device tokens; platform may be empty
+// when the token's platform is not known
+func (s *NotificationService) unregisterDeviceTokens(ctx context.Context, platform models.DevicePlatform, tokens []string, reason string) {
+	removed, err := s.repo.DeleteDeviceTokens(ctx, platform, tokens)
+	if err != nil || removed == 0 {
+		return
+	}
+
+	label := string(platform)
+	if label == "" {
+		label = "unknown"
+	}
+	s.metrics.RecordDevicesPruned(label, reason, removed)
+	s.logger.Info("Unregistered stale push devices", map[string]interface{}{
+		"platform": label,
+		"reason":   reason,
+		"count":    removed,
+	})
+}
+
+// pushPool returns the provider pool that reaches devices on a platform
+func (s *NotificationService) pushPool(platform models.DevicePlatform) *providers.ProviderPool {
+	switch platform {
+	case models.DevicePlatformIOS:
+		return s.providers.APNSPool
+	case models.DevicePlatformWeb:
+		return s.providers.WebPushPool
+	default:
+		return s.providers.PushPool
+	}
+}
+
+// newDevice validates a registration and builds the device it registers
+func newDevice(clubID uint, userID string, req *RegisterDeviceRequest) (*models.Device, error) {
+	device := &models.Device{
+		ClubID:     clubID,
+		UserID:     userID,
+		Platform:   req.Platform,
+		Token:      strings.TrimSpace(req.Token),
+		AppVersion: strings.TrimSpace(req.AppVersion),
+		LastSeenAt: time.Now(),
+	}
+
+	switch req.Platform {
+	case models.DevicePlatformAndroid, models.DevicePlatformIOS:
+		if device.Token == "" {
+			return nil, fmt.Errorf("token is required")
+		}
+	case models.DevicePlatformWeb:
+		if req.Subscription == nil {
+			return nil, fmt.Errorf("subscription is required for web devices")
+		}
+		endpoint, err := url.Parse(req.Subscription.Endpoint)
+		if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+			return nil, fmt.Errorf("subscription endpoint must be an https URL")
+		}
+		if req.Subscription.Keys.P256dh == "" || req.Subscription.Keys.Auth == "" {
+			return nil, fmt.Errorf("subscription keys are required")
+		}
+		device.Token = req.Subscription.Endpoint
+		device.P256dh = req.Subscription.Keys.P256dh
+		device.Auth = req.Subscription.Keys.Auth
+	default:
+		return nil, fmt.Errorf("platform must be one of android, ios or web")
+	}
+
+	if len(device.Token) > 512 {
+		return nil, fmt.Errorf("token must be at most 512 characters")
+	}
+	if len(device.AppVersion) > 50 {
+		return nil, fmt.Errorf("app_version must be at most 50 characters")
+	}
+	return device, nil
+}
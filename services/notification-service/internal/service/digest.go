@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/templating"
+)
+
+// DigestTemplateName is the club template digests are rendered with. It
+// receives frequency, count (notifications collected) and items, a list of
+// entries with key, category, subject, message, count, first_at and last_at.
+const DigestTemplateName = "digest"
+
+// defaultDigestDeliveryTime is when digests go out for users without a
+// delivery time on the digest's channel
+const defaultDigestDeliveryTime = "08:00"
+
+// digestReasonEmpty is recorded on digests that had nothing left to send
+const digestReasonEmpty = "empty_digest"
+
+// digestFrequencyOff removes a digest preference
+const digestFrequencyOff = "off"
+
+// defaultDigestContent renders digests for clubs without a digest template
+var defaultDigestContent = templating.Content{
+	Subject: "Your {{.frequency}} summary: {{.count}} updates",
+	Body: "Here is what happened since your last summary:\n\n" +
+		"{{range .items}}- {{if .subject}}{{.subject}}{{else}}{{.message}}{{end}}{{if gt .count 1}} ({{.count}} updates){{end}}\n{{end}}",
+}
+
+// digestEntry is one line of a digest; items sharing a collapse key are
+// counted on a single entry showing the latest of them
+type digestEntry struct {
+	key      string
+	category string
+	subject  string
+	message  string
+	count    int
+	firstAt  time.Time
+	lastAt   time.Time
+}
+
+// batchIntoDigest holds a low or normal priority notification for the user's
+// digest when they opted into one for its category, opening the digest at
+// their delivery time when none is pending. It reports whether the
+// notification was batched.
+func (s *NotificationService) batchIntoDigest(ctx context.Context, notification *models.Notification, preferences *models.UserPreferences, typePreferences []models.NotificationPreference, now time.Time) (bool, error) {
+	if !digestEligible(notification) {
+		return false, nil
+	}
+
+	userID := *notification.UserID
+	digestPreferences, err := s.repo.GetDigestPreferences(ctx, userID, notification.ClubID)
+	if err != nil {
+		return false, err
+	}
+	preference := digestPreference(digestPreferences, notification.Category)
+	if preference == nil {
+		return false, nil
+	}
+
+	digest, err := s.repo.GetOpenDigest(ctx, notification.ClubID, userID, notification.Type, preference.Frequency, now)
+	if err != nil {
+		return false, err
+	}
+	if digest == nil {
+		deliveryTime := defaultDigestDeliveryTime
+		if typePreference := typePreference(typePreferences, notification.Type); typePreference != nil {
+			if _, err := time.Parse(clockLayout, strings.TrimSpace(typePreference.DeliveryTime)); err == nil {
+				deliveryTime = strings.TrimSpace(typePreference.DeliveryTime)
+			}
+		}
+		due := nextDigestTime(preference, deliveryTime, now.In(userLocation(preferences.Timezone))).UTC()
+
+		digest = &models.Notification{
+			ClubID:          notification.ClubID,
+			UserID:          notification.UserID,
+			Type:            notification.Type,
+			Priority:        models.NotificationPriorityNormal,
+			Subject:         fmt.Sprintf("Your %s summary", preference.Frequency),
+			Message:         fmt.Sprintf("%s digest", preference.Frequency),
+			Recipient:       notification.Recipient,
+			Category:        models.CategoryDigest,
+			DigestFrequency: preference.Frequency,
+			ScheduledFor:    &due,
+			Status:          models.NotificationStatusPending,
+		}
+		if err := s.repo.CreateNotification(ctx, digest); err != nil {
+			return false, err
+		}
+	}
+
+	notification.MarkAsBatched(digest, fmt.Sprintf("collected into the %s digest", preference.Frequency))
+	return true, nil
+}
+
+// prepareDigest renders a due digest from the notifications collected for it
+// and returns them. A digest with nothing collected is left unrendered.
+func (s *NotificationService) prepareDigest(ctx context.Context, digest *models.Notification) ([]models.Notification, error) {
+	items, err := s.repo.GetDigestItems(ctx, digest.ID)
+	if err != nil || len(items) == 0 {
+		return items, err
+	}
+
+	data := map[string]interface{}{
+		"frequency": string(digest.DigestFrequency),
+		"count":     len(items),
+		"items":     collapseDigestItems(items),
+	}
+
+	template := s.digestTemplate(ctx, digest)
+	content := defaultDigestContent
+	if template != nil {
+		content = templating.ContentFromTemplate(template)
+	}
+
+	result, err := s.renderer.Render(digest.Type, content, data)
+	if err != nil && template != nil {
+		s.logger.Warn("Failed to render digest template, using the default", map[string]interface{}{
+			"error":       err.Error(),
+			"digest_id":   digest.ID,
+			"template_id": template.ID,
+		})
+		template = nil
+		result, err = s.renderer.Render(digest.Type, defaultDigestContent, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	digest.Subject = result.Subject
+	digest.Message = result.Text
+	digest.HTMLMessage = result.HTML
+	if template != nil {
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode digest variables: %w", err)
+		}
+		templateID := template.ID
+		digest.TemplateID = &templateID
+		digest.TemplateVersion = template.Version
+		digest.TemplateLocale = template.Locale
+		digest.TemplateData = string(encoded)
+	}
+
+	return items, nil
+}
+
+// completeDigest marks the items of a sent digest as delivered within it
+func (s *NotificationService) completeDigest(ctx context.Context, digest *models.Notification, items []models.Notification) {
+	ids := make([]uint, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+
+	if err := s.repo.MarkDigestItemsDigested(ctx, digest.ID, ids); err != nil {
+		s.logger.Error("Failed to mark digest items as digested", map[string]interface{}{
+			"error":     err.Error(),
+			"digest_id": digest.ID,
+		})
+		return
+	}
+
+	s.metrics.RecordDigestSent(string(digest.Type), string(digest.DigestFrequency), len(items))
+}
+
+// digestTemplate returns the club's digest template for the digest's channel
+// and the user's locale, or nil to use the default content
+func (s *NotificationService) digestTemplate(ctx context.Context, digest *models.Notification) *models.NotificationTemplate {
+	variants, err := s.repo.GetNotificationTemplateVariants(ctx, digest.ClubID, DigestTemplateName)
+	if err != nil {
+		s.logger.Warn("Failed to load digest template", map[string]interface{}{
+			"error":     err.Error(),
+			"digest_id": digest.ID,
+		})
+		return nil
+	}
+
+	template, err := selectTemplateVariant(variants, digest.Type, s.resolveLocale(ctx, digest.ClubID, digest.UserID, ""))
+	if err != nil {
+		return nil
+	}
+	return template
+}
+
+// digestEligible reports whether a notification may wait for a digest:
+// real-time delivery is kept for high and critical priority, webhooks and
+// notifications without a user
+func digestEligible(notification *models.Notification) bool {
+	if notification.UserID == nil || notification.IsDigest() || notification.DigestID != nil {
+		return false
+	}
+	if notification.Type == models.NotificationTypeWebhook {
+		return false
+	}
+
+	switch notification.Priority {
+	case models.NotificationPriorityLow, models.NotificationPriorityNormal, "":
+		return true
+	}
+	return false
+}
+
+// digestPreference returns the preference covering a category: its own, or
+// the user's preference for every category
+func digestPreference(preferences []models.DigestPreference, category string) *models.DigestPreference {
+	var fallback *models.DigestPreference
+	for i := range preferences {
+		switch {
+		case preferences[i].Category == "":
+			fallback = &preferences[i]
+		case category != "" && strings.EqualFold(preferences[i].Category, category):
+			return &preferences[i]
+		}
+	}
+	return fallback
+}
+
+// nextDigestTime returns the next delivery time after local, on the
+// preference's weekday for weekly digests
+func nextDigestTime(preference *models.DigestPreference, deliveryTime string, local time.Time) time.Time {
+	clock, err := time.Parse(clockLayout, deliveryTime)
+	if err != nil {
+		clock, _ = time.Parse(clockLayout, defaultDigestDeliveryTime)
+	}
+
+	next := time.Date(local.Year(), local.Month(), local.Day(), clock.Hour(), clock.Minute(), 0, 0, local.Location())
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	if preference.Frequency == models.DigestFrequencyWeekly {
+		for next.Weekday() != preference.Weekday {
+			next = next.AddDate(0, 0, 1)
+		}
+	}
+	return next
+}
+
+// collapseDigestItems turns digest items into template entries, in order of
+// first appearance, counting items that share a collapse key on one entry
+func collapseDigestItems(items []models.Notification) []interface{} {
+	var entries []*digestEntry
+	byKey := make(map[string]*digestEntry)
+
+	for _, item := range items {
+		if entry, ok := byKey[item.CollapseKey]; ok && item.CollapseKey != "" {
+			entry.count++
+			entry.subject = item.Subject
+			entry.message = item.Message
+			entry.lastAt = item.CreatedAt
+			continue
+		}
+
+		entry := &digestEntry{
+			key:      item.CollapseKey,
+			category: item.Category,
+			subject:  item.Subject,
+			message:  item.Message,
+			count:    1,
+			firstAt:  item.CreatedAt,
+			lastAt:   item.CreatedAt,
+		}
+		if item.CollapseKey != "" {
+			byKey[item.CollapseKey] = entry
+		}
+		entries = append(entries, entry)
+	}
+
+	values := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		values[i] = map[string]interface{}{
+			"key":      entry.key,
+			"category": entry.category,
+			"subject":  entry.subject,
+			"message":  entry.message,
+			"count":    entry.count,
+			"first_at": entry.firstAt,
+			"last_at":  entry.lastAt,
+		}
+	}
+	return values
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+)
+
+// ErrDispatcherRunning is returned when the dispatcher is started twice
+var ErrDispatcherRunning = errors.New("dispatcher already running")
+
+// Sender delivers a notification through one channel
+type Sender interface {
+	Send(ctx context.Context, notification *models.Notification) error
+}
+
+// SenderFunc adapts a function to a Sender
+type SenderFunc func(ctx context.Context, notification *models.Notification) error
+
+// Send calls f
+func (f SenderFunc) Send(ctx context.Context, notification *models.Notification) error {
+	return f(ctx, notification)
+}
+
+// DispatcherConfig controls the delivery worker pool and retry backoff
+type DispatcherConfig struct {
+	// Workers bounds concurrent deliveries per channel; channels without an entry are not delivered
+	Workers      map[models.NotificationType]int
+	PollInterval time.Duration
+	// Lease is how long a claimed notification is reserved for this replica
+	Lease     time.Duration
+	RetryBase time.Duration
+	RetryMax  time.Duration
+	// WebhookWorkers bounds concurrent webhook subscription deliveries; none are sent when zero
+	WebhookWorkers   int
+	WebhookRetryBase time.Duration
+	WebhookRetryMax  time.Duration
+}
+
+// DefaultDispatcherConfig returns the production dispatcher settings
+func DefaultDispatcherConfig() DispatcherConfig {
+	return DispatcherConfig{
+		Workers: map[models.NotificationType]int{
+			models.NotificationTypeEmail:   10,
+			models.NotificationTypeSMS:     5,
+			models.NotificationTypePush:    10,
+			models.NotificationTypeInApp:   20,
+			models.NotificationTypeWebhook: 5,
+		},
+		PollInterval: 5 * time.Second,
+		Lease:        2 * time.Minute,
+		RetryBase:    30 * time.Second,
+		RetryMax:     30 * time.Minute,
+		// Subscribers get eight attempts spread over 14 to 18 hours
+		WebhookWorkers:   10,
+		WebhookRetryBase: 10 * time.Minute,
+		WebhookRetryMax:  8 * time.Hour,
+	}
+}
+
+// dispatcher polls for deliverable notifications with one bounded pool per
+// channel, and for webhook subscription deliveries with another
+type dispatcher struct {
+	config       DispatcherConfig
+	workerID     string
+	wake         map[models.NotificationType]chan struct{}
+	wakeWebhooks chan struct{}
+	stop         chan struct{}
+	done         chan struct{}
+}
+
+// SetSender replaces the sender of a channel. It must be called before the
+// dispatcher starts.
+func (s *NotificationService) SetSender(notificationType models.NotificationType, sender Sender) {
+	s.senders[notificationType] = sender
+}
+
+// StartDispatcher starts delivering notifications in the background
+func (s *NotificationService) StartDispatcher(config DispatcherConfig) error {
+	s.dispatchMu.Lock()
+	defer s.dispatchMu.Unlock()
+
+	if s.dispatcher != nil {
+		return ErrDispatcherRunning
+	}
+
+	s.retryBase = config.RetryBase
+	s.retryMax = config.RetryMax
+	s.webhookRetryBase = config.WebhookRetryBase
+	s.webhookRetryMax = config.WebhookRetryMax
+
+	hostname, _ := os.Hostname()
+	d := &dispatcher{
+		config:       config,
+		workerID:     fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		wake:         make(map[models.NotificationType]chan struct{}),
+		wakeWebhooks: make(chan struct{}, 1),
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+
+	var wg sync.WaitGroup
+	for notificationType, workers := range config.Workers {
+		if workers <= 0 {
+			continue
+		}
+		d.wake[notificationType] = make(chan struct{}, 1)
+		wg.Add(1)
+		go func(notificationType models.NotificationType, workers int) {
+			defer wg.Done()
+			s.runChannel(d, notificationType, workers)
+		}(notificationType, workers)
+	}
+	if config.WebhookWorkers > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runWebhookDeliveries(d, config.WebhookWorkers)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(d.done)
+	}()
+
+	s.dispatcher = d
+
+	s.logger.Info("Notification dispatcher started", map[string]interface{}{
+		"worker_id":       d.workerID,
+		"workers":         config.Workers,
+		"webhook_workers": config.WebhookWorkers,
+	})
+	return nil
+}
+
+// StopDispatcher stops claiming new work and waits for in-flight deliveries
+// to finish. If ctx expires first, the remaining claims are released when
+// their leases run out.
+func (s *NotificationService) StopDispatcher(ctx context.Context) error {
+	s.dispatchMu.Lock()
+	d := s.dispatcher
+	s.dispatcher = nil
+	s.dispatchMu.Unlock()
+
+	if d == nil {
+		return nil
+	}
+
+	close(d.stop)
+	select {
+	case <-d.done:
+		s.logger.Info("Notification dispatcher drained", map[string]interface{}{
+			"worker_id": d.workerID,
+		})
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("dispatcher did not drain: %w", ctx.Err())
+	}
+}
+
+// wakeDispatcher asks the channel's pool to poll now rather than at its next interval
+func (s *NotificationService) wakeDispatcher(notificationType models.NotificationType) {
+	s.dispatchMu.Lock()
+	d := s.dispatcher
+	s.dispatchMu.Unlock()
+
+	if d == nil {
+		return
+	}
+	if wake, ok := d.wake[notificationType]; ok {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// wakeWebhookDispatcher asks the webhook delivery pool to poll now
+func (s *NotificationService) wakeWebhookDispatcher() {
+	s.dispatchMu.Lock()
+	d := s.dispatcher
+	s.dispatchMu.Unlock()
+
+	if d == nil {
+		return
+	}
+	select {
+	case d.wakeWebhooks <- struct{}{}:
+	default:
+	}
+}
+
+// wakeAllDispatchers asks every channel's pool to poll now
+func (s *NotificationService) wakeAllDispatchers() {
+	for _, notificationType := range []models.NotificationType{
+		models.NotificationTypeEmail, models.NotificationTypeSMS, models.NotificationTypePush,
+		models.NotificationTypeInApp, models.NotificationTypeWebhook,
+	} {
+		s.wakeDispatcher(notificationType)
+	}
+}
+
+// runChannel claims and delivers one channel's notifications with at most workers in flight
+func (s *NotificationService) runChannel(d *dispatcher, notificationType models.NotificationType, workers int) {
+	slots := make(chan struct{}, workers)
+	var inFlight sync.WaitGroup
+	defer inFlight.Wait()
+
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Claim while there is work and free capacity
+		for {
+			free := workers - len(slots)
+			if free == 0 {
+				break
+			}
+
+			claimed, err := s.repo.ClaimNotifications(context.Background(), notificationType, d.workerID, free, d.config.Lease)
+			if err != nil {
+				break
+			}
+
+			for i := range claimed {
+				notification := claimed[i]
+				slots <- struct{}{}
+				inFlight.Add(1)
+				go func() {
+					defer func() {
+						<-slots
+						inFlight.Done()
+					}()
+					s.deliverClaimed(context.Background(), &notification)
+				}()
+			}
+
+			if len(claimed) < free {
+				break
+			}
+		}
+
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+		case <-d.wake[notificationType]:
+		}
+	}
+}
+
+// runWebhookDeliveries claims and sends webhook subscription deliveries with at most workers in flight
+func (s *NotificationService) runWebhookDeliveries(d *dispatcher, workers int) {
+	slots := make(chan struct{}, workers)
+	var inFlight sync.WaitGroup
+	defer inFlight.Wait()
+
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			free := workers - len(slots)
+			if free == 0 {
+				break
+			}
+
+			claimed, err := s.repo.ClaimWebhookDeliveries(context.Background(), d.workerID, free, d.config.Lease)
+			if err != nil {
+				break
+			}
+
+			for i := range claimed {
+				delivery := claimed[i]
+				slots <- struct{}{}
+				inFlight.Add(1)
+				go func() {
+					defer func() {
+						<-slots
+						inFlight.Done()
+					}()
+					s.deliverWebhook(context.Background(), &delivery)
+				}()
+			}
+
+			if len(claimed) < free {
+				break
+			}
+		}
+
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+		case <-d.wakeWebhooks:
+		}
+	}
+}
+
+// deliverClaimed delivers a notification leased to this replica; saving the
+// outcome releases the lease
+func (s *NotificationService) deliverClaimed(ctx context.Context, notification *models.Notification) {
+	notification.Release()
+	s.processNotification(ctx, notification)
+}
+
+// retryBackoff is the exponential delay before retry attempt n, with jitter so
+// failures from one outage do not retry in lockstep
+func (s *NotificationService) retryBackoff(attempt int) time.Duration {
+	return backoff(s.retryBase, s.retryMax, attempt)
+}
+
+// backoff doubles base for each attempt after the first, up to max, and
+// jitters the result
+func backoff(base, max time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	// Equal jitter: half fixed, half random
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
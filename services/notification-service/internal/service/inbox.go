@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+)
+
+// inboxEventsSubject is where replicas announce inbox changes to each other;
+// the club ID is appended
+const inboxEventsSubject = "notification.inbox"
+
+// inboxEventRetention is how long inbox events are kept for streams to resume from
+const inboxEventRetention = 7 * 24 * time.Hour
+
+// InboxEventBatch bounds the inbox events InboxEventsSince returns at a time
+const InboxEventBatch = 100
+
+// InboxQuery selects a page of a user's inbox
+type InboxQuery struct {
+	UnreadOnly bool
+	Archived   bool
+	Limit      int
+	Offset     int
+}
+
+// InboxPage is a page of a user's inbox along with their unread count
+type InboxPage struct {
+	Notifications []models.Notification `json:"notifications"`
+	UnreadCount   int64                 `json:"unread_count"`
+}
+
+// InboxUpdate reports how many notifications a bulk inbox change updated
+type InboxUpdate struct {
+	Updated     int   `json:"updated"`
+	UnreadCount int64 `json:"unread_count"`
+}
+
+// inboxChange is announced on the message bus so every replica can wake the
+// streams of the user whose inbox changed
+type inboxChange struct {
+	ClubID  uint   `json:"club_id"`
+	UserID  string `json:"user_id"`
+	EventID uint   `json:"event_id"`
+}
+
+// GetInbox retrieves a page of a user's in-app inbox
+func (s *NotificationService) GetInbox(ctx context.Context, clubID uint, userID string, query InboxQuery) (*InboxPage, error) {
+	notifications, err := s.repo.GetInbox(ctx, clubID, userID, query.UnreadOnly, query.Archived, query.Limit, query.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := s.repo.CountUnreadInbox(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InboxPage{Notifications: notifications, UnreadCount: unread}, nil
+}
+
+// GetUnreadCount counts the unread notifications in a user's inbox
+func (s *NotificationService) GetUnreadCount(ctx context.Context, clubID uint, userID string) (int64, error) {
+	return s.repo.CountUnreadInbox(ctx, clubID, userID)
+}
+
+// MarkInboxRead marks notifications in a user's inbox as read, every one
+// that is not archived when no IDs are given
+func (s *NotificationService) MarkInboxRead(ctx context.Context, clubID uint, userID string, ids []uint) (*InboxUpdate, error) {
+	changed, err := s.repo.MarkInboxRead(ctx, clubID, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for range changed {
+		s.metrics.RecordNotificationRead(fmt.Sprintf("%d", clubID), string(models.NotificationTypeInApp))
+	}
+	return s.inboxUpdated(ctx, clubID, userID, models.InboxEventRead, changed)
+}
+
+// ArchiveInboxNotifications moves notifications out of a user's inbox
+func (s *NotificationService) ArchiveInboxNotifications(ctx context.Context, clubID uint, userID string, ids []uint) (*InboxUpdate, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: notification_ids is required", ErrValidation)
+	}
+
+	changed, err := s.repo.ArchiveInbox(ctx, clubID, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.inboxUpdated(ctx, clubID, userID, models.InboxEventArchived, changed)
+}
+
+// PinInboxNotification pins a notification to the top of a user's inbox, or unpins it
+func (s *NotificationService) PinInboxNotification(ctx context.Context, clubID uint, userID string, id uint, pinned bool) (*models.Notification, error) {
+	notification, err := s.repo.SetInboxPinned(ctx, clubID, userID, id, pinned)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := models.InboxEventPinned
+	if !pinned {
+		eventType = models.InboxEventUnpinned
+	}
+	s.recordInboxEvent(ctx, clubID, userID, eventType, []uint{id})
+
+	return notification, nil
+}
+
+// inboxUpdated records a bulk inbox change that updated any notifications
+// and reports the user's unread count after it
+func (s *NotificationService) inboxUpdated(ctx context.Context, clubID uint, userID, eventType string, changed []uint) (*InboxUpdate, error) {
+	if len(changed) > 0 {
+		s.recordInboxEvent(ctx, clubID, userID, eventType, changed)
+	}
+
+	unread, err := s.repo.CountUnreadInbox(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InboxUpdate{Updated: len(changed), UnreadCount: unread}, nil
+}
+
+// InboxEventsSince returns up to a batch of a user's inbox events after the
+// given event ID, oldest first, with new notifications attached
+func (s *NotificationService) InboxEventsSince(ctx context.Context, clubID uint, userID string, afterID uint) ([]models.InboxEvent, error) {
+	events, err := s.repo.GetInboxEvents(ctx, clubID, userID, afterID, InboxEventBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range events {
+		if events[i].Type != models.InboxEventNotification || len(events[i].NotificationIDs) == 0 {
+			continue
+		}
+		// A notification deleted since is streamed without its content
+		if notification, err := s.repo.GetNotificationByID(ctx, events[i].NotificationIDs[0]); err == nil {
+			events[i].Notification = notification
+		}
+	}
+
+	return events, nil
+}
+
+// LatestInboxEventID returns the ID of a user's latest inbox event, which a
+// new stream starts after
+func (s *NotificationService) LatestInboxEventID(ctx context.Context, clubID uint, userID string) (uint, error) {
+	return s.repo.GetLatestInboxEventID(ctx, clubID, userID)
+}
+
+// SubscribeInbox signals the caller whenever the user's inbox changes. The
+// subscription must be closed once the caller stops listening.
+func (s *NotificationService) SubscribeInbox(clubID uint, userID string) *InboxSubscription {
+	return s.inbox.subscribe(inboxKey(clubID, userID))
+}
+
+// CloseInboxStreams ends every inbox stream connected to this replica so the
+// HTTP server can shut down; clients reconnect to another replica and resume
+func (s *NotificationService) CloseInboxStreams() {
+	s.inbox.close()
+}
+
+// StartInboxFanout wakes the inbox streams connected to this replica when
+// another replica changes their user's inbox. Every replica receives every
+// change, so no queue group is used.
+func (s *NotificationService) StartInboxFanout() error {
+	subject := inboxEventsSubject + ".>"
+	if err := s.messaging.Subscribe(subject, s.handleInboxChange); err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+	}
+
+	s.logger.Info("Inbox fan-out started", map[string]interface{}{
+		"subject": subject,
+	})
+	return nil
+}
+
+// handleInboxChange wakes the local streams of the user an inbox change is for
+func (s *NotificationService) handleInboxChange(ctx context.Context, msg *messaging.Message) error {
+	var change inboxChange
+	if err := json.Unmarshal(msg.Data, &change); err != nil {
+		s.logger.Warn("Ignoring malformed inbox change", map[string]interface{}{
+			"error":   err.Error(),
+			"subject": msg.Subject,
+		})
+		return nil
+	}
+
+	s.inbox.wake(inboxKey(change.ClubID, change.UserID))
+	return nil
+}
+
+// recordInboxEvent appends a change to the user's inbox event log and wakes
+// their streams on every replica. Failures are logged: the change itself has
+// already been made, and streams catch up on their next event.
+func (s *NotificationService) recordInboxEvent(ctx context.Context, clubID uint, userID, eventType string, ids []uint) {
+	unread, err := s.repo.CountUnreadInbox(ctx, clubID, userID)
+	if err != nil {
+		return
+	}
+
+	event := &models.InboxEvent{
+		ClubID:          clubID,
+		UserID:          userID,
+		Type:            eventType,
+		NotificationIDs: ids,
+		UnreadCount:     unread,
+	}
+	if err := s.repo.CreateInboxEvent(ctx, event); err != nil {
+		return
+	}
+	s.metrics.RecordInboxEvent(eventType)
+
+	// Keep only what a reconnecting stream could reasonably resume from
+	if _, err := s.repo.DeleteInboxEventsBefore(ctx, clubID, userID, time.Now().Add(-inboxEventRetention)); err != nil {
+		s.logger.Warn("Failed to prune inbox events", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"club_id": clubID,
+		})
+	}
+
+	s.inbox.wake(inboxKey(clubID, userID))
+
+	subject := fmt.Sprintf("%s.%d", inboxEventsSubject, clubID)
+	change := inboxChange{ClubID: clubID, UserID: userID, EventID: event.ID}
+	if err := s.messaging.Publish(ctx, subject, change); err != nil {
+		s.logger.Warn("Failed to announce inbox change", map[string]interface{}{
+			"error":    err.Error(),
+			"event_id": event.ID,
+			"user_id":  userID,
+		})
+	}
+}
+
+// inboxKey identifies a user's inbox across clubs
+func inboxKey(clubID uint, userID string) string {
+	return fmt.Sprintf("%d:%s", clubID, userID)
+}
+
+// inboxBroker tracks the inbox streams connected to this replica. It only
+// signals that a user's inbox changed: streams read the changes themselves
+// from the event log, so a slow stream never holds up the others and never
+// misses an event.
+type inboxBroker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*InboxSubscription]struct{}
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+// InboxSubscription signals a connected stream that its user's inbox changed
+type InboxSubscription struct {
+	// C receives a value when new inbox events may be available; signals
+	// arriving while one is pending are coalesced
+	C <-chan struct{}
+
+	signal chan struct{}
+	key    string
+	broker *inboxBroker
+}
+
+func newInboxBroker() *inboxBroker {
+	return &inboxBroker{
+		subscribers: make(map[string]map[*InboxSubscription]struct{}),
+		closed:      make(chan struct{}),
+	}
+}
+
+func (b *inboxBroker) subscribe(key string) *InboxSubscription {
+	signal := make(chan struct{}, 1)
+	subscription := &InboxSubscription{C: signal, signal: signal, key: key, broker: b}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subscribers[key] == nil {
+		b.subscribers[key] = make(map[*InboxSubscription]struct{})
+	}
+	b.subscribers[key][subscription] = struct{}{}
+	return subscription
+}
+
+func (b *inboxBroker) wake(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for subscription := range b.subscribers[key] {
+		select {
+		case subscription.signal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (b *inboxBroker) close() {
+	b.closeOnce.Do(func() { close(b.closed) })
+}
+
+// Done is closed when the replica is shutting down and streams should end
+func (sub *InboxSubscription) Done() <-chan struct{} {
+	return sub.broker.closed
+}
+
+// Close stops signalling the subscription
+func (sub *InboxSubscription) Close() {
+	b := sub.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscribers[sub.key], sub)
+	if len(b.subscribers[sub.key]) == 0 {
+		delete(b.subscribers, sub.key)
+	}
+}
@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+)
+
+// Reasons recorded on notifications when preferences change their delivery
+const (
+	preferenceReasonBlockedCategory = "blocked_category"
+	preferenceReasonChannelDisabled = "channel_disabled"
+	preferenceReasonQuietHours      = "quiet_hours"
+	preferenceReasonDeliveryTime    = "delivery_time"
+)
+
+// clockLayout is the format of quiet hours and per-type delivery times
+const clockLayout = "15:04"
+
+// deliveryTimeImmediate disables the per-type delivery window
+const deliveryTimeImmediate = "immediate"
+
+// applyPreferences resolves the recipient's preferences against a notification.
+// Blocked categories suppress it, a disabled channel reroutes it in-app (or
+// suppresses it when in-app is disabled too), low and normal priority
+// notifications in a digest category are batched into the user's digest, and
+// other non-critical notifications are deferred out of quiet hours and into
+// the per-type delivery window, both evaluated in the user's timezone. Every
+// decision is recorded on the notification. It reports whether the
+// notification may be delivered now.
+func (s *NotificationService) applyPreferences(ctx context.Context, notification *models.Notification, now time.Time) (bool, error) {
+	if notification.UserID == nil || notification.Type == models.NotificationTypeWebhook {
+		return true, nil
+	}
+
+	userID := *notification.UserID
+	preferences, err := s.repo.GetUserPreferences(ctx, userID, notification.ClubID)
+	if err != nil {
+		return true, err
+	}
+	typePreferences, err := s.repo.GetNotificationPreferences(ctx, userID, notification.ClubID)
+	if err != nil {
+		return true, err
+	}
+
+	decisionsBefore := len(notification.Decisions)
+	defer func() {
+		for _, decision := range notification.Decisions[decisionsBefore:] {
+			s.metrics.RecordPreferenceDecision(fmt.Sprintf("%d", notification.ClubID), string(notification.Type), decision.Action, decision.Reason)
+		}
+	}()
+
+	if category := blockedCategory(preferences, notification); category != "" {
+		notification.MarkAsSuppressed(preferenceReasonBlockedCategory, fmt.Sprintf("category %q is blocked by the user", category))
+		return false, nil
+	}
+
+	if !channelEnabled(preferences, typePreferences, notification.Type) {
+		if notification.Type == models.NotificationTypeInApp || !channelEnabled(preferences, typePreferences, models.NotificationTypeInApp) {
+			notification.MarkAsSuppressed(preferenceReasonChannelDisabled, fmt.Sprintf("%s is disabled and no fallback channel is enabled", notification.Type))
+			return false, nil
+		}
+
+		original := notification.Type
+		notification.Type = models.NotificationTypeInApp
+		notification.Recipient = userID
+		notification.RecordDecision(models.DeliveryActionRerouted, preferenceReasonChannelDisabled,
+			fmt.Sprintf("%s is disabled; rerouted to %s", original, models.NotificationTypeInApp), nil)
+	}
+
+	if notification.IsCritical() {
+		return true, nil
+	}
+
+	// The digest itself is subject to quiet hours when it comes due
+	if batched, err := s.batchIntoDigest(ctx, notification, preferences, typePreferences, now); err != nil || batched {
+		return !batched, err
+	}
+
+	location := userLocation(preferences.Timezone)
+	local := now.In(location)
+	deferred := false
+
+	if until, ok := quietHoursEnd(preferences, local); ok {
+		deferred = deferNotification(notification, until, preferenceReasonQuietHours,
+			fmt.Sprintf("quiet hours in %s", location)) || deferred
+	}
+
+	// The delivery window only applies once, otherwise the notification would
+	// be pushed back a day every time it comes due. Digests are already
+	// scheduled at it.
+	if !notification.HasDecision(preferenceReasonDeliveryTime) && !notification.IsDigest() {
+		if preference := typePreference(typePreferences, notification.Type); preference != nil {
+			if until, ok := nextDeliveryTime(preference.DeliveryTime, local); ok {
+				deferred = deferNotification(notification, until, preferenceReasonDeliveryTime,
+					fmt.Sprintf("%s notifications are delivered at %s %s", notification.Type, preference.DeliveryTime, location)) || deferred
+			}
+		}
+	}
+
+	return !deferred, nil
+}
+
+// GetUserPreferences retrieves a user's channel preferences and per-type settings
+func (s *NotificationService) GetUserPreferences(ctx context.Context, userID string, clubID uint) (*UserPreferencesView, error) {
+	preferences, err := s.repo.GetUserPreferences(ctx, userID, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	typePreferences, err := s.repo.GetNotificationPreferences(ctx, userID, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	digests, err := s.repo.GetDigestPreferences(ctx, userID, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserPreferencesView{UserPreferences: preferences, TypePreferences: typePreferences, Digests: digests}, nil
+}
+
+// UpdateUserPreferences applies a partial update to a user's preferences
+func (s *NotificationService) UpdateUserPreferences(ctx context.Context, userID string, clubID uint, req *UpdateUserPreferencesRequest) (*UserPreferencesView, error) {
+	if strings.TrimSpace(userID) == "" || clubID == 0 {
+		return nil, fmt.Errorf("%w: user_id and club_id are required", ErrValidation)
+	}
+
+	preferences, err := s.repo.GetUserPreferences(ctx, userID, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.EmailEnabled != nil {
+		preferences.EmailEnabled = *req.EmailEnabled
+	}
+	if req.SMSEnabled != nil {
+		preferences.SMSEnabled = *req.SMSEnabled
+	}
+	if req.PushEnabled != nil {
+		preferences.PushEnabled = *req.PushEnabled
+	}
+	if req.InAppEnabled != nil {
+		preferences.InAppEnabled = *req.InAppEnabled
+	}
+	if req.BlockedTypes != nil {
+		encoded, err := json.Marshal(req.BlockedTypes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode blocked types: %w", err)
+		}
+		preferences.BlockedTypes = string(encoded)
+	}
+	if req.Timezone != nil {
+		if _, err := time.LoadLocation(*req.Timezone); err != nil || *req.Timezone == "" {
+			return nil, fmt.Errorf("%w: unknown timezone %q", ErrValidation, *req.Timezone)
+		}
+		preferences.Timezone = *req.Timezone
+	}
+	if req.PreferredLang != nil {
+		preferences.PreferredLang = *req.PreferredLang
+	}
+	if req.QuietHoursStart != nil || req.QuietHoursEnd != nil {
+		if req.QuietHoursStart == nil || req.QuietHoursEnd == nil {
+			return nil, fmt.Errorf("%w: quiet_hours_start and quiet_hours_end must be set together", ErrValidation)
+		}
+		if preferences.QuietHoursStart, err = parseClock(*req.QuietHoursStart); err != nil {
+			return nil, err
+		}
+		if preferences.QuietHoursEnd, err = parseClock(*req.QuietHoursEnd); err != nil {
+			return nil, err
+		}
+		if (preferences.QuietHoursStart == nil) != (preferences.QuietHoursEnd == nil) {
+			return nil, fmt.Errorf("%w: quiet_hours_start and quiet_hours_end must both be set or both be cleared", ErrValidation)
+		}
+	}
+
+	for _, update := range req.TypePreferences {
+		if !validNotificationType(update.NotificationType) {
+			return nil, fmt.Errorf("%w: unknown notification type %q", ErrValidation, update.NotificationType)
+		}
+		if _, ok := nextDeliveryTime(update.DeliveryTime, time.Now()); !ok && update.DeliveryTime != "" && update.DeliveryTime != deliveryTimeImmediate {
+			return nil, fmt.Errorf("%w: delivery_time must be HH:MM or %q", ErrValidation, deliveryTimeImmediate)
+		}
+	}
+	for _, update := range req.Digests {
+		switch models.DigestFrequency(update.Frequency) {
+		case models.DigestFrequencyDaily, models.DigestFrequencyWeekly, digestFrequencyOff:
+		default:
+			return nil, fmt.Errorf("%w: digest frequency must be %q, %q or %q", ErrValidation,
+				models.DigestFrequencyDaily, models.DigestFrequencyWeekly, digestFrequencyOff)
+		}
+		if update.Weekday != nil && (*update.Weekday < 0 || *update.Weekday > 6) {
+			return nil, fmt.Errorf("%w: digest weekday must be 0 (Sunday) to 6", ErrValidation)
+		}
+	}
+
+	if err := s.repo.UpsertUserPreferences(ctx, preferences); err != nil {
+		return nil, err
+	}
+
+	for _, update := range req.TypePreferences {
+		preference := &models.NotificationPreference{
+			ClubID:           clubID,
+			UserID:           userID,
+			NotificationType: update.NotificationType,
+			IsEnabled:        update.IsEnabled,
+			DeliveryTime:     update.DeliveryTime,
+		}
+		if err := s.repo.UpsertNotificationPreference(ctx, preference); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, update := range req.Digests {
+		category := strings.ToLower(strings.TrimSpace(update.Category))
+		if update.Frequency == digestFrequencyOff {
+			if err := s.repo.DeleteDigestPreference(ctx, userID, clubID, category); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		weekday := time.Monday
+		if update.Weekday != nil {
+			weekday = time.Weekday(*update.Weekday)
+		}
+		preference := &models.DigestPreference{
+			ClubID:    clubID,
+			UserID:    userID,
+			Category:  category,
+			Frequency: models.DigestFrequency(update.Frequency),
+			Weekday:   weekday,
+		}
+		if err := s.repo.UpsertDigestPreference(ctx, preference); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetUserPreferences(ctx, userID, clubID)
+}
+
+// blockedCategory returns the blocked entry matching the notification's category, if any
+func blockedCategory(preferences *models.UserPreferences, notification *models.Notification) string {
+	if notification.Category == "" || preferences.BlockedTypes == "" {
+		return ""
+	}
+
+	var blocked []string
+	if err := json.Unmarshal([]byte(preferences.BlockedTypes), &blocked); err != nil {
+		return ""
+	}
+
+	for _, entry := range blocked {
+		if strings.EqualFold(strings.TrimSpace(entry), notification.Category) {
+			return entry
+		}
+	}
+	return ""
+}
+
+// channelEnabled combines the user's channel switches with any per-type preference
+func channelEnabled(preferences *models.UserPreferences, typePreferences []models.NotificationPreference, notificationType models.NotificationType) bool {
+	if preference := typePreference(typePreferences, notificationType); preference != nil && !preference.IsEnabled {
+		return false
+	}
+
+	switch notificationType {
+	case models.NotificationTypeEmail:
+		return preferences.EmailEnabled
+	case models.NotificationTypeSMS:
+		return preferences.SMSEnabled
+	case models.NotificationTypePush:
+		return preferences.PushEnabled
+	case models.NotificationTypeInApp:
+		return preferences.InAppEnabled
+	default:
+		return true
+	}
+}
+
+func typePreference(typePreferences []models.NotificationPreference, notificationType models.NotificationType) *models.NotificationPreference {
+	for i := range typePreferences {
+		if typePreferences[i].NotificationType == notificationType {
+			return &typePreferences[i]
+		}
+	}
+	return nil
+}
+
+// deferNotification moves the notification to until unless it is already
+// scheduled later, recording the deferral
+func deferNotification(notification *models.Notification, until time.Time, reason, detail string) bool {
+	if notification.ScheduledFor != nil && !notification.ScheduledFor.Before(until) {
+		return false
+	}
+
+	until = until.UTC()
+	notification.ScheduledFor = &until
+	notification.RecordDecision(models.DeliveryActionDeferred, reason, detail, &until)
+	return true
+}
+
+// quietHoursEnd returns when the current quiet period ends, if local falls inside one.
+// Only the clock time of the stored quiet hours is used.
+func quietHoursEnd(preferences *models.UserPreferences, local time.Time) (time.Time, bool) {
+	if preferences.QuietHoursStart == nil || preferences.QuietHoursEnd == nil {
+		return time.Time{}, false
+	}
+
+	start := minuteOfDay(*preferences.QuietHoursStart)
+	end := minuteOfDay(*preferences.QuietHoursEnd)
+	if start == end {
+		return time.Time{}, false
+	}
+
+	current := local.Hour()*60 + local.Minute()
+	inQuietHours := current >= start && current < end
+	if start > end {
+		// Quiet hours span midnight
+		inQuietHours = current >= start || current < end
+	}
+	if !inQuietHours {
+		return time.Time{}, false
+	}
+
+	until := time.Date(local.Year(), local.Month(), local.Day(), end/60, end%60, 0, 0, local.Location())
+	if !until.After(local) {
+		until = until.AddDate(0, 0, 1)
+	}
+	return until, true
+}
+
+// nextDeliveryTime returns the next occurrence of an HH:MM delivery time
+// after local, or false when delivery is immediate or local is already in
+// that minute
+func nextDeliveryTime(deliveryTime string, local time.Time) (time.Time, bool) {
+	deliveryTime = strings.TrimSpace(deliveryTime)
+	if deliveryTime == "" || deliveryTime == deliveryTimeImmediate {
+		return time.Time{}, false
+	}
+
+	clock, err := time.Parse(clockLayout, deliveryTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	if local.Hour() == clock.Hour() && local.Minute() == clock.Minute() {
+		return time.Time{}, false
+	}
+
+	next := time.Date(local.Year(), local.Month(), local.Day(), clock.Hour(), clock.Minute(), 0, 0, local.Location())
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, true
+}
+
+// parseClock parses an HH:MM clock time; an empty string clears it
+func parseClock(value string) (*time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	clock, err := time.Parse(clockLayout, strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is not an HH:MM time", ErrValidation, value)
+	}
+
+	stored := time.Date(2000, time.January, 1, clock.Hour(), clock.Minute(), 0, 0, time.UTC)
+	return &stored, nil
+}
+
+func minuteOfDay(clock time.Time) int {
+	clock = clock.UTC()
+	return clock.Hour()*60 + clock.Minute()
+}
+
+func userLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+func validNotificationType(notificationType models.NotificationType) bool {
+	switch notificationType {
+	case models.NotificationTypeEmail, models.NotificationTypeSMS, models.NotificationTypePush,
+		models.NotificationTypeInApp, models.NotificationTypeWebhook:
+		return true
+	}
+	return false
+}
+
+// Preference request/response types
+
+type UserPreferencesView struct {
+	*models.UserPreferences
+	TypePreferences []models.NotificationPreference `json:"type_preferences"`
+	Digests         []models.DigestPreference       `json:"digests"`
+}
+
+type UpdateUserPreferencesRequest struct {
+	EmailEnabled    *bool                    `json:"email_enabled,omitempty"`
+	SMSEnabled      *bool                    `json:"sms_enabled,omitempty"`
+	PushEnabled     *bool                    `json:"push_enabled,omitempty"`
+	InAppEnabled    *bool                    `json:"in_app_enabled,omitempty"`
+	BlockedTypes    []string                 `json:"blocked_types,omitempty"`
+	Timezone        *string                  `json:"timezone,omitempty"`
+	PreferredLang   *string                  `json:"preferred_lang,omitempty"`
+	QuietHoursStart *string                  `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   *string                  `json:"quiet_hours_end,omitempty"`
+	TypePreferences []TypePreferenceUpdate   `json:"type_preferences,omitempty"`
+	Digests         []DigestPreferenceUpdate `json:"digests,omitempty"`
+}
+
+type TypePreferenceUpdate struct {
+	NotificationType models.NotificationType `json:"notification_type"`
+	IsEnabled        bool                    `json:"is_enabled"`
+	DeliveryTime     string                  `json:"delivery_time,omitempty"`
+}
+
+// DigestPreferenceUpdate sets the digest frequency of a category ("" for every
+// category); frequency "off" returns it to real-time delivery. Weekly digests
+// default to Monday.
+type DigestPreferenceUpdate struct {
+	Category  string `json:"category"`
+	Frequency string `json:"frequency"`
+	Weekday   *int   `json:"weekday,omitempty"`
+}
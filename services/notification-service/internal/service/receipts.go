@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/providers"
+)
+
+// ErrInvalidSignature is returned when an inbound provider webhook is not signed by a configured provider
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// ReceiptStatus is what a provider reported about a message it accepted
+type ReceiptStatus string
+
+const (
+	ReceiptStatusDelivered     ReceiptStatus = "delivered"
+	ReceiptStatusBounced       ReceiptStatus = "bounced"
+	ReceiptStatusUndeliverable ReceiptStatus = "undeliverable"
+	ReceiptStatusComplaint     ReceiptStatus = "complaint"
+)
+
+// DeliveryReceipt is a provider's report about a message, matched to a
+// notification by the provider's message ID
+type DeliveryReceipt struct {
+	Type      models.NotificationType
+	MessageID string
+	// Recipient is replaced by the matched notification's recipient
+	Recipient string
+	Status    ReceiptStatus
+	// Permanent marks hard bounces and invalid recipients, which are
+	// suppressed for future sends
+	Permanent bool
+	Reason    string
+}
+
+// ReceiptResult summarises a batch of delivery receipts
+type ReceiptResult struct {
+	Received   int `json:"received"`
+	Matched    int `json:"matched"`
+	Unmatched  int `json:"unmatched"`
+	Suppressed int `json:"suppressed"`
+}
+
+// ReceiptWebhook is the signed body of the email and push receipt webhooks
+type ReceiptWebhook struct {
+	Events []ReceiptEvent `json:"events"`
+}
+
+// ReceiptEvent is one provider report. Email events are delivered, bounce
+// (with bounce_type hard or soft) and complaint; push events are delivered and
+// invalid_token, with the device token as recipient.
+type ReceiptEvent struct {
+	MessageID  string `json:"message_id"`
+	Event      string `json:"event"`
+	BounceType string `json:"bounce_type,omitempty"`
+	Recipient  string `json:"recipient,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// Reason recorded on notifications held back by the suppression list
+const suppressionReasonRecipientSuppressed = "recipient_suppressed"
+
+// HandleSMSStatusCallback verifies and applies a Twilio status callback posted to callbackURL
+func (s *NotificationService) HandleSMSStatusCallback(ctx context.Context, callbackURL string, params url.Values, signature string) (*ReceiptResult, error) {
+	if s.providers == nil || !s.providers.VerifySMSCallback(callbackURL, params, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	receipt, ok := twilioReceipt(params)
+	if !ok {
+		// Intermediate statuses (queued, sending, sent) do not change anything
+		return &ReceiptResult{}, nil
+	}
+	return s.ProcessDeliveryReceipts(ctx, []DeliveryReceipt{receipt})
+}
+
+// HandleEmailReceipts verifies and applies an email bounce, complaint and delivery webhook
+func (s *NotificationService) HandleEmailReceipts(ctx context.Context, payload []byte, signature string) (*ReceiptResult, error) {
+	events, err := s.verifyReceiptWebhook(payload, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]DeliveryReceipt, 0, len(events))
+	for _, event := range events {
+		receipt := DeliveryReceipt{
+			Type:      models.NotificationTypeEmail,
+			MessageID: strings.Trim(event.MessageID, "<>"),
+			Recipient: event.Recipient,
+			Reason:    event.Reason,
+		}
+		switch event.Event {
+		case "delivered":
+			receipt.Status = ReceiptStatusDelivered
+		case "bounce":
+			receipt.Status = ReceiptStatusBounced
+			receipt.Permanent = event.BounceType != "soft"
+		case "complaint":
+			receipt.Status = ReceiptStatusComplaint
+		default:
+			return nil, fmt.Errorf("%w: unknown email event %q", ErrValidation, event.Event)
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return s.ProcessDeliveryReceipts(ctx, receipts)
+}
+
+// HandlePushReceipts verifies and applies a push delivery and token invalidation webhook
+func (s *NotificationService) HandlePushReceipts(ctx context.Context, payload []byte, signature string) (*ReceiptResult, error) {
+	events, err := s.verifyReceiptWebhook(payload, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]DeliveryReceipt, 0, len(events))
+	for _, event := range events {
+		receipt := DeliveryReceipt{
+			Type:      models.NotificationTypePush,
+			MessageID: event.MessageID,
+			Recipient: event.Recipient,
+			Reason:    event.Reason,
+		}
+		switch event.Event {
+		case "delivered":
+			receipt.Status = ReceiptStatusDelivered
+		case "invalid_token":
+			receipt.Status = ReceiptStatusUndeliverable
+			receipt.Permanent = true
+		default:
+			return nil, fmt.Errorf("%w: unknown push event %q", ErrValidation, event.Event)
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	// Invalidated tokens are also unregistered, whichever device they belong to
+	var invalid []string
+	for _, receipt := range receipts {
+		if receipt.Status == ReceiptStatusUndeliverable && receipt.Recipient != "" {
+			invalid = append(invalid, receipt.Recipient)
+		}
+	}
+	s.unregisterDeviceTokens(ctx, "", invalid, devicePruneInvalidToken)
+
+	return s.ProcessDeliveryReceipts(ctx, receipts)
+}
+
+// ProcessDeliveryReceipts applies provider reports to the notifications they
+// refer to and suppresses recipients that bounced hard, complained or can
+// never be reached. Reports for unknown messages still suppress their
+// recipient. Receipts are idempotent and never move a notification backwards.
+func (s *NotificationService) ProcessDeliveryReceipts(ctx context.Context, receipts []DeliveryReceipt) (*ReceiptResult, error) {
+	result := &ReceiptResult{Received: len(receipts)}
+
+	for _, receipt := range receipts {
+		var notification *models.Notification
+		if receipt.MessageID != "" {
+			found, err := s.repo.GetNotificationByProviderMessageID(ctx, receipt.MessageID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return result, err
+			}
+			notification = found
+		}
+
+		provider := ""
+		if notification == nil {
+			result.Unmatched++
+		} else {
+			result.Matched++
+			provider = notification.Provider
+			// Suppress the recipient as it is stored, which is what later sends are checked against
+			receipt.Recipient = notification.Recipient
+
+			if applyReceipt(notification, receipt) {
+				if err := s.repo.UpdateNotification(ctx, notification); err != nil {
+					return result, err
+				}
+				s.publishNotificationEvent(ctx, "notification."+string(notification.Status), notification)
+			}
+		}
+
+		if reason := receiptSuppressionReason(receipt); reason != "" && receipt.Recipient != "" {
+			suppression := &models.SuppressedRecipient{
+				Type:      receipt.Type,
+				Recipient: receipt.Recipient,
+				Reason:    reason,
+				Detail:    receipt.Reason,
+				Provider:  provider,
+			}
+			if notification != nil {
+				suppression.NotificationID = &notification.ID
+			}
+			if err := s.repo.SuppressRecipient(ctx, suppression); err != nil {
+				return result, err
+			}
+			result.Suppressed++
+		}
+
+		s.metrics.RecordDeliveryReceipt(string(receipt.Type), string(receipt.Status))
+	}
+
+	return result, nil
+}
+
+// GetSuppressedRecipients retrieves suppressed recipients, optionally for one channel
+func (s *NotificationService) GetSuppressedRecipients(ctx context.Context, notificationType models.NotificationType, limit, offset int) ([]models.SuppressedRecipient, error) {
+	return s.repo.GetSuppressedRecipients(ctx, notificationType, limit, offset)
+}
+
+// DeleteSuppressedRecipient allows sending to a recipient again
+func (s *NotificationService) DeleteSuppressedRecipient(ctx context.Context, id uint) error {
+	return s.repo.DeleteSuppressedRecipient(ctx, id)
+}
+
+// applySuppressionList suppresses a notification whose recipient is on the
+// suppression list for its channel. It reports whether it was suppressed.
+func (s *NotificationService) applySuppressionList(ctx context.Context, notification *models.Notification) (bool, error) {
+	suppression, err := s.repo.GetSuppressedRecipient(ctx, notification.Type, notification.Recipient)
+	if err != nil || suppression == nil {
+		return false, err
+	}
+
+	notification.MarkAsSuppressed(suppressionReasonRecipientSuppressed,
+		fmt.Sprintf("%s recipient is suppressed (%s)", notification.Type, suppression.Reason))
+	return true, nil
+}
+
+// rejectRecipient marks a notification undeliverable after the provider
+// refused its recipient for good, and suppresses the recipient
+func (s *NotificationService) rejectRecipient(ctx context.Context, notification *models.Notification, reason error) {
+	notification.MarkAsUndeliverable(reason.Error())
+
+	// Stale tokens of a push sent to the user's devices were unregistered instead
+	if notification.Recipient == "" {
+		return
+	}
+
+	if err := s.repo.SuppressRecipient(ctx, &models.SuppressedRecipient{
+		Type:           notification.Type,
+		Recipient:      notification.Recipient,
+		Reason:         models.SuppressionReasonInvalidRecipient,
+		Detail:         reason.Error(),
+		NotificationID: &notification.ID,
+	}); err != nil {
+		s.logger.Error("Failed to suppress invalid recipient", map[string]interface{}{
+			"error":           err.Error(),
+			"notification_id": notification.ID,
+		})
+	}
+}
+
+// verifyReceiptWebhook checks the signature of a receipt webhook and decodes its events
+func (s *NotificationService) verifyReceiptWebhook(payload []byte, signature string) ([]ReceiptEvent, error) {
+	if s.providers == nil || !s.providers.VerifyReceiptSignature(payload, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	var webhook ReceiptWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil {
+		return nil, fmt.Errorf("%w: invalid receipt payload: %v", ErrValidation, err)
+	}
+	return webhook.Events, nil
+}
+
+// applyReceipt moves the notification to the reported status; it reports
+// whether the notification changed
+func applyReceipt(notification *models.Notification, receipt DeliveryReceipt) bool {
+	reason := receipt.Reason
+	if reason == "" {
+		reason = string(receipt.Status)
+	}
+
+	switch receipt.Status {
+	case ReceiptStatusDelivered:
+		if notification.Status == models.NotificationStatusSent {
+			notification.MarkAsDelivered()
+			return true
+		}
+	case ReceiptStatusBounced:
+		if notification.Status == models.NotificationStatusSent || notification.Status == models.NotificationStatusDelivered {
+			notification.MarkAsBounced(reason)
+			return true
+		}
+	case ReceiptStatusUndeliverable:
+		if notification.Status == models.NotificationStatusSent || notification.Status == models.NotificationStatusDelivered {
+			notification.MarkAsUndeliverable(reason)
+			return true
+		}
+	}
+	return false
+}
+
+// receiptSuppressionReason returns why the receipt's recipient must be suppressed, if it must
+func receiptSuppressionReason(receipt DeliveryReceipt) string {
+	switch {
+	case receipt.Status == ReceiptStatusComplaint:
+		return models.SuppressionReasonComplaint
+	case receipt.Status == ReceiptStatusBounced && receipt.Permanent:
+		return models.SuppressionReasonHardBounce
+	case receipt.Status == ReceiptStatusUndeliverable && receipt.Permanent:
+		return models.SuppressionReasonInvalidRecipient
+	default:
+		return ""
+	}
+}
+
+// twilioReceipt maps a Twilio status callback onto a receipt; intermediate statuses are ignored
+func twilioReceipt(params url.Values) (DeliveryReceipt, bool) {
+	receipt := DeliveryReceipt{
+		Type:      models.NotificationTypeSMS,
+		MessageID: params.Get("MessageSid"),
+		Recipient: params.Get("To"),
+	}
+
+	switch status := params.Get("MessageStatus"); status {
+	case "delivered":
+		receipt.Status = ReceiptStatusDelivered
+	case "undelivered", "failed":
+		code, _ := strconv.Atoi(params.Get("ErrorCode"))
+		receipt.Status = ReceiptStatusUndeliverable
+		receipt.Permanent = providers.IsPermanentTwilioError(code)
+		receipt.Reason = fmt.Sprintf("Twilio %s (error %d)", status, code)
+	default:
+		return receipt, false
+	}
+	return receipt, true
+}
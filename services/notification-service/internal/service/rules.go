@@ -0,0 +1,760 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/templating"
+)
+
+const (
+	ruleEventsQueue = "notification-service"
+
+	// defaultRuleClubField is the payload field holding the club an event belongs to
+	defaultRuleClubField = "club_id"
+
+	// defaultRuleUserField is the payload field holding the user an event is about
+	defaultRuleUserField = "user_id"
+
+	// defaultEventMaxRetries matches the message bus default when a message does not set one
+	defaultEventMaxRetries = 3
+)
+
+// RuleEventSubjects are the domain event subjects notification rules are evaluated against
+var RuleEventSubjects = []string{"visit.>", "agreement.>", "governance.>", "member.>", "transaction.>"}
+
+// DomainEvent is a decoded message from another service
+type DomainEvent struct {
+	ID      string                 `json:"id"`
+	Subject string                 `json:"subject"`
+	Type    string                 `json:"type"`
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// ruleRecipient is one user a rule notifies; fields holds the payload values
+// recipient fields and variables are looked up in before the event itself
+type ruleRecipient struct {
+	userID string
+	fields map[string]interface{}
+}
+
+// StartEventRules subscribes the rules engine to domain events; the queue
+// group makes sure each event is handled by a single replica
+func (s *NotificationService) StartEventRules() error {
+	for _, subject := range RuleEventSubjects {
+		if err := s.messaging.SubscribeQueue(subject, ruleEventsQueue, s.handleRuleEvent); err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+		}
+	}
+
+	s.logger.Info("Notification rules engine started", map[string]interface{}{
+		"subjects": RuleEventSubjects,
+	})
+	return nil
+}
+
+// handleRuleEvent processes a message, letting the message bus retry failures
+// and dead-lettering the event once the last attempt fails
+func (s *NotificationService) handleRuleEvent(ctx context.Context, msg *messaging.Message) error {
+	event, err := eventFromMessage(msg)
+	if err == nil {
+		_, err = s.ProcessEvent(ctx, event)
+	}
+	if err == nil {
+		return nil
+	}
+
+	maxRetries := msg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultEventMaxRetries
+	}
+	if msg.Retries < maxRetries {
+		return err
+	}
+
+	s.deadLetterEvent(ctx, msg, event, err)
+	return nil
+}
+
+// ProcessEvent applies every active rule matching the event and returns how
+// many notifications were created. Events are processed at most once per
+// message ID; a failed event may be retried safely because users a rule
+// already notified for it are not notified again.
+func (s *NotificationService) ProcessEvent(ctx context.Context, event *DomainEvent) (int, error) {
+	processed, err := s.repo.IsEventProcessed(ctx, event.ID)
+	if err != nil {
+		return 0, err
+	}
+	if processed {
+		s.metrics.RecordRuleEvent(event.Type, "duplicate")
+		s.logger.Debug("Skipping already processed event", map[string]interface{}{
+			"message_id": event.ID,
+			"event_type": event.Type,
+		})
+		return 0, nil
+	}
+
+	rules, err := s.repo.GetActiveNotificationRules(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	var failures []string
+	for i := range rules {
+		rule := &rules[i]
+		if !ruleMatchesEvent(rule, event) {
+			continue
+		}
+
+		count, err := s.applyRule(ctx, rule, event)
+		created += count
+		if err != nil {
+			s.logger.Error("Failed to apply notification rule", map[string]interface{}{
+				"error":      err.Error(),
+				"rule_id":    rule.ID,
+				"message_id": event.ID,
+				"event_type": event.Type,
+			})
+			failures = append(failures, fmt.Sprintf("rule %d: %v", rule.ID, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		s.metrics.RecordRuleEvent(event.Type, "failed")
+		return created, fmt.Errorf("failed to apply notification rules: %s", strings.Join(failures, "; "))
+	}
+
+	err = s.repo.MarkEventProcessed(ctx, &models.ProcessedEvent{
+		MessageID:         event.ID,
+		EventType:         event.Type,
+		NotificationCount: created,
+		ProcessedAt:       time.Now(),
+	})
+	if err != nil {
+		return created, err
+	}
+
+	s.metrics.RecordRuleEvent(event.Type, "processed")
+	return created, nil
+}
+
+// applyRule creates the rule's notifications for an event
+func (s *NotificationService) applyRule(ctx context.Context, rule *models.NotificationRule, event *DomainEvent) (int, error) {
+	variants, err := s.repo.GetNotificationTemplateVariants(ctx, rule.ClubID, rule.TemplateName)
+	if err != nil {
+		return 0, err
+	}
+	declared, err := declaredVariables(variants)
+	if err != nil {
+		return 0, err
+	}
+
+	recipients, err := s.resolveAudience(ctx, rule, event)
+	if err != nil {
+		return 0, err
+	}
+
+	// Users notified by an earlier attempt are skipped so retries never
+	// duplicate; preferences may have rerouted their channels, so the check
+	// is per user rather than per channel
+	notifiedUsers, err := s.repo.GetEventNotifiedUsers(ctx, event.ID, rule.ID)
+	if err != nil {
+		return 0, err
+	}
+	notified := make(map[string]bool, len(notifiedUsers))
+	for _, userID := range notifiedUsers {
+		notified[userID] = true
+	}
+
+	ruleID := rule.ID
+	created := 0
+	for _, recipient := range recipients {
+		userID := recipient.userID
+		if notified[userID] {
+			continue
+		}
+
+		for _, channel := range rule.Channels {
+			address, ok := recipientAddress(rule, channel, recipient, event)
+			if !ok {
+				s.logger.Warn("Skipping rule recipient without an address", map[string]interface{}{
+					"rule_id": rule.ID,
+					"user_id": userID,
+					"type":    channel,
+				})
+				continue
+			}
+
+			_, err = s.CreateNotificationFromTemplate(ctx, &CreateNotificationFromTemplateRequest{
+				ClubID:        rule.ClubID,
+				UserID:        &userID,
+				TemplateName:  rule.TemplateName,
+				Type:          channel,
+				Priority:      rule.Priority,
+				Recipient:     address,
+				Variables:     ruleVariables(rule, declared, recipient, event),
+				Category:      rule.Category,
+				CollapseKey:   ruleCollapseKey(rule, event),
+				RuleID:        &ruleID,
+				SourceEventID: event.ID,
+			})
+			if errors.Is(err, ErrValidation) {
+				// A bad address affects one recipient only
+				s.logger.Warn("Skipping invalid rule recipient", map[string]interface{}{
+					"error":   err.Error(),
+					"rule_id": rule.ID,
+					"user_id": userID,
+					"type":    channel,
+				})
+				continue
+			}
+			if err != nil {
+				return created, err
+			}
+			created++
+		}
+	}
+
+	return created, nil
+}
+
+// resolveAudience lists the users a rule notifies for an event
+func (s *NotificationService) resolveAudience(ctx context.Context, rule *models.NotificationRule, event *DomainEvent) ([]ruleRecipient, error) {
+	audience := rule.Audience
+	userField := audience.UserField
+	if userField == "" {
+		userField = defaultRuleUserField
+	}
+
+	var recipients []ruleRecipient
+	switch audience.Kind {
+	case models.AudienceEventUser:
+		if userID, ok := payloadString(lookupField(event.Payload, userField)); ok {
+			recipients = append(recipients, ruleRecipient{userID: userID, fields: event.Payload})
+		}
+	case models.AudienceEventUsers:
+		entries, _ := lookupField(event.Payload, audience.UsersField).([]interface{})
+		for _, entry := range entries {
+			if fields, ok := entry.(map[string]interface{}); ok {
+				if userID, ok := payloadString(lookupField(fields, userField)); ok {
+					recipients = append(recipients, ruleRecipient{userID: userID, fields: fields})
+				}
+				continue
+			}
+			if userID, ok := payloadString(entry); ok {
+				recipients = append(recipients, ruleRecipient{userID: userID})
+			}
+		}
+	case models.AudienceClubUsers:
+		userIDs, err := s.repo.GetClubUserIDs(ctx, rule.ClubID)
+		if err != nil {
+			return nil, err
+		}
+		for _, userID := range userIDs {
+			recipients = append(recipients, ruleRecipient{userID: userID})
+		}
+	case models.AudienceUsers:
+		for _, userID := range audience.UserIDs {
+			recipients = append(recipients, ruleRecipient{userID: userID})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported audience kind %q", audience.Kind)
+	}
+
+	// A user listed twice is notified once
+	seen := make(map[string]bool, len(recipients))
+	unique := recipients[:0]
+	for _, recipient := range recipients {
+		if recipient.userID == "" || seen[recipient.userID] {
+			continue
+		}
+		seen[recipient.userID] = true
+		unique = append(unique, recipient)
+	}
+
+	return unique, nil
+}
+
+// Rule management
+
+// CreateNotificationRule validates and stores a notification rule
+func (s *NotificationService) CreateNotificationRule(ctx context.Context, req *CreateRuleRequest) (*models.NotificationRule, error) {
+	rule := &models.NotificationRule{
+		ClubID:        req.ClubID,
+		Name:          strings.TrimSpace(req.Name),
+		EventType:     strings.TrimSpace(req.EventType),
+		ClubField:     req.ClubField,
+		Filter:        req.Filter,
+		TemplateName:  req.TemplateName,
+		Variables:     req.Variables,
+		Audience:      req.Audience,
+		Channels:      req.Channels,
+		Priority:      req.Priority,
+		Category:      req.Category,
+		CollapseField: req.CollapseField,
+		IsActive:      true,
+		CreatedByID:   req.CreatedByID,
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+	if rule.Priority == "" {
+		rule.Priority = models.NotificationPriorityNormal
+	}
+
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateNotificationRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Notification rule created", map[string]interface{}{
+		"rule_id":    rule.ID,
+		"club_id":    rule.ClubID,
+		"event_type": rule.EventType,
+	})
+
+	return rule, nil
+}
+
+// GetNotificationRule retrieves a notification rule by ID
+func (s *NotificationService) GetNotificationRule(ctx context.Context, id uint) (*models.NotificationRule, error) {
+	return s.repo.GetNotificationRuleByID(ctx, id)
+}
+
+// GetNotificationRulesByClub retrieves the notification rules of a club
+func (s *NotificationService) GetNotificationRulesByClub(ctx context.Context, clubID uint) ([]models.NotificationRule, error) {
+	return s.repo.GetNotificationRulesByClub(ctx, clubID)
+}
+
+// UpdateNotificationRule applies a partial update to a notification rule
+func (s *NotificationService) UpdateNotificationRule(ctx context.Context, id uint, req *UpdateRuleRequest) (*models.NotificationRule, error) {
+	rule, err := s.repo.GetNotificationRuleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.EventType != nil {
+		rule.EventType = strings.TrimSpace(*req.EventType)
+	}
+	if req.ClubField != nil {
+		rule.ClubField = *req.ClubField
+	}
+	if req.Filter != nil {
+		rule.Filter = req.Filter
+	}
+	if req.TemplateName != nil {
+		rule.TemplateName = *req.TemplateName
+	}
+	if req.Variables != nil {
+		rule.Variables = req.Variables
+	}
+	if req.Audience != nil {
+		rule.Audience = *req.Audience
+	}
+	if req.Channels != nil {
+		rule.Channels = req.Channels
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.Category != nil {
+		rule.Category = *req.Category
+	}
+	if req.CollapseField != nil {
+		rule.CollapseField = *req.CollapseField
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateNotificationRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteNotificationRule deletes a notification rule
+func (s *NotificationService) DeleteNotificationRule(ctx context.Context, id uint) error {
+	return s.repo.DeleteNotificationRule(ctx, id)
+}
+
+// validateRule checks a rule is complete and that its template exists on every channel
+func (s *NotificationService) validateRule(ctx context.Context, rule *models.NotificationRule) error {
+	var problems []string
+	if rule.ClubID == 0 {
+		problems = append(problems, "club_id is required")
+	}
+	if rule.Name == "" {
+		problems = append(problems, "name is required")
+	}
+	if rule.EventType == "" {
+		problems = append(problems, "event_type is required")
+	}
+	if strings.TrimSpace(rule.TemplateName) == "" {
+		problems = append(problems, "template_name is required")
+	}
+	if len(rule.Channels) == 0 {
+		problems = append(problems, "at least one channel is required")
+	}
+	for _, channel := range rule.Channels {
+		if !validNotificationType(channel) {
+			problems = append(problems, fmt.Sprintf("unknown channel %q", channel))
+		}
+	}
+
+	switch rule.Priority {
+	case models.NotificationPriorityLow, models.NotificationPriorityNormal,
+		models.NotificationPriorityHigh, models.NotificationPritorityCritical:
+	default:
+		problems = append(problems, fmt.Sprintf("unknown priority %q", rule.Priority))
+	}
+
+	switch rule.Audience.Kind {
+	case models.AudienceEventUser, models.AudienceClubUsers:
+	case models.AudienceEventUsers:
+		if rule.Audience.UsersField == "" {
+			problems = append(problems, "audience.users_field is required for event_users")
+		}
+	case models.AudienceUsers:
+		if len(rule.Audience.UserIDs) == 0 {
+			problems = append(problems, "audience.user_ids is required for users")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("unknown audience kind %q", rule.Audience.Kind))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrValidation, strings.Join(problems, "; "))
+	}
+
+	variants, err := s.repo.GetNotificationTemplateVariants(ctx, rule.ClubID, rule.TemplateName)
+	if err != nil {
+		return err
+	}
+	for _, channel := range rule.Channels {
+		if _, err := selectTemplateVariant(variants, channel, defaultTemplateLocale); err != nil {
+			return fmt.Errorf("%w: template %q has no %s variant", ErrValidation, rule.TemplateName, channel)
+		}
+	}
+
+	return nil
+}
+
+// Dead letters
+
+// deadLetterEvent stores an event whose processing failed on every attempt
+func (s *NotificationService) deadLetterEvent(ctx context.Context, msg *messaging.Message, event *DomainEvent, cause error) {
+	eventType := msg.Subject
+	if event != nil {
+		eventType = event.Type
+	}
+
+	deadLetter := &models.EventDeadLetter{
+		MessageID: msg.ID,
+		Subject:   msg.Subject,
+		EventType: eventType,
+		Payload:   string(msg.Data),
+		Error:     cause.Error(),
+		Attempts:  msg.Retries + 1,
+	}
+
+	s.metrics.RecordRuleEvent(eventType, "dead_lettered")
+	s.logger.Error("Dead-lettering event after failed processing", map[string]interface{}{
+		"error":      cause.Error(),
+		"message_id": msg.ID,
+		"subject":    msg.Subject,
+		"attempts":   deadLetter.Attempts,
+	})
+
+	if err := s.repo.CreateEventDeadLetter(ctx, deadLetter); err != nil {
+		s.logger.Error("Failed to store dead-lettered event", map[string]interface{}{
+			"error":      err.Error(),
+			"message_id": msg.ID,
+		})
+	}
+}
+
+// GetEventDeadLetters retrieves dead-lettered events
+func (s *NotificationService) GetEventDeadLetters(ctx context.Context, includeReplayed bool, limit, offset int) ([]models.EventDeadLetter, error) {
+	return s.repo.GetEventDeadLetters(ctx, includeReplayed, limit, offset)
+}
+
+// ReplayEventDeadLetter processes a dead-lettered event again, typically after
+// the rule or template that made it fail was fixed
+func (s *NotificationService) ReplayEventDeadLetter(ctx context.Context, id uint) (*EventReplayResult, error) {
+	deadLetter, err := s.repo.GetEventDeadLetterByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deadLetter.ReplayedAt != nil {
+		return nil, fmt.Errorf("%w: event was already replayed", ErrValidation)
+	}
+
+	event, err := eventFromMessage(&messaging.Message{
+		ID:      deadLetter.MessageID,
+		Subject: deadLetter.Subject,
+		Data:    json.RawMessage(deadLetter.Payload),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+
+	created, err := s.ProcessEvent(ctx, event)
+	deadLetter.Attempts++
+	if err != nil {
+		deadLetter.Error = err.Error()
+		if updateErr := s.repo.UpdateEventDeadLetter(ctx, deadLetter); updateErr != nil {
+			return nil, updateErr
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	deadLetter.ReplayedAt = &now
+	if err := s.repo.UpdateEventDeadLetter(ctx, deadLetter); err != nil {
+		return nil, err
+	}
+
+	return &EventReplayResult{DeadLetter: deadLetter, NotificationsCreated: created}, nil
+}
+
+// Event helpers
+
+// eventFromMessage decodes a message payload. Some publishers marshal their
+// payload before publishing, so it may arrive as a base64 or JSON string.
+func eventFromMessage(msg *messaging.Message) (*DomainEvent, error) {
+	if msg.ID == "" {
+		return nil, fmt.Errorf("message on %s has no id", msg.Subject)
+	}
+
+	payload := map[string]interface{}{}
+	if len(msg.Data) > 0 {
+		if err := json.Unmarshal(msg.Data, &payload); err != nil {
+			var encoded string
+			if json.Unmarshal(msg.Data, &encoded) != nil {
+				return nil, fmt.Errorf("message payload is not a JSON object: %w", err)
+			}
+			raw := []byte(encoded)
+			if decoded, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+				raw = decoded
+			}
+			if err := json.Unmarshal(raw, &payload); err != nil {
+				return nil, fmt.Errorf("message payload is not a JSON object: %w", err)
+			}
+		}
+	}
+
+	// Services publishing several event types on one subject name the type in the payload
+	eventType := msg.Subject
+	if named, ok := payload["event_type"].(string); ok && named != "" {
+		eventType = named
+	}
+
+	return &DomainEvent{ID: msg.ID, Subject: msg.Subject, Type: eventType, Payload: payload}, nil
+}
+
+// ruleMatchesEvent checks the event type, club and filter of a rule
+func ruleMatchesEvent(rule *models.NotificationRule, event *DomainEvent) bool {
+	if !rule.MatchesEventType(event.Type) {
+		return false
+	}
+
+	clubField := rule.ClubField
+	if clubField == "" {
+		clubField = defaultRuleClubField
+	}
+	clubID, ok := payloadString(lookupField(event.Payload, clubField))
+	if !ok || clubID != strconv.FormatUint(uint64(rule.ClubID), 10) {
+		return false
+	}
+
+	for field, expected := range rule.Filter {
+		actual, ok := payloadString(lookupField(event.Payload, field))
+		if !ok || !filterMatches(expected, actual) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterMatches compares a filter value, or any entry of a filter list, with a payload value
+func filterMatches(expected interface{}, actual string) bool {
+	if options, ok := expected.([]interface{}); ok {
+		for _, option := range options {
+			if filterMatches(option, actual) {
+				return true
+			}
+		}
+		return false
+	}
+
+	value, ok := payloadString(expected)
+	return ok && value == actual
+}
+
+// recipientAddress resolves where a channel delivers to: in-app notifications
+// go to the user, other channels read the address from the rule's recipient field
+func recipientAddress(rule *models.NotificationRule, channel models.NotificationType, recipient ruleRecipient, event *DomainEvent) (string, bool) {
+	field, ok := rule.Audience.RecipientFields[channel]
+	if !ok {
+		switch channel {
+		case models.NotificationTypeInApp:
+			return recipient.userID, true
+		case models.NotificationTypePush:
+			// Sent to the devices the user registered
+			return "", true
+		}
+		return "", false
+	}
+
+	if value, ok := payloadString(lookupField(recipient.fields, field)); ok {
+		return value, true
+	}
+	return payloadString(lookupField(event.Payload, field))
+}
+
+// ruleVariables collects the template's declared variables from the payload,
+// using the rule's variable mapping where one is given
+func ruleVariables(rule *models.NotificationRule, declared map[string]bool, recipient ruleRecipient, event *DomainEvent) map[string]interface{} {
+	variables := make(map[string]interface{})
+	for name := range declared {
+		field := name
+		if mapped, ok := rule.Variables[name]; ok {
+			field = mapped
+		}
+
+		if value := lookupField(recipient.fields, field); value != nil {
+			variables[name] = value
+		} else if value := lookupField(event.Payload, field); value != nil {
+			variables[name] = value
+		}
+	}
+	return variables
+}
+
+// declaredVariables returns the variables declared by any variant of a template
+func declaredVariables(variants []models.NotificationTemplate) (map[string]bool, error) {
+	if len(variants) == 0 {
+		return nil, ErrTemplateNotFound
+	}
+
+	declared := make(map[string]bool)
+	for _, variant := range variants {
+		specs, err := templating.ParseVariableSpecs(variant.Variables)
+		if err != nil {
+			return nil, err
+		}
+		for name := range specs {
+			declared[name] = true
+		}
+	}
+	return declared, nil
+}
+
+// lookupField resolves a dot-separated path in a payload
+func lookupField(payload map[string]interface{}, path string) interface{} {
+	if payload == nil || path == "" {
+		return nil
+	}
+
+	var current interface{} = payload
+	for _, key := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[key]
+	}
+	return current
+}
+
+// ruleCollapseKey identifies notifications about the same subject, such as
+// votes on one proposal, so digests show them as one entry
+func ruleCollapseKey(rule *models.NotificationRule, event *DomainEvent) string {
+	if rule.CollapseField == "" {
+		return ""
+	}
+	value, ok := payloadString(lookupField(event.Payload, rule.CollapseField))
+	if !ok {
+		return ""
+	}
+	return event.Type + ":" + value
+}
+
+// payloadString formats a scalar payload value for comparisons and IDs
+func payloadString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case json.Number:
+		return v.String(), true
+	case int:
+		return strconv.Itoa(v), true
+	default:
+		return "", false
+	}
+}
+
+// Rule request/response types
+
+type CreateRuleRequest struct {
+	ClubID        uint                        `json:"club_id" validate:"required"`
+	Name          string                      `json:"name" validate:"required"`
+	EventType     string                      `json:"event_type" validate:"required"`
+	ClubField     string                      `json:"club_field,omitempty"`
+	Filter        map[string]interface{}      `json:"filter,omitempty"`
+	TemplateName  string                      `json:"template_name" validate:"required"`
+	Variables     map[string]string           `json:"variables,omitempty"`
+	Audience      models.RuleAudience         `json:"audience"`
+	Channels      []models.NotificationType   `json:"channels" validate:"required"`
+	Priority      models.NotificationPriority `json:"priority,omitempty"`
+	Category      string                      `json:"category,omitempty"`
+	CollapseField string                      `json:"collapse_field,omitempty"`
+	IsActive      *bool                       `json:"is_active,omitempty"`
+	CreatedByID   string                      `json:"created_by_id"`
+}
+
+type UpdateRuleRequest struct {
+	Name          *string                      `json:"name,omitempty"`
+	EventType     *string                      `json:"event_type,omitempty"`
+	ClubField     *string                      `json:"club_field,omitempty"`
+	Filter        map[string]interface{}       `json:"filter,omitempty"`
+	TemplateName  *string                      `json:"template_name,omitempty"`
+	Variables     map[string]string            `json:"variables,omitempty"`
+	Audience      *models.RuleAudience         `json:"audience,omitempty"`
+	Channels      []models.NotificationType    `json:"channels,omitempty"`
+	Priority      *models.NotificationPriority `json:"priority,omitempty"`
+	Category      *string                      `json:"category,omitempty"`
+	CollapseField *string                      `json:"collapse_field,omitempty"`
+	IsActive      *bool                        `json:"is_active,omitempty"`
+}
+
+type EventReplayResult struct {
+	DeadLetter           *models.EventDeadLetter `json:"dead_letter"`
+	NotificationsCreated int                     `json:"notifications_created"`
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"reciprocal-clubs-backend/pkg/shared/logging"
@@ -15,6 +17,7 @@ import (
 	notificationmonitoring "reciprocal-clubs-backend/services/notification-service/internal/monitoring"
 	"reciprocal-clubs-backend/services/notification-service/internal/providers"
 	"reciprocal-clubs-backend/services/notification-service/internal/repository"
+	"reciprocal-clubs-backend/services/notification-service/internal/templating"
 )
 
 // NotificationService handles business logic for notifications
@@ -26,6 +29,17 @@ type NotificationService struct {
 	monitoring monitoring.MonitoringInterface
 	metrics    *notificationmonitoring.NotificationMetrics
 	health     *notificationmonitoring.HealthChecker
+	renderer   *templating.Renderer
+	senders    map[models.NotificationType]Sender
+	inbox      *inboxBroker
+
+	dispatchMu sync.Mutex
+	dispatcher *dispatcher
+	retryBase  time.Duration
+	retryMax   time.Duration
+
+	webhookRetryBase time.Duration
+	webhookRetryMax  time.Duration
 }
 
 // NewService creates a new notification service
@@ -33,7 +47,8 @@ func NewService(repo *repository.Repository, providers *providers.NotificationPr
 	metrics := notificationmonitoring.NewNotificationMetrics(logger)
 	health := notificationmonitoring.NewHealthChecker(repo.GetDB(), providers, logger)
 
-	return &NotificationService{
+	defaults := DefaultDispatcherConfig()
+	s := &NotificationService{
 		repo:       repo,
 		providers:  providers,
 		logger:     logger,
@@ -41,7 +56,26 @@ func NewService(repo *repository.Repository, providers *providers.NotificationPr
 		monitoring: monitoring,
 		metrics:    metrics,
 		health:     health,
+		renderer:   templating.NewRenderer(templating.DefaultMaxSMSSegments),
+		inbox:      newInboxBroker(),
+		retryBase:  defaults.RetryBase,
+		retryMax:   defaults.RetryMax,
+
+		webhookRetryBase: defaults.WebhookRetryBase,
+		webhookRetryMax:  defaults.WebhookRetryMax,
+	}
+	if providers != nil {
+		providers.SetObserver(metrics)
+	}
+	s.senders = map[models.NotificationType]Sender{
+		models.NotificationTypeEmail:   SenderFunc(s.sendEmail),
+		models.NotificationTypeSMS:     SenderFunc(s.sendSMS),
+		models.NotificationTypePush:    SenderFunc(s.sendPush),
+		models.NotificationTypeInApp:   SenderFunc(s.sendInApp),
+		models.NotificationTypeWebhook: SenderFunc(s.sendWebhook),
 	}
+
+	return s
 }
 
 // Notification operations
@@ -50,7 +84,7 @@ func NewService(repo *repository.Repository, providers *providers.NotificationPr
 func (s *NotificationService) CreateNotification(ctx context.Context, req *CreateNotificationRequest) (*models.Notification, error) {
 	// Validate request
 	if err := s.validateCreateNotificationRequest(req); err != nil {
-		return nil, fmt.Errorf("validation failed: %w", err)
+		return nil, fmt.Errorf("%w: %v", ErrValidation, err)
 	}
 
 	notification := &models.Notification{
@@ -61,17 +95,39 @@ func (s *NotificationService) CreateNotification(ctx context.Context, req *Creat
 		Subject:      req.Subject,
 		Message:      req.Message,
 		Recipient:    req.Recipient,
+		Category:     req.Category,
+		CollapseKey:  req.CollapseKey,
 		Metadata:     req.Metadata,
 		ScheduledFor: req.ScheduledFor,
 		Status:       models.NotificationStatusPending,
 	}
 
-	if err := s.repo.CreateNotification(ctx, notification); err != nil {
-		s.metrics.RecordNotificationFailed(fmt.Sprintf("%d", req.ClubID), string(req.Type), "repository", "create_error")
+	if err := s.saveAndDispatch(ctx, notification); err != nil {
 		return nil, err
 	}
 
-	s.metrics.RecordNotificationCreated(fmt.Sprintf("%d", req.ClubID), string(req.Type), string(req.Priority))
+	return notification, nil
+}
+
+// saveAndDispatch resolves the recipient's preferences, persists the new
+// notification, announces it and, unless it is suppressed or scheduled for
+// later, wakes the dispatcher to deliver it
+func (s *NotificationService) saveAndDispatch(ctx context.Context, notification *models.Notification) error {
+	if _, err := s.applyPreferences(ctx, notification, time.Now()); err != nil {
+		// Preferences are advisory; a lookup failure must not lose the notification
+		s.logger.Error("Failed to resolve user preferences", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": notification.ClubID,
+			"user_id": notification.UserID,
+		})
+	}
+
+	if err := s.repo.CreateNotification(ctx, notification); err != nil {
+		s.metrics.RecordNotificationFailed(fmt.Sprintf("%d", notification.ClubID), string(notification.Type), "repository", "create_error")
+		return err
+	}
+
+	s.metrics.RecordNotificationCreated(fmt.Sprintf("%d", notification.ClubID), string(notification.Type), string(notification.Priority))
 
 	// Publish notification created event
 	s.publishNotificationEvent(ctx, "notification.created", notification)
@@ -83,12 +139,17 @@ func (s *NotificationService) CreateNotification(ctx context.Context, req *Creat
 		"priority":        notification.Priority,
 	})
 
+	if notification.Status == models.NotificationStatusSuppressed {
+		s.publishNotificationEvent(ctx, "notification.suppressed", notification)
+		return nil
+	}
+
 	// If not scheduled, attempt immediate delivery
 	if !notification.IsScheduled() {
-		go s.processNotification(context.Background(), notification)
+		s.wakeDispatcher(notification.Type)
 	}
 
-	return notification, nil
+	return nil
 }
 
 // GetNotificationByID retrieves a notification by ID
@@ -143,50 +204,48 @@ func (s *NotificationService) MarkNotificationAsRead(ctx context.Context, id uin
 
 	// Publish notification read event
 	s.publishNotificationEvent(ctx, "notification.read", notification)
+	if notification.IsInInbox() {
+		s.recordInboxEvent(ctx, notification.ClubID, *notification.UserID, models.InboxEventRead, []uint{notification.ID})
+	}
 
 	return notification, nil
 }
 
-// ProcessPendingNotifications processes notifications ready to be sent
+// ProcessPendingNotifications asks the dispatcher to deliver notifications that are due
 func (s *NotificationService) ProcessPendingNotifications(ctx context.Context) error {
-	notifications, err := s.repo.GetPendingNotifications(ctx, 100)
-	if err != nil {
-		return err
-	}
-
-	for _, notification := range notifications {
-		go s.processNotification(context.Background(), &notification)
-	}
-
+	s.wakeAllDispatchers()
 	return nil
 }
 
-// RetryFailedNotifications retries failed notifications that can be retried
+// RetryFailedNotifications makes retryable failed notifications due now instead
+// of after their backoff and asks the dispatcher to deliver them
 func (s *NotificationService) RetryFailedNotifications(ctx context.Context) error {
-	notifications, err := s.repo.GetFailedNotifications(ctx, 50)
-	if err != nil {
+	if _, err := s.repo.RescheduleFailedNotifications(ctx); err != nil {
 		return err
 	}
 
-	for _, notification := range notifications {
-		go s.processNotification(context.Background(), &notification)
-	}
-
+	s.wakeAllDispatchers()
 	return nil
 }
 
-// ProcessNotification processes a specific notification by ID (public method for gRPC)
+// ProcessNotification delivers a specific notification now (public method for
+// gRPC). It does nothing if the notification is already delivered or is being
+// delivered by a worker.
 func (s *NotificationService) ProcessNotification(ctx context.Context, id uint) error {
-	notification, err := s.repo.GetNotificationByID(ctx, id)
+	notification, err := s.repo.ClaimNotification(ctx, id, "direct", DefaultDispatcherConfig().Lease)
 	if err != nil {
-		return fmt.Errorf("failed to get notification %d: %w", id, err)
+		return fmt.Errorf("failed to claim notification %d: %w", id, err)
+	}
+	if notification == nil {
+		return nil
 	}
 
-	go s.processNotification(context.Background(), notification)
+	// Finish delivery and record the outcome even if the caller goes away
+	s.deliverClaimed(context.WithoutCancel(ctx), notification)
 	return nil
 }
 
-// ProcessScheduledNotifications processes all pending notifications and returns count
+// ProcessScheduledNotifications asks the dispatcher to deliver due notifications and returns how many are due
 func (s *NotificationService) ProcessScheduledNotifications(ctx context.Context) int {
 	notifications, err := s.repo.GetPendingNotifications(ctx, 100)
 	if err != nil {
@@ -196,9 +255,7 @@ func (s *NotificationService) ProcessScheduledNotifications(ctx context.Context)
 		return 0
 	}
 
-	for _, notification := range notifications {
-		go s.processNotification(context.Background(), &notification)
-	}
+	s.wakeAllDispatchers()
 
 	s.logger.Info("Processed scheduled notifications", map[string]interface{}{
 		"count": len(notifications),
@@ -207,29 +264,88 @@ func (s *NotificationService) ProcessScheduledNotifications(ctx context.Context)
 	return len(notifications)
 }
 
-// RetryFailedNotifications with count return for monitoring
+// RetryFailedNotificationsWithCount retries failed notifications now and returns how many were rescheduled
 func (s *NotificationService) RetryFailedNotificationsWithCount(ctx context.Context) int {
-	notifications, err := s.repo.GetFailedNotifications(ctx, 50)
+	count, err := s.repo.RescheduleFailedNotifications(ctx)
 	if err != nil {
-		s.logger.Error("Failed to get failed notifications", map[string]interface{}{
-			"error": err.Error(),
-		})
 		return 0
 	}
 
-	for _, notification := range notifications {
-		go s.processNotification(context.Background(), &notification)
-	}
+	s.wakeAllDispatchers()
 
 	s.logger.Info("Retried failed notifications", map[string]interface{}{
-		"count": len(notifications),
+		"count": count,
 	})
 
-	return len(notifications)
+	return int(count)
 }
 
 // processNotification handles the actual delivery of a notification
 func (s *NotificationService) processNotification(ctx context.Context, notification *models.Notification) {
+	if notification.Status == models.NotificationStatusSuppressed {
+		return
+	}
+
+	// Preferences may have changed since the notification was created
+	deliverNow, err := s.applyPreferences(ctx, notification, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to resolve user preferences", map[string]interface{}{
+			"error":           err.Error(),
+			"notification_id": notification.ID,
+		})
+	} else if !deliverNow {
+		if err := s.repo.UpdateNotification(ctx, notification); err != nil {
+			s.logger.Error("Failed to update notification status", map[string]interface{}{
+				"error":           err.Error(),
+				"notification_id": notification.ID,
+			})
+		}
+		if notification.Status == models.NotificationStatusSuppressed {
+			s.publishNotificationEvent(ctx, "notification.suppressed", notification)
+		}
+		return
+	}
+
+	// Never send to a recipient a provider reported as unreachable
+	if suppressed, err := s.applySuppressionList(ctx, notification); err != nil {
+		s.logger.Error("Failed to check suppression list", map[string]interface{}{
+			"error":           err.Error(),
+			"notification_id": notification.ID,
+		})
+	} else if suppressed {
+		if err := s.repo.UpdateNotification(ctx, notification); err != nil {
+			s.logger.Error("Failed to update notification status", map[string]interface{}{
+				"error":           err.Error(),
+				"notification_id": notification.ID,
+			})
+		}
+		s.publishNotificationEvent(ctx, "notification.suppressed", notification)
+		return
+	}
+
+	// A digest is rendered from what was collected for it when it comes due
+	var digestItems []models.Notification
+	if notification.IsDigest() {
+		digestItems, err = s.prepareDigest(ctx, notification)
+		if err != nil {
+			s.logger.Error("Failed to prepare digest", map[string]interface{}{
+				"error":           err.Error(),
+				"notification_id": notification.ID,
+			})
+			return
+		}
+		if len(digestItems) == 0 {
+			notification.MarkAsSuppressed(digestReasonEmpty, "no notifications were collected for the digest")
+			if err := s.repo.UpdateNotification(ctx, notification); err != nil {
+				s.logger.Error("Failed to update notification status", map[string]interface{}{
+					"error":           err.Error(),
+					"notification_id": notification.ID,
+				})
+			}
+			return
+		}
+	}
+
 	s.logger.Info("Processing notification", map[string]interface{}{
 		"notification_id": notification.ID,
 		"type":            notification.Type,
@@ -240,19 +356,9 @@ func (s *NotificationService) processNotification(ctx context.Context, notificat
 	providerName := s.getProviderName(notification.Type)
 	s.metrics.RecordDeliveryAttempt(string(notification.Type), providerName, 1)
 
-	var err error
-	switch notification.Type {
-	case models.NotificationTypeEmail:
-		err = s.sendEmail(ctx, notification)
-	case models.NotificationTypeSMS:
-		err = s.sendSMS(ctx, notification)
-	case models.NotificationTypePush:
-		err = s.sendPush(ctx, notification)
-	case models.NotificationTypeInApp:
-		err = s.sendInApp(ctx, notification)
-	case models.NotificationTypeWebhook:
-		err = s.sendWebhook(ctx, notification)
-	default:
+	if sender, ok := s.senders[notification.Type]; ok {
+		err = sender.Send(ctx, notification)
+	} else {
 		err = fmt.Errorf("unsupported notification type: %s", notification.Type)
 	}
 
@@ -260,18 +366,43 @@ func (s *NotificationService) processNotification(ctx context.Context, notificat
 	clubID := fmt.Sprintf("%d", notification.ClubID)
 	notificationType := string(notification.Type)
 
-	if err != nil {
+	if errors.Is(err, providers.ErrInvalidRecipient) {
+		// Retrying or failing over cannot help a recipient that does not exist
+		s.rejectRecipient(ctx, notification, err)
+		s.metrics.RecordNotificationFailed(clubID, notificationType, providerName, "invalid_recipient")
+		s.metrics.RecordDeliveryDuration(notificationType, providerName, "failed", duration)
+		s.logger.Warn("Notification recipient is undeliverable", map[string]interface{}{
+			"error":           err.Error(),
+			"notification_id": notification.ID,
+			"type":            notification.Type,
+		})
+	} else if err != nil {
 		notification.MarkAsFailed(err.Error())
+		if notification.CanRetry() {
+			nextAttempt := time.Now().Add(s.retryBackoff(notification.RetryCount))
+			notification.NextAttemptAt = &nextAttempt
+		} else {
+			notification.MarkAsDeadLetter()
+		}
 		s.metrics.RecordNotificationFailed(clubID, notificationType, providerName, "delivery_error")
 		s.metrics.RecordDeliveryDuration(notificationType, providerName, "failed", duration)
 		s.logger.Error("Failed to send notification", map[string]interface{}{
 			"error":           err.Error(),
 			"notification_id": notification.ID,
 			"type":            notification.Type,
+			"retry_count":     notification.RetryCount,
+			"next_attempt_at": notification.NextAttemptAt,
 			"duration_ms":     duration.Milliseconds(),
 		})
 	} else {
 		notification.MarkAsSent()
+		// Critical notices stay at the top of the inbox until the user unpins or archives them
+		if notification.IsInInbox() && notification.IsCritical() && notification.PinnedAt == nil {
+			notification.Pin()
+		}
+		if notification.Provider != "" {
+			providerName = notification.Provider
+		}
 		s.metrics.RecordNotificationSent(clubID, notificationType, providerName)
 		s.metrics.RecordDeliveryDuration(notificationType, providerName, "success", duration)
 	}
@@ -283,10 +414,24 @@ func (s *NotificationService) processNotification(ctx context.Context, notificat
 		})
 	}
 
+	if notification.IsDigest() && notification.Status == models.NotificationStatusSent {
+		s.completeDigest(ctx, notification, digestItems)
+	}
+
+	// Stream newly delivered in-app notifications to the user's inbox
+	if notification.IsInInbox() {
+		s.recordInboxEvent(ctx, notification.ClubID, *notification.UserID, models.InboxEventNotification, []uint{notification.ID})
+	}
+
 	// Publish notification status update event
-	if err != nil {
+	switch {
+	case notification.Status == models.NotificationStatusDeadLetter:
+		s.publishNotificationEvent(ctx, "notification.dead_lettered", notification)
+	case notification.Status == models.NotificationStatusUndeliverable:
+		s.publishNotificationEvent(ctx, "notification.undeliverable", notification)
+	case err != nil:
 		s.publishNotificationEvent(ctx, "notification.failed", notification)
-	} else {
+	default:
 		s.publishNotificationEvent(ctx, "notification.sent", notification)
 	}
 }
@@ -299,13 +444,24 @@ func (s *NotificationService) CreateNotificationTemplate(ctx context.Context, re
 		ClubID:      req.ClubID,
 		Name:        req.Name,
 		Type:        req.Type,
+		Locale:      normalizeLocale(req.Locale),
 		Subject:     req.Subject,
 		Body:        req.Body,
+		HTMLBody:    req.HTMLBody,
 		Variables:   req.Variables,
+		Version:     1,
 		IsActive:    true,
 		CreatedByID: req.CreatedByID,
 	}
 
+	if err := templating.Compile(templating.ContentFromTemplate(template)); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureTemplateVariantAvailable(ctx, template); err != nil {
+		return nil, err
+	}
+
 	if err := s.repo.CreateNotificationTemplate(ctx, template); err != nil {
 		s.metrics.RecordNotificationFailed(fmt.Sprintf("%d", req.ClubID), string(req.Type), "repository", "template_create_error")
 		return nil, err
@@ -341,83 +497,80 @@ func (s *NotificationService) GetNotificationStats(ctx context.Context, clubID u
 // Notification delivery methods (stubs - implement with actual providers)
 
 func (s *NotificationService) sendEmail(ctx context.Context, notification *models.Notification) error {
-	if s.providers.Email == nil {
-		return fmt.Errorf("email provider not configured")
-	}
-
-	// Parse metadata for additional email configuration
-	metadata := make(map[string]string)
-	if notification.Metadata != "" {
-		var metaMap map[string]string
-		if err := json.Unmarshal([]byte(notification.Metadata), &metaMap); err == nil {
-			metadata = metaMap
-		}
-	}
+	// Send with a plain-text alternative when the HTML was rendered from a template
+	return s.deliverThroughPool(ctx, s.providers.EmailPool, notification, &providers.Message{
+		To:       notification.Recipient,
+		Subject:  notification.Subject,
+		Text:     notification.Message,
+		HTML:     notification.HTMLMessage,
+		Metadata: notificationMetadata(notification),
+	})
+}
 
-	// Send email via provider
-	err := s.providers.Email.SendEmail(ctx, notification.Recipient, notification.Subject, notification.Message, metadata)
-	if err != nil {
-		s.logger.Error("Failed to send email", map[string]interface{}{
-			"error":           err.Error(),
-			"notification_id": notification.ID,
-			"recipient":       notification.Recipient,
-		})
-		return err
+func (s *NotificationService) sendSMS(ctx context.Context, notification *models.Notification) error {
+	// For SMS, we use the message content (subject + message combined if needed)
+	body := notification.Message
+	if notification.Subject != "" && notification.Subject != notification.Message {
+		body = notification.Subject + ": " + notification.Message
 	}
 
-	s.logger.Info("Email sent successfully", map[string]interface{}{
-		"notification_id": notification.ID,
-		"recipient":       notification.Recipient,
+	return s.deliverThroughPool(ctx, s.providers.SMSPool, notification, &providers.Message{
+		To:       notification.Recipient,
+		Text:     body,
+		Metadata: notificationMetadata(notification),
 	})
-
-	return nil
 }
 
-func (s *NotificationService) sendSMS(ctx context.Context, notification *models.Notification) error {
-	if s.providers.SMS == nil {
-		return fmt.Errorf("SMS provider not configured")
+func (s *NotificationService) sendPush(ctx context.Context, notification *models.Notification) error {
+	message := &providers.Message{
+		To:          notification.Recipient,
+		Subject:     notification.Subject,
+		Text:        notification.Message,
+		Metadata:    notificationMetadata(notification),
+		Priority:    string(notification.Priority),
+		CollapseKey: notification.CollapseKey,
 	}
 
-	// Parse metadata
-	metadata := make(map[string]string)
-	if notification.Metadata != "" {
-		var metaMap map[string]string
-		if err := json.Unmarshal([]byte(notification.Metadata), &metaMap); err == nil {
-			metadata = metaMap
-		}
+	// Without a device token the push goes to every device the user registered
+	if notification.Recipient == "" && notification.UserID != nil {
+		return s.sendPushToDevices(ctx, notification, message)
 	}
+	return s.deliverThroughPool(ctx, s.providers.PushPool, notification, message)
+}
 
-	// For SMS, we use the message content (subject + message combined if needed)
-	body := notification.Message
-	if notification.Subject != "" && notification.Subject != notification.Message {
-		body = notification.Subject + ": " + notification.Message
+// deliverThroughPool sends through the channel's provider pool, which fails
+// over between providers, and records which provider delivered
+func (s *NotificationService) deliverThroughPool(ctx context.Context, pool *providers.ProviderPool, notification *models.Notification, message *providers.Message) error {
+	if pool == nil || pool.Len() == 0 {
+		return fmt.Errorf("%s provider not configured", notification.Type)
 	}
 
-	// Send SMS via provider
-	err := s.providers.SMS.SendSMS(ctx, notification.Recipient, body, metadata)
+	delivery, err := pool.Deliver(ctx, message)
 	if err != nil {
-		s.logger.Error("Failed to send SMS", map[string]interface{}{
+		s.logger.Error("Failed to send notification through any provider", map[string]interface{}{
 			"error":           err.Error(),
 			"notification_id": notification.ID,
+			"type":            notification.Type,
 			"recipient":       notification.Recipient,
 		})
 		return err
 	}
 
-	s.logger.Info("SMS sent successfully", map[string]interface{}{
-		"notification_id": notification.ID,
-		"recipient":       notification.Recipient,
+	notification.Provider = delivery.Provider
+	notification.ProviderMessageID = delivery.MessageID
+	s.logger.Info("Notification sent successfully", map[string]interface{}{
+		"notification_id":     notification.ID,
+		"type":                notification.Type,
+		"provider":            delivery.Provider,
+		"provider_message_id": delivery.MessageID,
+		"recipient":           notification.Recipient,
 	})
 
 	return nil
 }
 
-func (s *NotificationService) sendPush(ctx context.Context, notification *models.Notification) error {
-	if s.providers.Push == nil {
-		return fmt.Errorf("push notification provider not configured")
-	}
-
-	// Parse metadata
+// notificationMetadata parses the notification's metadata for the provider
+func notificationMetadata(notification *models.Notification) map[string]string {
 	metadata := make(map[string]string)
 	if notification.Metadata != "" {
 		var metaMap map[string]string
@@ -425,24 +578,7 @@ func (s *NotificationService) sendPush(ctx context.Context, notification *models
 			metadata = metaMap
 		}
 	}
-
-	// Send push notification via provider
-	err := s.providers.Push.SendPush(ctx, notification.Recipient, notification.Subject, notification.Message, metadata)
-	if err != nil {
-		s.logger.Error("Failed to send push notification", map[string]interface{}{
-			"error":           err.Error(),
-			"notification_id": notification.ID,
-			"recipient":       notification.Recipient,
-		})
-		return err
-	}
-
-	s.logger.Info("Push notification sent successfully", map[string]interface{}{
-		"notification_id": notification.ID,
-		"recipient":       notification.Recipient,
-	})
-
-	return nil
+	return metadata
 }
 
 func (s *NotificationService) sendInApp(ctx context.Context, notification *models.Notification) error {
@@ -557,6 +693,8 @@ type CreateNotificationRequest struct {
 	Subject      string                       `json:"subject"`
 	Message      string                       `json:"message" validate:"required"`
 	Recipient    string                       `json:"recipient" validate:"required"`
+	Category     string                       `json:"category,omitempty"`
+	CollapseKey  string                       `json:"collapse_key,omitempty"`
 	Metadata     string                       `json:"metadata,omitempty"`
 	ScheduledFor *time.Time                   `json:"scheduled_for,omitempty"`
 }
@@ -565,8 +703,10 @@ type CreateTemplateRequest struct {
 	ClubID      uint                    `json:"club_id" validate:"required"`
 	Name        string                  `json:"name" validate:"required"`
 	Type        models.NotificationType `json:"type" validate:"required"`
+	Locale      string                  `json:"locale,omitempty"`
 	Subject     string                  `json:"subject"`
 	Body        string                  `json:"body" validate:"required"`
+	HTMLBody    string                  `json:"html_body,omitempty"`
 	Variables   string                  `json:"variables,omitempty"`
 	CreatedByID string                  `json:"created_by_id" validate:"required"`
 }
@@ -596,28 +736,38 @@ func (s *NotificationService) validateCreateNotificationRequest(req *CreateNotif
 		return fmt.Errorf("message is required")
 	}
 
-	if strings.TrimSpace(req.Recipient) == "" {
+	return validateRecipient(req.Type, req.Recipient, req.UserID)
+}
+
+// validateRecipient checks the recipient format for a notification type. A
+// push notification without a recipient is sent to the user's devices.
+func validateRecipient(notificationType models.NotificationType, recipient string, userID *string) error {
+	if notificationType == models.NotificationTypePush && recipient == "" && userID != nil {
+		return nil
+	}
+
+	if strings.TrimSpace(recipient) == "" {
 		return fmt.Errorf("recipient is required")
 	}
 
 	// Validate recipient format based on notification type
-	switch req.Type {
+	switch notificationType {
 	case models.NotificationTypeEmail:
-		if !emailRegex.MatchString(req.Recipient) {
+		if !emailRegex.MatchString(recipient) {
 			return fmt.Errorf("invalid email format")
 		}
 	case models.NotificationTypeSMS:
-		if !phoneRegex.MatchString(req.Recipient) {
+		if !phoneRegex.MatchString(recipient) {
 			return fmt.Errorf("invalid phone number format")
 		}
 	case models.NotificationTypePush:
-		// For push notifications, recipient could be a device token
-		if len(req.Recipient) < 10 {
+		// Otherwise the recipient is a device token
+		if len(recipient) < 10 {
 			return fmt.Errorf("invalid device token format")
 		}
 	case models.NotificationTypeWebhook:
 		// For webhooks, recipient should be a URL
-		if !strings.HasPrefix(req.Recipient, "http://") && !strings.HasPrefix(req.Recipient, "https://") {
+		if !strings.HasPrefix(recipient, "http://") && !strings.HasPrefix(recipient, "https://") {
 			return fmt.Errorf("webhook recipient must be a valid URL")
 		}
 	}
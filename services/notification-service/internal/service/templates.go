@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/templating"
+)
+
+// defaultTemplateLocale is used when neither the request nor the user's
+// preferences name a locale, and as the last fallback when selecting variants
+const defaultTemplateLocale = "en"
+
+var (
+	// ErrValidation is returned when a request is malformed
+	ErrValidation = errors.New("validation failed")
+
+	// ErrTemplateNotFound is returned when no active template matches a name
+	ErrTemplateNotFound = errors.New("template not found")
+
+	// ErrTemplateVariantExists is returned when a template with the same name, type and locale already exists
+	ErrTemplateVariantExists = errors.New("template variant already exists")
+)
+
+// CreateNotificationFromTemplate renders the named template for the recipient's
+// locale and creates a notification from the result
+func (s *NotificationService) CreateNotificationFromTemplate(ctx context.Context, req *CreateNotificationFromTemplateRequest) (*models.Notification, error) {
+	if req.ClubID == 0 {
+		return nil, fmt.Errorf("%w: club_id is required", ErrValidation)
+	}
+	if strings.TrimSpace(req.TemplateName) == "" {
+		return nil, fmt.Errorf("%w: template_name is required", ErrValidation)
+	}
+	if req.UserID != nil && strings.TrimSpace(*req.UserID) == "" {
+		return nil, fmt.Errorf("%w: user_id cannot be empty when provided", ErrValidation)
+	}
+
+	variants, err := s.repo.GetNotificationTemplateVariants(ctx, req.ClubID, req.TemplateName)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := s.resolveLocale(ctx, req.ClubID, req.UserID, req.Locale)
+	template, err := selectTemplateVariant(variants, req.Type, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRecipient(template.Type, req.Recipient, req.UserID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+
+	result, err := s.renderer.Render(template.Type, templating.ContentFromTemplate(template), req.Variables)
+	if err != nil {
+		s.metrics.RecordNotificationFailed(fmt.Sprintf("%d", req.ClubID), string(template.Type), "template", "render_error")
+		return nil, err
+	}
+
+	templateData := ""
+	if len(req.Variables) > 0 {
+		encoded, err := json.Marshal(req.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode template variables: %w", err)
+		}
+		templateData = string(encoded)
+	}
+
+	priority := req.Priority
+	if priority == "" {
+		priority = models.NotificationPriorityNormal
+	}
+
+	templateID := template.ID
+	notification := &models.Notification{
+		ClubID:          req.ClubID,
+		UserID:          req.UserID,
+		Type:            template.Type,
+		Priority:        priority,
+		Subject:         result.Subject,
+		Message:         result.Text,
+		HTMLMessage:     result.HTML,
+		Recipient:       req.Recipient,
+		Category:        req.Category,
+		CollapseKey:     req.CollapseKey,
+		Metadata:        req.Metadata,
+		TemplateID:      &templateID,
+		TemplateVersion: template.Version,
+		TemplateLocale:  template.Locale,
+		TemplateData:    templateData,
+		RuleID:          req.RuleID,
+		SourceEventID:   req.SourceEventID,
+		ScheduledFor:    req.ScheduledFor,
+		Status:          models.NotificationStatusPending,
+	}
+
+	if err := s.saveAndDispatch(ctx, notification); err != nil {
+		return nil, err
+	}
+
+	s.metrics.RecordTemplateUsage(fmt.Sprintf("%d", req.ClubID), template.Name, string(template.Type))
+
+	return notification, nil
+}
+
+// PreviewTemplate renders a template with sample data. Variables supplied in
+// the request override the declared samples.
+func (s *NotificationService) PreviewTemplate(ctx context.Context, id uint, req *PreviewTemplateRequest) (*TemplatePreview, error) {
+	template, err := s.repo.GetNotificationTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content := templating.ContentFromTemplate(template)
+	version := template.Version
+	if req.Version > 0 && req.Version != template.Version {
+		templateVersion, err := s.repo.GetNotificationTemplateVersion(ctx, template.ID, req.Version)
+		if err != nil {
+			return nil, err
+		}
+		content = templating.ContentFromVersion(templateVersion)
+		version = templateVersion.Version
+	}
+
+	result, err := s.renderer.Preview(template.Type, content, req.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplatePreview{
+		TemplateID: template.ID,
+		Version:    version,
+		Locale:     template.Locale,
+		Type:       template.Type,
+		Result:     result,
+	}, nil
+}
+
+// UpdateNotificationTemplate applies changes to a template. Content changes
+// bump the version and keep the previous content available for re-rendering.
+func (s *NotificationService) UpdateNotificationTemplate(ctx context.Context, id uint, req *UpdateTemplateRequest) (*models.NotificationTemplate, error) {
+	template, err := s.repo.GetNotificationTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	contentChanged := false
+	if req.Subject != nil && *req.Subject != template.Subject {
+		template.Subject = *req.Subject
+		contentChanged = true
+	}
+	if req.Body != nil && *req.Body != template.Body {
+		if strings.TrimSpace(*req.Body) == "" {
+			return nil, fmt.Errorf("%w: body cannot be empty", ErrValidation)
+		}
+		template.Body = *req.Body
+		contentChanged = true
+	}
+	if req.HTMLBody != nil && *req.HTMLBody != template.HTMLBody {
+		template.HTMLBody = *req.HTMLBody
+		contentChanged = true
+	}
+	if req.Variables != nil && *req.Variables != template.Variables {
+		template.Variables = *req.Variables
+		contentChanged = true
+	}
+	if req.Locale != nil && normalizeLocale(*req.Locale) != template.Locale {
+		template.Locale = normalizeLocale(*req.Locale)
+		if err := s.ensureTemplateVariantAvailable(ctx, template); err != nil {
+			return nil, err
+		}
+		contentChanged = true
+	}
+	if req.IsActive != nil {
+		template.IsActive = *req.IsActive
+	}
+
+	if !contentChanged {
+		if err := s.repo.UpdateNotificationTemplate(ctx, template); err != nil {
+			return nil, err
+		}
+		return template, nil
+	}
+
+	if err := templating.Compile(templating.ContentFromTemplate(template)); err != nil {
+		return nil, err
+	}
+
+	template.Version++
+	if err := s.repo.UpdateNotificationTemplateContent(ctx, template, req.UpdatedByID); err != nil {
+		s.metrics.RecordNotificationFailed(fmt.Sprintf("%d", template.ClubID), string(template.Type), "repository", "template_update_error")
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GetNotificationTemplateVersions retrieves the version history of a template
+func (s *NotificationService) GetNotificationTemplateVersions(ctx context.Context, id uint) ([]models.NotificationTemplateVersion, error) {
+	template, err := s.repo.GetNotificationTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetNotificationTemplateVersions(ctx, template.ID)
+}
+
+// RenderNotification re-renders a template-based notification from the exact
+// template version and variables it was created with
+func (s *NotificationService) RenderNotification(ctx context.Context, id uint) (*TemplatePreview, error) {
+	notification, err := s.repo.GetNotificationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if notification.TemplateID == nil {
+		return nil, fmt.Errorf("%w: notification %d was not created from a template", ErrValidation, id)
+	}
+
+	templateVersion, err := s.repo.GetNotificationTemplateVersion(ctx, *notification.TemplateID, notification.TemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]interface{})
+	if notification.TemplateData != "" {
+		if err := json.Unmarshal([]byte(notification.TemplateData), &data); err != nil {
+			return nil, fmt.Errorf("failed to decode stored template variables: %w", err)
+		}
+	}
+
+	result, err := s.renderer.Render(notification.Type, templating.ContentFromVersion(templateVersion), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplatePreview{
+		TemplateID: templateVersion.TemplateID,
+		Version:    templateVersion.Version,
+		Locale:     templateVersion.Locale,
+		Type:       notification.Type,
+		Result:     result,
+	}, nil
+}
+
+// resolveLocale picks the locale to render in: an explicit request locale,
+// then the user's preferred language, then the default
+func (s *NotificationService) resolveLocale(ctx context.Context, clubID uint, userID *string, requested string) string {
+	if strings.TrimSpace(requested) != "" {
+		return normalizeLocale(requested)
+	}
+
+	if userID != nil {
+		preferences, err := s.repo.GetUserPreferences(ctx, *userID, clubID)
+		if err != nil {
+			s.logger.Warn("Failed to load user preferences for locale", map[string]interface{}{
+				"error":   err.Error(),
+				"user_id": *userID,
+				"club_id": clubID,
+			})
+		} else if preferences.PreferredLang != "" {
+			return normalizeLocale(preferences.PreferredLang)
+		}
+	}
+
+	return defaultTemplateLocale
+}
+
+// ensureTemplateVariantAvailable rejects a second active template with the same name, type and locale
+func (s *NotificationService) ensureTemplateVariantAvailable(ctx context.Context, template *models.NotificationTemplate) error {
+	variants, err := s.repo.GetNotificationTemplateVariants(ctx, template.ClubID, template.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, variant := range variants {
+		if variant.ID != template.ID && variant.Type == template.Type && variant.Locale == template.Locale {
+			return fmt.Errorf("%w: %s (%s, %s)", ErrTemplateVariantExists, template.Name, template.Type, template.Locale)
+		}
+	}
+
+	return nil
+}
+
+// selectTemplateVariant chooses the variant for a channel and locale, falling
+// back from the exact locale to its base language, then the default locale,
+// then any variant of the channel
+func selectTemplateVariant(variants []models.NotificationTemplate, channel models.NotificationType, locale string) (*models.NotificationTemplate, error) {
+	var candidates []*models.NotificationTemplate
+	channels := make(map[models.NotificationType]bool)
+	for i := range variants {
+		if channel != "" && variants[i].Type != channel {
+			continue
+		}
+		candidates = append(candidates, &variants[i])
+		channels[variants[i].Type] = true
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrTemplateNotFound
+	}
+	if len(channels) > 1 {
+		return nil, fmt.Errorf("%w: type is required for templates with variants on several channels", ErrValidation)
+	}
+
+	language := baseLanguage(locale)
+	for _, match := range []func(string) bool{
+		func(l string) bool { return l == locale },
+		func(l string) bool { return l == language },
+		func(l string) bool { return baseLanguage(l) == language },
+		func(l string) bool { return l == defaultTemplateLocale },
+	} {
+		for _, candidate := range candidates {
+			if match(candidate.Locale) {
+				return candidate, nil
+			}
+		}
+	}
+
+	return candidates[0], nil
+}
+
+// normalizeLocale lower-cases a locale tag and uses "-" as its separator
+func normalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if locale == "" {
+		return defaultTemplateLocale
+	}
+	return strings.ReplaceAll(locale, "_", "-")
+}
+
+func baseLanguage(locale string) string {
+	if i := strings.Index(locale, "-"); i > 0 {
+		return locale[:i]
+	}
+	return locale
+}
+
+// Template request/response types
+
+type CreateNotificationFromTemplateRequest struct {
+	ClubID       uint                        `json:"club_id" validate:"required"`
+	UserID       *string                     `json:"user_id,omitempty"`
+	TemplateName string                      `json:"template_name" validate:"required"`
+	Type         models.NotificationType     `json:"type,omitempty"`
+	Locale       string                      `json:"locale,omitempty"`
+	Priority     models.NotificationPriority `json:"priority"`
+	Recipient    string                      `json:"recipient" validate:"required"`
+	Variables    map[string]interface{}      `json:"variables,omitempty"`
+	Category     string                      `json:"category,omitempty"`
+	CollapseKey  string                      `json:"collapse_key,omitempty"`
+	Metadata     string                      `json:"metadata,omitempty"`
+	ScheduledFor *time.Time                  `json:"scheduled_for,omitempty"`
+
+	// Set by the rules engine, never by API callers
+	RuleID        *uint  `json:"-"`
+	SourceEventID string `json:"-"`
+}
+
+type UpdateTemplateRequest struct {
+	Subject     *string `json:"subject,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	HTMLBody    *string `json:"html_body,omitempty"`
+	Variables   *string `json:"variables,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+	UpdatedByID string  `json:"updated_by_id"`
+}
+
+type PreviewTemplateRequest struct {
+	Version   int                    `json:"version,omitempty"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type TemplatePreview struct {
+	TemplateID uint                    `json:"template_id"`
+	Version    int                     `json:"version"`
+	Locale     string                  `json:"locale"`
+	Type       models.NotificationType `json:"type"`
+	*templating.Result
+}
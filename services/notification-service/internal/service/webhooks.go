@@ -0,0 +1,594 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"reciprocal-clubs-backend/pkg/shared/messaging"
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/providers"
+)
+
+const (
+	// webhookEventsQueue receives its own copy of every domain event, separate
+	// from the rules engine's
+	webhookEventsQueue = "notification-service-webhooks"
+
+	// webhookDeliveryRetention is how long finished deliveries stay in the log
+	webhookDeliveryRetention = 30 * 24 * time.Hour
+
+	// A subscription is disabled once its endpoint has failed this many
+	// attempts in a row over at least this long, so neither a short outage
+	// nor a few failures spread over a quiet week disable it
+	webhookDisableAfterFailures   = 20
+	webhookDisableAfterFailingFor = 24 * time.Hour
+
+	// defaultWebhookSecretGrace is how long a rotated secret keeps signing deliveries
+	defaultWebhookSecretGrace  = 24 * time.Hour
+	maxWebhookSecretGraceHours = 7 * 24
+)
+
+// Reasons a subscription is disabled without the club asking
+const (
+	webhookDisabledFailing = "consecutive_failures"
+	webhookDisabledGone    = "endpoint_gone"
+)
+
+// Webhook delivery attempt outcomes
+const (
+	webhookOutcomeSucceeded = "succeeded"
+	webhookOutcomeRetrying  = "retrying"
+	webhookOutcomeExhausted = "dead_letter"
+)
+
+// WebhookSubscriptionSecret is a subscription with its signing secret, which
+// is only shown when the subscription is created and when it is rotated
+type WebhookSubscriptionSecret struct {
+	Subscription *models.WebhookSubscription `json:"subscription"`
+	Secret       string                      `json:"secret"`
+}
+
+// StartWebhookSubscriptions subscribes webhook subscriptions to domain
+// events; the queue group makes sure each event is queued by a single replica
+func (s *NotificationService) StartWebhookSubscriptions() error {
+	for _, subject := range RuleEventSubjects {
+		if err := s.messaging.SubscribeQueue(subject, webhookEventsQueue, s.handleWebhookEvent); err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+		}
+	}
+
+	s.logger.Info("Webhook subscriptions started", map[string]interface{}{
+		"subjects": RuleEventSubjects,
+	})
+	return nil
+}
+
+// handleWebhookEvent queues a message for the subscriptions that receive it,
+// letting the message bus retry failures
+func (s *NotificationService) handleWebhookEvent(ctx context.Context, msg *messaging.Message) error {
+	event, err := eventFromMessage(msg)
+	if err != nil {
+		// A malformed message never becomes valid, so it is not retried
+		s.logger.Warn("Skipping malformed event for webhooks", map[string]interface{}{
+			"error":   err.Error(),
+			"subject": msg.Subject,
+		})
+		return nil
+	}
+
+	_, err = s.QueueWebhookEvent(ctx, event)
+	return err
+}
+
+// QueueWebhookEvent queues a delivery of the event to every enabled
+// subscription of its club that receives its type, and returns how many were
+// queued. An event is queued once per subscription however often it arrives.
+func (s *NotificationService) QueueWebhookEvent(ctx context.Context, event *DomainEvent) (int, error) {
+	club, ok := payloadString(lookupField(event.Payload, defaultRuleClubField))
+	if !ok {
+		return 0, nil
+	}
+	clubID, err := strconv.ParseUint(club, 10, 32)
+	if err != nil {
+		return 0, nil
+	}
+
+	subscriptions, err := s.repo.GetActiveWebhookSubscriptions(ctx, uint(clubID))
+	if err != nil {
+		return 0, err
+	}
+
+	var matching []*models.WebhookSubscription
+	for i := range subscriptions {
+		if subscriptions[i].MatchesEventType(event.Type) {
+			matching = append(matching, &subscriptions[i])
+		}
+	}
+	if len(matching) == 0 {
+		return 0, nil
+	}
+
+	body, err := json.Marshal(providers.WebhookPayload{
+		ID:        event.ID,
+		Event:     event.Type,
+		Timestamp: time.Now().Unix(),
+		Data:      event.Payload,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	deliveries := make([]models.WebhookDelivery, 0, len(matching))
+	for _, subscription := range matching {
+		deliveries = append(deliveries, models.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			ClubID:         subscription.ClubID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        string(body),
+			Status:         models.WebhookDeliveryPending,
+		})
+	}
+
+	queued, err := s.repo.CreateWebhookDeliveries(ctx, deliveries)
+	if err != nil {
+		return 0, err
+	}
+
+	// Keep the delivery log to what support can reasonably need
+	if _, err := s.repo.DeleteWebhookDeliveriesBefore(ctx, uint(clubID), time.Now().Add(-webhookDeliveryRetention)); err != nil {
+		s.logger.Warn("Failed to prune webhook deliveries", map[string]interface{}{
+			"error":   err.Error(),
+			"club_id": clubID,
+		})
+	}
+
+	if queued > 0 {
+		s.wakeWebhookDispatcher()
+	}
+	return int(queued), nil
+}
+
+// deliverWebhook makes one attempt at a delivery leased to this replica and
+// schedules a retry, with backoff, when the endpoint does not accept it;
+// saving the outcome releases the lease
+func (s *NotificationService) deliverWebhook(ctx context.Context, delivery *models.WebhookDelivery) {
+	delivery.ClaimedBy = ""
+	delivery.ClaimedUntil = nil
+
+	subscription, err := s.repo.GetWebhookSubscriptionByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		// The lease runs out and the delivery is claimed again, unless the
+		// subscription was deleted in the meantime
+		return
+	}
+
+	now := time.Now()
+	headers := map[string]string{
+		providers.WebhookIDHeader:        strconv.FormatUint(uint64(delivery.ID), 10),
+		providers.WebhookEventHeader:     delivery.EventType,
+		providers.WebhookTimestampHeader: strconv.FormatInt(now.Unix(), 10),
+		providers.WebhookSignatureHeader: providers.SignWebhook([]byte(delivery.Payload), now, subscription.SigningSecrets(now)...),
+	}
+
+	var response *providers.WebhookResponse
+	if s.providers == nil || s.providers.Webhook == nil {
+		err = fmt.Errorf("webhook provider not configured")
+	} else {
+		response, err = s.providers.Webhook.PostWebhook(ctx, subscription.URL, headers, []byte(delivery.Payload))
+	}
+	duration := time.Since(now)
+
+	delivery.Attempts++
+	attempt := &models.WebhookDeliveryAttempt{
+		DeliveryID:     delivery.ID,
+		Attempt:        delivery.Attempts,
+		RequestHeaders: headers,
+		DurationMs:     duration.Milliseconds(),
+	}
+
+	succeeded := false
+	if err != nil {
+		attempt.Error = err.Error()
+		delivery.ResponseStatus = 0
+		delivery.LastError = err.Error()
+	} else {
+		attempt.ResponseStatus = response.StatusCode
+		attempt.ResponseHeaders = response.Headers
+		attempt.ResponseBody = response.Body
+		delivery.ResponseStatus = response.StatusCode
+		succeeded = response.StatusCode >= 200 && response.StatusCode < 300
+		if !succeeded {
+			delivery.LastError = fmt.Sprintf("endpoint responded with status %d", response.StatusCode)
+			attempt.Error = delivery.LastError
+		}
+	}
+
+	outcome := webhookOutcomeSucceeded
+	switch {
+	case succeeded:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+		delivery.LastError = ""
+	case delivery.Attempts >= models.MaxWebhookAttempts:
+		outcome = webhookOutcomeExhausted
+		delivery.Status = models.WebhookDeliveryDeadLetter
+		delivery.NextAttemptAt = nil
+	default:
+		outcome = webhookOutcomeRetrying
+		nextAttempt := now.Add(backoff(s.webhookRetryBase, s.webhookRetryMax, delivery.Attempts))
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.NextAttemptAt = &nextAttempt
+	}
+
+	s.metrics.RecordWebhookDelivery(delivery.EventType, outcome, duration)
+	if err := s.repo.SaveWebhookDeliveryAttempt(ctx, delivery, attempt); err != nil {
+		return
+	}
+
+	if !succeeded {
+		s.logger.Warn("Webhook delivery attempt failed", map[string]interface{}{
+			"delivery_id":     delivery.ID,
+			"subscription_id": subscription.ID,
+			"event_type":      delivery.EventType,
+			"attempt":         delivery.Attempts,
+			"error":           delivery.LastError,
+			"outcome":         outcome,
+		})
+	}
+
+	gone := response != nil && response.StatusCode == http.StatusGone
+	s.recordWebhookResult(ctx, subscription, succeeded, gone, now)
+}
+
+// recordWebhookResult tracks the subscription's run of failed attempts and
+// disables it when the endpoint asked to stop (410 Gone) or keeps failing
+func (s *NotificationService) recordWebhookResult(ctx context.Context, subscription *models.WebhookSubscription, succeeded, gone bool, at time.Time) {
+	updated, err := s.repo.RecordWebhookSubscriptionResult(ctx, subscription.ID, succeeded, at)
+	if err != nil || succeeded {
+		return
+	}
+
+	reason := ""
+	switch {
+	case gone:
+		reason = webhookDisabledGone
+	case updated.ConsecutiveFailures >= webhookDisableAfterFailures &&
+		updated.FailingSince != nil && at.Sub(*updated.FailingSince) >= webhookDisableAfterFailingFor:
+		reason = webhookDisabledFailing
+	default:
+		return
+	}
+
+	disabled, err := s.repo.DisableWebhookSubscription(ctx, subscription.ID, reason, at)
+	if err != nil || !disabled {
+		return
+	}
+
+	s.metrics.RecordWebhookSubscriptionDisabled(reason)
+	s.logger.Warn("Webhook subscription disabled", map[string]interface{}{
+		"subscription_id":      subscription.ID,
+		"club_id":              subscription.ClubID,
+		"url":                  subscription.URL,
+		"reason":               reason,
+		"consecutive_failures": updated.ConsecutiveFailures,
+	})
+
+	// Let the club know its endpoint needs attention
+	data, _ := json.Marshal(map[string]interface{}{
+		"subscription_id": subscription.ID,
+		"club_id":         subscription.ClubID,
+		"url":             subscription.URL,
+		"reason":          reason,
+		"timestamp":       at,
+	})
+	if err := s.messaging.Publish(ctx, "webhook.subscription_disabled", data); err != nil {
+		s.logger.Error("Failed to publish webhook subscription event", map[string]interface{}{
+			"error":           err.Error(),
+			"subscription_id": subscription.ID,
+		})
+	}
+}
+
+// Subscription management
+
+// CreateWebhookSubscription validates and stores a webhook subscription with
+// a new signing secret
+func (s *NotificationService) CreateWebhookSubscription(ctx context.Context, req *CreateWebhookSubscriptionRequest) (*WebhookSubscriptionSecret, error) {
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &models.WebhookSubscription{
+		ClubID:      req.ClubID,
+		Name:        strings.TrimSpace(req.Name),
+		URL:         strings.TrimSpace(req.URL),
+		EventTypes:  req.EventTypes,
+		Secret:      secret,
+		IsActive:    true,
+		CreatedByID: req.CreatedByID,
+	}
+	if err := validateWebhookSubscription(subscription); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateWebhookSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Webhook subscription created", map[string]interface{}{
+		"subscription_id": subscription.ID,
+		"club_id":         subscription.ClubID,
+		"event_types":     subscription.EventTypes,
+	})
+
+	return &WebhookSubscriptionSecret{Subscription: subscription, Secret: secret}, nil
+}
+
+// GetWebhookSubscription retrieves a webhook subscription by ID
+func (s *NotificationService) GetWebhookSubscription(ctx context.Context, id uint) (*models.WebhookSubscription, error) {
+	return s.repo.GetWebhookSubscriptionByID(ctx, id)
+}
+
+// GetWebhookSubscriptionsByClub retrieves the webhook subscriptions of a club
+func (s *NotificationService) GetWebhookSubscriptionsByClub(ctx context.Context, clubID uint) ([]models.WebhookSubscription, error) {
+	return s.repo.GetWebhookSubscriptionsByClub(ctx, clubID)
+}
+
+// UpdateWebhookSubscription applies a partial update to a webhook
+// subscription. Enabling a disabled subscription clears its failures and
+// resumes the deliveries that were waiting for it.
+func (s *NotificationService) UpdateWebhookSubscription(ctx context.Context, id uint, req *UpdateWebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
+	subscription, err := s.repo.GetWebhookSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		subscription.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.URL != nil {
+		subscription.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.EventTypes != nil {
+		subscription.EventTypes = req.EventTypes
+	}
+
+	enabled := false
+	if req.IsActive != nil && *req.IsActive != subscription.IsActive {
+		subscription.IsActive = *req.IsActive
+		if subscription.IsActive {
+			enabled = true
+			subscription.ConsecutiveFailures = 0
+			subscription.FailingSince = nil
+			subscription.DisabledAt = nil
+			subscription.DisabledReason = ""
+		} else {
+			now := time.Now()
+			subscription.DisabledAt = &now
+			subscription.DisabledReason = ""
+		}
+	}
+
+	if err := validateWebhookSubscription(subscription); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateWebhookSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	if enabled {
+		s.wakeWebhookDispatcher()
+	}
+	return subscription, nil
+}
+
+// DeleteWebhookSubscription deletes a webhook subscription; deliveries not yet
+// sent to it are dead-lettered
+func (s *NotificationService) DeleteWebhookSubscription(ctx context.Context, id uint) error {
+	return s.repo.DeleteWebhookSubscription(ctx, id)
+}
+
+// RotateWebhookSecret replaces a subscription's signing secret. Deliveries
+// are signed with both secrets until the grace period ends, so the receiver
+// can switch over without rejecting any; a grace of zero revokes the old
+// secret at once.
+func (s *NotificationService) RotateWebhookSecret(ctx context.Context, id uint, req *RotateWebhookSecretRequest) (*WebhookSubscriptionSecret, error) {
+	grace := defaultWebhookSecretGrace
+	if req != nil && req.GraceHours != nil {
+		if *req.GraceHours < 0 || *req.GraceHours > maxWebhookSecretGraceHours {
+			return nil, fmt.Errorf("%w: grace_hours must be between 0 and %d", ErrValidation, maxWebhookSecretGraceHours)
+		}
+		grace = time.Duration(*req.GraceHours) * time.Hour
+	}
+
+	subscription, err := s.repo.GetWebhookSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.PreviousSecret = ""
+	subscription.PreviousSecretExpiresAt = nil
+	if grace > 0 {
+		expiresAt := time.Now().Add(grace)
+		subscription.PreviousSecret = subscription.Secret
+		subscription.PreviousSecretExpiresAt = &expiresAt
+	}
+	subscription.Secret = secret
+
+	if err := s.repo.UpdateWebhookSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Webhook subscription secret rotated", map[string]interface{}{
+		"subscription_id": subscription.ID,
+		"club_id":         subscription.ClubID,
+		"grace":           grace.String(),
+	})
+
+	return &WebhookSubscriptionSecret{Subscription: subscription, Secret: secret}, nil
+}
+
+// Delivery log
+
+// GetWebhookDeliveries retrieves a page of a subscription's deliveries,
+// newest first
+func (s *NotificationService) GetWebhookDeliveries(ctx context.Context, subscriptionID uint, query *WebhookDeliveryQuery) ([]models.WebhookDelivery, error) {
+	if _, err := s.repo.GetWebhookSubscriptionByID(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWebhookDeliveries(ctx, subscriptionID, query.Status, query.EventType, query.Limit, query.Offset)
+}
+
+// GetWebhookDelivery retrieves a delivery with the request and response of each attempt
+func (s *NotificationService) GetWebhookDelivery(ctx context.Context, id uint) (*models.WebhookDelivery, error) {
+	return s.repo.GetWebhookDeliveryByID(ctx, id)
+}
+
+// RedeliverWebhook sends a delivery again with a fresh set of attempts,
+// typically after the receiver fixed what made it fail. The payload and
+// webhook ID are unchanged, so receivers can recognise an event they already
+// processed.
+func (s *NotificationService) RedeliverWebhook(ctx context.Context, id uint) (*models.WebhookDelivery, error) {
+	delivery, err := s.repo.GetWebhookDeliveryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.repo.GetWebhookSubscriptionByID(ctx, delivery.SubscriptionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: the subscription was deleted", ErrValidation)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.IsActive {
+		return nil, fmt.Errorf("%w: the subscription is disabled", ErrValidation)
+	}
+
+	reset, err := s.repo.ResetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !reset {
+		return nil, fmt.Errorf("%w: the delivery is being sent", ErrValidation)
+	}
+
+	s.logger.Info("Webhook delivery queued for redelivery", map[string]interface{}{
+		"delivery_id":     id,
+		"subscription_id": subscription.ID,
+		"event_type":      delivery.EventType,
+	})
+
+	s.wakeWebhookDispatcher()
+	return s.repo.GetWebhookDeliveryByID(ctx, id)
+}
+
+// validateWebhookSubscription checks a subscription is complete and that it
+// delivers to an https URL on a public host
+func validateWebhookSubscription(subscription *models.WebhookSubscription) error {
+	var problems []string
+	if subscription.ClubID == 0 {
+		problems = append(problems, "club_id is required")
+	}
+	if subscription.Name == "" {
+		problems = append(problems, "name is required")
+	}
+
+	endpoint, err := url.Parse(subscription.URL)
+	switch {
+	case subscription.URL == "":
+		problems = append(problems, "url is required")
+	case err != nil || endpoint.Scheme != "https" || endpoint.Host == "":
+		problems = append(problems, "url must be an https URL")
+	case endpoint.User != nil:
+		problems = append(problems, "url must not contain credentials")
+	case !publicWebhookHost(endpoint.Hostname()):
+		problems = append(problems, "url must point to a public host")
+	case len(subscription.URL) > 2048:
+		problems = append(problems, "url must be at most 2048 characters")
+	}
+
+	if len(subscription.EventTypes) == 0 {
+		problems = append(problems, "at least one event type is required")
+	}
+	for _, eventType := range subscription.EventTypes {
+		if strings.TrimSpace(eventType) == "" || len(eventType) > 100 {
+			problems = append(problems, fmt.Sprintf("invalid event type %q", eventType))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrValidation, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// publicWebhookHost rejects hosts that are plainly internal. Names are only
+// resolved on delivery, where the provider refuses non-public addresses.
+func publicWebhookHost(host string) bool {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return providers.IsPublicAddress(addr)
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	return host != "localhost" && !strings.HasSuffix(host, ".localhost")
+}
+
+// newWebhookSecret generates a signing secret
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+// Webhook request/response types
+
+type CreateWebhookSubscriptionRequest struct {
+	ClubID      uint     `json:"club_id" validate:"required"`
+	Name        string   `json:"name" validate:"required"`
+	URL         string   `json:"url" validate:"required"`
+	EventTypes  []string `json:"event_types" validate:"required"`
+	CreatedByID string   `json:"created_by_id"`
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	Name       *string  `json:"name,omitempty"`
+	URL        *string  `json:"url,omitempty"`
+	EventTypes []string `json:"event_types,omitempty"`
+	IsActive   *bool    `json:"is_active,omitempty"`
+}
+
+type RotateWebhookSecretRequest struct {
+	// GraceHours is how long the old secret keeps signing deliveries (default 24)
+	GraceHours *int `json:"grace_hours,omitempty"`
+}
+
+// WebhookDeliveryQuery selects a page of a subscription's delivery log
+type WebhookDeliveryQuery struct {
+	Status    models.WebhookDeliveryStatus
+	EventType string
+	Limit     int
+	Offset    int
+}
@@ -0,0 +1,223 @@
+package templating
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+)
+
+// DefaultMaxSMSSegments caps rendered SMS bodies at three concatenated parts
+const DefaultMaxSMSSegments = 3
+
+// Content is the renderable source of one template version
+type Content struct {
+	Subject   string
+	Body      string
+	HTMLBody  string
+	Variables string
+}
+
+// ContentFromTemplate returns the renderable content of a template
+func ContentFromTemplate(t *models.NotificationTemplate) Content {
+	return Content{
+		Subject:   t.Subject,
+		Body:      t.Body,
+		HTMLBody:  t.HTMLBody,
+		Variables: t.Variables,
+	}
+}
+
+// ContentFromVersion returns the renderable content of a stored template version
+func ContentFromVersion(v *models.NotificationTemplateVersion) Content {
+	return Content{
+		Subject:   v.Subject,
+		Body:      v.Body,
+		HTMLBody:  v.HTMLBody,
+		Variables: v.Variables,
+	}
+}
+
+// Result is the channel-specific output of rendering a template
+type Result struct {
+	Subject string   `json:"subject,omitempty"`
+	Text    string   `json:"text"`
+	HTML    string   `json:"html,omitempty"`
+	SMS     *SMSInfo `json:"sms,omitempty"`
+}
+
+// Renderer renders notification templates for a delivery channel
+type Renderer struct {
+	maxSMSSegments int
+}
+
+// NewRenderer creates a renderer. SMS output longer than maxSMSSegments is truncated.
+func NewRenderer(maxSMSSegments int) *Renderer {
+	return &Renderer{maxSMSSegments: maxSMSSegments}
+}
+
+var templateFuncs = map[string]interface{}{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"formatDate": func(layout string, t time.Time) string {
+		return t.Format(layout)
+	},
+	"default": func(fallback, value interface{}) interface{} {
+		if value == nil || value == "" {
+			return fallback
+		}
+		return value
+	},
+}
+
+// Compile checks that every part of the content parses and that its
+// variable declarations are valid. It is used before a template is saved.
+func Compile(content Content) error {
+	if _, err := ParseVariableSpecs(content.Variables); err != nil {
+		return err
+	}
+	if _, err := parseText("subject", content.Subject); err != nil {
+		return err
+	}
+	if _, err := parseText("body", content.Body); err != nil {
+		return err
+	}
+	if content.HTMLBody != "" {
+		if _, err := parseHTML("html_body", content.HTMLBody); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render validates data against the declared variables and renders the content
+func (r *Renderer) Render(channel models.NotificationType, content Content, data map[string]interface{}) (*Result, error) {
+	specs, err := ParseVariableSpecs(content.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := specs.Validate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.render(channel, content, specs, values)
+}
+
+// Preview renders the content using declared sample values for any variable
+// not present in data
+func (r *Renderer) Preview(channel models.NotificationType, content Content, data map[string]interface{}) (*Result, error) {
+	specs, err := ParseVariableSpecs(content.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := specs.Validate(specs.SampleData(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.render(channel, content, specs, values)
+}
+
+func (r *Renderer) render(channel models.NotificationType, content Content, specs VariableSpecs, values map[string]interface{}) (*Result, error) {
+	// Optional variables that were not supplied render as empty rather than "<no value>"
+	for name := range specs {
+		if _, ok := values[name]; !ok {
+			values[name] = ""
+		}
+	}
+
+	text, err := executeText("body", content.Body, values)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Text: text}
+
+	switch channel {
+	case models.NotificationTypeSMS:
+		fitted, info := FitSMS(strings.TrimSpace(text), r.maxSMSSegments)
+		result.Text = fitted
+		result.SMS = &info
+		return result, nil
+	}
+
+	subject, err := executeText("subject", content.Subject, values)
+	if err != nil {
+		return nil, err
+	}
+	// Subjects end up in mail headers and push titles, so keep them on one line
+	result.Subject = strings.Join(strings.Fields(subject), " ")
+
+	if channel == models.NotificationTypeEmail {
+		if content.HTMLBody != "" {
+			result.HTML, err = executeHTML("html_body", content.HTMLBody, values)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			result.HTML = plainTextToHTML(text)
+		}
+	}
+
+	return result, nil
+}
+
+func parseText(name, source string) (*texttemplate.Template, error) {
+	tmpl, err := texttemplate.New(name).Funcs(texttemplate.FuncMap(templateFuncs)).Option("missingkey=error").Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return tmpl, nil
+}
+
+func parseHTML(name, source string) (*htmltemplate.Template, error) {
+	tmpl, err := htmltemplate.New(name).Funcs(htmltemplate.FuncMap(templateFuncs)).Option("missingkey=error").Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return tmpl, nil
+}
+
+func executeText(name, source string, values map[string]interface{}) (string, error) {
+	tmpl, err := parseText(name, source)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidVariables, err)
+	}
+	return buf.String(), nil
+}
+
+func executeHTML(name, source string, values map[string]interface{}) (string, error) {
+	tmpl, err := parseHTML(name, source)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidVariables, err)
+	}
+	return buf.String(), nil
+}
+
+// plainTextToHTML escapes rendered plain text and keeps its line breaks
+func plainTextToHTML(text string) string {
+	escaped := htmltemplate.HTMLEscapeString(strings.TrimSpace(text))
+	paragraphs := strings.Split(escaped, "\n\n")
+	for i, paragraph := range paragraphs {
+		paragraphs[i] = "<p>" + strings.ReplaceAll(paragraph, "\n", "<br>") + "</p>"
+	}
+	return "<html>\n<body>\n" + strings.Join(paragraphs, "\n") + "\n</body>\n</html>"
+}
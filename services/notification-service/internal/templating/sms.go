@@ -0,0 +1,95 @@
+package templating
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// SMS encodings
+const (
+	SMSEncodingGSM7 = "gsm7"
+	SMSEncodingUCS2 = "ucs2"
+)
+
+const smsTruncationSuffix = "..."
+
+// gsm7Basic is the GSM 03.38 basic character set; each takes one septet
+const gsm7Basic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsm7Extended characters are sent with an escape and take two septets
+const gsm7Extended = "^{}\\[~]|€\f"
+
+// SMSInfo describes how a rendered SMS body will be transmitted
+type SMSInfo struct {
+	Encoding  string `json:"encoding"`
+	Length    int    `json:"length"`
+	Segments  int    `json:"segments"`
+	Truncated bool   `json:"truncated"`
+}
+
+// AnalyzeSMS reports the encoding, encoded length and segment count of text
+func AnalyzeSMS(text string) SMSInfo {
+	if septets, ok := gsm7Length(text); ok {
+		return SMSInfo{
+			Encoding: SMSEncodingGSM7,
+			Length:   septets,
+			Segments: segmentCount(septets, 160, 153),
+		}
+	}
+
+	units := len(utf16.Encode([]rune(text)))
+	return SMSInfo{
+		Encoding: SMSEncodingUCS2,
+		Length:   units,
+		Segments: segmentCount(units, 70, 67),
+	}
+}
+
+// FitSMS shortens text so it fits in maxSegments, marking the cut with an
+// ellipsis. A maxSegments of zero or less disables truncation.
+func FitSMS(text string, maxSegments int) (string, SMSInfo) {
+	info := AnalyzeSMS(text)
+	if maxSegments <= 0 || info.Segments <= maxSegments {
+		return text, info
+	}
+
+	runes := []rune(strings.TrimSpace(text))
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRight(string(runes), " ") + smsTruncationSuffix
+		if candidateInfo := AnalyzeSMS(candidate); candidateInfo.Segments <= maxSegments {
+			candidateInfo.Truncated = true
+			return candidate, candidateInfo
+		}
+	}
+
+	info = AnalyzeSMS(smsTruncationSuffix)
+	info.Truncated = true
+	return smsTruncationSuffix, info
+}
+
+func gsm7Length(text string) (int, bool) {
+	septets := 0
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune(gsm7Basic, r):
+			septets++
+		case strings.ContainsRune(gsm7Extended, r):
+			septets += 2
+		default:
+			return 0, false
+		}
+	}
+	return septets, true
+}
+
+func segmentCount(length, single, multi int) int {
+	if length == 0 {
+		return 0
+	}
+	if length <= single {
+		return 1
+	}
+	return (length + multi - 1) / multi
+}
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"reciprocal-clubs-backend/services/notification-service/internal/models"
+	"reciprocal-clubs-backend/services/notification-service/internal/templating"
+)
+
+const bookingVariables = `{
+	"member_name": {"type": "string", "required": true, "sample": "Alex"},
+	"guests": "integer",
+	"date": {"type": "date", "required": true}
+}`
+
+func TestRender_EmailEscapesHTML(t *testing.T) {
+	renderer := templating.NewRenderer(templating.DefaultMaxSMSSegments)
+	content := templating.Content{
+		Subject:   "Booking for {{.member_name}}\nBcc: someone@example.com",
+		Body:      "Hi {{.member_name}},\n\nYou booked for {{.guests}} guests on {{formatDate \"2 Jan 2006\" .date}}.",
+		Variables: bookingVariables,
+	}
+
+	result, err := renderer.Render(models.NotificationTypeEmail, content, map[string]interface{}{
+		"member_name": "<script>alert(1)</script>",
+		"guests":      float64(2),
+		"date":        "2024-03-09",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Booking for <script>alert(1)</script> Bcc: someone@example.com", result.Subject)
+	assert.Contains(t, result.Text, "You booked for 2 guests on 9 Mar 2024.")
+	assert.NotContains(t, result.HTML, "<script>")
+	assert.Contains(t, result.HTML, "&lt;script&gt;")
+	assert.Nil(t, result.SMS)
+}
+
+func TestRender_HTMLBodyIsContextuallyEscaped(t *testing.T) {
+	renderer := templating.NewRenderer(templating.DefaultMaxSMSSegments)
+	content := templating.Content{
+		Subject:   "Hello",
+		Body:      "Hello {{.member_name}}",
+		HTMLBody:  `<p>Hello <b>{{.member_name}}</b></p>`,
+		Variables: `{"member_name": "string"}`,
+	}
+
+	result, err := renderer.Render(models.NotificationTypeEmail, content, map[string]interface{}{
+		"member_name": `Sam & "Co"`,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, `<p>Hello <b>Sam &amp; &#34;Co&#34;</b></p>`, result.HTML)
+	assert.Equal(t, `Hello Sam & "Co"`, result.Text)
+}
+
+func TestRender_ValidatesVariables(t *testing.T) {
+	renderer := templating.NewRenderer(templating.DefaultMaxSMSSegments)
+	content := templating.Content{Body: "{{.member_name}} {{.guests}}", Variables: bookingVariables}
+
+	_, err := renderer.Render(models.NotificationTypeEmail, content, map[string]interface{}{
+		"guests":  "two",
+		"unknown": "x",
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, templating.ErrInvalidVariables))
+	assert.Contains(t, err.Error(), "member_name is required")
+	assert.Contains(t, err.Error(), "guests: expected integer")
+	assert.Contains(t, err.Error(), "unknown is not a declared variable")
+}
+
+func TestParseVariableSpecs_RejectsUnknownType(t *testing.T) {
+	_, err := templating.ParseVariableSpecs(`{"amount": "money"}`)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, templating.ErrInvalidTemplate))
+}
+
+func TestPreview_UsesSamples(t *testing.T) {
+	renderer := templating.NewRenderer(templating.DefaultMaxSMSSegments)
+	content := templating.Content{
+		Subject:   "Hi {{.member_name}}",
+		Body:      "{{.member_name}} x{{.guests}} on {{.date.Format \"2006-01-02\"}}",
+		Variables: bookingVariables,
+	}
+
+	result, err := renderer.Preview(models.NotificationTypePush, content, map[string]interface{}{"guests": 4})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hi Alex", result.Subject)
+	assert.Equal(t, "Alex x4 on 2024-01-15", result.Text)
+	assert.Empty(t, result.HTML)
+}
+
+func TestRender_ListVariables(t *testing.T) {
+	renderer := templating.NewRenderer(templating.DefaultMaxSMSSegments)
+	content := templating.Content{
+		Body:      "{{range .items}}- {{.subject}} ({{.count}})\n{{end}}",
+		Variables: `{"items": {"type": "list", "required": true}}`,
+	}
+
+	result, err := renderer.Render(models.NotificationTypeInApp, content, map[string]interface{}{
+		"items": []map[string]interface{}{
+			{"subject": "Court lighting", "count": 2},
+			{"subject": "Winter hours", "count": 1},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "- Court lighting (2)\n- Winter hours (1)\n", result.Text)
+
+	_, err = renderer.Render(models.NotificationTypeInApp, content, map[string]interface{}{"items": "Court lighting"})
+	assert.True(t, errors.Is(err, templating.ErrInvalidVariables))
+}
+
+func TestRender_SMSTruncatesToSegmentLimit(t *testing.T) {
+	renderer := templating.NewRenderer(2)
+	content := templating.Content{Subject: "ignored", Body: "{{.message}}", Variables: `{"message": "string"}`}
+
+	result, err := renderer.Render(models.NotificationTypeSMS, content, map[string]interface{}{
+		"message": strings.Repeat("a", 400),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, result.SMS)
+	assert.Empty(t, result.Subject)
+	assert.Equal(t, templating.SMSEncodingGSM7, result.SMS.Encoding)
+	assert.Equal(t, 2, result.SMS.Segments)
+	assert.Equal(t, 306, result.SMS.Length)
+	assert.True(t, result.SMS.Truncated)
+	assert.True(t, strings.HasSuffix(result.Text, "..."))
+}
+
+func TestAnalyzeSMS(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		encoding string
+		length   int
+		segments int
+	}{
+		{"single gsm segment", strings.Repeat("a", 160), templating.SMSEncodingGSM7, 160, 1},
+		{"multipart gsm", strings.Repeat("a", 161), templating.SMSEncodingGSM7, 161, 2},
+		{"extended characters count twice", "{}€", templating.SMSEncodingGSM7, 6, 1},
+		{"unicode switches to ucs2", strings.Repeat("é", 10) + "😀", templating.SMSEncodingUCS2, 12, 1},
+		{"multipart ucs2", strings.Repeat("ł", 71), templating.SMSEncodingUCS2, 71, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := templating.AnalyzeSMS(tt.text)
+			assert.Equal(t, tt.encoding, info.Encoding)
+			assert.Equal(t, tt.length, info.Length)
+			assert.Equal(t, tt.segments, info.Segments)
+		})
+	}
+}
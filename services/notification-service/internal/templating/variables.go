@@ -0,0 +1,278 @@
+package templating
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidTemplate is returned when a template or its variable declarations cannot be parsed
+	ErrInvalidTemplate = errors.New("invalid template")
+
+	// ErrInvalidVariables is returned when supplied data does not match the declared variables
+	ErrInvalidVariables = errors.New("invalid template variables")
+)
+
+// VariableType is the declared type of a template variable
+type VariableType string
+
+const (
+	VariableTypeString  VariableType = "string"
+	VariableTypeNumber  VariableType = "number"
+	VariableTypeInteger VariableType = "integer"
+	VariableTypeBoolean VariableType = "boolean"
+	VariableTypeDate    VariableType = "date"
+	VariableTypeURL     VariableType = "url"
+	// VariableTypeList holds a list of values or objects, ranged over in the template
+	VariableTypeList VariableType = "list"
+)
+
+// VariableSpec describes a single variable a template expects
+type VariableSpec struct {
+	Type        VariableType `json:"type"`
+	Required    bool         `json:"required"`
+	Description string       `json:"description,omitempty"`
+	Sample      interface{}  `json:"sample,omitempty"`
+}
+
+// VariableSpecs maps variable names to their declarations
+type VariableSpecs map[string]VariableSpec
+
+// ParseVariableSpecs parses the JSON stored in NotificationTemplate.Variables.
+// Each entry may either be a bare type name ({"name": "string"}) or a full
+// declaration ({"name": {"type": "string", "required": true}}).
+func ParseVariableSpecs(raw string) (VariableSpecs, error) {
+	specs := make(VariableSpecs)
+	if strings.TrimSpace(raw) == "" {
+		return specs, nil
+	}
+
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+		return nil, fmt.Errorf("%w: variables must be a JSON object: %v", ErrInvalidTemplate, err)
+	}
+
+	for name, entry := range entries {
+		var spec VariableSpec
+
+		var typeName string
+		if err := json.Unmarshal(entry, &typeName); err == nil {
+			spec.Type = VariableType(typeName)
+		} else if err := json.Unmarshal(entry, &spec); err != nil {
+			return nil, fmt.Errorf("%w: variable %q: %v", ErrInvalidTemplate, name, err)
+		}
+
+		if spec.Type == "" {
+			spec.Type = VariableTypeString
+		}
+		if !spec.Type.valid() {
+			return nil, fmt.Errorf("%w: variable %q has unsupported type %q", ErrInvalidTemplate, name, spec.Type)
+		}
+		if spec.Sample != nil {
+			if _, err := spec.coerce(spec.Sample); err != nil {
+				return nil, fmt.Errorf("%w: sample for %q: %v", ErrInvalidTemplate, name, err)
+			}
+		}
+
+		specs[name] = spec
+	}
+
+	return specs, nil
+}
+
+// Validate checks data against the declarations and returns a copy with every
+// value converted to its declared Go type. Undeclared variables are rejected
+// when the template declares any variables at all.
+func (s VariableSpecs) Validate(data map[string]interface{}) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(data))
+	var problems []string
+
+	for name, spec := range s {
+		raw, ok := data[name]
+		if !ok || raw == nil {
+			if spec.Required {
+				problems = append(problems, fmt.Sprintf("%s is required", name))
+			}
+			continue
+		}
+
+		value, err := spec.coerce(raw)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		values[name] = value
+	}
+
+	for name, value := range data {
+		if _, declared := s[name]; declared {
+			continue
+		}
+		if len(s) > 0 {
+			problems = append(problems, fmt.Sprintf("%s is not a declared variable", name))
+			continue
+		}
+		values[name] = value
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVariables, strings.Join(problems, "; "))
+	}
+
+	return values, nil
+}
+
+// SampleData builds preview data from declared samples, falling back to a
+// readable placeholder for variables without one. Values in overrides win.
+func (s VariableSpecs) SampleData(overrides map[string]interface{}) map[string]interface{} {
+	data := make(map[string]interface{}, len(s))
+	for name, spec := range s {
+		if spec.Sample != nil {
+			data[name] = spec.Sample
+			continue
+		}
+		data[name] = spec.placeholder(name)
+	}
+	for name, value := range overrides {
+		data[name] = value
+	}
+	return data
+}
+
+func (t VariableType) valid() bool {
+	switch t {
+	case VariableTypeString, VariableTypeNumber, VariableTypeInteger, VariableTypeBoolean, VariableTypeDate, VariableTypeURL, VariableTypeList:
+		return true
+	}
+	return false
+}
+
+func (spec VariableSpec) placeholder(name string) interface{} {
+	switch spec.Type {
+	case VariableTypeNumber:
+		return 12.5
+	case VariableTypeInteger:
+		return 42
+	case VariableTypeBoolean:
+		return true
+	case VariableTypeDate:
+		return time.Date(2024, time.January, 15, 18, 30, 0, 0, time.UTC).Format(time.RFC3339)
+	case VariableTypeURL:
+		return "https://example.com/" + name
+	case VariableTypeList:
+		return []interface{}{"[" + name + "]"}
+	default:
+		return "[" + name + "]"
+	}
+}
+
+// coerce converts a JSON-decoded value into the Go type used during rendering
+func (spec VariableSpec) coerce(raw interface{}) (interface{}, error) {
+	switch spec.Type {
+	case VariableTypeString:
+		switch v := raw.(type) {
+		case string:
+			return v, nil
+		case float64, bool, int, int64:
+			return fmt.Sprint(v), nil
+		}
+		return nil, fmt.Errorf("expected string")
+
+	case VariableTypeNumber:
+		switch v := raw.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("expected number")
+			}
+			return f, nil
+		}
+		return nil, fmt.Errorf("expected number")
+
+	case VariableTypeInteger:
+		switch v := raw.(type) {
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("expected integer")
+			}
+			return int64(v), nil
+		case int:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		case string:
+			i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("expected integer")
+			}
+			return i, nil
+		}
+		return nil, fmt.Errorf("expected integer")
+
+	case VariableTypeBoolean:
+		switch v := raw.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, fmt.Errorf("expected boolean")
+			}
+			return b, nil
+		}
+		return nil, fmt.Errorf("expected boolean")
+
+	case VariableTypeDate:
+		switch v := raw.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+				if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+					return t, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("expected RFC3339 or YYYY-MM-DD date")
+
+	case VariableTypeURL:
+		v, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected URL")
+		}
+		u, err := url.Parse(strings.TrimSpace(v))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("expected absolute http(s) URL")
+		}
+		return u.String(), nil
+
+	case VariableTypeList:
+		switch v := raw.(type) {
+		case []interface{}:
+			return v, nil
+		case []map[string]interface{}:
+			items := make([]interface{}, len(v))
+			for i, item := range v {
+				items[i] = item
+			}
+			return items, nil
+		}
+		return nil, fmt.Errorf("expected list")
+	}
+
+	return nil, fmt.Errorf("unsupported type %q", spec.Type)
+}
@@ -407,11 +407,12 @@ func (s *ReciprocalService) generateQRCodeData(visit *models.Visit) string {
 
 func (s *ReciprocalService) publishAgreementEvent(ctx context.Context, eventType string, agreement *models.Agreement) {
 	data := map[string]interface{}{
-		"agreement_id":      agreement.ID,
-		"proposing_club_id": agreement.ProposingClubID,
-		"target_club_id":    agreement.TargetClubID,
-		"status":            agreement.Status,
-		"timestamp":         time.Now(),
+		"agreement_id":         agreement.ID,
+		"proposing_club_id":    agreement.ProposingClubID,
+		"target_club_id":       agreement.TargetClubID,
+		"status":               agreement.Status,
+		"max_visits_per_month": agreement.Terms.MaxVisitsPerMonth,
+		"timestamp":            time.Now(),
 	}
 
 	jsonData, _ := json.Marshal(data)
@@ -433,6 +434,10 @@ func (s *ReciprocalService) publishVisitEvent(ctx context.Context, eventType str
 		"home_club_id":     visit.HomeClubID,
 		"status":           visit.Status,
 		"visit_date":       visit.VisitDate,
+		"facilities_used":  visit.FacilitiesUsed,
+		"guest_count":      visit.GuestCount,
+		"member_rating":    visit.MemberRating,
+		"club_rating":      visit.ClubRating,
 		"timestamp":        time.Now(),
 	}
 